@@ -15,6 +15,35 @@ type AIClient interface {
 	// AnalyzeProgress provides insights on progress and next steps
 	AnalyzeProgress(ctx context.Context, req ProgressAnalysisRequest) (*ProgressAnalysisResponse, error)
 
+	// ClassifyResources assigns a skill and resource type to imported links
+	ClassifyResources(ctx context.Context, req ResourceClassificationRequest) (*ResourceClassificationResponse, error)
+
+	// ExtractProgress turns a free-form transcript into progress log fields
+	ExtractProgress(ctx context.Context, req ProgressExtractionRequest) (*ProgressExtractionResponse, error)
+
+	// DraftPost writes a learning-in-public social post about a week of progress
+	DraftPost(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error)
+
+	// AuditPath reviews an existing learning path for missing topics,
+	// redundant resources and ordering problems
+	AuditPath(ctx context.Context, req PathAuditRequest) (*PathAuditResponse, error)
+
 	// Provider returns the name of the AI provider
 	Provider() string
 }
+
+// ModelLister is implemented by clients that can list the models available
+// to their API key
+type ModelLister interface {
+	ListModels(ctx context.Context) ([]ModelInfo, error)
+}
+
+// HealthChecker is implemented by clients that can run a minimal test
+// generation to verify the API key, model and quota
+type HealthChecker interface {
+	// Model returns the model requests are sent to
+	Model() string
+
+	// Check sends a tiny prompt and returns any error from the provider
+	Check(ctx context.Context) error
+}
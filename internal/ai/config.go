@@ -7,12 +7,26 @@ import (
 
 // Config holds AI provider configuration
 type Config struct {
-	Provider    string  // "gemini", "openai", "anthropic", "local"
+	Provider    string  // "gemini", "openai", "anthropic", "local", "mock"
 	APIKey      string  // API key or loaded from env
 	Model       string  // Model name
 	Temperature float32 // Temperature for generation (0.0 - 1.0)
 	MaxTokens   int     // Maximum output tokens
 	BaseURL     string  // For custom endpoints (optional)
+
+	// StagedGeneration splits path generation into a planning request and
+	// one request per phase, see gemini.GenerateStagedPath
+	StagedGeneration bool
+	// ParallelRequests is how many requests a generation split into parts,
+	// like one per phase of a path, sends at once
+	ParallelRequests int
+
+	// RecordDir, if set, is where every prompt and its response are saved
+	// as fixtures, see Fixtures
+	RecordDir string
+	// ReplayDir, if set, answers requests with the fixtures recorded there
+	// instead of calling the provider
+	ReplayDir string
 }
 
 func (c *Config) Validate() error {
@@ -24,7 +38,7 @@ func (c *Config) Validate() error {
 		c.APIKey = c.loadAPIKeyFromEnv()
 	}
 
-	if c.APIKey == "" && c.Provider != "local" {
+	if c.APIKey == "" && c.Provider != "local" && c.Provider != "mock" && c.ReplayDir == "" {
 		return fmt.Errorf("API key is required for provider %s (set in config or use env var)", c.Provider)
 	}
 
@@ -36,17 +50,29 @@ func (c *Config) Validate() error {
 		c.MaxTokens = 8000
 	}
 
+	if c.ParallelRequests == 0 {
+		c.ParallelRequests = 3
+	}
+
 	return nil
 }
 
 func (c *Config) loadAPIKeyFromEnv() string {
-	switch c.Provider {
+	if name := APIKeyEnvVar(c.Provider); name != "" {
+		return os.Getenv(name)
+	}
+	return ""
+}
+
+// APIKeyEnvVar returns the environment variable holding a provider's API key
+func APIKeyEnvVar(provider string) string {
+	switch provider {
 	case "gemini":
-		return os.Getenv("GEMINI_API_KEY")
+		return "GEMINI_API_KEY"
 	case "openai":
-		return os.Getenv("OPENAI_API_KEY")
+		return "OPENAI_API_KEY"
 	case "anthropic":
-		return os.Getenv("ANTHROPIC_API_KEY")
+		return "ANTHROPIC_API_KEY"
 	default:
 		return ""
 	}
@@ -54,9 +80,10 @@ func (c *Config) loadAPIKeyFromEnv() string {
 
 func DefaultConfig() Config {
 	return Config{
-		Provider:    "gemini",
-		Model:       "gemini-3-flash-preview",
-		Temperature: 0.7,
-		MaxTokens:   8000,
+		Provider:         "gemini",
+		Model:            "gemini-3-flash-preview",
+		Temperature:      0.7,
+		MaxTokens:        8000,
+		ParallelRequests: 3,
 	}
 }
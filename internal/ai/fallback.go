@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"errors"
+)
+
+// FallbackClient sends each request to a chain of clients in order, moving
+// on to the next one when a client returns an error
+type FallbackClient struct {
+	clients []AIClient
+	last    AIClient
+
+	// OnFallback, if set, is called before a request is retried against
+	// the next client in the chain
+	OnFallback func(failed string, err error, next string)
+}
+
+// NewFallbackClient creates a client that tries primary first and then each fallback
+func NewFallbackClient(primary AIClient, fallbacks ...AIClient) *FallbackClient {
+	return &FallbackClient{
+		clients: append([]AIClient{primary}, fallbacks...),
+		last:    primary,
+	}
+}
+
+// Provider returns the provider that served the last request, or the
+// primary provider before any request was made
+func (f *FallbackClient) Provider() string {
+	return f.last.Provider()
+}
+
+func (f *FallbackClient) GenerateLearningPath(ctx context.Context, req PathGenerationRequest) (*PathGenerationResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*PathGenerationResponse, error) {
+		return c.GenerateLearningPath(ctx, req)
+	})
+}
+
+func (f *FallbackClient) SuggestResources(ctx context.Context, req ResourceSuggestionRequest) (*ResourceSuggestionResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*ResourceSuggestionResponse, error) {
+		return c.SuggestResources(ctx, req)
+	})
+}
+
+func (f *FallbackClient) AnalyzeProgress(ctx context.Context, req ProgressAnalysisRequest) (*ProgressAnalysisResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*ProgressAnalysisResponse, error) {
+		return c.AnalyzeProgress(ctx, req)
+	})
+}
+
+func (f *FallbackClient) ClassifyResources(ctx context.Context, req ResourceClassificationRequest) (*ResourceClassificationResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*ResourceClassificationResponse, error) {
+		return c.ClassifyResources(ctx, req)
+	})
+}
+
+func (f *FallbackClient) ExtractProgress(ctx context.Context, req ProgressExtractionRequest) (*ProgressExtractionResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*ProgressExtractionResponse, error) {
+		return c.ExtractProgress(ctx, req)
+	})
+}
+
+func (f *FallbackClient) DraftPost(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*PostDraftResponse, error) {
+		return c.DraftPost(ctx, req)
+	})
+}
+
+func (f *FallbackClient) AuditPath(ctx context.Context, req PathAuditRequest) (*PathAuditResponse, error) {
+	return fallback(f, ctx, func(c AIClient) (*PathAuditResponse, error) {
+		return c.AuditPath(ctx, req)
+	})
+}
+
+// fallback runs call against each client until one succeeds. It stops early
+// when the context is done, since later clients would fail the same way.
+func fallback[T any](f *FallbackClient, ctx context.Context, call func(AIClient) (T, error)) (T, error) {
+	var zero T
+	var errs []error
+
+	for i, client := range f.clients {
+		resp, err := call(client)
+		if err == nil {
+			f.last = client
+			return resp, nil
+		}
+		errs = append(errs, err)
+
+		if ctx.Err() != nil || i == len(f.clients)-1 {
+			break
+		}
+		if f.OnFallback != nil {
+			f.OnFallback(client.Provider(), err, f.clients[i+1].Provider())
+		}
+	}
+
+	return zero, errors.Join(errs...)
+}
@@ -0,0 +1,81 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackClient(t *testing.T) {
+	rateLimited := &MockClient{
+		ProviderName: "gemini",
+		DraftPostFunc: func(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error) {
+			return nil, &APIError{Provider: "gemini", StatusCode: 429, Err: ErrRateLimitExceeded}
+		},
+	}
+	working := &MockClient{ProviderName: "openai"}
+
+	t.Run("retries against the next provider", func(t *testing.T) {
+		client := NewFallbackClient(rateLimited, working)
+		var switched []string
+		client.OnFallback = func(failed string, err error, next string) {
+			switched = append(switched, failed+"->"+next)
+		}
+
+		assert.Equal(t, "gemini", client.Provider())
+
+		resp, err := client.DraftPost(context.Background(), PostDraftRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Mock weekly learning update", resp.Text)
+		assert.Equal(t, "openai", client.Provider())
+		assert.Equal(t, []string{"gemini->openai"}, switched)
+	})
+
+	t.Run("uses the primary when it succeeds", func(t *testing.T) {
+		client := NewFallbackClient(working, rateLimited)
+
+		_, err := client.DraftPost(context.Background(), PostDraftRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "openai", client.Provider())
+	})
+
+	t.Run("returns every error when all providers fail", func(t *testing.T) {
+		down := &MockClient{
+			ProviderName: "openai",
+			DraftPostFunc: func(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error) {
+				return nil, errors.New("service unavailable")
+			},
+		}
+		client := NewFallbackClient(rateLimited, down)
+
+		_, err := client.DraftPost(context.Background(), PostDraftRequest{})
+
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+		assert.ErrorContains(t, err, "service unavailable")
+		assert.Equal(t, "gemini", client.Provider())
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+		next := &MockClient{
+			ProviderName: "openai",
+			DraftPostFunc: func(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error) {
+				called = true
+				return &PostDraftResponse{}, nil
+			},
+		}
+		client := NewFallbackClient(rateLimited, next)
+
+		_, err := client.DraftPost(ctx, PostDraftRequest{})
+
+		assert.Error(t, err)
+		assert.False(t, called)
+	})
+}
@@ -0,0 +1,83 @@
+package ai
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNoFixture means no response was recorded for a prompt
+var ErrNoFixture = errors.New("no recorded response for this prompt")
+
+// Fixture is a prompt and the response a provider gave to it
+type Fixture struct {
+	Provider string `json:"provider"`
+	Model    string `json:"model"`
+	Prompt   string `json:"prompt"`
+	Response string `json:"response"`
+}
+
+// Fixtures is a directory of recorded responses, one JSON file per prompt
+// named after the prompt's hash. Responses are recorded with
+// Config.RecordDir and replayed by a client created with Config.ReplayDir.
+type Fixtures struct {
+	Dir string
+}
+
+func (f Fixtures) path(prompt string) string {
+	return filepath.Join(f.Dir, strings.TrimPrefix(HashPrompt(prompt), "sha256:")+".json")
+}
+
+// Save writes a fixture, replacing the one recorded for the same prompt
+func (f Fixtures) Save(fixture Fixture) error {
+	if err := os.MkdirAll(f.Dir, 0755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+
+	if err := os.WriteFile(f.path(fixture.Prompt), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// Load reads the fixture recorded for a prompt. It returns ErrNoFixture when
+// there is none, which usually means the data the prompt is built from has
+// changed since it was recorded.
+func (f Fixtures) Load(prompt string) (*Fixture, error) {
+	path := f.path(prompt)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w in %s (%s)", ErrNoFixture, f.Dir, filepath.Base(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
+
+// Record saves a response as a fixture when c.RecordDir is set
+func (c *Config) Record(model, prompt, response string) error {
+	if c.RecordDir == "" {
+		return nil
+	}
+	return Fixtures{Dir: c.RecordDir}.Save(Fixture{
+		Provider: c.Provider,
+		Model:    model,
+		Prompt:   prompt,
+		Response: response,
+	})
+}
@@ -0,0 +1,53 @@
+package ai
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixtures(t *testing.T) {
+	t.Run("loads the response saved for a prompt", func(t *testing.T) {
+		fixtures := Fixtures{Dir: filepath.Join(t.TempDir(), "fixtures")}
+		fixture := Fixture{Provider: "openai", Model: "gpt-4o", Prompt: "Plan a path", Response: `{"ok": true}`}
+		require.NoError(t, fixtures.Save(fixture))
+
+		loaded, err := fixtures.Load("Plan a path")
+		require.NoError(t, err)
+		assert.Equal(t, fixture, *loaded)
+	})
+
+	t.Run("reports prompts that were not recorded", func(t *testing.T) {
+		fixtures := Fixtures{Dir: t.TempDir()}
+		require.NoError(t, fixtures.Save(Fixture{Prompt: "Plan a path", Response: "{}"}))
+
+		_, err := fixtures.Load("Plan another path")
+		assert.ErrorIs(t, err, ErrNoFixture)
+	})
+}
+
+func TestConfigRecord(t *testing.T) {
+	t.Run("does nothing without a record directory", func(t *testing.T) {
+		config := Config{Provider: "gemini"}
+		assert.NoError(t, config.Record("gemini-3-flash-preview", "prompt", "response"))
+	})
+
+	t.Run("saves the provider and model", func(t *testing.T) {
+		dir := t.TempDir()
+		config := Config{Provider: "gemini", RecordDir: dir}
+		require.NoError(t, config.Record("gemini-3-flash-preview", "prompt", "response"))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		fixture, err := Fixtures{Dir: dir}.Load("prompt")
+		require.NoError(t, err)
+		assert.Equal(t, "gemini", fixture.Provider)
+		assert.Equal(t, "gemini-3-flash-preview", fixture.Model)
+		assert.Equal(t, "response", fixture.Response)
+	})
+}
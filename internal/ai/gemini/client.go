@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -11,13 +12,18 @@ import (
 	"github.com/google/generative-ai-go/genai"
 	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/core"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
+// checkPrompt is the minimal prompt used by Check
+const checkPrompt = `Reply with the JSON object {"ok": true}.`
+
 type Client struct {
-	client *genai.Client
-	model  *genai.GenerativeModel
-	config ai.Config
+	client    *genai.Client
+	model     *genai.GenerativeModel
+	modelName string
+	config    ai.Config
 }
 
 func NewClient(cfg ai.Config) (*Client, error) {
@@ -67,9 +73,10 @@ func NewClient(cfg ai.Config) (*Client, error) {
 	}
 
 	return &Client{
-		client: client,
-		model:  model,
-		config: cfg,
+		client:    client,
+		model:     model,
+		modelName: modelName,
+		config:    cfg,
 	}, nil
 }
 
@@ -77,27 +84,24 @@ func (c *Client) Provider() string {
 	return "gemini"
 }
 
+// GenerateLearningPath generates the path in one request, or with
+// ai.stagedGeneration in a planning request and parallel phase requests, see
+// GeneratePath
 func (c *Client) GenerateLearningPath(ctx context.Context, req ai.PathGenerationRequest) (*ai.PathGenerationResponse, error) {
-	prompt, err := c.renderPathPrompt(req)
-	if err != nil {
-		return nil, err
-	}
-
-	responseText, err := c.generateWithRetry(ctx, prompt, 3)
-	if err != nil {
-		return nil, err
-	}
-
 	pathID := core.EntityID(fmt.Sprintf("path-%03d", time.Now().Unix()%1000))
-	goalID := req.Goal.ID
 
-	resp, err := ParsePathGeneration(responseText, pathID, goalID)
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		return c.generateWithRetry(ctx, prompt, 3)
+	}
+	resp, prompt, err := GeneratePath(ctx, generate, req, pathID, c.config)
 	if err != nil {
 		return nil, err
 	}
 
-	resp.Path.GenerationContext = fmt.Sprintf("Goal: %s | Style: %s | Time: %s",
-		req.Goal.Title, req.LearningStyle, req.TimeCommitment)
+	resp.Path.GeneratedBy = c.Provider() + "/" + c.modelName
+	resp.Path.Provenance = ai.NewProvenance(c.Provider(), c.modelName, c.config.Temperature, prompt)
+	resp.Path.GenerationContext = fmt.Sprintf("%s | Style: %s | Time: %s",
+		req.Scope(), req.LearningStyle, req.TimeCommitment)
 
 	return resp, nil
 }
@@ -140,6 +144,105 @@ func (c *Client) AnalyzeProgress(ctx context.Context, req ai.ProgressAnalysisReq
 	return resp, nil
 }
 
+func (c *Client) ClassifyResources(ctx context.Context, req ai.ResourceClassificationRequest) (*ai.ResourceClassificationResponse, error) {
+	prompt, err := c.renderClassificationPrompt(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseResourceClassification(responseText, req)
+}
+
+func (c *Client) ExtractProgress(ctx context.Context, req ai.ProgressExtractionRequest) (*ai.ProgressExtractionResponse, error) {
+	prompt, err := c.renderExtractionPrompt(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseProgressExtraction(responseText, req)
+}
+
+func (c *Client) DraftPost(ctx context.Context, req ai.PostDraftRequest) (*ai.PostDraftResponse, error) {
+	prompt, err := c.renderPostPrompt(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePostDraft(responseText)
+}
+
+func (c *Client) AuditPath(ctx context.Context, req ai.PathAuditRequest) (*ai.PathAuditResponse, error) {
+	prompt, err := c.renderAuditPrompt(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePathAudit(responseText, req)
+}
+
+func (c *Client) Model() string {
+	return c.modelName
+}
+
+// Check runs a single, unretried generation of a tiny prompt
+func (c *Client) Check(ctx context.Context) error {
+	_, err := c.generateWithRetry(ctx, checkPrompt, 1)
+	return err
+}
+
+// ListModels returns the models that support content generation, sorted by ID
+func (c *Client) ListModels(ctx context.Context) ([]ai.ModelInfo, error) {
+	var models []ai.ModelInfo
+
+	it := c.client.ListModels(ctx)
+	for {
+		info, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, &ai.APIError{
+				Provider: "gemini",
+				Message:  "failed to list models",
+				Err:      err,
+			}
+		}
+
+		for _, method := range info.SupportedGenerationMethods {
+			if method == "generateContent" {
+				models = append(models, ai.ModelInfo{
+					ID:          strings.TrimPrefix(info.Name, "models/"),
+					DisplayName: info.DisplayName,
+				})
+				break
+			}
+		}
+	}
+
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+	return models, nil
+}
+
 func (c *Client) generateWithRetry(ctx context.Context, prompt string, maxRetries int) (string, error) {
 	var lastErr error
 
@@ -204,6 +307,9 @@ func (c *Client) generateWithRetry(ctx context.Context, prompt string, maxRetrie
 			continue
 		}
 
+		if err := c.config.Record(c.modelName, prompt, text); err != nil {
+			return "", err
+		}
 		return text, nil
 	}
 
@@ -217,6 +323,12 @@ func (c *Client) generateWithRetry(ctx context.Context, prompt string, maxRetrie
 }
 
 func (c *Client) renderPrompt(promptTemplate string, data interface{}) (string, error) {
+	return RenderPrompt(promptTemplate, data)
+}
+
+// RenderPrompt fills in one of the prompt templates with its request. It is
+// exported so prompts can be previewed or run outside the CLI.
+func RenderPrompt(promptTemplate string, data interface{}) (string, error) {
 	tmpl, err := template.New("prompt").Parse(promptTemplate)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
@@ -230,10 +342,6 @@ func (c *Client) renderPrompt(promptTemplate string, data interface{}) (string,
 	return buf.String(), nil
 }
 
-func (c *Client) renderPathPrompt(req ai.PathGenerationRequest) (string, error) {
-	return c.renderPrompt(PathGenerationPrompt, req)
-}
-
 func (c *Client) renderResourcePrompt(req ai.ResourceSuggestionRequest) (string, error) {
 	return c.renderPrompt(ResourceSuggestionPrompt, req)
 }
@@ -242,6 +350,22 @@ func (c *Client) renderProgressPrompt(req ai.ProgressAnalysisRequest) (string, e
 	return c.renderPrompt(ProgressAnalysisPrompt, req)
 }
 
+func (c *Client) renderClassificationPrompt(req ai.ResourceClassificationRequest) (string, error) {
+	return c.renderPrompt(ResourceClassificationPrompt, req)
+}
+
+func (c *Client) renderExtractionPrompt(req ai.ProgressExtractionRequest) (string, error) {
+	return c.renderPrompt(ProgressExtractionPrompt, req)
+}
+
+func (c *Client) renderPostPrompt(req ai.PostDraftRequest) (string, error) {
+	return c.renderPrompt(PostDraftPrompt, req)
+}
+
+func (c *Client) renderAuditPrompt(req ai.PathAuditRequest) (string, error) {
+	return c.renderPrompt(PathAuditPrompt, req)
+}
+
 func (c *Client) Close() error {
 	return c.client.Close()
 }
@@ -2,7 +2,9 @@ package gemini
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/core"
@@ -102,6 +104,36 @@ func TestParsePathGeneration(t *testing.T) {
 			if resp.Path.Type != core.PathTypeAIGenerated {
 				t.Errorf("expected AI generated path type, got %s", resp.Path.Type)
 			}
+
+			for _, phase := range resp.Phases {
+				if len(phase.Resources) == 0 && len(resp.Resources) > 0 {
+					t.Errorf("expected phase %s to reference its resources", phase.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "plain object", input: `{"a": 1}`, want: `{"a": 1}`},
+		{name: "surrounding whitespace", input: "\n  {\"a\": 1}\n", want: `{"a": 1}`},
+		{name: "json code fence", input: "```json\n{\"a\": {\"b\": 2}}\n```", want: `{"a": {"b": 2}}`},
+		{name: "bare code fence", input: "```\n{\"a\": 1}\n```", want: `{"a": 1}`},
+		{name: "text around fence", input: "Here is your path:\n```json\n{\"a\": 1}\n```\nGood luck!", want: `{"a": 1}`},
+		{name: "text around object", input: "Sure! {\"a\": 1} Let me know.", want: `{"a": 1}`},
+		{name: "no object", input: "no json here", want: "no json here"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractJSON(tt.input); got != tt.want {
+				t.Errorf("ExtractJSON() = %q, want %q", got, tt.want)
+			}
 		})
 	}
 }
@@ -139,6 +171,22 @@ func TestParseResourceSuggestion(t *testing.T) {
 	if resp.Resources[0].Type != core.ResourceCourse {
 		t.Errorf("expected course type, got %s", resp.Resources[0].Type)
 	}
+
+	if resp.Resources[0].WhyRecommended != "Good for beginners" {
+		t.Errorf("expected why recommended to be kept, got %q", resp.Resources[0].WhyRecommended)
+	}
+
+	if resp.Resources[0].Pricing != "free" {
+		t.Errorf("expected pricing free, got %q", resp.Resources[0].Pricing)
+	}
+
+	resp, err = ParseResourceSuggestion(`{"resources": [{"title": "Pricey", "cost": "$49"}]}`, "skill-001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Resources[0].Pricing != "" {
+		t.Errorf("expected unknown cost to be dropped, got %q", resp.Resources[0].Pricing)
+	}
 }
 
 func TestParseProgressAnalysis(t *testing.T) {
@@ -168,6 +216,412 @@ func TestParseProgressAnalysis(t *testing.T) {
 	}
 }
 
+func TestParseResourceClassification(t *testing.T) {
+	req := ai.ResourceClassificationRequest{
+		Candidates: []ai.ResourceCandidate{
+			{Title: "Go pipelines", URL: "https://go.dev/blog/pipelines"},
+			{Title: "Cooking tips", URL: "https://example.com/cooking"},
+		},
+		Skills: []*core.Skill{
+			{ID: "skill-001", Title: "Go"},
+		},
+	}
+
+	input := `{
+		"classifications": [
+			{"index": 0, "skill_id": "skill-001", "type": "article"},
+			{"index": 1, "skill_id": "skill-042", "type": "podcast"},
+			{"index": 7, "skill_id": "skill-001", "type": "video"}
+		]
+	}`
+
+	resp, err := ParseResourceClassification(input, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Classifications) != 2 {
+		t.Fatalf("expected 2 classifications, got %d", len(resp.Classifications))
+	}
+
+	if resp.Classifications[0].SkillID != "skill-001" {
+		t.Errorf("expected skill-001, got %s", resp.Classifications[0].SkillID)
+	}
+
+	if resp.Classifications[1].SkillID != "" {
+		t.Errorf("expected unknown skill to be cleared, got %s", resp.Classifications[1].SkillID)
+	}
+
+	if resp.Classifications[1].Type != core.ResourceArticle {
+		t.Errorf("expected invalid type to fall back to article, got %s", resp.Classifications[1].Type)
+	}
+}
+
+func TestParseProgressExtraction(t *testing.T) {
+	req := ai.ProgressExtractionRequest{
+		Transcript: "so today I spent like ninety minutes on the Go book",
+		Skills: []*core.Skill{
+			{ID: "skill-001", Title: "Go"},
+		},
+		Resources: []*core.Resource{
+			{ID: "resource-001", Title: "The Go Programming Language"},
+		},
+	}
+
+	input := `{
+		"hours_invested": 1.5,
+		"skill_ids": ["skill-001", "skill-099"],
+		"resource_ids": ["resource-001", "resource-042"],
+		"mood": "focused",
+		"summary": "Read two chapters of the Go book."
+	}`
+
+	resp, err := ParseProgressExtraction(input, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.HoursInvested != 1.5 {
+		t.Errorf("expected 1.5 hours, got %v", resp.HoursInvested)
+	}
+
+	if len(resp.SkillIDs) != 1 || resp.SkillIDs[0] != "skill-001" {
+		t.Errorf("expected only skill-001, got %v", resp.SkillIDs)
+	}
+
+	if len(resp.ResourceIDs) != 1 || resp.ResourceIDs[0] != "resource-001" {
+		t.Errorf("expected only resource-001, got %v", resp.ResourceIDs)
+	}
+
+	if resp.Mood != "focused" {
+		t.Errorf("expected mood 'focused', got %s", resp.Mood)
+	}
+
+	if _, err := ParseProgressExtraction("not json", req); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestParsePostDraft(t *testing.T) {
+	input := `{
+		"text": "  Finished the Go concurrency course this week.  ",
+		"hashtags": ["#golang", "learninginpublic", "two words", ""]
+	}`
+
+	resp, err := ParsePostDraft(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Text != "Finished the Go concurrency course this week." {
+		t.Errorf("expected trimmed text, got %q", resp.Text)
+	}
+
+	if len(resp.Hashtags) != 2 || resp.Hashtags[0] != "golang" || resp.Hashtags[1] != "learninginpublic" {
+		t.Errorf("expected [golang learninginpublic], got %v", resp.Hashtags)
+	}
+
+	if _, err := ParsePostDraft(`{"text": " "}`); err == nil {
+		t.Error("expected an error for an empty draft")
+	}
+}
+
+func TestParsePathAudit(t *testing.T) {
+	req := ai.PathAuditRequest{
+		Phases: []ai.AuditPhase{
+			{Phase: &core.Phase{Title: "Basics"}, Number: 1, Resources: []*core.Resource{{ID: "resource-001", Title: "Tour of Go"}}},
+			{Phase: &core.Phase{Title: "Concurrency"}, Number: 2},
+		},
+	}
+
+	input := `{
+		"summary": " Solid, but thin on testing. ",
+		"findings": [
+			{"kind": "Missing-Topic", "phase": 2, "resource_id": "", "problem": "No testing", "suggestion": "Add a testing phase"},
+			{"kind": "redundant-resource", "phase": 7, "resource_id": "[resource-001]", "problem": "Repeats the book", "suggestion": "Drop it"},
+			{"kind": "ordering", "phase": 1, "resource_id": "resource-042", "problem": "Channels before goroutines", "suggestion": "Swap them"},
+			{"kind": "style", "phase": 1, "problem": "Too many videos"},
+			{"kind": "ordering", "phase": 1, "problem": "  "}
+		]
+	}`
+
+	resp, err := ParsePathAudit(input, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Summary != "Solid, but thin on testing." {
+		t.Errorf("unexpected summary %q", resp.Summary)
+	}
+	if len(resp.Findings) != 3 {
+		t.Fatalf("expected 3 findings, got %d", len(resp.Findings))
+	}
+	if got := resp.Findings[0]; got.Kind != ai.AuditMissingTopic || got.Phase != 2 {
+		t.Errorf("expected a missing topic in phase 2, got %+v", got)
+	}
+	if got := resp.Findings[1]; got.Phase != 0 || got.ResourceID != "resource-001" {
+		t.Errorf("expected an unknown phase cleared and the resource kept, got %+v", got)
+	}
+	if got := resp.Findings[2]; got.ResourceID != "" {
+		t.Errorf("expected an unknown resource cleared, got %+v", got)
+	}
+
+	if _, err := ParsePathAudit("not json", req); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestRenderPathAuditPrompt(t *testing.T) {
+	req := ai.PathAuditRequest{
+		Path: &core.LearningPath{Title: "Go backend"},
+		Goal: &core.Goal{Title: "Become a backend engineer"},
+		Phases: []ai.AuditPhase{
+			{
+				Phase:      &core.Phase{Title: "Basics", EstimatedDuration: "2 weeks"},
+				Number:     1,
+				Resources:  []*core.Resource{{ID: "resource-001", Title: "Tour of Go", Type: core.ResourceCourse, EstimatedHours: 6, Status: core.ResourceNotStarted}},
+				Milestones: []*core.Milestone{{Title: "Write a CLI"}},
+			},
+			{Phase: &core.Phase{Title: "Concurrency"}, Number: 2},
+		},
+		Language: "German",
+	}
+
+	prompt, err := RenderPrompt(PathAuditPrompt, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"GOAL: Become a backend engineer",
+		"LEARNING PATH: Go backend",
+		"PHASE 1: Basics (2 weeks)",
+		"- [resource-001] Tour of Go (course, 6 hours, not-started)",
+		"- Write a CLI",
+		"PHASE 2: Concurrency\nResources:\n- none",
+		"Write the summary, problems and suggestions in German",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected prompt to contain %q", want)
+		}
+	}
+	if strings.Contains(prompt, "SKILL:") {
+		t.Error("expected no skill for a goal's path")
+	}
+}
+
+func TestRenderPostPrompt(t *testing.T) {
+	start := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	req := ai.PostDraftRequest{
+		Platform:  "linkedin",
+		MaxLength: 3000,
+		Digest: core.WeeklyDigest{
+			WeekStart: start,
+			WeekEnd:   start.AddDate(0, 0, 7),
+			Hours:     6.5,
+		},
+		ProgressLogs: []*core.ProgressLog{
+			{Date: start, HoursInvested: 2, Body: "Built a worker pool"},
+		},
+	}
+
+	prompt, err := (&Client{}).renderPostPrompt(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"Jun 9 - Jun 15, 2025", "Hours invested: 6.5", "Built a worker pool", "under 3000 characters"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected prompt to contain %q", want)
+		}
+	}
+}
+
+func TestRenderResourcePrompt(t *testing.T) {
+	req := ai.ResourceSuggestionRequest{
+		Skill:         &core.Skill{Title: "Kubernetes", Category: "devops"},
+		CurrentLevel:  core.LevelBeginner,
+		TargetLevel:   core.LevelIntermediate,
+		LearningStyle: "project-based",
+		Budget:        "any",
+	}
+
+	prompt, err := (&Client{}).renderResourcePrompt(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, unwanted := range []string{"SUBSCRIPTIONS", "REMAINING BUDGET"} {
+		if strings.Contains(prompt, unwanted) {
+			t.Errorf("expected prompt without %q", unwanted)
+		}
+	}
+
+	req.Subscriptions = []string{"oreilly", "pluralsight"}
+	req.BudgetLimit = 500
+	req.RemainingBudget = 180
+
+	prompt, err = (&Client{}).renderResourcePrompt(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"SUBSCRIPTIONS: oreilly, pluralsight", "REMAINING BUDGET: 180 of 500", "Prefer resources available through the subscriptions"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected prompt to contain %q", want)
+		}
+	}
+}
+
+func TestRenderProgressAnalysisPrompt(t *testing.T) {
+	req := ai.ProgressAnalysisRequest{
+		Goal: &core.Goal{Title: "Become a platform engineer"},
+		Path: &core.LearningPath{Title: "Platform engineering"},
+		CurrentSkills: []*core.Skill{
+			{Title: "Kubernetes", Level: core.LevelAdvanced, Status: core.SkillLearning, Confidence: 2},
+			{Title: "Go", Level: core.LevelIntermediate, Status: core.SkillLearning},
+		},
+	}
+
+	prompt, err := RenderPrompt(ProgressAnalysisPrompt, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"- Kubernetes (advanced, Status: learning, Confidence: 2/5) - NEEDS REINFORCEMENT",
+		"- Go (intermediate, Status: learning)\n",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected prompt to contain %q", want)
+		}
+	}
+
+	// Overall progress has neither a goal nor a path
+	prompt, err = RenderPrompt(ProgressAnalysisPrompt, ai.ProgressAnalysisRequest{CurrentSkills: req.CurrentSkills})
+	if err != nil {
+		t.Fatalf("unexpected error without a goal: %v", err)
+	}
+	if !strings.Contains(prompt, "SCOPE: Overall progress") || strings.Contains(prompt, "LEARNING PATH:") {
+		t.Errorf("expected an overall scope without a path, got:\n%s", prompt)
+	}
+}
+
+func TestRenderFeedbackInPrompts(t *testing.T) {
+	feedback := &core.Feedback{
+		From:         "Alice",
+		Relationship: core.FeedbackPeer,
+		Date:         time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Rating:       4,
+		Strengths:    []string{"Thorough code reviews"},
+		Improvements: []string{"Share designs earlier"},
+		Body:         "Great to work with.",
+	}
+	entries := []ai.FeedbackEntry{{Feedback: feedback, SkillTitles: []string{"Kubernetes", "Go"}}}
+
+	want := []string{
+		"FEEDBACK FROM PEERS AND MANAGERS:",
+		"- 2025-06-30, Alice (peer) on Kubernetes, Go, rating 4/5\n  Strength: Thorough code reviews\n  To improve: Share designs earlier\n  Comment: Great to work with.\n",
+	}
+	for name, render := range map[string]func() (string, error){
+		"path generation": func() (string, error) {
+			return RenderPrompt(PathGenerationPrompt, ai.PathGenerationRequest{Goal: &core.Goal{Title: "Platform engineer"}, Feedback: entries})
+		},
+		"path plan": func() (string, error) {
+			return RenderPrompt(PathPlanPrompt, ai.PathGenerationRequest{Goal: &core.Goal{Title: "Platform engineer"}, Feedback: entries})
+		},
+		"progress analysis": func() (string, error) {
+			return RenderPrompt(ProgressAnalysisPrompt, ai.ProgressAnalysisRequest{Goal: &core.Goal{}, Path: &core.LearningPath{}, Feedback: entries})
+		},
+	} {
+		prompt, err := render()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for _, w := range want {
+			if !strings.Contains(prompt, w) {
+				t.Errorf("%s: expected prompt to contain %q", name, w)
+			}
+		}
+	}
+
+	prompt, err := RenderPrompt(PathGenerationPrompt, ai.PathGenerationRequest{Goal: &core.Goal{Title: "Platform engineer"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(prompt, "FEEDBACK FROM") {
+		t.Error("expected no feedback section without feedback")
+	}
+}
+
+func TestRenderSkillPlanPrompts(t *testing.T) {
+	req := ai.PathGenerationRequest{
+		Skill:       &core.Skill{Title: "Kubernetes", Category: "devops", Level: core.LevelBeginner},
+		TargetLevel: core.LevelAdvanced,
+	}
+
+	for name, render := range map[string]func() (string, error){
+		"path generation": func() (string, error) { return RenderPrompt(PathGenerationPrompt, req) },
+		"path plan":       func() (string, error) { return RenderPrompt(PathPlanPrompt, req) },
+	} {
+		prompt, err := render()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for _, want := range []string{"SKILL: Kubernetes - devops\nCURRENT LEVEL: beginner\nTARGET LEVEL: advanced", "Keep every phase about Kubernetes"} {
+			if !strings.Contains(prompt, want) {
+				t.Errorf("%s: expected prompt to contain %q", name, want)
+			}
+		}
+		if strings.Contains(prompt, "GOAL:") {
+			t.Errorf("%s: expected no goal in a skill plan", name)
+		}
+	}
+
+	prompt, err := RenderPrompt(PhaseDetailPrompt, PhaseDetailRequest{PathGenerationRequest: req, PathTitle: "Kubernetes basics", Outline: []string{"1. Pods (2 weeks)"}, Number: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(prompt, "SKILL: Kubernetes (beginner to advanced)") {
+		t.Error("expected the phase detail prompt to name the skill")
+	}
+	if got, want := req.Scope(), "Skill: Kubernetes (beginner to advanced)"; got != want {
+		t.Errorf("scope = %q, want %q", got, want)
+	}
+}
+
+func TestRenderPrompt(t *testing.T) {
+	req := ai.PathGenerationRequest{
+		Goal:           &core.Goal{Title: "Become a platform engineer"},
+		LearningStyle:  "top-down",
+		TimeCommitment: "10 hours/week",
+	}
+
+	prompt, err := RenderPrompt(PathGenerationPrompt, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"Become a platform engineer", "10 hours/week"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("expected prompt to contain %q", want)
+		}
+	}
+	if strings.Contains(prompt, "Write all titles") {
+		t.Error("expected no language instruction without a language")
+	}
+
+	req.Language = "Ukrainian"
+	prompt, err = RenderPrompt(PathGenerationPrompt, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(prompt, "reasoning in Ukrainian") {
+		t.Error("expected prompt to ask for Ukrainian")
+	}
+
+	if _, err := RenderPrompt("{{.Missing", req); err == nil {
+		t.Error("expected error for an invalid template")
+	}
+}
+
 func TestMockClient(t *testing.T) {
 	mockClient := &ai.MockClient{
 		ProviderName: "test-mock",
@@ -3,6 +3,7 @@ package gemini
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/core"
@@ -80,12 +81,24 @@ func createResource(resourceOut ResourceOutput, resourceID, skillID core.EntityI
 		Author:         resourceOut.Author,
 		URL:            resourceOut.URL,
 		EstimatedHours: resourceOut.EstimatedHours,
+		Pricing:        parsePricing(resourceOut.Cost),
+		WhyRecommended: strings.TrimSpace(resourceOut.WhyRecommended),
 		Status:         core.ResourceNotStarted,
 		Tags:           []string{},
 		Timestamps:     core.NewTimestamps(),
 	}
 }
 
+// parsePricing keeps the free or paid cost of a suggested resource, dropping
+// anything else the model made up
+func parsePricing(cost string) string {
+	switch cost = strings.ToLower(strings.TrimSpace(cost)); cost {
+	case "free", "paid":
+		return cost
+	}
+	return ""
+}
+
 func createMilestone(milestoneOut MilestoneOutput, milestoneID, pathID core.EntityID) *core.Milestone {
 	milestoneType := core.MilestoneType(milestoneOut.Type)
 	if !milestoneType.IsValid() {
@@ -122,16 +135,21 @@ func ParsePathGeneration(responseText string, pathID, goalID core.EntityID) (*ai
 		}
 	}
 
+	return buildPathGeneration(output, pathID), nil
+}
+
+// buildPathGeneration turns a generated path into entities with placeholder
+// IDs, numbered from 1 in the order they appear
+func buildPathGeneration(output PathGenerationOutput, pathID core.EntityID) *ai.PathGenerationResponse {
 	path := &core.LearningPath{
-		ID:          pathID,
-		Title:       output.Path.Title,
-		Body:        output.Path.Description,
-		Type:        core.PathTypeAIGenerated,
-		Status:      core.StatusActive,
-		GeneratedBy: "gemini-3-flash-preview",
-		Phases:      []core.EntityID{},
-		Tags:        []string{},
-		Timestamps:  core.NewTimestamps(),
+		ID:         pathID,
+		Title:      output.Path.Title,
+		Body:       output.Path.Description,
+		Type:       core.PathTypeAIGenerated,
+		Status:     core.StatusActive,
+		Phases:     []core.EntityID{},
+		Tags:       []string{},
+		Timestamps: core.NewTimestamps(),
 	}
 
 	phases := make([]*core.Phase, 0, len(output.Phases))
@@ -149,6 +167,7 @@ func ParsePathGeneration(responseText string, pathID, goalID core.EntityID) (*ai
 			Order:             i + 1,
 			RequiredSkills:    []core.SkillRequirement{},
 			Milestones:        []core.EntityID{},
+			Resources:         []core.EntityID{},
 			EstimatedDuration: fmt.Sprintf("%d weeks", phaseOut.DurationWeeks),
 			Timestamps:        core.NewTimestamps(),
 		}
@@ -174,6 +193,7 @@ func ParsePathGeneration(responseText string, pathID, goalID core.EntityID) (*ai
 			resourceID := core.EntityID(fmt.Sprintf("resource-%03d", len(resources)+k+1))
 			resource := createResource(resourceOut, resourceID, "")
 			resources = append(resources, resource)
+			phase.Resources = append(phase.Resources, resourceID)
 		}
 
 		path.Phases = append(path.Phases, phaseID)
@@ -186,7 +206,7 @@ func ParsePathGeneration(responseText string, pathID, goalID core.EntityID) (*ai
 		Resources:  resources,
 		Milestones: milestones,
 		Reasoning:  output.Reasoning,
-	}, nil
+	}
 }
 
 func ParseResourceSuggestion(responseText string, skillID core.EntityID) (*ai.ResourceSuggestionResponse, error) {
@@ -233,3 +253,241 @@ func ParseProgressAnalysis(responseText string) (*ai.ProgressAnalysisResponse, e
 		SuggestedFocus:  output.SuggestedFocus,
 	}, nil
 }
+
+type ResourceClassificationOutput struct {
+	Classifications []ClassificationOutput `json:"classifications"`
+}
+
+type ClassificationOutput struct {
+	Index   int    `json:"index"`
+	SkillID string `json:"skill_id"`
+	Type    string `json:"type"`
+}
+
+// ParseResourceClassification parses a classification response, dropping entries
+// with out-of-range indexes and clearing skill IDs that were not offered.
+func ParseResourceClassification(responseText string, req ai.ResourceClassificationRequest) (*ai.ResourceClassificationResponse, error) {
+	var output ResourceClassificationOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse resource classification response",
+			Err:      err,
+		}
+	}
+
+	knownSkills := make(map[core.EntityID]bool, len(req.Skills))
+	for _, skill := range req.Skills {
+		knownSkills[skill.ID] = true
+	}
+
+	classifications := make([]ai.ResourceClassification, 0, len(output.Classifications))
+	for _, c := range output.Classifications {
+		if c.Index < 0 || c.Index >= len(req.Candidates) {
+			continue
+		}
+
+		skillID := core.EntityID(c.SkillID)
+		if !knownSkills[skillID] {
+			skillID = ""
+		}
+
+		resourceType := core.ResourceType(c.Type)
+		if !resourceType.IsValid() {
+			resourceType = core.ResourceArticle
+		}
+
+		classifications = append(classifications, ai.ResourceClassification{
+			Index:   c.Index,
+			SkillID: skillID,
+			Type:    resourceType,
+		})
+	}
+
+	return &ai.ResourceClassificationResponse{
+		Classifications: classifications,
+	}, nil
+}
+
+type ProgressExtractionOutput struct {
+	HoursInvested float64  `json:"hours_invested"`
+	SkillIDs      []string `json:"skill_ids"`
+	ResourceIDs   []string `json:"resource_ids"`
+	Mood          string   `json:"mood"`
+	Summary       string   `json:"summary"`
+}
+
+// ParseProgressExtraction parses a transcript extraction response, dropping
+// skill and resource IDs that were not offered.
+func ParseProgressExtraction(responseText string, req ai.ProgressExtractionRequest) (*ai.ProgressExtractionResponse, error) {
+	var output ProgressExtractionOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse progress extraction response",
+			Err:      err,
+		}
+	}
+
+	knownSkills := make(map[core.EntityID]bool, len(req.Skills))
+	for _, skill := range req.Skills {
+		knownSkills[skill.ID] = true
+	}
+
+	knownResources := make(map[core.EntityID]bool, len(req.Resources))
+	for _, resource := range req.Resources {
+		knownResources[resource.ID] = true
+	}
+
+	resp := &ai.ProgressExtractionResponse{
+		HoursInvested: output.HoursInvested,
+		SkillIDs:      []core.EntityID{},
+		ResourceIDs:   []core.EntityID{},
+		Mood:          output.Mood,
+		Summary:       output.Summary,
+	}
+
+	if resp.HoursInvested < 0 {
+		resp.HoursInvested = 0
+	}
+
+	for _, id := range output.SkillIDs {
+		if knownSkills[core.EntityID(id)] {
+			resp.SkillIDs = append(resp.SkillIDs, core.EntityID(id))
+		}
+	}
+
+	for _, id := range output.ResourceIDs {
+		if knownResources[core.EntityID(id)] {
+			resp.ResourceIDs = append(resp.ResourceIDs, core.EntityID(id))
+		}
+	}
+
+	return resp, nil
+}
+
+type PostDraftOutput struct {
+	Text     string   `json:"text"`
+	Hashtags []string `json:"hashtags"`
+}
+
+// ParsePostDraft parses a social post draft, normalizing hashtags to bare
+// words without the leading #.
+func ParsePostDraft(responseText string) (*ai.PostDraftResponse, error) {
+	var output PostDraftOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse post draft response",
+			Err:      err,
+		}
+	}
+
+	text := strings.TrimSpace(output.Text)
+	if text == "" {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "post draft is empty",
+		}
+	}
+
+	resp := &ai.PostDraftResponse{
+		Text:     text,
+		Hashtags: []string{},
+	}
+	for _, tag := range output.Hashtags {
+		tag = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag != "" && !strings.ContainsAny(tag, " \t") {
+			resp.Hashtags = append(resp.Hashtags, tag)
+		}
+	}
+
+	return resp, nil
+}
+
+// ExtractJSON returns the JSON object in a response copied from a chat UI,
+// dropping a surrounding markdown code fence and any text around the object
+func ExtractJSON(text string) string {
+	text = strings.TrimSpace(text)
+
+	if start := strings.Index(text, "```"); start >= 0 {
+		fenced := text[start+3:]
+		if newline := strings.IndexByte(fenced, '\n'); newline >= 0 {
+			fenced = fenced[newline+1:]
+		}
+		if end := strings.Index(fenced, "```"); end >= 0 {
+			text = strings.TrimSpace(fenced[:end])
+		}
+	}
+
+	start := strings.IndexByte(text, '{')
+	end := strings.LastIndexByte(text, '}')
+	if start < 0 || end < start {
+		return text
+	}
+	return text[start : end+1]
+}
+
+type PathAuditOutput struct {
+	Summary  string                `json:"summary"`
+	Findings []PathAuditFindingOut `json:"findings"`
+}
+
+type PathAuditFindingOut struct {
+	Kind       string `json:"kind"`
+	Phase      int    `json:"phase"`
+	ResourceID string `json:"resource_id"`
+	Problem    string `json:"problem"`
+	Suggestion string `json:"suggestion"`
+}
+
+// ParsePathAudit parses a path audit. Findings of unknown kinds or without a
+// problem are dropped, and phases and resources not in the request cleared.
+func ParsePathAudit(responseText string, req ai.PathAuditRequest) (*ai.PathAuditResponse, error) {
+	var output PathAuditOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse path audit response",
+			Err:      err,
+		}
+	}
+
+	knownResources := make(map[core.EntityID]bool)
+	for _, phase := range req.Phases {
+		for _, resource := range phase.Resources {
+			knownResources[resource.ID] = true
+		}
+	}
+
+	resp := &ai.PathAuditResponse{
+		Summary:  strings.TrimSpace(output.Summary),
+		Findings: []ai.PathAuditFinding{},
+	}
+	for _, out := range output.Findings {
+		kind := strings.ToLower(strings.TrimSpace(out.Kind))
+		problem := strings.TrimSpace(out.Problem)
+		if problem == "" || (kind != ai.AuditMissingTopic && kind != ai.AuditRedundantResource && kind != ai.AuditOrdering) {
+			continue
+		}
+
+		finding := ai.PathAuditFinding{
+			Kind:       kind,
+			Problem:    problem,
+			Suggestion: strings.TrimSpace(out.Suggestion),
+		}
+		if out.Phase >= 1 && out.Phase <= len(req.Phases) {
+			finding.Phase = out.Phase
+		}
+		if id := core.EntityID(strings.Trim(strings.TrimSpace(out.ResourceID), "[]")); knownResources[id] {
+			finding.ResourceID = id
+		}
+		resp.Findings = append(resp.Findings, finding)
+	}
+
+	return resp, nil
+}
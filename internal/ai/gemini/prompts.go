@@ -2,16 +2,28 @@ package gemini
 
 const PathGenerationPrompt = `You are an expert career coach for software engineers. Generate a personalized learning path.
 
-GOAL: {{.Goal.Title}}
+{{if .Skill}}SKILL: {{.Skill.Title}} - {{.Skill.Category}}
+{{if .Skill.Body}}SKILL DESCRIPTION: {{.Skill.Body}}
+{{end}}CURRENT LEVEL: {{.Skill.Level}}
+TARGET LEVEL: {{.TargetLevel}}
+{{else}}GOAL: {{.Goal.Title}}
 GOAL DESCRIPTION: {{.Goal.Body}}
 PRIORITY: {{.Goal.Priority}}
 {{if .Goal.TargetDate}}TARGET DATE: {{.Goal.TargetDate.Format "2006-01-02"}}{{end}}
-
+{{end}}
 CURRENT SKILLS:
 {{range .CurrentSkills}}
 - {{.Title}} ({{.Level}}) - {{.Category}}
 {{end}}
-
+{{if .Feedback}}
+FEEDBACK FROM PEERS AND MANAGERS:
+{{range .Feedback}}
+- {{.Date.Format "2006-01-02"}}, {{.From}} ({{.Relationship}}){{if .SkillTitles}} on {{range $i, $s := .SkillTitles}}{{if $i}}, {{end}}{{$s}}{{end}}{{end}}{{if .Rating}}, rating {{.Rating}}/5{{end}}
+{{range .Strengths}}  Strength: {{.}}
+{{end}}{{range .Improvements}}  To improve: {{.}}
+{{end}}{{if .Body}}  Comment: {{.Body}}
+{{end}}{{end}}
+{{end}}
 BACKGROUND:
 {{.Background}}
 
@@ -74,10 +86,154 @@ OUTPUT FORMAT (JSON):
 IMPORTANT:
 - Make the path practical and achievable
 - Consider the user's current skill level
+{{- if .Skill}}
+- Keep every phase about {{.Skill.Title}}: lead from {{.Skill.Level}} to {{.TargetLevel}} without drifting into other skills
+{{- end}}
+- Where feedback names things to improve, address them in the phases; build on the strengths it names
 - Prioritize hands-on projects and real-world application
 - Include both foundational and advanced resources
 - Suggest free resources when possible
 - Provide clear milestones for tracking progress
+{{- if .Language}}
+- Write all titles, descriptions and reasoning in {{.Language}}; keep JSON field names and enum values (such as "book" or "beginner") in English
+{{- end}}
+- Ensure all JSON fields use exact names as specified above
+`
+
+// PathPlanPrompt asks for the outline of a learning path: the path and its
+// phases, without milestones or resources. PhaseDetailPrompt fills those in
+// per phase.
+const PathPlanPrompt = `You are an expert career coach for software engineers. Plan a personalized learning path.
+
+{{if .Skill}}SKILL: {{.Skill.Title}} - {{.Skill.Category}}
+{{if .Skill.Body}}SKILL DESCRIPTION: {{.Skill.Body}}
+{{end}}CURRENT LEVEL: {{.Skill.Level}}
+TARGET LEVEL: {{.TargetLevel}}
+{{else}}GOAL: {{.Goal.Title}}
+GOAL DESCRIPTION: {{.Goal.Body}}
+PRIORITY: {{.Goal.Priority}}
+{{if .Goal.TargetDate}}TARGET DATE: {{.Goal.TargetDate.Format "2006-01-02"}}{{end}}
+{{end}}
+CURRENT SKILLS:
+{{range .CurrentSkills}}
+- {{.Title}} ({{.Level}}) - {{.Category}}
+{{end}}
+{{if .Feedback}}
+FEEDBACK FROM PEERS AND MANAGERS:
+{{range .Feedback}}
+- {{.Date.Format "2006-01-02"}}, {{.From}} ({{.Relationship}}){{if .SkillTitles}} on {{range $i, $s := .SkillTitles}}{{if $i}}, {{end}}{{$s}}{{end}}{{end}}{{if .Rating}}, rating {{.Rating}}/5{{end}}
+{{range .Strengths}}  Strength: {{.}}
+{{end}}{{range .Improvements}}  To improve: {{.}}
+{{end}}{{if .Body}}  Comment: {{.Body}}
+{{end}}{{end}}
+{{end}}
+BACKGROUND:
+{{.Background}}
+
+LEARNING PREFERENCES:
+- Learning Style: {{.LearningStyle}}
+- Time Commitment: {{.TimeCommitment}}
+
+TASK:
+Outline a structured learning path with:
+1. Path Overview (title, description, estimated duration in weeks)
+2. Phases (3-8 phases, ordered by learning progression), each with a title,
+   a description of what it covers, a duration estimate (in weeks) and skill
+   requirements (prerequisite proficiency levels)
+
+Milestones and resources are chosen per phase later, so leave them out.
+
+OUTPUT FORMAT (JSON):
+{
+  "path": {
+    "title": "string",
+    "description": "string",
+    "estimated_duration_weeks": 12
+  },
+  "phases": [
+    {
+      "title": "string",
+      "description": "string",
+      "duration_weeks": 3,
+      "skill_requirements": [
+        {
+          "skill_title": "string",
+          "category": "string",
+          "required_level": "beginner|intermediate|advanced|expert"
+        }
+      ]
+    }
+  ],
+  "reasoning": "string - explain the learning path design rationale"
+}
+
+IMPORTANT:
+- Make the path practical and achievable
+- Consider the user's current skill level
+{{- if .Skill}}
+- Keep every phase about {{.Skill.Title}}: lead from {{.Skill.Level}} to {{.TargetLevel}} without drifting into other skills
+{{- end}}
+- Where feedback names things to improve, address them in the phases; build on the strengths it names
+- Give each phase a distinct scope, so phases do not repeat each other
+{{- if .Language}}
+- Write all titles, descriptions and reasoning in {{.Language}}; keep JSON field names and enum values (such as "book" or "beginner") in English
+{{- end}}
+- Ensure all JSON fields use exact names as specified above
+`
+
+// PhaseDetailPrompt asks for the milestones and resources of one phase of a
+// path planned with PathPlanPrompt
+const PhaseDetailPrompt = `You are an expert career coach for software engineers. Choose milestones and resources for one phase of a learning path.
+
+{{if .Skill}}SKILL: {{.Skill.Title}} ({{.Skill.Level}} to {{.TargetLevel}}){{else}}GOAL: {{.Goal.Title}}{{end}}
+LEARNING PATH: {{.PathTitle}}
+LEARNING PREFERENCES:
+- Learning Style: {{.LearningStyle}}
+- Time Commitment: {{.TimeCommitment}}
+
+ALL PHASES:
+{{range .Outline}}
+- {{.}}
+{{- end}}
+
+THIS PHASE ({{.Number}} of {{len .Outline}}): {{.Phase.Title}}
+DESCRIPTION: {{.Phase.Description}}
+DURATION: {{.Phase.DurationWeeks}} weeks
+
+TASK:
+List the milestones (concrete achievements) and recommended resources
+(books, courses, projects) for this phase only. Leave topics of the other
+phases to them.
+
+OUTPUT FORMAT (JSON):
+{
+  "milestones": [
+    {
+      "title": "string",
+      "description": "string",
+      "type": "goal-level|path-level|skill-level"
+    }
+  ],
+  "resources": [
+    {
+      "title": "string",
+      "type": "book|course|video|article|project|documentation",
+      "author": "string",
+      "url": "string",
+      "estimated_hours": 10,
+      "description": "string"
+    }
+  ]
+}
+
+IMPORTANT:
+- Fit the resources into the phase's duration at the time commitment above
+- Prioritize hands-on projects and real-world application
+- Suggest free resources when possible
+- Provide clear milestones for tracking progress
+{{- if .Language}}
+- Write all titles and descriptions in {{.Language}}; keep JSON field names and enum values (such as "book" or "beginner") in English
+{{- end}}
 - Ensure all JSON fields use exact names as specified above
 `
 
@@ -89,6 +245,12 @@ CURRENT LEVEL: {{.CurrentLevel}}
 TARGET LEVEL: {{.TargetLevel}}
 LEARNING STYLE: {{.LearningStyle}}
 BUDGET: {{.Budget}}
+{{- if .Subscriptions}}
+SUBSCRIPTIONS: {{range $i, $s := .Subscriptions}}{{if $i}}, {{end}}{{$s}}{{end}}
+{{- end}}
+{{- if .BudgetLimit}}
+REMAINING BUDGET: {{printf "%.0f" .RemainingBudget}} of {{printf "%.0f" .BudgetLimit}} for this year
+{{- end}}
 
 TASK:
 Recommend 5-10 high-quality learning resources to progress from {{.CurrentLevel}} to {{.TargetLevel}}.
@@ -112,18 +274,27 @@ OUTPUT FORMAT (JSON):
 
 GUIDELINES:
 - Prioritize {{.Budget}} resources
+{{- if .Subscriptions}}
+- Prefer resources available through the subscriptions above; they cost nothing extra, so mark them "free" and name the platform in why_recommended
+{{- end}}
+{{- if .BudgetLimit}}
+- Keep the total price of other paid resources within the remaining budget; suggest free alternatives once it is spent
+{{- end}}
 - Match {{.LearningStyle}} (e.g., top-down = projects first, bottom-up = theory first)
 - Include diverse formats (books, courses, projects)
 - Prefer well-reviewed, current resources (2023+)
 - Start with foundational resources, progress to advanced
+{{- if .Language}}
+- Write all titles, descriptions and reasoning in {{.Language}}; keep JSON field names and enum values (such as "book" or "beginner") in English
+{{- end}}
 - Ensure all JSON fields use exact names as specified above
 `
 
 const ProgressAnalysisPrompt = `You are an expert career coach analyzing learning progress.
 
-GOAL: {{.Goal.Title}}
-LEARNING PATH: {{.Path.Title}}
-
+{{if .Goal}}GOAL: {{.Goal.Title}}{{else}}SCOPE: Overall progress across all goals{{end}}
+{{if .Path}}LEARNING PATH: {{.Path.Title}}
+{{end}}
 PROGRESS LOGS (Last 30 days):
 {{range .ProgressLogs}}
 - {{.Date.Format "2006-01-02"}}: {{.HoursInvested}} hours{{if .Mood}}, Mood: {{.Mood}}{{end}}
@@ -132,9 +303,22 @@ PROGRESS LOGS (Last 30 days):
 
 CURRENT SKILLS:
 {{range .CurrentSkills}}
-- {{.Title}} ({{.Level}}, Status: {{.Status}})
+- {{.Title}} ({{.Level}}, Status: {{.Status}}{{if .Confidence}}, Confidence: {{.Confidence}}/5{{end}}){{if .NeedsReinforcement}} - NEEDS REINFORCEMENT{{end}}
+{{end}}
+{{if .Feedback}}
+FEEDBACK FROM PEERS AND MANAGERS:
+{{range .Feedback}}
+- {{.Date.Format "2006-01-02"}}, {{.From}} ({{.Relationship}}){{if .SkillTitles}} on {{range $i, $s := .SkillTitles}}{{if $i}}, {{end}}{{$s}}{{end}}{{end}}{{if .Rating}}, rating {{.Rating}}/5{{end}}
+{{range .Strengths}}  Strength: {{.}}
+{{end}}{{range .Improvements}}  To improve: {{.}}
+{{end}}{{if .Body}}  Comment: {{.Body}}
+{{end}}{{end}}
+{{end}}{{if .Notes}}
+NOTES:
+{{range .Notes}}
+- {{.Date.Format "2006-01-02"}}: {{.Body}}
+{{end}}
 {{end}}
-
 TASK:
 Analyze the user's progress and provide actionable insights.
 
@@ -157,7 +341,178 @@ ANALYSIS GUIDELINES:
 - Look for consistency patterns (regular vs sporadic)
 - Identify skills with momentum vs stagnation
 - Consider mood trends and energy levels
+- Confidence is self-reported (1 shaky to 5 sure) and separate from level; flag each skill marked NEEDS REINFORCEMENT (advanced or expert with low confidence) in the insights and recommend how to reinforce it
+- Compare the feedback from peers and managers with the self-assessed levels; point out gaps between how the user rates a skill and how others see it
 - Provide encouraging but honest assessment
 - Suggest specific next actions, not generic advice
+{{- if .Language}}
+- Write all titles, descriptions and reasoning in {{.Language}}; keep JSON field names and enum values (such as "book" or "beginner") in English
+{{- end}}
+- Ensure all JSON fields use exact names as specified above
+`
+
+const ResourceClassificationPrompt = `You are an expert learning advisor organizing a backlog of saved links.
+
+SKILLS:
+{{range .Skills}}
+- {{.ID}}: {{.Title}} ({{.Category}})
+{{end}}
+
+LINKS:
+{{range $i, $c := .Candidates}}
+{{$i}}. {{$c.Title}} - {{$c.URL}}{{if $c.Tags}} [tags: {{range $j, $t := $c.Tags}}{{if $j}}, {{end}}{{$t}}{{end}}]{{end}}
+{{end}}
+
+TASK:
+For each link, choose the single skill it helps with most and the resource type.
+
+OUTPUT FORMAT (JSON):
+{
+  "classifications": [
+    {
+      "index": 0,
+      "skill_id": "string - one of the skill IDs above, or empty if none fit",
+      "type": "book|course|video|article|project|documentation"
+    }
+  ]
+}
+
+CLASSIFICATION GUIDELINES:
+- Only use skill IDs from the list above
+- Leave skill_id empty rather than guessing when a link is unrelated to every skill
+- Include every link index exactly once
+`
+
+const ProgressExtractionPrompt = `You are a learning journal assistant turning a spoken progress update into a structured log entry.
+
+SKILLS:
+{{range .Skills}}
+- {{.ID}}: {{.Title}} ({{.Category}})
+{{end}}
+
+RESOURCES:
+{{range .Resources}}
+- {{.ID}}: {{.Title}} ({{.Type}}, {{.Status}})
+{{end}}
+
+TRANSCRIPT:
+{{.Transcript}}
+
+TASK:
+Extract the time spent learning, the skills and resources worked on, the mood, and a short summary.
+
+OUTPUT FORMAT (JSON):
+{
+  "hours_invested": 0.0,
+  "skill_ids": ["string - skill IDs from the list above"],
+  "resource_ids": ["string - resource IDs from the list above"],
+  "mood": "string - one word such as motivated, focused, tired or frustrated, or empty if not mentioned",
+  "summary": "string - 2-4 sentences in the first person, in markdown"
+}
+
+EXTRACTION GUIDELINES:
+- Only use skill and resource IDs from the lists above
+- Convert minutes to fractional hours, and use 0 when no time is mentioned
+- Ignore filler words, repetitions and off-topic remarks from the transcript
+- Keep the summary faithful to the transcript; do not invent accomplishments
+`
+
+const PostDraftPrompt = `You are helping a developer who learns in public write a weekly update for {{.Platform}}.
+
+THIS WEEK ({{.Digest.WeekStart.Format "Jan 2"}} - {{(.Digest.WeekEnd.AddDate 0 0 -1).Format "Jan 2, 2006"}}):
+- Hours invested: {{printf "%.1f" .Digest.Hours}}
+- Weekly streak: {{.Digest.Streak}}
+
+COMPLETED RESOURCES:
+{{range .Digest.CompletedResources}}
+- {{.Title}} ({{.Type}})
+{{end}}
+
+ACHIEVED MILESTONES:
+{{range .Digest.AchievedMilestones}}
+- {{.Title}}{{if .Proof}} ({{.Proof}}){{end}}
+{{end}}
+
+SKILLS WORKED ON:
+{{range .Skills}}
+- {{.Title}} ({{.Level}})
+{{end}}
+
+PROGRESS LOGS:
+{{range .ProgressLogs}}
+- {{.Date.Format "2006-01-02"}} ({{.HoursInvested}}h): {{.Body}}
+{{end}}
+
+TASK:
+Draft a post sharing what was learned and achieved this week.
+
+OUTPUT FORMAT (JSON):
+{
+  "text": "string - the post, without hashtags",
+  "hashtags": ["string - up to 4 hashtags without the # sign"]
+}
+
+WRITING GUIDELINES:
+- Write in the first person, in a genuine and humble tone
+- Keep the text and hashtags together under {{.MaxLength}} characters
+- Lead with the most concrete achievement, then one insight learned
+- Only mention things listed above; do not invent accomplishments
+- Do not use markdown formatting
+`
+
+// PathAuditPrompt asks for a review of an existing, possibly hand-built,
+// learning path against its goal or skill
+const PathAuditPrompt = `You are an expert career coach for software engineers. Audit an existing learning path as a curriculum.
+
+{{if .Goal}}GOAL: {{.Goal.Title}}
+{{if .Goal.Body}}GOAL DESCRIPTION: {{.Goal.Body}}
+{{end}}{{end}}{{if .Skill}}SKILL: {{.Skill.Title}} ({{.Skill.Level}})
+{{end}}LEARNING PATH: {{.Path.Title}}
+{{if .Path.Body}}PATH DESCRIPTION: {{.Path.Body}}
+{{end}}
+CURRENT SKILLS:
+{{range .CurrentSkills}}
+- {{.Title}} ({{.Level}}) - {{.Category}}
+{{end}}
+
+PHASES IN ORDER:
+{{range .Phases}}
+PHASE {{.Number}}: {{.Title}}{{if .EstimatedDuration}} ({{.EstimatedDuration}}){{end}}
+{{if .Body}}{{.Body}}
+{{end}}Resources:
+{{range .Resources}}- [{{.ID}}] {{.Title}} ({{.Type}}{{if .EstimatedHours}}, {{.EstimatedHours}} hours{{end}}, {{.Status}})
+{{else}}- none
+{{end}}Milestones:
+{{range .Milestones}}- {{.Title}}
+{{else}}- none
+{{end}}{{end}}
+TASK:
+Review whether this path gets the user to the {{if .Goal}}goal{{else if .Skill}}skill's next level{{else}}path's aim{{end}}, and report:
+1. missing-topic: topics it needs that no phase covers
+2. redundant-resource: resources that repeat another resource or do not fit their phase
+3. ordering: phases or resources that come before what they build on
+
+OUTPUT FORMAT (JSON):
+{
+  "summary": "string - 1-2 sentence overall assessment",
+  "findings": [
+    {
+      "kind": "missing-topic|redundant-resource|ordering",
+      "phase": 2,
+      "resource_id": "string - ID in brackets of the resource concerned, or empty",
+      "problem": "string - what is wrong",
+      "suggestion": "string - a concrete action to fix it"
+    }
+  ]
+}
+
+AUDIT GUIDELINES:
+- Only report real problems; an empty findings list is fine for a sound path
+- Refer to phases by number (0 for the whole path) and to resources by the ID in brackets
+- Make every suggestion actionable: what to add, remove or move, and where
+- Consider the user's current skills: topics they already know are not missing
+{{- if .Language}}
+- Write the summary, problems and suggestions in {{.Language}}; keep JSON field names and kinds in English
+{{- end}}
 - Ensure all JSON fields use exact names as specified above
 `
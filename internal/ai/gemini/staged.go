@@ -0,0 +1,246 @@
+package gemini
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// PhaseDetailRequest is the data PhaseDetailPrompt is rendered with: the
+// original request, the planned path, and the phase to detail
+type PhaseDetailRequest struct {
+	ai.PathGenerationRequest
+	PathTitle string
+	Outline   []string // every phase as "1. Title (3 weeks)"
+	Number    int      // position of Phase in the path, from 1
+	Phase     PhaseOutput
+}
+
+// PhaseDetailOutput is the response to PhaseDetailPrompt
+type PhaseDetailOutput struct {
+	Milestones []MilestoneOutput `json:"milestones"`
+	Resources  []ResourceOutput  `json:"resources"`
+}
+
+// GenerateFunc sends a prompt to a provider and returns the text of its
+// response
+type GenerateFunc func(ctx context.Context, prompt string) (string, error)
+
+// GeneratePath generates a learning path in a single request, or staged when
+// cfg.StagedGeneration is set, see GenerateStagedPath. It returns the path with
+// placeholder IDs and the first prompt sent.
+func GeneratePath(ctx context.Context, generate GenerateFunc, req ai.PathGenerationRequest, pathID core.EntityID, cfg ai.Config) (*ai.PathGenerationResponse, string, error) {
+	if cfg.StagedGeneration {
+		return GenerateStagedPath(ctx, generate, req, pathID, cfg.ParallelRequests)
+	}
+
+	prompt, err := RenderPrompt(PathGenerationPrompt, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	text, err := generate(ctx, prompt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var goalID core.EntityID
+	if req.Goal != nil {
+		goalID = req.Goal.ID
+	}
+	resp, err := ParsePathGeneration(text, pathID, goalID)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp, prompt, nil
+}
+
+// RenderPathPrompts renders the prompts path generation sends: the single
+// PathGenerationPrompt, or when staged the planning prompt followed by the
+// phase prompt sent once per planned phase. The phase prompt is filled in from
+// the plan's response, so its phase is shown as placeholders.
+func RenderPathPrompts(req ai.PathGenerationRequest, staged bool) (string, error) {
+	if !staged {
+		return RenderPrompt(PathGenerationPrompt, req)
+	}
+
+	plan, err := RenderPrompt(PathPlanPrompt, req)
+	if err != nil {
+		return "", err
+	}
+
+	phase, err := RenderPrompt(PhaseDetailPrompt, PhaseDetailRequest{
+		PathGenerationRequest: req,
+		PathTitle:             "<path title from the plan>",
+		Outline:               []string{"<phases from the plan>"},
+		Number:                1,
+		Phase: PhaseOutput{
+			Title:         "<phase title>",
+			Description:   "<phase description>",
+			DurationWeeks: 1,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return "=== Planning prompt ===\n\n" + strings.TrimRight(plan, "\n") +
+		"\n\n=== Phase prompt, sent once per planned phase ===\n\n" + phase, nil
+}
+
+// GenerateStagedPath generates a learning path in two stages: one request
+// plans the path and its phases, then one request per phase picks its
+// milestones and resources, with at most parallel of them running at once.
+// Each response stays small, so large paths are not cut off at the output
+// token limit, and the phases are detailed concurrently.
+//
+// It returns the path with placeholder IDs, like ParsePathGeneration, and
+// the planning prompt.
+func GenerateStagedPath(ctx context.Context, generate GenerateFunc, req ai.PathGenerationRequest, pathID core.EntityID, parallel int) (*ai.PathGenerationResponse, string, error) {
+	prompt, err := RenderPrompt(PathPlanPrompt, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	planText, err := generate(ctx, prompt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	plan, err := ParsePathPlan(planText)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := detailPhases(ctx, generate, req, plan, parallel); err != nil {
+		return nil, "", err
+	}
+
+	return buildPathGeneration(*plan, pathID), prompt, nil
+}
+
+// detailPhases fills in the milestones and resources of each planned phase.
+// The first failure cancels the requests still running.
+func detailPhases(ctx context.Context, generate GenerateFunc, req ai.PathGenerationRequest, plan *PathGenerationOutput, parallel int) error {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	outline := make([]string, len(plan.Phases))
+	for i, phase := range plan.Phases {
+		outline[i] = fmt.Sprintf("%d. %s (%d weeks)", i+1, phase.Title, phase.DurationWeeks)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, parallel)
+
+	for i := range plan.Phases {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				return
+			}
+
+			detail, err := detailPhase(ctx, generate, PhaseDetailRequest{
+				PathGenerationRequest: req,
+				PathTitle:             plan.Path.Title,
+				Outline:               outline,
+				Number:                i + 1,
+				Phase:                 plan.Phases[i],
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("phase %d (%s): %w", i+1, plan.Phases[i].Title, err)
+					cancel()
+				}
+				return
+			}
+			plan.Phases[i].Milestones = detail.Milestones
+			plan.Phases[i].Resources = detail.Resources
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	// A cancelled parent context stops phases before they start
+	return ctx.Err()
+}
+
+func detailPhase(ctx context.Context, generate GenerateFunc, req PhaseDetailRequest) (*PhaseDetailOutput, error) {
+	prompt, err := RenderPrompt(PhaseDetailPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := generate(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePhaseDetail(text)
+}
+
+// ParsePathPlan parses the response to PathPlanPrompt
+func ParsePathPlan(responseText string) (*PathGenerationOutput, error) {
+	var output PathGenerationOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse path plan response",
+			Err:      err,
+		}
+	}
+
+	if output.Path.Title == "" {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "path title is missing from response",
+		}
+	}
+	if len(output.Phases) == 0 {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "path plan has no phases",
+		}
+	}
+
+	return &output, nil
+}
+
+// ParsePhaseDetail parses the response to PhaseDetailPrompt
+func ParsePhaseDetail(responseText string) (*PhaseDetailOutput, error) {
+	var output PhaseDetailOutput
+
+	if err := json.Unmarshal([]byte(responseText), &output); err != nil {
+		return nil, &ai.ParseError{
+			Provider: "gemini",
+			Message:  "failed to parse phase detail response",
+			Err:      err,
+		}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,196 @@
+package gemini
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+const testPlan = `{
+	"path": {"title": "Backend Go", "description": "From basics to services"},
+	"phases": [
+		{"title": "Basics", "duration_weeks": 2},
+		{"title": "Concurrency", "duration_weeks": 3},
+		{"title": "Services", "duration_weeks": 4},
+		{"title": "Operations", "duration_weeks": 2}
+	],
+	"reasoning": "bottom-up"
+}`
+
+// fakeGenerate answers the planning prompt with testPlan and each phase
+// prompt with a milestone and a resource named after the phase
+func fakeGenerate(ctx context.Context, prompt string) (string, error) {
+	if strings.Contains(prompt, "Plan a personalized learning path") {
+		return testPlan, nil
+	}
+
+	start := strings.Index(prompt, "THIS PHASE (")
+	line := prompt[start : strings.Index(prompt[start:], "\n")+start]
+	title := line[strings.Index(line, ": ")+2:]
+	return fmt.Sprintf(`{"milestones": [{"title": "Finish %[1]s"}], "resources": [{"title": "%[1]s guide", "type": "book"}]}`, title), nil
+}
+
+func TestGenerateStagedPath(t *testing.T) {
+	req := ai.PathGenerationRequest{Goal: &core.Goal{ID: "goal-001", Title: "Backend developer"}}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		mu.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		return fakeGenerate(ctx, prompt)
+	}
+
+	resp, prompt, err := GenerateStagedPath(context.Background(), generate, req, "path-000", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(prompt, "Backend developer") {
+		t.Error("expected the planning prompt to be returned")
+	}
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 requests at once, got %d", maxRunning)
+	}
+
+	if resp.Path.Title != "Backend Go" || resp.Reasoning != "bottom-up" {
+		t.Errorf("unexpected path: %s (%s)", resp.Path.Title, resp.Reasoning)
+	}
+	if len(resp.Phases) != 4 || len(resp.Milestones) != 4 || len(resp.Resources) != 4 {
+		t.Fatalf("expected 4 phases, milestones and resources, got %d, %d, %d",
+			len(resp.Phases), len(resp.Milestones), len(resp.Resources))
+	}
+
+	// Details land on their own phase, in path order
+	for i, title := range []string{"Basics", "Concurrency", "Services", "Operations"} {
+		phase := resp.Phases[i]
+		if phase.Title != title || phase.Order != i+1 {
+			t.Errorf("phase %d = %s (order %d), want %s", i+1, phase.Title, phase.Order, title)
+		}
+		if resp.Resources[i].Title != title+" guide" || phase.Resources[0] != resp.Resources[i].ID {
+			t.Errorf("phase %s has resource %s (%s)", title, phase.Resources[0], resp.Resources[i].Title)
+		}
+		if resp.Milestones[i].Title != "Finish "+title || phase.Milestones[0] != resp.Milestones[i].ID {
+			t.Errorf("phase %s has milestone %s (%s)", title, phase.Milestones[0], resp.Milestones[i].Title)
+		}
+	}
+}
+
+func TestGenerateStagedPathErrors(t *testing.T) {
+	req := ai.PathGenerationRequest{Goal: &core.Goal{ID: "goal-001", Title: "Backend developer"}}
+
+	t.Run("fails when a phase fails", func(t *testing.T) {
+		apiErr := errors.New("rate limited")
+		generate := func(ctx context.Context, prompt string) (string, error) {
+			if strings.Contains(prompt, "THIS PHASE (3 of 4)") {
+				return "", apiErr
+			}
+			return fakeGenerate(ctx, prompt)
+		}
+
+		_, _, err := GenerateStagedPath(context.Background(), generate, req, "path-000", 3)
+		if !errors.Is(err, apiErr) || !strings.Contains(err.Error(), "phase 3 (Services)") {
+			t.Errorf("expected the phase error, got %v", err)
+		}
+	})
+
+	t.Run("fails on a plan without phases", func(t *testing.T) {
+		generate := func(ctx context.Context, prompt string) (string, error) {
+			return `{"path": {"title": "Empty"}, "phases": []}`, nil
+		}
+
+		_, _, err := GenerateStagedPath(context.Background(), generate, req, "path-000", 3)
+		var parseErr *ai.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("expected a parse error, got %v", err)
+		}
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		generate := func(ctx context.Context, prompt string) (string, error) {
+			if !strings.Contains(prompt, "Plan a personalized learning path") {
+				cancel()
+				<-ctx.Done()
+				return "", ctx.Err()
+			}
+			return testPlan, nil
+		}
+
+		_, _, err := GenerateStagedPath(ctx, generate, req, "path-000", 1)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestGeneratePath(t *testing.T) {
+	req := ai.PathGenerationRequest{Goal: &core.Goal{ID: "goal-001", Title: "Backend developer"}}
+
+	var prompts []string
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		prompts = append(prompts, prompt)
+		return `{"path": {"title": "Backend Go"}, "phases": [{"title": "Basics", "resources": [{"title": "Tour of Go", "type": "course"}]}]}`, nil
+	}
+
+	resp, prompt, err := GeneratePath(context.Background(), generate, req, "path-000", ai.Config{ParallelRequests: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prompts) != 1 || prompt != prompts[0] {
+		t.Fatalf("expected a single request, got %d", len(prompts))
+	}
+	if resp.Path.Title != "Backend Go" || len(resp.Resources) != 1 {
+		t.Errorf("unexpected path %q with %d resources", resp.Path.Title, len(resp.Resources))
+	}
+
+	prompts = nil
+	resp, _, err = GeneratePath(context.Background(), func(ctx context.Context, prompt string) (string, error) {
+		prompts = append(prompts, prompt)
+		return fakeGenerate(ctx, prompt)
+	}, req, "path-000", ai.Config{StagedGeneration: true, ParallelRequests: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(prompts) != 5 || len(resp.Phases) != 4 {
+		t.Errorf("expected a plan and 4 phase requests, got %d requests and %d phases", len(prompts), len(resp.Phases))
+	}
+}
+
+func TestRenderPathPrompts(t *testing.T) {
+	req := ai.PathGenerationRequest{Goal: &core.Goal{ID: "goal-001", Title: "Backend developer"}}
+
+	single, err := RenderPathPrompts(req, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want, _ := RenderPrompt(PathGenerationPrompt, req); single != want {
+		t.Error("expected the single path generation prompt")
+	}
+
+	staged, err := RenderPathPrompts(req, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"=== Planning prompt ===", "Plan a personalized learning path", "=== Phase prompt, sent once per planned phase ===", "<phase title>"} {
+		if !strings.Contains(staged, want) {
+			t.Errorf("expected staged prompts to contain %q", want)
+		}
+	}
+}
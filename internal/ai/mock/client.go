@@ -0,0 +1,368 @@
+// Package mock is an AI provider that answers every request with canned
+// responses instead of calling an API. It needs no network or API key, so
+// end-to-end tests and demos can run generation, parsing and saving
+// deterministically.
+//
+// Requests still go through the real prompt templates and response parsers.
+// The built-in responses can be replaced by JSON files in the directory named
+// by GROWTH_MOCK_RESPONSES, one per operation: path.json, or path-plan.json
+// and phase-detail.json for staged generation, resources.json, analysis.json, classification.json,
+// extraction.json and post.json. Each holds a response in the format its
+// prompt asks for.
+//
+// A replay client answers from fixtures recorded from a real provider
+// instead, see ai.Fixtures.
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// ResponsesEnvVar names the directory with response files that replace the
+// built-in ones
+const ResponsesEnvVar = "GROWTH_MOCK_RESPONSES"
+
+// The models reported for responses; ai.model is ignored, as it usually
+// names a model of the real provider
+const (
+	model       = "canned"
+	replayModel = "fixtures"
+)
+
+type Client struct {
+	dir      string       // response files, none when empty
+	fixtures *ai.Fixtures // recorded responses when replaying
+	config   ai.Config
+}
+
+func NewClient(cfg ai.Config) (*Client, error) {
+	return &Client{
+		dir:    os.Getenv(ResponsesEnvVar),
+		config: cfg,
+	}, nil
+}
+
+// NewReplayClient creates a client that answers each prompt with the
+// response recorded for it in cfg.ReplayDir, and fails for prompts that
+// were not recorded
+func NewReplayClient(cfg ai.Config) (*Client, error) {
+	info, err := os.Stat(cfg.ReplayDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot replay from %s: %w", cfg.ReplayDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("cannot replay from %s: not a directory", cfg.ReplayDir)
+	}
+
+	return &Client{
+		fixtures: &ai.Fixtures{Dir: cfg.ReplayDir},
+		config:   cfg,
+	}, nil
+}
+
+func (c *Client) Provider() string {
+	if c.fixtures != nil {
+		return "replay"
+	}
+	return "mock"
+}
+
+func (c *Client) Model() string {
+	if c.fixtures != nil {
+		return replayModel
+	}
+	return model
+}
+
+// Check always passes
+func (c *Client) Check(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// GenerateLearningPath runs the path generation of the real providers. Staged,
+// the plan and every phase are answered with the same canned responses.
+func (c *Client) GenerateLearningPath(ctx context.Context, req ai.PathGenerationRequest) (*ai.PathGenerationResponse, error) {
+	planPrompt, err := gemini.RenderPrompt(gemini.PathPlanPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		switch {
+		case !c.config.StagedGeneration:
+			return c.response("path.json", prompt, defaultPath)
+		case prompt == planPrompt:
+			return c.response("path-plan.json", prompt, defaultPathPlan)
+		}
+		return c.response("phase-detail.json", prompt, defaultPhaseDetail)
+	}
+	resp, prompt, err := gemini.GeneratePath(ctx, generate, req, "path-001", c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Path.GeneratedBy = c.Provider() + "/" + c.Model()
+	resp.Path.Provenance = ai.NewProvenance(c.Provider(), c.Model(), c.config.Temperature, prompt)
+	resp.Path.GenerationContext = fmt.Sprintf("%s | Style: %s | Time: %s",
+		req.Scope(), req.LearningStyle, req.TimeCommitment)
+
+	return resp, nil
+}
+
+func (c *Client) SuggestResources(ctx context.Context, req ai.ResourceSuggestionRequest) (*ai.ResourceSuggestionResponse, error) {
+	text, err := c.generate(ctx, gemini.ResourceSuggestionPrompt, req, "resources.json", defaultResources)
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParseResourceSuggestion(text, req.Skill.ID)
+}
+
+func (c *Client) AnalyzeProgress(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+	text, err := c.generate(ctx, gemini.ProgressAnalysisPrompt, req, "analysis.json", defaultAnalysis)
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParseProgressAnalysis(text)
+}
+
+func (c *Client) ClassifyResources(ctx context.Context, req ai.ResourceClassificationRequest) (*ai.ResourceClassificationResponse, error) {
+	text, err := c.generate(ctx, gemini.ResourceClassificationPrompt, req, "classification.json", defaultClassification(req))
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParseResourceClassification(text, req)
+}
+
+func (c *Client) ExtractProgress(ctx context.Context, req ai.ProgressExtractionRequest) (*ai.ProgressExtractionResponse, error) {
+	text, err := c.generate(ctx, gemini.ProgressExtractionPrompt, req, "extraction.json", defaultExtraction(req))
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParseProgressExtraction(text, req)
+}
+
+func (c *Client) DraftPost(ctx context.Context, req ai.PostDraftRequest) (*ai.PostDraftResponse, error) {
+	text, err := c.generate(ctx, gemini.PostDraftPrompt, req, "post.json", defaultPost)
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParsePostDraft(text)
+}
+
+func (c *Client) AuditPath(ctx context.Context, req ai.PathAuditRequest) (*ai.PathAuditResponse, error) {
+	text, err := c.generate(ctx, gemini.PathAuditPrompt, req, "audit.json", defaultAudit)
+	if err != nil {
+		return nil, err
+	}
+	return gemini.ParsePathAudit(text, req)
+}
+
+// generate renders the prompt, so a request the template cannot render fails
+// like it would with a real provider, and returns the canned response
+func (c *Client) generate(ctx context.Context, promptTemplate string, req any, name, fallback string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	prompt, err := gemini.RenderPrompt(promptTemplate, req)
+	if err != nil {
+		return "", err
+	}
+	return c.response(name, prompt, fallback)
+}
+
+// response returns the fixture recorded for the prompt when replaying, and
+// otherwise the named response file, or fallback when there is none
+func (c *Client) response(name, prompt, fallback string) (string, error) {
+	if c.fixtures != nil {
+		fixture, err := c.fixtures.Load(prompt)
+		if err != nil {
+			return "", err
+		}
+		return fixture.Response, nil
+	}
+
+	if c.dir == "" {
+		return fallback, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fallback, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read response %s: %w", name, err)
+	}
+	return string(data), nil
+}
+
+const defaultPath = `{
+  "path": {
+    "title": "Mock Learning Path",
+    "description": "A canned learning path from the mock provider",
+    "estimated_duration_weeks": 6
+  },
+  "phases": [
+    {
+      "title": "Foundations",
+      "description": "Learn the basics",
+      "duration_weeks": 2,
+      "skill_requirements": [{"skill_title": "Mock Skill", "category": "mock", "required_level": "beginner"}],
+      "milestones": [{"title": "Finish the phase", "description": "Complete every resource", "type": "skill-level"}],
+      "resources": [
+        {
+          "title": "Mock Guide",
+          "type": "article",
+          "author": "Mock Author",
+          "url": "https://example.com/mock-guide",
+          "estimated_hours": 2,
+          "description": "A canned resource from the mock provider"
+        }
+      ]
+    },
+    {
+      "title": "Practice",
+      "description": "Build something with it",
+      "duration_weeks": 4,
+      "skill_requirements": [{"skill_title": "Mock Skill", "category": "mock", "required_level": "intermediate"}],
+      "milestones": [{"title": "Finish the phase", "description": "Complete every resource", "type": "skill-level"}],
+      "resources": [
+        {
+          "title": "Mock Guide",
+          "type": "article",
+          "author": "Mock Author",
+          "url": "https://example.com/mock-guide",
+          "estimated_hours": 2,
+          "description": "A canned resource from the mock provider"
+        }
+      ]
+    }
+  ],
+  "reasoning": "Canned reasoning from the mock provider"
+}`
+
+const defaultPathPlan = `{
+  "path": {
+    "title": "Mock Learning Path",
+    "description": "A canned learning path from the mock provider",
+    "estimated_duration_weeks": 6
+  },
+  "phases": [
+    {
+      "title": "Foundations",
+      "description": "Learn the basics",
+      "duration_weeks": 2,
+      "skill_requirements": [{"skill_title": "Mock Skill", "category": "mock", "required_level": "beginner"}]
+    },
+    {
+      "title": "Practice",
+      "description": "Build something with it",
+      "duration_weeks": 4,
+      "skill_requirements": [{"skill_title": "Mock Skill", "category": "mock", "required_level": "intermediate"}]
+    }
+  ],
+  "reasoning": "Canned reasoning from the mock provider"
+}`
+
+const defaultPhaseDetail = `{
+  "milestones": [
+    {"title": "Finish the phase", "description": "Complete every resource", "type": "skill-level"}
+  ],
+  "resources": [
+    {
+      "title": "Mock Guide",
+      "type": "article",
+      "author": "Mock Author",
+      "url": "https://example.com/mock-guide",
+      "estimated_hours": 2,
+      "description": "A canned resource from the mock provider"
+    }
+  ]
+}`
+
+const defaultResources = `{
+  "resources": [
+    {
+      "title": "Mock Book",
+      "type": "book",
+      "author": "Mock Author",
+      "url": "https://example.com/mock-book",
+      "estimated_hours": 10,
+      "description": "A canned resource from the mock provider",
+      "why_recommended": "It is always recommended",
+      "cost": "free"
+    }
+  ],
+  "reasoning": "Canned reasoning from the mock provider"
+}`
+
+const defaultAnalysis = `{
+  "summary": "Canned progress summary from the mock provider.",
+  "insights": ["You log progress regularly"],
+  "recommendations": ["Keep going"],
+  "is_on_track": true,
+  "suggested_focus": ["Practice"]
+}`
+
+const defaultPost = `{
+  "text": "Another week of learning in public, drafted by the mock provider.",
+  "hashtags": ["learning", "growth"]
+}`
+
+const defaultAudit = `{
+  "summary": "A sound path, audited by the mock provider.",
+  "findings": []
+}`
+
+// defaultClassification files every link under the first skill as an article
+func defaultClassification(req ai.ResourceClassificationRequest) string {
+	var skillID core.EntityID
+	if len(req.Skills) > 0 {
+		skillID = req.Skills[0].ID
+	}
+
+	output := gemini.ResourceClassificationOutput{Classifications: []gemini.ClassificationOutput{}}
+	for i := range req.Candidates {
+		output.Classifications = append(output.Classifications, gemini.ClassificationOutput{
+			Index:   i,
+			SkillID: string(skillID),
+			Type:    string(core.ResourceArticle),
+		})
+	}
+	return mustMarshal(output)
+}
+
+// defaultExtraction logs an hour on the first skill
+func defaultExtraction(req ai.ProgressExtractionRequest) string {
+	output := gemini.ProgressExtractionOutput{
+		HoursInvested: 1,
+		SkillIDs:      []string{},
+		ResourceIDs:   []string{},
+		Mood:          "focused",
+		Summary:       "Canned summary of the transcript from the mock provider",
+	}
+	if len(req.Skills) > 0 {
+		output.SkillIDs = append(output.SkillIDs, string(req.Skills[0].ID))
+	}
+	return mustMarshal(output)
+}
+
+func mustMarshal(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
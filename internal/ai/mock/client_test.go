@@ -0,0 +1,190 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+func TestGenerateLearningPath(t *testing.T) {
+	client, err := NewClient(ai.Config{Provider: "mock", ParallelRequests: 2})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	req := ai.PathGenerationRequest{
+		Goal:           &core.Goal{ID: "goal-001", Title: "Learn Go"},
+		LearningStyle:  "project-based",
+		TimeCommitment: "5 hours/week",
+	}
+	resp, err := client.GenerateLearningPath(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GenerateLearningPath: %v", err)
+	}
+
+	if resp.Path.Title != "Mock Learning Path" || len(resp.Phases) != 2 {
+		t.Fatalf("got path %q with %d phases", resp.Path.Title, len(resp.Phases))
+	}
+	if len(resp.Milestones) != 2 || len(resp.Resources) != 2 {
+		t.Errorf("got %d milestones and %d resources, want one of each per phase", len(resp.Milestones), len(resp.Resources))
+	}
+	if resp.Path.GeneratedBy != "mock/canned" || resp.Path.Provenance == nil {
+		t.Errorf("got generated by %q, provenance %v", resp.Path.GeneratedBy, resp.Path.Provenance)
+	}
+
+	again, err := client.GenerateLearningPath(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GenerateLearningPath: %v", err)
+	}
+	if again.Path.Provenance.PromptHash != resp.Path.Provenance.PromptHash {
+		t.Error("the same request should render the same prompt")
+	}
+}
+
+func TestResponseFiles(t *testing.T) {
+	dir := t.TempDir()
+	analysis := `{"summary": "From a file", "is_on_track": false}`
+	if err := os.WriteFile(filepath.Join(dir, "analysis.json"), []byte(analysis), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ResponsesEnvVar, dir)
+
+	client, err := NewClient(ai.Config{Provider: "mock"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	resp, err := client.AnalyzeProgress(context.Background(), ai.ProgressAnalysisRequest{
+		Goal: &core.Goal{Title: "Learn Go"},
+		Path: &core.LearningPath{Title: "Go path"},
+	})
+	if err != nil {
+		t.Fatalf("AnalyzeProgress: %v", err)
+	}
+	if resp.Summary != "From a file" || resp.IsOnTrack {
+		t.Errorf("got %+v, want the response from analysis.json", resp)
+	}
+
+	// Operations without a file keep the built-in response
+	post, err := client.DraftPost(context.Background(), ai.PostDraftRequest{Platform: "x", MaxLength: 280})
+	if err != nil {
+		t.Fatalf("DraftPost: %v", err)
+	}
+	if len(post.Hashtags) != 2 {
+		t.Errorf("got hashtags %v, want the built-in ones", post.Hashtags)
+	}
+}
+
+func TestInvalidResponseFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "resources.json"), []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{dir: dir}
+	_, err := client.SuggestResources(context.Background(), ai.ResourceSuggestionRequest{Skill: &core.Skill{ID: "skill-001"}})
+	var parseErr *ai.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("got error %v, want a parse error", err)
+	}
+}
+
+func TestClassifyResources(t *testing.T) {
+	client := &Client{}
+
+	req := ai.ResourceClassificationRequest{
+		Candidates: []ai.ResourceCandidate{{Title: "A", URL: "https://a.example"}, {Title: "B", URL: "https://b.example"}},
+		Skills:     []*core.Skill{{ID: "skill-002", Title: "Go"}},
+	}
+	resp, err := client.ClassifyResources(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ClassifyResources: %v", err)
+	}
+
+	if len(resp.Classifications) != 2 {
+		t.Fatalf("got %d classifications, want 2", len(resp.Classifications))
+	}
+	for i, c := range resp.Classifications {
+		if c.Index != i || c.SkillID != "skill-002" || c.Type != core.ResourceArticle {
+			t.Errorf("classification %d: got %+v", i, c)
+		}
+	}
+}
+
+func TestCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &Client{}
+	if _, err := client.AnalyzeProgress(ctx, ai.ProgressAnalysisRequest{}); err != context.Canceled {
+		t.Errorf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dir := t.TempDir()
+	req := ai.PathGenerationRequest{
+		Goal:           &core.Goal{ID: "goal-001", Title: "Learn Go"},
+		LearningStyle:  "top-down",
+		TimeCommitment: "5 hours/week",
+	}
+
+	// Record what a provider would have answered to the plan and its phase
+	planPrompt, err := gemini.RenderPrompt(gemini.PathPlanPrompt, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures := ai.Fixtures{Dir: dir}
+	plan := `{"path": {"title": "Recorded Path"}, "phases": [{"title": "Basics", "duration_weeks": 1}]}`
+	if err := fixtures.Save(ai.Fixture{Provider: "gemini", Prompt: planPrompt, Response: plan}); err != nil {
+		t.Fatal(err)
+	}
+	phasePrompt, err := gemini.RenderPrompt(gemini.PhaseDetailPrompt, gemini.PhaseDetailRequest{
+		PathGenerationRequest: req,
+		PathTitle:             "Recorded Path",
+		Outline:               []string{"1. Basics (1 weeks)"},
+		Number:                1,
+		Phase:                 gemini.PhaseOutput{Title: "Basics", DurationWeeks: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	detail := `{"milestones": [], "resources": [{"title": "Tour of Go", "type": "course"}]}`
+	if err := fixtures.Save(ai.Fixture{Provider: "gemini", Prompt: phasePrompt, Response: detail}); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewReplayClient(ai.Config{Provider: "gemini", ReplayDir: dir, StagedGeneration: true})
+	if err != nil {
+		t.Fatalf("NewReplayClient: %v", err)
+	}
+
+	resp, err := client.GenerateLearningPath(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GenerateLearningPath: %v", err)
+	}
+	if resp.Path.Title != "Recorded Path" || len(resp.Resources) != 1 || resp.Resources[0].Title != "Tour of Go" {
+		t.Errorf("got path %q with resources %v, want the recorded ones", resp.Path.Title, resp.Resources)
+	}
+	if resp.Path.GeneratedBy != "replay/fixtures" {
+		t.Errorf("got generated by %q", resp.Path.GeneratedBy)
+	}
+
+	// A prompt that was not recorded fails instead of falling back
+	req.TimeCommitment = "10 hours/week"
+	if _, err := client.GenerateLearningPath(context.Background(), req); !errors.Is(err, ai.ErrNoFixture) {
+		t.Errorf("got error %v, want ai.ErrNoFixture", err)
+	}
+}
+
+func TestReplayMissingDirectory(t *testing.T) {
+	if _, err := NewReplayClient(ai.Config{ReplayDir: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("expected an error for a missing fixture directory")
+	}
+}
@@ -10,6 +10,10 @@ type MockClient struct {
 	GenerateLearningPathFunc func(ctx context.Context, req PathGenerationRequest) (*PathGenerationResponse, error)
 	SuggestResourcesFunc     func(ctx context.Context, req ResourceSuggestionRequest) (*ResourceSuggestionResponse, error)
 	AnalyzeProgressFunc      func(ctx context.Context, req ProgressAnalysisRequest) (*ProgressAnalysisResponse, error)
+	ClassifyResourcesFunc    func(ctx context.Context, req ResourceClassificationRequest) (*ResourceClassificationResponse, error)
+	ExtractProgressFunc      func(ctx context.Context, req ProgressExtractionRequest) (*ProgressExtractionResponse, error)
+	DraftPostFunc            func(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error)
+	AuditPathFunc            func(ctx context.Context, req PathAuditRequest) (*PathAuditResponse, error)
 	ProviderName             string
 }
 
@@ -67,6 +71,65 @@ func (m *MockClient) AnalyzeProgress(ctx context.Context, req ProgressAnalysisRe
 	}, nil
 }
 
+func (m *MockClient) ClassifyResources(ctx context.Context, req ResourceClassificationRequest) (*ResourceClassificationResponse, error) {
+	if m.ClassifyResourcesFunc != nil {
+		return m.ClassifyResourcesFunc(ctx, req)
+	}
+
+	var skillID core.EntityID
+	if len(req.Skills) > 0 {
+		skillID = req.Skills[0].ID
+	}
+
+	classifications := make([]ResourceClassification, 0, len(req.Candidates))
+	for i := range req.Candidates {
+		classifications = append(classifications, ResourceClassification{
+			Index:   i,
+			SkillID: skillID,
+			Type:    core.ResourceArticle,
+		})
+	}
+
+	return &ResourceClassificationResponse{
+		Classifications: classifications,
+	}, nil
+}
+
+func (m *MockClient) ExtractProgress(ctx context.Context, req ProgressExtractionRequest) (*ProgressExtractionResponse, error) {
+	if m.ExtractProgressFunc != nil {
+		return m.ExtractProgressFunc(ctx, req)
+	}
+
+	return &ProgressExtractionResponse{
+		HoursInvested: 1,
+		SkillIDs:      []core.EntityID{},
+		ResourceIDs:   []core.EntityID{},
+		Summary:       "Mock progress summary",
+	}, nil
+}
+
+func (m *MockClient) DraftPost(ctx context.Context, req PostDraftRequest) (*PostDraftResponse, error) {
+	if m.DraftPostFunc != nil {
+		return m.DraftPostFunc(ctx, req)
+	}
+
+	return &PostDraftResponse{
+		Text:     "Mock weekly learning update",
+		Hashtags: []string{"learninginpublic"},
+	}, nil
+}
+
+func (m *MockClient) AuditPath(ctx context.Context, req PathAuditRequest) (*PathAuditResponse, error) {
+	if m.AuditPathFunc != nil {
+		return m.AuditPathFunc(ctx, req)
+	}
+
+	return &PathAuditResponse{
+		Summary:  "Mock path audit",
+		Findings: []PathAuditFinding{},
+	}, nil
+}
+
 func (m *MockClient) Provider() string {
 	if m.ProviderName != "" {
 		return m.ProviderName
@@ -1,19 +1,63 @@
 package openai
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/core"
 )
 
+const (
+	defaultBaseURL = "https://api.openai.com/v1"
+	defaultModel   = "gpt-4o-mini"
+
+	// maxResponseSize limits how much of an API response is read
+	maxResponseSize = 10 << 20
+
+	// checkPrompt is the minimal prompt used by Check
+	checkPrompt = `Reply with the JSON object {"ok": true}.`
+)
+
+// Client talks to the OpenAI chat completions API. It shares its prompts and
+// response parsers with the Gemini client. Responses are requested in JSON
+// mode; OpenAI has no per-request safety thresholds to match Gemini's, so
+// its default moderation applies.
 type Client struct {
-	config ai.Config
+	httpClient *http.Client
+	baseURL    string
+	model      string
+	config     ai.Config
+	retryDelay time.Duration // base delay, doubled on each retry
 }
 
 func NewClient(cfg ai.Config) (*Client, error) {
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	model := cfg.Model
+	if model == "" || strings.HasPrefix(model, "gemini") {
+		model = defaultModel
+	}
+
 	return &Client{
-		config: cfg,
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+		baseURL:    baseURL,
+		model:      model,
+		config:     cfg,
+		retryDelay: time.Second,
 	}, nil
 }
 
@@ -21,14 +65,324 @@ func (c *Client) Provider() string {
 	return "openai"
 }
 
+// GenerateLearningPath generates the path in one request, or with
+// ai.stagedGeneration in a planning request and parallel phase requests, see
+// gemini.GeneratePath
 func (c *Client) GenerateLearningPath(ctx context.Context, req ai.PathGenerationRequest) (*ai.PathGenerationResponse, error) {
-	return nil, fmt.Errorf("OpenAI provider: %w (coming soon)", ai.ErrProviderNotSupported)
+	pathID := core.EntityID(fmt.Sprintf("path-%03d", time.Now().Unix()%1000))
+
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		return c.generateWithRetry(ctx, prompt, 3)
+	}
+	resp, prompt, err := gemini.GeneratePath(ctx, generate, req, pathID, c.config)
+	if err != nil {
+		return nil, asOpenAIError(err)
+	}
+
+	resp.Path.GeneratedBy = c.Provider() + "/" + c.model
+	resp.Path.Provenance = ai.NewProvenance(c.Provider(), c.model, c.config.Temperature, prompt)
+	resp.Path.GenerationContext = fmt.Sprintf("%s | Style: %s | Time: %s",
+		req.Scope(), req.LearningStyle, req.TimeCommitment)
+
+	return resp, nil
 }
 
 func (c *Client) SuggestResources(ctx context.Context, req ai.ResourceSuggestionRequest) (*ai.ResourceSuggestionResponse, error) {
-	return nil, fmt.Errorf("OpenAI provider: %w (coming soon)", ai.ErrProviderNotSupported)
+	prompt, err := gemini.RenderPrompt(gemini.ResourceSuggestionPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParseResourceSuggestion(responseText, req.Skill.ID)
+	return resp, asOpenAIError(err)
 }
 
 func (c *Client) AnalyzeProgress(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
-	return nil, fmt.Errorf("OpenAI provider: %w (coming soon)", ai.ErrProviderNotSupported)
+	prompt, err := gemini.RenderPrompt(gemini.ProgressAnalysisPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParseProgressAnalysis(responseText)
+	return resp, asOpenAIError(err)
+}
+
+func (c *Client) ClassifyResources(ctx context.Context, req ai.ResourceClassificationRequest) (*ai.ResourceClassificationResponse, error) {
+	prompt, err := gemini.RenderPrompt(gemini.ResourceClassificationPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParseResourceClassification(responseText, req)
+	return resp, asOpenAIError(err)
+}
+
+func (c *Client) ExtractProgress(ctx context.Context, req ai.ProgressExtractionRequest) (*ai.ProgressExtractionResponse, error) {
+	prompt, err := gemini.RenderPrompt(gemini.ProgressExtractionPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParseProgressExtraction(responseText, req)
+	return resp, asOpenAIError(err)
+}
+
+func (c *Client) DraftPost(ctx context.Context, req ai.PostDraftRequest) (*ai.PostDraftResponse, error) {
+	prompt, err := gemini.RenderPrompt(gemini.PostDraftPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParsePostDraft(responseText)
+	return resp, asOpenAIError(err)
+}
+
+func (c *Client) AuditPath(ctx context.Context, req ai.PathAuditRequest) (*ai.PathAuditResponse, error) {
+	prompt, err := gemini.RenderPrompt(gemini.PathAuditPrompt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseText, err := c.generateWithRetry(ctx, prompt, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gemini.ParsePathAudit(responseText, req)
+	return resp, asOpenAIError(err)
+}
+
+func (c *Client) Model() string {
+	return c.model
+}
+
+// Check runs a single, unretried generation of a tiny prompt
+func (c *Client) Check(ctx context.Context) error {
+	_, err := c.generateWithRetry(ctx, checkPrompt, 1)
+	return err
+}
+
+type modelList struct {
+	Data []struct {
+		ID      string `json:"id"`
+		OwnedBy string `json:"owned_by"`
+	} `json:"data"`
+}
+
+// ListModels returns the models available to the API key, sorted by ID
+func (c *Client) ListModels(ctx context.Context) ([]ai.ModelInfo, error) {
+	body, status, err := c.do(ctx, http.MethodGet, "/models", nil)
+	if err != nil {
+		return nil, &ai.APIError{Provider: "openai", Message: "failed to list models", Err: err}
+	}
+	if status != http.StatusOK {
+		return nil, apiError(status, body)
+	}
+
+	var list modelList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, &ai.ParseError{Provider: "openai", Message: "failed to parse model list", Err: err}
+	}
+
+	models := make([]ai.ModelInfo, 0, len(list.Data))
+	for _, m := range list.Data {
+		models = append(models, ai.ModelInfo{ID: m.ID, DisplayName: m.OwnedBy})
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+	return models, nil
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model               string         `json:"model"`
+	Messages            []chatMessage  `json:"messages"`
+	Temperature         float32        `json:"temperature"`
+	MaxCompletionTokens int            `json:"max_completion_tokens,omitempty"`
+	ResponseFormat      responseFormat `json:"response_format"`
+}
+
+type responseFormat struct {
+	Type string `json:"type"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+			Refusal string `json:"refusal"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+// generateWithRetry sends the prompt as a JSON-mode chat completion, retrying
+// rate limits, server errors, timeouts and empty responses with backoff
+func (c *Client) generateWithRetry(ctx context.Context, prompt string, maxRetries int) (string, error) {
+	payload, err := json.Marshal(chatRequest{
+		Model: c.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: "Respond with a single JSON object."},
+			{Role: "user", Content: prompt},
+		},
+		Temperature:         c.config.Temperature,
+		MaxCompletionTokens: c.config.MaxTokens,
+		ResponseFormat:      responseFormat{Type: "json_object"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := c.retryDelay * time.Duration(1<<uint(attempt-1))
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		body, status, err := c.do(ctx, http.MethodPost, "/chat/completions", payload)
+		if err != nil {
+			lastErr = &ai.APIError{Provider: "openai", Message: "API call failed", Err: err}
+
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				continue
+			}
+			return "", lastErr
+		}
+
+		if status != http.StatusOK {
+			lastErr = apiError(status, body)
+			if status == http.StatusTooManyRequests || status >= 500 {
+				continue
+			}
+			return "", lastErr
+		}
+
+		var resp chatResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return "", &ai.ParseError{Provider: "openai", Message: "failed to parse chat completion", Err: err}
+		}
+
+		if len(resp.Choices) == 0 {
+			lastErr = &ai.APIError{Provider: "openai", StatusCode: status, Message: "no choices returned"}
+			continue
+		}
+
+		message := resp.Choices[0].Message
+		if message.Refusal != "" {
+			return "", &ai.APIError{Provider: "openai", StatusCode: status, Message: "request refused: " + message.Refusal}
+		}
+		if resp.Choices[0].FinishReason == "length" {
+			return "", &ai.APIError{Provider: "openai", StatusCode: status, Message: "response was cut off; raise ai.maxTokens"}
+		}
+		if strings.TrimSpace(message.Content) == "" {
+			lastErr = &ai.APIError{Provider: "openai", StatusCode: status, Message: "empty response content"}
+			continue
+		}
+
+		if err := c.config.Record(c.model, prompt, message.Content); err != nil {
+			return "", err
+		}
+		return message.Content, nil
+	}
+
+	if lastErr != nil {
+		return "", lastErr
+	}
+	return "", &ai.APIError{
+		Provider: "openai",
+		Message:  "max retries exceeded",
+	}
+}
+
+// do sends an authenticated request to the API and returns the response body and status
+func (c *Client) do(ctx context.Context, method, path string, payload []byte) ([]byte, int, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	return body, resp.StatusCode, nil
+}
+
+// apiError builds an APIError from an error response, using the message
+// OpenAI returns in its error object when there is one
+func apiError(status int, body []byte) error {
+	var errBody struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	message := http.StatusText(status)
+	if json.Unmarshal(body, &errBody) == nil && errBody.Error.Message != "" {
+		message = errBody.Error.Message
+	}
+
+	apiErr := &ai.APIError{Provider: "openai", StatusCode: status, Message: message}
+	if status == http.StatusTooManyRequests {
+		apiErr.Err = ai.ErrRateLimitExceeded
+	}
+	return apiErr
+}
+
+// asOpenAIError attributes parse errors from the shared parsers to this provider
+func asOpenAIError(err error) error {
+	var parseErr *ai.ParseError
+	if errors.As(err, &parseErr) {
+		parseErr.Provider = "openai"
+	}
+	return err
 }
@@ -0,0 +1,283 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(ai.Config{
+		Provider:    "openai",
+		APIKey:      "test-key",
+		Model:       "gpt-4o",
+		Temperature: 0.5,
+		MaxTokens:   1000,
+		BaseURL:     server.URL,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.retryDelay = 0
+	return client
+}
+
+func completion(content string) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"choices": []map[string]interface{}{
+			{"message": map[string]string{"content": content}, "finish_reason": "stop"},
+		},
+	})
+	return string(body)
+}
+
+func TestSuggestResources(t *testing.T) {
+	var got chatRequest
+	var auth string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			http.NotFound(w, r)
+			return
+		}
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&got)
+		fmt.Fprint(w, completion(`{"resources": [{"title": "Kubernetes in Action", "type": "book", "estimated_hours": 30}], "reasoning": "classic"}`))
+	})
+
+	resp, err := client.SuggestResources(context.Background(), ai.ResourceSuggestionRequest{
+		Skill:        &core.Skill{ID: "skill-001", Title: "Kubernetes"},
+		CurrentLevel: core.LevelBeginner,
+		TargetLevel:  core.LevelIntermediate,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if auth != "Bearer test-key" {
+		t.Errorf("expected bearer auth, got %q", auth)
+	}
+	if got.Model != "gpt-4o" || got.ResponseFormat.Type != "json_object" || got.MaxCompletionTokens != 1000 {
+		t.Errorf("unexpected request: %+v", got)
+	}
+	if len(resp.Resources) != 1 || resp.Resources[0].SkillID != "skill-001" {
+		t.Fatalf("unexpected resources: %+v", resp.Resources)
+	}
+	if resp.Reasoning != "classic" {
+		t.Errorf("expected reasoning, got %q", resp.Reasoning)
+	}
+}
+
+func TestGenerateLearningPathProvenance(t *testing.T) {
+	var mu sync.Mutex
+	var planPrompt string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var got chatRequest
+		json.NewDecoder(r.Body).Decode(&got)
+		prompt := got.Messages[1].Content
+
+		if !strings.Contains(prompt, "Plan a personalized learning path") {
+			fmt.Fprint(w, completion(`{"milestones": [{"title": "Reconcile a CRD"}], "resources": [{"title": "Kubebuilder book", "type": "book"}]}`))
+			return
+		}
+		mu.Lock()
+		planPrompt = prompt
+		mu.Unlock()
+		fmt.Fprint(w, completion(`{"path": {"title": "Kubernetes Operator"}, "phases": [{"title": "Controllers", "duration_weeks": 2}], "reasoning": "focused"}`))
+	})
+	client.config.StagedGeneration = true
+
+	resp, err := client.GenerateLearningPath(context.Background(), ai.PathGenerationRequest{
+		Goal: &core.Goal{ID: "goal-001", Title: "Build an operator"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Path.GeneratedBy != "openai/gpt-4o" {
+		t.Errorf("expected GeneratedBy openai/gpt-4o, got %s", resp.Path.GeneratedBy)
+	}
+
+	provenance := resp.Path.Provenance
+	if provenance == nil {
+		t.Fatal("expected provenance to be recorded")
+	}
+	if provenance.Provider != "openai" || provenance.Model != "gpt-4o" || provenance.Temperature != 0.5 {
+		t.Errorf("unexpected provenance: %+v", provenance)
+	}
+	if provenance.PromptHash != ai.HashPrompt(planPrompt) {
+		t.Errorf("expected the hash of the planning prompt, got %s", provenance.PromptHash)
+	}
+	if len(resp.Phases) != 1 || len(resp.Milestones) != 1 || len(resp.Resources) != 1 {
+		t.Errorf("expected the phase with its details, got %d phases, %d milestones, %d resources",
+			len(resp.Phases), len(resp.Milestones), len(resp.Resources))
+	}
+	if provenance.RespondedAt.IsZero() {
+		t.Error("expected a response timestamp")
+	}
+}
+
+func TestRecordFixtures(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, completion(`{"resources": [{"title": "Go in Action", "type": "book"}]}`))
+	})
+	client.config.RecordDir = t.TempDir()
+
+	req := ai.ResourceSuggestionRequest{Skill: &core.Skill{ID: "skill-001", Title: "Go"}, TargetLevel: core.LevelIntermediate}
+	if _, err := client.SuggestResources(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prompt, err := gemini.RenderPrompt(gemini.ResourceSuggestionPrompt, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fixture, err := ai.Fixtures{Dir: client.config.RecordDir}.Load(prompt)
+	if err != nil {
+		t.Fatalf("expected the response to be recorded: %v", err)
+	}
+	if fixture.Provider != "openai" || fixture.Model != "gpt-4o" || !strings.Contains(fixture.Response, "Go in Action") {
+		t.Errorf("unexpected fixture: %+v", fixture)
+	}
+}
+
+func TestGenerateWithRetry(t *testing.T) {
+	t.Run("retries rate limits and server errors", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			switch calls {
+			case 1:
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"error": {"message": "slow down"}}`)
+			case 2:
+				w.WriteHeader(http.StatusBadGateway)
+			default:
+				fmt.Fprint(w, completion(`{"ok": true}`))
+			}
+		})
+
+		text, err := client.generateWithRetry(context.Background(), "prompt", 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if text != `{"ok": true}` || calls != 3 {
+			t.Errorf("expected success on third call, got %q after %d calls", text, calls)
+		}
+	})
+
+	t.Run("reports the last rate limit error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error": {"message": "slow down"}}`)
+		})
+
+		_, err := client.generateWithRetry(context.Background(), "prompt", 2)
+		if !errors.Is(err, ai.ErrRateLimitExceeded) {
+			t.Errorf("expected rate limit error, got %v", err)
+		}
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": {"message": "Incorrect API key provided"}}`)
+		})
+
+		_, err := client.generateWithRetry(context.Background(), "prompt", 3)
+		var apiErr *ai.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || apiErr.Message != "Incorrect API key provided" {
+			t.Errorf("expected 401 API error, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+}
+
+func TestParseErrorsNameProvider(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, completion(`not json`))
+	})
+
+	_, err := client.DraftPost(context.Background(), ai.PostDraftRequest{})
+
+	var parseErr *ai.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Provider != "openai" {
+		t.Errorf("expected openai parse error, got %v", err)
+	}
+}
+
+func TestListModels(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/models" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"id": "gpt-4o", "owned_by": "system"}, {"id": "gpt-4o-mini", "owned_by": "system"}, {"id": "dall-e-3", "owned_by": "system"}]}`)
+	})
+
+	models, err := client.ListModels(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var ids []string
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	if fmt.Sprint(ids) != "[dall-e-3 gpt-4o gpt-4o-mini]" {
+		t.Errorf("expected sorted model IDs, got %v", ids)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"error": {"message": "You exceeded your current quota"}}`)
+	})
+
+	err := client.Check(context.Background())
+
+	if !errors.Is(err, ai.ErrRateLimitExceeded) {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected check not to retry, got %d calls", calls)
+	}
+	if client.Model() != "gpt-4o" {
+		t.Errorf("expected model gpt-4o, got %s", client.Model())
+	}
+}
+
+func TestNewClientDefaults(t *testing.T) {
+	client, err := NewClient(ai.Config{Provider: "openai", APIKey: "key", Model: "gemini-3-flash-preview"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.model != defaultModel {
+		t.Errorf("expected a Gemini model name to fall back to %s, got %s", defaultModel, client.model)
+	}
+	if client.baseURL != defaultBaseURL {
+		t.Errorf("expected default base URL, got %s", client.baseURL)
+	}
+}
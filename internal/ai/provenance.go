@@ -0,0 +1,27 @@
+package ai
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// NewProvenance describes a generation from the prompt that was sent and
+// the provider settings it was sent with, timestamped now
+func NewProvenance(provider, model string, temperature float32, prompt string) *core.Provenance {
+	return &core.Provenance{
+		Provider:    provider,
+		Model:       model,
+		Temperature: temperature,
+		PromptHash:  HashPrompt(prompt),
+		RespondedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// HashPrompt returns the sha256 of a rendered prompt, prefixed with the algorithm
+func HashPrompt(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
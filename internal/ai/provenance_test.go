@@ -0,0 +1,28 @@
+package ai
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProvenance(t *testing.T) {
+	before := time.Now().UTC().Truncate(time.Second)
+
+	provenance := NewProvenance("openai", "gpt-4o-mini", 0.7, "Generate a path")
+
+	assert.Equal(t, "openai", provenance.Provider)
+	assert.Equal(t, "gpt-4o-mini", provenance.Model)
+	assert.Equal(t, float32(0.7), provenance.Temperature)
+	assert.Equal(t, HashPrompt("Generate a path"), provenance.PromptHash)
+	assert.False(t, provenance.RespondedAt.Before(before))
+}
+
+func TestHashPrompt(t *testing.T) {
+	hash := HashPrompt("Generate a path")
+
+	assert.Len(t, hash, len("sha256:")+64)
+	assert.Equal(t, hash, HashPrompt("Generate a path"))
+	assert.NotEqual(t, hash, HashPrompt("Generate a path "))
+}
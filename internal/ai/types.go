@@ -1,18 +1,33 @@
 package ai
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
 )
 
 type PathGenerationRequest struct {
-	Goal           *core.Goal
+	Goal *core.Goal
+	// Skill and TargetLevel scope the path to one skill instead of a goal;
+	// Goal is nil then
+	Skill          *core.Skill
+	TargetLevel    core.ProficiencyLevel
 	CurrentSkills  []*core.Skill
 	Background     string
 	LearningStyle  string // e.g., "top-down", "bottom-up", "project-based"
 	TimeCommitment string // e.g., "10 hours/week"
 	TargetDate     *time.Time
+	Feedback       []FeedbackEntry // recent 360 feedback, newest first
+	Language       string          // e.g., "Ukrainian", empty for English
+}
+
+// Scope describes what the path is generated for, the goal or the skill
+func (r PathGenerationRequest) Scope() string {
+	if r.Skill != nil {
+		return fmt.Sprintf("Skill: %s (%s to %s)", r.Skill.Title, r.Skill.Level, r.TargetLevel)
+	}
+	return "Goal: " + r.Goal.Title
 }
 
 type PathGenerationResponse struct {
@@ -29,6 +44,15 @@ type ResourceSuggestionRequest struct {
 	TargetLevel   core.ProficiencyLevel
 	LearningStyle string
 	Budget        string // e.g., "free", "paid", "any"
+
+	// Subscriptions are platforms the user already has access to
+	Subscriptions []string
+	// BudgetLimit is the yearly learning budget (0 for no limit) and
+	// RemainingBudget what is left of it this year
+	BudgetLimit     float64
+	RemainingBudget float64
+
+	Language string // e.g., "Ukrainian", empty for English
 }
 
 type ResourceSuggestionResponse struct {
@@ -41,6 +65,16 @@ type ProgressAnalysisRequest struct {
 	Path          *core.LearningPath
 	ProgressLogs  []*core.ProgressLog
 	CurrentSkills []*core.Skill
+	Notes         []*core.Note
+	Feedback      []FeedbackEntry // recent 360 feedback, newest first
+	Language      string          // e.g., "Ukrainian", empty for English
+}
+
+// FeedbackEntry is a piece of 360 feedback with the titles of the skills it
+// is about, since prompts name skills by title
+type FeedbackEntry struct {
+	*core.Feedback
+	SkillTitles []string
 }
 
 type ProgressAnalysisResponse struct {
@@ -50,3 +84,97 @@ type ProgressAnalysisResponse struct {
 	IsOnTrack       bool
 	SuggestedFocus  []string
 }
+
+type ResourceCandidate struct {
+	Title string
+	URL   string
+	Tags  []string
+}
+
+type ResourceClassificationRequest struct {
+	Candidates []ResourceCandidate
+	Skills     []*core.Skill
+}
+
+type ResourceClassification struct {
+	Index   int // position in ResourceClassificationRequest.Candidates
+	SkillID core.EntityID
+	Type    core.ResourceType
+}
+
+type ResourceClassificationResponse struct {
+	Classifications []ResourceClassification
+}
+
+type ProgressExtractionRequest struct {
+	Transcript string
+	Skills     []*core.Skill
+	Resources  []*core.Resource
+}
+
+type ProgressExtractionResponse struct {
+	HoursInvested float64
+	SkillIDs      []core.EntityID // only IDs from ProgressExtractionRequest.Skills
+	ResourceIDs   []core.EntityID // only IDs from ProgressExtractionRequest.Resources
+	Mood          string
+	Summary       string
+}
+
+type PostDraftRequest struct {
+	Platform     string // linkedin or x
+	MaxLength    int    // characters allowed by the platform
+	Digest       core.WeeklyDigest
+	ProgressLogs []*core.ProgressLog // logs of the week, with their bodies
+	Skills       []*core.Skill       // skills worked on during the week
+}
+
+type PostDraftResponse struct {
+	Text     string
+	Hashtags []string
+}
+
+// ModelInfo describes a model offered by a provider
+type ModelInfo struct {
+	ID          string // name to use as ai.model
+	DisplayName string
+}
+
+// PathAuditRequest is an existing learning path, with its phases in order,
+// to review against what it is for: its goal or, for skill plans, its skill
+type PathAuditRequest struct {
+	Path          *core.LearningPath
+	Goal          *core.Goal  // nil when no goal links the path
+	Skill         *core.Skill // set for paths scoped to one skill
+	Phases        []AuditPhase
+	CurrentSkills []*core.Skill
+	Language      string // e.g., "Ukrainian", empty for English
+}
+
+// AuditPhase is a phase of an audited path with its resources and milestones
+type AuditPhase struct {
+	*core.Phase
+	Number     int // position in the path, from 1
+	Resources  []*core.Resource
+	Milestones []*core.Milestone
+}
+
+// Kinds of path audit findings
+const (
+	AuditMissingTopic      = "missing-topic"
+	AuditRedundantResource = "redundant-resource"
+	AuditOrdering          = "ordering"
+)
+
+// PathAuditFinding is one problem found in a path, with what to do about it
+type PathAuditFinding struct {
+	Kind       string
+	Phase      int           // number of the phase, 0 for the whole path
+	ResourceID core.EntityID // only IDs from PathAuditRequest.Phases
+	Problem    string
+	Suggestion string
+}
+
+type PathAuditResponse struct {
+	Summary  string
+	Findings []PathAuditFinding
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/ai/mock"
 	"github.com/illenko/growth.md/internal/ai/openai"
 )
 
@@ -15,12 +16,18 @@ func NewClient(cfg ai.Config) (ai.AIClient, error) {
 		return nil, err
 	}
 
+	if cfg.ReplayDir != "" {
+		return mock.NewReplayClient(cfg)
+	}
+
 	// Create client based on provider
 	switch cfg.Provider {
 	case "gemini":
 		return gemini.NewClient(cfg)
 	case "openai":
 		return openai.NewClient(cfg)
+	case "mock":
+		return mock.NewClient(cfg)
 	case "anthropic":
 		return nil, fmt.Errorf("anthropic provider: %w (coming soon)", ai.ErrProviderNotSupported)
 	case "local":
@@ -29,3 +36,42 @@ func NewClient(cfg ai.Config) (ai.AIClient, error) {
 		return nil, fmt.Errorf("unknown provider '%s': %w", cfg.Provider, ai.ErrProviderNotSupported)
 	}
 }
+
+// NewClientWithFallbacks creates the client for cfg, falling back to the
+// given providers in order when it fails. Fallbacks use their provider's
+// default model and API key environment variable; those that can't be set
+// up (no key, not supported yet) are left out of the chain.
+func NewClientWithFallbacks(cfg ai.Config, fallbacks []string) (ai.AIClient, error) {
+	primary, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.ReplayDir != "" {
+		// Replayed responses don't fail over
+		return primary, nil
+	}
+
+	var chain []ai.AIClient
+	for _, provider := range fallbacks {
+		if provider == cfg.Provider {
+			continue
+		}
+		client, err := NewClient(ai.Config{
+			Provider:         provider,
+			Temperature:      cfg.Temperature,
+			MaxTokens:        cfg.MaxTokens,
+			StagedGeneration: cfg.StagedGeneration,
+			ParallelRequests: cfg.ParallelRequests,
+			RecordDir:        cfg.RecordDir,
+		})
+		if err != nil {
+			continue
+		}
+		chain = append(chain, client)
+	}
+
+	if len(chain) == 0 {
+		return primary, nil
+	}
+	return ai.NewFallbackClient(primary, chain...), nil
+}
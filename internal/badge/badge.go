@@ -0,0 +1,146 @@
+// Package badge renders shields.io-style SVG badges for embedding current
+// stats into a README. Text is measured with an embedded font, so rendering
+// needs no system dependencies.
+package badge
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+)
+
+// Colors are the named badge colors of shields.io
+var Colors = map[string]string{
+	"brightgreen": "#4c1",
+	"green":       "#97ca00",
+	"yellowgreen": "#a4a61d",
+	"yellow":      "#dfb317",
+	"orange":      "#fe7d37",
+	"red":         "#e05d44",
+	"blue":        "#007ec6",
+	"lightgrey":   "#9f9f9f",
+}
+
+// DefaultColor is the color of the message when a badge sets none
+const DefaultColor = "brightgreen"
+
+const (
+	height       = 20
+	padding      = 5 // on each side of the text
+	labelColor   = "#555"
+	fontSize     = 11
+	fontFamilies = "Verdana,Geneva,DejaVu Sans,sans-serif"
+)
+
+var hexColor = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Badge is the content of a badge: a gray label and a colored message
+type Badge struct {
+	Label   string
+	Message string
+	Color   string // a name from Colors or a hex color like #ff69b4
+}
+
+// ParseColor returns the hex value of a color name or hex color
+func ParseColor(color string) (string, error) {
+	if color == "" {
+		color = DefaultColor
+	}
+	if hex, ok := Colors[strings.ToLower(color)]; ok {
+		return hex, nil
+	}
+	if match := hexColor.FindStringSubmatch(color); match != nil {
+		return "#" + strings.ToLower(match[1]), nil
+	}
+	return "", fmt.Errorf("invalid badge color %q, must be a hex color or one of: brightgreen, green, yellowgreen, yellow, orange, red, blue, lightgrey", color)
+}
+
+// Render writes the badge to w as an SVG image
+func Render(w io.Writer, b Badge) error {
+	if strings.TrimSpace(b.Message) == "" {
+		return errors.New("badge message is required")
+	}
+	color, err := ParseColor(b.Color)
+	if err != nil {
+		return err
+	}
+
+	face, err := loadFace()
+	if err != nil {
+		return err
+	}
+
+	labelText := textWidth(face, b.Label)
+	messageText := textWidth(face, b.Message)
+
+	labelWidth := 0
+	if b.Label != "" {
+		labelWidth = labelText + 2*padding
+	}
+	messageWidth := messageText + 2*padding
+	width := labelWidth + messageWidth
+
+	title := b.Message
+	if b.Label != "" {
+		title = b.Label + ": " + b.Message
+	}
+
+	// Text is drawn at 10x size and scaled down, as shields.io does, for
+	// finer positioning
+	var texts strings.Builder
+	if b.Label != "" {
+		writeText(&texts, labelWidth*5, labelText*10, b.Label)
+	}
+	writeText(&texts, labelWidth*10+messageWidth*5, messageText*10, b.Message)
+
+	_, err = fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[2]d" role="img" aria-label="%[3]s">`+
+		`<title>%[3]s</title>`+
+		`<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`+
+		`<clipPath id="r"><rect width="%[1]d" height="%[2]d" rx="3" fill="#fff"/></clipPath>`+
+		`<g clip-path="url(#r)"><rect width="%[4]d" height="%[2]d" fill="%[5]s"/><rect x="%[4]d" width="%[6]d" height="%[2]d" fill="%[7]s"/><rect width="%[1]d" height="%[2]d" fill="url(#s)"/></g>`+
+		`<g fill="#fff" text-anchor="middle" font-family="%[8]s" text-rendering="geometricPrecision" font-size="%[9]d">%[10]s</g>`+
+		"</svg>\n",
+		width, height, html.EscapeString(title),
+		labelWidth, labelColor, messageWidth, color,
+		fontFamilies, fontSize*10, texts.String())
+	return err
+}
+
+// writeText writes a text with its shadow, centered on x
+func writeText(b *strings.Builder, x, length int, text string) {
+	text = html.EscapeString(text)
+	fmt.Fprintf(b, `<text aria-hidden="true" x="%d" y="150" fill="#010101" fill-opacity=".3" transform="scale(.1)" textLength="%d">%s</text>`, x, length, text)
+	fmt.Fprintf(b, `<text x="%d" y="140" transform="scale(.1)" fill="#fff" textLength="%d">%s</text>`, x, length, text)
+}
+
+func textWidth(face font.Face, text string) int {
+	return font.MeasureString(face, text).Ceil()
+}
+
+var (
+	faceOnce sync.Once
+	face     font.Face
+	faceErr  error
+)
+
+// loadFace loads the font text is measured with, whose widths are close to
+// those of Verdana the badge is displayed with
+func loadFace() (font.Face, error) {
+	faceOnce.Do(func() {
+		var f *opentype.Font
+		if f, faceErr = opentype.Parse(goregular.TTF); faceErr != nil {
+			faceErr = fmt.Errorf("failed to load font: %w", faceErr)
+			return
+		}
+		face, faceErr = opentype.NewFace(f, &opentype.FaceOptions{Size: fontSize, DPI: 72, Hinting: font.HintingNone})
+	})
+	return face, faceErr
+}
@@ -0,0 +1,73 @@
+package badge
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("writes an SVG with the label and message", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := Render(&buf, Badge{Label: "hours in 2025", Message: "127", Color: "blue"})
+
+		require.NoError(t, err)
+		svg := buf.String()
+		assert.Contains(t, svg, `aria-label="hours in 2025: 127"`)
+		assert.Contains(t, svg, `>hours in 2025</text>`)
+		assert.Contains(t, svg, `>127</text>`)
+		assert.Contains(t, svg, `fill="#007ec6"`)
+		assert.NoError(t, xml.Unmarshal(buf.Bytes(), new(struct{})), "SVG must be well-formed XML")
+	})
+
+	t.Run("grows with the text", func(t *testing.T) {
+		var short, long bytes.Buffer
+		require.NoError(t, Render(&short, Badge{Label: "streak", Message: "2 weeks"}))
+		require.NoError(t, Render(&long, Badge{Label: "streak", Message: "52 weeks and counting"}))
+
+		var shortSVG, longSVG struct {
+			Width int `xml:"width,attr"`
+		}
+		require.NoError(t, xml.Unmarshal(short.Bytes(), &shortSVG))
+		require.NoError(t, xml.Unmarshal(long.Bytes(), &longSVG))
+		assert.Greater(t, longSVG.Width, shortSVG.Width)
+	})
+
+	t.Run("escapes text", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Render(&buf, Badge{Label: "R&D", Message: "<3"}))
+
+		assert.Contains(t, buf.String(), "R&amp;D")
+		assert.Contains(t, buf.String(), "&lt;3")
+	})
+
+	t.Run("renders without a label", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Render(&buf, Badge{Message: "growing"}))
+
+		assert.Contains(t, buf.String(), `aria-label="growing"`)
+	})
+
+	t.Run("fails without a message", func(t *testing.T) {
+		assert.Error(t, Render(&bytes.Buffer{}, Badge{Label: "hours"}))
+	})
+}
+
+func TestParseColor(t *testing.T) {
+	color, err := ParseColor("")
+	require.NoError(t, err)
+	assert.Equal(t, "#4c1", color)
+
+	color, _ = ParseColor("Orange")
+	assert.Equal(t, "#fe7d37", color)
+
+	color, _ = ParseColor("FF69B4")
+	assert.Equal(t, "#ff69b4", color)
+
+	_, err = ParseColor("pinkish")
+	assert.Error(t, err)
+}
@@ -0,0 +1,219 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// GoogleConfig holds the OAuth client and target calendar for Google Calendar
+type GoogleConfig struct {
+	ClientID     string
+	ClientSecret string // loaded from GROWTH_GOOGLE_CLIENT_SECRET when empty
+	CalendarID   string // defaults to primary
+	TokenFile    string // where the OAuth token is cached between runs
+}
+
+func (c *GoogleConfig) Validate() error {
+	if c.ClientID == "" {
+		return errors.New("OAuth client ID is required")
+	}
+
+	if c.ClientSecret == "" {
+		c.ClientSecret = os.Getenv("GROWTH_GOOGLE_CLIENT_SECRET")
+	}
+	if c.ClientSecret == "" {
+		return errors.New("OAuth client secret is required (set GROWTH_GOOGLE_CLIENT_SECRET)")
+	}
+
+	if c.TokenFile == "" {
+		return errors.New("token file is required")
+	}
+
+	if c.CalendarID == "" {
+		c.CalendarID = "primary"
+	}
+
+	return nil
+}
+
+func (c *GoogleConfig) oauthConfig() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     google.Endpoint,
+		Scopes:       []string{gcal.CalendarEventsScope},
+	}
+}
+
+// GoogleCalendar writes events to a single Google calendar
+type GoogleCalendar struct {
+	service    *gcal.Service
+	calendarID string
+}
+
+// NewGoogleCalendar connects to Google Calendar with the cached OAuth token,
+// running the browser authorization flow first when there is none. showURL is
+// called with the consent page the user has to open.
+func NewGoogleCalendar(ctx context.Context, cfg GoogleConfig, showURL func(string)) (*GoogleCalendar, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid calendar configuration: %w", err)
+	}
+
+	oc := cfg.oauthConfig()
+
+	token, err := LoadToken(cfg.TokenFile)
+	if err != nil {
+		token, err = authorize(ctx, oc, showURL)
+		if err != nil {
+			return nil, err
+		}
+		if err := SaveToken(cfg.TokenFile, token); err != nil {
+			return nil, err
+		}
+	}
+
+	service, err := gcal.NewService(ctx, option.WithHTTPClient(oc.Client(ctx, token)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create calendar client: %w", err)
+	}
+
+	return &GoogleCalendar{service: service, calendarID: cfg.CalendarID}, nil
+}
+
+// Insert creates the events, updating any that were written by an earlier run
+func (g *GoogleCalendar) Insert(ctx context.Context, events []Event) error {
+	for _, event := range events {
+		ge := googleEvent(event)
+
+		_, err := g.service.Events.Insert(g.calendarID, ge).Context(ctx).Do()
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			_, err = g.service.Events.Update(g.calendarID, ge.Id, ge).Context(ctx).Do()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write event %q: %w", event.Summary, err)
+		}
+	}
+
+	return nil
+}
+
+// googleEvent converts an event, deriving a Google event ID (lowercase
+// base32hex) from its UID
+func googleEvent(event Event) *gcal.Event {
+	sum := sha1.Sum([]byte(event.UID))
+
+	ge := &gcal.Event{
+		Id:          hex.EncodeToString(sum[:]),
+		Summary:     event.Summary,
+		Description: event.Description,
+		Start:       &gcal.EventDateTime{DateTime: event.Start.Format("2006-01-02T15:04:05Z07:00")},
+		End:         &gcal.EventDateTime{DateTime: event.End.Format("2006-01-02T15:04:05Z07:00")},
+	}
+	if event.URL != "" {
+		ge.Source = &gcal.EventSource{Title: event.Summary, Url: event.URL}
+	}
+	return ge
+}
+
+// IsOffline reports whether err means Google could not be reached at all, as
+// opposed to rejecting the request
+func IsOffline(err error) bool {
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	return errors.As(err, &opErr) || errors.As(err, &dnsErr)
+}
+
+// authorize runs the OAuth loopback flow: it serves the redirect on a local
+// port and exchanges the returned code for a token
+func authorize(ctx context.Context, oc *oauth2.Config, showURL func(string)) (*oauth2.Token, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start authorization listener: %w", err)
+	}
+	defer listener.Close()
+
+	oc.RedirectURL = fmt.Sprintf("http://%s/", listener.Addr())
+
+	state := rand.Text()
+	verifier := oauth2.GenerateVerifier()
+
+	codes := make(chan string, 1)
+	failures := make(chan error, 1)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case query.Get("state") != state:
+			http.Error(w, "invalid state", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			failures <- fmt.Errorf("authorization denied: %s", query.Get("error"))
+		default:
+			codes <- query.Get("code")
+		}
+		fmt.Fprintln(w, "growth is authorized, you can close this window.")
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	showURL(oc.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.S256ChallengeOption(verifier)))
+
+	select {
+	case code := <-codes:
+		token, err := oc.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+		if err != nil {
+			return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+		}
+		return token, nil
+	case err := <-failures:
+		return nil, err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("authorization timed out: %w", ctx.Err())
+	}
+}
+
+// LoadToken reads a cached OAuth token
+func LoadToken(path string) (*oauth2.Token, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token file: %w", err)
+	}
+	return &token, nil
+}
+
+// SaveToken caches an OAuth token, readable only by the current user
+func SaveToken(path string, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+	return nil
+}
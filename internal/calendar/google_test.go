@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestGoogleConfig_Validate(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		t.Setenv("GROWTH_GOOGLE_CLIENT_SECRET", "secret")
+		cfg := GoogleConfig{ClientID: "client", TokenFile: "token.json"}
+
+		require.NoError(t, cfg.Validate())
+		assert.Equal(t, "secret", cfg.ClientSecret)
+		assert.Equal(t, "primary", cfg.CalendarID)
+	})
+
+	t.Run("requires client credentials", func(t *testing.T) {
+		t.Setenv("GROWTH_GOOGLE_CLIENT_SECRET", "")
+		assert.Error(t, (&GoogleConfig{TokenFile: "token.json"}).Validate())
+		assert.Error(t, (&GoogleConfig{ClientID: "client", TokenFile: "token.json"}).Validate())
+	})
+}
+
+func TestToken_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "token.json")
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}
+
+	require.NoError(t, SaveToken(path, token))
+
+	loaded, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", loaded.RefreshToken)
+}
+
+func TestGoogleCalendar_Insert(t *testing.T) {
+	var inserted, updated []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event gcal.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+
+		switch r.Method {
+		case http.MethodPost:
+			if len(inserted) > 0 {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"error":{"code":409,"message":"duplicate"}}`))
+				return
+			}
+			inserted = append(inserted, event.Summary)
+		case http.MethodPut:
+			assert.True(t, strings.HasSuffix(r.URL.Path, "/events/"+event.Id))
+			updated = append(updated, event.Summary)
+		}
+		json.NewEncoder(w).Encode(event)
+	}))
+	defer server.Close()
+
+	service, err := gcal.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+	g := &GoogleCalendar{service: service, calendarID: "primary"}
+
+	start := time.Date(2025, 7, 7, 19, 0, 0, 0, time.UTC)
+	events := []Event{
+		{UID: "a", Summary: "Study: Course", Start: start, End: start.Add(time.Hour)},
+		{UID: "b", Summary: "Study: Book", Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)},
+	}
+
+	require.NoError(t, g.Insert(context.Background(), events))
+	assert.Equal(t, []string{"Study: Course"}, inserted)
+	assert.Equal(t, []string{"Study: Book"}, updated)
+}
+
+func TestGoogleEvent(t *testing.T) {
+	start := time.Date(2025, 7, 7, 19, 0, 0, 0, time.UTC)
+	event := googleEvent(Event{UID: "growth-resource-001", Summary: "Study", URL: "https://go.dev", Start: start, End: start.Add(time.Hour)})
+
+	assert.Regexp(t, "^[0-9a-v]{5,}$", event.Id)
+	assert.Equal(t, "2025-07-07T19:00:00Z", event.Start.DateTime)
+	assert.Equal(t, "https://go.dev", event.Source.Url)
+	assert.Equal(t, event.Id, googleEvent(Event{UID: "growth-resource-001"}).Id)
+}
+
+func TestIsOffline(t *testing.T) {
+	assert.True(t, IsOffline(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, IsOffline(&net.DNSError{Name: "www.googleapis.com"}))
+	assert.False(t, IsOffline(errors.New("403 forbidden")))
+}
@@ -0,0 +1,88 @@
+// Package calendar writes study time blocks to calendars, either as an
+// iCalendar (ICS) file or through the Google Calendar API.
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Event is a calendar event for a planned study block
+type Event struct {
+	UID         string // stable identifier, so re-imports update instead of duplicate
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+}
+
+const icsTimeFormat = "20060102T150405Z"
+
+// WriteICS writes events as an iCalendar (RFC 5545) document. stamp is used as
+// the DTSTAMP of every event.
+func WriteICS(w io.Writer, events []Event, stamp time.Time) error {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//growth.md//plan week//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+
+	for _, event := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+event.UID)
+		writeLine(&b, "DTSTAMP:"+stamp.UTC().Format(icsTimeFormat))
+		writeLine(&b, "DTSTART:"+event.Start.UTC().Format(icsTimeFormat))
+		writeLine(&b, "DTEND:"+event.End.UTC().Format(icsTimeFormat))
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.URL != "" {
+			writeLine(&b, "URL:"+event.URL)
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// escapeText escapes a TEXT property value
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeLine writes a content line, folding it at 75 octets without splitting
+// multi-byte characters
+func writeLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = 74 // continuation lines start with a space
+	}
+	b.WriteString(line + "\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteICS(t *testing.T) {
+	stamp := time.Date(2025, 7, 6, 12, 0, 0, 0, time.UTC)
+	events := []Event{
+		{
+			UID:         "growth-resource-001-20250707T1900@growth.md",
+			Summary:     "Study: A Tour of Go",
+			Description: "Course, 2.0h; part 1, 2",
+			URL:         "https://go.dev/tour",
+			Start:       time.Date(2025, 7, 7, 19, 0, 0, 0, time.UTC),
+			End:         time.Date(2025, 7, 7, 21, 0, 0, 0, time.UTC),
+		},
+	}
+
+	var b strings.Builder
+	require.NoError(t, WriteICS(&b, events, stamp))
+	ics := b.String()
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "UID:growth-resource-001-20250707T1900@growth.md\r\n")
+	assert.Contains(t, ics, "DTSTAMP:20250706T120000Z\r\n")
+	assert.Contains(t, ics, "DTSTART:20250707T190000Z\r\n")
+	assert.Contains(t, ics, "DTEND:20250707T210000Z\r\n")
+	assert.Contains(t, ics, "SUMMARY:Study: A Tour of Go\r\n")
+	assert.Contains(t, ics, `DESCRIPTION:Course\, 2.0h\; part 1\, 2`+"\r\n")
+	assert.Contains(t, ics, "URL:https://go.dev/tour\r\n")
+}
+
+func TestWriteLine_Folds(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "SUMMARY:"+strings.Repeat("é", 50))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	require.Len(t, lines, 2)
+	assert.LessOrEqual(t, len(lines[0]), 75)
+	assert.True(t, strings.HasPrefix(lines[1], " é"))
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("é", 50), lines[0]+strings.TrimPrefix(lines[1], " "))
+}
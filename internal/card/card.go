@@ -0,0 +1,201 @@
+// Package card renders shareable progress cards as PNG images for posting
+// learning-in-public updates. Fonts are embedded, so rendering needs no
+// system dependencies.
+package card
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card size, the common preview size of social networks
+const (
+	Width  = 1200
+	Height = 630
+)
+
+const margin = 80
+
+var (
+	background = color.RGBA{R: 0x16, G: 0x1b, B: 0x22, A: 0xff}
+	foreground = color.RGBA{R: 0xf0, G: 0xf6, B: 0xfc, A: 0xff}
+	muted      = color.RGBA{R: 0x8b, G: 0x94, B: 0x9e, A: 0xff}
+	accent     = color.RGBA{R: 0x3f, G: 0xb9, B: 0x50, A: 0xff}
+	track      = color.RGBA{R: 0x30, G: 0x36, B: 0x3d, A: 0xff}
+)
+
+// Card is the content of a progress card
+type Card struct {
+	Title    string
+	Subtitle string   // shown above the title, e.g. "Goal · high priority"
+	Progress float64  // from 0 to 1
+	Streak   int      // consecutive weeks with progress
+	Details  []string // short lines shown below the progress bar
+	Footer   string
+}
+
+// Render draws the card and writes it to w as a PNG
+func Render(w io.Writer, c Card) error {
+	if strings.TrimSpace(c.Title) == "" {
+		return errors.New("card title is required")
+	}
+
+	faces, err := loadFaces()
+	if err != nil {
+		return err
+	}
+	defer faces.close()
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	y := margin + 30
+	if c.Subtitle != "" {
+		drawText(img, faces.small, muted, margin, y, c.Subtitle)
+		y += 70
+	} else {
+		y += 40
+	}
+
+	for _, line := range wrap(faces.title, c.Title, Width-2*margin, 2) {
+		drawText(img, faces.title, foreground, margin, y, line)
+		y += 72
+	}
+
+	// Percentage and streak above the bar
+	progress := clamp(c.Progress)
+	barTop := Height - margin - 150
+	drawText(img, faces.large, accent, margin, barTop-30, fmt.Sprintf("%.0f%%", progress*100))
+	if c.Streak > 0 {
+		streak := fmt.Sprintf("%d-week streak", c.Streak)
+		width := font.MeasureString(faces.body, streak).Ceil()
+		drawText(img, faces.body, foreground, Width-margin-width, barTop-34, streak)
+	}
+
+	fillRect(img, image.Rect(margin, barTop, Width-margin, barTop+24), track)
+	if filled := int(float64(Width-2*margin) * progress); filled > 0 {
+		fillRect(img, image.Rect(margin, barTop, margin+filled, barTop+24), accent)
+	}
+
+	y = barTop + 70
+	if len(c.Details) > 0 {
+		drawText(img, faces.body, muted, margin, y, strings.Join(c.Details, "  ·  "))
+	}
+	if c.Footer != "" {
+		drawText(img, faces.small, muted, margin, Height-margin/2, c.Footer)
+	}
+
+	return png.Encode(w, img)
+}
+
+type faceSet struct {
+	title, large, body, small font.Face
+}
+
+func loadFaces() (*faceSet, error) {
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load font: %w", err)
+	}
+
+	newFace := func(f *opentype.Font, size float64) (font.Face, error) {
+		return opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	}
+
+	set := &faceSet{}
+	if set.title, err = newFace(bold, 60); err != nil {
+		return nil, err
+	}
+	if set.large, err = newFace(bold, 96); err != nil {
+		return nil, err
+	}
+	if set.body, err = newFace(regular, 32); err != nil {
+		return nil, err
+	}
+	if set.small, err = newFace(regular, 26); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+func (f *faceSet) close() {
+	for _, face := range []font.Face{f.title, f.large, f.body, f.small} {
+		face.Close()
+	}
+}
+
+func drawText(img draw.Image, face font.Face, c color.Color, x, y int, text string) {
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+func fillRect(img draw.Image, r image.Rectangle, c color.Color) {
+	draw.Draw(img, r, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// wrap breaks text into at most maxLines lines that fit width, ending the
+// last line with an ellipsis when the text is cut
+func wrap(face font.Face, text string, width, maxLines int) []string {
+	fits := func(s string) bool { return font.MeasureString(face, s).Ceil() <= width }
+
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if fits(candidate) || line == "" {
+			line = candidate
+			continue
+		}
+		lines = append(lines, line)
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) <= maxLines {
+		return lines
+	}
+
+	lines = lines[:maxLines]
+	last := []rune(lines[maxLines-1])
+	for len(last) > 0 && !fits(string(last)+"…") {
+		last = last[:len(last)-1]
+	}
+	lines[maxLines-1] = strings.TrimSpace(string(last)) + "…"
+	return lines
+}
+
+func clamp(p float64) float64 {
+	if p < 0 {
+		return 0
+	}
+	if p > 1 {
+		return 1
+	}
+	return p
+}
@@ -0,0 +1,59 @@
+package card
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("writes a PNG of the card size", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := Render(&buf, Card{
+			Title:    "Become a backend engineer",
+			Subtitle: "Goal · high priority",
+			Progress: 0.42,
+			Streak:   3,
+			Details:  []string{"2 of 5 milestones"},
+			Footer:   "growth.md",
+		})
+
+		require.NoError(t, err)
+		img, err := png.Decode(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, Width, img.Bounds().Dx())
+		assert.Equal(t, Height, img.Bounds().Dy())
+	})
+
+	t.Run("requires a title", func(t *testing.T) {
+		err := Render(&bytes.Buffer{}, Card{Progress: 0.5})
+
+		assert.ErrorContains(t, err, "title is required")
+	})
+}
+
+func TestWrap(t *testing.T) {
+	faces, err := loadFaces()
+	require.NoError(t, err)
+	defer faces.close()
+
+	t.Run("keeps short titles on one line", func(t *testing.T) {
+		assert.Equal(t, []string{"Learn Go"}, wrap(faces.title, "Learn Go", 1000, 2))
+	})
+
+	t.Run("cuts long titles with an ellipsis", func(t *testing.T) {
+		lines := wrap(faces.title, strings.Repeat("distributed systems ", 20), 1000, 2)
+
+		require.Len(t, lines, 2)
+		assert.True(t, strings.HasSuffix(lines[1], "…"))
+		for _, line := range lines {
+			assert.LessOrEqual(t, font.MeasureString(faces.title, line).Ceil(), 1000)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+// Package catalog searches the content catalogs of learning subscriptions
+// (O'Reilly, Pluralsight) for resources on a topic.
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// maxResponseSize limits how much of a search response is read
+const maxResponseSize = 5 << 20
+
+// Result is a single item found in a catalog
+type Result struct {
+	Title          string
+	Type           core.ResourceType
+	Author         string
+	URL            string
+	EstimatedHours float64
+	Catalog        string // name of the catalog the result came from
+}
+
+// ToResource creates a resource for the skill from the result, tagged with
+// the catalog name
+func (r Result) ToResource(id, skillID core.EntityID) (*core.Resource, error) {
+	resource, err := core.NewResource(id, r.Title, r.Type, skillID)
+	if err != nil {
+		return nil, err
+	}
+	resource.SetURL(r.URL)
+	resource.SetAuthor(r.Author)
+	if r.EstimatedHours > 0 {
+		if err := resource.SetEstimatedHours(r.EstimatedHours); err != nil {
+			return nil, err
+		}
+	}
+	resource.AddTag(r.Catalog)
+	return resource, nil
+}
+
+// Provider searches one catalog
+type Provider interface {
+	Name() string
+	Search(ctx context.Context, query string, limit int) ([]Result, error)
+}
+
+// Names lists the catalogs that can be searched
+var Names = []string{"oreilly", "pluralsight"}
+
+// IsSupported returns true if a provider exists for the catalog name
+func IsSupported(name string) bool {
+	name = normalizeName(name)
+	for _, n := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NewProvider creates the provider for a catalog name. Catalogs that need
+// an API key read it from the environment (PLURALSIGHT_API_KEY).
+func NewProvider(name string, client *http.Client) (Provider, error) {
+	switch normalizeName(name) {
+	case "oreilly":
+		return NewOReilly(client), nil
+	case "pluralsight":
+		apiKey := os.Getenv("PLURALSIGHT_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("the pluralsight catalog needs an API key: set PLURALSIGHT_API_KEY")
+		}
+		return NewPluralsight(client, apiKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported catalog '%s' (supported: %s)", name, strings.Join(Names, ", "))
+	}
+}
+
+// normalizeName lowercases a catalog name and drops punctuation, so
+// "O'Reilly" matches "oreilly"
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
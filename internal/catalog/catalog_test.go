@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Run("matches catalog names loosely", func(t *testing.T) {
+		provider, err := NewProvider("O'Reilly", http.DefaultClient)
+
+		require.NoError(t, err)
+		assert.Equal(t, "oreilly", provider.Name())
+	})
+
+	t.Run("pluralsight needs an API key", func(t *testing.T) {
+		t.Setenv("PLURALSIGHT_API_KEY", "")
+
+		_, err := NewProvider("pluralsight", http.DefaultClient)
+		assert.ErrorContains(t, err, "PLURALSIGHT_API_KEY")
+
+		t.Setenv("PLURALSIGHT_API_KEY", "secret")
+		provider, err := NewProvider("pluralsight", http.DefaultClient)
+		require.NoError(t, err)
+		assert.Equal(t, "pluralsight", provider.Name())
+	})
+
+	t.Run("rejects unknown catalogs", func(t *testing.T) {
+		_, err := NewProvider("udemy", http.DefaultClient)
+
+		assert.ErrorContains(t, err, "unsupported catalog 'udemy'")
+		assert.False(t, IsSupported("udemy"))
+		assert.True(t, IsSupported("Pluralsight"))
+	})
+}
+
+func TestResult_ToResource(t *testing.T) {
+	result := Result{
+		Title:          "Kubernetes: Up and Running",
+		Type:           core.ResourceBook,
+		Author:         "Brendan Burns",
+		URL:            "https://learning.oreilly.com/library/view/kubernetes-up-and/9781098110192/",
+		EstimatedHours: 14,
+		Catalog:        "oreilly",
+	}
+
+	resource, err := result.ToResource("resource-010", "skill-001")
+
+	require.NoError(t, err)
+	assert.Equal(t, core.EntityID("resource-010"), resource.ID)
+	assert.Equal(t, core.EntityID("skill-001"), resource.SkillID)
+	assert.Equal(t, "Kubernetes: Up and Running", resource.Title)
+	assert.Equal(t, "Brendan Burns", resource.Author)
+	assert.Equal(t, result.URL, resource.URL)
+	assert.Equal(t, 14.0, resource.EstimatedHours)
+	assert.Equal(t, []string{"oreilly"}, resource.Tags)
+}
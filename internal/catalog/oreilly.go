@@ -0,0 +1,131 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+const (
+	defaultOReillyBaseURL = "https://learning.oreilly.com"
+
+	// pagesPerHour is the reading pace used to estimate hours for books
+	pagesPerHour = 20
+)
+
+// OReilly searches the O'Reilly learning platform catalog
+type OReilly struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewOReilly creates a provider for the O'Reilly catalog
+func NewOReilly(client *http.Client) *OReilly {
+	return &OReilly{client: client, baseURL: defaultOReillyBaseURL}
+}
+
+func (o *OReilly) Name() string {
+	return "oreilly"
+}
+
+type oreillyResponse struct {
+	Results []struct {
+		Title           string   `json:"title"`
+		Authors         []string `json:"authors"`
+		Format          string   `json:"format"`
+		WebURL          string   `json:"web_url"`
+		DurationSeconds float64  `json:"duration_seconds"`
+		VirtualPages    int      `json:"virtual_pages"`
+	} `json:"results"`
+}
+
+// Search queries the catalog's search API
+func (o *OReilly) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(limit))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/api/v2/search/?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "growth.md catalog search")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search O'Reilly: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to search O'Reilly: status %d", resp.StatusCode)
+	}
+
+	var body oreillyResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to parse O'Reilly search results: %w", err)
+	}
+
+	var results []Result
+	for _, item := range body.Results {
+		if strings.TrimSpace(item.Title) == "" {
+			continue
+		}
+
+		result := Result{
+			Title:   strings.TrimSpace(item.Title),
+			Type:    oreillyType(item.Format),
+			Author:  strings.Join(item.Authors, ", "),
+			URL:     item.WebURL,
+			Catalog: o.Name(),
+		}
+		if strings.HasPrefix(result.URL, "/") {
+			result.URL = o.baseURL + result.URL
+		}
+		switch {
+		case item.DurationSeconds > 0:
+			result.EstimatedHours = roundHours(item.DurationSeconds / 3600)
+		case item.VirtualPages > 0:
+			result.EstimatedHours = roundHours(float64(item.VirtualPages) / pagesPerHour)
+		}
+
+		results = append(results, result)
+		if len(results) == limit {
+			break
+		}
+	}
+	return results, nil
+}
+
+// oreillyType maps an O'Reilly content format to a resource type
+func oreillyType(format string) core.ResourceType {
+	switch strings.ToLower(format) {
+	case "book":
+		return core.ResourceBook
+	case "video":
+		return core.ResourceVideo
+	case "course", "live-online-training", "learning-path":
+		return core.ResourceCourse
+	case "scenario", "lab", "sandbox":
+		return core.ResourceProject
+	default:
+		return core.ResourceArticle
+	}
+}
+
+// roundHours rounds to the nearest half hour, with half an hour at least
+func roundHours(hours float64) float64 {
+	rounded := float64(int(hours*2+0.5)) / 2
+	if rounded < 0.5 {
+		return 0.5
+	}
+	return rounded
+}
@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOReilly_Search(t *testing.T) {
+	var gotQuery, gotLimit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/search/" {
+			http.NotFound(w, r)
+			return
+		}
+		gotQuery = r.URL.Query().Get("query")
+		gotLimit = r.URL.Query().Get("limit")
+		fmt.Fprint(w, `{"results": [
+			{"title": "Kubernetes: Up and Running", "authors": ["Brendan Burns", "Joe Beda"], "format": "book",
+			 "web_url": "/library/view/kubernetes-up-and/9781098110192/", "virtual_pages": 330},
+			{"title": "Kubernetes Fundamentals", "authors": ["Sander van Vugt"], "format": "video",
+			 "web_url": "https://learning.oreilly.com/videos/kubernetes-fundamentals/9780136897033/", "duration_seconds": 20700},
+			{"title": "", "format": "book"},
+			{"title": "Kubernetes Patterns", "authors": [], "format": "book", "web_url": "/library/view/kubernetes-patterns/9781492050278/"}
+		]}`)
+	}))
+	defer server.Close()
+
+	provider := NewOReilly(server.Client())
+	provider.baseURL = server.URL
+
+	t.Run("maps search results to resources", func(t *testing.T) {
+		results, err := provider.Search(context.Background(), "kubernetes", 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, "kubernetes", gotQuery)
+		assert.Equal(t, "10", gotLimit)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, "Kubernetes: Up and Running", results[0].Title)
+		assert.Equal(t, core.ResourceBook, results[0].Type)
+		assert.Equal(t, "Brendan Burns, Joe Beda", results[0].Author)
+		assert.Equal(t, server.URL+"/library/view/kubernetes-up-and/9781098110192/", results[0].URL)
+		assert.Equal(t, 16.5, results[0].EstimatedHours)
+		assert.Equal(t, "oreilly", results[0].Catalog)
+
+		assert.Equal(t, core.ResourceVideo, results[1].Type)
+		assert.Equal(t, 6.0, results[1].EstimatedHours)
+
+		assert.Equal(t, 0.0, results[2].EstimatedHours)
+	})
+
+	t.Run("stops at the limit", func(t *testing.T) {
+		results, err := provider.Search(context.Background(), "kubernetes", 1)
+
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("fails on error status", func(t *testing.T) {
+		provider := NewOReilly(server.Client())
+		provider.baseURL = server.URL + "/missing"
+
+		_, err := provider.Search(context.Background(), "kubernetes", 10)
+		assert.ErrorContains(t, err, "status 404")
+	})
+}
+
+func TestOReillyType(t *testing.T) {
+	assert.Equal(t, core.ResourceBook, oreillyType("book"))
+	assert.Equal(t, core.ResourceVideo, oreillyType("Video"))
+	assert.Equal(t, core.ResourceCourse, oreillyType("live-online-training"))
+	assert.Equal(t, core.ResourceProject, oreillyType("scenario"))
+	assert.Equal(t, core.ResourceArticle, oreillyType("shortcut"))
+}
@@ -0,0 +1,133 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+const defaultPluralsightEndpoint = "https://paas-api.pluralsight.com/graphql"
+
+const pluralsightQuery = `query Search($query: String!, $first: Int!) {
+  courseCatalog(first: $first, filter: { search: $query }) {
+    nodes {
+      title
+      url
+      courseSeconds
+      authors { firstName lastName }
+    }
+  }
+}`
+
+// Pluralsight searches the Pluralsight course catalog through its GraphQL API
+type Pluralsight struct {
+	client   *http.Client
+	endpoint string
+	apiKey   string
+}
+
+// NewPluralsight creates a provider for the Pluralsight catalog
+func NewPluralsight(client *http.Client, apiKey string) *Pluralsight {
+	return &Pluralsight{client: client, endpoint: defaultPluralsightEndpoint, apiKey: apiKey}
+}
+
+func (p *Pluralsight) Name() string {
+	return "pluralsight"
+}
+
+type pluralsightResponse struct {
+	Data struct {
+		CourseCatalog struct {
+			Nodes []struct {
+				Title         string  `json:"title"`
+				URL           string  `json:"url"`
+				CourseSeconds float64 `json:"courseSeconds"`
+				Authors       []struct {
+					FirstName string `json:"firstName"`
+					LastName  string `json:"lastName"`
+				} `json:"authors"`
+			} `json:"nodes"`
+		} `json:"courseCatalog"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Search queries the course catalog for courses matching query
+func (p *Pluralsight) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"query":     pluralsightQuery,
+		"variables": map[string]interface{}{"query": query, "first": limit},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "growth.md catalog search")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search Pluralsight: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, errors.New("failed to search Pluralsight: the API key was rejected")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to search Pluralsight: status %d", resp.StatusCode)
+	}
+
+	var body pluralsightResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to parse Pluralsight search results: %w", err)
+	}
+	if len(body.Errors) > 0 {
+		return nil, fmt.Errorf("failed to search Pluralsight: %s", body.Errors[0].Message)
+	}
+
+	var results []Result
+	for _, node := range body.Data.CourseCatalog.Nodes {
+		if strings.TrimSpace(node.Title) == "" {
+			continue
+		}
+
+		var authors []string
+		for _, a := range node.Authors {
+			if name := strings.TrimSpace(a.FirstName + " " + a.LastName); name != "" {
+				authors = append(authors, name)
+			}
+		}
+
+		result := Result{
+			Title:   strings.TrimSpace(node.Title),
+			Type:    core.ResourceCourse,
+			Author:  strings.Join(authors, ", "),
+			URL:     node.URL,
+			Catalog: p.Name(),
+		}
+		if node.CourseSeconds > 0 {
+			result.EstimatedHours = roundHours(node.CourseSeconds / 3600)
+		}
+
+		results = append(results, result)
+		if len(results) == limit {
+			break
+		}
+	}
+	return results, nil
+}
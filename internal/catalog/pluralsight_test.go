@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluralsight_Search(t *testing.T) {
+	var gotAuth string
+	var gotVariables map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		if gotAuth != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotVariables = body.Variables
+
+		fmt.Fprint(w, `{"data": {"courseCatalog": {"nodes": [
+			{"title": "Kubernetes for Developers: Core Concepts", "url": "https://app.pluralsight.com/library/courses/kubernetes-developers-core-concepts",
+			 "courseSeconds": 16200, "authors": [{"firstName": "Dan", "lastName": "Wahlin"}]},
+			{"title": "Getting Started with Kubernetes", "url": "https://app.pluralsight.com/library/courses/getting-started-kubernetes",
+			 "courseSeconds": 600, "authors": []}
+		]}}}`)
+	}))
+	defer server.Close()
+
+	t.Run("maps catalog courses to resources", func(t *testing.T) {
+		provider := NewPluralsight(server.Client(), "secret")
+		provider.endpoint = server.URL
+
+		results, err := provider.Search(context.Background(), "kubernetes", 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer secret", gotAuth)
+		assert.Equal(t, "kubernetes", gotVariables["query"])
+		assert.Equal(t, 5.0, gotVariables["first"])
+		require.Len(t, results, 2)
+
+		assert.Equal(t, "Kubernetes for Developers: Core Concepts", results[0].Title)
+		assert.Equal(t, core.ResourceCourse, results[0].Type)
+		assert.Equal(t, "Dan Wahlin", results[0].Author)
+		assert.Equal(t, 4.5, results[0].EstimatedHours)
+		assert.Equal(t, "pluralsight", results[0].Catalog)
+
+		assert.Empty(t, results[1].Author)
+		assert.Equal(t, 0.5, results[1].EstimatedHours)
+	})
+
+	t.Run("reports a rejected API key", func(t *testing.T) {
+		provider := NewPluralsight(server.Client(), "wrong")
+		provider.endpoint = server.URL
+
+		_, err := provider.Search(context.Background(), "kubernetes", 5)
+		assert.ErrorContains(t, err, "API key was rejected")
+	})
+
+	t.Run("reports GraphQL errors", func(t *testing.T) {
+		errServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"errors": [{"message": "rate limit exceeded"}]}`)
+		}))
+		defer errServer.Close()
+
+		provider := NewPluralsight(errServer.Client(), "secret")
+		provider.endpoint = errServer.URL
+
+		_, err := provider.Search(context.Background(), "kubernetes", 5)
+		assert.ErrorContains(t, err, "rate limit exceeded")
+	})
+}
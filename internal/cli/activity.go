@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	activitySince  string
+	activityType   string
+	activityEntity string
+	activityLimit  int
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Show the activity feed",
+	Long: `Show a chronological feed of what happened in your growth repository.
+
+Events are read from .growth/events.jsonl, an append-only log with one JSON
+object per line. Entities created or deleted, status changes, completed
+goals, paths and resources, and achieved milestones are all recorded there,
+so the file can also be consumed by hooks and notification scripts.
+
+The most recent events are shown last.
+
+Examples:
+  growth activity
+  growth activity --since 2025-01-01
+  growth activity --type resource.completed
+  growth activity --entity goal-001 --limit 50
+  growth activity --format json`,
+	RunE: runActivity,
+}
+
+func init() {
+	rootCmd.AddCommand(activityCmd)
+
+	activityCmd.Flags().StringVar(&activitySince, "since", "", "only show events on or after this date (YYYY-MM-DD)")
+	activityCmd.Flags().StringVar(&activityType, "type", "", "filter by event type (e.g. resource.completed, entity.created)")
+	activityCmd.Flags().StringVar(&activityEntity, "entity", "", "filter by entity ID")
+	activityCmd.Flags().IntVarP(&activityLimit, "limit", "n", 20, "maximum number of events to show (0 for all)")
+}
+
+func runActivity(cmd *cobra.Command, args []string) error {
+	var since time.Time
+	if activitySince != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", activitySince, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		since = parsed
+	}
+
+	all, err := eventLog.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read activity: %w", err)
+	}
+
+	var feed []events.Event
+	for _, event := range all {
+		if !since.IsZero() && event.Time.Before(since) {
+			continue
+		}
+		if activityType != "" && string(event.Type) != activityType {
+			continue
+		}
+		if activityEntity != "" && event.EntityID != core.EntityID(activityEntity) {
+			continue
+		}
+		feed = append(feed, event)
+	}
+
+	if len(feed) == 0 {
+		PrintInfo("No activity found")
+		return nil
+	}
+
+	if activityLimit > 0 && len(feed) > activityLimit {
+		feed = feed[len(feed)-activityLimit:]
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, event := range feed {
+			fmt.Printf("%s  %-20s %-14s %s\n",
+				formatDateTime(event.Time), event.Type, event.EntityID, describeEvent(event))
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(feed)
+}
+
+// describeEvent renders the human-readable part of a feed line
+func describeEvent(event events.Event) string {
+	if event.Field == "" {
+		return event.Title
+	}
+
+	if event.Type == events.FieldChanged {
+		return fmt.Sprintf("%s (%s: %s %s %s)", event.Title, event.Field, event.From, glyph.Arrow, event.To)
+	}
+
+	return fmt.Sprintf("%s (%s %s %s)", event.Title, event.From, glyph.Arrow, event.To)
+}
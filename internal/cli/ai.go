@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/aifactory"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	aiModelsProvider string
+	aiCheckProvider  string
+
+	exportGoal        string
+	exportSkill       string
+	exportStyle       string
+	exportTime        string
+	exportBackground  string
+	exportTargetLevel string
+	exportBudget      string
+)
+
+var aiCmd = &cobra.Command{
+	Use:   "ai",
+	Short: "Inspect the configured AI providers",
+	Long:  `Inspect the AI providers used for path generation, resource suggestions and other AI features.`,
+}
+
+var aiModelsCmd = &cobra.Command{
+	Use:   "models",
+	Short: "List the models available from an AI provider",
+	Long: `List the models your API key can use, as reported by the provider's API.
+Set one as ai.model in config.yml or pass it with --model to AI commands.
+
+Examples:
+  growth ai models
+  growth ai models --provider openai`,
+	RunE: runAIModels,
+}
+
+var aiCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check that the AI provider is set up and responding",
+	Long: `Check the configured AI provider and each of ai.fallbackProviders before
+relying on them: verifies an API key is available, sends a tiny test
+generation and reports the latency. Invalid keys, unknown models and
+exhausted quotas are reported with a hint on how to fix them.
+
+Exits with an error if any check fails.
+
+Examples:
+  growth ai check
+  growth ai check --provider openai`,
+	RunE: runAICheck,
+}
+
+var aiExportPromptCmd = &cobra.Command{
+	Use:   "export-prompt",
+	Short: "Print an AI prompt to run by hand",
+	Long: `Render the prompt growth would send for a learning path (--goal) or for
+resource suggestions (--skill) and print it, without calling a provider
+or needing an API key.
+
+Redirect it to a file and paste it into the chat UI of any model, for
+example a stronger one than your API plan includes. The prompt asks for a
+JSON response; save a learning path response with
+'growth path import-response <file> --goal <goal-id>'.
+
+Examples:
+  growth ai export-prompt --goal goal-001 > prompt.txt
+  growth ai export-prompt --goal goal-001 --style project-based --time "10 hours/week"
+  growth ai export-prompt --skill skill-003 --target-level advanced --budget free`,
+	RunE: runAIExportPrompt,
+}
+
+func init() {
+	rootCmd.AddCommand(aiCmd)
+	aiCmd.AddCommand(aiModelsCmd)
+	aiCmd.AddCommand(aiCheckCmd)
+	aiCmd.AddCommand(aiExportPromptCmd)
+
+	aiModelsCmd.Flags().StringVar(&aiModelsProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	aiCheckCmd.Flags().StringVar(&aiCheckProvider, "provider", "", "AI provider to check (gemini, openai) - defaults to config")
+
+	aiExportPromptCmd.Flags().StringVar(&exportGoal, "goal", "", "goal to generate a learning path for")
+	aiExportPromptCmd.Flags().StringVar(&exportSkill, "skill", "", "skill to suggest resources for")
+	aiExportPromptCmd.Flags().StringVar(&exportStyle, "style", "", "learning style (top-down, bottom-up, project-based) - defaults to config")
+	aiExportPromptCmd.Flags().StringVar(&exportTime, "time", "5 hours/week", "time commitment, with --goal")
+	aiExportPromptCmd.Flags().StringVar(&exportBackground, "background", "", "additional background context, with --goal")
+	aiExportPromptCmd.Flags().StringVar(&exportTargetLevel, "target-level", "", "target proficiency level, with --skill (defaults to next level up)")
+	aiExportPromptCmd.Flags().StringVar(&exportBudget, "budget", "", "resource budget (free, paid, any), with --skill - defaults to config")
+}
+
+func runAIModels(cmd *cobra.Command, args []string) error {
+	aiConfig := aiService.AIConfig(aiModelsProvider, "")
+	if err := aiConfig.Validate(); err != nil {
+		return fmt.Errorf("AI configuration error: %w", err)
+	}
+
+	client, err := aifactory.NewClient(aiConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize AI client: %w", err)
+	}
+
+	lister, ok := client.(ai.ModelLister)
+	if !ok {
+		return fmt.Errorf("the %s provider does not support listing models", client.Provider())
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	models, err := lister.ListModels(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list models: %w", err)
+	}
+
+	if len(models) == 0 {
+		PrintInfo(fmt.Sprintf("No models available from %s", client.Provider()))
+		return nil
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(models)
+	}
+
+	for _, model := range models {
+		if model.DisplayName != "" {
+			fmt.Printf("  %-40s  %s\n", model.ID, model.DisplayName)
+		} else {
+			fmt.Printf("  %s\n", model.ID)
+		}
+	}
+	return nil
+}
+
+func runAICheck(cmd *cobra.Command, args []string) error {
+	configs := []ai.Config{aiService.AIConfig(aiCheckProvider, "")}
+	if aiCheckProvider == "" {
+		for _, provider := range config.AI.FallbackProviders {
+			configs = append(configs, ai.Config{
+				Provider:         provider,
+				Temperature:      config.AI.Temperature,
+				MaxTokens:        config.AI.MaxTokens,
+				StagedGeneration: config.AI.StagedGeneration,
+				ParallelRequests: config.AI.ParallelRequests,
+			})
+		}
+	}
+
+	failed := 0
+	for i, aiConfig := range configs {
+		if i > 0 {
+			fmt.Println()
+		}
+		if !checkAIProvider(cmd.Context(), aiConfig, i > 0) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("AI check failed for %s", countOf(failed, "provider"))
+	}
+	return nil
+}
+
+// checkAIProvider runs the health check for one provider, printing the
+// result, and reports whether it passed
+func checkAIProvider(ctx context.Context, aiConfig ai.Config, isFallback bool) bool {
+	provider := aiConfig.Provider
+	if isFallback {
+		fmt.Printf("%s (fallback)\n", provider)
+	} else {
+		fmt.Printf("%s\n", provider)
+	}
+
+	env := ai.APIKeyEnvVar(provider)
+	if err := aiConfig.Validate(); err != nil {
+		if env != "" {
+			fmt.Printf("  %s API key: %s is not set\n", glyph.Cross, env)
+		} else {
+			fmt.Printf("  %s %v\n", glyph.Cross, err)
+		}
+		return false
+	}
+	if env != "" {
+		fmt.Printf("  %s API key: found in %s\n", glyph.Check, env)
+	}
+
+	client, err := aifactory.NewClient(aiConfig)
+	if err != nil {
+		fmt.Printf("  %s Client: %v\n", glyph.Cross, err)
+		return false
+	}
+
+	checker, ok := client.(ai.HealthChecker)
+	if !ok {
+		fmt.Printf("  %s Test generation: not supported by %s\n", glyph.Cross, provider)
+		return false
+	}
+	fmt.Printf("  %s Model: %s\n", glyph.Bullet, checker.Model())
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err = checker.Check(ctx)
+	latency := time.Since(start)
+	if err != nil {
+		fmt.Printf("  %s Test generation failed after %s: %s\n", glyph.Cross, latency.Round(time.Millisecond), diagnoseAIError(err))
+		return false
+	}
+
+	fmt.Printf("  %s Test generation: responded in %s\n", glyph.Check, latency.Round(time.Millisecond))
+	return true
+}
+
+func runAIExportPrompt(cmd *cobra.Command, args []string) error {
+	switch {
+	case exportGoal != "" && exportSkill != "":
+		return fmt.Errorf("use either --goal or --skill, not both")
+	case exportGoal != "":
+		req, err := aiService.BuildPathRequest(service.PathGenerationOptions{
+			GoalID:         core.EntityID(exportGoal),
+			Style:          exportStyle,
+			TimeCommitment: exportTime,
+			Background:     exportBackground,
+		})
+		if err != nil {
+			return err
+		}
+		return printPrompt(gemini.PathGenerationPrompt, req)
+	case exportSkill != "":
+		req, err := aiService.BuildResourceRequest(service.ResourceSuggestionOptions{
+			SkillID:     core.EntityID(exportSkill),
+			TargetLevel: core.ProficiencyLevel(exportTargetLevel),
+			Style:       exportStyle,
+			Budget:      exportBudget,
+		})
+		if err != nil {
+			return err
+		}
+		return printPrompt(gemini.ResourceSuggestionPrompt, req)
+	default:
+		return fmt.Errorf("specify --goal for a learning path prompt or --skill for a resource suggestion prompt")
+	}
+}
+
+// diagnoseAIError turns a provider error into a short explanation with a hint
+func diagnoseAIError(err error) string {
+	msg := err.Error()
+	lower := strings.ToLower(msg)
+
+	var apiErr *ai.APIError
+	status := 0
+	if errors.As(err, &apiErr) {
+		status = apiErr.StatusCode
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || strings.Contains(lower, "deadline exceeded") || strings.Contains(lower, "timeout"):
+		return "timed out waiting for the provider"
+	case errors.Is(err, ai.ErrRateLimitExceeded) || status == 429 ||
+		strings.Contains(lower, "quota") || strings.Contains(lower, "resource_exhausted") || strings.Contains(lower, "rate limit"):
+		return "quota or rate limit exceeded - check your plan and billing, or try again later (" + msg + ")"
+	case status == 401 || status == 403 ||
+		strings.Contains(lower, "api key not valid") || strings.Contains(lower, "incorrect api key") || strings.Contains(lower, "permission_denied"):
+		return "the API key was rejected - check that it is correct and active (" + msg + ")"
+	case status == 404 || strings.Contains(lower, "not found"):
+		return "model not found - see 'growth ai models' for the available ones (" + msg + ")"
+	default:
+		return msg
+	}
+}
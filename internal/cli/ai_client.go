@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+)
+
+// printPrompt renders a prompt template with its request and writes it to
+// stdout, so it can be reviewed or run by hand without calling a provider
+func printPrompt(promptTemplate string, req interface{}) error {
+	prompt, err := gemini.RenderPrompt(promptTemplate, req)
+	if err != nil {
+		return fmt.Errorf("failed to render prompt: %w", err)
+	}
+	fmt.Println(strings.TrimRight(prompt, "\n"))
+	return nil
+}
+
+// printPathPrompts prints the prompts path generation sends, which depend on
+// ai.stagedGeneration
+func printPathPrompts(req ai.PathGenerationRequest) error {
+	prompt, err := gemini.RenderPathPrompts(req, config.AI.StagedGeneration)
+	if err != nil {
+		return fmt.Errorf("failed to render prompt: %w", err)
+	}
+	fmt.Println(strings.TrimRight(prompt, "\n"))
+	return nil
+}
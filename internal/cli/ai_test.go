@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseAIError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"openai quota", &ai.APIError{Provider: "openai", StatusCode: 429, Message: "You exceeded your current quota", Err: ai.ErrRateLimitExceeded}, "quota or rate limit exceeded"},
+		{"gemini quota", &ai.APIError{Provider: "gemini", Message: "API call failed", Err: errors.New("googleapi: Error 429: RESOURCE_EXHAUSTED")}, "quota or rate limit exceeded"},
+		{"rejected key", &ai.APIError{Provider: "openai", StatusCode: 401, Message: "Incorrect API key provided"}, "API key was rejected"},
+		{"gemini invalid key", &ai.APIError{Provider: "gemini", Message: "API call failed", Err: errors.New("googleapi: Error 400: API key not valid")}, "API key was rejected"},
+		{"unknown model", &ai.APIError{Provider: "openai", StatusCode: 404, Message: "The model `gpt-9` does not exist"}, "model not found"},
+		{"timeout", fmt.Errorf("call: %w", context.DeadlineExceeded), "timed out"},
+		{"other", errors.New("connection refused"), "connection refused"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Contains(t, diagnoseAIError(tt.err), tt.want)
+		})
+	}
+}
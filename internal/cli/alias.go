@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Show command aliases",
+	Long: `Command aliases turn frequent workflows into short commands. Define them
+under aliases in .growth/config.yml:
+
+  aliases:
+    pl: progress log --hours
+    today: overview --format table
+
+Running 'growth pl 2' then runs 'growth progress log --hours 2'. Extra
+arguments are appended to the expanded command. Built-in commands always
+take precedence over aliases with the same name.`,
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List command aliases",
+	Long: `List the command aliases defined in the config file.
+
+Examples:
+  growth alias list`,
+	Aliases: []string{"ls"},
+	RunE:    runAliasList,
+}
+
+func init() {
+	rootCmd.AddCommand(aliasCmd)
+	aliasCmd.AddCommand(aliasListCmd)
+}
+
+func runAliasList(cmd *cobra.Command, args []string) error {
+	if len(config.Aliases) == 0 {
+		PrintInfo("No aliases defined. Add them under 'aliases' in .growth/config.yml")
+		return nil
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(config.Aliases)
+	}
+
+	names := make([]string, 0, len(config.Aliases))
+	for name := range config.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		note := ""
+		if isBuiltinCommand(name) {
+			note = "  (shadowed by built-in command)"
+		}
+		fmt.Printf("%-12s  growth %s%s\n", name, config.Aliases[name], note)
+	}
+
+	return nil
+}
+
+// takesGlobalValue reports whether arg is a persistent flag whose value is
+// the next argument, as in "--timeout 30s" but not "--timeout=30s" or "-v"
+func takesGlobalValue(arg string) bool {
+	if strings.Contains(arg, "=") {
+		return false
+	}
+
+	var flag *pflag.Flag
+	switch {
+	case strings.HasPrefix(arg, "--"):
+		flag = rootCmd.PersistentFlags().Lookup(arg[2:])
+	case len(arg) == 2:
+		flag = rootCmd.PersistentFlags().ShorthandLookup(arg[1:])
+	}
+	return flag != nil && flag.NoOptDefVal == "" && flag.Value.Type() != "bool"
+}
+
+// expandAliases replaces an alias used as the command name in args with the
+// command line it stands for. Built-in commands are never expanded.
+func expandAliases(args []string, aliases map[string]string, builtin func(string) bool) ([]string, error) {
+	if len(aliases) == 0 {
+		return args, nil
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return args, nil
+		}
+		if strings.HasPrefix(arg, "-") {
+			if takesGlobalValue(arg) {
+				i++
+			}
+			continue
+		}
+
+		command, ok := aliases[arg]
+		if !ok || builtin(arg) {
+			return args, nil
+		}
+
+		words, err := splitCommandLine(command)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alias '%s': %w", arg, err)
+		}
+
+		expanded := make([]string, 0, len(args)+len(words))
+		expanded = append(expanded, args[:i]...)
+		expanded = append(expanded, words...)
+		expanded = append(expanded, args[i+1:]...)
+		return expanded, nil
+	}
+
+	return args, nil
+}
+
+// splitCommandLine splits an alias command into words, honouring single and
+// double quotes
+func splitCommandLine(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	var quote rune
+	inWord := false
+
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+func isBuiltinCommand(name string) bool {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return name == "help" || name == "completion"
+}
+
+// loadAliases reads the aliases from the config file before the command line
+// is parsed, honouring --config and --repo in args
+func loadAliases(args []string) map[string]string {
+	var cfg, repo string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		for _, flag := range []string{"--config", "--repo"} {
+			value := ""
+			switch {
+			case arg == flag && i+1 < len(args):
+				value = args[i+1]
+			case strings.HasPrefix(arg, flag+"="):
+				value = strings.TrimPrefix(arg, flag+"=")
+			default:
+				continue
+			}
+			if flag == "--config" {
+				cfg = value
+			} else {
+				repo = value
+			}
+		}
+	}
+
+	if cfg == "" {
+		if repo == "" {
+			cwd, err := os.Getwd()
+			if err != nil {
+				return nil
+			}
+			repo = cwd
+		}
+		cfg = filepath.Join(repo, ".growth", "config.yml")
+	}
+
+	loaded, err := storage.LoadConfig(cfg)
+	if err != nil {
+		return nil
+	}
+	return loaded.Aliases
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAliases(t *testing.T) {
+	aliases := map[string]string{
+		"pl":    "progress log --hours",
+		"nt":    `note create --title "Quick note"`,
+		"skill": "skill list",
+	}
+	builtin := func(name string) bool { return name == "skill" || name == "progress" }
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{"expands alias", []string{"pl", "2"}, []string{"progress", "log", "--hours", "2"}},
+		{"keeps quoted words", []string{"nt"}, []string{"note", "create", "--title", "Quick note"}},
+		{"skips global flags", []string{"--repo", "/tmp/g", "-v", "pl", "1"}, []string{"--repo", "/tmp/g", "-v", "progress", "log", "--hours", "1"}},
+		{"skips flag values", []string{"--timeout", "30s", "--ai-replay", "fixtures", "pl", "1"}, []string{"--timeout", "30s", "--ai-replay", "fixtures", "progress", "log", "--hours", "1"}},
+		{"skips flags with inline values", []string{"--timeout=30s", "-f", "json", "pl", "1"}, []string{"--timeout=30s", "-f", "json", "progress", "log", "--hours", "1"}},
+		{"built-in commands win", []string{"skill", "create"}, []string{"skill", "create"}},
+		{"unknown command", []string{"goal", "list"}, []string{"goal", "list"}},
+		{"only the command name", []string{"progress", "pl"}, []string{"progress", "pl"}},
+		{"no args", []string{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := expandAliases(tt.args, aliases, builtin)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("rejects unterminated quotes", func(t *testing.T) {
+		_, err := expandAliases([]string{"bad"}, map[string]string{"bad": `note create --title "oops`}, builtin)
+		assert.Error(t, err)
+	})
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	words, err := splitCommandLine(`  resource list --status 'in-progress'  -t "" `)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"resource", "list", "--status", "in-progress", "-t", ""}, words)
+}
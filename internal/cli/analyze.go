@@ -6,15 +6,18 @@ import (
 	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
-	"github.com/illenko/growth.md/internal/aifactory"
+	"github.com/illenko/growth.md/internal/ai/gemini"
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/service"
 	"github.com/spf13/cobra"
 )
 
 var (
-	analyzeProvider string
-	analyzeModel    string
-	analyzeDays     int
+	analyzeProvider    string
+	analyzeModel       string
+	analyzeDays        int
+	analyzePrintPrompt bool
 )
 
 var analyzeCmd = &cobra.Command{
@@ -29,7 +32,8 @@ Examples:
   growth analyze                  # Overall analysis
   growth analyze goal-001         # Goal-specific analysis
   growth analyze --days 60        # Analyze last 60 days
-  growth analyze goal-001 --provider gemini`,
+  growth analyze goal-001 --provider gemini
+  growth analyze goal-001 --print-prompt`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runAnalyze,
 }
@@ -40,142 +44,82 @@ func init() {
 	analyzeCmd.Flags().StringVar(&analyzeProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
 	analyzeCmd.Flags().StringVar(&analyzeModel, "model", "", "model override - defaults to config")
 	analyzeCmd.Flags().IntVar(&analyzeDays, "days", 30, "number of days to analyze")
+	analyzeCmd.Flags().BoolVar(&analyzePrintPrompt, "print-prompt", false, "print the prompt that would be sent and exit")
 }
 
 func runAnalyze(cmd *cobra.Command, args []string) error {
-	var goal *core.Goal
-	var path *core.LearningPath
-	var err error
-
-	// Load goal if specified
+	var goalID core.EntityID
 	if len(args) > 0 {
-		goalID := core.EntityID(args[0])
-		goal, err = goalRepo.GetByIDWithBody(goalID)
-		if err != nil {
-			return fmt.Errorf("goal '%s' not found: %w", goalID, err)
-		}
-
-		// Load associated learning path if exists
-		if len(goal.LearningPaths) > 0 {
-			path, err = pathRepo.GetByIDWithBody(goal.LearningPaths[0])
-			if err != nil {
-				// Non-fatal: can analyze without path
-				PrintWarning(fmt.Sprintf("Could not load learning path: %v", err))
-			}
-		}
+		goalID = core.EntityID(args[0])
 	}
 
-	// Load recent progress logs
-	allProgress, err := progressRepo.GetAll()
+	req, err := aiService.BuildAnalysisRequest(service.ProgressAnalysisOptions{GoalID: goalID, Days: analyzeDays})
 	if err != nil {
-		return fmt.Errorf("failed to load progress logs: %w", err)
-	}
-
-	// Filter progress logs by date
-	cutoffDate := time.Now().AddDate(0, 0, -analyzeDays)
-	var recentProgress []*core.ProgressLog
-	for _, p := range allProgress {
-		if p.Date.After(cutoffDate) {
-			recentProgress = append(recentProgress, p)
-		}
+		return err
 	}
 
-	if len(recentProgress) == 0 {
-		return fmt.Errorf("no progress logs found in the last %d days", analyzeDays)
+	if analyzePrintPrompt {
+		return printPrompt(gemini.ProgressAnalysisPrompt, req)
 	}
 
-	// Load current skills
-	skills, err := skillRepo.GetAll()
+	client, err := aiService.NewClient(analyzeProvider, analyzeModel)
 	if err != nil {
-		return fmt.Errorf("failed to load skills: %w", err)
-	}
-
-	// Initialize AI client - use config defaults, allow flags to override
-	provider := config.AI.Provider
-	if analyzeProvider != "" {
-		provider = analyzeProvider
-	}
-
-	model := config.AI.Model
-	if analyzeModel != "" {
-		model = analyzeModel
-	}
-
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: config.AI.Temperature,
-		MaxTokens:   config.AI.MaxTokens,
-	}
-
-	if err := aiConfig.Validate(); err != nil {
-		return fmt.Errorf("AI configuration error: %w", err)
-	}
-
-	client, err := aifactory.NewClient(aiConfig)
-	if err != nil {
-		return fmt.Errorf("failed to initialize AI client: %w", err)
+		return err
 	}
 
 	// Show progress
-	fmt.Println("🤖 Progress Analysis")
-	if goal != nil {
-		fmt.Printf("   Goal: %s\n", goal.Title)
-		if path != nil {
-			fmt.Printf("   Path: %s\n", path.Title)
+	fmt.Println(glyph.Emoji("🤖") + "Progress Analysis")
+	if req.Goal != nil {
+		fmt.Printf("   Goal: %s\n", req.Goal.Title)
+		if req.Path != nil {
+			fmt.Printf("   Path: %s\n", req.Path.Title)
 		}
 	} else {
 		fmt.Println("   Scope: Overall Progress")
 	}
 	fmt.Printf("   Period: Last %d days\n", analyzeDays)
-	fmt.Printf("   Progress Logs: %d\n", len(recentProgress))
+	fmt.Printf("   Progress Logs: %d\n", len(req.ProgressLogs))
 	fmt.Printf("   Provider: %s\n", client.Provider())
 	fmt.Println()
-	fmt.Println("⏳ Analyzing your learning journey...")
-
-	// Create analysis request
-	req := ai.ProgressAnalysisRequest{
-		Goal:          goal,
-		Path:          path,
-		ProgressLogs:  recentProgress,
-		CurrentSkills: skills,
-	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	// Long histories take a request per month, so allow more than one call
+	ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Minute)
 	defer cancel()
 
-	resp, err := client.AnalyzeProgress(ctx, req)
+	progress := startSpinner("Analyzing your learning journey")
+	resp, err := service.RunAnalysis(ctx, client, req)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("failed to analyze progress: %w", err)
 	}
 
 	// Display analysis
-	displayProgressAnalysis(resp, len(recentProgress))
+	displayProgressAnalysis(resp, len(req.ProgressLogs))
 
 	return nil
 }
 
 func displayProgressAnalysis(resp *ai.ProgressAnalysisResponse, logCount int) {
 	fmt.Println()
-	PrintSuccess("✨ Analysis Complete!")
+	PrintSuccess(glyph.Emoji("✨") + "Analysis Complete!")
 	fmt.Println()
 
 	// Summary
-	fmt.Println("📊 SUMMARY")
+	fmt.Println(glyph.Emoji("📊") + "SUMMARY")
 	fmt.Printf("   %s\n", resp.Summary)
 	fmt.Println()
 
 	// On track status
 	if resp.IsOnTrack {
-		fmt.Println("✅ Status: On Track")
+		fmt.Println(glyph.Emoji("✅") + "Status: On Track")
 	} else {
-		fmt.Println("⚠️  Status: Needs Attention")
+		fmt.Println(glyph.Emoji("⚠️ ") + "Status: Needs Attention")
 	}
 	fmt.Println()
 
 	// Insights
 	if len(resp.Insights) > 0 {
-		fmt.Println("💡 KEY INSIGHTS")
+		fmt.Println(glyph.Emoji("💡") + "KEY INSIGHTS")
 		for i, insight := range resp.Insights {
 			fmt.Printf("   %d. %s\n", i+1, insight)
 		}
@@ -184,7 +128,7 @@ func displayProgressAnalysis(resp *ai.ProgressAnalysisResponse, logCount int) {
 
 	// Recommendations
 	if len(resp.Recommendations) > 0 {
-		fmt.Println("🎯 RECOMMENDATIONS")
+		fmt.Println(glyph.Emoji("🎯") + "RECOMMENDATIONS")
 		for i, rec := range resp.Recommendations {
 			fmt.Printf("   %d. %s\n", i+1, rec)
 		}
@@ -193,12 +137,12 @@ func displayProgressAnalysis(resp *ai.ProgressAnalysisResponse, logCount int) {
 
 	// Suggested focus
 	if len(resp.SuggestedFocus) > 0 {
-		fmt.Println("🔍 SUGGESTED FOCUS AREAS")
+		fmt.Println(glyph.Emoji("🔍") + "SUGGESTED FOCUS AREAS")
 		for _, focus := range resp.SuggestedFocus {
-			fmt.Printf("   • %s\n", focus)
+			fmt.Printf("   %s %s\n", glyph.Bullet, focus)
 		}
 		fmt.Println()
 	}
 
-	fmt.Printf("💾 Based on %d progress log(s) from the last %d days\n", logCount, analyzeDays)
+	fmt.Printf("%sBased on %d progress log(s) from the last %d days\n", glyph.Emoji("💾"), logCount, analyzeDays)
 }
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/git"
+	"github.com/spf13/cobra"
+)
+
+var attachForce bool
+
+// openAttachment is set by the --open flag on view commands
+var openAttachment string
+
+var attachCmd = &cobra.Command{
+	Use:   "attach <id> <file>...",
+	Short: "Attach files to a skill, goal, path, resource or milestone",
+	Long: `Copy files into attachments/<id>/ and record them in the entity's frontmatter.
+
+Attachments are listed by the view command of the entity and can be opened
+with its --open flag.
+
+Examples:
+  growth attach milestone-001 ./certificate.pdf
+  growth attach resource-003 notes.pdf diagram.png
+  growth milestone view milestone-001 --open certificate.pdf`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runAttach,
+}
+
+func init() {
+	rootCmd.AddCommand(attachCmd)
+
+	attachCmd.Flags().BoolVar(&attachForce, "force", false, "replace attachments with the same name")
+}
+
+func runAttach(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	entity, save, err := attachableEntity(id)
+	if err != nil {
+		return err
+	}
+
+	var copied []string
+	for _, src := range args[1:] {
+		name := filepath.Base(src)
+		if _, exists := entity.GetAttachments().Find(name); exists && !attachForce {
+			return fmt.Errorf("%s already has an attachment named '%s'. Use --force to replace it", id, name)
+		}
+
+		relPath := core.AttachmentPath(id, name)
+		if err := copyFile(src, filepath.Join(repoPath, filepath.FromSlash(relPath))); err != nil {
+			return fmt.Errorf("failed to attach %s: %w", src, err)
+		}
+
+		entity.AddAttachment(core.Attachment{Name: name, Path: relPath, Added: time.Now()})
+		copied = append(copied, relPath)
+	}
+
+	// Stage the files so the entity's auto-commit includes them
+	if config.Git.AutoCommit && config.Git.CommitOnUpdate && database == nil {
+		if err := git.AddContext(cmd.Context(), repoPath, copied); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to stage attachments: %v", err))
+		}
+	}
+
+	if err := save(); err != nil {
+		return fmt.Errorf("failed to update %s: %w", id, err)
+	}
+
+	for _, path := range copied {
+		PrintSuccess(fmt.Sprintf("Attached %s to %s", path, id))
+	}
+	return nil
+}
+
+// attachableEntity loads an entity that supports attachments by its ID
+// prefix, returning it with a function that saves it
+func attachableEntity(id core.EntityID) (core.Attachable, func() error, error) {
+	kind, _, _ := strings.Cut(string(id), "-")
+	switch kind {
+	case "skill":
+		skill, err := skillRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+		}
+		return skill, func() error { return skillRepo.Update(skill) }, nil
+	case "goal":
+		goal, err := goalRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+		}
+		return goal, func() error { return goalRepo.Update(goal) }, nil
+	case "path":
+		path, err := pathRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+		}
+		return path, func() error { return pathRepo.Update(path) }, nil
+	case "resource":
+		resource, err := resourceRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
+		}
+		return resource, func() error { return resourceRepo.Update(resource) }, nil
+	case "milestone":
+		milestone, err := milestoneRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("milestone '%s' not found. Use 'growth milestone list' to see available milestones", id)
+		}
+		return milestone, func() error { return milestoneRepo.Update(milestone) }, nil
+	}
+
+	return nil, nil, fmt.Errorf("cannot attach files to '%s': only skills, goals, paths, resources and milestones have attachments", id)
+}
+
+// copyFile copies a regular file, creating the destination directory
+func copyFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", src)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// printAttachments lists an entity's attachments in view output
+func printAttachments(attachments core.Attachments) {
+	if len(attachments) == 0 {
+		return
+	}
+
+	fmt.Println("\nAttachments:")
+	for _, attachment := range attachments {
+		fmt.Printf("  %s  %s\n", formatDate(attachment.Added), attachment.Path)
+	}
+}
+
+// openEntityAttachment opens the named attachment with the system's default
+// application
+func openEntityAttachment(attachments core.Attachments, name string) error {
+	attachment, ok := attachments.Find(name)
+	if !ok {
+		return fmt.Errorf("no attachment named '%s'", name)
+	}
+
+	path := filepath.Join(repoPath, filepath.FromSlash(attachment.Path))
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("attachment file missing: %w", err)
+	}
+
+	var opener *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		opener = exec.Command("open", path)
+	case "windows":
+		opener = exec.Command("cmd", "/c", "start", "", path)
+	default:
+		opener = exec.Command("xdg-open", path)
+	}
+	if err := opener.Start(); err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	PrintInfo(fmt.Sprintf("Opened %s", attachment.Path))
+	return nil
+}
@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/catalog"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+// catalogTimeout bounds each catalog search
+const catalogTimeout = 20 * time.Second
+
+var (
+	catalogQuery string
+	catalogName  string
+	catalogLimit int
+	catalogSave  bool
+)
+
+var skillSearchCatalogCmd = &cobra.Command{
+	Use:   "search-catalog <skill-id>",
+	Short: "Search your subscription catalogs for resources",
+	Long: `Search the catalogs of the learning subscriptions you already have for
+resources on a skill, as an alternative to AI suggestions.
+
+Catalogs come from ai.subscriptions in config.yml; supported ones are
+oreilly and pluralsight. Pluralsight needs an API key in the
+PLURALSIGHT_API_KEY environment variable. The skill title is the default
+search query.
+
+With --save, results become not-started resources for the skill, tagged
+with the catalog name. Results whose URL is already tracked are skipped.
+
+Examples:
+  growth skill search-catalog skill-001
+  growth skill search-catalog skill-001 --catalog oreilly --query "kubernetes operators"
+  growth skill search-catalog skill-001 --limit 5 --save`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSkillSearchCatalog,
+}
+
+func init() {
+	skillCmd.AddCommand(skillSearchCatalogCmd)
+
+	skillSearchCatalogCmd.Flags().StringVarP(&catalogQuery, "query", "q", "", "search query (defaults to the skill title)")
+	skillSearchCatalogCmd.Flags().StringVar(&catalogName, "catalog", "", "catalog to search (oreilly, pluralsight) - defaults to ai.subscriptions")
+	skillSearchCatalogCmd.Flags().IntVar(&catalogLimit, "limit", 10, "maximum results per catalog")
+	skillSearchCatalogCmd.Flags().BoolVar(&catalogSave, "save", false, "save results as resources")
+}
+
+func runSkillSearchCatalog(cmd *cobra.Command, args []string) error {
+	skillID := core.EntityID(args[0])
+
+	skill, err := skillRepo.GetByID(skillID)
+	if err != nil {
+		return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", skillID)
+	}
+
+	if catalogLimit < 1 {
+		return fmt.Errorf("--limit must be at least 1")
+	}
+
+	names, err := catalogNames(catalogName, config.AI.Subscriptions)
+	if err != nil {
+		return err
+	}
+
+	query := catalogQuery
+	if query == "" {
+		query = skill.Title
+	}
+
+	client := &http.Client{Timeout: catalogTimeout}
+	var results []catalog.Result
+	for _, name := range names {
+		provider, err := catalog.NewProvider(name, client)
+		if err != nil {
+			PrintWarning(err.Error())
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), catalogTimeout)
+		progress := startSpinner("Searching " + name)
+		found, err := provider.Search(ctx, query, catalogLimit)
+		progress.Stop()
+		cancel()
+		if err != nil {
+			PrintWarning(err.Error())
+			continue
+		}
+		results = append(results, found...)
+	}
+
+	if len(results) == 0 {
+		PrintInfo(fmt.Sprintf("No catalog results for '%s'", query))
+		return nil
+	}
+
+	fmt.Printf("%sCatalog results for: %s\n\n", glyph.Emoji("🔎"), query)
+	saved := 0
+	for i, result := range results {
+		fmt.Printf("%d. %s\n", i+1, result.Title)
+		fmt.Printf("   Catalog: %s | Type: %s", result.Catalog, result.Type)
+		if result.EstimatedHours > 0 {
+			fmt.Printf(" | Estimated Hours: %s", formatNumber(result.EstimatedHours, 1))
+		}
+		fmt.Println()
+		if result.Author != "" {
+			fmt.Printf("   Author: %s\n", result.Author)
+		}
+		if result.URL != "" {
+			fmt.Printf("   URL: %s\n", result.URL)
+		}
+
+		if catalogSave {
+			id, err := saveCatalogResult(result, skill.ID)
+			if err != nil {
+				PrintWarning(err.Error())
+			} else if id == "" {
+				fmt.Println("   Already tracked")
+			} else {
+				fmt.Printf("   ID: %s\n", id)
+				saved++
+			}
+		}
+		fmt.Println()
+	}
+
+	if catalogSave {
+		PrintSuccess(fmt.Sprintf("Saved %s for %s", countOf(saved, "resource"), skill.Title))
+	} else {
+		fmt.Println(glyph.Emoji("💾") + "Tip: Use --save flag to save these resources to your repository")
+	}
+	return nil
+}
+
+// catalogNames returns the catalogs to search: the one named by --catalog,
+// or the supported entries of the configured subscriptions
+func catalogNames(flag string, subscriptions []string) ([]string, error) {
+	if flag != "" {
+		if !catalog.IsSupported(flag) {
+			return nil, fmt.Errorf("unsupported catalog '%s'. Valid options: %s", flag, strings.Join(catalog.Names, ", "))
+		}
+		return []string{flag}, nil
+	}
+
+	var names []string
+	for _, subscription := range subscriptions {
+		if catalog.IsSupported(subscription) {
+			names = append(names, subscription)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no searchable subscriptions configured. Add oreilly or pluralsight to ai.subscriptions in config.yml, or pass --catalog")
+	}
+	return names, nil
+}
+
+// saveCatalogResult creates a resource for the result and returns its ID,
+// or an empty ID when a resource with the same URL already exists
+func saveCatalogResult(result catalog.Result, skillID core.EntityID) (core.EntityID, error) {
+	if result.URL != "" {
+		existing, err := resourceRepo.FindByURL(result.URL)
+		if err != nil {
+			return "", fmt.Errorf("failed to check for duplicate resources: %w", err)
+		}
+		if len(existing) > 0 {
+			return "", nil
+		}
+	}
+
+	id, err := GenerateNextID("resource")
+	if err != nil {
+		return "", fmt.Errorf("failed to generate resource ID: %w", err)
+	}
+
+	resource, err := result.ToResource(id, skillID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create resource '%s': %w", result.Title, err)
+	}
+	if err := links.CreateResource(resource); err != nil {
+		return "", fmt.Errorf("failed to save resource '%s': %w", result.Title, err)
+	}
+	return resource.ID, nil
+}
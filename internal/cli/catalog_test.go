@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogNames(t *testing.T) {
+	t.Run("uses the supported subscriptions", func(t *testing.T) {
+		names, err := catalogNames("", []string{"O'Reilly", "udemy", "pluralsight"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"O'Reilly", "pluralsight"}, names)
+	})
+
+	t.Run("flag overrides subscriptions", func(t *testing.T) {
+		names, err := catalogNames("pluralsight", []string{"oreilly"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pluralsight"}, names)
+	})
+
+	t.Run("errors without searchable catalogs", func(t *testing.T) {
+		_, err := catalogNames("", []string{"udemy"})
+		assert.ErrorContains(t, err, "no searchable subscriptions")
+
+		_, err = catalogNames("udemy", nil)
+		assert.ErrorContains(t, err, "unsupported catalog 'udemy'")
+	})
+}
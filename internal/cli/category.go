@@ -0,0 +1,312 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+var categoryFromSkills bool
+
+var categoryCmd = &cobra.Command{
+	Use:   "category",
+	Short: "Manage skill categories",
+	Long: `Keep a managed list of skill categories to avoid typos and near-duplicates
+such as "backend" and "back-end".
+
+The list is stored in .growth/categories.yml. In strict mode, creating or
+editing a skill with a category that is not on the list fails.`,
+}
+
+var categoryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List categories",
+	Long: `List managed categories with the number of skills in each. Categories used
+by skills but missing from the list are shown as unmanaged.
+
+Examples:
+  growth category list`,
+	Aliases: []string{"ls"},
+	RunE:    runCategoryList,
+}
+
+var categoryAddCmd = &cobra.Command{
+	Use:   "add [name...]",
+	Short: "Add categories to the managed list",
+	Long: `Add one or more categories to the managed list.
+
+Examples:
+  growth category add backend frontend devops
+  growth category add "machine learning"
+  growth category add --from-skills`,
+	RunE: runCategoryAdd,
+}
+
+var categoryRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a category",
+	Long: `Rename a category in the managed list and in every skill that uses it.
+Renaming onto an existing category merges the two.
+
+The old name does not need to be on the list, so this also fixes typos
+in skills.
+
+Examples:
+  growth category rename back-end backend
+  growth category rename "ml" "machine learning"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCategoryRename,
+}
+
+var categoryStrictCmd = &cobra.Command{
+	Use:   "strict <on|off>",
+	Short: "Turn strict category checking on or off",
+	Long: `In strict mode, skill create and skill edit reject categories that are not
+on the managed list.
+
+Examples:
+  growth category strict on
+  growth category strict off`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"on", "off"},
+	RunE:      runCategoryStrict,
+}
+
+func init() {
+	rootCmd.AddCommand(categoryCmd)
+	categoryCmd.AddCommand(categoryListCmd)
+	categoryCmd.AddCommand(categoryAddCmd)
+	categoryCmd.AddCommand(categoryRenameCmd)
+	categoryCmd.AddCommand(categoryStrictCmd)
+
+	categoryAddCmd.Flags().BoolVar(&categoryFromSkills, "from-skills", false, "add every category currently used by skills")
+}
+
+func runCategoryList(cmd *cobra.Command, args []string) error {
+	list, err := loadCategories()
+	if err != nil {
+		return err
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	counts := make(map[string]int)
+	var unmanaged []string
+	for _, skill := range skills {
+		category, ok := list.Find(skill.Category)
+		if !ok {
+			category = skill.Category
+			if counts[category] == 0 {
+				unmanaged = append(unmanaged, category)
+			}
+		}
+		counts[category]++
+	}
+	sort.Strings(unmanaged)
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(list)
+	}
+
+	if len(list.Categories) == 0 && len(unmanaged) == 0 {
+		PrintInfo("No categories found. Add some with 'growth category add <name>'")
+		return nil
+	}
+
+	for _, category := range list.Categories {
+		fmt.Printf("%-30s  %d skills\n", truncate(category, 30), counts[category])
+	}
+
+	if len(unmanaged) > 0 {
+		fmt.Println("\nUnmanaged (used by skills, not on the list):")
+		for _, category := range unmanaged {
+			fmt.Printf("%-30s  %d skills\n", truncate(category, 30), counts[category])
+		}
+	}
+
+	mode := "off"
+	if list.Strict {
+		mode = "on"
+	}
+	fmt.Printf("\nStrict mode: %s\n", mode)
+
+	return nil
+}
+
+func runCategoryAdd(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && !categoryFromSkills {
+		return fmt.Errorf("specify at least one category name, or use --from-skills")
+	}
+
+	list, err := loadCategories()
+	if err != nil {
+		return err
+	}
+
+	names := args
+	if categoryFromSkills {
+		skills, err := skillRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to load skills: %w", err)
+		}
+		for _, skill := range skills {
+			names = append(names, skill.Category)
+		}
+	}
+
+	var added []string
+	for _, name := range names {
+		if _, exists := list.Find(name); exists && categoryFromSkills {
+			continue
+		}
+		if err := list.Add(name); err != nil {
+			return err
+		}
+		added = append(added, strings.TrimSpace(name))
+	}
+
+	if len(added) == 0 {
+		PrintInfo("No new categories to add")
+		return nil
+	}
+
+	if err := storage.SaveCategories(list, categoriesPath()); err != nil {
+		return fmt.Errorf("failed to save categories: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Added %d categories: %s", len(added), strings.Join(added, ", ")))
+	return nil
+}
+
+func runCategoryRename(cmd *cobra.Command, args []string) error {
+	from, to := args[0], strings.TrimSpace(args[1])
+	if to == "" {
+		return fmt.Errorf("new category name cannot be empty")
+	}
+
+	list, err := loadCategories()
+	if err != nil {
+		return err
+	}
+
+	_, managed := list.Find(from)
+	if managed {
+		if err := list.Rename(from, to); err != nil {
+			return err
+		}
+		to, _ = list.Find(to)
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	var renamed int
+	for _, skill := range skills {
+		if !strings.EqualFold(skill.Category, from) || skill.Category == to {
+			continue
+		}
+		full, err := skillRepo.GetByIDWithBody(skill.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load skill %s: %w", skill.ID, err)
+		}
+		full.Category = to
+		full.Touch()
+		if err := skillRepo.Update(full); err != nil {
+			return fmt.Errorf("failed to update skill %s: %w", skill.ID, err)
+		}
+		renamed++
+	}
+
+	if !managed && renamed == 0 {
+		return fmt.Errorf("category '%s' not found. Use 'growth category list' to see available categories", from)
+	}
+
+	if managed {
+		if err := storage.SaveCategories(list, categoriesPath()); err != nil {
+			return fmt.Errorf("failed to save categories: %w", err)
+		}
+	}
+
+	PrintSuccess(fmt.Sprintf("Renamed category '%s' to '%s' (%d skills updated)", from, to, renamed))
+	return nil
+}
+
+func runCategoryStrict(cmd *cobra.Command, args []string) error {
+	var strict bool
+	switch strings.ToLower(args[0]) {
+	case "on", "true":
+		strict = true
+	case "off", "false":
+		strict = false
+	default:
+		return fmt.Errorf("invalid value '%s'. Use 'on' or 'off'", args[0])
+	}
+
+	list, err := loadCategories()
+	if err != nil {
+		return err
+	}
+
+	if strict && len(list.Categories) == 0 {
+		return fmt.Errorf("the category list is empty. Add categories with 'growth category add' before turning on strict mode")
+	}
+
+	list.Strict = strict
+	if err := storage.SaveCategories(list, categoriesPath()); err != nil {
+		return fmt.Errorf("failed to save categories: %w", err)
+	}
+
+	if strict {
+		PrintSuccess("Strict mode on: skills must use a category from the managed list")
+	} else {
+		PrintSuccess("Strict mode off")
+	}
+	return nil
+}
+
+func categoriesPath() string {
+	return filepath.Join(repoPath, ".growth", "categories.yml")
+}
+
+func loadCategories() (*core.CategoryList, error) {
+	list, err := storage.LoadCategories(categoriesPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categories: %w", err)
+	}
+	return list, nil
+}
+
+// checkSkillCategory validates a skill category against the managed list and
+// returns the spelling to store. Unknown categories fail in strict mode and
+// produce a warning otherwise.
+func checkSkillCategory(category string) (string, error) {
+	list, err := loadCategories()
+	if err != nil {
+		return "", err
+	}
+
+	checked, err := list.Check(category)
+	if err != nil {
+		return "", fmt.Errorf("%w. Use 'growth category add' to add it or 'growth category list' to see available categories", err)
+	}
+
+	if _, ok := list.Find(checked); !ok && len(list.Categories) > 0 {
+		if suggestion := list.Suggest(checked); suggestion != "" {
+			PrintWarning(fmt.Sprintf("Category '%s' is not on the managed list (did you mean '%s'?)", checked, suggestion))
+		} else {
+			PrintWarning(fmt.Sprintf("Category '%s' is not on the managed list", checked))
+		}
+	}
+
+	return checked, nil
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration",
+	Long:  `Inspect and check the repository configuration in .growth/config.yml.`,
+	// Only resolve the config path: loading it would warn about the very
+	// problems these commands report
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return resolvePaths()
+	},
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the config file for problems",
+	Long: `Check .growth/config.yml (or the file given with --config) and report every
+problem with its field path, suggestions for likely typos in values such as
+"gemni", and keys that growth does not recognise.
+
+Exits with an error when the config is invalid, so it can run before a commit.
+
+Examples:
+  growth config validate
+  growth config validate --config ~/growth/.growth/config.yml`,
+	RunE: runConfigValidate,
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configValidateCmd)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	problems, warnings, err := storage.CheckConfigFile(cfgFile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", cfgFile, err)
+	}
+
+	for _, warning := range warnings {
+		PrintWarning(warning.Error())
+	}
+
+	for _, problem := range problems {
+		PrintError(problem)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s has %d problem(s)", cfgFile, len(problems))
+	}
+
+	PrintSuccess(fmt.Sprintf("%s is valid", cfgFile))
+	return nil
+}
@@ -0,0 +1,399 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	credentialTitle    string
+	credentialIssuer   string
+	credentialNumber   string
+	credentialIssued   string
+	credentialExpires  string
+	credentialURL      string
+	credentialSkills   string
+	credentialTags     string
+	credentialNotes    string
+	credentialSkill    string
+	credentialExpiring int
+)
+
+// credentialWarningDays is how long before expiry a credential is flagged in growth today
+const credentialWarningDays = 60
+
+var credentialCmd = &cobra.Command{
+	Use:   "credential",
+	Short: "Track certificates and credentials",
+	Long: `Track certificates and credentials: who issued them, when they expire and
+which skills they certify. Credentials that are about to expire are flagged
+in 'growth today' and listed in 'growth export resume'.`,
+	Aliases: []string{"cert"},
+}
+
+var credentialCreateCmd = &cobra.Command{
+	Use:   "create [title]",
+	Short: "Add a credential",
+	Long: `Add a certificate or credential.
+
+Examples:
+  growth credential create "Certified Kubernetes Administrator" --issuer CNCF --issued 2024-05-02 --expires 2026-05-02 --skills skill-002
+  growth credential create "AWS Solutions Architect - Associate" --issuer "Amazon Web Services" --credential-id ABC123 --url https://www.credly.com/badges/abc
+  growth credential create`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCredentialCreate,
+}
+
+var credentialListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List credentials",
+	Long: `List credentials, most recently issued first.
+
+Examples:
+  growth credential list
+  growth credential list --skill skill-002
+  growth credential list --expiring 90`,
+	Aliases: []string{"ls"},
+	RunE:    runCredentialList,
+}
+
+var credentialViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View a credential",
+	Long: `View a credential with its dates, verification link and notes.
+
+Examples:
+  growth credential view credential-001
+  growth credential view credential-001 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCredentialView,
+}
+
+var credentialEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit a credential",
+	Long: `Edit a credential. --skills and --tags replace the current values; an empty
+--expires marks the credential as not expiring.
+
+Examples:
+  growth credential edit credential-001 --expires 2028-05-02
+  growth credential edit credential-001 --expires ""`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCredentialEdit,
+}
+
+var credentialDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a credential",
+	Long: `Delete a credential by ID. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth credential delete credential-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runCredentialDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(credentialCmd)
+	credentialCmd.AddCommand(credentialCreateCmd)
+	credentialCmd.AddCommand(credentialListCmd)
+	credentialCmd.AddCommand(credentialViewCmd)
+	credentialCmd.AddCommand(credentialEditCmd)
+	credentialCmd.AddCommand(credentialDeleteCmd)
+
+	for _, cmd := range []*cobra.Command{credentialCreateCmd, credentialEditCmd} {
+		cmd.Flags().StringVar(&credentialIssuer, "issuer", "", "organization that issued the credential")
+		cmd.Flags().StringVar(&credentialNumber, "credential-id", "", "the issuer's ID for the credential")
+		cmd.Flags().StringVar(&credentialIssued, "issued", "", "issue date (YYYY-MM-DD), defaults to today")
+		cmd.Flags().StringVar(&credentialExpires, "expires", "", "expiry date (YYYY-MM-DD)")
+		cmd.Flags().StringVar(&credentialURL, "url", "", "verification URL")
+		cmd.Flags().StringVar(&credentialSkills, "skills", "", "comma-separated skill IDs the credential certifies")
+		cmd.Flags().StringVar(&credentialTags, "tags", "", "comma-separated tags")
+		cmd.Flags().StringVar(&credentialNotes, "notes", "", "notes, e.g. renewal requirements")
+	}
+	credentialEditCmd.Flags().StringVar(&credentialTitle, "title", "", "credential title")
+
+	credentialListCmd.Flags().StringVar(&credentialSkill, "skill", "", "filter by skill ID")
+	credentialListCmd.Flags().IntVar(&credentialExpiring, "expiring", 0, "only show credentials expired or expiring within this many days")
+}
+
+func runCredentialCreate(cmd *cobra.Command, args []string) error {
+	title := ""
+	if len(args) > 0 {
+		title = args[0]
+	} else {
+		title = PromptStringRequired("Title")
+	}
+
+	issuer := credentialIssuer
+	if issuer == "" {
+		issuer = PromptStringRequired("Issuer")
+	}
+
+	issued := time.Now()
+	if credentialIssued != "" {
+		parsed, err := time.Parse("2006-01-02", credentialIssued)
+		if err != nil {
+			return fmt.Errorf("invalid issue date format (use YYYY-MM-DD): %w", err)
+		}
+		issued = parsed
+	}
+
+	skills, err := credentialSkillIDs()
+	if err != nil {
+		return err
+	}
+
+	id, err := GenerateNextID("credential")
+	if err != nil {
+		return fmt.Errorf("failed to generate credential ID: %w", err)
+	}
+
+	credential, err := core.NewCredential(id, title, issuer, issued)
+	if err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+
+	if credentialExpires != "" {
+		expires, err := time.Parse("2006-01-02", credentialExpires)
+		if err != nil {
+			return fmt.Errorf("invalid expiry date format (use YYYY-MM-DD): %w", err)
+		}
+		if err := credential.SetExpiryDate(expires); err != nil {
+			return err
+		}
+	}
+	credential.CredentialID = credentialNumber
+	credential.URL = credentialURL
+	for _, skillID := range skills {
+		credential.AddSkill(skillID)
+	}
+	for _, tag := range splitList(credentialTags) {
+		credential.AddTag(tag)
+	}
+	credential.Body = credentialNotes
+
+	if err := credentialRepo.Create(credential); err != nil {
+		return fmt.Errorf("failed to save credential: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Added credential %s: %s", credential.ID, credential.Title))
+	return nil
+}
+
+func runCredentialList(cmd *cobra.Command, args []string) error {
+	var credentials []*core.Credential
+	var err error
+
+	if credentialSkill != "" {
+		credentials, err = credentialRepo.FindBySkill(core.EntityID(credentialSkill))
+	} else {
+		credentials, err = credentialRepo.GetAll()
+		sortCredentials(credentials)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+
+	if cmd.Flags().Changed("expiring") {
+		credentials = core.ExpiringCredentials(credentials, time.Now(), credentialExpiring)
+	}
+
+	if len(credentials) == 0 {
+		PrintInfo("No credentials found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		now := time.Now()
+		for _, credential := range credentials {
+			fmt.Printf("%s  %s  %s (%s)", credential.ID, formatDate(credential.IssueDate), credential.Title, credential.Issuer)
+			if credential.ExpiryDate != nil {
+				fmt.Printf("  %s", describeExpiry(credential.DaysUntilExpiry(now)))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(credentials)
+}
+
+func runCredentialView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	credential, err := credentialRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("credential '%s' not found. Use 'growth credential list' to see available credentials", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:            %s\n", credential.ID)
+		fmt.Printf("Title:         %s\n", credential.Title)
+		fmt.Printf("Issuer:        %s\n", credential.Issuer)
+		if credential.CredentialID != "" {
+			fmt.Printf("Credential ID: %s\n", credential.CredentialID)
+		}
+		fmt.Printf("Issued:        %s\n", formatDate(credential.IssueDate))
+		if credential.ExpiryDate != nil {
+			fmt.Printf("Expires:       %s (%s)\n", formatDate(*credential.ExpiryDate), describeExpiry(credential.DaysUntilExpiry(time.Now())))
+		}
+		if credential.URL != "" {
+			fmt.Printf("URL:           %s\n", credential.URL)
+		}
+		if len(credential.Skills) > 0 {
+			fmt.Printf("Skills:        %s\n", formatEntityIDs(credential.Skills))
+		}
+		if len(credential.Tags) > 0 {
+			fmt.Printf("Tags:          %s\n", strings.Join(credential.Tags, ", "))
+		}
+
+		if credential.Body != "" {
+			fmt.Printf("\nNotes:\n%s\n", credential.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(credential)
+}
+
+func runCredentialEdit(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	credential, err := credentialRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("credential '%s' not found. Use 'growth credential list' to see available credentials", id)
+	}
+
+	skills, err := credentialSkillIDs()
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("title") {
+		credential.Title = credentialTitle
+	}
+	if flags.Changed("issuer") {
+		credential.Issuer = credentialIssuer
+	}
+	if flags.Changed("credential-id") {
+		credential.CredentialID = credentialNumber
+	}
+	if flags.Changed("issued") {
+		issued, err := time.Parse("2006-01-02", credentialIssued)
+		if err != nil {
+			return fmt.Errorf("invalid issue date format (use YYYY-MM-DD): %w", err)
+		}
+		credential.IssueDate = issued
+	}
+	if flags.Changed("expires") {
+		if credentialExpires == "" {
+			credential.ClearExpiryDate()
+		} else {
+			expires, err := time.Parse("2006-01-02", credentialExpires)
+			if err != nil {
+				return fmt.Errorf("invalid expiry date format (use YYYY-MM-DD): %w", err)
+			}
+			if err := credential.SetExpiryDate(expires); err != nil {
+				return err
+			}
+		}
+	}
+	if flags.Changed("url") {
+		credential.URL = credentialURL
+	}
+	if flags.Changed("skills") {
+		credential.Skills = skills
+	}
+	if flags.Changed("tags") {
+		credential.Tags = []string{}
+		for _, tag := range splitList(credentialTags) {
+			credential.AddTag(tag)
+		}
+	}
+	if flags.Changed("notes") {
+		credential.Body = credentialNotes
+	}
+
+	credential.Touch()
+	if err := credential.Validate(); err != nil {
+		return fmt.Errorf("invalid credential: %w", err)
+	}
+
+	if err := credentialRepo.Update(credential); err != nil {
+		return fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated credential %s: %s", credential.ID, credential.Title))
+	return nil
+}
+
+func runCredentialDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	credential, err := credentialRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("credential '%s' not found. Use 'growth credential list' to see available credentials", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", credential.ID)
+	fmt.Printf("  Title: %s\n", credential.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this credential?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := credentialRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted credential %s", id))
+	return nil
+}
+
+// credentialSkillIDs parses the --skills flag, verifying that each skill exists
+func credentialSkillIDs() ([]core.EntityID, error) {
+	var skills []core.EntityID
+	for _, id := range splitList(credentialSkills) {
+		exists, err := skillRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return nil, fmt.Errorf("failed to check skill existence: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+		}
+		skills = append(skills, core.EntityID(id))
+	}
+	return skills, nil
+}
+
+// sortCredentials orders credentials most recently issued first
+func sortCredentials(credentials []*core.Credential) {
+	sort.Slice(credentials, func(i, j int) bool {
+		return credentials[i].IssueDate.After(credentials[j].IssueDate)
+	})
+}
+
+// describeExpiry tells how long until, or since, a credential expires given
+// the days until its expiry
+func describeExpiry(days int) string {
+	switch {
+	case days < 0:
+		return fmt.Sprintf("expired %s ago", countOf(-days, "day"))
+	case days == 0:
+		return "expires today"
+	default:
+		return fmt.Sprintf("expires in %s", countOf(days, "day"))
+	}
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/mail"
+	"github.com/illenko/growth.md/internal/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	digestWeek     bool
+	digestDate     string
+	digestOutput   string
+	digestEmail    bool
+	digestNoAI     bool
+	digestProvider string
+	digestModel    string
+)
+
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Generate a weekly digest",
+	Long: `Compose a markdown summary of a week of learning: hours invested, completed
+resources, achieved milestones, your weekly streak, and a suggested focus for
+next week from the AI.
+
+The digest is printed to stdout, written to a file with --output, or sent via
+SMTP with --email. Email settings are read from the email section of
+.growth/config.yml and the password from GROWTH_SMTP_PASSWORD.
+
+Examples:
+  growth digest --week
+  growth digest --week --date 2025-01-06
+  growth digest --week --output digest.md
+  growth digest --week --email
+  growth digest --week --no-ai`,
+	RunE: runDigest,
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+
+	digestCmd.Flags().BoolVar(&digestWeek, "week", true, "summarize a single week (currently the only period)")
+	digestCmd.Flags().StringVar(&digestDate, "date", "", "any date within the week to summarize (YYYY-MM-DD), defaults to this week")
+	digestCmd.Flags().StringVarP(&digestOutput, "output", "o", "", "write the digest to a file")
+	digestCmd.Flags().BoolVar(&digestEmail, "email", false, "send the digest using the email config")
+	digestCmd.Flags().BoolVar(&digestNoAI, "no-ai", false, "skip the AI-suggested focus for next week")
+	digestCmd.Flags().StringVar(&digestProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	digestCmd.Flags().StringVar(&digestModel, "model", "", "model override - defaults to config")
+}
+
+func runDigest(cmd *cobra.Command, args []string) error {
+	if !digestWeek {
+		return fmt.Errorf("only weekly digests are supported, use --week")
+	}
+
+	day := time.Now()
+	if digestDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", digestDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+
+	spawnRecurringMilestones()
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	digest := core.ComputeWeeklyDigest(day, logs, resources, milestones)
+
+	var focus []string
+	if !digestNoAI {
+		focus, err = suggestDigestFocus(cmd.Context(), digest, logs)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Could not get suggested focus: %v", err))
+		}
+	}
+	if len(focus) == 0 {
+		focus = queuedDigestFocus()
+	}
+
+	markdown := renderDigestMarkdown(digest, focus)
+
+	if digestOutput != "" {
+		if err := os.WriteFile(digestOutput, []byte(markdown), 0644); err != nil {
+			return fmt.Errorf("failed to write digest: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote digest to %s", digestOutput))
+	}
+
+	if digestEmail {
+		mailConfig := mail.Config{
+			Host:     config.Email.SMTPHost,
+			Port:     config.Email.SMTPPort,
+			Username: config.Email.Username,
+			From:     config.Email.From,
+			To:       config.Email.To,
+		}
+		if err := mail.Send(mailConfig, digestTitle(digest), markdown); err != nil {
+			return err
+		}
+		PrintSuccess(fmt.Sprintf("Sent digest to %s", strings.Join(config.Email.To, ", ")))
+	}
+
+	if digestOutput == "" && !digestEmail {
+		fmt.Print(markdown)
+	}
+
+	return nil
+}
+
+// suggestDigestFocus asks the AI for next week's focus based on the last 30 days
+func suggestDigestFocus(ctx context.Context, digest core.WeeklyDigest, logs []*core.ProgressLog) ([]string, error) {
+	cutoff := digest.WeekEnd.AddDate(0, 0, -30)
+	recent := slices.ContainsFunc(logs, func(log *core.ProgressLog) bool {
+		return log.Date.After(cutoff) && log.Date.Before(digest.WeekEnd)
+	})
+	if !recent {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	progress := startSpinner("Suggesting next week's focus")
+	resp, err := aiService.AnalyzeProgress(ctx, service.ProgressAnalysisOptions{
+		Days:     30,
+		Until:    digest.WeekEnd,
+		Provider: digestProvider,
+		Model:    digestModel,
+	})
+	progress.Stop()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.SuggestedFocus) > 0 {
+		return resp.SuggestedFocus, nil
+	}
+	return resp.Recommendations, nil
+}
+
+// queuedDigestFocus falls back to the top of the resource queue
+func queuedDigestFocus() []string {
+	queue, err := buildResourceQueue()
+	if err != nil {
+		return nil
+	}
+
+	var focus []string
+	for i, entry := range queue {
+		if i >= 3 {
+			break
+		}
+		focus = append(focus, fmt.Sprintf("Start %s (%s)", entry.Resource.Title, entry.Resource.ID))
+	}
+	return focus
+}
+
+func digestTitle(digest core.WeeklyDigest) string {
+	return fmt.Sprintf("Weekly digest: %s – %s",
+		digest.WeekStart.Format("Jan 2"), digest.WeekEnd.AddDate(0, 0, -1).Format("Jan 2, 2006"))
+}
+
+// renderDigestMarkdown formats the digest as a markdown document
+func renderDigestMarkdown(digest core.WeeklyDigest, focus []string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", digestTitle(digest))
+
+	b.WriteString("## Summary\n\n")
+	fmt.Fprintf(&b, "- Hours invested: %.1f\n", digest.Hours)
+	fmt.Fprintf(&b, "- Progress logs: %d\n", digest.ProgressLogs)
+	fmt.Fprintf(&b, "- Resources completed: %d\n", len(digest.CompletedResources))
+	fmt.Fprintf(&b, "- Milestones achieved: %d\n", len(digest.AchievedMilestones))
+	weeks := "weeks"
+	if digest.Streak == 1 {
+		weeks = "week"
+	}
+	fmt.Fprintf(&b, "- Streak: %d %s\n", digest.Streak, weeks)
+
+	if len(digest.CompletedResources) > 0 {
+		b.WriteString("\n## Completed Resources\n\n")
+		for _, resource := range digest.CompletedResources {
+			fmt.Fprintf(&b, "- %s (%s, %s)\n", resource.Title, resource.Type, resource.ID)
+		}
+	}
+
+	if len(digest.AchievedMilestones) > 0 {
+		b.WriteString("\n## Milestones Achieved\n\n")
+		for _, milestone := range digest.AchievedMilestones {
+			fmt.Fprintf(&b, "- %s (%s)\n", milestone.Title, milestone.AchievedDate.Format("Mon Jan 2"))
+		}
+	}
+
+	if len(focus) > 0 {
+		b.WriteString("\n## Next Week's Focus\n\n")
+		for _, item := range focus {
+			fmt.Fprintf(&b, "- %s\n", item)
+		}
+	}
+
+	return b.String()
+}
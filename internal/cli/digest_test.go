@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderDigestMarkdown(t *testing.T) {
+	resource, _ := core.NewResource("resource-001", "Go Book", core.ResourceBook, "skill-001")
+	milestone, _ := core.NewMilestone("milestone-001", "First PR", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	achieved := time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC)
+	milestone.AchievedDate = &achieved
+
+	digest := core.WeeklyDigest{
+		WeekStart:          time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		WeekEnd:            time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		Hours:              4.5,
+		ProgressLogs:       2,
+		CompletedResources: []*core.Resource{resource},
+		AchievedMilestones: []*core.Milestone{milestone},
+		Streak:             1,
+	}
+
+	t.Run("renders all sections", func(t *testing.T) {
+		md := renderDigestMarkdown(digest, []string{"Practice concurrency"})
+
+		assert.Contains(t, md, "# Weekly digest: Jan 6 – Jan 12, 2025\n")
+		assert.Contains(t, md, "- Hours invested: 4.5\n")
+		assert.Contains(t, md, "- Streak: 1 week\n")
+		assert.Contains(t, md, "## Completed Resources\n\n- Go Book (book, resource-001)\n")
+		assert.Contains(t, md, "## Milestones Achieved\n\n- First PR (Thu Jan 9)\n")
+		assert.Contains(t, md, "## Next Week's Focus\n\n- Practice concurrency\n")
+	})
+
+	t.Run("omits empty sections", func(t *testing.T) {
+		md := renderDigestMarkdown(core.WeeklyDigest{WeekStart: digest.WeekStart, WeekEnd: digest.WeekEnd}, nil)
+
+		assert.Contains(t, md, "- Streak: 0 weeks\n")
+		assert.NotContains(t, md, "## Completed Resources")
+		assert.NotContains(t, md, "## Milestones Achieved")
+		assert.NotContains(t, md, "## Next Week's Focus")
+	})
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorFix bool
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the repository for inconsistent links",
+	Long: `Check the repository for links between entities that disagree.
+
+Each resource names its skill, and each skill lists its resources. Resources
+created or edited with growth keep both sides in step, but files edited by
+hand or written by older versions can drift: a skill may miss resources that
+belong to it, or list resources that were deleted or moved to another skill.
+
+Use --fix to rebuild each skill's resource list from the resources.
+
+Examples:
+  growth doctor
+  growth doctor --fix`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "repair the problems found")
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	fixes, err := links.CheckSkillLinks()
+	if err != nil {
+		return err
+	}
+
+	if len(fixes) == 0 {
+		PrintSuccess("No problems found")
+		return nil
+	}
+
+	for _, fix := range fixes {
+		for _, id := range fix.Added {
+			PrintWarning(fmt.Sprintf("Skill %s does not list its resource %s", fix.SkillID, id))
+		}
+		for _, id := range fix.Removed {
+			PrintWarning(fmt.Sprintf("Skill %s lists %s, which is missing or belongs to another skill", fix.SkillID, id))
+		}
+	}
+
+	if !doctorFix {
+		PrintInfo("Run 'growth doctor --fix' to repair them")
+		return nil
+	}
+
+	if _, err := links.RepairSkillLinks(); err != nil {
+		return fmt.Errorf("failed to repair skill resources: %w", err)
+	}
+	PrintSuccess(fmt.Sprintf("Repaired the resources of %d skill(s)", len(fixes)))
+	return nil
+}
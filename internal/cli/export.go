@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportPeriod   string
+	exportTemplate string
+	exportOutput   string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data for use outside growth",
+	Long:  `Export your growth data into documents for other tools.`,
+}
+
+var exportReviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Generate a performance review packet",
+	Long: `Fill a template with your achievements for a review period: completed goals,
+paths and resources, achieved milestones with evidence links, skills
+progressed, and hours invested. The result is ready to paste into HR tooling.
+
+Periods can be a year (2025), a half (2025-H1) or a quarter (2025-Q3).
+
+Templates use Go text/template syntax. Available fields:
+  .Name .Period .Start .Through (last day) .Hours
+  .GoalsCompleted .PathsCompleted .ResourcesCompleted .Milestones .Outputs
+      (each item has .ID .Title .Date .Evidence; outputs also have .Kind)
+  .SkillsProgressed (each has .ID .Title .Category .From .To)
+  .Evidence (all items with an evidence link)
+Functions: date (formats as YYYY-MM-DD), hours (formats as 0.0)
+
+Without --template a default markdown layout is used.
+
+Examples:
+  growth export review --period 2025-H1
+  growth export review --period 2025-H1 --template company.md
+  growth export review --period 2025 --output review-2025.md`,
+	RunE: runExportReview,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportReviewCmd)
+
+	exportReviewCmd.Flags().StringVar(&exportPeriod, "period", "", "review period, e.g. 2025-H1, 2025-Q3 or 2025 (required)")
+	exportReviewCmd.Flags().StringVar(&exportTemplate, "template", "", "path to a text/template file (defaults to a built-in markdown layout)")
+	exportReviewCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "write the packet to a file instead of stdout")
+	exportReviewCmd.MarkFlagRequired("period")
+}
+
+const defaultReviewTemplate = `# Performance Review: {{.Period}}
+{{if .Name}}
+{{.Name}}, {{date .Start}} to {{date .Through}}
+{{else}}
+{{date .Start}} to {{date .Through}}
+{{end}}
+## Summary
+
+- Hours invested in learning: {{hours .Hours}}
+- Goals completed: {{len .GoalsCompleted}}
+- Learning paths completed: {{len .PathsCompleted}}
+- Resources completed: {{len .ResourcesCompleted}}
+- Milestones achieved: {{len .Milestones}}
+- Talks, posts and contributions: {{len .Outputs}}
+{{if .Milestones}}
+## Achievements
+{{range .Milestones}}
+- {{.Title}} ({{date .Date}}){{if .Evidence}} - {{.Evidence}}{{end}}{{end}}
+{{end}}{{if .Outputs}}
+## Talks, Posts and Contributions
+{{range .Outputs}}
+- {{.Title}} ({{.Kind}}, {{date .Date}}){{if .Evidence}} - {{.Evidence}}{{end}}{{end}}
+{{end}}{{if .GoalsCompleted}}
+## Goals Completed
+{{range .GoalsCompleted}}
+- {{.Title}} ({{date .Date}}){{end}}
+{{end}}{{if .SkillsProgressed}}
+## Skills Progressed
+{{range .SkillsProgressed}}
+- {{.Title}}: {{.From}} → {{.To}}{{end}}
+{{end}}{{if or .PathsCompleted .ResourcesCompleted}}
+## Learning Completed
+{{range .PathsCompleted}}
+- Path: {{.Title}} ({{date .Date}}){{end}}{{range .ResourcesCompleted}}
+- {{.Title}} ({{date .Date}}){{if .Evidence}} - {{.Evidence}}{{end}}{{end}}
+{{end}}`
+
+// reviewTemplateData is what review templates are executed against
+type reviewTemplateData struct {
+	core.ReviewPacket
+	Name    string
+	Through time.Time // last day of the period
+}
+
+func runExportReview(cmd *cobra.Command, args []string) error {
+	start, end, err := core.ParsePeriod(exportPeriod, time.Local)
+	if err != nil {
+		return err
+	}
+
+	tmplText := defaultReviewTemplate
+	if exportTemplate != "" {
+		data, err := os.ReadFile(exportTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		tmplText = string(data)
+	}
+
+	src, err := loadSnapshotSource()
+	if err != nil {
+		return err
+	}
+
+	packet := core.BuildReviewPacket(strings.ToUpper(exportPeriod), start, end, src)
+
+	rendered, err := renderReviewPacket(tmplText, reviewTemplateData{
+		ReviewPacket: packet,
+		Name:         config.User.Name,
+		Through:      end.AddDate(0, 0, -1),
+	})
+	if err != nil {
+		return err
+	}
+
+	if exportOutput != "" {
+		if err := os.WriteFile(exportOutput, []byte(rendered), 0644); err != nil {
+			return fmt.Errorf("failed to write review packet: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote review packet for %s to %s", packet.Period, exportOutput))
+		return nil
+	}
+
+	fmt.Print(rendered)
+	return nil
+}
+
+// renderReviewPacket executes a review template against data
+func renderReviewPacket(tmplText string, data reviewTemplateData) (string, error) {
+	funcs := template.FuncMap{
+		"date": func(t time.Time) string {
+			return t.Format("2006-01-02")
+		},
+		"hours": func(h float64) string {
+			return fmt.Sprintf("%.1f", h)
+		},
+	}
+
+	tmpl, err := template.New("review").Funcs(funcs).Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to fill template: %w", err)
+	}
+
+	return b.String(), nil
+}
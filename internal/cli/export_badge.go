@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/badge"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportBadgeMetric string
+	exportBadgeOut    string
+	exportBadgeLabel  string
+	exportBadgeColor  string
+)
+
+var exportBadgeCmd = &cobra.Command{
+	Use:   "badge",
+	Short: "Render a stats badge as an SVG",
+	Long: `Render a shields.io-style SVG badge with a current stat, for embedding in a
+GitHub profile README. Regenerate it from a git hook or CI job to keep it
+current.
+
+Metrics:
+  hours-this-year      hours invested this calendar year
+  hours-total          hours invested overall
+  streak               consecutive weeks with progress logs
+  skills-mastered      skills with status mastered
+  goals-completed      completed goals
+  resources-completed  completed resources
+
+Badges of metrics that are zero are gray unless --color is set.
+
+Examples:
+  growth export badge --metric hours-this-year --out badge.svg
+  growth export badge --metric streak --label "study streak" --color blue
+  # in README.md: ![hours](badge.svg)`,
+	RunE: runExportBadge,
+}
+
+func init() {
+	exportCmd.AddCommand(exportBadgeCmd)
+
+	exportBadgeCmd.Flags().StringVar(&exportBadgeMetric, "metric", "", "stat to show: "+strings.Join(badgeMetricNames(), ", ")+" (required)")
+	exportBadgeCmd.Flags().StringVarP(&exportBadgeOut, "out", "o", "badge.svg", "SVG file to write, - for stdout")
+	exportBadgeCmd.Flags().StringVar(&exportBadgeLabel, "label", "", "label instead of the metric's")
+	exportBadgeCmd.Flags().StringVar(&exportBadgeColor, "color", "", "message color: a hex color or brightgreen, green, yellowgreen, yellow, orange, red, blue, lightgrey")
+	exportBadgeCmd.MarkFlagRequired("metric")
+}
+
+// badgeMetric computes a stat for a badge
+type badgeMetric struct {
+	label func(now time.Time) string
+	value func(data badgeData, now time.Time) float64
+	unit  string // counted noun, like "week"; plain number when empty
+}
+
+// badgeData is what badge metrics are computed from
+type badgeData struct {
+	logs      []*core.ProgressLog
+	skills    []*core.Skill
+	goals     []*core.Goal
+	resources []*core.Resource
+}
+
+var badgeMetrics = map[string]badgeMetric{
+	"hours-this-year": {
+		label: func(now time.Time) string { return fmt.Sprintf("hours in %d", now.Year()) },
+		value: func(data badgeData, now time.Time) float64 {
+			hours := 0.0
+			for _, log := range data.logs {
+				if log.Date.Year() == now.Year() {
+					hours += log.HoursInvested
+				}
+			}
+			return hours
+		},
+	},
+	"hours-total": {
+		label: func(time.Time) string { return "learning hours" },
+		value: func(data badgeData, _ time.Time) float64 {
+			hours := 0.0
+			for _, log := range data.logs {
+				hours += log.HoursInvested
+			}
+			return hours
+		},
+	},
+	"streak": {
+		label: func(time.Time) string { return "learning streak" },
+		value: func(data badgeData, now time.Time) float64 {
+			return float64(core.WeeklyProgressStreak(data.logs, now))
+		},
+		unit: "week",
+	},
+	"skills-mastered": {
+		label: func(time.Time) string { return "skills mastered" },
+		value: func(data badgeData, _ time.Time) float64 {
+			count := 0
+			for _, skill := range data.skills {
+				if skill.Status == core.SkillMastered {
+					count++
+				}
+			}
+			return float64(count)
+		},
+	},
+	"goals-completed": {
+		label: func(time.Time) string { return "goals completed" },
+		value: func(data badgeData, _ time.Time) float64 {
+			count := 0
+			for _, goal := range data.goals {
+				if goal.Status == core.StatusCompleted {
+					count++
+				}
+			}
+			return float64(count)
+		},
+	},
+	"resources-completed": {
+		label: func(time.Time) string { return "resources completed" },
+		value: func(data badgeData, _ time.Time) float64 {
+			count := 0
+			for _, resource := range data.resources {
+				if resource.Status == core.ResourceCompleted {
+					count++
+				}
+			}
+			return float64(count)
+		},
+	},
+}
+
+func badgeMetricNames() []string {
+	names := make([]string, 0, len(badgeMetrics))
+	for name := range badgeMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func runExportBadge(cmd *cobra.Command, args []string) error {
+	metric, ok := badgeMetrics[exportBadgeMetric]
+	if !ok {
+		return fmt.Errorf("unknown metric '%s'. Valid metrics: %s", exportBadgeMetric, strings.Join(badgeMetricNames(), ", "))
+	}
+
+	var data badgeData
+	var err error
+	if data.logs, err = progressRepo.GetAll(); err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+	if data.skills, err = skillRepo.GetAll(); err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+	if data.goals, err = goalRepo.GetAll(); err != nil {
+		return fmt.Errorf("failed to load goals: %w", err)
+	}
+	if data.resources, err = resourceRepo.GetAll(); err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+
+	b := metricBadge(metric, data, time.Now())
+	if exportBadgeLabel != "" {
+		b.Label = exportBadgeLabel
+	}
+	if exportBadgeColor != "" {
+		b.Color = exportBadgeColor
+	}
+
+	var buf bytes.Buffer
+	if err := badge.Render(&buf, b); err != nil {
+		return fmt.Errorf("failed to render badge: %w", err)
+	}
+
+	if exportBadgeOut == "-" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if err := os.WriteFile(exportBadgeOut, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write badge: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Wrote %s badge (%s: %s) to %s", exportBadgeMetric, b.Label, b.Message, exportBadgeOut))
+	return nil
+}
+
+// metricBadge computes a metric into a badge, gray when it is zero
+func metricBadge(metric badgeMetric, data badgeData, now time.Time) badge.Badge {
+	value := metric.value(data, now)
+
+	b := badge.Badge{Label: metric.label(now), Message: formatNumber(value, 0), Color: badge.DefaultColor}
+	if metric.unit != "" {
+		b.Message = countOf(int(value), metric.unit)
+	}
+	if value == 0 {
+		b.Color = "lightgrey"
+	}
+	return b
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/badge"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricBadge(t *testing.T) {
+	now := time.Date(2025, 6, 12, 10, 0, 0, 0, time.UTC)
+	thisWeek, _ := core.NewProgressLog("progress-002", now.AddDate(0, 0, -1))
+	thisWeek.SetHoursInvested(5)
+	lastWeek, _ := core.NewProgressLog("progress-001", now.AddDate(0, 0, -7))
+	lastWeek.SetHoursInvested(10)
+	lastYear, _ := core.NewProgressLog("progress-000", time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC))
+	lastYear.SetHoursInvested(20)
+	data := badgeData{logs: []*core.ProgressLog{lastYear, lastWeek, thisWeek}}
+
+	t.Run("sums hours this year", func(t *testing.T) {
+		b := metricBadge(badgeMetrics["hours-this-year"], data, now)
+		assert.Equal(t, badge.Badge{Label: "hours in 2025", Message: "15", Color: badge.DefaultColor}, b)
+
+		b = metricBadge(badgeMetrics["hours-total"], data, now)
+		assert.Equal(t, "35", b.Message)
+	})
+
+	t.Run("counts the streak in weeks", func(t *testing.T) {
+		b := metricBadge(badgeMetrics["streak"], data, now)
+		assert.Equal(t, "2 weeks", b.Message)
+	})
+
+	t.Run("grays out zero", func(t *testing.T) {
+		b := metricBadge(badgeMetrics["goals-completed"], data, now)
+		assert.Equal(t, badge.Badge{Label: "goals completed", Message: "0", Color: "lightgrey"}, b)
+	})
+}
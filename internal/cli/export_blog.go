@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var exportBlogCmd = &cobra.Command{
+	Use:   "blog <path-id>",
+	Short: "Write a retrospective article about a learning path",
+	Long: `Generate a long-form markdown write-up of a learning path to edit into a blog
+post: what was learned in each phase, with the key takeaways from the Notes
+sections of its resources, the resources ranked by how much they contributed,
+dropped resources, and achieved milestones with their proofs.
+
+Sections that only you can write are left as HTML comments to fill in.
+
+Examples:
+  growth export blog path-001
+  growth export blog path-001 --output retrospective.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExportBlog,
+}
+
+func init() {
+	exportCmd.AddCommand(exportBlogCmd)
+
+	exportBlogCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "write the article to a file instead of stdout")
+}
+
+func runExportBlog(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	path, err := pathRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+	}
+
+	phases, err := phaseRepo.FindByPathID(id)
+	if err != nil {
+		return fmt.Errorf("failed to load phases: %w", err)
+	}
+
+	// Load bodies for the phases and their resources' takeaways
+	resources := make(map[core.EntityID]*core.Resource)
+	for i, phase := range phases {
+		if full, err := phaseRepo.GetByIDWithBody(phase.ID); err == nil {
+			phases[i] = full
+		}
+		for _, resourceID := range phase.Resources {
+			if resource, err := resourceRepo.GetByIDWithBody(resourceID); err == nil {
+				resources[resourceID] = resource
+			}
+		}
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	var goalIDs []core.EntityID
+	for _, goal := range goalsForPath(id) {
+		goalIDs = append(goalIDs, goal.ID)
+	}
+
+	retro := core.BuildPathRetrospective(path, phases, resources, milestones, goalIDs)
+	article := renderBlogMarkdown(retro)
+
+	if exportOutput != "" {
+		if err := os.WriteFile(exportOutput, []byte(article), 0644); err != nil {
+			return fmt.Errorf("failed to write article: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote retrospective of %s to %s", path.ID, exportOutput))
+		return nil
+	}
+
+	fmt.Print(article)
+	return nil
+}
+
+// renderBlogMarkdown turns a path retrospective into an article scaffold
+func renderBlogMarkdown(retro core.PathRetrospective) string {
+	var b strings.Builder
+	path := retro.Path
+
+	fmt.Fprintf(&b, "# %s: a retrospective\n\n", path.Title)
+
+	summary := []string{countOf(len(retro.Phases), "phase")}
+	if retro.Hours > 0 {
+		summary = append(summary, fmt.Sprintf("%.1f hours", retro.Hours))
+	}
+	if len(retro.Milestones) > 0 {
+		summary = append(summary, countOf(len(retro.Milestones), "milestone"))
+	}
+	fmt.Fprintf(&b, "*%s*\n\n", strings.Join(summary, " · "))
+
+	b.WriteString("## Why I started\n\n")
+	if body := strings.TrimSpace(path.Body); body != "" {
+		b.WriteString(body + "\n\n")
+	} else {
+		b.WriteString("<!-- What made you start this path, and where were you at the time? -->\n\n")
+	}
+
+	b.WriteString("## What I learned\n\n")
+	for i, pr := range retro.Phases {
+		fmt.Fprintf(&b, "### Phase %d: %s\n\n", i+1, pr.Phase.Title)
+		if body := strings.TrimSpace(pr.Phase.Body); body != "" {
+			b.WriteString(body + "\n\n")
+		}
+
+		var takeaways []string
+		for _, resource := range pr.Resources {
+			takeaways = append(takeaways, core.SectionItems(resource.Body, core.SectionNotes)...)
+		}
+		if len(takeaways) > 0 {
+			b.WriteString("Key takeaways:\n\n")
+			for _, takeaway := range takeaways {
+				fmt.Fprintf(&b, "- %s\n", takeaway)
+			}
+			b.WriteString("\n")
+		} else {
+			b.WriteString("<!-- What clicked in this phase? -->\n\n")
+		}
+
+		if len(pr.Resources) > 0 {
+			b.WriteString("Resources, best first:\n\n")
+			for rank, resource := range pr.Resources {
+				fmt.Fprintf(&b, "%d. %s\n", rank+1, blogResourceLine(resource))
+			}
+			b.WriteString("\n")
+		}
+		if len(pr.Dropped) > 0 {
+			b.WriteString("Dropped or skipped:\n\n")
+			for _, resource := range pr.Dropped {
+				line := "- " + blogResourceTitle(resource)
+				if resource.AbandonReason != "" {
+					line += " (" + resource.AbandonReason + ")"
+				}
+				b.WriteString(line + "\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(retro.Milestones) > 0 {
+		b.WriteString("## Milestones\n\n")
+		for _, m := range retro.Milestones {
+			line := fmt.Sprintf("- **%s** (%s)", m.Title, m.AchievedDate.Format("2006-01-02"))
+			if m.Proof != "" {
+				line += fmt.Sprintf(" · [proof](%s)", m.Proof)
+			}
+			for _, attachment := range m.Attachments {
+				line += fmt.Sprintf(" · [%s](%s)", attachment.Name, attachment.Path)
+			}
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Looking back\n\n")
+	b.WriteString("<!-- What worked, what would you do differently, and what comes next? -->\n")
+
+	return b.String()
+}
+
+func blogResourceTitle(resource *core.Resource) string {
+	if resource.URL != "" {
+		return fmt.Sprintf("[%s](%s)", resource.Title, resource.URL)
+	}
+	return resource.Title
+}
+
+func blogResourceLine(resource *core.Resource) string {
+	details := []string{string(resource.Type)}
+	if resource.Author != "" {
+		details[0] += " by " + resource.Author
+	}
+	if resource.ActualHours > 0 {
+		details = append(details, fmt.Sprintf("%.1fh", resource.ActualHours))
+	}
+	if resource.Status != core.ResourceCompleted {
+		details = append(details, string(resource.Status))
+	}
+	return fmt.Sprintf("%s (%s)", blogResourceTitle(resource), strings.Join(details, ", "))
+}
+
+// countOf formats a count with a singular or plural noun
+func countOf(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
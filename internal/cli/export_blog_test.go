@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderBlogMarkdown(t *testing.T) {
+	path, _ := core.NewLearningPath("path-001", "Backend in Go", core.PathTypeManual)
+	phase, _ := core.NewPhase("phase-001", "path-001", "Basics", 1)
+	book, _ := core.NewResource("resource-001", "The Go Book", core.ResourceBook, "skill-001")
+	book.Author = "Donovan"
+	book.ActualHours = 20
+	book.Complete()
+	book.Body = core.AppendSectionItem("", core.SectionNotes, "Interfaces are implicit")
+	video, _ := core.NewResource("resource-002", "Old video", core.ResourceVideo, "skill-001")
+	video.Abandon("outdated")
+	milestone, _ := core.NewMilestone("milestone-001", "Finish basics", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	milestone.Achieve("https://example.com/cert")
+
+	article := renderBlogMarkdown(core.PathRetrospective{
+		Path:       path,
+		Phases:     []core.PhaseRetrospective{{Phase: phase, Resources: []*core.Resource{book}, Dropped: []*core.Resource{video}}},
+		Milestones: []*core.Milestone{milestone},
+		Hours:      20,
+	})
+
+	assert.Contains(t, article, "# Backend in Go: a retrospective")
+	assert.Contains(t, article, "*1 phase · 20.0 hours · 1 milestone*")
+	assert.Contains(t, article, "### Phase 1: Basics")
+	assert.Contains(t, article, "- Interfaces are implicit")
+	assert.Contains(t, article, "1. The Go Book (book by Donovan, 20.0h)")
+	assert.Contains(t, article, "- Old video (outdated)")
+	assert.Contains(t, article, "[proof](https://example.com/cert)")
+	assert.Contains(t, article, "## Looking back")
+}
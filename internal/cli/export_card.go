@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/illenko/growth.md/internal/card"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportCardGoal string
+	exportCardOut  string
+)
+
+var exportCardCmd = &cobra.Command{
+	Use:   "card",
+	Short: "Render a shareable progress card as a PNG",
+	Long: `Render a goal's progress as a PNG card for learning-in-public posts: its title,
+percent complete (achieved milestones, or 100% once completed) and your
+weekly progress streak.
+
+Examples:
+  growth export card --goal goal-001
+  growth export card --goal goal-001 --out card.png`,
+	RunE: runExportCard,
+}
+
+func init() {
+	exportCmd.AddCommand(exportCardCmd)
+
+	exportCardCmd.Flags().StringVar(&exportCardGoal, "goal", "", "goal ID (required)")
+	exportCardCmd.Flags().StringVarP(&exportCardOut, "out", "o", "card.png", "PNG file to write")
+	exportCardCmd.MarkFlagRequired("goal")
+}
+
+func runExportCard(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(exportCardGoal)
+
+	goal, err := goalRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := card.Render(&buf, goalCard(goal, milestones, logs, config.User.Name, time.Now())); err != nil {
+		return fmt.Errorf("failed to render card: %w", err)
+	}
+	if err := os.WriteFile(exportCardOut, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write card: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Wrote progress card for %s to %s", goal.ID, exportCardOut))
+	return nil
+}
+
+// goalCard collects what a progress card shows about a goal
+func goalCard(goal *core.Goal, milestones []*core.Milestone, logs []*core.ProgressLog, name string, now time.Time) card.Card {
+	c := card.Card{
+		Title:    goal.Title,
+		Subtitle: fmt.Sprintf("Goal · %s priority", goal.Priority),
+		Progress: core.GoalProgress(goal, milestones),
+		Streak:   core.WeeklyProgressStreak(logs, now),
+		Footer:   "growth.md · " + now.Format("Jan 2, 2006"),
+	}
+	if name != "" {
+		c.Footer = name + " · " + c.Footer
+	}
+
+	if goal.Status == core.StatusCompleted {
+		c.Subtitle = "Goal · completed"
+	}
+	if achieved, total := core.GoalMilestoneCounts(goal.ID, milestones); total > 0 {
+		c.Details = append(c.Details, fmt.Sprintf("%d of %d milestones", achieved, total))
+	}
+	if goal.TargetDate != nil && goal.Status != core.StatusCompleted {
+		c.Details = append(c.Details, "target "+goal.TargetDate.Format("Jan 2, 2006"))
+	}
+
+	return c
+}
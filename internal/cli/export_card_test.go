@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoalCard(t *testing.T) {
+	now := time.Date(2025, 6, 12, 10, 0, 0, 0, time.UTC)
+	goal, _ := core.NewGoal("goal-001", "Become a backend engineer", core.PriorityHigh)
+	done, _ := core.NewMilestone("milestone-001", "Ship API", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	done.Achieve("")
+	pending, _ := core.NewMilestone("milestone-002", "Ship UI", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	log, _ := core.NewProgressLog("progress-001", now.AddDate(0, 0, -1))
+
+	c := goalCard(goal, []*core.Milestone{done, pending}, []*core.ProgressLog{log}, "Ada", now)
+
+	assert.Equal(t, "Become a backend engineer", c.Title)
+	assert.Equal(t, "Goal · high priority", c.Subtitle)
+	assert.Equal(t, 0.5, c.Progress)
+	assert.Equal(t, 1, c.Streak)
+	assert.Equal(t, []string{"1 of 2 milestones"}, c.Details)
+	assert.Equal(t, "Ada · growth.md · Jun 12, 2025", c.Footer)
+}
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportResumeOutput         string
+	exportResumeIncludeExpired bool
+)
+
+var exportResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Generate resume sections from your skills and credentials",
+	Long: `Generate markdown resume sections: skills you are learning or have mastered,
+grouped by category, your certifications and credentials with verification
+links, and the learning paths you completed.
+
+Expired credentials are left out unless --include-expired is given.
+
+Examples:
+  growth export resume
+  growth export resume --output resume.md`,
+	RunE: runExportResume,
+}
+
+func init() {
+	exportCmd.AddCommand(exportResumeCmd)
+
+	exportResumeCmd.Flags().StringVarP(&exportResumeOutput, "output", "o", "", "write the resume to a file instead of stdout")
+	exportResumeCmd.Flags().BoolVar(&exportResumeIncludeExpired, "include-expired", false, "include expired credentials")
+}
+
+func runExportResume(cmd *cobra.Command, args []string) error {
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+	credentials, err := credentialRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load credentials: %w", err)
+	}
+	paths, err := pathRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load paths: %w", err)
+	}
+
+	now := time.Now()
+	if !exportResumeIncludeExpired {
+		var current []*core.Credential
+		for _, credential := range credentials {
+			if !credential.IsExpired(now) {
+				current = append(current, credential)
+			}
+		}
+		credentials = current
+	}
+
+	rendered := renderResume(config.User.Name, skills, credentials, paths, now)
+
+	if exportResumeOutput != "" {
+		if err := os.WriteFile(exportResumeOutput, []byte(rendered), 0644); err != nil {
+			return fmt.Errorf("failed to write resume: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote resume to %s", exportResumeOutput))
+		return nil
+	}
+
+	fmt.Print(rendered)
+	return nil
+}
+
+// renderResume writes the resume sections as markdown
+func renderResume(name string, skills []*core.Skill, credentials []*core.Credential, paths []*core.LearningPath, now time.Time) string {
+	var b strings.Builder
+
+	if name != "" {
+		fmt.Fprintf(&b, "# %s\n", name)
+	} else {
+		b.WriteString("# Resume\n")
+	}
+
+	byCategory := make(map[string][]*core.Skill)
+	for _, skill := range skills {
+		if skill.Status == core.SkillNotStarted {
+			continue
+		}
+		byCategory[skill.Category] = append(byCategory[skill.Category], skill)
+	}
+	if len(byCategory) > 0 {
+		categories := make([]string, 0, len(byCategory))
+		for category := range byCategory {
+			categories = append(categories, category)
+		}
+		sort.Strings(categories)
+
+		b.WriteString("\n## Skills\n\n")
+		for _, category := range categories {
+			group := byCategory[category]
+			sort.Slice(group, func(i, j int) bool { return group[i].Title < group[j].Title })
+			items := make([]string, len(group))
+			for i, skill := range group {
+				items[i] = fmt.Sprintf("%s (%s)", skill.Title, skill.Level)
+			}
+			fmt.Fprintf(&b, "- **%s**: %s\n", category, strings.Join(items, ", "))
+		}
+	}
+
+	if len(credentials) > 0 {
+		sorted := make([]*core.Credential, len(credentials))
+		copy(sorted, credentials)
+		sortCredentials(sorted)
+
+		b.WriteString("\n## Certifications\n\n")
+		for _, credential := range sorted {
+			line := fmt.Sprintf("- %s, %s (%s", credential.Title, credential.Issuer, credential.IssueDate.Format("Jan 2006"))
+			if credential.ExpiryDate != nil {
+				verb := "valid until"
+				if credential.IsExpired(now) {
+					verb = "expired"
+				}
+				line += fmt.Sprintf(", %s %s", verb, credential.ExpiryDate.Format("Jan 2006"))
+			}
+			line += ")"
+			if credential.CredentialID != "" {
+				line += " - Credential ID " + credential.CredentialID
+			}
+			if credential.URL != "" {
+				line += " - " + credential.URL
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	type completedPath struct {
+		title string
+		at    time.Time
+	}
+	var completed []completedPath
+	for _, path := range paths {
+		if path.Status != core.StatusCompleted {
+			continue
+		}
+		at := path.Updated
+		if changed, ok := path.History.LastChangeTo("status", string(core.StatusCompleted)); ok {
+			at = changed
+		}
+		completed = append(completed, completedPath{title: path.Title, at: at})
+	}
+	if len(completed) > 0 {
+		sort.Slice(completed, func(i, j int) bool { return completed[i].at.After(completed[j].at) })
+
+		b.WriteString("\n## Learning\n\n")
+		for _, path := range completed {
+			fmt.Fprintf(&b, "- %s (completed %s)\n", path.title, path.at.Format("Jan 2006"))
+		}
+	}
+
+	return b.String()
+}
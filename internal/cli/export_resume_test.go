@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderResume(t *testing.T) {
+	now := time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	goSkill, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelAdvanced)
+	goSkill.Status = core.SkillMastered
+	k8s, _ := core.NewSkill("skill-002", "Kubernetes", "devops", core.LevelIntermediate)
+	k8s.Status = core.SkillLearning
+	rust, _ := core.NewSkill("skill-003", "Rust", "programming", core.LevelBeginner)
+
+	cka, _ := core.NewCredential("credential-001", "Certified Kubernetes Administrator", "CNCF", time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC))
+	cka.SetExpiryDate(time.Date(2027, 5, 2, 0, 0, 0, 0, time.UTC))
+	cka.CredentialID = "LF-abc123"
+	cka.URL = "https://www.credly.com/badges/abc123"
+
+	t.Run("renders skills and credentials", func(t *testing.T) {
+		out := renderResume("Alex", []*core.Skill{rust, k8s, goSkill}, []*core.Credential{cka}, nil, now)
+
+		assert.Contains(t, out, "# Alex\n")
+		assert.Contains(t, out, "- **devops**: Kubernetes (intermediate)\n- **programming**: Go (advanced)\n")
+		assert.NotContains(t, out, "Rust")
+		assert.Contains(t, out, "- Certified Kubernetes Administrator, CNCF (May 2024, valid until May 2027) - Credential ID LF-abc123 - https://www.credly.com/badges/abc123\n")
+		assert.NotContains(t, out, "## Learning")
+	})
+
+	t.Run("marks expired credentials", func(t *testing.T) {
+		out := renderResume("", nil, []*core.Credential{cka}, nil, time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Contains(t, out, "# Resume\n")
+		assert.Contains(t, out, "(May 2024, expired May 2027)")
+		assert.NotContains(t, out, "## Skills")
+	})
+}
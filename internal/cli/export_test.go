@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderReviewPacket(t *testing.T) {
+	data := reviewTemplateData{
+		ReviewPacket: core.ReviewPacket{
+			Period: "2025-H1",
+			Start:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:    time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			Hours:  42.25,
+			Milestones: []core.ReviewItem{
+				{ID: "milestone-001", Title: "Conference talk", Date: time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC), Evidence: "https://example.com/talk"},
+			},
+			Outputs: []core.ReviewItem{
+				{ID: "output-001", Title: "Profiling Go services", Date: time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC), Kind: "talk"},
+			},
+			SkillsProgressed: []core.ReviewSkill{
+				{ID: "skill-001", Title: "Go", From: core.LevelIntermediate, To: core.LevelAdvanced},
+			},
+		},
+		Name:    "Alex",
+		Through: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("fills default template", func(t *testing.T) {
+		out, err := renderReviewPacket(defaultReviewTemplate, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, out, "# Performance Review: 2025-H1\n")
+		assert.Contains(t, out, "Alex, 2025-01-01 to 2025-06-30")
+		assert.Contains(t, out, "- Hours invested in learning: 42.2\n")
+		assert.Contains(t, out, "- Conference talk (2025-03-07) - https://example.com/talk\n")
+		assert.Contains(t, out, "- Talks, posts and contributions: 1\n")
+		assert.Contains(t, out, "- Profiling Go services (talk, 2025-05-20)\n")
+		assert.Contains(t, out, "- Go: intermediate → advanced\n")
+		assert.NotContains(t, out, "## Goals Completed")
+	})
+
+	t.Run("fills custom template", func(t *testing.T) {
+		out, err := renderReviewPacket("{{.Period}}: {{hours .Hours}}h{{range .Evidence}} [{{.Evidence}}]{{end}}", data)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2025-H1: 42.2h [https://example.com/talk]", out)
+	})
+
+	t.Run("reports template errors", func(t *testing.T) {
+		_, err := renderReviewPacket("{{.Period", data)
+		assert.ErrorContains(t, err, "failed to parse template")
+
+		_, err = renderReviewPacket("{{.Missing}}", data)
+		assert.ErrorContains(t, err, "failed to fill template")
+	})
+}
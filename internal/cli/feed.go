@@ -0,0 +1,411 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/feeds"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	feedSkillID string
+	feedTitle   string
+	feedTags    string
+	feedAll     bool
+)
+
+var feedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Watch RSS feeds and newsletters for new resources",
+	Long:  `Watch RSS/Atom feeds per skill and turn new items into resources.`,
+}
+
+var feedAddCmd = &cobra.Command{
+	Use:   "add <url>",
+	Short: "Watch a feed for a skill",
+	Long: `Start watching an RSS or Atom feed for a skill.
+
+The feed is fetched once to check it and to read its title. Items already
+in the feed are treated as new on the first 'growth feed fetch'.
+
+Examples:
+  growth feed add https://golangweekly.com/rss --skill skill-001
+  growth feed add https://this-week-in-rust.org/rss.xml --skill skill-002 --title "TWiR"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFeedAdd,
+}
+
+var feedListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List watched feeds",
+	Long: `List all watched feeds.
+
+Examples:
+  growth feed list
+  growth feed list --skill skill-001`,
+	Aliases: []string{"ls"},
+	RunE:    runFeedList,
+}
+
+var feedFetchCmd = &cobra.Command{
+	Use:   "fetch [feed-id]",
+	Short: "Fetch new items and add them as resources",
+	Long: `Fetch new items from watched feeds and pick which ones to add as resources.
+
+New items are listed per feed; select them by number (e.g. "1,3,5-7"),
+"all", or leave empty to add none. Every listed item is remembered, so it
+won't be offered again. Resources are created for the feed's skill.
+
+Examples:
+  growth feed fetch
+  growth feed fetch feed-002
+  growth feed fetch --skill skill-001 --all`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runFeedFetch,
+}
+
+var feedRemoveCmd = &cobra.Command{
+	Use:   "remove <id>",
+	Short: "Stop watching a feed",
+	Long: `Stop watching a feed. Resources created from it are kept.
+
+Examples:
+  growth feed remove feed-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runFeedRemove,
+}
+
+func init() {
+	rootCmd.AddCommand(feedCmd)
+	feedCmd.AddCommand(feedAddCmd)
+	feedCmd.AddCommand(feedListCmd)
+	feedCmd.AddCommand(feedFetchCmd)
+	feedCmd.AddCommand(feedRemoveCmd)
+
+	feedAddCmd.Flags().StringVar(&feedSkillID, "skill", "", "skill ID the feed's items belong to (required)")
+	feedAddCmd.Flags().StringVar(&feedTitle, "title", "", "feed title (defaults to the feed's own title)")
+	feedAddCmd.Flags().StringVar(&feedTags, "tags", "", "comma-separated tags added to resources from this feed")
+	feedAddCmd.MarkFlagRequired("skill")
+
+	feedListCmd.Flags().StringVar(&feedSkillID, "skill", "", "filter by skill ID")
+
+	feedFetchCmd.Flags().StringVar(&feedSkillID, "skill", "", "only fetch feeds for this skill")
+	feedFetchCmd.Flags().BoolVar(&feedAll, "all", false, "add every new item without prompting")
+}
+
+func runFeedAdd(cmd *cobra.Command, args []string) error {
+	feedURL := strings.TrimSpace(args[0])
+	skillID := core.EntityID(feedSkillID)
+
+	exists, err := skillRepo.Exists(skillID)
+	if err != nil {
+		return fmt.Errorf("failed to check skill existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", skillID)
+	}
+
+	existing, err := feedRepo.FindByURL(feedURL)
+	if err != nil {
+		return fmt.Errorf("failed to check existing feeds: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("feed %s already watches %s", existing.ID, feedURL)
+	}
+
+	parsed, err := fetchFeed(cmd.Context(), feedURL)
+	if err != nil {
+		return err
+	}
+
+	title := feedTitle
+	if title == "" {
+		title = parsed.Title
+	}
+	if title == "" {
+		title = feedURL
+	}
+
+	id, err := GenerateNextID("feed")
+	if err != nil {
+		return fmt.Errorf("failed to generate feed ID: %w", err)
+	}
+
+	feed, err := core.NewFeed(id, title, feedURL, skillID)
+	if err != nil {
+		return fmt.Errorf("failed to create feed: %w", err)
+	}
+
+	if feedTags != "" {
+		for _, tag := range strings.Split(feedTags, ",") {
+			feed.AddTag(tag)
+		}
+	}
+
+	if err := feedRepo.Create(feed); err != nil {
+		return fmt.Errorf("failed to save feed: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Watching feed %s: %s (%d items)", feed.ID, feed.Title, len(parsed.Items)))
+	return nil
+}
+
+func runFeedList(cmd *cobra.Command, args []string) error {
+	var feedList []*core.Feed
+	var err error
+
+	if feedSkillID != "" {
+		feedList, err = feedRepo.FindBySkillID(core.EntityID(feedSkillID))
+	} else {
+		feedList, err = feedRepo.GetAll()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to retrieve feeds: %w", err)
+	}
+
+	if len(feedList) == 0 {
+		PrintInfo("No feeds found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, feed := range feedList {
+			lastFetched := "never"
+			if feed.LastFetched != nil {
+				lastFetched = formatDate(*feed.LastFetched)
+			}
+			fmt.Printf("%s  %-10s  %-40s  fetched %s\n", feed.ID, feed.SkillID, truncate(feed.Title, 40), lastFetched)
+			fmt.Printf("          %s\n", feed.URL)
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(feedList)
+}
+
+func runFeedFetch(cmd *cobra.Command, args []string) error {
+	var feedList []*core.Feed
+
+	if len(args) > 0 {
+		id := core.EntityID(args[0])
+		feed, err := feedRepo.GetByIDWithBody(id)
+		if err != nil {
+			return fmt.Errorf("feed '%s' not found. Use 'growth feed list' to see available feeds", id)
+		}
+		feedList = []*core.Feed{feed}
+	} else {
+		var err error
+		if feedSkillID != "" {
+			feedList, err = feedRepo.FindBySkillID(core.EntityID(feedSkillID))
+		} else {
+			feedList, err = feedRepo.GetAll()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve feeds: %w", err)
+		}
+	}
+
+	if len(feedList) == 0 {
+		PrintInfo("No feeds found. Add one with 'growth feed add <url> --skill <skill-id>'")
+		return nil
+	}
+
+	existing, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+	existingURLs := make(map[string]bool, len(existing))
+	for _, resource := range existing {
+		if resource.URL != "" {
+			existingURLs[core.NormalizeURL(resource.URL)] = true
+		}
+	}
+
+	created := 0
+	for _, feed := range feedList {
+		// Stop between feeds; the feeds already fetched keep their resources
+		if err := cmd.Context().Err(); err != nil {
+			return err
+		}
+
+		parsed, err := fetchFeed(cmd.Context(), feed.URL)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("%s (%s): %v", feed.ID, feed.Title, err))
+			continue
+		}
+
+		var items []feeds.Item
+		var seen []string
+		for _, item := range parsed.Items {
+			if feed.HasSeen(item.ID) {
+				continue
+			}
+			seen = append(seen, item.ID)
+			if item.Link == "" || existingURLs[core.NormalizeURL(item.Link)] {
+				continue
+			}
+			items = append(items, item)
+		}
+
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].Published.After(items[j].Published)
+		})
+
+		fmt.Printf("\n%s  %s: %d new items\n", feed.ID, feed.Title, len(items))
+
+		var selected []int
+		if len(items) > 0 {
+			for i, item := range items {
+				date := ""
+				if !item.Published.IsZero() {
+					date = formatDate(item.Published) + "  "
+				}
+				fmt.Printf("  %2d. %s%s\n", i+1, date, item.Title)
+				if verbose {
+					fmt.Printf("      %s\n", item.Link)
+				}
+			}
+
+			if feedAll {
+				selected = parseSelection("all", len(items))
+			} else {
+				answer := PromptString("Add which items? (e.g. 1,3,5-7, all, empty for none)", "")
+				selected = parseSelection(answer, len(items))
+			}
+		}
+
+		for _, i := range selected {
+			item := items[i]
+
+			id, err := GenerateNextID("resource")
+			if err != nil {
+				return fmt.Errorf("failed to generate resource ID: %w", err)
+			}
+
+			resource, err := core.NewResource(id, item.Title, importer.GuessResourceType(item.Link), feed.SkillID)
+			if err != nil {
+				PrintWarning(fmt.Sprintf("Skipping %s: %v", item.Link, err))
+				continue
+			}
+			resource.SetURL(item.Link)
+			if item.Author != "" {
+				resource.SetAuthor(item.Author)
+			}
+			for _, tag := range feed.Tags {
+				resource.AddTag(tag)
+			}
+
+			if err := links.CreateResource(resource); err != nil {
+				return fmt.Errorf("failed to save resource: %w", err)
+			}
+			existingURLs[core.NormalizeURL(item.Link)] = true
+			created++
+
+			if verbose {
+				fmt.Printf("  Created %s: %s (%s)\n", resource.ID, resource.Title, resource.Type)
+			}
+		}
+
+		feed.MarkSeen(seen...)
+		feed.MarkFetched(time.Now())
+		if err := feedRepo.Update(feed); err != nil {
+			return fmt.Errorf("failed to update feed: %w", err)
+		}
+	}
+
+	fmt.Println()
+	PrintSuccess(fmt.Sprintf("Added %d resources from feeds", created))
+	return nil
+}
+
+func runFeedRemove(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	feed, err := feedRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("feed '%s' not found. Use 'growth feed list' to see available feeds", id)
+	}
+
+	fmt.Printf("You are about to stop watching:\n")
+	fmt.Printf("  ID: %s\n", feed.ID)
+	fmt.Printf("  Title: %s\n", feed.Title)
+	fmt.Printf("  URL: %s\n", feed.URL)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to remove this feed?") {
+		PrintInfo("Removal cancelled")
+		return nil
+	}
+
+	if err := feedRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to remove feed: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Removed feed %s", id))
+	return nil
+}
+
+func fetchFeed(ctx context.Context, url string) (*feeds.Feed, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	progress := startSpinner("Fetching " + url)
+	defer progress.Stop()
+
+	return feeds.Fetch(ctx, &http.Client{Timeout: 30 * time.Second}, url)
+}
+
+// parseSelection converts input like "1,3,5-7" or "all" into zero-based indexes
+// below n. Invalid or out-of-range entries are ignored.
+func parseSelection(input string, n int) []int {
+	input = strings.TrimSpace(strings.ToLower(input))
+	if input == "" {
+		return nil
+	}
+
+	if input == "all" || input == "a" {
+		indexes := make([]int, n)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes
+	}
+
+	chosen := make(map[int]bool)
+	for _, part := range strings.Split(input, ",") {
+		part = strings.TrimSpace(part)
+		from, to := part, part
+		if lo, hi, ok := strings.Cut(part, "-"); ok {
+			from, to = strings.TrimSpace(lo), strings.TrimSpace(hi)
+		}
+
+		start, err1 := strconv.Atoi(from)
+		end, err2 := strconv.Atoi(to)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		for i := start; i <= end; i++ {
+			if i >= 1 && i <= n {
+				chosen[i-1] = true
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(chosen))
+	for i := range chosen {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
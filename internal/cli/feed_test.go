@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSelection(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		n     int
+		want  []int
+	}{
+		{"empty selects nothing", "", 5, nil},
+		{"all selects everything", "all", 3, []int{0, 1, 2}},
+		{"single numbers", "1, 3", 5, []int{0, 2}},
+		{"ranges", "2-4", 5, []int{1, 2, 3}},
+		{"mixed with duplicates", "1,2-3,3", 5, []int{0, 1, 2}},
+		{"ignores out of range and junk", "0,2,9,x,4-12", 5, []int{1, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSelection(tt.input, tt.n)
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
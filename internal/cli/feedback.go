@@ -0,0 +1,382 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	feedbackDryRun bool
+	feedbackSkill  string
+	feedbackFrom   string
+	feedbackSince  string
+)
+
+var feedbackCmd = &cobra.Command{
+	Use:   "feedback",
+	Short: "Track 360 feedback from peers and managers",
+	Long: `Keep the feedback peers, managers and reports give you, linked to the
+skills it is about. Feedback shows up in 'growth skill view' and is passed to
+'growth analyze' and AI path generation, so plans work on what others see.`,
+}
+
+var feedbackImportCmd = &cobra.Command{
+	Use:   "import <yaml>",
+	Short: "Import feedback entries from a YAML file",
+	Long: `Import a round of 360 feedback written up as YAML. Each entry is what one
+person said; skills are matched by ID or title, and unknown skills are
+skipped with a warning. Date and cycle at the top apply to every entry that
+does not set its own. Entries already imported (same person, day and skills)
+are skipped.
+
+  cycle: 2025 H1
+  date: 2025-06-30
+  feedback:
+    - from: Alice
+      relationship: peer        # peer, manager or report
+      skills: [skill-001, Kubernetes]
+      rating: 4                 # optional, 1-5
+      strengths:
+        - Thorough code reviews
+      improvements:
+        - Share designs earlier
+      comment: Great to work with on the payments migration.
+
+Examples:
+  growth feedback import review-2025-h1.yaml
+  growth feedback import review-2025-h1.yaml --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFeedbackImport,
+}
+
+var feedbackListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List feedback",
+	Long: `List feedback, newest first.
+
+Examples:
+  growth feedback list
+  growth feedback list --skill skill-001
+  growth feedback list --from Alice --since 2025-01-01`,
+	Aliases: []string{"ls"},
+	RunE:    runFeedbackList,
+}
+
+var feedbackViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View a piece of feedback",
+	Long: `View a piece of feedback with its strengths, improvements and comment.
+
+Examples:
+  growth feedback view feedback-001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFeedbackView,
+}
+
+var feedbackDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a piece of feedback",
+	Long: `Delete a piece of feedback by ID. You'll be prompted for confirmation
+before deletion.
+
+Examples:
+  growth feedback delete feedback-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runFeedbackDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(feedbackCmd)
+	feedbackCmd.AddCommand(feedbackImportCmd)
+	feedbackCmd.AddCommand(feedbackListCmd)
+	feedbackCmd.AddCommand(feedbackViewCmd)
+	feedbackCmd.AddCommand(feedbackDeleteCmd)
+
+	feedbackImportCmd.Flags().BoolVar(&feedbackDryRun, "dry-run", false, "show what would be imported without saving anything")
+
+	feedbackListCmd.Flags().StringVar(&feedbackSkill, "skill", "", "filter by skill ID")
+	feedbackListCmd.Flags().StringVar(&feedbackFrom, "from", "", "filter by the person who gave it")
+	feedbackListCmd.Flags().StringVar(&feedbackSince, "since", "", "only show feedback on or after this date (YYYY-MM-DD)")
+}
+
+func runFeedbackImport(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open feedback file: %w", err)
+	}
+	defer f.Close()
+
+	file, err := importer.ParseFeedback(f)
+	if err != nil {
+		return err
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+	existing, err := feedbackRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load feedback: %w", err)
+	}
+
+	imported, skipped := 0, 0
+	for _, entry := range file.Entries {
+		feedback, unknown, err := buildFeedback(entry, "feedback-000", skills)
+		if err != nil {
+			return fmt.Errorf("feedback from %s: %w", entry.From, err)
+		}
+		for _, ref := range unknown {
+			PrintWarning(fmt.Sprintf("Skill '%s' in the feedback from %s not found, skipping it", ref, entry.From))
+		}
+
+		if duplicate := sameFeedback(existing, feedback); duplicate != nil {
+			PrintInfo(fmt.Sprintf("Already imported: %s on %s (%s)", feedback.Title, formatDate(feedback.Date), duplicate.ID))
+			skipped++
+			continue
+		}
+
+		if feedbackDryRun {
+			fmt.Printf("  %s  %s%s\n", formatDate(feedback.Date), feedback.Title, feedbackSkillsNote(feedback))
+			imported++
+			continue
+		}
+
+		id, err := GenerateNextID("feedback")
+		if err != nil {
+			return fmt.Errorf("failed to generate feedback ID: %w", err)
+		}
+		feedback.ID = id
+		if err := feedbackRepo.Create(feedback); err != nil {
+			return fmt.Errorf("failed to save feedback: %w", err)
+		}
+		existing = append(existing, feedback)
+		imported++
+		PrintSuccess(fmt.Sprintf("Imported %s: %s%s", feedback.ID, feedback.Title, feedbackSkillsNote(feedback)))
+	}
+
+	entries := "feedback entries"
+	if imported == 1 {
+		entries = "feedback entry"
+	}
+	summary := fmt.Sprintf("Imported %d %s", imported, entries)
+	if feedbackDryRun {
+		summary = fmt.Sprintf("Would import %d %s", imported, entries)
+	}
+	if skipped > 0 {
+		summary += fmt.Sprintf(", skipped %d already imported", skipped)
+	}
+	PrintInfo(summary)
+	return nil
+}
+
+// buildFeedback turns an imported entry into feedback, matching its skills by
+// ID or title. It returns the skill references that matched no skill.
+func buildFeedback(entry importer.FeedbackEntry, id core.EntityID, skills []*core.Skill) (*core.Feedback, []string, error) {
+	feedback, err := core.NewFeedback(id, entry.From, entry.Relationship, entry.Given)
+	if err != nil {
+		return nil, nil, err
+	}
+	feedback.Cycle = entry.Cycle
+	if err := feedback.SetRating(entry.Rating); err != nil {
+		return nil, nil, err
+	}
+	feedback.Strengths = entry.Strengths
+	feedback.Improvements = entry.Improvements
+	feedback.Body = entry.Comment
+
+	var unknown []string
+	for _, ref := range entry.Skills {
+		skill := feedbackSkillMatch(ref, skills)
+		if skill == nil {
+			unknown = append(unknown, ref)
+			continue
+		}
+		feedback.AddSkill(skill.ID)
+	}
+	return feedback, unknown, nil
+}
+
+// feedbackSkillMatch finds the skill with the ID or title, ignoring case
+func feedbackSkillMatch(ref string, skills []*core.Skill) *core.Skill {
+	for _, skill := range skills {
+		if string(skill.ID) == ref || strings.EqualFold(skill.Title, ref) {
+			return skill
+		}
+	}
+	return nil
+}
+
+// sameFeedback returns the existing feedback the new one duplicates, if any
+func sameFeedback(existing []*core.Feedback, feedback *core.Feedback) *core.Feedback {
+	for _, other := range existing {
+		if other.SameAs(feedback) {
+			return other
+		}
+	}
+	return nil
+}
+
+func feedbackSkillsNote(feedback *core.Feedback) string {
+	if len(feedback.Skills) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" on %s", formatEntityIDs(feedback.Skills))
+}
+
+func runFeedbackList(cmd *cobra.Command, args []string) error {
+	var feedback []*core.Feedback
+	var err error
+
+	if feedbackSkill != "" {
+		feedback, err = feedbackRepo.FindBySkill(core.EntityID(feedbackSkill))
+	} else {
+		feedback, err = feedbackRepo.FindSince(time.Time{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve feedback: %w", err)
+	}
+
+	var since time.Time
+	if feedbackSince != "" {
+		since, err = time.Parse("2006-01-02", feedbackSince)
+		if err != nil {
+			return fmt.Errorf("invalid since date format (use YYYY-MM-DD): %w", err)
+		}
+	}
+
+	var filtered []*core.Feedback
+	for _, f := range feedback {
+		if f.Date.Before(since) || (feedbackFrom != "" && !strings.EqualFold(f.From, strings.TrimSpace(feedbackFrom))) {
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+
+	if len(filtered) == 0 {
+		PrintInfo("No feedback found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, f := range filtered {
+			fmt.Printf("%s  %s  %s", f.ID, formatDate(f.Date), f.Title)
+			if f.Rating > 0 {
+				fmt.Printf("  %d/%d", f.Rating, core.MaxFeedbackRating)
+			}
+			if len(f.Skills) > 0 {
+				fmt.Printf("  %s", formatEntityIDs(f.Skills))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(filtered)
+}
+
+func runFeedbackView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	feedback, err := feedbackRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("feedback '%s' not found. Use 'growth feedback list' to see available feedback", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:     %s\n", feedback.ID)
+		fmt.Printf("Title:  %s\n", feedback.Title)
+		fmt.Printf("From:   %s (%s)\n", feedback.From, feedback.Relationship)
+		fmt.Printf("Date:   %s\n", formatDate(feedback.Date))
+		if feedback.Cycle != "" {
+			fmt.Printf("Cycle:  %s\n", feedback.Cycle)
+		}
+		if feedback.Rating > 0 {
+			fmt.Printf("Rating: %d/%d\n", feedback.Rating, core.MaxFeedbackRating)
+		}
+		if len(feedback.Skills) > 0 {
+			fmt.Printf("Skills: %s\n", formatEntityIDs(feedback.Skills))
+		}
+
+		printFeedbackPoints(feedback)
+
+		if feedback.Body != "" {
+			fmt.Printf("\nComment:\n%s\n", feedback.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(feedback)
+}
+
+// printFeedbackPoints lists the strengths and improvements of the feedback
+func printFeedbackPoints(feedback *core.Feedback) {
+	if len(feedback.Strengths) > 0 {
+		fmt.Println("\nStrengths:")
+		for _, strength := range feedback.Strengths {
+			fmt.Printf("  + %s\n", strength)
+		}
+	}
+	if len(feedback.Improvements) > 0 {
+		fmt.Println("\nTo improve:")
+		for _, improvement := range feedback.Improvements {
+			fmt.Printf("  - %s\n", improvement)
+		}
+	}
+}
+
+// printSkillFeedback lists the feedback about a skill in its view
+func printSkillFeedback(feedback []*core.Feedback) {
+	if len(feedback) == 0 {
+		return
+	}
+
+	fmt.Println("\nFeedback:")
+	for _, f := range feedback {
+		fmt.Printf("  %s  %s (%s)", formatDate(f.Date), f.From, f.Relationship)
+		if f.Rating > 0 {
+			fmt.Printf("  %d/%d", f.Rating, core.MaxFeedbackRating)
+		}
+		fmt.Println()
+		for _, strength := range f.Strengths {
+			fmt.Printf("    + %s\n", strength)
+		}
+		for _, improvement := range f.Improvements {
+			fmt.Printf("    - %s\n", improvement)
+		}
+	}
+}
+
+func runFeedbackDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	feedback, err := feedbackRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("feedback '%s' not found. Use 'growth feedback list' to see available feedback", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", feedback.ID)
+	fmt.Printf("  Title: %s\n", feedback.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this feedback?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := feedbackRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete feedback: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted feedback %s", id))
+	return nil
+}
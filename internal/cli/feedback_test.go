@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildFeedback(t *testing.T) {
+	skills := []*core.Skill{
+		{ID: "skill-001", Title: "Kubernetes"},
+		{ID: "skill-002", Title: "System Design"},
+	}
+	entry := importer.FeedbackEntry{
+		From:         "Alice",
+		Relationship: core.FeedbackPeer,
+		Given:        time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Cycle:        "2025 H1",
+		Skills:       []string{"skill-001", "system design", "Rust"},
+		Rating:       4,
+		Strengths:    []string{"Thorough code reviews"},
+		Improvements: []string{"Share designs earlier"},
+		Comment:      "Great to work with.",
+	}
+
+	feedback, unknown, err := buildFeedback(entry, "feedback-003", skills)
+
+	require.NoError(t, err)
+	assert.Equal(t, core.EntityID("feedback-003"), feedback.ID)
+	assert.Equal(t, "Feedback from Alice (peer)", feedback.Title)
+	assert.Equal(t, []core.EntityID{"skill-001", "skill-002"}, feedback.Skills)
+	assert.Equal(t, []string{"Rust"}, unknown)
+	assert.Equal(t, 4, feedback.Rating)
+	assert.Equal(t, "2025 H1", feedback.Cycle)
+	assert.Equal(t, "Great to work with.", feedback.Body)
+}
+
+func TestSameFeedback(t *testing.T) {
+	date := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	existing, _ := core.NewFeedback("feedback-001", "Alice", core.FeedbackPeer, date)
+	existing.AddSkill("skill-001")
+
+	again, _ := core.NewFeedback("feedback-000", "Alice", core.FeedbackPeer, date)
+	again.AddSkill("skill-001")
+	other, _ := core.NewFeedback("feedback-000", "Bo", core.FeedbackManager, date)
+	other.AddSkill("skill-001")
+
+	match := sameFeedback([]*core.Feedback{existing}, again)
+	require.NotNil(t, match)
+	assert.Equal(t, core.EntityID("feedback-001"), match.ID)
+	assert.Nil(t, sameFeedback([]*core.Feedback{existing}, other))
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var focusMinutes int
+
+var focusCmd = &cobra.Command{
+	Use:   "focus",
+	Short: "Run focused study sessions",
+	Long:  `Time focused study sessions and log the distractions that interrupt them.`,
+}
+
+var focusStartCmd = &cobra.Command{
+	Use:   "start <resource-id>",
+	Short: "Start a focus session on a resource",
+	Long: `Start a timer for a focused study session on a resource.
+
+While the timer runs, press Enter to log a distraction; type a few words
+first to note what it was. Type 's' and press Enter (or press Ctrl+C) to stop.
+With --minutes the session also stops when the time is up.
+
+On stop, a progress log is created for today with the focused minutes,
+the number of distractions and the time added to the resource's hours.
+'growth stats' summarizes focus sessions over time.
+
+Examples:
+  growth focus start resource-004
+  growth focus start resource-004 --minutes 25`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFocusStart,
+}
+
+func init() {
+	rootCmd.AddCommand(focusCmd)
+	focusCmd.AddCommand(focusStartCmd)
+
+	focusStartCmd.Flags().IntVarP(&focusMinutes, "minutes", "m", 0, "stop automatically after this many minutes")
+}
+
+func runFocusStart(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	resource, err := resourceRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
+	}
+	if focusMinutes < 0 {
+		return fmt.Errorf("minutes must be greater than 0")
+	}
+
+	// Ctrl+C cancels the command context and ends the session like 's'
+	ctx := cmd.Context()
+
+	var timeUp <-chan time.Time
+	if focusMinutes > 0 {
+		timer := time.NewTimer(time.Duration(focusMinutes) * time.Minute)
+		defer timer.Stop()
+		timeUp = timer.C
+	}
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			lines <- strings.TrimSpace(line)
+		}
+	}()
+
+	session := core.NewFocusSession(resource.ID, time.Now())
+	PrintInfo(fmt.Sprintf("Focusing on %s: %s", resource.ID, resource.Title))
+	if focusMinutes > 0 {
+		fmt.Printf("Timer set for %d minutes, until %s.\n", focusMinutes, session.Started.Add(time.Duration(focusMinutes)*time.Minute).Format("15:04"))
+	}
+	fmt.Println("Press Enter to log a distraction (type a note first if you like), 's' + Enter to stop.")
+
+loop:
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok || strings.EqualFold(line, "s") || strings.EqualFold(line, "stop") {
+				break loop
+			}
+			now := time.Now()
+			session.LogDistraction(now, line)
+			fmt.Printf("Distraction %d logged after %d min. Back to it!\n", len(session.Distractions), session.Minutes(now))
+		case <-timeUp:
+			fmt.Println("\a")
+			PrintInfo("Time's up")
+			break loop
+		case <-ctx.Done():
+			fmt.Println()
+			break loop
+		}
+	}
+
+	session.Stop(time.Now())
+	return saveFocusSession(session, resource)
+}
+
+// saveFocusSession records a finished focus session as a progress log and
+// adds its time to the resource
+func saveFocusSession(session *core.FocusSession, resource *core.Resource) error {
+	minutes := session.Minutes(session.Stopped)
+	if minutes < 1 {
+		PrintInfo("Focus session shorter than a minute, nothing recorded")
+		return nil
+	}
+
+	id, err := GenerateNextID("progress")
+	if err != nil {
+		return fmt.Errorf("failed to generate progress ID: %w", err)
+	}
+
+	log, err := core.NewProgressLog(id, session.Started)
+	if err != nil {
+		return fmt.Errorf("failed to create progress log: %w", err)
+	}
+	if err := log.RecordFocus(minutes, len(session.Distractions)); err != nil {
+		return fmt.Errorf("failed to record focus session: %w", err)
+	}
+	log.AddResourceUsed(resource.ID)
+	log.AddSkillWorked(resource.SkillID)
+	log.Body = session.Summary(resource.Title)
+
+	if err := progressRepo.Create(log); err != nil {
+		return fmt.Errorf("failed to save progress log: %w", err)
+	}
+
+	if resource.Status == core.ResourceNotStarted {
+		resource.Start()
+		if err := resourceRepo.Update(resource); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to start resource %s: %v", resource.ID, err))
+		}
+	}
+	accrueResourceHours(log)
+	startSkillsWorked(log)
+
+	PrintSuccess(fmt.Sprintf("Focused %d min on %s with %s, logged as %s",
+		minutes, resource.ID, countOf(len(session.Distractions), "distraction"), log.ID))
+	return nil
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/illenko/growth.md/internal/i18n"
+)
+
+// defaultDateLayout is used when display.dateFormat is not set
+const defaultDateLayout = "2006-01-02"
+
+// dateLayout returns the Go time layout for dates, display.dateFormat in config.yml
+func dateLayout() string {
+	if config == nil || config.Display.DateFormat == "" {
+		return defaultDateLayout
+	}
+	return config.Display.DateFormat
+}
+
+// formatDate formats a date for display with display.dateFormat
+func formatDate(t time.Time) string {
+	return t.Format(dateLayout())
+}
+
+// formatDateTime formats a date and time of day for display with
+// display.dateFormat, in local time
+func formatDateTime(t time.Time) string {
+	return t.Local().Format(dateLayout() + " 15:04")
+}
+
+// formatNumber formats a number for display with the given decimals and
+// the decimal separator of user.language
+func formatNumber(v float64, decimals int) string {
+	return i18n.FormatNumber(v, decimals)
+}
@@ -47,11 +47,6 @@ func init() {
 }
 
 func runGitStatus(cmd *cobra.Command, args []string) error {
-	// Check if git is installed
-	if err := git.EnsureGitInstalled(); err != nil {
-		return fmt.Errorf("git is not installed: %w", err)
-	}
-
 	// Check if we're in a git repository
 	if !git.IsRepo(repoPath) {
 		PrintWarning("Not a git repository")
@@ -62,7 +57,7 @@ func runGitStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get git status
-	status, err := git.Status(repoPath)
+	status, err := git.StatusContext(cmd.Context(), repoPath)
 	if err != nil {
 		return fmt.Errorf("failed to get git status: %w", err)
 	}
@@ -90,11 +85,6 @@ func runGitStatus(cmd *cobra.Command, args []string) error {
 }
 
 func runGitLog(cmd *cobra.Command, args []string) error {
-	// Check if git is installed
-	if err := git.EnsureGitInstalled(); err != nil {
-		return fmt.Errorf("git is not installed: %w", err)
-	}
-
 	// Check if we're in a git repository
 	if !git.IsRepo(repoPath) {
 		PrintWarning("Not a git repository")
@@ -102,7 +92,7 @@ func runGitLog(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get git log
-	commits, err := git.Log(repoPath, gitLogCount)
+	commits, err := git.LogContext(cmd.Context(), repoPath, gitLogCount)
 	if err != nil {
 		return fmt.Errorf("failed to get git log: %w", err)
 	}
@@ -15,6 +15,8 @@ var (
 	goalTags       string
 	goalTargetDate string
 	goalTitle      string
+	goalBlocker    int
+	goalComplete   string
 )
 
 var goalCmd = &cobra.Command{
@@ -34,6 +36,8 @@ Optionally specify priority, target date, and tags using flags.
 Examples:
   growth goal create "Senior Engineer by 2025" --priority high --target 2025-12-31
   growth goal create "Learn Cloud Architecture" --tags cloud,aws,architecture
+  growth goal create "Pass the CKA exam" --target "end of Q3"
+  growth goal create "Ship a side project" --complete-when milestones,paths
   growth goal create`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runGoalCreate,
@@ -63,6 +67,7 @@ The output format can be controlled with the --format flag (table, json, yaml).
 
 Examples:
   growth goal view goal-001
+  growth goal view goal-001 --history
   growth goal view goal-042 --format json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runGoalView,
@@ -79,6 +84,7 @@ to update each field interactively (press Enter to keep current value).
 Examples:
   growth goal edit goal-001 --priority high
   growth goal edit goal-042 --status completed --target 2025-06-30
+  growth goal edit goal-001 --complete-when milestones
   growth goal edit goal-001`,
 	Args: cobra.ExactArgs(1),
 	RunE: runGoalEdit,
@@ -122,6 +128,37 @@ Examples:
 	RunE: runGoalRemovePath,
 }
 
+var goalBlockCmd = &cobra.Command{
+	Use:   "block <id> <blocker>",
+	Short: "Mark a goal as blocked",
+	Long: `Mark a goal as blocked and record what is blocking it.
+
+Run again to add more blockers. Blocked goals are highlighted in the
+overview and in 'growth today' so stalled objectives don't hide behind an
+"active" status.
+
+Examples:
+  growth goal block goal-001 "Waiting on team lead to approve conference budget"
+  growth goal block goal-002 "Need access to production Kubernetes cluster"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runGoalBlock,
+}
+
+var goalUnblockCmd = &cobra.Command{
+	Use:   "unblock <id>",
+	Short: "Clear blockers from a goal",
+	Long: `Clear all blockers from a goal and make it active again.
+
+Use --blocker to resolve a single blocker by its number in 'growth goal view';
+the goal becomes active once no blockers remain.
+
+Examples:
+  growth goal unblock goal-001
+  growth goal unblock goal-001 --blocker 2`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGoalUnblock,
+}
+
 func init() {
 	rootCmd.AddCommand(goalCmd)
 	goalCmd.AddCommand(goalCreateCmd)
@@ -131,19 +168,31 @@ func init() {
 	goalCmd.AddCommand(goalDeleteCmd)
 	goalCmd.AddCommand(goalAddPathCmd)
 	goalCmd.AddCommand(goalRemovePathCmd)
+	goalCmd.AddCommand(goalBlockCmd)
+	goalCmd.AddCommand(goalUnblockCmd)
 
 	goalCreateCmd.Flags().StringVarP(&goalPriority, "priority", "p", "", "goal priority (high, medium, low)")
-	goalCreateCmd.Flags().StringVarP(&goalTargetDate, "target", "d", "", "target date (YYYY-MM-DD)")
+	goalCreateCmd.Flags().StringVarP(&goalTargetDate, "target", "d", "", "target date (YYYY-MM-DD or e.g. 'end of Q3', 'in 3 months')")
 	goalCreateCmd.Flags().StringVarP(&goalTags, "tags", "t", "", "comma-separated tags")
+	goalCreateCmd.Flags().StringVar(&goalComplete, "complete-when", "", "completion criteria (milestones, paths), comma-separated")
+	goalCreateCmd.Flags().StringArrayVar(&ticketLinks, "link", nil, "link a Jira ticket, e.g. WORK-123 (repeatable)")
 
-	goalListCmd.Flags().StringVarP(&goalStatus, "status", "s", "", "filter by status (active, completed, archived)")
+	goalListCmd.Flags().StringVarP(&goalStatus, "status", "s", "", "filter by status (active, blocked, completed, archived)")
 	goalListCmd.Flags().StringVarP(&goalPriority, "priority", "p", "", "filter by priority (high, medium, low)")
 
 	goalEditCmd.Flags().StringVar(&goalTitle, "title", "", "goal title")
 	goalEditCmd.Flags().StringVarP(&goalPriority, "priority", "p", "", "goal priority")
 	goalEditCmd.Flags().StringVarP(&goalStatus, "status", "s", "", "goal status")
-	goalEditCmd.Flags().StringVarP(&goalTargetDate, "target", "d", "", "target date (YYYY-MM-DD)")
+	goalEditCmd.Flags().StringVarP(&goalTargetDate, "target", "d", "", "target date (YYYY-MM-DD or e.g. 'end of Q3', 'in 3 months')")
 	goalEditCmd.Flags().StringVarP(&goalTags, "tags", "t", "", "comma-separated tags")
+	goalEditCmd.Flags().StringVar(&goalComplete, "complete-when", "", "completion criteria (milestones, paths), comma-separated - empty to clear")
+	goalEditCmd.Flags().StringArrayVar(&ticketLinks, "link", nil, "link a Jira ticket, e.g. WORK-123 (repeatable)")
+	goalEditCmd.Flags().StringArrayVar(&ticketUnlinks, "unlink", nil, "unlink a Jira ticket (repeatable)")
+
+	goalUnblockCmd.Flags().IntVar(&goalBlocker, "blocker", 0, "resolve only this blocker (1-based)")
+
+	goalViewCmd.Flags().BoolVar(&showHistory, "history", false, "show the history of status, level, and priority changes")
+	goalViewCmd.Flags().StringVar(&openAttachment, "open", "", "open the attachment with this name")
 }
 
 func runGoalCreate(cmd *cobra.Command, args []string) error {
@@ -178,9 +227,9 @@ func runGoalCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	if goalTargetDate != "" {
-		targetDate, err := time.Parse("2006-01-02", goalTargetDate)
+		targetDate, err := parseDateFlag("target", goalTargetDate)
 		if err != nil {
-			return fmt.Errorf("invalid target date format (use YYYY-MM-DD): %w", err)
+			return err
 		}
 		goal.SetTargetDate(targetDate)
 	}
@@ -192,6 +241,18 @@ func runGoalCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if goalComplete != "" {
+		criteria, err := core.ParseCompletionCriteria(goalComplete)
+		if err != nil {
+			return err
+		}
+		goal.CompleteWhen = criteria
+	}
+
+	if _, err := linkTickets(&goal.Tickets, ticketLinks, nil); err != nil {
+		return err
+	}
+
 	description := PromptMultiline("Description (optional, press Ctrl+D or enter '.' to finish)")
 	if description != "" {
 		goal.Body = description
@@ -210,7 +271,7 @@ func runGoalCreate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Priority: %s\n", goal.Priority)
 		fmt.Printf("  Status: %s\n", goal.Status)
 		if goal.TargetDate != nil {
-			fmt.Printf("  Target: %s\n", goal.TargetDate.Format("2006-01-02"))
+			fmt.Printf("  Target: %s\n", formatDate(*goal.TargetDate))
 		}
 		if len(goal.Tags) > 0 {
 			fmt.Printf("  Tags: %s\n", strings.Join(goal.Tags, ", "))
@@ -226,8 +287,8 @@ func runGoalList(cmd *cobra.Command, args []string) error {
 
 	if goalStatus != "" {
 		status := core.Status(goalStatus)
-		if !status.IsValid() {
-			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived", goalStatus)
+		if !status.IsValidForGoal() {
+			return fmt.Errorf("invalid status '%s'. Valid options: active, blocked, completed, archived", goalStatus)
 		}
 		goals, err = goalRepo.FindByStatus(status)
 	} else if goalPriority != "" {
@@ -272,13 +333,23 @@ func runGoalView(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
 	}
 
+	if openAttachment != "" {
+		return openEntityAttachment(goal.Attachments, openAttachment)
+	}
+
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", goal.ID)
 		fmt.Printf("Title:    %s\n", goal.Title)
 		fmt.Printf("Status:   %s\n", goal.Status)
 		fmt.Printf("Priority: %s\n", goal.Priority)
+		if len(goal.Blockers) > 0 {
+			fmt.Printf("Blockers:\n")
+			for i, blocker := range goal.Blockers {
+				fmt.Printf("  %d. %s\n", i+1, blocker)
+			}
+		}
 		if goal.TargetDate != nil {
-			fmt.Printf("Target:   %s\n", goal.TargetDate.Format("2006-01-02"))
+			fmt.Printf("Target:   %s%s\n", formatDate(*goal.TargetDate), dueDate(*goal.TargetDate))
 		}
 		if len(goal.Tags) > 0 {
 			fmt.Printf("Tags:     %s\n", strings.Join(goal.Tags, ", "))
@@ -289,19 +360,57 @@ func runGoalView(cmd *cobra.Command, args []string) error {
 		if len(goal.Milestones) > 0 {
 			fmt.Printf("Milestones: %v\n", goal.Milestones)
 		}
-		fmt.Printf("Created:  %s\n", goal.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", goal.Updated.Format("2006-01-02 15:04:05"))
+		if len(goal.CompleteWhen) > 0 {
+			fmt.Printf("Complete when: %s\n", describeCriteria(goal.CompleteWhen))
+		}
+		fmt.Printf("Created:  %s\n", formatDateTime(goal.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(goal.Updated))
 
 		if goal.Body != "" {
 			fmt.Printf("\nDescription:\n%s\n", goal.Body)
 		}
 
+		printTickets(goal.Tickets)
+		printAttachments(goal.Attachments)
+
+		if showHistory {
+			printHistory(goal.History)
+		}
+
+		warnGoalScheduleOverrun(goal)
+
 		return nil
 	}
 
 	return PrintOutputWithConfig(goal)
 }
 
+// warnGoalScheduleOverrun prints a warning for each linked path whose projected
+// end date falls after the goal's target date.
+func warnGoalScheduleOverrun(goal *core.Goal) {
+	if goal.TargetDate == nil {
+		return
+	}
+
+	for _, pathID := range goal.LearningPaths {
+		path, err := pathRepo.GetByID(pathID)
+		if err != nil || path.Status != core.StatusActive {
+			continue
+		}
+
+		projection, err := projectPathSchedule(path)
+		if err != nil || len(projection.Phases) == 0 {
+			continue
+		}
+
+		if projection.ExceedsTarget(goal.TargetDate) {
+			fmt.Println()
+			PrintWarning(fmt.Sprintf("Path %s is projected to finish on %s, after the target date %s",
+				path.ID, formatDate(projection.End), formatDate(*goal.TargetDate)))
+		}
+	}
+}
+
 func runGoalEdit(cmd *cobra.Command, args []string) error {
 	id := core.EntityID(args[0])
 
@@ -330,8 +439,8 @@ func runGoalEdit(cmd *cobra.Command, args []string) error {
 
 	if cmd.Flags().Changed("status") {
 		status := core.Status(goalStatus)
-		if !status.IsValid() {
-			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived", goalStatus)
+		if !status.IsValidForGoal() {
+			return fmt.Errorf("invalid status '%s'. Valid options: active, blocked, completed, archived", goalStatus)
 		}
 		if err := goal.UpdateStatus(status); err != nil {
 			return fmt.Errorf("failed to update status: %w", err)
@@ -343,9 +452,9 @@ func runGoalEdit(cmd *cobra.Command, args []string) error {
 		if goalTargetDate == "" {
 			goal.ClearTargetDate()
 		} else {
-			targetDate, err := time.Parse("2006-01-02", goalTargetDate)
+			targetDate, err := parseDateFlag("target", goalTargetDate)
 			if err != nil {
-				return fmt.Errorf("invalid target date format (use YYYY-MM-DD): %w", err)
+				return err
 			}
 			goal.SetTargetDate(targetDate)
 		}
@@ -363,6 +472,23 @@ func runGoalEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("complete-when") {
+		criteria, err := core.ParseCompletionCriteria(goalComplete)
+		if err != nil {
+			return err
+		}
+		goal.CompleteWhen = criteria
+		updated = true
+	}
+
+	if cmd.Flags().Changed("link") || cmd.Flags().Changed("unlink") {
+		changed, err := linkTickets(&goal.Tickets, ticketLinks, ticketUnlinks)
+		if err != nil {
+			return err
+		}
+		updated = updated || changed
+	}
+
 	if !updated {
 		PrintInfo("No changes specified. Use flags to update fields or run interactively.")
 
@@ -387,7 +513,7 @@ func runGoalEdit(cmd *cobra.Command, args []string) error {
 		if PromptConfirm("Update status?") {
 			newStatus := PromptSelectWithDefault(
 				"Status",
-				[]string{"active", "completed", "archived"},
+				[]string{"active", "blocked", "completed", "archived"},
 				string(goal.Status),
 			)
 			status := core.Status(newStatus)
@@ -402,13 +528,13 @@ func runGoalEdit(cmd *cobra.Command, args []string) error {
 			if goal.TargetDate != nil {
 				defaultDate = goal.TargetDate.Format("2006-01-02")
 			}
-			dateStr := PromptString("Target date (YYYY-MM-DD, empty to clear)", defaultDate)
+			dateStr := PromptString("Target date (YYYY-MM-DD or e.g. 'end of Q3', empty to clear)", defaultDate)
 			if dateStr == "" {
 				goal.ClearTargetDate()
 			} else {
-				targetDate, err := time.Parse("2006-01-02", dateStr)
+				targetDate, err := core.ParseDate(dateStr, time.Now())
 				if err != nil {
-					return fmt.Errorf("invalid date format: %w", err)
+					return err
 				}
 				goal.SetTargetDate(targetDate)
 			}
@@ -507,3 +633,57 @@ func runGoalRemovePath(cmd *cobra.Command, args []string) error {
 	PrintSuccess(fmt.Sprintf("Removed path %s from goal %s", pathID, goalID))
 	return nil
 }
+
+func runGoalBlock(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	goal, err := goalRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+	}
+
+	if strings.TrimSpace(args[1]) == "" {
+		return fmt.Errorf("blocker description cannot be empty")
+	}
+
+	goal.Block(args[1])
+
+	if err := goalRepo.Update(goal); err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Blocked goal %s: %s (%d blocker(s))", goal.ID, goal.Title, len(goal.Blockers)))
+	return nil
+}
+
+func runGoalUnblock(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	goal, err := goalRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+	}
+
+	if goalBlocker > 0 {
+		if err := goal.RemoveBlocker(goalBlocker); err != nil {
+			return fmt.Errorf("goal %s has %d blocker(s): %w", goal.ID, len(goal.Blockers), err)
+		}
+	} else {
+		if !goal.IsBlocked() && len(goal.Blockers) == 0 {
+			PrintInfo(fmt.Sprintf("Goal %s is not blocked", goal.ID))
+			return nil
+		}
+		goal.Unblock()
+	}
+
+	if err := goalRepo.Update(goal); err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	if goal.IsBlocked() {
+		PrintSuccess(fmt.Sprintf("Resolved blocker on goal %s (%d remaining)", goal.ID, len(goal.Blockers)))
+	} else {
+		PrintSuccess(fmt.Sprintf("Unblocked goal %s: %s", goal.ID, goal.Title))
+	}
+	return nil
+}
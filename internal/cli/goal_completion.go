@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var goalCheckCmd = &cobra.Command{
+	Use:   "check [goal-id]",
+	Short: "Offer to complete goals whose completion criteria are met",
+	Long: `Check the completion criteria of active goals, set with --complete-when on
+'growth goal create' or 'growth goal edit', and offer to mark each goal
+whose criteria are all met as completed.
+
+Goals are also checked when one of their milestones is achieved or one of
+their learning paths is completed.
+
+Examples:
+  growth goal check
+  growth goal check goal-001`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runGoalCheck,
+}
+
+func init() {
+	goalCmd.AddCommand(goalCheckCmd)
+}
+
+func runGoalCheck(cmd *cobra.Command, args []string) error {
+	var ids []core.EntityID
+	if len(args) > 0 {
+		id := core.EntityID(args[0])
+		goal, err := goalRepo.GetByID(id)
+		if err != nil {
+			return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+		}
+		if len(goal.CompleteWhen) == 0 {
+			PrintInfo(fmt.Sprintf("Goal %s has no completion criteria. Set them with 'growth goal edit %s --complete-when milestones,paths'", id, id))
+			return nil
+		}
+		ids = append(ids, id)
+	} else {
+		goals, err := goalRepo.FindByStatus(core.StatusActive)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve goals: %w", err)
+		}
+		for _, goal := range goals {
+			if len(goal.CompleteWhen) > 0 {
+				ids = append(ids, goal.ID)
+			}
+		}
+	}
+
+	if offerGoalCompletion(ids...) == 0 {
+		PrintInfo("No goals have all their completion criteria met")
+	}
+	return nil
+}
+
+// offerGoalCompletion asks to mark each of the goals completed whose
+// completion criteria are all met, and returns how many were offered
+func offerGoalCompletion(goalIDs ...core.EntityID) int {
+	if len(goalIDs) == 0 {
+		return 0
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return 0
+	}
+	paths, err := pathRepo.GetAll()
+	if err != nil {
+		return 0
+	}
+
+	offered := 0
+	for _, id := range goalIDs {
+		goal, err := goalRepo.GetByIDWithBody(id)
+		if err != nil || !goal.CriteriaMet(milestones, paths) {
+			continue
+		}
+		offered++
+
+		if !PromptConfirm(fmt.Sprintf("All completion criteria of goal %s (%s) are met. Mark it completed?", goal.ID, goal.Title)) {
+			continue
+		}
+		if err := goal.UpdateStatus(core.StatusCompleted); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to complete goal %s: %v", goal.ID, err))
+			continue
+		}
+		if err := goalRepo.Update(goal); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to update goal %s: %v", goal.ID, err))
+			continue
+		}
+		PrintSuccess(fmt.Sprintf("Completed goal %s: %s", goal.ID, goal.Title))
+	}
+	return offered
+}
+
+// goalsForMilestone returns the IDs of the goals a milestone counts toward
+func goalsForMilestone(milestone *core.Milestone) []core.EntityID {
+	var ids []core.EntityID
+	if milestone.ReferenceType == core.ReferenceGoal {
+		ids = append(ids, milestone.ReferenceID)
+	}
+
+	goals, err := goalRepo.GetAll()
+	if err != nil {
+		return ids
+	}
+	for _, goal := range goals {
+		if slices.Contains(goal.Milestones, milestone.ID) && !slices.Contains(ids, goal.ID) {
+			ids = append(ids, goal.ID)
+		}
+	}
+	return ids
+}
+
+// describeCriteria renders completion criteria for goal view
+func describeCriteria(criteria []core.CompletionCriterion) string {
+	descriptions := make([]string, len(criteria))
+	for i, criterion := range criteria {
+		switch criterion {
+		case core.CriterionMilestones:
+			descriptions[i] = "all milestones achieved"
+		case core.CriterionPaths:
+			descriptions[i] = "all learning paths completed"
+		default:
+			descriptions[i] = string(criterion)
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var goalReviewCmd = &cobra.Command{
+	Use:   "review [id...]",
+	Short: "Review open goals one by one",
+	Long: `Walk through each active or blocked goal with a few quick questions:
+is it still relevant, what progress was made this month, and what is
+blocking it.
+
+The answers update the goal: goals that are no longer relevant are archived
+or completed, new blockers block the goal, resolved ones unblock it, and the
+target date can be moved. A dated review note is added to the Reviews
+section of the goal's description.
+
+Examples:
+  growth goal review
+  growth goal review goal-001 goal-003`,
+	RunE: runGoalReview,
+}
+
+func init() {
+	goalCmd.AddCommand(goalReviewCmd)
+}
+
+// goalReview is the answers given for one goal in a review
+type goalReview struct {
+	Relevant bool
+	Outcome  core.Status // archived or completed, when the goal is no longer relevant
+	Progress string
+	Resolved bool   // the goal's blockers are gone
+	Blocker  string // new blocker
+	Target   *time.Time
+}
+
+func runGoalReview(cmd *cobra.Command, args []string) error {
+	goals, err := goalsToReview(args)
+	if err != nil {
+		return err
+	}
+	if len(goals) == 0 {
+		PrintInfo("No active or blocked goals to review")
+		return nil
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	now := time.Now()
+	for i, goal := range goals {
+		fmt.Printf("\n[%d/%d] %s: %s\n", i+1, len(goals), goal.ID, goal.Title)
+		printGoalReviewContext(goal, milestones, now)
+		fmt.Println()
+
+		review := askGoalReview(goal)
+		if err := applyGoalReview(goal, review, now); err != nil {
+			return err
+		}
+		if err := goalRepo.Update(goal); err != nil {
+			return fmt.Errorf("failed to update goal %s: %w", goal.ID, err)
+		}
+		PrintSuccess(fmt.Sprintf("Reviewed %s: %s", goal.ID, goalReviewNote(review)))
+	}
+
+	fmt.Println()
+	PrintSuccess(fmt.Sprintf("Reviewed %s", countOf(len(goals), "goal")))
+	return nil
+}
+
+// goalsToReview loads the goals with the IDs, or all active and blocked goals
+// by priority
+func goalsToReview(ids []string) ([]*core.Goal, error) {
+	var goals []*core.Goal
+	if len(ids) > 0 {
+		for _, arg := range ids {
+			id := core.EntityID(arg)
+			goal, err := goalRepo.GetByIDWithBody(id)
+			if err != nil {
+				return nil, fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+			}
+			goals = append(goals, goal)
+		}
+		return goals, nil
+	}
+
+	all, err := goalRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load goals: %w", err)
+	}
+	for _, goal := range all {
+		if goal.Status != core.StatusActive && goal.Status != core.StatusBlocked {
+			continue
+		}
+		withBody, err := goalRepo.GetByIDWithBody(goal.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load goal %s: %w", goal.ID, err)
+		}
+		goals = append(goals, withBody)
+	}
+
+	rank := map[core.Priority]int{core.PriorityHigh: 0, core.PriorityMedium: 1, core.PriorityLow: 2}
+	slices.SortStableFunc(goals, func(a, b *core.Goal) int {
+		if rank[a.Priority] != rank[b.Priority] {
+			return rank[a.Priority] - rank[b.Priority]
+		}
+		return strings.Compare(string(a.ID), string(b.ID))
+	})
+	return goals, nil
+}
+
+// printGoalReviewContext shows what the questions are about: the status,
+// target date and the goal's milestones achieved in the last month
+func printGoalReviewContext(goal *core.Goal, milestones []*core.Milestone, now time.Time) {
+	fmt.Printf("  Status:     %s, %s priority\n", goal.Status, goal.Priority)
+	if goal.TargetDate != nil {
+		fmt.Printf("  Target:     %s%s\n", formatDate(*goal.TargetDate), dueDate(*goal.TargetDate))
+	}
+	for i, blocker := range goal.Blockers {
+		fmt.Printf("  Blocker %d:  %s\n", i+1, blocker)
+	}
+
+	total, achieved, recent := 0, 0, 0
+	monthAgo := now.AddDate(0, -1, 0)
+	for _, milestone := range milestones {
+		if !slices.Contains(goal.Milestones, milestone.ID) &&
+			(milestone.ReferenceType != core.ReferenceGoal || milestone.ReferenceID != goal.ID) {
+			continue
+		}
+		total++
+		if milestone.IsAchieved() {
+			achieved++
+			if milestone.AchievedDate.After(monthAgo) {
+				recent++
+			}
+		}
+	}
+	if total > 0 {
+		fmt.Printf("  Milestones: %d/%d achieved, %d in the last month\n", achieved, total, recent)
+	}
+}
+
+// askGoalReview asks the review questions for a goal
+func askGoalReview(goal *core.Goal) goalReview {
+	review := goalReview{Relevant: PromptConfirmDefault("Still relevant?", true)}
+	if !review.Relevant {
+		outcome := PromptSelectWithDefault("What happened to it?", []string{"archive it", "it is done"}, "archive it")
+		review.Outcome = core.StatusArchived
+		if outcome == "it is done" {
+			review.Outcome = core.StatusCompleted
+		}
+		return review
+	}
+
+	review.Progress = PromptString("Progress this month", "")
+
+	if len(goal.Blockers) > 0 {
+		review.Resolved = PromptConfirmDefault("Are the blockers resolved?", false)
+	}
+	review.Blocker = PromptString("Any new blockers? (leave empty for none)", "")
+
+	if goal.TargetDate != nil && !PromptConfirmDefault(fmt.Sprintf("Still on track for %s?", formatDate(*goal.TargetDate)), true) {
+		for {
+			value := PromptString("New target date (empty to keep it)", "")
+			if value == "" {
+				break
+			}
+			target, err := core.ParseDate(value, time.Now())
+			if err != nil {
+				PrintWarning(err.Error())
+				continue
+			}
+			review.Target = &target
+			break
+		}
+	}
+	return review
+}
+
+// applyGoalReview updates the goal from the review answers and adds the review
+// note to its description
+func applyGoalReview(goal *core.Goal, review goalReview, date time.Time) error {
+	if !review.Relevant {
+		if err := goal.UpdateStatus(review.Outcome); err != nil {
+			return err
+		}
+	} else {
+		if review.Resolved {
+			goal.Unblock()
+		}
+		if strings.TrimSpace(review.Blocker) != "" {
+			goal.Block(review.Blocker)
+		}
+		if review.Target != nil {
+			goal.SetTargetDate(*review.Target)
+		}
+	}
+
+	goal.Body = core.AppendSectionItem(goal.Body, core.SectionReviews, formatLogEntry(date, goalReviewNote(review)))
+	goal.Touch()
+	return nil
+}
+
+// goalReviewNote summarizes the answers in a sentence for the review note
+func goalReviewNote(review goalReview) string {
+	if !review.Relevant {
+		if review.Outcome == core.StatusCompleted {
+			return "No longer relevant: done."
+		}
+		return "No longer relevant: archived."
+	}
+
+	var parts []string
+	if progress := strings.TrimSuffix(strings.TrimSpace(review.Progress), "."); progress != "" {
+		parts = append(parts, "Progress: "+progress+".")
+	} else {
+		parts = append(parts, "No progress this month.")
+	}
+	if review.Resolved {
+		parts = append(parts, "Blockers resolved.")
+	}
+	if blocker := strings.TrimSuffix(strings.TrimSpace(review.Blocker), "."); blocker != "" {
+		parts = append(parts, "Blocked: "+blocker+".")
+	}
+	if review.Target != nil {
+		parts = append(parts, "Target moved to "+review.Target.Format("2006-01-02")+".")
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyGoalReview(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.Local)
+
+	t.Run("records progress and a new blocker", func(t *testing.T) {
+		goal, err := core.NewGoal("goal-001", "Become a Go expert", core.PriorityHigh)
+		require.NoError(t, err)
+		goal.Body = "Why it matters."
+
+		err = applyGoalReview(goal, goalReview{Relevant: true, Progress: "Finished the concurrency chapter", Blocker: "No time for side projects"}, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, core.StatusBlocked, goal.Status)
+		assert.Equal(t, []string{"No time for side projects"}, goal.Blockers)
+		assert.Equal(t, "Why it matters.\n\n## Reviews\n\n- 2025-03-14: Progress: Finished the concurrency chapter. Blocked: No time for side projects.", goal.Body)
+	})
+
+	t.Run("unblocks and moves the target date", func(t *testing.T) {
+		goal, err := core.NewGoal("goal-001", "Become a Go expert", core.PriorityHigh)
+		require.NoError(t, err)
+		goal.Block("Waiting on budget")
+		target := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+
+		err = applyGoalReview(goal, goalReview{Relevant: true, Resolved: true, Target: &target}, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, core.StatusActive, goal.Status)
+		assert.Empty(t, goal.Blockers)
+		assert.Equal(t, &target, goal.TargetDate)
+		assert.Equal(t, []string{"2025-03-14: No progress this month. Blockers resolved. Target moved to 2025-09-01."}, core.SectionItems(goal.Body, core.SectionReviews))
+	})
+
+	t.Run("archives goals that are no longer relevant", func(t *testing.T) {
+		goal, err := core.NewGoal("goal-001", "Learn Rust", core.PriorityLow)
+		require.NoError(t, err)
+
+		err = applyGoalReview(goal, goalReview{Outcome: core.StatusArchived}, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, core.StatusArchived, goal.Status)
+		assert.Equal(t, []string{"2025-03-14: No longer relevant: archived."}, core.SectionItems(goal.Body, core.SectionReviews))
+	})
+}
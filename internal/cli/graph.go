@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var graphExport string
+
+var graphCmd = &cobra.Command{
+	Use:   "graph [entity-id]",
+	Short: "Show how entities are linked",
+	Long: `Show the links between entities as a tree: goals to their learning paths and
+milestones, paths to their phases, phases to their milestones and resources,
+and skills to their resources and milestones.
+
+With an entity ID, only its neighborhood is shown: the entity, everything it
+links to, and everything that links to it.
+
+Use --export to print the graph as Graphviz DOT or a Mermaid flowchart
+instead, for rendering elsewhere.
+
+Examples:
+  growth graph
+  growth graph goal-001
+  growth graph resource-004
+  growth graph path-001 --export dot | dot -Tsvg > path.svg
+  growth graph skill-002 --export mermaid`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runGraph,
+}
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+
+	graphCmd.Flags().StringVar(&graphExport, "export", "", "print the graph as dot or mermaid")
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	if graphExport != "" && graphExport != "dot" && graphExport != "mermaid" {
+		return fmt.Errorf("invalid export format '%s'. Valid options: dot, mermaid", graphExport)
+	}
+
+	graph, err := buildEntityGraph()
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		id := core.EntityID(args[0])
+		if _, ok := graph.Node(id); !ok {
+			return fmt.Errorf("entity '%s' not found. Only goals, paths, phases, milestones, skills and resources are linked in the graph", id)
+		}
+		graph = graph.Neighborhood(id)
+	}
+
+	switch graphExport {
+	case "dot":
+		fmt.Print(renderGraphDOT(graph))
+		return nil
+	case "mermaid":
+		fmt.Print(renderGraphMermaid(graph))
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		if len(graph.Nodes) == 0 {
+			PrintInfo("No entities found")
+			return nil
+		}
+		fmt.Print(renderGraphTree(graph))
+		return nil
+	}
+
+	return PrintOutputWithConfig(graph)
+}
+
+// buildEntityGraph loads the goals, paths, phases, milestones, skills and
+// resources and links them
+func buildEntityGraph() (*core.Graph, error) {
+	goals, err := goalRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve goals: %w", err)
+	}
+	paths, err := pathRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve paths: %w", err)
+	}
+	phases, err := phaseRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve phases: %w", err)
+	}
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve milestones: %w", err)
+	}
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve skills: %w", err)
+	}
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+
+	graph := &core.Graph{}
+	for _, goal := range goals {
+		graph.AddNode(goal.ID, goal.Title, string(goal.Status))
+	}
+	for _, path := range paths {
+		graph.AddNode(path.ID, path.Title, string(path.Status))
+	}
+	for _, phase := range phases {
+		status := "not started"
+		if phase.IsFinished() {
+			status = "finished"
+		} else if phase.StartDate != nil {
+			status = "started"
+		}
+		graph.AddNode(phase.ID, phase.Title, status)
+	}
+	for _, milestone := range milestones {
+		graph.AddNode(milestone.ID, milestone.Title, string(milestone.Status))
+	}
+	for _, skill := range skills {
+		graph.AddNode(skill.ID, skill.Title, string(skill.Status))
+	}
+	for _, resource := range resources {
+		graph.AddNode(resource.ID, resource.Title, string(resource.Status))
+	}
+
+	for _, goal := range goals {
+		for _, id := range goal.LearningPaths {
+			graph.AddEdge(goal.ID, id)
+		}
+		for _, id := range goal.Milestones {
+			graph.AddEdge(goal.ID, id)
+		}
+	}
+	for _, path := range paths {
+		for _, id := range path.Phases {
+			graph.AddEdge(path.ID, id)
+		}
+	}
+	for _, phase := range phases {
+		graph.AddEdge(phase.PathID, phase.ID)
+		for _, id := range phase.Milestones {
+			graph.AddEdge(phase.ID, id)
+		}
+		for _, id := range phase.Resources {
+			graph.AddEdge(phase.ID, id)
+		}
+	}
+	for _, milestone := range milestones {
+		graph.AddEdge(milestone.ReferenceID, milestone.ID)
+	}
+	for _, skill := range skills {
+		for _, id := range skill.Resources {
+			graph.AddEdge(skill.ID, id)
+		}
+	}
+	for _, resource := range resources {
+		graph.AddEdge(resource.SkillID, resource.ID)
+	}
+
+	return graph, nil
+}
+
+// renderGraphTree renders a graph as trees from the entities nothing links
+// to. An entity linked from several others is shown under each of them.
+func renderGraphTree(graph *core.Graph) string {
+	var b strings.Builder
+	for _, root := range graph.Roots() {
+		writeGraphTree(&b, graph, root, "", "", nil)
+	}
+	return b.String()
+}
+
+// writeGraphTree writes a node and, below it, the nodes it links to.
+// ancestors guards against cycles.
+func writeGraphTree(b *strings.Builder, graph *core.Graph, id core.EntityID, branch, indent string, ancestors []core.EntityID) {
+	node, _ := graph.Node(id)
+	fmt.Fprintf(b, "%s%s\n", branch, describeGraphNode(node))
+	if slices.Contains(ancestors, id) {
+		return
+	}
+	ancestors = append(ancestors, id)
+
+	children := graph.Children(id)
+	for i, child := range children {
+		childBranch, childIndent := glyph.Branch.String()+" ", glyph.Pipe.String()+" "
+		if i == len(children)-1 {
+			childBranch, childIndent = glyph.LastBranch.String()+" ", "   "
+		}
+		writeGraphTree(b, graph, child, indent+childBranch, indent+childIndent, ancestors)
+	}
+}
+
+func describeGraphNode(node core.GraphNode) string {
+	if node.Status == "" {
+		return fmt.Sprintf("%s  %s", node.ID, node.Title)
+	}
+	return fmt.Sprintf("%s  %s (%s)", node.ID, node.Title, node.Status)
+}
+
+// renderGraphDOT renders a graph in the Graphviz DOT language
+func renderGraphDOT(graph *core.Graph) string {
+	var b strings.Builder
+	b.WriteString("digraph growth {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, "  %q [label=%s];\n", node.ID, strconv.Quote(string(node.ID)+"\n"+node.Title))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %q -> %q;\n", edge.From, edge.To)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderGraphMermaid renders a graph as a Mermaid flowchart
+func renderGraphMermaid(graph *core.Graph) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, node := range graph.Nodes {
+		label := strings.ReplaceAll(string(node.ID)+": "+node.Title, `"`, "#quot;")
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", mermaidID(node.ID), label)
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(edge.From), mermaidID(edge.To))
+	}
+	return b.String()
+}
+
+// mermaidID turns an entity ID into a Mermaid node ID, which cannot contain
+// hyphens
+func mermaidID(id core.EntityID) string {
+	return strings.ReplaceAll(string(id), "-", "_")
+}
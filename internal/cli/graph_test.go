@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEntityGraph() *core.Graph {
+	g := &core.Graph{}
+	g.AddNode("goal-001", "Backend developer", "active")
+	g.AddNode("path-001", "Go path", "active")
+	g.AddNode("phase-001", "Basics", "finished")
+	g.AddNode("phase-002", "Web", "not started")
+	g.AddNode("resource-001", `The "Go" book`, "completed")
+	g.AddNode("skill-001", "Go", "")
+	g.AddEdge("goal-001", "path-001")
+	g.AddEdge("path-001", "phase-001")
+	g.AddEdge("path-001", "phase-002")
+	g.AddEdge("phase-001", "resource-001")
+	g.AddEdge("skill-001", "resource-001")
+	return g
+}
+
+func TestRenderGraphTree(t *testing.T) {
+	expected := `goal-001  Backend developer (active)
+└─ path-001  Go path (active)
+   ├─ phase-001  Basics (finished)
+   │  └─ resource-001  The "Go" book (completed)
+   └─ phase-002  Web (not started)
+skill-001  Go
+└─ resource-001  The "Go" book (completed)
+`
+	assert.Equal(t, expected, renderGraphTree(testEntityGraph()))
+}
+
+func TestRenderGraphDOT(t *testing.T) {
+	g := &core.Graph{}
+	g.AddNode("skill-001", "Go", "")
+	g.AddNode("resource-001", `The "Go" book`, "")
+	g.AddEdge("skill-001", "resource-001")
+
+	expected := `digraph growth {
+  rankdir=LR;
+  node [shape=box];
+  "skill-001" [label="skill-001\nGo"];
+  "resource-001" [label="resource-001\nThe \"Go\" book"];
+  "skill-001" -> "resource-001";
+}
+`
+	assert.Equal(t, expected, renderGraphDOT(g))
+}
+
+func TestRenderGraphMermaid(t *testing.T) {
+	g := &core.Graph{}
+	g.AddNode("skill-001", "Go", "")
+	g.AddNode("resource-001", `The "Go" book`, "")
+	g.AddEdge("skill-001", "resource-001")
+
+	expected := `flowchart LR
+  skill_001["skill-001: Go"]
+  resource_001["resource-001: The #quot;Go#quot; book"]
+  skill_001 --> resource_001
+`
+	assert.Equal(t, expected, renderGraphMermaid(g))
+}
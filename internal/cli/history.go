@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+)
+
+// showHistory is set by the --history flag on view commands
+var showHistory bool
+
+// printHistory renders an entity's audit trail, oldest first
+func printHistory(history core.History) {
+	fmt.Println("\nHistory:")
+	if len(history) == 0 {
+		fmt.Println("  No recorded changes")
+		return
+	}
+
+	for _, change := range history {
+		from := change.From
+		if from == "" {
+			from = "(none)"
+		}
+		fmt.Printf("  %s  %-9s %s %s %s\n", formatDateTime(change.Timestamp), change.Field, from, glyph.Arrow, change.To)
+	}
+}
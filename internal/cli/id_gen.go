@@ -8,9 +8,22 @@ import (
 	"strings"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
 )
 
 func GenerateNextID(entityType string) (core.EntityID, error) {
+	if database != nil {
+		ids, err := storage.SQLiteIDs(database, entityType)
+		if err != nil {
+			return "", err
+		}
+		names := make([]string, len(ids))
+		for i, id := range ids {
+			names[i] = string(id)
+		}
+		return nextEntityID(entityType, names), nil
+	}
+
 	return GenerateNextIDInPath(entityType, repoPath)
 }
 
@@ -31,6 +44,24 @@ func GenerateNextIDInPath(entityType string, basePath string) (core.EntityID, er
 		pattern = filepath.Join(basePath, "milestones", "milestone-*.md")
 	case "progress":
 		pattern = filepath.Join(basePath, "progress", "progress-*.md")
+	case "note":
+		pattern = filepath.Join(basePath, "notes", "note-*.md")
+	case "feed":
+		pattern = filepath.Join(basePath, "feeds", "feed-*.md")
+	case "objective":
+		pattern = filepath.Join(basePath, "objectives", "objective-*.md")
+	case "snapshot":
+		pattern = filepath.Join(basePath, "snapshots", "snapshot-*.md")
+	case "session":
+		pattern = filepath.Join(basePath, "sessions", "session-*.md")
+	case "credential":
+		pattern = filepath.Join(basePath, "credentials", "credential-*.md")
+	case "output":
+		pattern = filepath.Join(basePath, "outputs", "output-*.md")
+	case "mentor":
+		pattern = filepath.Join(basePath, "mentoring", "mentor-*.md")
+	case "feedback":
+		pattern = filepath.Join(basePath, "feedback", "feedback-*.md")
 	default:
 		return "", fmt.Errorf("unknown entity type: %s", entityType)
 	}
@@ -40,12 +71,22 @@ func GenerateNextIDInPath(entityType string, basePath string) (core.EntityID, er
 		return "", fmt.Errorf("failed to scan files: %w", err)
 	}
 
+	names := make([]string, len(matches))
+	for i, match := range matches {
+		names[i] = filepath.Base(match)
+	}
+
+	return nextEntityID(entityType, names), nil
+}
+
+// nextEntityID returns the ID after the highest one found in names, which are
+// IDs or file names starting with an ID
+func nextEntityID(entityType string, names []string) core.EntityID {
 	maxID := 0
 	idPattern := regexp.MustCompile(fmt.Sprintf(`%s-(\d+)`, entityType))
 
-	for _, match := range matches {
-		basename := filepath.Base(match)
-		if submatch := idPattern.FindStringSubmatch(basename); submatch != nil {
+	for _, name := range names {
+		if submatch := idPattern.FindStringSubmatch(name); submatch != nil {
 			id, err := strconv.Atoi(submatch[1])
 			if err == nil && id > maxID {
 				maxID = id
@@ -54,7 +95,7 @@ func GenerateNextIDInPath(entityType string, basePath string) (core.EntityID, er
 	}
 
 	nextID := maxID + 1
-	return core.EntityID(fmt.Sprintf("%s-%03d", entityType, nextID)), nil
+	return core.EntityID(fmt.Sprintf("%s-%03d", entityType, nextID))
 }
 
 func GenerateSlug(title string) string {
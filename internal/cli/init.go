@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/illenko/growth.md/internal/glyph"
 	"github.com/illenko/growth.md/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -21,13 +22,51 @@ This will:
 - Create the directory structure (skills/, goals/, paths/, etc.)
 - Initialize a Git repository
 - Create a default config.yml
-- Make an initial commit`,
+- Make an initial commit
+
+Use --repair on an existing repository to create only what is missing:
+directories, .growth/config.yml, .gitignore, README.md, or the Git
+repository. Existing files are never overwritten.
+
+Examples:
+  growth init my-growth
+  growth init --repair`,
 	Args: cobra.MaximumNArgs(1),
+	// Skip loading repositories: that would create the entity directories in
+	// the current directory before init or --repair can inspect the target
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return nil
+	},
 	RunE: runInit,
 }
 
+var initRepair bool
+
+// repositoryDirs are the directories every growth repository contains
+var repositoryDirs = []string{
+	".growth",
+	"skills",
+	"goals",
+	"paths",
+	"phases",
+	"resources",
+	"milestones",
+	"progress",
+	"notes",
+	"feeds",
+	"objectives",
+	"snapshots",
+	"sessions",
+	"credentials",
+	"outputs",
+	"mentoring",
+	"feedback",
+}
+
 func init() {
 	rootCmd.AddCommand(initCmd)
+
+	initCmd.Flags().BoolVar(&initRepair, "repair", false, "create missing parts of an existing repository without overwriting anything")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
@@ -41,6 +80,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to resolve path: %w", err)
 	}
 
+	if initRepair {
+		return runInitRepair(absPath)
+	}
+
 	if err := os.MkdirAll(absPath, 0755); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
@@ -71,7 +114,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fmt.Printf("\n✓ Initialized growth.md repository in %s\n", absPath)
+	fmt.Printf("\n%s Initialized growth.md repository in %s\n", glyph.Check, absPath)
 	fmt.Println("\nNext steps:")
 	fmt.Println("  cd", targetDir)
 	fmt.Println("  growth skill create \"Your First Skill\" --category programming")
@@ -82,18 +125,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 }
 
 func createDirectoryStructure(basePath string) error {
-	dirs := []string{
-		".growth",
-		"skills",
-		"goals",
-		"paths",
-		"phases",
-		"resources",
-		"milestones",
-		"progress",
-	}
-
-	for _, dir := range dirs {
+	for _, dir := range repositoryDirs {
 		path := filepath.Join(basePath, dir)
 		if err := os.MkdirAll(path, 0755); err != nil {
 			return fmt.Errorf("failed to create %s: %w", dir, err)
@@ -103,11 +135,79 @@ func createDirectoryStructure(basePath string) error {
 	return nil
 }
 
+// runInitRepair creates whatever is missing from an existing repository,
+// leaving existing files untouched
+func runInitRepair(basePath string) error {
+	info, err := os.Stat(basePath)
+	if err != nil || !info.IsDir() {
+		return fmt.Errorf("%s is not an existing directory. Run 'growth init' to create a new repository", basePath)
+	}
+
+	var repaired []string
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(basePath, name))
+		return err == nil
+	}
+
+	for _, dir := range repositoryDirs {
+		if exists(dir) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(basePath, dir), 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+		repaired = append(repaired, dir+"/")
+	}
+
+	configPath := filepath.Join(basePath, ".growth", "config.yml")
+	if !exists(filepath.Join(".growth", "config.yml")) {
+		if err := storage.SaveConfig(storage.DefaultConfig(), configPath); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		repaired = append(repaired, ".growth/config.yml (defaults)")
+	} else if _, err := storage.LoadConfig(configPath); err != nil {
+		PrintWarning(fmt.Sprintf("Config file is invalid and was left unchanged: %v", err))
+	}
+
+	if !exists(".gitignore") {
+		if err := createGitignore(basePath); err != nil {
+			return err
+		}
+		repaired = append(repaired, ".gitignore")
+	}
+
+	if !exists("README.md") {
+		if err := createReadme(basePath); err != nil {
+			return err
+		}
+		repaired = append(repaired, "README.md")
+	}
+
+	if !isGitRepo(basePath) {
+		if err := initializeGit(basePath); err != nil {
+			return err
+		}
+		repaired = append(repaired, "Git repository")
+	}
+
+	if len(repaired) == 0 {
+		PrintSuccess(fmt.Sprintf("Repository in %s is complete, nothing to repair", basePath))
+		return nil
+	}
+
+	PrintSuccess(fmt.Sprintf("Repaired repository in %s", basePath))
+	for _, item := range repaired {
+		fmt.Printf("  created %s\n", item)
+	}
+
+	return nil
+}
+
 func promptForConfig() (*storage.Config, error) {
 	reader := bufio.NewReader(os.Stdin)
 	config := storage.DefaultConfig()
 
-	fmt.Println("\n📝 Let's set up your growth.md configuration")
+	fmt.Println("\n" + glyph.Emoji("📝") + "Let's set up your growth.md configuration")
 	fmt.Println()
 
 	fmt.Print("Your name (optional): ")
@@ -147,6 +247,10 @@ func promptForConfig() (*storage.Config, error) {
 func createGitignore(basePath string) error {
 	content := `# growth.md specific
 .growth/cache/
+.growth/google-token.json
+.growth/growth.db
+.growth/growth.db-wal
+.growth/growth.db-shm
 .DS_Store
 
 # Editor files
@@ -161,6 +265,38 @@ func createGitignore(basePath string) error {
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// ensureGitignored appends the entries missing from the repository's
+// .gitignore, creating it if needed
+func ensureGitignored(basePath string, entries ...string) error {
+	path := filepath.Join(basePath, ".gitignore")
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, entry := range entries {
+		if !existing[entry] {
+			missing = append(missing, entry)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	content := string(data)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	content += strings.Join(missing, "\n") + "\n"
+	return os.WriteFile(path, []byte(content), 0644)
+}
+
 func createReadme(basePath string) error {
 	content := `# My Growth Journey
 
@@ -175,6 +311,15 @@ This repository tracks my career development using [growth.md](https://github.co
 - **resources/** - Books, courses, articles, and other learning materials
 - **milestones/** - Achievement markers
 - **progress/** - Weekly progress logs
+- **notes/** - Quick notes and things learned
+- **feeds/** - RSS feeds and newsletters watched for new material
+- **objectives/** - Quarterly objectives with goals as key results
+- **snapshots/** - Immutable summaries taken at the end of review periods
+- **sessions/** - Study group and other group learning sessions
+- **credentials/** - Certificates and credentials, with their expiry dates
+- **outputs/** - Talks, blog posts and open source contributions
+- **mentoring/** - Mentoring sessions, as mentor or mentee
+- **feedback/** - 360 feedback from peers, managers and reports
 
 ## Quick Start
 
@@ -204,7 +349,7 @@ Configuration is stored in ` + "`" + `.growth/config.yml` + "`" + `. Edit this f
 
 func initializeGit(basePath string) error {
 	if isGitRepo(basePath) {
-		fmt.Println("\n⚠  Git repository already exists, skipping git init")
+		fmt.Printf("\n%s  Git repository already exists, skipping git init\n", glyph.Warning)
 		return nil
 	}
 
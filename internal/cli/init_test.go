@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureGitignored(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".gitignore")
+	require.NoError(t, os.WriteFile(path, []byte(".growth/cache/\n.growth/growth.db"), 0644))
+
+	require.NoError(t, ensureGitignored(dir, ".growth/growth.db", ".growth/growth.db-wal"))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, ".growth/cache/\n.growth/growth.db\n.growth/growth.db-wal\n", string(data))
+
+	// Entries already present are not added twice
+	require.NoError(t, ensureGitignored(dir, ".growth/growth.db-wal"))
+	again, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(again))
+
+	empty := t.TempDir()
+	require.NoError(t, ensureGitignored(empty, ".growth/growth.db"))
+	data, err = os.ReadFile(filepath.Join(empty, ".gitignore"))
+	require.NoError(t, err)
+	assert.Equal(t, ".growth/growth.db\n", string(data))
+}
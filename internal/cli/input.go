@@ -2,10 +2,15 @@ package cli
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/i18n"
 )
 
 var reader = bufio.NewReader(os.Stdin)
@@ -36,7 +41,7 @@ func PromptStringRequired(prompt string) string {
 		if input != "" {
 			return input
 		}
-		PrintError(fmt.Errorf("this field is required"))
+		PrintError(errors.New(i18n.T("this field is required")))
 	}
 }
 
@@ -222,3 +227,17 @@ func ValidateRange(value int, min, max int, fieldName string) error {
 	}
 	return nil
 }
+
+// parseDateFlag resolves the value of a date flag with core.ParseDate. Dates
+// given in words are echoed so the resolved day can be checked.
+func parseDateFlag(name, value string) (time.Time, error) {
+	date, err := core.ParseDate(value, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+
+	if _, err := time.Parse("2006-01-02", strings.TrimSpace(value)); err != nil {
+		PrintInfo(fmt.Sprintf("--%s %q is %s", name, value, date.Format("Monday, Jan 2, 2006")))
+	}
+	return date, nil
+}
@@ -0,0 +1,344 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mentorWith     string
+	mentorRole     string
+	mentorDate     string
+	mentorHours    float64
+	mentorTopics   string
+	mentorActions  []string
+	mentorGoal     string
+	mentorDue      string
+	mentorNotes    string
+	mentorSince    string
+	mentorListRole string
+)
+
+var mentorCmd = &cobra.Command{
+	Use:   "mentor",
+	Short: "Track mentoring sessions",
+	Long: `Log mentoring sessions, both with your mentors and with the people you
+mentor. Action items agreed in a session become milestones, and 'growth
+stats' sums up the hours given and received.`,
+}
+
+var mentorLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Log a mentoring session",
+	Long: `Log a mentoring session with a person.
+
+--role is your side of the session: mentee (the default) when they mentored
+you, mentor when you mentored them. --topics takes the IDs of the skills
+discussed.
+
+Each --action becomes a pending milestone, attached to --goal if given or
+else to the first skill in --topics. Set a target date for them with --due.
+
+Examples:
+  growth mentor log --with "Alice" --topics skill-003 --hours 1
+  growth mentor log --with "Alice" --topics skill-003 --action "Write a design doc for the cache" --due 2025-05-01
+  growth mentor log --with "Bo" --role mentor --hours 0.5 --notes "Reviewed their first PR"`,
+	RunE: runMentorLog,
+}
+
+var mentorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List mentoring sessions",
+	Long: `List mentoring sessions, newest first.
+
+Examples:
+  growth mentor list
+  growth mentor list --with Alice
+  growth mentor list --role mentor --since 2025-01-01`,
+	Aliases: []string{"ls"},
+	RunE:    runMentorList,
+}
+
+var mentorViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View a mentoring session",
+	Long: `View a mentoring session with its action items and notes.
+
+Examples:
+  growth mentor view mentor-001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMentorView,
+}
+
+var mentorDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a mentoring session",
+	Long: `Delete a mentoring session by ID. Milestones created from its action items
+are kept. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth mentor delete mentor-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runMentorDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(mentorCmd)
+	mentorCmd.AddCommand(mentorLogCmd)
+	mentorCmd.AddCommand(mentorListCmd)
+	mentorCmd.AddCommand(mentorViewCmd)
+	mentorCmd.AddCommand(mentorDeleteCmd)
+
+	mentorLogCmd.Flags().StringVar(&mentorWith, "with", "", "person the session was with (required)")
+	mentorLogCmd.Flags().StringVar(&mentorRole, "role", "mentee", "your role (mentee, mentor)")
+	mentorLogCmd.Flags().StringVar(&mentorDate, "date", "", "date of the session (YYYY-MM-DD), defaults to today")
+	mentorLogCmd.Flags().Float64Var(&mentorHours, "hours", 0, "length of the session in hours")
+	mentorLogCmd.Flags().StringVar(&mentorTopics, "topics", "", "comma-separated skill IDs discussed")
+	mentorLogCmd.Flags().StringArrayVar(&mentorActions, "action", nil, "action item to track as a milestone (repeatable)")
+	mentorLogCmd.Flags().StringVar(&mentorGoal, "goal", "", "goal ID to attach action items to")
+	mentorLogCmd.Flags().StringVar(&mentorDue, "due", "", "target date for the action items (YYYY-MM-DD or e.g. 'in 2 weeks')")
+	mentorLogCmd.Flags().StringVar(&mentorNotes, "notes", "", "session notes")
+	mentorLogCmd.MarkFlagRequired("with")
+
+	mentorListCmd.Flags().StringVar(&mentorWith, "with", "", "filter by person")
+	mentorListCmd.Flags().StringVar(&mentorListRole, "role", "", "filter by your role (mentee, mentor)")
+	mentorListCmd.Flags().StringVar(&mentorSince, "since", "", "only show sessions on or after this date (YYYY-MM-DD)")
+}
+
+func runMentorLog(cmd *cobra.Command, args []string) error {
+	role := core.MentorRole(mentorRole)
+	if !role.IsValid() {
+		return fmt.Errorf("invalid role '%s'. Valid options: mentee, mentor", mentorRole)
+	}
+
+	date := time.Now()
+	if mentorDate != "" {
+		parsed, err := time.Parse("2006-01-02", mentorDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		date = parsed
+	}
+
+	var skills []core.EntityID
+	for _, id := range splitList(mentorTopics) {
+		exists, err := skillRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return fmt.Errorf("failed to check skill existence: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+		}
+		skills = append(skills, core.EntityID(id))
+	}
+
+	// Action items become milestones attached to the goal or the first topic
+	var refType core.ReferenceType
+	var refID core.EntityID
+	var milestoneType core.MilestoneType
+	if len(mentorActions) > 0 {
+		switch {
+		case mentorGoal != "":
+			exists, err := goalRepo.Exists(core.EntityID(mentorGoal))
+			if err != nil {
+				return fmt.Errorf("failed to check goal existence: %w", err)
+			}
+			if !exists {
+				return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", mentorGoal)
+			}
+			refType, refID, milestoneType = core.ReferenceGoal, core.EntityID(mentorGoal), core.MilestoneGoalLevel
+		case len(skills) > 0:
+			refType, refID, milestoneType = core.ReferenceSkill, skills[0], core.MilestoneSkillLevel
+		default:
+			return fmt.Errorf("action items need a goal (--goal) or a skill (--topics) to attach their milestones to")
+		}
+	}
+
+	var due time.Time
+	if mentorDue != "" {
+		parsed, err := parseDateFlag("due", mentorDue)
+		if err != nil {
+			return err
+		}
+		due = parsed
+	}
+
+	id, err := GenerateNextID("mentor")
+	if err != nil {
+		return fmt.Errorf("failed to generate mentor session ID: %w", err)
+	}
+
+	session, err := core.NewMentorSession(id, mentorWith, role, date)
+	if err != nil {
+		return fmt.Errorf("failed to create mentor session: %w", err)
+	}
+	if err := session.SetHours(mentorHours); err != nil {
+		return fmt.Errorf("failed to set hours: %w", err)
+	}
+	for _, skillID := range skills {
+		session.AddSkill(skillID)
+	}
+	session.Body = mentorNotes
+
+	for _, action := range mentorActions {
+		milestoneID, err := GenerateNextID("milestone")
+		if err != nil {
+			return fmt.Errorf("failed to generate milestone ID: %w", err)
+		}
+
+		milestone, err := core.NewMilestone(milestoneID, action, milestoneType, refType, refID)
+		if err != nil {
+			return fmt.Errorf("failed to create milestone for action item: %w", err)
+		}
+		if !due.IsZero() {
+			milestone.SetTargetDate(due)
+		}
+		milestone.Body = fmt.Sprintf("Action item from the mentoring session with %s on %s (%s).", session.With, date.Format("2006-01-02"), session.ID)
+
+		if err := milestoneRepo.Create(milestone); err != nil {
+			return fmt.Errorf("failed to save milestone: %w", err)
+		}
+		session.AddActionItem(milestone.ID)
+	}
+
+	if err := mentorRepo.Create(session); err != nil {
+		return fmt.Errorf("failed to save mentor session: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Logged mentor session %s: %s", session.ID, session.Title))
+	for _, milestoneID := range session.ActionItems {
+		PrintInfo(fmt.Sprintf("Tracking action item as %s", milestoneID))
+	}
+	return nil
+}
+
+func runMentorList(cmd *cobra.Command, args []string) error {
+	var sessions []*core.MentorSession
+	var err error
+
+	if mentorWith != "" {
+		sessions, err = mentorRepo.FindWith(mentorWith)
+	} else {
+		sessions, err = mentorRepo.FindSince(time.Time{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve mentor sessions: %w", err)
+	}
+
+	var since time.Time
+	if mentorSince != "" {
+		since, err = time.Parse("2006-01-02", mentorSince)
+		if err != nil {
+			return fmt.Errorf("invalid since date format (use YYYY-MM-DD): %w", err)
+		}
+	}
+	if mentorListRole != "" && !core.MentorRole(mentorListRole).IsValid() {
+		return fmt.Errorf("invalid role '%s'. Valid options: mentee, mentor", mentorListRole)
+	}
+
+	var filtered []*core.MentorSession
+	for _, session := range sessions {
+		if session.Date.Before(since) || (mentorListRole != "" && session.Role != core.MentorRole(mentorListRole)) {
+			continue
+		}
+		filtered = append(filtered, session)
+	}
+
+	if len(filtered) == 0 {
+		PrintInfo("No mentor sessions found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, session := range filtered {
+			fmt.Printf("%s  %s  %s", session.ID, formatDate(session.Date), session.Title)
+			if session.Hours > 0 {
+				fmt.Printf("  %sh", formatNumber(session.Hours, 1))
+			}
+			if len(session.ActionItems) > 0 {
+				fmt.Printf("  %s", countOf(len(session.ActionItems), "action item"))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(filtered)
+}
+
+func runMentorView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	session, err := mentorRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("mentor session '%s' not found. Use 'growth mentor list' to see available sessions", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:     %s\n", session.ID)
+		fmt.Printf("Title:  %s\n", session.Title)
+		fmt.Printf("With:   %s\n", session.With)
+		fmt.Printf("Role:   %s\n", session.Role)
+		fmt.Printf("Date:   %s\n", formatDate(session.Date))
+		if session.Hours > 0 {
+			fmt.Printf("Hours:  %s\n", formatNumber(session.Hours, 1))
+		}
+		if len(session.Skills) > 0 {
+			fmt.Printf("Topics: %s\n", formatEntityIDs(session.Skills))
+		}
+
+		if len(session.ActionItems) > 0 {
+			fmt.Println("\nAction items:")
+			for _, milestoneID := range session.ActionItems {
+				milestone, err := milestoneRepo.GetByID(milestoneID)
+				if err != nil {
+					fmt.Printf("  %s (deleted)\n", milestoneID)
+					continue
+				}
+				mark := " "
+				if milestone.IsAchieved() {
+					mark = "x"
+				}
+				fmt.Printf("  [%s] %s  %s\n", mark, milestone.ID, milestone.Title)
+			}
+		}
+
+		if session.Body != "" {
+			fmt.Printf("\nNotes:\n%s\n", session.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(session)
+}
+
+func runMentorDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	session, err := mentorRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("mentor session '%s' not found. Use 'growth mentor list' to see available sessions", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", session.ID)
+	fmt.Printf("  Title: %s\n", session.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this mentor session?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := mentorRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete mentor session: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted mentor session %s", id))
+	return nil
+}
@@ -18,6 +18,8 @@ var (
 	milestoneStatus     string
 	milestoneTitle      string
 	milestoneFilterType string
+	milestoneRecurring  string
+	milestoneConfidence int
 )
 
 var milestoneCmd = &cobra.Command{
@@ -36,7 +38,12 @@ Milestones must be associated with a goal, path, or skill using --ref-type and -
 Examples:
   growth milestone create "Deploy first app" --type skill-level --ref-type skill --ref-id skill-001
   growth milestone create "Complete course" --type goal-level --ref-type goal --ref-id goal-001 --target 2025-06-30
-  growth milestone create`,
+  growth milestone create "Write weekly blog post" --ref-type goal --ref-id goal-001 --recurring weekly
+  growth milestone create "Finish the demo" --ref-type goal --ref-id goal-001 --target "in 2 weeks"
+  growth milestone create
+
+Recurring milestones (daily, weekly, monthly) spawn a fresh instance each period.
+Archive the latest instance to stop a series.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMilestoneCreate,
 }
@@ -66,6 +73,7 @@ The output format can be controlled with the --format flag (table, json, yaml).
 
 Examples:
   growth milestone view milestone-001
+  growth milestone view milestone-001 --history
   growth milestone view milestone-042 --format json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMilestoneView,
@@ -107,9 +115,13 @@ var milestoneAchieveCmd = &cobra.Command{
 	Short: "Mark milestone as achieved",
 	Long: `Mark a milestone as achieved with optional proof URL.
 
+Achieving a skill review asks how confident you now feel in the skill, or
+takes the rating from --confidence.
+
 Examples:
   growth milestone achieve milestone-001
-  growth milestone achieve milestone-001 --proof https://github.com/user/repo`,
+  growth milestone achieve milestone-001 --proof https://github.com/user/repo
+  growth milestone achieve milestone-007 --confidence 4`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMilestoneAchieve,
 }
@@ -126,7 +138,9 @@ func init() {
 	milestoneCreateCmd.Flags().StringVarP(&milestoneType, "type", "t", "", "milestone type (goal-level, path-level, skill-level)")
 	milestoneCreateCmd.Flags().StringVar(&milestoneRefType, "ref-type", "", "reference type (goal, path, skill)")
 	milestoneCreateCmd.Flags().StringVar(&milestoneRefID, "ref-id", "", "reference ID (e.g., goal-001)")
-	milestoneCreateCmd.Flags().StringVar(&milestoneTargetDate, "target", "", "target date (YYYY-MM-DD)")
+	milestoneCreateCmd.Flags().StringVar(&milestoneTargetDate, "target", "", "target date (YYYY-MM-DD or e.g. 'in 2 weeks', 'next friday')")
+	milestoneCreateCmd.Flags().StringVar(&milestoneRecurring, "recurring", "", "repeat every period (daily, weekly, monthly)")
+	milestoneCreateCmd.Flags().StringArrayVar(&ticketLinks, "link", nil, "link a Jira ticket, e.g. WORK-123 (repeatable)")
 	milestoneCreateCmd.MarkFlagRequired("ref-type")
 	milestoneCreateCmd.MarkFlagRequired("ref-id")
 
@@ -136,10 +150,16 @@ func init() {
 
 	milestoneEditCmd.Flags().StringVar(&milestoneTitle, "title", "", "milestone title")
 	milestoneEditCmd.Flags().StringVarP(&milestoneStatus, "status", "s", "", "milestone status")
-	milestoneEditCmd.Flags().StringVar(&milestoneTargetDate, "target", "", "target date (YYYY-MM-DD)")
+	milestoneEditCmd.Flags().StringVar(&milestoneTargetDate, "target", "", "target date (YYYY-MM-DD or e.g. 'in 2 weeks', 'next friday')")
 	milestoneEditCmd.Flags().StringVar(&milestoneProof, "proof", "", "proof URL")
+	milestoneEditCmd.Flags().StringArrayVar(&ticketLinks, "link", nil, "link a Jira ticket, e.g. WORK-123 (repeatable)")
+	milestoneEditCmd.Flags().StringArrayVar(&ticketUnlinks, "unlink", nil, "unlink a Jira ticket (repeatable)")
 
 	milestoneAchieveCmd.Flags().StringVar(&milestoneProof, "proof", "", "proof URL")
+	milestoneAchieveCmd.Flags().IntVar(&milestoneConfidence, "confidence", 0, "confidence in the reviewed skill, 1 (shaky) to 5 (sure)")
+
+	milestoneViewCmd.Flags().BoolVar(&showHistory, "history", false, "show the history of status, level, and priority changes")
+	milestoneViewCmd.Flags().StringVar(&openAttachment, "open", "", "open the attachment with this name")
 }
 
 func runMilestoneCreate(cmd *cobra.Command, args []string) error {
@@ -219,14 +239,27 @@ func runMilestoneCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create milestone: %w", err)
 	}
 
+	if milestoneRecurring != "" {
+		if milestoneTargetDate != "" {
+			return fmt.Errorf("--target cannot be combined with --recurring; each instance targets the end of its period")
+		}
+		if err := milestone.SetRecurrence(core.Recurrence(milestoneRecurring), time.Now()); err != nil {
+			return err
+		}
+	}
+
 	if milestoneTargetDate != "" {
-		targetDate, err := time.Parse("2006-01-02", milestoneTargetDate)
+		targetDate, err := parseDateFlag("target", milestoneTargetDate)
 		if err != nil {
-			return fmt.Errorf("invalid target date format (use YYYY-MM-DD): %w", err)
+			return err
 		}
 		milestone.SetTargetDate(targetDate)
 	}
 
+	if _, err := linkTickets(&milestone.Tickets, ticketLinks, nil); err != nil {
+		return err
+	}
+
 	description := PromptMultiline("Description (optional, press Ctrl+D or enter '.' to finish)")
 	if description != "" {
 		milestone.Body = description
@@ -245,7 +278,10 @@ func runMilestoneCreate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Type: %s\n", milestone.Type)
 		fmt.Printf("  Reference: %s (%s)\n", milestone.ReferenceID, milestone.ReferenceType)
 		if milestone.TargetDate != nil {
-			fmt.Printf("  Target: %s\n", milestone.TargetDate.Format("2006-01-02"))
+			fmt.Printf("  Target: %s\n", formatDate(*milestone.TargetDate))
+		}
+		if milestone.IsRecurring() {
+			fmt.Printf("  Recurring: %s\n", milestone.Recurrence)
 		}
 	}
 
@@ -253,6 +289,8 @@ func runMilestoneCreate(cmd *cobra.Command, args []string) error {
 }
 
 func runMilestoneList(cmd *cobra.Command, args []string) error {
+	spawnRecurringMilestones()
+
 	var milestones []*core.Milestone
 	var err error
 
@@ -306,6 +344,10 @@ func runMilestoneView(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("milestone '%s' not found. Use 'growth milestone list' to see available milestones", id)
 	}
 
+	if openAttachment != "" {
+		return openEntityAttachment(milestone.Attachments, openAttachment)
+	}
+
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", milestone.ID)
 		fmt.Printf("Title:    %s\n", milestone.Title)
@@ -313,21 +355,39 @@ func runMilestoneView(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Reference: %s (%s)\n", milestone.ReferenceID, milestone.ReferenceType)
 		fmt.Printf("Status:   %s\n", milestone.Status)
 		if milestone.TargetDate != nil {
-			fmt.Printf("Target:   %s\n", milestone.TargetDate.Format("2006-01-02"))
+			fmt.Printf("Target:   %s%s\n", formatDate(*milestone.TargetDate), dueDate(*milestone.TargetDate))
 		}
 		if milestone.AchievedDate != nil {
-			fmt.Printf("Achieved: %s\n", milestone.AchievedDate.Format("2006-01-02"))
+			fmt.Printf("Achieved: %s%s\n", formatDate(*milestone.AchievedDate), relativeDate(*milestone.AchievedDate))
 		}
 		if milestone.Proof != "" {
 			fmt.Printf("Proof:    %s\n", milestone.Proof)
 		}
-		fmt.Printf("Created:  %s\n", milestone.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", milestone.Updated.Format("2006-01-02 15:04:05"))
+		if milestone.IsReview() {
+			fmt.Printf("Review:   %s after mastery\n", milestone.ReviewInterval.Label())
+		}
+		if milestone.IsRecurring() {
+			fmt.Printf("Recurring: %s (series %s)\n", milestone.Recurrence, milestone.SeriesID)
+			if instances, err := milestoneRepo.FindBySeriesID(milestone.SeriesID); err == nil {
+				stats := core.ComputeRecurringStats(instances, time.Now())
+				fmt.Printf("Completion: %d/%d periods (%s%%), streak %d (best %d)\n",
+					stats.Completed, stats.Periods, formatNumber(stats.CompletionRate, 0), stats.CurrentStreak, stats.LongestStreak)
+			}
+		}
+		fmt.Printf("Created:  %s\n", formatDateTime(milestone.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(milestone.Updated))
 
 		if milestone.Body != "" {
 			fmt.Printf("\nDescription:\n%s\n", milestone.Body)
 		}
 
+		printTickets(milestone.Tickets)
+		printAttachments(milestone.Attachments)
+
+		if showHistory {
+			printHistory(milestone.History)
+		}
+
 		return nil
 	}
 
@@ -354,10 +414,8 @@ func runMilestoneEdit(cmd *cobra.Command, args []string) error {
 		if !status.IsValid() {
 			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived", milestoneStatus)
 		}
-		milestone.Status = status
-		if status == core.StatusCompleted && milestone.AchievedDate == nil {
-			now := time.Now()
-			milestone.AchievedDate = &now
+		if err := milestone.UpdateStatus(status); err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
 		}
 		updated = true
 	}
@@ -366,9 +424,9 @@ func runMilestoneEdit(cmd *cobra.Command, args []string) error {
 		if milestoneTargetDate == "" {
 			milestone.ClearTargetDate()
 		} else {
-			targetDate, err := time.Parse("2006-01-02", milestoneTargetDate)
+			targetDate, err := parseDateFlag("target", milestoneTargetDate)
 			if err != nil {
-				return fmt.Errorf("invalid target date format (use YYYY-MM-DD): %w", err)
+				return err
 			}
 			milestone.SetTargetDate(targetDate)
 		}
@@ -380,6 +438,14 @@ func runMilestoneEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("link") || cmd.Flags().Changed("unlink") {
+		changed, err := linkTickets(&milestone.Tickets, ticketLinks, ticketUnlinks)
+		if err != nil {
+			return err
+		}
+		updated = updated || changed
+	}
+
 	if !updated {
 		PrintInfo("No changes specified. Use flags to update fields.")
 		return nil
@@ -422,6 +488,9 @@ func runMilestoneDelete(cmd *cobra.Command, args []string) error {
 
 func runMilestoneAchieve(cmd *cobra.Command, args []string) error {
 	id := core.EntityID(args[0])
+	if cmd.Flags().Changed("confidence") && (milestoneConfidence < core.MinConfidence || milestoneConfidence > core.MaxConfidence) {
+		return fmt.Errorf("invalid confidence %d: must be between 1 and 5", milestoneConfidence)
+	}
 
 	milestone, err := milestoneRepo.GetByIDWithBody(id)
 	if err != nil {
@@ -443,6 +512,63 @@ func runMilestoneAchieve(cmd *cobra.Command, args []string) error {
 	if milestone.Proof != "" {
 		fmt.Printf("Proof: %s\n", milestone.Proof)
 	}
+	addToWeekLog(string(milestone.ID), func(log *core.ProgressLog) { log.AddMilestoneAchieved(milestone.ID) })
+	if milestone.ReferenceType == core.ReferenceSkill && milestone.IsReview() {
+		if err := rateReviewedSkill(milestone.ReferenceID); err != nil {
+			return err
+		}
+	}
+	if milestone.ReferenceType == core.ReferenceSkill && !milestone.IsReview() {
+		suggestMasteryReview(milestone.ReferenceID)
+	}
+	offerGoalCompletion(goalsForMilestone(milestone)...)
 
 	return nil
 }
+
+// spawnRecurringMilestones creates an instance for the current period of every
+// recurring series whose latest instance belongs to an earlier period.
+// A series stops when its latest instance is archived.
+func spawnRecurringMilestones() {
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return
+	}
+
+	latest := make(map[core.EntityID]*core.Milestone)
+	for _, milestone := range milestones {
+		if !milestone.IsRecurring() || milestone.PeriodStart == nil {
+			continue
+		}
+		current, ok := latest[milestone.SeriesID]
+		if !ok || milestone.PeriodStart.After(*current.PeriodStart) {
+			latest[milestone.SeriesID] = milestone
+		}
+	}
+
+	now := time.Now()
+	for _, milestone := range latest {
+		if milestone.Status == core.StatusArchived {
+			continue
+		}
+		if !milestone.Recurrence.PeriodStart(now).After(*milestone.PeriodStart) {
+			continue
+		}
+
+		id, err := GenerateNextID("milestone")
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Failed to spawn recurring milestone %s: %v", milestone.SeriesID, err))
+			continue
+		}
+
+		instance, err := milestone.NextInstance(id, now)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Failed to spawn recurring milestone %s: %v", milestone.SeriesID, err))
+			continue
+		}
+
+		if err := milestoneRepo.Create(instance); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to save recurring milestone %s: %v", instance.ID, err))
+		}
+	}
+}
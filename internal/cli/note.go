@@ -0,0 +1,308 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	noteSkill    string
+	noteGoal     string
+	notePath     string
+	noteResource string
+	noteTags     string
+	noteDate     string
+	noteLimit    int
+)
+
+var noteCmd = &cobra.Command{
+	Use:   "note",
+	Short: "Capture quick notes",
+	Long:  `Capture timestamped notes and attach them to skills, goals, paths, or resources.`,
+}
+
+var noteAddCmd = &cobra.Command{
+	Use:   "add <text>",
+	Short: "Add a note",
+	Long: `Add a quick note, optionally attached to one or more entities.
+
+The first line of the text becomes the note title.
+
+Examples:
+  growth note add "TIL about epoll" --skill skill-002
+  growth note add "Chapter 3 was dense, revisit later" --resource resource-004 --tags reread
+  growth note add "Struggling with DP problems" --skill skill-005 --goal goal-001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNoteAdd,
+}
+
+var noteListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List notes",
+	Long: `List notes, newest first.
+
+Optionally filter by the entity the notes are attached to.
+
+Examples:
+  growth note list
+  growth note list --skill skill-002
+  growth note list --goal goal-001 --limit 5`,
+	Aliases: []string{"ls"},
+	RunE:    runNoteList,
+}
+
+var noteViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View a note",
+	Long: `View the full text of a note.
+
+Examples:
+  growth note view note-001
+  growth note view note-012 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNoteView,
+}
+
+var noteDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a note",
+	Long: `Delete a note by ID. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth note delete note-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runNoteDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(noteCmd)
+	noteCmd.AddCommand(noteAddCmd)
+	noteCmd.AddCommand(noteListCmd)
+	noteCmd.AddCommand(noteViewCmd)
+	noteCmd.AddCommand(noteDeleteCmd)
+
+	noteAddCmd.Flags().StringVar(&noteSkill, "skill", "", "attach to skill ID")
+	noteAddCmd.Flags().StringVar(&noteGoal, "goal", "", "attach to goal ID")
+	noteAddCmd.Flags().StringVar(&notePath, "path", "", "attach to learning path ID")
+	noteAddCmd.Flags().StringVar(&noteResource, "resource", "", "attach to resource ID")
+	noteAddCmd.Flags().StringVar(&noteTags, "tags", "", "comma-separated tags")
+	noteAddCmd.Flags().StringVar(&noteDate, "date", "", "date of the note (YYYY-MM-DD), defaults to now")
+
+	noteListCmd.Flags().StringVar(&noteSkill, "skill", "", "filter by skill ID")
+	noteListCmd.Flags().StringVar(&noteGoal, "goal", "", "filter by goal ID")
+	noteListCmd.Flags().StringVar(&notePath, "path", "", "filter by learning path ID")
+	noteListCmd.Flags().StringVar(&noteResource, "resource", "", "filter by resource ID")
+	noteListCmd.Flags().IntVarP(&noteLimit, "limit", "n", 0, "maximum number of notes to show")
+}
+
+func runNoteAdd(cmd *cobra.Command, args []string) error {
+	date := time.Now()
+	if noteDate != "" {
+		parsed, err := time.Parse("2006-01-02", noteDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		date = parsed
+	}
+
+	refs, err := noteReferences()
+	if err != nil {
+		return err
+	}
+
+	id, err := GenerateNextID("note")
+	if err != nil {
+		return fmt.Errorf("failed to generate note ID: %w", err)
+	}
+
+	note, err := core.NewNote(id, args[0], date)
+	if err != nil {
+		return fmt.Errorf("failed to create note: %w", err)
+	}
+
+	for _, ref := range refs {
+		note.AddReference(ref)
+	}
+
+	if noteTags != "" {
+		for _, tag := range strings.Split(noteTags, ",") {
+			note.AddTag(tag)
+		}
+	}
+
+	if err := noteRepo.Create(note); err != nil {
+		return fmt.Errorf("failed to save note: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Added note %s: %s", note.ID, note.Title))
+	return nil
+}
+
+func runNoteList(cmd *cobra.Command, args []string) error {
+	refs, err := noteReferences()
+	if err != nil {
+		return err
+	}
+
+	var notes []*core.Note
+	if len(refs) > 0 {
+		notes, err = noteRepo.FindByReference(refs[0])
+		if err == nil && len(refs) > 1 {
+			notes = filterNotesByReferences(notes, refs[1:])
+		}
+	} else {
+		notes, err = noteRepo.GetAll()
+		sortNotesNewestFirst(notes)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to retrieve notes: %w", err)
+	}
+
+	if len(notes) == 0 {
+		PrintInfo("No notes found")
+		return nil
+	}
+
+	if noteLimit > 0 && len(notes) > noteLimit {
+		notes = notes[:noteLimit]
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, note := range notes {
+			fmt.Printf("%s  %s  %s", note.ID, formatDateTime(note.Date), note.Title)
+			if len(note.References) > 0 {
+				fmt.Printf("  %s", formatEntityIDs(note.References))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(notes)
+}
+
+func runNoteView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	note, err := noteRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("note '%s' not found. Use 'growth note list' to see available notes", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:       %s\n", note.ID)
+		fmt.Printf("Date:     %s\n", formatDateTime(note.Date))
+		if len(note.References) > 0 {
+			fmt.Printf("Attached: %s\n", formatEntityIDs(note.References))
+		}
+		if len(note.Tags) > 0 {
+			fmt.Printf("Tags:     %s\n", strings.Join(note.Tags, ", "))
+		}
+
+		if note.Body != "" {
+			fmt.Printf("\n%s\n", note.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(note)
+}
+
+func runNoteDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	note, err := noteRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("note '%s' not found. Use 'growth note list' to see available notes", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", note.ID)
+	fmt.Printf("  Title: %s\n", note.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this note?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := noteRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted note %s", id))
+	return nil
+}
+
+// noteReferences collects entity IDs from the --skill, --goal, --path and --resource
+// flags, verifying that each referenced entity exists.
+func noteReferences() ([]core.EntityID, error) {
+	checks := []struct {
+		id      string
+		kind    string
+		exists  func(core.EntityID) (bool, error)
+		listCmd string
+	}{
+		{noteSkill, "skill", skillRepo.Exists, "growth skill list"},
+		{noteGoal, "goal", goalRepo.Exists, "growth goal list"},
+		{notePath, "path", pathRepo.Exists, "growth path list"},
+		{noteResource, "resource", resourceRepo.Exists, "growth resource list"},
+	}
+
+	var refs []core.EntityID
+	for _, check := range checks {
+		if check.id == "" {
+			continue
+		}
+		id := core.EntityID(check.id)
+		exists, err := check.exists(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s existence: %w", check.kind, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%s '%s' not found. Use '%s' to see available %ss", check.kind, id, check.listCmd, check.kind)
+		}
+		refs = append(refs, id)
+	}
+
+	return refs, nil
+}
+
+func filterNotesByReferences(notes []*core.Note, refs []core.EntityID) []*core.Note {
+	var results []*core.Note
+	for _, note := range notes {
+		matches := true
+		for _, ref := range refs {
+			if !note.HasReference(ref) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			results = append(results, note)
+		}
+	}
+	return results
+}
+
+func sortNotesNewestFirst(notes []*core.Note) {
+	sort.Slice(notes, func(i, j int) bool {
+		return notes[i].Date.After(notes[j].Date)
+	})
+}
+
+func formatEntityIDs(ids []core.EntityID) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = string(id)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,547 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	okrQuarter     string
+	okrTags        string
+	okrMetric      string
+	okrTarget      float64
+	okrDescription string
+	okrStatus      string
+)
+
+var okrCmd = &cobra.Command{
+	Use:   "okr",
+	Short: "Manage quarterly objectives and key results",
+	Long: `Group goals into quarterly objectives to line up with workplace review cycles.
+
+Each objective links goals as key results with a measurable target. Progress is
+computed from the linked goals and their milestones.`,
+}
+
+var okrCreateCmd = &cobra.Command{
+	Use:   "create <title>",
+	Short: "Create an objective",
+	Long: `Create an objective for a quarter.
+
+Examples:
+  growth okr create "Become the go-to person for backend performance"
+  growth okr create "Grow as a mentor" --quarter 2025-Q2 --tags leadership`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOKRCreate,
+}
+
+var okrListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List objectives",
+	Long: `List objectives with their current progress.
+
+Examples:
+  growth okr list
+  growth okr list --quarter 2025-Q1`,
+	Aliases: []string{"ls"},
+	RunE:    runOKRList,
+}
+
+var okrViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View an objective",
+	Long: `View an objective and its key results.
+
+Examples:
+  growth okr view objective-001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOKRView,
+}
+
+var okrLinkCmd = &cobra.Command{
+	Use:   "link <objective-id> <goal-id>",
+	Short: "Link a goal as a key result",
+	Long: `Link a goal to an objective as a key result.
+
+Metrics:
+  completion   share of the goal's milestones achieved, 100% once the goal is completed (default)
+  milestones   number of the goal's milestones achieved, measured against --target
+
+Examples:
+  growth okr link objective-001 goal-002
+  growth okr link objective-001 goal-003 --metric milestones --target 3 --description "Ship 3 performance fixes"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runOKRLink,
+}
+
+var okrUnlinkCmd = &cobra.Command{
+	Use:   "unlink <objective-id> <goal-id>",
+	Short: "Remove a key result",
+	Long: `Remove a goal from an objective's key results.
+
+Examples:
+  growth okr unlink objective-001 goal-002`,
+	Args: cobra.ExactArgs(2),
+	RunE: runOKRUnlink,
+}
+
+var okrStatusCmd = &cobra.Command{
+	Use:   "status [objective-id]",
+	Short: "Show objective completion",
+	Long: `Show how far each objective and key result is, computed from goal and
+milestone progress. Defaults to the objectives of the current quarter.
+
+Examples:
+  growth okr status
+  growth okr status objective-001
+  growth okr status --quarter 2025-Q1`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runOKRStatus,
+}
+
+var okrEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit an objective",
+	Long: `Edit an objective's status or quarter.
+
+Examples:
+  growth okr edit objective-001 --status completed
+  growth okr edit objective-001 --quarter 2025-Q3`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOKREdit,
+}
+
+var okrDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete an objective",
+	Long: `Delete an objective by ID. Linked goals are not affected.
+
+Examples:
+  growth okr delete objective-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runOKRDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(okrCmd)
+	okrCmd.AddCommand(okrCreateCmd)
+	okrCmd.AddCommand(okrListCmd)
+	okrCmd.AddCommand(okrViewCmd)
+	okrCmd.AddCommand(okrLinkCmd)
+	okrCmd.AddCommand(okrUnlinkCmd)
+	okrCmd.AddCommand(okrStatusCmd)
+	okrCmd.AddCommand(okrEditCmd)
+	okrCmd.AddCommand(okrDeleteCmd)
+
+	okrCreateCmd.Flags().StringVar(&okrQuarter, "quarter", "", "quarter the objective belongs to, e.g. 2025-Q1 (defaults to the current quarter)")
+	okrCreateCmd.Flags().StringVar(&okrTags, "tags", "", "comma-separated tags")
+
+	okrListCmd.Flags().StringVar(&okrQuarter, "quarter", "", "filter by quarter, e.g. 2025-Q1")
+
+	okrLinkCmd.Flags().StringVar(&okrMetric, "metric", string(core.MetricCompletion), "how progress is measured: completion, milestones")
+	okrLinkCmd.Flags().Float64Var(&okrTarget, "target", 0, "target number of achieved milestones (required for the milestones metric)")
+	okrLinkCmd.Flags().StringVar(&okrDescription, "description", "", "what the key result means, e.g. \"Ship 3 performance fixes\"")
+
+	okrStatusCmd.Flags().StringVar(&okrQuarter, "quarter", "", "quarter to report on (defaults to the current quarter)")
+
+	okrEditCmd.Flags().StringVar(&okrStatus, "status", "", "new status (active, completed, archived)")
+	okrEditCmd.Flags().StringVar(&okrQuarter, "quarter", "", "move to another quarter, e.g. 2025-Q3")
+}
+
+func runOKRCreate(cmd *cobra.Command, args []string) error {
+	quarter := okrQuarter
+	if quarter == "" {
+		quarter = core.QuarterOf(time.Now())
+	}
+
+	id, err := GenerateNextID("objective")
+	if err != nil {
+		return fmt.Errorf("failed to generate objective ID: %w", err)
+	}
+
+	objective, err := core.NewObjective(id, args[0], quarter)
+	if err != nil {
+		return fmt.Errorf("failed to create objective: %w", err)
+	}
+
+	if okrTags != "" {
+		for _, tag := range strings.Split(okrTags, ",") {
+			objective.AddTag(tag)
+		}
+	}
+
+	if err := objectiveRepo.Create(objective); err != nil {
+		return fmt.Errorf("failed to save objective: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Created objective %s for %s: %s", objective.ID, objective.Quarter, objective.Title))
+	fmt.Printf("Link goals as key results with: growth okr link %s <goal-id>\n", objective.ID)
+	return nil
+}
+
+func runOKRList(cmd *cobra.Command, args []string) error {
+	var objectives []*core.Objective
+	var err error
+
+	if okrQuarter != "" {
+		objectives, err = objectiveRepo.FindByQuarter(strings.ToUpper(okrQuarter))
+	} else {
+		objectives, err = objectiveRepo.GetAll()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to retrieve objectives: %w", err)
+	}
+
+	if len(objectives) == 0 {
+		PrintInfo("No objectives found")
+		return nil
+	}
+
+	sortObjectives(objectives)
+
+	if config.Display.OutputFormat == "table" {
+		goals, milestones, err := loadOKRProgressData()
+		if err != nil {
+			return err
+		}
+
+		for _, objective := range objectives {
+			progress := core.ObjectiveProgress(objective, goals, milestones)
+			fmt.Printf("%s  %s  %-9s  %3s%%  %-40s  %d key results\n",
+				objective.ID, objective.Quarter, objective.Status, formatNumber(progress*100, 0),
+				truncate(objective.Title, 40), len(objective.KeyResults))
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(objectives)
+}
+
+func runOKRView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	objective, err := objectiveRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:      %s\n", objective.ID)
+		fmt.Printf("Title:   %s\n", objective.Title)
+		fmt.Printf("Quarter: %s\n", objective.Quarter)
+		fmt.Printf("Status:  %s\n", objective.Status)
+		if len(objective.Tags) > 0 {
+			fmt.Printf("Tags:    %s\n", strings.Join(objective.Tags, ", "))
+		}
+
+		goals, milestones, err := loadOKRProgressData()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println()
+		printObjectiveStatus(objective, goals, milestones)
+
+		if objective.Body != "" {
+			fmt.Printf("\n%s\n", objective.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(objective)
+}
+
+func runOKRLink(cmd *cobra.Command, args []string) error {
+	objectiveID := core.EntityID(args[0])
+	goalID := core.EntityID(args[1])
+
+	objective, err := objectiveRepo.GetByIDWithBody(objectiveID)
+	if err != nil {
+		return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", objectiveID)
+	}
+
+	goal, err := goalRepo.GetByID(goalID)
+	if err != nil {
+		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", goalID)
+	}
+
+	kr := core.KeyResult{
+		GoalID:      goal.ID,
+		Description: okrDescription,
+		Metric:      core.KeyResultMetric(okrMetric),
+		Target:      okrTarget,
+	}
+
+	if err := objective.AddKeyResult(kr); err != nil {
+		return err
+	}
+
+	if err := objectiveRepo.Update(objective); err != nil {
+		return fmt.Errorf("failed to update objective: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Linked goal %s (%s) to objective %s", goal.ID, goal.Title, objective.ID))
+	return nil
+}
+
+func runOKRUnlink(cmd *cobra.Command, args []string) error {
+	objectiveID := core.EntityID(args[0])
+	goalID := core.EntityID(args[1])
+
+	objective, err := objectiveRepo.GetByIDWithBody(objectiveID)
+	if err != nil {
+		return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", objectiveID)
+	}
+
+	if !objective.RemoveKeyResult(goalID) {
+		return fmt.Errorf("goal %s is not a key result of objective %s", goalID, objectiveID)
+	}
+
+	if err := objectiveRepo.Update(objective); err != nil {
+		return fmt.Errorf("failed to update objective: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Unlinked goal %s from objective %s", goalID, objective.ID))
+	return nil
+}
+
+func runOKRStatus(cmd *cobra.Command, args []string) error {
+	var objectives []*core.Objective
+
+	if len(args) > 0 {
+		id := core.EntityID(args[0])
+		objective, err := objectiveRepo.GetByID(id)
+		if err != nil {
+			return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", id)
+		}
+		objectives = []*core.Objective{objective}
+	} else {
+		quarter := strings.ToUpper(okrQuarter)
+		if quarter == "" {
+			quarter = core.QuarterOf(time.Now())
+		}
+
+		var err error
+		objectives, err = objectiveRepo.FindByQuarter(quarter)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve objectives: %w", err)
+		}
+
+		if len(objectives) == 0 {
+			PrintInfo(fmt.Sprintf("No objectives for %s. Create one with 'growth okr create'", quarter))
+			return nil
+		}
+	}
+
+	sortObjectives(objectives)
+
+	goals, milestones, err := loadOKRProgressData()
+	if err != nil {
+		return err
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(buildOKRReport(objectives, goals, milestones))
+	}
+
+	for i, objective := range objectives {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s  %s  %s\n", objective.ID, objective.Quarter, objective.Title)
+		printObjectiveStatus(objective, goals, milestones)
+	}
+
+	return nil
+}
+
+func runOKREdit(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	objective, err := objectiveRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", id)
+	}
+
+	updated := false
+
+	if okrStatus != "" {
+		if err := objective.UpdateStatus(core.Status(okrStatus)); err != nil {
+			return fmt.Errorf("invalid status '%s' (must be: active, completed, archived)", okrStatus)
+		}
+		updated = true
+	}
+
+	if okrQuarter != "" {
+		objective.Quarter = strings.ToUpper(okrQuarter)
+		objective.Touch()
+		updated = true
+	}
+
+	if !updated {
+		PrintInfo("No changes specified. Use --status or --quarter")
+		return nil
+	}
+
+	if err := objective.Validate(); err != nil {
+		return err
+	}
+
+	if err := objectiveRepo.Update(objective); err != nil {
+		return fmt.Errorf("failed to update objective: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated objective %s: %s", objective.ID, objective.Title))
+	return nil
+}
+
+func runOKRDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	objective, err := objectiveRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("objective '%s' not found. Use 'growth okr list' to see available objectives", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", objective.ID)
+	fmt.Printf("  Title: %s\n", objective.Title)
+	fmt.Printf("  Key results: %d\n", len(objective.KeyResults))
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this objective?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := objectiveRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete objective: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted objective %s", id))
+	return nil
+}
+
+// okrKeyResultReport is the machine-readable status of a key result
+type okrKeyResultReport struct {
+	GoalID      core.EntityID        `json:"goalId" yaml:"goalId"`
+	GoalTitle   string               `json:"goalTitle" yaml:"goalTitle"`
+	Description string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Metric      core.KeyResultMetric `json:"metric" yaml:"metric"`
+	Target      float64              `json:"target,omitempty" yaml:"target,omitempty"`
+	Progress    float64              `json:"progress" yaml:"progress"`
+}
+
+// okrReport is the machine-readable status of an objective
+type okrReport struct {
+	ID         core.EntityID        `json:"id" yaml:"id"`
+	Title      string               `json:"title" yaml:"title"`
+	Quarter    string               `json:"quarter" yaml:"quarter"`
+	Status     core.Status          `json:"status" yaml:"status"`
+	Progress   float64              `json:"progress" yaml:"progress"`
+	KeyResults []okrKeyResultReport `json:"keyResults" yaml:"keyResults"`
+}
+
+func buildOKRReport(objectives []*core.Objective, goals map[core.EntityID]*core.Goal, milestones []*core.Milestone) []okrReport {
+	reports := make([]okrReport, 0, len(objectives))
+	for _, objective := range objectives {
+		report := okrReport{
+			ID:         objective.ID,
+			Title:      objective.Title,
+			Quarter:    objective.Quarter,
+			Status:     objective.Status,
+			Progress:   core.ObjectiveProgress(objective, goals, milestones),
+			KeyResults: []okrKeyResultReport{},
+		}
+		for _, kr := range objective.KeyResults {
+			krReport := okrKeyResultReport{
+				GoalID:      kr.GoalID,
+				Description: kr.Description,
+				Metric:      kr.Metric,
+				Target:      kr.Target,
+				Progress:    core.KeyResultProgress(kr, goals[kr.GoalID], milestones),
+			}
+			if goal := goals[kr.GoalID]; goal != nil {
+				krReport.GoalTitle = goal.Title
+			}
+			report.KeyResults = append(report.KeyResults, krReport)
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+func printObjectiveStatus(objective *core.Objective, goals map[core.EntityID]*core.Goal, milestones []*core.Milestone) {
+	overall := core.ObjectiveProgress(objective, goals, milestones)
+	fmt.Printf("Progress: %s %3s%%\n", okrProgressBar(overall), formatNumber(overall*100, 0))
+
+	if len(objective.KeyResults) == 0 {
+		fmt.Printf("  No key results. Link goals with: growth okr link %s <goal-id>\n", objective.ID)
+		return
+	}
+
+	for i, kr := range objective.KeyResults {
+		goal := goals[kr.GoalID]
+		title := "(goal not found)"
+		status := ""
+		if goal != nil {
+			title = goal.Title
+			status = fmt.Sprintf(" [%s]", goal.Status)
+		}
+
+		progress := core.KeyResultProgress(kr, goal, milestones)
+		fmt.Printf("  KR%d %s %3s%%  %s: %s%s\n", i+1, okrProgressBar(progress), formatNumber(progress*100, 0), kr.GoalID, title, status)
+
+		measure := "goal completion"
+		if kr.Metric == core.MetricMilestones {
+			measure = fmt.Sprintf("%g milestones achieved", kr.Target)
+		}
+		if kr.Description != "" {
+			fmt.Printf("      %s (%s)\n", kr.Description, measure)
+		} else {
+			fmt.Printf("      target: %s\n", measure)
+		}
+	}
+}
+
+func okrProgressBar(progress float64) string {
+	const width = 10
+	filled := int(progress*width + 0.5)
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+func loadOKRProgressData() (map[core.EntityID]*core.Goal, []*core.Milestone, error) {
+	allGoals, err := goalRepo.GetAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load goals: %w", err)
+	}
+
+	goals := make(map[core.EntityID]*core.Goal, len(allGoals))
+	for _, goal := range allGoals {
+		goals[goal.ID] = goal
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	return goals, milestones, nil
+}
+
+func sortObjectives(objectives []*core.Objective) {
+	sort.Slice(objectives, func(i, j int) bool {
+		if objectives[i].Quarter != objectives[j].Quarter {
+			return objectives[i].Quarter > objectives[j].Quarter
+		}
+		return objectives[i].ID < objectives[j].ID
+	})
+}
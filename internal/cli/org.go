@@ -0,0 +1,351 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/orgmode"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportOrgOutput string
+	importOrgDryRun bool
+)
+
+var exportOrgCmd = &cobra.Command{
+	Use:   "org [path-id...]",
+	Short: "Export paths and milestones as an org-mode file",
+	Long: `Export learning paths as an org-mode outline for org-agenda: a heading per
+path, its phases below it, and milestones below their phase or path.
+
+Phases and milestones are TODO or DONE. Phases are SCHEDULED on their start
+date, milestones have their target date as DEADLINE, and finished ones are
+CLOSED on the date they were finished. Each heading keeps the entity's ID in
+a GROWTH_ID property, so 'growth import org' can read your changes back.
+
+Without path IDs, all active paths are exported.
+
+Examples:
+  growth export org --output ~/org/growth.org
+  growth export org path-001 path-003 -o growth.org`,
+	RunE: runExportOrg,
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import changes made in other tools",
+	Long:  `Import changes made to your growth data in other tools.`,
+}
+
+var importOrgCmd = &cobra.Command{
+	Use:   "org <file>",
+	Short: "Apply changes made to an exported org-mode file",
+	Long: `Read back an org-mode file written by 'growth export org' and apply what
+you changed in Emacs:
+
+  - marking a phase or milestone DONE finishes it on its CLOSED date, or today
+  - marking it TODO again reopens it
+  - changing a milestone DEADLINE changes its target date
+  - changing a phase SCHEDULED date changes its start date
+  - renaming a heading renames the entity
+
+Headings without a GROWTH_ID property are skipped, and removing a heading
+does not delete anything.
+
+Examples:
+  growth import org ~/org/growth.org
+  growth import org growth.org --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportOrg,
+}
+
+func init() {
+	exportCmd.AddCommand(exportOrgCmd)
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importOrgCmd)
+
+	exportOrgCmd.Flags().StringVarP(&exportOrgOutput, "output", "o", "", "write the org file instead of printing it")
+	importOrgCmd.Flags().BoolVar(&importOrgDryRun, "dry-run", false, "show the changes without applying them")
+}
+
+func runExportOrg(cmd *cobra.Command, args []string) error {
+	var paths []*core.LearningPath
+	if len(args) > 0 {
+		for _, arg := range args {
+			path, err := pathRepo.GetByID(core.EntityID(arg))
+			if err != nil {
+				return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", arg)
+			}
+			paths = append(paths, path)
+		}
+	} else {
+		all, err := pathRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to load paths: %w", err)
+		}
+		for _, path := range all {
+			if path.Status == core.StatusActive {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	var headings []orgmode.Heading
+	for _, path := range paths {
+		phases, err := phaseRepo.FindByPathID(path.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load phases of %s: %w", path.ID, err)
+		}
+		headings = append(headings, pathOrgHeadings(path, phases, milestones)...)
+	}
+
+	if exportOrgOutput == "" {
+		return orgmode.Write(os.Stdout, "growth.md", headings)
+	}
+
+	file, err := os.Create(exportOrgOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create org file: %w", err)
+	}
+	defer file.Close()
+
+	if err := orgmode.Write(file, "growth.md", headings); err != nil {
+		return err
+	}
+
+	PrintSuccess(fmt.Sprintf("Exported %s to %s", countOf(len(paths), "path"), exportOrgOutput))
+	fmt.Printf("Apply your changes with: growth import org %s\n", exportOrgOutput)
+	return nil
+}
+
+// pathOrgHeadings outlines a path with its phases and their milestones.
+// Milestones of the path that are in no phase follow the phases, archived
+// milestones are left out.
+func pathOrgHeadings(path *core.LearningPath, phases []*core.Phase, milestones []*core.Milestone) []orgmode.Heading {
+	byID := make(map[core.EntityID]*core.Milestone, len(milestones))
+	for _, milestone := range milestones {
+		byID[milestone.ID] = milestone
+	}
+
+	headings := []orgmode.Heading{{Level: 1, Title: path.Title, ID: string(path.ID)}}
+	inPhase := map[core.EntityID]bool{}
+	for _, phase := range phases {
+		heading := orgmode.Heading{Level: 2, State: orgmode.Todo, Title: phase.Title, ID: string(phase.ID), Scheduled: phase.StartDate}
+		if phase.IsFinished() {
+			heading.State = orgmode.Done
+			heading.Closed = phase.EndDate
+		}
+		headings = append(headings, heading)
+
+		for _, id := range phase.Milestones {
+			inPhase[id] = true
+			if milestone, ok := byID[id]; ok && milestone.Status != core.StatusArchived {
+				headings = append(headings, milestoneOrgHeading(milestone, 3))
+			}
+		}
+	}
+
+	for _, milestone := range milestones {
+		if milestone.ReferenceType == core.ReferencePath && milestone.ReferenceID == path.ID &&
+			!inPhase[milestone.ID] && milestone.Status != core.StatusArchived {
+			headings = append(headings, milestoneOrgHeading(milestone, 2))
+		}
+	}
+
+	return headings
+}
+
+func milestoneOrgHeading(milestone *core.Milestone, level int) orgmode.Heading {
+	heading := orgmode.Heading{Level: level, State: orgmode.Todo, Title: milestone.Title, ID: string(milestone.ID), Deadline: milestone.TargetDate}
+	if milestone.IsAchieved() {
+		heading.State = orgmode.Done
+		heading.Closed = milestone.AchievedDate
+	}
+	return heading
+}
+
+func runImportOrg(cmd *cobra.Command, args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open org file: %w", err)
+	}
+	defer file.Close()
+
+	headings, err := orgmode.Parse(file)
+	if err != nil {
+		return err
+	}
+
+	today := core.CalendarDate(time.Now())
+	updated, skipped := 0, 0
+	for _, heading := range headings {
+		if heading.ID == "" {
+			skipped++
+			continue
+		}
+
+		changes, save, err := applyOrgHeading(heading, today)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+
+		fmt.Printf("%s:\n", heading.ID)
+		for _, change := range changes {
+			fmt.Printf("  %s\n", change)
+		}
+		if !importOrgDryRun {
+			if err := save(); err != nil {
+				return fmt.Errorf("failed to update %s: %w", heading.ID, err)
+			}
+		}
+		updated++
+	}
+
+	if skipped > 0 {
+		PrintInfo(fmt.Sprintf("Skipped %s without a %s property", countOf(skipped, "heading"), orgmode.IDProperty))
+	}
+	entities := fmt.Sprintf("%d entities", updated)
+	if updated == 1 {
+		entities = "1 entity"
+	}
+	switch {
+	case updated == 0:
+		PrintInfo("No changes to import")
+	case importOrgDryRun:
+		PrintInfo(fmt.Sprintf("Would update %s (dry run)", entities))
+	default:
+		PrintSuccess(fmt.Sprintf("Updated %s", entities))
+	}
+	return nil
+}
+
+// applyOrgHeading applies a heading to the entity with its ID, returning the
+// changes and how to save them
+func applyOrgHeading(heading orgmode.Heading, today time.Time) ([]string, func() error, error) {
+	id := core.EntityID(heading.ID)
+	switch {
+	case strings.HasPrefix(heading.ID, "milestone-"):
+		milestone, err := milestoneRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("milestone '%s' in the org file not found", id)
+		}
+		changes, err := applyOrgMilestone(milestone, heading, today)
+		return changes, func() error { return milestoneRepo.Update(milestone) }, err
+	case strings.HasPrefix(heading.ID, "phase-"):
+		phase, err := phaseRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("phase '%s' in the org file not found", id)
+		}
+		changes, err := applyOrgPhase(phase, heading, today)
+		return changes, func() error { return phaseRepo.Update(phase) }, err
+	case strings.HasPrefix(heading.ID, "path-"):
+		path, err := pathRepo.GetByIDWithBody(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("path '%s' in the org file not found", id)
+		}
+		return applyOrgTitle(&path.Title, heading), func() error { return pathRepo.Update(path) }, nil
+	default:
+		return nil, nil, fmt.Errorf("cannot import %s: only paths, phases and milestones are exported to org", id)
+	}
+}
+
+// applyOrgMilestone updates a milestone from its heading
+func applyOrgMilestone(milestone *core.Milestone, heading orgmode.Heading, today time.Time) ([]string, error) {
+	changes := applyOrgTitle(&milestone.Title, heading)
+
+	switch {
+	case heading.State == orgmode.Done && !milestone.IsAchieved():
+		achieved := today
+		if heading.Closed != nil {
+			achieved = *heading.Closed
+		}
+		if err := milestone.UpdateStatus(core.StatusCompleted); err != nil {
+			return nil, err
+		}
+		milestone.AchievedDate = &achieved
+		changes = append(changes, "achieved on "+formatDate(achieved))
+	case heading.State == orgmode.Todo && milestone.IsAchieved():
+		if err := milestone.UpdateStatus(core.StatusActive); err != nil {
+			return nil, err
+		}
+		changes = append(changes, "reopened")
+	}
+
+	if !sameOrgDate(milestone.TargetDate, heading.Deadline) {
+		if heading.Deadline == nil {
+			milestone.ClearTargetDate()
+			changes = append(changes, "target date removed")
+		} else {
+			milestone.SetTargetDate(*heading.Deadline)
+			changes = append(changes, "target date "+formatDate(*heading.Deadline))
+		}
+	}
+
+	return changes, nil
+}
+
+// applyOrgPhase updates a phase from its heading
+func applyOrgPhase(phase *core.Phase, heading orgmode.Heading, today time.Time) ([]string, error) {
+	changes := applyOrgTitle(&phase.Title, heading)
+
+	if !sameOrgDate(phase.StartDate, heading.Scheduled) {
+		if heading.Scheduled == nil {
+			phase.StartDate = nil
+			phase.Touch()
+			changes = append(changes, "start date removed")
+		} else {
+			if err := phase.SetStartDate(*heading.Scheduled); err != nil {
+				return nil, fmt.Errorf("cannot schedule %s: %w", phase.ID, err)
+			}
+			changes = append(changes, "starts "+formatDate(*heading.Scheduled))
+		}
+	}
+
+	switch {
+	case heading.State == orgmode.Done && !phase.IsFinished():
+		finished := today
+		if heading.Closed != nil {
+			finished = *heading.Closed
+		}
+		if err := phase.Complete(finished); err != nil {
+			return nil, fmt.Errorf("cannot finish %s: %w", phase.ID, err)
+		}
+		changes = append(changes, "finished on "+formatDate(finished))
+	case heading.State == orgmode.Todo && phase.IsFinished():
+		phase.EndDate = nil
+		phase.Touch()
+		changes = append(changes, "reopened")
+	}
+
+	return changes, nil
+}
+
+// applyOrgTitle renames an entity to the title of its heading
+func applyOrgTitle(title *string, heading orgmode.Heading) []string {
+	if heading.Title == "" || heading.Title == strings.TrimSpace(*title) {
+		return nil
+	}
+	change := fmt.Sprintf("renamed from '%s' to '%s'", *title, heading.Title)
+	*title = heading.Title
+	return []string{change}
+}
+
+// sameOrgDate compares dates at the day precision of org timestamps
+func sameOrgDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return core.CalendarDate(*a).Equal(core.CalendarDate(*b))
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/orgmode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func orgDate(month time.Month, day int) *time.Time {
+	d := time.Date(2025, month, day, 0, 0, 0, 0, time.Local)
+	return &d
+}
+
+func TestPathOrgHeadings(t *testing.T) {
+	path, _ := core.NewLearningPath("path-001", "Backend Go", core.PathTypeManual)
+	started, _ := core.NewPhase("phase-001", "path-001", "Foundations", 1)
+	started.SetStartDate(*orgDate(1, 6))
+	started.Milestones = []core.EntityID{"milestone-001", "milestone-004"}
+	next, _ := core.NewPhase("phase-002", "path-001", "Services", 2)
+
+	cli, _ := core.NewMilestone("milestone-001", "Write a CLI", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	cli.SetTargetDate(*orgDate(2, 1))
+	final, _ := core.NewMilestone("milestone-002", "Ship a service", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	final.UpdateStatus(core.StatusCompleted)
+	final.AchievedDate = orgDate(3, 1)
+	other, _ := core.NewMilestone("milestone-003", "Other path", core.MilestonePathLevel, core.ReferencePath, "path-002")
+	archived, _ := core.NewMilestone("milestone-004", "Dropped", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	archived.UpdateStatus(core.StatusArchived)
+
+	headings := pathOrgHeadings(path, []*core.Phase{started, next}, []*core.Milestone{cli, final, other, archived})
+
+	assert.Equal(t, []orgmode.Heading{
+		{Level: 1, Title: "Backend Go", ID: "path-001"},
+		{Level: 2, State: orgmode.Todo, Title: "Foundations", ID: "phase-001", Scheduled: orgDate(1, 6)},
+		{Level: 3, State: orgmode.Todo, Title: "Write a CLI", ID: "milestone-001", Deadline: orgDate(2, 1)},
+		{Level: 2, State: orgmode.Todo, Title: "Services", ID: "phase-002"},
+		{Level: 2, State: orgmode.Done, Title: "Ship a service", ID: "milestone-002", Closed: orgDate(3, 1)},
+	}, headings)
+}
+
+func TestApplyOrgMilestone(t *testing.T) {
+	today := *orgDate(3, 10)
+	newMilestone := func() *core.Milestone {
+		m, _ := core.NewMilestone("milestone-001", "Write a CLI", core.MilestonePathLevel, core.ReferencePath, "path-001")
+		m.SetTargetDate(*orgDate(2, 1))
+		return m
+	}
+
+	t.Run("unchanged heading changes nothing", func(t *testing.T) {
+		m := newMilestone()
+		changes, err := applyOrgMilestone(m, milestoneOrgHeading(m, 3), today)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("achieves on the closed date and moves the deadline", func(t *testing.T) {
+		m := newMilestone()
+		heading := orgmode.Heading{State: orgmode.Done, Title: "Write a Go CLI", ID: "milestone-001", Deadline: orgDate(2, 15), Closed: orgDate(2, 20)}
+
+		changes, err := applyOrgMilestone(m, heading, today)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"renamed from 'Write a CLI' to 'Write a Go CLI'",
+			"achieved on 2025-02-20",
+			"target date 2025-02-15",
+		}, changes)
+		assert.True(t, m.IsAchieved())
+		assert.Equal(t, orgDate(2, 20), m.AchievedDate)
+		assert.Equal(t, "Write a Go CLI", m.Title)
+	})
+
+	t.Run("achieves today without a closed date and reopens", func(t *testing.T) {
+		m := newMilestone()
+		heading := orgmode.Heading{State: orgmode.Done, Title: m.Title, Deadline: m.TargetDate}
+
+		_, err := applyOrgMilestone(m, heading, today)
+		require.NoError(t, err)
+		assert.Equal(t, &today, m.AchievedDate)
+
+		heading.State = orgmode.Todo
+		changes, err := applyOrgMilestone(m, heading, today)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reopened"}, changes)
+		assert.False(t, m.IsAchieved())
+	})
+
+	t.Run("removes the deadline", func(t *testing.T) {
+		m := newMilestone()
+		changes, err := applyOrgMilestone(m, orgmode.Heading{State: orgmode.Todo, Title: m.Title}, today)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"target date removed"}, changes)
+		assert.Nil(t, m.TargetDate)
+	})
+}
+
+func TestApplyOrgPhase(t *testing.T) {
+	today := *orgDate(3, 10)
+
+	t.Run("schedules and finishes a phase", func(t *testing.T) {
+		phase, _ := core.NewPhase("phase-001", "path-001", "Foundations", 1)
+		heading := orgmode.Heading{State: orgmode.Done, Title: "Foundations", Scheduled: orgDate(1, 6), Closed: orgDate(1, 20)}
+
+		changes, err := applyOrgPhase(phase, heading, today)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"starts 2025-01-06", "finished on 2025-01-20"}, changes)
+		assert.Equal(t, orgDate(1, 20), phase.EndDate)
+
+		heading.State = orgmode.Todo
+		changes, err = applyOrgPhase(phase, heading, today)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reopened"}, changes)
+		assert.False(t, phase.IsFinished())
+	})
+
+	t.Run("rejects a start after the end", func(t *testing.T) {
+		phase, _ := core.NewPhase("phase-001", "path-001", "Foundations", 1)
+		phase.Complete(*orgDate(1, 20))
+
+		_, err := applyOrgPhase(phase, orgmode.Heading{State: orgmode.Done, Title: "Foundations", Scheduled: orgDate(2, 1), Closed: orgDate(1, 20)}, today)
+		assert.Error(t, err)
+	})
+}
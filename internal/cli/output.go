@@ -6,19 +6,14 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/i18n"
 	"gopkg.in/yaml.v3"
 )
 
-const (
-	colorReset  = "\033[0m"
-	colorRed    = "\033[31m"
-	colorGreen  = "\033[32m"
-	colorYellow = "\033[33m"
-	colorBlue   = "\033[34m"
-	colorGray   = "\033[90m"
-)
-
 func PrintTable(data interface{}) error {
 	if data == nil {
 		return nil
@@ -95,7 +90,7 @@ func getTableHeaders(t reflect.Type) ([]string, []int) {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 
-		if field.Tag.Get("yaml") == "-" || field.Name == "Body" {
+		if field.Tag.Get("yaml") == "-" || field.Name == "Body" || field.Name == "History" {
 			continue
 		}
 
@@ -108,8 +103,16 @@ func getTableHeaders(t reflect.Type) ([]string, []int) {
 			}
 		}
 
+		width := max(len(name), 10)
+		if isTimeField(field.Type) {
+			width = max(len(name), len(formatDate(time.Now())))
+			if relativeDatesEnabled() {
+				width += 16
+			}
+		}
+
 		headers = append(headers, strings.ToUpper(name))
-		widths = append(widths, max(len(name), 10))
+		widths = append(widths, width)
 	}
 
 	return headers, widths
@@ -136,7 +139,7 @@ func printTableRow(item reflect.Value, headers []string, widths []int) {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 
-		if field.Tag.Get("yaml") == "-" || field.Name == "Body" {
+		if field.Tag.Get("yaml") == "-" || field.Name == "Body" || field.Name == "History" {
 			continue
 		}
 
@@ -177,8 +180,11 @@ func formatFieldValue(v reflect.Value) string {
 		}
 		return strings.Join(parts, ",")
 	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return formatDate(t) + relativeDate(t)
+		}
 		if timeValue, ok := v.Interface().(interface{ Format(string) string }); ok {
-			return timeValue.Format("2006-01-02")
+			return timeValue.Format(dateLayout())
 		}
 		return fmt.Sprint(v.Interface())
 	default:
@@ -186,6 +192,35 @@ func formatFieldValue(v reflect.Value) string {
 	}
 }
 
+func isTimeField(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == reflect.TypeOf(time.Time{})
+}
+
+func relativeDatesEnabled() bool {
+	return config != nil && config.Display.RelativeDates
+}
+
+// relativeDate returns how far t is from today in parentheses, such as
+// " (in 12 days)", when display.relativeDates is on
+func relativeDate(t time.Time) string {
+	if !relativeDatesEnabled() || t.IsZero() {
+		return ""
+	}
+	return " (" + core.RelativeDate(t, time.Now()) + ")"
+}
+
+// dueDate returns how far a target date is from today, such as
+// " (due in 12 days)", when display.relativeDates is on
+func dueDate(t time.Time) string {
+	if !relativeDatesEnabled() || t.IsZero() {
+		return ""
+	}
+	return " (due " + core.RelativeDate(t, time.Now()) + ")"
+}
+
 func PrintJSON(data interface{}) error {
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
@@ -199,21 +234,21 @@ func PrintYAML(data interface{}) error {
 }
 
 func PrintSuccess(message string) {
-	fmt.Printf("%s✓%s %s\n", colorGreen, colorReset, message)
+	fmt.Printf("%s %s\n", paint(currentTheme().success, glyph.Check.String()), i18n.T(message))
 }
 
 func PrintError(err error) {
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s✗ Error:%s %s\n", colorRed, colorReset, err.Error())
+		fmt.Fprintf(os.Stderr, "%s %s\n", paint(currentTheme().failure, glyph.Cross.String()+" Error:"), err.Error())
 	}
 }
 
 func PrintWarning(message string) {
-	fmt.Printf("%s⚠%s  %s\n", colorYellow, colorReset, message)
+	fmt.Printf("%s  %s\n", paint(currentTheme().warning, glyph.Warning.String()), i18n.T(message))
 }
 
 func PrintInfo(message string) {
-	fmt.Printf("%sℹ%s  %s\n", colorBlue, colorReset, message)
+	fmt.Printf("%s  %s\n", paint(currentTheme().info, glyph.Info.String()), i18n.T(message))
 }
 
 func Print(format string, args ...interface{}) {
@@ -256,3 +291,15 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+// truncate shortens s to at most width characters, adding an ellipsis when cut
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-3]) + "..."
+}
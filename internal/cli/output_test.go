@@ -208,3 +208,9 @@ func TestFormatFieldValue(t *testing.T) {
 		assert.Equal(t, "", result)
 	})
 }
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", truncate("short", 10))
+	assert.Equal(t, "a long ...", truncate("a long phase title", 10))
+	assert.Equal(t, "ab", truncate("abcdef", 2))
+}
@@ -0,0 +1,412 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	outputTitle  string
+	outputType   string
+	outputDate   string
+	outputURL    string
+	outputVenue  string
+	outputSkills string
+	outputGoals  string
+	outputTags   string
+	outputNotes  string
+	outputSkill  string
+	outputGoal   string
+	outputSince  string
+)
+
+var outputCmd = &cobra.Command{
+	Use:   "output",
+	Short: "Track talks, blog posts and open source contributions",
+	Long: `Track what you give back: conference talks, blog posts and open source
+contributions, linked to the skills they draw on and the goals they serve.
+Outputs are counted in 'growth stats' and listed in 'growth export review'.`,
+}
+
+var outputCreateCmd = &cobra.Command{
+	Use:   "create [title]",
+	Short: "Record an output",
+	Long: `Record a talk, blog post or open source contribution.
+
+Types: talk, blog-post, oss-contribution
+
+Examples:
+  growth output create "Profiling Go services" --type talk --venue "GopherCon EU" --skills skill-001
+  growth output create "Fix race in the scheduler" --type oss-contribution --url https://github.com/org/repo/pull/42 --goals goal-002
+  growth output create`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runOutputCreate,
+}
+
+var outputListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List outputs",
+	Long: `List outputs, newest first.
+
+Examples:
+  growth output list
+  growth output list --type talk
+  growth output list --skill skill-001 --since 2025-01-01`,
+	Aliases: []string{"ls"},
+	RunE:    runOutputList,
+}
+
+var outputViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View an output",
+	Long: `View an output with its links and notes.
+
+Examples:
+  growth output view output-001
+  growth output view output-001 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOutputView,
+}
+
+var outputEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit an output",
+	Long: `Edit an output. List flags replace the current values.
+
+Examples:
+  growth output edit output-001 --url https://example.com/recording
+  growth output edit output-001 --skills "skill-001,skill-004"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOutputEdit,
+}
+
+var outputDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete an output",
+	Long: `Delete an output by ID. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth output delete output-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runOutputDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(outputCmd)
+	outputCmd.AddCommand(outputCreateCmd)
+	outputCmd.AddCommand(outputListCmd)
+	outputCmd.AddCommand(outputViewCmd)
+	outputCmd.AddCommand(outputEditCmd)
+	outputCmd.AddCommand(outputDeleteCmd)
+
+	for _, cmd := range []*cobra.Command{outputCreateCmd, outputEditCmd} {
+		cmd.Flags().StringVar(&outputType, "type", "", "output type (talk, blog-post, oss-contribution)")
+		cmd.Flags().StringVar(&outputDate, "date", "", "date given, published or merged (YYYY-MM-DD)")
+		cmd.Flags().StringVar(&outputURL, "url", "", "link to the recording, post or pull request")
+		cmd.Flags().StringVar(&outputVenue, "venue", "", "conference, publication or repository")
+		cmd.Flags().StringVar(&outputSkills, "skills", "", "comma-separated skill IDs the output draws on")
+		cmd.Flags().StringVar(&outputGoals, "goals", "", "comma-separated goal IDs the output contributes to")
+		cmd.Flags().StringVar(&outputTags, "tags", "", "comma-separated tags")
+		cmd.Flags().StringVar(&outputNotes, "notes", "", "notes, e.g. abstract or feedback")
+	}
+	outputEditCmd.Flags().StringVar(&outputTitle, "title", "", "output title")
+
+	outputListCmd.Flags().StringVar(&outputType, "type", "", "filter by type")
+	outputListCmd.Flags().StringVar(&outputSkill, "skill", "", "filter by skill ID")
+	outputListCmd.Flags().StringVar(&outputGoal, "goal", "", "filter by goal ID")
+	outputListCmd.Flags().StringVar(&outputSince, "since", "", "only show outputs on or after this date (YYYY-MM-DD)")
+}
+
+func runOutputCreate(cmd *cobra.Command, args []string) error {
+	title := ""
+	if len(args) > 0 {
+		title = args[0]
+	} else {
+		title = PromptStringRequired("Title")
+	}
+
+	if outputType == "" {
+		outputType = PromptSelect("Type", []string{"talk", "blog-post", "oss-contribution"})
+	}
+	oType := core.OutputType(outputType)
+	if !oType.IsValid() {
+		return fmt.Errorf("invalid output type '%s'. Valid options: talk, blog-post, oss-contribution", outputType)
+	}
+
+	date := time.Now()
+	if outputDate != "" {
+		parsed, err := time.Parse("2006-01-02", outputDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		date = parsed
+	}
+
+	skills, goals, err := outputReferences()
+	if err != nil {
+		return err
+	}
+
+	id, err := GenerateNextID("output")
+	if err != nil {
+		return fmt.Errorf("failed to generate output ID: %w", err)
+	}
+
+	output, err := core.NewOutput(id, title, oType, date)
+	if err != nil {
+		return fmt.Errorf("failed to create output: %w", err)
+	}
+
+	output.URL = outputURL
+	output.Venue = outputVenue
+	for _, skillID := range skills {
+		output.AddSkill(skillID)
+	}
+	for _, goalID := range goals {
+		output.AddGoal(goalID)
+	}
+	for _, tag := range splitList(outputTags) {
+		output.AddTag(tag)
+	}
+	output.Body = outputNotes
+
+	if err := outputRepo.Create(output); err != nil {
+		return fmt.Errorf("failed to save output: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Recorded %s %s: %s", output.Type, output.ID, output.Title))
+	return nil
+}
+
+func runOutputList(cmd *cobra.Command, args []string) error {
+	var outputs []*core.Output
+	var err error
+
+	switch {
+	case outputSkill != "":
+		outputs, err = outputRepo.FindByReference(core.EntityID(outputSkill))
+	case outputGoal != "":
+		outputs, err = outputRepo.FindByReference(core.EntityID(outputGoal))
+	default:
+		outputs, err = outputRepo.FindSince(time.Time{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve outputs: %w", err)
+	}
+
+	if outputSkill != "" && outputGoal != "" {
+		outputs = filterOutputs(outputs, func(o *core.Output) bool {
+			return o.HasReference(core.EntityID(outputGoal))
+		})
+	}
+
+	if outputType != "" {
+		oType := core.OutputType(outputType)
+		if !oType.IsValid() {
+			return fmt.Errorf("invalid output type '%s'. Valid options: talk, blog-post, oss-contribution", outputType)
+		}
+		outputs = filterOutputs(outputs, func(o *core.Output) bool {
+			return o.Type == oType
+		})
+	}
+
+	if outputSince != "" {
+		since, err := time.Parse("2006-01-02", outputSince)
+		if err != nil {
+			return fmt.Errorf("invalid since date format (use YYYY-MM-DD): %w", err)
+		}
+		outputs = filterOutputs(outputs, func(o *core.Output) bool {
+			return !o.Date.Before(since)
+		})
+	}
+
+	if len(outputs) == 0 {
+		PrintInfo("No outputs found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, output := range outputs {
+			fmt.Printf("%s  %s  %-16s  %s", output.ID, formatDate(output.Date), output.Type, output.Title)
+			if output.Venue != "" {
+				fmt.Printf("  (%s)", output.Venue)
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(outputs)
+}
+
+func runOutputView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	output, err := outputRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("output '%s' not found. Use 'growth output list' to see available outputs", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:     %s\n", output.ID)
+		fmt.Printf("Title:  %s\n", output.Title)
+		fmt.Printf("Type:   %s\n", output.Type)
+		fmt.Printf("Date:   %s\n", formatDate(output.Date))
+		if output.Venue != "" {
+			fmt.Printf("Venue:  %s\n", output.Venue)
+		}
+		if output.URL != "" {
+			fmt.Printf("URL:    %s\n", output.URL)
+		}
+		if len(output.Skills) > 0 {
+			fmt.Printf("Skills: %s\n", formatEntityIDs(output.Skills))
+		}
+		if len(output.Goals) > 0 {
+			fmt.Printf("Goals:  %s\n", formatEntityIDs(output.Goals))
+		}
+		if len(output.Tags) > 0 {
+			fmt.Printf("Tags:   %s\n", strings.Join(output.Tags, ", "))
+		}
+
+		if output.Body != "" {
+			fmt.Printf("\nNotes:\n%s\n", output.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(output)
+}
+
+func runOutputEdit(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	output, err := outputRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("output '%s' not found. Use 'growth output list' to see available outputs", id)
+	}
+
+	skills, goals, err := outputReferences()
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("title") {
+		output.Title = outputTitle
+	}
+	if flags.Changed("type") {
+		output.Type = core.OutputType(outputType)
+	}
+	if flags.Changed("date") {
+		date, err := time.Parse("2006-01-02", outputDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		output.Date = date
+	}
+	if flags.Changed("url") {
+		output.URL = outputURL
+	}
+	if flags.Changed("venue") {
+		output.Venue = outputVenue
+	}
+	if flags.Changed("skills") {
+		output.Skills = skills
+	}
+	if flags.Changed("goals") {
+		output.Goals = goals
+	}
+	if flags.Changed("tags") {
+		output.Tags = []string{}
+		for _, tag := range splitList(outputTags) {
+			output.AddTag(tag)
+		}
+	}
+	if flags.Changed("notes") {
+		output.Body = outputNotes
+	}
+
+	output.Touch()
+	if err := output.Validate(); err != nil {
+		return fmt.Errorf("invalid output: %w", err)
+	}
+
+	if err := outputRepo.Update(output); err != nil {
+		return fmt.Errorf("failed to update output: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated output %s: %s", output.ID, output.Title))
+	return nil
+}
+
+func runOutputDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	output, err := outputRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("output '%s' not found. Use 'growth output list' to see available outputs", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", output.ID)
+	fmt.Printf("  Title: %s\n", output.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this output?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := outputRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete output: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted output %s", id))
+	return nil
+}
+
+// outputReferences parses the --skills and --goals flags, verifying that
+// each referenced entity exists
+func outputReferences() ([]core.EntityID, []core.EntityID, error) {
+	var skills, goals []core.EntityID
+
+	for _, id := range splitList(outputSkills) {
+		exists, err := skillRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check skill existence: %w", err)
+		}
+		if !exists {
+			return nil, nil, fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+		}
+		skills = append(skills, core.EntityID(id))
+	}
+
+	for _, id := range splitList(outputGoals) {
+		exists, err := goalRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check goal existence: %w", err)
+		}
+		if !exists {
+			return nil, nil, fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+		}
+		goals = append(goals, core.EntityID(id))
+	}
+
+	return skills, goals, nil
+}
+
+func filterOutputs(outputs []*core.Output, keep func(*core.Output) bool) []*core.Output {
+	var results []*core.Output
+	for _, output := range outputs {
+		if keep(output) {
+			results = append(results, output)
+		}
+	}
+	return results
+}
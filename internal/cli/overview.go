@@ -28,21 +28,26 @@ func runOverview(cmd *cobra.Command, args []string) error {
 	fmt.Println("==========================")
 	fmt.Println()
 
-	// Skills
-	skills, err := skillRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get skills: %w", err)
+	if err := printBlockedGoals(); err != nil {
+		return err
 	}
 
+	// Skills
+	skillCount := 0
 	skillsByLevel := make(map[core.ProficiencyLevel]int)
 	skillsByStatus := make(map[core.SkillStatus]int)
-	for _, skill := range skills {
+	err := skillRepo.Iterate(func(skill *core.Skill) bool {
+		skillCount++
 		skillsByLevel[skill.Level]++
 		skillsByStatus[skill.Status]++
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get skills: %w", err)
 	}
 
-	fmt.Printf("Skills: %d total\n", len(skills))
-	if len(skills) > 0 {
+	fmt.Printf("Skills: %d total\n", skillCount)
+	if skillCount > 0 {
 		fmt.Printf("  Beginner: %d | Intermediate: %d | Advanced: %d | Expert: %d\n",
 			skillsByLevel[core.LevelBeginner],
 			skillsByLevel[core.LevelIntermediate],
@@ -56,48 +61,51 @@ func runOverview(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Goals
-	goals, err := goalRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get goals: %w", err)
-	}
-
+	goalCount := 0
 	goalsByPriority := make(map[core.Priority]int)
 	goalsByStatus := make(map[core.Status]int)
-	for _, goal := range goals {
+	err = goalRepo.Iterate(func(goal *core.Goal) bool {
+		goalCount++
 		goalsByPriority[goal.Priority]++
 		goalsByStatus[goal.Status]++
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get goals: %w", err)
 	}
 
-	fmt.Printf("Goals: %d total\n", len(goals))
-	if len(goals) > 0 {
+	fmt.Printf("Goals: %d total\n", goalCount)
+	if goalCount > 0 {
 		fmt.Printf("  High: %d | Medium: %d | Low: %d\n",
 			goalsByPriority[core.PriorityHigh],
 			goalsByPriority[core.PriorityMedium],
 			goalsByPriority[core.PriorityLow])
-		fmt.Printf("  Active: %d | Completed: %d | Archived: %d\n",
+		fmt.Printf("  Active: %d | Blocked: %d | Completed: %d | Archived: %d\n",
 			goalsByStatus[core.StatusActive],
+			goalsByStatus[core.StatusBlocked],
 			goalsByStatus[core.StatusCompleted],
 			goalsByStatus[core.StatusArchived])
 	}
 	fmt.Println()
 
 	// Resources
-	resources, err := resourceRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get resources: %w", err)
-	}
-
+	resourceCount := 0
 	resourcesByType := make(map[core.ResourceType]int)
 	resourcesByStatus := make(map[core.ResourceStatus]int)
 	totalHours := 0.0
-	for _, resource := range resources {
+	err = resourceRepo.Iterate(func(resource *core.Resource) bool {
+		resourceCount++
 		resourcesByType[resource.Type]++
 		resourcesByStatus[resource.Status]++
 		totalHours += resource.EstimatedHours
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
 	}
 
-	fmt.Printf("Resources: %d total (%.1f hours estimated)\n", len(resources), totalHours)
-	if len(resources) > 0 {
+	fmt.Printf("Resources: %d total (%s hours estimated)\n", resourceCount, formatNumber(totalHours, 1))
+	if resourceCount > 0 {
 		fmt.Printf("  Books: %d | Courses: %d | Videos: %d | Articles: %d | Projects: %d | Docs: %d\n",
 			resourcesByType[core.ResourceBook],
 			resourcesByType[core.ResourceCourse],
@@ -105,55 +113,59 @@ func runOverview(cmd *cobra.Command, args []string) error {
 			resourcesByType[core.ResourceArticle],
 			resourcesByType[core.ResourceProject],
 			resourcesByType[core.ResourceDocumentation])
-		fmt.Printf("  Not Started: %d | In Progress: %d | Completed: %d\n",
+		fmt.Printf("  Not Started: %d | In Progress: %d | Completed: %d | Abandoned: %d\n",
 			resourcesByStatus[core.ResourceNotStarted],
 			resourcesByStatus[core.ResourceInProgress],
-			resourcesByStatus[core.ResourceCompleted])
+			resourcesByStatus[core.ResourceCompleted],
+			resourcesByStatus[core.ResourceAbandoned])
 	}
 	fmt.Println()
 
 	// Paths
-	paths, err := pathRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get paths: %w", err)
-	}
-
+	pathCount := 0
 	pathsByType := make(map[core.PathType]int)
 	pathsByStatus := make(map[core.Status]int)
-	for _, path := range paths {
+	err = pathRepo.Iterate(func(path *core.LearningPath) bool {
+		pathCount++
 		pathsByType[path.Type]++
 		pathsByStatus[path.Status]++
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get paths: %w", err)
 	}
 
-	fmt.Printf("Learning Paths: %d total\n", len(paths))
-	if len(paths) > 0 {
+	fmt.Printf("Learning Paths: %d total\n", pathCount)
+	if pathCount > 0 {
 		fmt.Printf("  Manual: %d | AI-Generated: %d\n",
 			pathsByType[core.PathTypeManual],
 			pathsByType[core.PathTypeAIGenerated])
-		fmt.Printf("  Active: %d | Completed: %d | Archived: %d\n",
+		fmt.Printf("  Active: %d | Completed: %d | Archived: %d | Abandoned: %d\n",
 			pathsByStatus[core.StatusActive],
 			pathsByStatus[core.StatusCompleted],
-			pathsByStatus[core.StatusArchived])
+			pathsByStatus[core.StatusArchived],
+			pathsByStatus[core.StatusAbandoned])
 	}
 	fmt.Println()
 
 	// Milestones
-	milestones, err := milestoneRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get milestones: %w", err)
-	}
-
+	milestoneCount := 0
 	milestonesByType := make(map[core.MilestoneType]int)
 	milestonesAchieved := 0
-	for _, milestone := range milestones {
+	err = milestoneRepo.Iterate(func(milestone *core.Milestone) bool {
+		milestoneCount++
 		milestonesByType[milestone.Type]++
 		if milestone.IsAchieved() {
 			milestonesAchieved++
 		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get milestones: %w", err)
 	}
 
-	fmt.Printf("Milestones: %d total (%d achieved)\n", len(milestones), milestonesAchieved)
-	if len(milestones) > 0 {
+	fmt.Printf("Milestones: %d total (%d achieved)\n", milestoneCount, milestonesAchieved)
+	if milestoneCount > 0 {
 		fmt.Printf("  Goal-level: %d | Path-level: %d | Skill-level: %d\n",
 			milestonesByType[core.MilestoneGoalLevel],
 			milestonesByType[core.MilestonePathLevel],
@@ -162,18 +174,45 @@ func runOverview(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Progress Logs
-	progressLogs, err := progressRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get progress logs: %w", err)
-	}
-
+	progressCount := 0
 	totalProgressHours := 0.0
-	for _, log := range progressLogs {
+	err = progressRepo.Iterate(func(log *core.ProgressLog) bool {
+		progressCount++
 		totalProgressHours += log.HoursInvested
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get progress logs: %w", err)
 	}
 
-	fmt.Printf("Progress Logs: %d total (%.1f hours logged)\n", len(progressLogs), totalProgressHours)
+	fmt.Printf("Progress Logs: %d total (%s hours logged)\n", progressCount, formatNumber(totalProgressHours, 1))
 	fmt.Println()
 
 	return nil
 }
+
+// printBlockedGoals lists blocked goals and their blockers so stalled objectives stand out.
+func printBlockedGoals() error {
+	blocked, err := goalRepo.FindByStatus(core.StatusBlocked)
+	if err != nil {
+		return fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	displayBlockedGoals(blocked)
+	return nil
+}
+
+func displayBlockedGoals(blocked []*core.Goal) {
+	if len(blocked) == 0 {
+		return
+	}
+
+	PrintWarning(fmt.Sprintf("%d blocked goal(s):", len(blocked)))
+	for _, goal := range blocked {
+		fmt.Printf("  %s  %s (%s priority)\n", goal.ID, goal.Title, goal.Priority)
+		for _, blocker := range goal.Blockers {
+			fmt.Printf("      - %s\n", blocker)
+		}
+	}
+	fmt.Println()
+}
@@ -2,29 +2,44 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
-	"github.com/illenko/growth.md/internal/aifactory"
+	"github.com/illenko/growth.md/internal/ai/gemini"
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/service"
 	"github.com/spf13/cobra"
 )
 
 var (
-	pathType       string
-	pathStatus     string
-	pathTags       string
-	pathTitle      string
-	pathFilterType string
+	pathType          string
+	pathStatus        string
+	pathAbandonReason string
+	pathTags          string
+	pathTitle         string
+	pathFilterType    string
+	pathHoursPerWeek  float64
+	pathExpand        bool
 
 	// Path generate flags
-	pathGenerateStyle      string
-	pathGenerateTime       string
-	pathGenerateBackground string
-	pathGenerateProvider   string
-	pathGenerateModel      string
+	pathGenerateStyle       string
+	pathGenerateTime        string
+	pathGenerateBackground  string
+	pathGenerateProvider    string
+	pathGenerateModel       string
+	pathGenerateStaged      bool
+	pathGeneratePrintPrompt bool
+
+	// Path import-response flags
+	pathImportGoal  string
+	pathImportTime  string
+	pathImportModel string
 )
 
 var pathCmd = &cobra.Command{
@@ -69,10 +84,15 @@ var pathViewCmd = &cobra.Command{
 	Short: "View path details",
 	Long: `View detailed information about a specific learning path.
 
+The phases of the path are listed in order with their progress. Use --expand
+to also list the milestones and resources of each phase.
+
 The output format can be controlled with the --format flag (table, json, yaml).
 
 Examples:
   growth path view path-001
+  growth path view path-001 --history
+  growth path view path-001 --expand
   growth path view path-042 --format json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runPathView,
@@ -88,7 +108,8 @@ You can update any field using flags.
 Examples:
   growth path edit path-001 --status completed
   growth path edit path-042 --title "New Title"
-  growth path edit path-001 --tags backend,devops`,
+  growth path edit path-001 --tags backend,devops
+  growth path edit path-001 --hours-per-week 8`,
 	Args: cobra.ExactArgs(1),
 	RunE: runPathEdit,
 }
@@ -117,15 +138,72 @@ var pathGenerateCmd = &cobra.Command{
 The AI will analyze your goal, current skills, and preferences to create
 a structured learning path with phases, milestones, and resource recommendations.
 
+The path is generated in a single request. With --staged, or ai.stagedGeneration
+in the config, one request plans the path and one more per phase picks its
+milestones and resources, so large paths are not cut off at the model's output
+limit at the cost of more requests.
+
 Examples:
   growth path generate goal-001
   growth path generate goal-001 --style top-down --time "10 hours/week"
   growth path generate goal-001 --background "I have 5 years Python experience"
-  growth path generate goal-001 --provider gemini --model gemini-3-flash-preview`,
+  growth path generate goal-001 --provider gemini --model gemini-3-flash-preview
+  growth path generate goal-001 --staged
+  growth path generate goal-001 --print-prompt > prompt.txt`,
 	Args: cobra.ExactArgs(1),
 	RunE: runPathGenerate,
 }
 
+var pathImportResponseCmd = &cobra.Command{
+	Use:   "import-response <json-file>",
+	Short: "Save a learning path from an AI response obtained elsewhere",
+	Long: `Save a learning path from a model response you got outside growth, for
+example by pasting the output of 'growth ai export-prompt --goal' into a
+web chat. The response goes through the same parsing and saving as
+'growth path generate'.
+
+The file may contain the bare JSON object or the whole reply, including
+a markdown code fence and any text around it.
+
+Examples:
+  growth ai export-prompt --goal goal-001 > prompt.txt
+  growth path import-response response.json --goal goal-001
+  growth path import-response response.md --goal goal-001 --time "10 hours/week" --model claude-web`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathImportResponse,
+}
+
+var pathAbandonCmd = &cobra.Command{
+	Use:   "abandon <id>",
+	Short: "Mark a learning path as abandoned",
+	Long: `Mark a learning path as abandoned, optionally recording why.
+
+Abandoned paths are kept for history but excluded from completion rates.
+Use 'growth path edit <id> --status active' to pick a path back up.
+
+Examples:
+  growth path abandon path-001
+  growth path abandon path-002 --reason "Switched focus to frontend"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathAbandon,
+}
+
+var pathSimulateCmd = &cobra.Command{
+	Use:   "simulate <id>",
+	Short: "Simulate a different weekly time commitment",
+	Long: `Recompute projected completion dates for a learning path using a
+different weekly time commitment, without changing anything.
+
+Each phase is shown with its current projected end date next to the
+simulated one, followed by the projected end date of the whole path.
+
+Examples:
+  growth path simulate path-001 --hours-per-week 8
+  growth path simulate path-002 --hours-per-week 15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathSimulate,
+}
+
 func init() {
 	rootCmd.AddCommand(pathCmd)
 	pathCmd.AddCommand(pathCreateCmd)
@@ -134,6 +212,9 @@ func init() {
 	pathCmd.AddCommand(pathEditCmd)
 	pathCmd.AddCommand(pathDeleteCmd)
 	pathCmd.AddCommand(pathGenerateCmd)
+	pathCmd.AddCommand(pathImportResponseCmd)
+	pathCmd.AddCommand(pathSimulateCmd)
+	pathCmd.AddCommand(pathAbandonCmd)
 
 	pathCreateCmd.Flags().StringVarP(&pathType, "type", "t", "", "path type (manual, ai-generated)")
 	pathCreateCmd.Flags().StringVar(&pathTags, "tags", "", "comma-separated tags")
@@ -144,12 +225,29 @@ func init() {
 	pathEditCmd.Flags().StringVar(&pathTitle, "title", "", "path title")
 	pathEditCmd.Flags().StringVarP(&pathStatus, "status", "s", "", "path status")
 	pathEditCmd.Flags().StringVar(&pathTags, "tags", "", "comma-separated tags")
+	pathEditCmd.Flags().Float64Var(&pathHoursPerWeek, "hours-per-week", 0, "weekly time commitment used for schedule projection")
+
+	pathSimulateCmd.Flags().Float64Var(&pathHoursPerWeek, "hours-per-week", 0, "weekly time commitment to simulate")
+	pathSimulateCmd.MarkFlagRequired("hours-per-week")
+
+	pathAbandonCmd.Flags().StringVar(&pathAbandonReason, "reason", "", "why the path was abandoned")
 
 	pathGenerateCmd.Flags().StringVar(&pathGenerateStyle, "style", "", "learning style (top-down, bottom-up, project-based) - defaults to config")
 	pathGenerateCmd.Flags().StringVar(&pathGenerateTime, "time", "5 hours/week", "time commitment (e.g., '10 hours/week')")
 	pathGenerateCmd.Flags().StringVar(&pathGenerateBackground, "background", "", "additional background context")
 	pathGenerateCmd.Flags().StringVar(&pathGenerateProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
 	pathGenerateCmd.Flags().StringVar(&pathGenerateModel, "model", "", "model override - defaults to config")
+	pathGenerateCmd.Flags().BoolVar(&pathGenerateStaged, "staged", false, "plan the path first, then detail each phase in its own request")
+	pathGenerateCmd.Flags().BoolVar(&pathGeneratePrintPrompt, "print-prompt", false, "print the prompts that would be sent and exit")
+
+	pathImportResponseCmd.Flags().StringVar(&pathImportGoal, "goal", "", "goal the path was generated for (required)")
+	pathImportResponseCmd.Flags().StringVar(&pathImportTime, "time", "5 hours/week", "time commitment the prompt was exported with")
+	pathImportResponseCmd.Flags().StringVar(&pathImportModel, "model", "", "model that wrote the response, recorded in generatedBy")
+	pathImportResponseCmd.MarkFlagRequired("goal")
+
+	pathViewCmd.Flags().BoolVar(&showHistory, "history", false, "show the history of status, level, and priority changes")
+	pathViewCmd.Flags().BoolVarP(&pathExpand, "expand", "e", false, "show the milestones and resources of each phase")
+	pathViewCmd.Flags().StringVar(&openAttachment, "open", "", "open the attachment with this name")
 }
 
 func runPathCreate(cmd *cobra.Command, args []string) error {
@@ -227,8 +325,8 @@ func runPathList(cmd *cobra.Command, args []string) error {
 		paths, err = pathRepo.FindByType(pType)
 	} else if pathStatus != "" {
 		status := core.Status(pathStatus)
-		if !status.IsValid() {
-			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived", pathStatus)
+		if !status.IsValidForPath() {
+			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived, abandoned", pathStatus)
 		}
 		paths, err = pathRepo.FindByStatus(status)
 	} else {
@@ -244,6 +342,26 @@ func runPathList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if config.Display.OutputFormat == "table" {
+		allPhases, err := phaseRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to retrieve phases: %w", err)
+		}
+		byPath := make(map[core.EntityID][]*core.Phase)
+		for _, phase := range allPhases {
+			byPath[phase.PathID] = append(byPath[phase.PathID], phase)
+		}
+
+		for _, path := range paths {
+			phases := "no phases"
+			if found := byPath[path.ID]; len(found) > 0 {
+				phases = fmt.Sprintf("%d/%d phases", core.CountFinished(found), len(found))
+			}
+			fmt.Printf("%s  %-9s  %-12s  %-11s  %s\n", path.ID, path.Status, path.Type, phases, truncate(path.Title, 50))
+		}
+		return nil
+	}
+
 	return PrintOutputWithConfig(paths)
 }
 
@@ -255,30 +373,69 @@ func runPathView(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
 	}
 
+	if openAttachment != "" {
+		return openEntityAttachment(path.Attachments, openAttachment)
+	}
+
+	phases, err := loadPhaseDetails(path.ID)
+	if err != nil {
+		return err
+	}
+
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", path.ID)
 		fmt.Printf("Title:    %s\n", path.Title)
 		fmt.Printf("Type:     %s\n", path.Type)
 		fmt.Printf("Status:   %s\n", path.Status)
+		if path.SkillID != "" {
+			fmt.Printf("Skill:    %s\n", path.SkillID)
+		}
+		if path.AbandonReason != "" {
+			fmt.Printf("Reason:   %s\n", path.AbandonReason)
+		}
 		if path.GeneratedBy != "" {
 			fmt.Printf("Generated By: %s\n", path.GeneratedBy)
 		}
+		if p := path.Provenance; p != nil {
+			fmt.Printf("Generated At: %s (temperature %.2g)\n", formatDateTime(p.RespondedAt), p.Temperature)
+			fmt.Printf("Prompt Hash:  %s\n", p.PromptHash)
+		}
 		if len(path.Tags) > 0 {
 			fmt.Printf("Tags:     %s\n", strings.Join(path.Tags, ", "))
 		}
-		if len(path.Phases) > 0 {
-			fmt.Printf("Phases:   %v\n", path.Phases)
+		if path.HoursPerWeek > 0 {
+			fmt.Printf("Commitment: %s hours/week\n", formatNumber(path.HoursPerWeek, 1))
+		}
+		if projection, err := projectPathSchedule(path); err == nil && len(projection.Phases) > 0 {
+			fmt.Printf("Projected End: %s (%s hours remaining at %s hours/week)\n",
+				formatDate(projection.End), formatNumber(projection.RemainingHours, 1), formatNumber(projection.HoursPerWeek, 1))
+			if bias := describeBias(projection.Bias); bias != "" {
+				fmt.Printf("  (%s)\n", bias)
+			}
 		}
-		fmt.Printf("Created:  %s\n", path.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", path.Updated.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Created:  %s\n", formatDateTime(path.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(path.Updated))
 
 		if path.Body != "" {
 			fmt.Printf("\nDescription:\n%s\n", path.Body)
 		}
 
+		if len(phases) > 0 {
+			fmt.Printf("\nPhases:\n%s", renderPhaseTree(phases, pathExpand))
+		}
+
+		printAttachments(path.Attachments)
+
+		if showHistory {
+			printHistory(path.History)
+		}
+
 		return nil
 	}
 
+	if pathExpand {
+		return PrintOutputWithConfig(expandedPath{LearningPath: *path, PhaseDetails: phases})
+	}
 	return PrintOutputWithConfig(path)
 }
 
@@ -299,8 +456,8 @@ func runPathEdit(cmd *cobra.Command, args []string) error {
 
 	if cmd.Flags().Changed("status") {
 		status := core.Status(pathStatus)
-		if !status.IsValid() {
-			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived", pathStatus)
+		if !status.IsValidForPath() {
+			return fmt.Errorf("invalid status '%s'. Valid options: active, completed, archived, abandoned", pathStatus)
 		}
 		if err := path.UpdateStatus(status); err != nil {
 			return fmt.Errorf("failed to update status: %w", err)
@@ -319,6 +476,13 @@ func runPathEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("hours-per-week") {
+		if err := path.SetHoursPerWeek(pathHoursPerWeek); err != nil {
+			return fmt.Errorf("failed to set hours per week: %w", err)
+		}
+		updated = true
+	}
+
 	if !updated {
 		PrintInfo("No changes specified. Use flags to update fields.")
 		return nil
@@ -329,6 +493,123 @@ func runPathEdit(cmd *cobra.Command, args []string) error {
 	}
 
 	PrintSuccess(fmt.Sprintf("Updated path %s: %s", path.ID, path.Title))
+	if cmd.Flags().Changed("status") && path.Status == core.StatusCompleted {
+		offerGoalCompletion(goalIDs(goalsForPath(path.ID))...)
+	}
+	return nil
+}
+
+func runPathSimulate(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	path, err := pathRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+	}
+
+	if pathHoursPerWeek <= 0 {
+		return fmt.Errorf("hours per week must be greater than 0")
+	}
+
+	current, err := projectPathSchedule(path)
+	if err != nil {
+		return fmt.Errorf("failed to project schedule: %w", err)
+	}
+
+	simulated, err := projectPathScheduleWithHours(path, pathHoursPerWeek)
+	if err != nil {
+		return fmt.Errorf("failed to project schedule: %w", err)
+	}
+
+	if len(simulated.Phases) == 0 {
+		PrintInfo(fmt.Sprintf("Path %s has no phases to schedule", path.ID))
+		return nil
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(simulated)
+	}
+
+	fmt.Printf("Path:       %s (%s)\n", path.Title, path.ID)
+	fmt.Printf("Commitment: %s %s %s hours/week\n", formatNumber(current.HoursPerWeek, 1), glyph.Arrow, formatNumber(simulated.HoursPerWeek, 1))
+	fmt.Printf("Remaining:  %s hours\n", formatNumber(simulated.RemainingHours, 1))
+	if bias := describeBias(simulated.Bias); bias != "" {
+		fmt.Printf("  (%s)\n", bias)
+	}
+	fmt.Println()
+
+	fmt.Printf("%-5s %-35s %-12s %-12s\n", "Order", "Phase", "Current", "Simulated")
+	fmt.Println(strings.Repeat("-", 67))
+	for i, phase := range simulated.Phases {
+		status := ""
+		if phase.Finished {
+			status = " " + glyph.Check.String()
+		}
+		fmt.Printf("%-5d %-35s %-12s %-12s%s\n",
+			phase.Order,
+			truncate(phase.Title, 35),
+			formatDate(current.Phases[i].End),
+			formatDate(phase.End),
+			status)
+	}
+	fmt.Println()
+
+	diff := simulated.End.Sub(current.End).Hours() / 24
+	fmt.Printf("Projected End: %s %s %s (%+.0f days)\n",
+		formatDate(current.End), glyph.Arrow, formatDate(simulated.End), diff)
+
+	for _, goal := range goalsForPath(path.ID) {
+		if simulated.ExceedsTarget(goal.TargetDate) {
+			PrintWarning(fmt.Sprintf("Goal %s target date %s would be missed", goal.ID, formatDate(*goal.TargetDate)))
+		}
+	}
+
+	return nil
+}
+
+// goalIDs returns the IDs of goals
+func goalIDs(goals []*core.Goal) []core.EntityID {
+	ids := make([]core.EntityID, len(goals))
+	for i, goal := range goals {
+		ids[i] = goal.ID
+	}
+	return ids
+}
+
+// goalsForPath returns goals that link the given learning path
+func goalsForPath(pathID core.EntityID) []*core.Goal {
+	goals, err := goalRepo.GetAll()
+	if err != nil {
+		return nil
+	}
+
+	var linked []*core.Goal
+	for _, goal := range goals {
+		for _, id := range goal.LearningPaths {
+			if id == pathID {
+				linked = append(linked, goal)
+				break
+			}
+		}
+	}
+	return linked
+}
+
+func runPathAbandon(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	path, err := pathRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+	}
+
+	path.Abandon(pathAbandonReason)
+
+	if err := pathRepo.Update(path); err != nil {
+		return fmt.Errorf("failed to update path: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Abandoned path %s: %s", path.ID, path.Title))
 	return nil
 }
 
@@ -362,81 +643,50 @@ func runPathDelete(cmd *cobra.Command, args []string) error {
 func runPathGenerate(cmd *cobra.Command, args []string) error {
 	goalID := core.EntityID(args[0])
 
-	// Load goal
-	goal, err := goalRepo.GetByIDWithBody(goalID)
-	if err != nil {
-		return fmt.Errorf("goal '%s' not found: %w", goalID, err)
-	}
-
-	// Load current skills
-	skills, err := skillRepo.GetAll()
+	req, err := aiService.BuildPathRequest(service.PathGenerationOptions{
+		GoalID:         goalID,
+		Style:          pathGenerateStyle,
+		TimeCommitment: pathGenerateTime,
+		Background:     pathGenerateBackground,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to load skills: %w", err)
-	}
-
-	// Initialize AI client - use config defaults, allow flags to override
-	provider := config.AI.Provider
-	if pathGenerateProvider != "" {
-		provider = pathGenerateProvider
-	}
-
-	model := config.AI.Model
-	if pathGenerateModel != "" {
-		model = pathGenerateModel
+		return err
 	}
 
-	style := config.AI.DefaultStyle
-	if pathGenerateStyle != "" {
-		style = pathGenerateStyle
+	if pathGenerateStaged {
+		config.AI.StagedGeneration = true
 	}
-
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: config.AI.Temperature,
-		MaxTokens:   config.AI.MaxTokens,
-	}
-
-	if err := aiConfig.Validate(); err != nil {
-		return fmt.Errorf("AI configuration error: %w", err)
+	if pathGeneratePrintPrompt {
+		return printPathPrompts(req)
 	}
 
-	client, err := aifactory.NewClient(aiConfig)
+	client, err := aiService.NewClient(pathGenerateProvider, pathGenerateModel)
 	if err != nil {
-		return fmt.Errorf("failed to initialize AI client: %w", err)
+		return err
 	}
 
 	// Show progress
-	fmt.Printf("🤖 Generating learning path for: %s\n", goal.Title)
+	fmt.Printf("%sGenerating learning path for: %s\n", glyph.Emoji("🤖"), req.Goal.Title)
 	fmt.Printf("   Provider: %s\n", client.Provider())
 	if pathGenerateModel != "" {
 		fmt.Printf("   Model: %s\n", pathGenerateModel)
 	}
-	fmt.Printf("   Style: %s\n", style)
-	fmt.Printf("   Time Commitment: %s\n", pathGenerateTime)
+	fmt.Printf("   Style: %s\n", req.LearningStyle)
+	fmt.Printf("   Time Commitment: %s\n", req.TimeCommitment)
 	fmt.Println()
-	fmt.Println("⏳ Analyzing your goal and skills...")
 
-	// Generate path
-	req := ai.PathGenerationRequest{
-		Goal:           goal,
-		CurrentSkills:  skills,
-		Background:     pathGenerateBackground,
-		LearningStyle:  style,
-		TimeCommitment: pathGenerateTime,
-		TargetDate:     goal.TargetDate,
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
 	defer cancel()
 
+	progress := startSpinner("Analyzing your goal and skills")
 	resp, err := client.GenerateLearningPath(ctx, req)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("failed to generate path: %w", err)
 	}
 
 	// Save path and related entities
-	if err := saveGeneratedPath(resp, goalID); err != nil {
+	if err := aiService.SaveGeneratedPath(cmd.Context(), resp, goalID, req.TimeCommitment); err != nil {
 		return fmt.Errorf("failed to save path: %w", err)
 	}
 
@@ -446,145 +696,54 @@ func runPathGenerate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func saveGeneratedPath(resp *ai.PathGenerationResponse, goalID core.EntityID) error {
-	// Generate proper sequential IDs to avoid conflicts
-	if err := reassignGeneratedIDs(resp); err != nil {
-		return fmt.Errorf("failed to assign IDs: %w", err)
-	}
-
-	// Save the main path
-	if err := pathRepo.Create(resp.Path); err != nil {
-		return fmt.Errorf("failed to save path: %w", err)
-	}
-
-	// Save phases
-	for _, phase := range resp.Phases {
-		if err := phaseRepo.Create(phase); err != nil {
-			return fmt.Errorf("failed to save phase %s: %w", phase.ID, err)
-		}
-	}
-
-	// Save resources
-	for _, resource := range resp.Resources {
-		if err := resourceRepo.Create(resource); err != nil {
-			return fmt.Errorf("failed to save resource %s: %w", resource.ID, err)
-		}
-	}
-
-	// Save milestones
-	for _, milestone := range resp.Milestones {
-		if err := milestoneRepo.Create(milestone); err != nil {
-			return fmt.Errorf("failed to save milestone %s: %w", milestone.ID, err)
-		}
-	}
+func runPathImportResponse(cmd *cobra.Command, args []string) error {
+	goalID := core.EntityID(pathImportGoal)
 
-	// Link path to goal
-	goal, err := goalRepo.GetByIDWithBody(goalID)
-	if err == nil {
-		goal.LearningPaths = append(goal.LearningPaths, resp.Path.ID)
-		if err := goalRepo.Update(goal); err != nil {
-			// Non-fatal: path is already created
-			PrintWarning(fmt.Sprintf("Failed to link path to goal: %v", err))
-		}
+	goal, err := goalRepo.GetByID(goalID)
+	if err != nil {
+		return fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", goalID)
 	}
 
-	return nil
-}
-
-func reassignGeneratedIDs(resp *ai.PathGenerationResponse) error {
-	// Map old IDs to new IDs for references
-	milestoneIDMap := make(map[core.EntityID]core.EntityID)
-	phaseIDMap := make(map[core.EntityID]core.EntityID)
-
-	// Generate new path ID
-	newPathID, err := GenerateNextID("path")
+	data, err := os.ReadFile(args[0])
 	if err != nil {
-		return fmt.Errorf("failed to generate path ID: %w", err)
+		return fmt.Errorf("failed to read response: %w", err)
 	}
-	resp.Path.ID = newPathID
 
-	if len(resp.Phases) > 0 {
-		startPhaseID, err := GenerateNextID("phase")
-		if err != nil {
-			return fmt.Errorf("failed to generate phase ID: %w", err)
-		}
-		phaseCounter := extractIDNumber(startPhaseID)
-
-		for _, phase := range resp.Phases {
-			oldPhaseID := phase.ID
-			newPhaseID := core.EntityID(fmt.Sprintf("phase-%03d", phaseCounter))
-			phaseIDMap[oldPhaseID] = newPhaseID
-			phase.ID = newPhaseID
-			phase.PathID = newPathID
-			phaseCounter++
+	resp, err := gemini.ParsePathGeneration(gemini.ExtractJSON(string(data)), "path-000", goalID)
+	if err != nil {
+		var parseErr *ai.ParseError
+		if errors.As(err, &parseErr) {
+			parseErr.Provider = "import"
 		}
+		return fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	if len(resp.Resources) > 0 {
-		startResourceID, err := GenerateNextID("resource")
-		if err != nil {
-			return fmt.Errorf("failed to generate resource ID: %w", err)
-		}
-		resourceCounter := extractIDNumber(startResourceID)
-
-		for _, resource := range resp.Resources {
-			newResourceID := core.EntityID(fmt.Sprintf("resource-%03d", resourceCounter))
-			resource.ID = newResourceID
-			resourceCounter++
-		}
+	resp.Path.GeneratedBy = "import"
+	if pathImportModel != "" {
+		resp.Path.GeneratedBy = "import/" + pathImportModel
 	}
+	resp.Path.GenerationContext = fmt.Sprintf("Goal: %s | Time: %s | Imported from %s",
+		goal.Title, pathImportTime, filepath.Base(args[0]))
 
-	if len(resp.Milestones) > 0 {
-		startMilestoneID, err := GenerateNextID("milestone")
-		if err != nil {
-			return fmt.Errorf("failed to generate milestone ID: %w", err)
-		}
-		milestoneCounter := extractIDNumber(startMilestoneID)
-
-		for _, milestone := range resp.Milestones {
-			oldMilestoneID := milestone.ID
-			newMilestoneID := core.EntityID(fmt.Sprintf("milestone-%03d", milestoneCounter))
-			milestoneIDMap[oldMilestoneID] = newMilestoneID
-			milestone.ID = newMilestoneID
-			milestoneCounter++
-		}
+	if err := aiService.SaveGeneratedPath(cmd.Context(), resp, goalID, pathImportTime); err != nil {
+		return fmt.Errorf("failed to save path: %w", err)
 	}
 
-	for _, phase := range resp.Phases {
-		var newMilestones []core.EntityID
-		for _, oldMilestoneID := range phase.Milestones {
-			if newMilestoneID, ok := milestoneIDMap[oldMilestoneID]; ok {
-				newMilestones = append(newMilestones, newMilestoneID)
-			}
-		}
-		phase.Milestones = newMilestones
-	}
+	displayPathSummary(resp)
 
 	return nil
 }
 
-func extractIDNumber(id core.EntityID) int {
-	// Extract number from ID like "phase-001" -> 1
-	parts := strings.Split(string(id), "-")
-	if len(parts) >= 2 {
-		var result int
-		if _, err := fmt.Sscanf(parts[len(parts)-1], "%d", &result); err == nil {
-			return result
-		}
-	}
-	return 1
-}
-
 func displayPathSummary(resp *ai.PathGenerationResponse) {
 	fmt.Println()
-	PrintSuccess("✨ Learning path generated successfully!")
+	PrintSuccess(glyph.Emoji("✨") + "Learning path generated successfully!")
 	fmt.Println()
 
-	fmt.Printf("📚 Path: %s (ID: %s)\n", resp.Path.Title, resp.Path.ID)
+	fmt.Printf("%sPath: %s (ID: %s)\n", glyph.Emoji("📚"), resp.Path.Title, resp.Path.ID)
 	fmt.Printf("   %s\n", resp.Path.Body)
 	fmt.Println()
 
-	fmt.Printf("📅 Phases: %d\n", len(resp.Phases))
+	fmt.Printf("%sPhases: %d\n", glyph.Emoji("📅"), len(resp.Phases))
 	for i, phase := range resp.Phases {
 		fmt.Printf("   %d. %s (%s)\n", i+1, phase.Title, phase.EstimatedDuration)
 		fmt.Printf("      %s\n", phase.Body)
@@ -597,10 +756,10 @@ func displayPathSummary(resp *ai.PathGenerationResponse) {
 	}
 	fmt.Println()
 
-	fmt.Printf("📖 Resources: %d\n", len(resp.Resources))
+	fmt.Printf("%sResources: %d\n", glyph.Emoji("📖"), len(resp.Resources))
 	for i, resource := range resp.Resources {
 		if i < 5 { // Show first 5
-			fmt.Printf("   • %s (%s) - %.1f hours\n", resource.Title, resource.Type, resource.EstimatedHours)
+			fmt.Printf("   %s %s (%s) - %s hours\n", glyph.Bullet, resource.Title, resource.Type, formatNumber(resource.EstimatedHours, 1))
 		}
 	}
 	if len(resp.Resources) > 5 {
@@ -608,10 +767,10 @@ func displayPathSummary(resp *ai.PathGenerationResponse) {
 	}
 	fmt.Println()
 
-	fmt.Printf("🎯 Milestones: %d\n", len(resp.Milestones))
+	fmt.Printf("%sMilestones: %d\n", glyph.Emoji("🎯"), len(resp.Milestones))
 	for i, milestone := range resp.Milestones {
 		if i < 3 { // Show first 3
-			fmt.Printf("   • %s (%s)\n", milestone.Title, milestone.Type)
+			fmt.Printf("   %s %s (%s)\n", glyph.Bullet, milestone.Title, milestone.Type)
 		}
 	}
 	if len(resp.Milestones) > 3 {
@@ -620,7 +779,7 @@ func displayPathSummary(resp *ai.PathGenerationResponse) {
 	fmt.Println()
 
 	if resp.Reasoning != "" {
-		fmt.Println("💡 AI Reasoning:")
+		fmt.Println(glyph.Emoji("💡") + "AI Reasoning:")
 		fmt.Printf("   %s\n", resp.Reasoning)
 		fmt.Println()
 	}
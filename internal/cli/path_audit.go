@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/ai/gemini"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pathAuditProvider    string
+	pathAuditModel       string
+	pathAuditPrintPrompt bool
+)
+
+var pathAuditCmd = &cobra.Command{
+	Use:   "audit <path-id>",
+	Short: "Check a learning path for gaps using AI",
+	Long: `Review an existing learning path, generated or built by hand, against the
+goal it belongs to or the skill it was planned for.
+
+The audit reports topics the path is missing, resources that are redundant or
+out of place, and phases or resources in the wrong order, each with a
+suggestion of what to change. Nothing is changed by the audit itself.
+
+Examples:
+  growth path audit path-001
+  growth path audit path-001 --provider openai
+  growth path audit path-001 --print-prompt`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathAudit,
+}
+
+func init() {
+	pathCmd.AddCommand(pathAuditCmd)
+
+	pathAuditCmd.Flags().StringVar(&pathAuditProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	pathAuditCmd.Flags().StringVar(&pathAuditModel, "model", "", "model override - defaults to config")
+	pathAuditCmd.Flags().BoolVar(&pathAuditPrintPrompt, "print-prompt", false, "print the prompt that would be sent and exit")
+}
+
+func runPathAudit(cmd *cobra.Command, args []string) error {
+	req, err := aiService.BuildPathAuditRequest(core.EntityID(args[0]))
+	if err != nil {
+		return err
+	}
+	if len(req.Phases) == 0 {
+		return fmt.Errorf("path '%s' has no phases to audit. Add one with 'growth phase create'", req.Path.ID)
+	}
+
+	if pathAuditPrintPrompt {
+		return printPrompt(gemini.PathAuditPrompt, req)
+	}
+
+	client, err := aiService.NewClient(pathAuditProvider, pathAuditModel)
+	if err != nil {
+		return err
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("%sAuditing learning path: %s\n", glyph.Emoji("🔍"), req.Path.Title)
+		fmt.Printf("   Provider: %s\n", client.Provider())
+		if pathAuditModel != "" {
+			fmt.Printf("   Model: %s\n", pathAuditModel)
+		}
+		fmt.Println()
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
+	defer cancel()
+
+	progress := startSpinner("Reviewing phases and resources")
+	resp, err := client.AuditPath(ctx, req)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to audit path: %w", err)
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(newPathAuditReport(req.Path.ID, resp))
+	}
+
+	displayPathAudit(resp)
+	return nil
+}
+
+// pathAuditReport is the audit of a path as printed in the json and yaml
+// formats
+type pathAuditReport struct {
+	Path     core.EntityID         `json:"path" yaml:"path"`
+	Summary  string                `json:"summary" yaml:"summary"`
+	Findings []pathAuditFindingRow `json:"findings" yaml:"findings"`
+}
+
+type pathAuditFindingRow struct {
+	Kind       string        `json:"kind" yaml:"kind"`
+	Phase      int           `json:"phase,omitempty" yaml:"phase,omitempty"`
+	Resource   core.EntityID `json:"resource,omitempty" yaml:"resource,omitempty"`
+	Problem    string        `json:"problem" yaml:"problem"`
+	Suggestion string        `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
+}
+
+func newPathAuditReport(pathID core.EntityID, resp *ai.PathAuditResponse) pathAuditReport {
+	report := pathAuditReport{Path: pathID, Summary: resp.Summary, Findings: []pathAuditFindingRow{}}
+	for _, finding := range resp.Findings {
+		report.Findings = append(report.Findings, pathAuditFindingRow{
+			Kind:       finding.Kind,
+			Phase:      finding.Phase,
+			Resource:   finding.ResourceID,
+			Problem:    finding.Problem,
+			Suggestion: finding.Suggestion,
+		})
+	}
+	return report
+}
+
+// auditSections are the finding kinds in the order they are shown
+var auditSections = []struct {
+	kind  string
+	title string
+}{
+	{ai.AuditMissingTopic, "Missing topics"},
+	{ai.AuditRedundantResource, "Redundant resources"},
+	{ai.AuditOrdering, "Ordering problems"},
+}
+
+func displayPathAudit(resp *ai.PathAuditResponse) {
+	if resp.Summary != "" {
+		fmt.Println(resp.Summary)
+		fmt.Println()
+	}
+
+	if len(resp.Findings) == 0 {
+		PrintSuccess("No gaps found: the path covers what it needs to, in order")
+		return
+	}
+
+	for _, section := range auditSections {
+		var findings []ai.PathAuditFinding
+		for _, finding := range resp.Findings {
+			if finding.Kind == section.kind {
+				findings = append(findings, finding)
+			}
+		}
+		if len(findings) == 0 {
+			continue
+		}
+
+		fmt.Printf("%s (%d):\n", section.title, len(findings))
+		for _, finding := range findings {
+			if where := auditLocation(finding); where != "" {
+				fmt.Printf("   %s [%s] %s\n", glyph.Bullet, where, finding.Problem)
+			} else {
+				fmt.Printf("   %s %s\n", glyph.Bullet, finding.Problem)
+			}
+			if finding.Suggestion != "" {
+				fmt.Printf("     %s %s\n", glyph.Arrow, finding.Suggestion)
+			}
+		}
+		fmt.Println()
+	}
+}
+
+// auditLocation describes where in the path a finding is, e.g. "Phase 2,
+// resource-004", or "" for the path as a whole
+func auditLocation(finding ai.PathAuditFinding) string {
+	var parts []string
+	if finding.Phase > 0 {
+		parts = append(parts, fmt.Sprintf("Phase %d", finding.Phase))
+	}
+	if finding.ResourceID != "" {
+		parts = append(parts, string(finding.ResourceID))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,15 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLocation(t *testing.T) {
+	assert.Equal(t, "Phase 2, resource-004", auditLocation(ai.PathAuditFinding{Phase: 2, ResourceID: "resource-004"}))
+	assert.Equal(t, "Phase 1", auditLocation(ai.PathAuditFinding{Phase: 1}))
+	assert.Equal(t, "resource-004", auditLocation(ai.PathAuditFinding{ResourceID: "resource-004"}))
+	assert.Equal(t, "", auditLocation(ai.PathAuditFinding{}))
+}
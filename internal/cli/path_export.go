@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pathTemplateOutput string
+	pathTemplateAuthor string
+)
+
+var pathExportTemplateCmd = &cobra.Command{
+	Use:   "export-template <id>",
+	Short: "Export a path as a shareable template",
+	Long: `Export a learning path as a YAML template that others can load with
+'growth path import'.
+
+IDs, dates, statuses, hours spent, proofs and the notes, logs and links
+sections of your entities are left out. Skills are described by title and
+category so whoever imports the path can map them to their own skills.
+Abandoned resources are not included.
+
+Examples:
+  growth path export-template path-001
+  growth path export-template path-001 --output backend-go.yaml --author "Ana"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathExportTemplate,
+}
+
+func init() {
+	pathCmd.AddCommand(pathExportTemplateCmd)
+
+	pathExportTemplateCmd.Flags().StringVarP(&pathTemplateOutput, "output", "o", "", "write the template to a file instead of stdout")
+	pathExportTemplateCmd.Flags().StringVar(&pathTemplateAuthor, "author", "", "name to credit in the template")
+}
+
+func runPathExportTemplate(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	path, err := pathRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+	}
+
+	phases, err := phaseRepo.FindByPathID(id)
+	if err != nil {
+		return fmt.Errorf("failed to load phases: %w", err)
+	}
+
+	resources := make(map[core.EntityID]*core.Resource)
+	skills := make(map[core.EntityID]*core.Skill)
+	addSkill := func(skillID core.EntityID) {
+		if _, ok := skills[skillID]; ok {
+			return
+		}
+		if skill, err := skillRepo.GetByID(skillID); err == nil {
+			skills[skillID] = skill
+		}
+	}
+
+	for i, phase := range phases {
+		if full, err := phaseRepo.GetByIDWithBody(phase.ID); err == nil {
+			phases[i] = full
+		}
+		for _, req := range phase.RequiredSkills {
+			addSkill(req.SkillID)
+		}
+		for _, resourceID := range phase.Resources {
+			if resource, err := resourceRepo.GetByID(resourceID); err == nil {
+				resources[resourceID] = resource
+				addSkill(resource.SkillID)
+			}
+		}
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	template := importer.BuildPathTemplate(path, phases, resources, milestones, skills)
+	template.Author = pathTemplateAuthor
+
+	if pathTemplateOutput == "" {
+		return template.Encode(os.Stdout)
+	}
+
+	file, err := os.Create(pathTemplateOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create template file: %w", err)
+	}
+	defer file.Close()
+
+	if err := template.Encode(file); err != nil {
+		return err
+	}
+
+	PrintSuccess(fmt.Sprintf("Exported %s as a template to %s", path.ID, pathTemplateOutput))
+	fmt.Printf("Others can import it with: growth path import %s\n", pathTemplateOutput)
+	return nil
+}
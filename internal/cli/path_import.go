@@ -0,0 +1,326 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/illenko/growth.md/internal/service"
+	"github.com/spf13/cobra"
+)
+
+// newSkillChoice is the answer that maps a template skill to a new skill
+const newSkillChoice = "new"
+
+var pathImportYes bool
+
+var pathImportCmd = &cobra.Command{
+	Use:   "import <url|file>",
+	Short: "Import a learning path shared by someone else",
+	Long: `Import a path template: a YAML file with phases, milestones and resource
+placeholders that someone shared from their own growth.md.
+
+Each skill in the template is mapped to one of your skills, or created as a new
+one. Skills with the same title are suggested automatically; use --yes to
+accept the suggestions without prompting. Imported resources start as
+not-started and every entity gets a new ID.
+
+Examples:
+  growth path import backend-go.yaml
+  growth path import https://example.com/paths/backend-go.yaml
+  growth path import backend-go.yaml --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathImport,
+}
+
+func init() {
+	pathCmd.AddCommand(pathImportCmd)
+
+	pathImportCmd.Flags().BoolVarP(&pathImportYes, "yes", "y", false, "accept suggested skill mappings without prompting")
+}
+
+func runPathImport(cmd *cobra.Command, args []string) (err error) {
+	ctx := cmd.Context()
+	template, err := loadPathTemplate(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	// Remove what was already imported when a later step fails or the
+	// import is interrupted
+	var rollback service.Rollback
+	defer func() {
+		if err == nil {
+			return
+		}
+		if undoErr := rollback.Undo(); undoErr != nil {
+			PrintWarning(fmt.Sprintf("Failed to remove the partly imported path: %v", undoErr))
+		}
+	}()
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	PrintInfo(fmt.Sprintf("Importing '%s': %s", template.Title, countOf(len(template.Phases), "phase")))
+
+	skillIDs, err := mapTemplateSkills(template.Skills, skills, &rollback)
+	if err != nil {
+		return err
+	}
+
+	pathID, err := GenerateNextID("path")
+	if err != nil {
+		return fmt.Errorf("failed to generate path ID: %w", err)
+	}
+
+	path, err := core.NewLearningPath(pathID, template.Title, core.PathTypeManual)
+	if err != nil {
+		return fmt.Errorf("failed to create path: %w", err)
+	}
+	for _, tag := range template.Tags {
+		path.AddTag(tag)
+	}
+	path.HoursPerWeek = template.HoursPerWeek
+	path.Body = pathTemplateBody(template, args[0])
+
+	var resourceCount, milestoneCount int
+	for i, tp := range template.Phases {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		phaseID, err := GenerateNextID("phase")
+		if err != nil {
+			return fmt.Errorf("failed to generate phase ID: %w", err)
+		}
+
+		phase, err := core.NewPhase(phaseID, pathID, tp.Title, i+1)
+		if err != nil {
+			return fmt.Errorf("failed to create phase '%s': %w", tp.Title, err)
+		}
+		phase.EstimatedDuration = tp.EstimatedDuration
+		phase.Body = tp.Description
+		for _, req := range tp.RequiredSkills {
+			phase.RequiredSkills = append(phase.RequiredSkills, core.SkillRequirement{
+				SkillID:     skillIDs[req.Skill],
+				TargetLevel: req.TargetLevel,
+			})
+		}
+
+		for _, tr := range tp.Resources {
+			resource, err := createTemplateResource(tr, skillIDs[tr.Skill], &rollback)
+			if err != nil {
+				return err
+			}
+			phase.Resources = append(phase.Resources, resource.ID)
+			resourceCount++
+		}
+
+		for _, tm := range tp.Milestones {
+			milestone, err := createTemplateMilestone(tm, pathID, &rollback)
+			if err != nil {
+				return err
+			}
+			phase.Milestones = append(phase.Milestones, milestone.ID)
+			milestoneCount++
+		}
+
+		if err := phaseRepo.Create(phase); err != nil {
+			return fmt.Errorf("failed to save phase %s: %w", phase.ID, err)
+		}
+		rollback.Add(func() error { return phaseRepo.Delete(phase.ID) })
+		path.AddPhase(phase.ID)
+	}
+
+	for _, tm := range template.Milestones {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := createTemplateMilestone(tm, pathID, &rollback); err != nil {
+			return err
+		}
+		milestoneCount++
+	}
+
+	if err := pathRepo.Create(path); err != nil {
+		return fmt.Errorf("failed to save path: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Imported path %s: %s", path.ID, path.Title))
+	fmt.Printf("  %s, %s, %s\n",
+		countOf(len(path.Phases), "phase"),
+		countOf(resourceCount, "resource"),
+		countOf(milestoneCount, "milestone"))
+	fmt.Printf("\nView it with: growth path view %s\n", path.ID)
+	return nil
+}
+
+// loadPathTemplate reads a path template from a URL or a local file
+func loadPathTemplate(ctx context.Context, source string) (*importer.PathTemplate, error) {
+	if isTemplateURL(source) {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		progress := startSpinner("Downloading " + source)
+		defer progress.Stop()
+		return importer.FetchPathTemplate(ctx, http.DefaultClient, source)
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open path template: %w", err)
+	}
+	defer file.Close()
+
+	return importer.ParsePathTemplate(file)
+}
+
+func isTemplateURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// mapTemplateSkills resolves every template skill to one of the user's
+// skills, creating new ones where asked. It returns skill IDs by template key.
+func mapTemplateSkills(templateSkills []importer.TemplateSkill, skills []*core.Skill, rollback *service.Rollback) (map[string]core.EntityID, error) {
+	byID := make(map[core.EntityID]*core.Skill, len(skills))
+	for _, skill := range skills {
+		byID[skill.ID] = skill
+	}
+
+	mapping := make(map[string]core.EntityID, len(templateSkills))
+	for _, ts := range templateSkills {
+		suggestion := newSkillChoice
+		if match := matchTemplateSkill(ts, skills); match != nil {
+			suggestion = string(match.ID)
+		}
+
+		choice := suggestion
+		for !pathImportYes {
+			choice = PromptString(fmt.Sprintf("Map skill '%s' to (skill ID or '%s')", ts.Title, newSkillChoice), suggestion)
+			if choice == newSkillChoice || byID[core.EntityID(choice)] != nil {
+				break
+			}
+			PrintWarning(fmt.Sprintf("Skill '%s' not found. Use 'growth skill list' to see available skills", choice))
+		}
+
+		if choice != newSkillChoice {
+			mapping[ts.Key] = core.EntityID(choice)
+			continue
+		}
+
+		skill, err := createTemplateSkill(ts, rollback)
+		if err != nil {
+			return nil, err
+		}
+		mapping[ts.Key] = skill.ID
+	}
+
+	return mapping, nil
+}
+
+// matchTemplateSkill finds the user's skill with the same title or key
+func matchTemplateSkill(ts importer.TemplateSkill, skills []*core.Skill) *core.Skill {
+	for _, skill := range skills {
+		if strings.EqualFold(skill.Title, ts.Title) || strings.EqualFold(skill.Title, ts.Key) {
+			return skill
+		}
+	}
+	return nil
+}
+
+func createTemplateSkill(ts importer.TemplateSkill, rollback *service.Rollback) (*core.Skill, error) {
+	category := ts.Category
+	if category == "" {
+		if pathImportYes {
+			category = "general"
+		} else {
+			category = PromptStringRequired(fmt.Sprintf("Category for new skill '%s'", ts.Title))
+		}
+	}
+
+	category, err := checkSkillCategory(category)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := GenerateNextID("skill")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate skill ID: %w", err)
+	}
+
+	skill, err := core.NewSkill(id, ts.Title, category, core.LevelBeginner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create skill '%s': %w", ts.Title, err)
+	}
+
+	if err := skillRepo.Create(skill); err != nil {
+		return nil, fmt.Errorf("failed to save skill: %w", err)
+	}
+	rollback.Add(func() error { return skillRepo.Delete(skill.ID) })
+
+	PrintSuccess(fmt.Sprintf("Created skill %s: %s", skill.ID, skill.Title))
+	return skill, nil
+}
+
+func createTemplateResource(tr importer.TemplateResource, skillID core.EntityID, rollback *service.Rollback) (*core.Resource, error) {
+	id, err := GenerateNextID("resource")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate resource ID: %w", err)
+	}
+
+	resource, err := core.NewResource(id, tr.Title, tr.Type, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource '%s': %w", tr.Title, err)
+	}
+	resource.URL = tr.URL
+	resource.Author = tr.Author
+	resource.EstimatedHours = tr.EstimatedHours
+	resource.Body = tr.Description
+
+	if err := links.CreateResource(resource); err != nil {
+		return nil, fmt.Errorf("failed to save resource %s: %w", resource.ID, err)
+	}
+	rollback.Add(func() error { return links.DeleteResource(resource.ID) })
+
+	return resource, nil
+}
+
+func createTemplateMilestone(tm importer.TemplateMilestone, pathID core.EntityID, rollback *service.Rollback) (*core.Milestone, error) {
+	id, err := GenerateNextID("milestone")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate milestone ID: %w", err)
+	}
+
+	milestone, err := core.NewMilestone(id, tm.Title, core.MilestonePathLevel, core.ReferencePath, pathID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create milestone '%s': %w", tm.Title, err)
+	}
+	milestone.Body = tm.Description
+
+	if err := milestoneRepo.Create(milestone); err != nil {
+		return nil, fmt.Errorf("failed to save milestone %s: %w", milestone.ID, err)
+	}
+	rollback.Add(func() error { return milestoneRepo.Delete(milestone.ID) })
+
+	return milestone, nil
+}
+
+// pathTemplateBody describes the path and records where it was imported from
+func pathTemplateBody(template *importer.PathTemplate, source string) string {
+	origin := importer.ImportedFromPrefix + source
+	if template.Author != "" {
+		origin += " (shared by " + template.Author + ")"
+	}
+
+	if description := strings.TrimSpace(template.Description); description != "" {
+		return description + "\n\n" + origin + "\n"
+	}
+	return origin + "\n"
+}
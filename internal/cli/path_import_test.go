@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchTemplateSkill(t *testing.T) {
+	golang, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelBeginner)
+	k8s, _ := core.NewSkill("skill-002", "Kubernetes", "devops", core.LevelBeginner)
+	skills := []*core.Skill{golang, k8s}
+
+	t.Run("matches titles ignoring case", func(t *testing.T) {
+		match := matchTemplateSkill(importer.TemplateSkill{Key: "k", Title: "kubernetes"}, skills)
+
+		require.NotNil(t, match)
+		assert.Equal(t, core.EntityID("skill-002"), match.ID)
+	})
+
+	t.Run("matches keys", func(t *testing.T) {
+		match := matchTemplateSkill(importer.TemplateSkill{Key: "go", Title: "Golang"}, skills)
+
+		require.NotNil(t, match)
+		assert.Equal(t, core.EntityID("skill-001"), match.ID)
+	})
+
+	t.Run("returns nil without a match", func(t *testing.T) {
+		assert.Nil(t, matchTemplateSkill(importer.TemplateSkill{Key: "rust", Title: "Rust"}, skills))
+	})
+}
+
+func TestPathTemplateBody(t *testing.T) {
+	t.Run("keeps the description and source", func(t *testing.T) {
+		template := &importer.PathTemplate{Description: "Learn Go.", Author: "Ana"}
+
+		assert.Equal(t, "Learn Go.\n\nImported from go.yaml (shared by Ana)\n", pathTemplateBody(template, "go.yaml"))
+	})
+
+	t.Run("records the source alone", func(t *testing.T) {
+		assert.Equal(t, "Imported from https://example.com/go.yaml\n", pathTemplateBody(&importer.PathTemplate{}, "https://example.com/go.yaml"))
+	})
+}
+
+func TestIsTemplateURL(t *testing.T) {
+	assert.True(t, isTemplateURL("https://example.com/path.yaml"))
+	assert.True(t, isTemplateURL("http://example.com/path.yaml"))
+	assert.False(t, isTemplateURL("paths/backend.yaml"))
+}
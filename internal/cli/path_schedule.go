@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pathScheduleStart  string
+	pathScheduleOutput string
+	pathScheduleDryRun bool
+)
+
+var pathScheduleCmd = &cobra.Command{
+	Use:   "schedule <id>",
+	Short: "Plan a path week by week for a study group",
+	Long: `Generate a dated, week-by-week schedule for a learning path, as a study group
+following the same path from the same start date would use it.
+
+Resources are planned at their full estimate, one after another, at the
+path's hours per week; each phase starts on a new week. Estimates are scaled
+by your estimate bias from 'growth stats calibration'. The planned weeks are
+stored on the phases and resources, phase milestones get the phase's last day
+as their target date, and a printable markdown syllabus is written.
+
+Examples:
+  growth path schedule path-001 --start 2025-07-01
+  growth path schedule path-001 --start 2025-07-01 --hours-per-week 6 --output syllabus.md
+  growth path schedule path-001 --start 2025-07-01 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPathSchedule,
+}
+
+func init() {
+	pathCmd.AddCommand(pathScheduleCmd)
+
+	pathScheduleCmd.Flags().StringVar(&pathScheduleStart, "start", "", "first day of the schedule (YYYY-MM-DD)")
+	pathScheduleCmd.Flags().Float64Var(&pathHoursPerWeek, "hours-per-week", 0, "weekly study time - defaults to the path's commitment")
+	pathScheduleCmd.Flags().StringVarP(&pathScheduleOutput, "output", "o", "", "write the syllabus to a file instead of stdout")
+	pathScheduleCmd.Flags().BoolVar(&pathScheduleDryRun, "dry-run", false, "print the syllabus without storing the schedule")
+	pathScheduleCmd.MarkFlagRequired("start")
+}
+
+func runPathSchedule(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	path, err := pathRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("path '%s' not found. Use 'growth path list' to see available paths", id)
+	}
+
+	start, err := time.ParseInLocation("2006-01-02", pathScheduleStart, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid start date format (use YYYY-MM-DD): %w", err)
+	}
+
+	hoursPerWeek := path.HoursPerWeek
+	if cmd.Flags().Changed("hours-per-week") {
+		if pathHoursPerWeek <= 0 {
+			return fmt.Errorf("hours per week must be greater than 0")
+		}
+		hoursPerWeek = pathHoursPerWeek
+	}
+
+	phases, err := phaseRepo.FindByPathID(id)
+	if err != nil {
+		return fmt.Errorf("failed to load phases: %w", err)
+	}
+	if len(phases) == 0 {
+		return fmt.Errorf("path '%s' has no phases to schedule", id)
+	}
+
+	resources := make(map[core.EntityID]*core.Resource)
+	milestones := make(map[core.EntityID]*core.Milestone)
+	for i, phase := range phases {
+		full, err := phaseRepo.GetByIDWithBody(phase.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load phase %s: %w", phase.ID, err)
+		}
+		phases[i] = full
+
+		for _, resourceID := range phase.Resources {
+			if resource, err := resourceRepo.GetByIDWithBody(resourceID); err == nil {
+				resources[resourceID] = resource
+			}
+		}
+		for _, milestoneID := range phase.Milestones {
+			if milestone, err := milestoneRepo.GetByIDWithBody(milestoneID); err == nil {
+				milestones[milestoneID] = milestone
+			}
+		}
+	}
+
+	schedule := core.BuildCohortScheduleWithBias(phases, resources, hoursPerWeek, estimateBias(), start)
+	syllabus := renderSyllabus(path, schedule, milestones)
+
+	if !pathScheduleDryRun {
+		if err := saveCohortSchedule(schedule, milestones); err != nil {
+			return err
+		}
+	}
+
+	if pathScheduleOutput != "" {
+		if err := os.WriteFile(pathScheduleOutput, []byte(syllabus), 0644); err != nil {
+			return fmt.Errorf("failed to write syllabus: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote syllabus to %s", pathScheduleOutput))
+	} else {
+		fmt.Print(syllabus)
+		fmt.Println()
+	}
+
+	if !pathScheduleDryRun {
+		PrintSuccess(fmt.Sprintf("Scheduled %s over %s, ending %s",
+			path.ID, countOf(schedule.Weeks, "week"), formatDate(schedule.End())))
+	}
+	if bias := describeBias(schedule.Bias); bias != "" {
+		PrintInfo("Planned with " + bias)
+	}
+	return nil
+}
+
+// saveCohortSchedule stores the planned weeks on phases and resources, and
+// targets phase milestones at the end of their phase
+func saveCohortSchedule(schedule *core.CohortSchedule, milestones map[core.EntityID]*core.Milestone) error {
+	for _, cp := range schedule.Phases {
+		cp.Phase.AssignSchedule(cp.Assignment)
+		if err := phaseRepo.Update(cp.Phase); err != nil {
+			return fmt.Errorf("failed to update phase %s: %w", cp.Phase.ID, err)
+		}
+
+		for _, cr := range cp.Resources {
+			cr.Resource.AssignSchedule(cr.Assignment)
+			if err := resourceRepo.Update(cr.Resource); err != nil {
+				return fmt.Errorf("failed to update resource %s: %w", cr.Resource.ID, err)
+			}
+		}
+
+		for _, milestoneID := range cp.Phase.Milestones {
+			milestone, ok := milestones[milestoneID]
+			if !ok || milestone.IsAchieved() {
+				continue
+			}
+			milestone.SetTargetDate(cp.Assignment.Due)
+			if err := milestoneRepo.Update(milestone); err != nil {
+				return fmt.Errorf("failed to update milestone %s: %w", milestone.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// renderSyllabus prints a cohort schedule as a markdown syllabus with a
+// checklist for every week
+func renderSyllabus(path *core.LearningPath, schedule *core.CohortSchedule, milestones map[core.EntityID]*core.Milestone) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s: syllabus\n\n", path.Title)
+	fmt.Fprintf(&b, "*%s to %s · %s · %.1f hours/week*\n",
+		schedule.Start.Format("Mon, Jan 2, 2006"),
+		schedule.End().Format("Mon, Jan 2, 2006"),
+		countOf(schedule.Weeks, "week"),
+		schedule.HoursPerWeek)
+
+	phases := make(map[int]core.CohortPhase)
+	for _, cp := range schedule.Phases {
+		phases[cp.Assignment.StartWeek] = cp
+	}
+
+	for _, week := range schedule.WeekByWeek() {
+		if cp, ok := phases[week.Number]; ok {
+			fmt.Fprintf(&b, "\n## Phase %d: %s\n\n", cp.Phase.Order, cp.Phase.Title)
+			fmt.Fprintf(&b, "%s, %s to %s\n", weekRange(cp.Assignment), syllabusDate(cp.Assignment.Start), syllabusDate(cp.Assignment.Due))
+			if description := strings.TrimSpace(cp.Phase.Body); description != "" {
+				b.WriteString("\n" + description + "\n")
+			}
+
+			var due []string
+			for _, milestoneID := range cp.Phase.Milestones {
+				if milestone, ok := milestones[milestoneID]; ok {
+					due = append(due, fmt.Sprintf("- %s (by %s)", milestone.Title, syllabusDate(cp.Assignment.Due)))
+				}
+			}
+			if len(due) > 0 {
+				b.WriteString("\nMilestones:\n\n" + strings.Join(due, "\n") + "\n")
+			}
+		}
+
+		fmt.Fprintf(&b, "\n### Week %d: %s to %s\n\n", week.Number, syllabusDate(week.Start), syllabusDate(week.End))
+		if len(week.Resources) == 0 {
+			b.WriteString("- [ ] Catch up and review\n")
+			continue
+		}
+		for _, cr := range week.Resources {
+			fmt.Fprintf(&b, "- [ ] %s\n", syllabusResourceLine(cr, week.Number))
+		}
+	}
+
+	return b.String()
+}
+
+func syllabusResourceLine(cr core.CohortResource, week int) string {
+	line := blogResourceTitle(cr.Resource) + fmt.Sprintf(" (%s, %.1fh)", cr.Resource.Type, cr.Hours)
+	if cr.Assignment.EndWeek == week {
+		return line + ", due " + syllabusDate(cr.Assignment.Due)
+	}
+	return line + fmt.Sprintf(", continues to week %d", cr.Assignment.EndWeek)
+}
+
+func weekRange(a core.Assignment) string {
+	if a.StartWeek == a.EndWeek {
+		return fmt.Sprintf("Week %d", a.StartWeek)
+	}
+	return fmt.Sprintf("Weeks %d-%d", a.StartWeek, a.EndWeek)
+}
+
+// formatAssignment describes the planned weeks of a phase or resource
+func formatAssignment(a core.Assignment) string {
+	return fmt.Sprintf("%s (%s to %s)", strings.ToLower(weekRange(a)), formatDate(a.Start), formatDate(a.Due))
+}
+
+func syllabusDate(t time.Time) string {
+	return t.Format("Jan 2")
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderSyllabus(t *testing.T) {
+	path, _ := core.NewLearningPath("path-001", "Backend in Go", core.PathTypeManual)
+
+	basics, _ := core.NewPhase("phase-001", "path-001", "Basics", 1)
+	basics.Body = "Learn the language."
+	basics.Resources = []core.EntityID{"resource-001", "resource-002"}
+	basics.Milestones = []core.EntityID{"milestone-001"}
+	reading, _ := core.NewPhase("phase-002", "path-001", "Reading", 2)
+
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	tour.URL = "https://go.dev/tour"
+	tour.EstimatedHours = 7
+	book, _ := core.NewResource("resource-002", "Go book", core.ResourceBook, "skill-001")
+	book.EstimatedHours = 3
+	resources := map[core.EntityID]*core.Resource{tour.ID: tour, book.ID: book}
+
+	finish, _ := core.NewMilestone("milestone-001", "Finish the tour", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	milestones := map[core.EntityID]*core.Milestone{finish.ID: finish}
+
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	schedule := core.BuildCohortSchedule([]*core.Phase{basics, reading}, resources, 5, start)
+
+	expected := `# Backend in Go: syllabus
+
+*Tue, Jul 1, 2025 to Mon, Jul 21, 2025 · 3 weeks · 5.0 hours/week*
+
+## Phase 1: Basics
+
+Weeks 1-2, Jul 1 to Jul 14
+
+Learn the language.
+
+Milestones:
+
+- Finish the tour (by Jul 14)
+
+### Week 1: Jul 1 to Jul 7
+
+- [ ] [A Tour of Go](https://go.dev/tour) (course, 7.0h), continues to week 2
+
+### Week 2: Jul 8 to Jul 14
+
+- [ ] [A Tour of Go](https://go.dev/tour) (course, 7.0h), due Jul 14
+- [ ] Go book (book, 3.0h), due Jul 14
+
+## Phase 2: Reading
+
+Week 3, Jul 15 to Jul 21
+
+### Week 3: Jul 15 to Jul 21
+
+- [ ] Catch up and review
+`
+
+	assert.Equal(t, expected, renderSyllabus(path, schedule, milestones))
+}
+
+func TestFormatAssignment(t *testing.T) {
+	a := core.Assignment{
+		StartWeek: 2,
+		EndWeek:   2,
+		Start:     time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC),
+		Due:       time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, "week 2 (2025-07-08 to 2025-07-14)", formatAssignment(a))
+
+	a.EndWeek = 3
+	assert.Equal(t, "weeks 2-3 (2025-07-08 to 2025-07-14)", formatAssignment(a))
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+)
+
+// phaseDetails is a phase with the milestones and resources it links to.
+// Missing lists linked IDs that no longer exist.
+type phaseDetails struct {
+	Phase      *core.Phase       `yaml:"phase"`
+	Milestones []*core.Milestone `yaml:"milestones,omitempty"`
+	Resources  []*core.Resource  `yaml:"resources,omitempty"`
+	Missing    []core.EntityID   `yaml:"missing,omitempty"`
+}
+
+// expandedPath is the output of path view --expand in json and yaml
+type expandedPath struct {
+	core.LearningPath `yaml:",inline"`
+	PhaseDetails      []phaseDetails `yaml:"phaseDetails"`
+}
+
+// loadPhaseDetails loads the phases of a path in order, with their
+// milestones and resources
+func loadPhaseDetails(pathID core.EntityID) ([]phaseDetails, error) {
+	phases, err := phaseRepo.FindByPathID(pathID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve phases: %w", err)
+	}
+
+	details := make([]phaseDetails, len(phases))
+	for i, phase := range phases {
+		details[i].Phase = phase
+		for _, id := range phase.Milestones {
+			if milestone, err := milestoneRepo.GetByID(id); err == nil {
+				details[i].Milestones = append(details[i].Milestones, milestone)
+			} else {
+				details[i].Missing = append(details[i].Missing, id)
+			}
+		}
+		for _, id := range phase.Resources {
+			if resource, err := resourceRepo.GetByID(id); err == nil {
+				details[i].Resources = append(details[i].Resources, resource)
+			} else {
+				details[i].Missing = append(details[i].Missing, id)
+			}
+		}
+	}
+	return details, nil
+}
+
+// renderPhaseTree renders the phases of a path as a tree, one line per
+// phase with its progress and estimated duration. With expand, each phase
+// lists its milestones and resources with their statuses.
+func renderPhaseTree(phases []phaseDetails, expand bool) string {
+	var b strings.Builder
+	for i, details := range phases {
+		branch, indent := glyph.Branch.String(), glyph.Pipe.String()+"  "
+		if i == len(phases)-1 {
+			branch, indent = glyph.LastBranch.String(), "    "
+		}
+
+		phase := details.Phase
+		fmt.Fprintf(&b, "%s %d. %s (%s)  %s\n", branch, phase.Order, phase.Title, phase.ID, describePhaseProgress(phase))
+		if !expand {
+			continue
+		}
+
+		var children []string
+		for _, milestone := range details.Milestones {
+			children = append(children, describeMilestoneNode(milestone))
+		}
+		for _, resource := range details.Resources {
+			children = append(children, describeResourceNode(resource))
+		}
+		for _, id := range details.Missing {
+			children = append(children, fmt.Sprintf("%s %s (not found)", glyph.Cross, id))
+		}
+
+		for j, child := range children {
+			childBranch := glyph.Branch.String()
+			if j == len(children)-1 {
+				childBranch = glyph.LastBranch.String()
+			}
+			fmt.Fprintf(&b, "%s%s %s\n", indent, childBranch, child)
+		}
+	}
+	return b.String()
+}
+
+// describePhaseProgress summarizes whether a phase is finished, in
+// progress or not started, and how long it is estimated to take
+func describePhaseProgress(phase *core.Phase) string {
+	var progress string
+	switch {
+	case phase.IsFinished():
+		progress = "finished " + formatDate(*phase.EndDate)
+	case phase.StartDate != nil:
+		progress = "started " + formatDate(*phase.StartDate)
+	default:
+		progress = "not started"
+	}
+
+	if phase.EstimatedDuration != "" {
+		progress += ", estimated " + phase.EstimatedDuration
+	}
+	return progress
+}
+
+func describeMilestoneNode(milestone *core.Milestone) string {
+	mark := glyph.Bullet.String()
+	state := string(milestone.Status)
+	switch {
+	case milestone.IsAchieved():
+		mark = glyph.Check.String()
+		state = "achieved " + formatDate(*milestone.AchievedDate)
+	case milestone.TargetDate != nil:
+		state += ", due " + formatDate(*milestone.TargetDate)
+	}
+	return fmt.Sprintf("%s Milestone %s: %s (%s)", mark, milestone.ID, milestone.Title, state)
+}
+
+func describeResourceNode(resource *core.Resource) string {
+	mark := glyph.Bullet.String()
+	if resource.Status == core.ResourceCompleted {
+		mark = glyph.Check.String()
+	}
+
+	state := fmt.Sprintf("%s, %s", resource.Type, resource.Status)
+	if resource.EstimatedHours > 0 {
+		state += fmt.Sprintf(", %s/%sh", formatNumber(resource.ActualHours, 1), formatNumber(resource.EstimatedHours, 1))
+	}
+	return fmt.Sprintf("%s Resource %s: %s (%s)", mark, resource.ID, resource.Title, state)
+}
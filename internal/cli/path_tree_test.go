@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderPhaseTree(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)
+
+	basics, _ := core.NewPhase("phase-001", "path-001", "Basics", 1)
+	basics.EstimatedDuration = "2 weeks"
+	basics.StartDate = &start
+	basics.EndDate = &end
+	web, _ := core.NewPhase("phase-002", "path-001", "Web", 2)
+	web.StartDate = &end
+
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	tour.Status = core.ResourceCompleted
+	tour.EstimatedHours = 7
+	tour.ActualHours = 6.5
+	finish, _ := core.NewMilestone("milestone-001", "Finish the tour", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	finish.Status = core.StatusCompleted
+	finish.AchievedDate = &end
+	server, _ := core.NewMilestone("milestone-002", "Ship a server", core.MilestonePathLevel, core.ReferencePath, "path-001")
+
+	phases := []phaseDetails{
+		{Phase: basics, Milestones: []*core.Milestone{finish}, Resources: []*core.Resource{tour}},
+		{Phase: web, Milestones: []*core.Milestone{server}, Missing: []core.EntityID{"resource-009"}},
+	}
+
+	t.Run("phases only", func(t *testing.T) {
+		expected := `├─ 1. Basics (phase-001)  finished 2025-07-14, estimated 2 weeks
+└─ 2. Web (phase-002)  started 2025-07-14
+`
+		assert.Equal(t, expected, renderPhaseTree(phases, false))
+	})
+
+	t.Run("expanded", func(t *testing.T) {
+		expected := `├─ 1. Basics (phase-001)  finished 2025-07-14, estimated 2 weeks
+│   ├─ ✓ Milestone milestone-001: Finish the tour (achieved 2025-07-14)
+│   └─ ✓ Resource resource-001: A Tour of Go (course, completed, 6.5/7.0h)
+└─ 2. Web (phase-002)  started 2025-07-14
+    ├─ • Milestone milestone-002: Ship a server (active)
+    └─ ✗ resource-009 (not found)
+`
+		assert.Equal(t, expected, renderPhaseTree(phases, true))
+	})
+}
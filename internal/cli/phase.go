@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	phasePathID    string
+	phaseTitle     string
+	phaseStartDate string
+	phaseEndDate   string
+	phaseEnergy    string
+	phaseDoneDate  string
+)
+
+var phaseCmd = &cobra.Command{
+	Use:   "phase",
+	Short: "Manage learning path phases",
+	Long:  `List, view, and edit the phases of learning paths.`,
+}
+
+var phaseListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List phases",
+	Long: `List all phases in the repository.
+
+Optionally filter by learning path using --path-id.
+
+Examples:
+  growth phase list
+  growth phase list --path-id path-001`,
+	Aliases: []string{"ls"},
+	RunE:    runPhaseList,
+}
+
+var phaseViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View phase details",
+	Long: `View detailed information about a specific phase.
+
+The output format can be controlled with the --format flag (table, json, yaml).
+
+Examples:
+  growth phase view phase-001
+  growth phase view phase-003 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPhaseView,
+}
+
+var phaseEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit an existing phase",
+	Long: `Edit an existing phase by ID.
+
+Start and end dates record when work on the phase actually happened.
+A phase with an end date is treated as finished in schedule projections.
+Pass an empty value to clear a date.
+
+Examples:
+  growth phase edit phase-001 --start 2025-01-06
+  growth phase edit phase-001 --end 2025-02-14
+  growth phase edit phase-002 --title "Advanced Topics"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPhaseEdit,
+}
+
+var phaseCompleteCmd = &cobra.Command{
+	Use:   "complete <id>",
+	Short: "Mark a phase as finished",
+	Long: `Mark a phase as finished by setting its end date, today unless --date is
+given. A phase without a start date gets the same date as its start.
+
+When every phase of the learning path is finished, you are offered to
+mark the path completed too, and then any goal whose completion criteria
+are met.
+
+Examples:
+  growth phase complete phase-001
+  growth phase complete phase-002 --date yesterday`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPhaseComplete,
+}
+
+func init() {
+	rootCmd.AddCommand(phaseCmd)
+	phaseCmd.AddCommand(phaseListCmd)
+	phaseCmd.AddCommand(phaseViewCmd)
+	phaseCmd.AddCommand(phaseEditCmd)
+	phaseCmd.AddCommand(phaseCompleteCmd)
+
+	phaseListCmd.Flags().StringVar(&phasePathID, "path-id", "", "filter by learning path ID")
+
+	phaseEditCmd.Flags().StringVar(&phaseTitle, "title", "", "phase title")
+	phaseEditCmd.Flags().StringVar(&phaseStartDate, "start", "", "start date (YYYY-MM-DD)")
+	phaseEditCmd.Flags().StringVar(&phaseEndDate, "end", "", "end date (YYYY-MM-DD)")
+	phaseEditCmd.Flags().StringVar(&phaseEnergy, "energy", "", "energy needed (low, medium, high), empty to clear")
+
+	phaseCompleteCmd.Flags().StringVar(&phaseDoneDate, "date", "", "date the phase was finished (YYYY-MM-DD or e.g. 'yesterday'), defaults to today")
+}
+
+func runPhaseList(cmd *cobra.Command, args []string) error {
+	var phases []*core.Phase
+	var err error
+
+	if phasePathID != "" {
+		phases, err = phaseRepo.FindByPathID(core.EntityID(phasePathID))
+	} else {
+		phases, err = phaseRepo.GetAll()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to retrieve phases: %w", err)
+	}
+
+	if len(phases) == 0 {
+		PrintInfo("No phases found")
+		return nil
+	}
+
+	return PrintOutputWithConfig(phases)
+}
+
+func runPhaseView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	phase, err := phaseRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("phase '%s' not found. Use 'growth phase list' to see available phases", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:       %s\n", phase.ID)
+		fmt.Printf("Title:    %s\n", phase.Title)
+		fmt.Printf("Path:     %s\n", phase.PathID)
+		fmt.Printf("Order:    %d\n", phase.Order)
+		if phase.EstimatedDuration != "" {
+			fmt.Printf("Duration: %s\n", phase.EstimatedDuration)
+		}
+		if phase.Energy != "" {
+			fmt.Printf("Energy:   %s\n", phase.Energy)
+		}
+		if phase.StartDate != nil {
+			fmt.Printf("Started:  %s\n", formatDate(*phase.StartDate))
+		}
+		if phase.EndDate != nil {
+			fmt.Printf("Finished: %s\n", formatDate(*phase.EndDate))
+		}
+		if phase.Schedule != nil {
+			fmt.Printf("Planned:  %s\n", formatAssignment(*phase.Schedule))
+		}
+		if len(phase.Resources) > 0 {
+			fmt.Printf("Resources: %v\n", phase.Resources)
+		}
+		if len(phase.Milestones) > 0 {
+			fmt.Printf("Milestones: %v\n", phase.Milestones)
+		}
+		fmt.Printf("Created:  %s\n", formatDateTime(phase.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(phase.Updated))
+
+		if phase.Body != "" {
+			fmt.Printf("\nDescription:\n%s\n", phase.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(phase)
+}
+
+func runPhaseEdit(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	phase, err := phaseRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("phase '%s' not found. Use 'growth phase list' to see available phases", id)
+	}
+
+	updated := false
+
+	if cmd.Flags().Changed("title") {
+		phase.Title = phaseTitle
+		updated = true
+	}
+
+	if cmd.Flags().Changed("start") {
+		if phaseStartDate == "" {
+			phase.StartDate = nil
+		} else {
+			startDate, err := time.Parse("2006-01-02", phaseStartDate)
+			if err != nil {
+				return fmt.Errorf("invalid start date format (use YYYY-MM-DD): %w", err)
+			}
+			if err := phase.SetStartDate(startDate); err != nil {
+				return err
+			}
+		}
+		updated = true
+	}
+
+	if cmd.Flags().Changed("end") {
+		if phaseEndDate == "" {
+			phase.EndDate = nil
+		} else {
+			endDate, err := time.Parse("2006-01-02", phaseEndDate)
+			if err != nil {
+				return fmt.Errorf("invalid end date format (use YYYY-MM-DD): %w", err)
+			}
+			if err := phase.SetEndDate(endDate); err != nil {
+				return err
+			}
+		}
+		updated = true
+	}
+
+	if cmd.Flags().Changed("energy") {
+		if phaseEnergy == "" {
+			phase.Energy = ""
+			phase.Touch()
+		} else if err := phase.SetEnergy(core.EnergyLevel(phaseEnergy)); err != nil {
+			return fmt.Errorf("invalid energy '%s'. Valid options: low, medium, high", phaseEnergy)
+		}
+		updated = true
+	}
+
+	if !updated {
+		PrintInfo("No changes specified. Use flags to update fields.")
+		return nil
+	}
+
+	if err := phaseRepo.Update(phase); err != nil {
+		return fmt.Errorf("failed to update phase: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated phase %s: %s", phase.ID, phase.Title))
+	return nil
+}
+
+func runPhaseComplete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	phase, err := phaseRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("phase '%s' not found. Use 'growth phase list' to see available phases", id)
+	}
+	if phase.IsFinished() {
+		PrintInfo(fmt.Sprintf("Phase %s was already finished on %s", phase.ID, formatDate(*phase.EndDate)))
+		return nil
+	}
+
+	date, err := core.ParseDate("today", time.Now())
+	if err != nil {
+		return err
+	}
+	if phaseDoneDate != "" {
+		date, err = parseDateFlag("date", phaseDoneDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := phase.Complete(date); err != nil {
+		return err
+	}
+	if err := phaseRepo.Update(phase); err != nil {
+		return fmt.Errorf("failed to update phase: %w", err)
+	}
+	PrintSuccess(fmt.Sprintf("Completed phase %s: %s", phase.ID, phase.Title))
+
+	offerPathCompletion(phase.PathID)
+	return nil
+}
+
+// offerPathCompletion asks to mark an active path completed once all of its
+// phases are finished, then checks the goals the path belongs to
+func offerPathCompletion(pathID core.EntityID) {
+	path, err := pathRepo.GetByIDWithBody(pathID)
+	if err != nil || path.Status != core.StatusActive {
+		return
+	}
+	phases, err := phaseRepo.FindByPathID(path.ID)
+	if err != nil || len(phases) == 0 {
+		return
+	}
+
+	finished := core.CountFinished(phases)
+	if finished < len(phases) {
+		PrintInfo(fmt.Sprintf("Path %s: %d/%d phases finished", path.ID, finished, len(phases)))
+		return
+	}
+
+	if !PromptConfirm(fmt.Sprintf("All phases of path %s (%s) are finished. Mark it completed?", path.ID, path.Title)) {
+		return
+	}
+	if err := path.UpdateStatus(core.StatusCompleted); err != nil {
+		PrintWarning(fmt.Sprintf("Failed to complete path %s: %v", path.ID, err))
+		return
+	}
+	if err := pathRepo.Update(path); err != nil {
+		PrintWarning(fmt.Sprintf("Failed to update path %s: %v", path.ID, err))
+		return
+	}
+	PrintSuccess(fmt.Sprintf("Completed path %s: %s", path.ID, path.Title))
+
+	offerGoalCompletion(goalIDs(goalsForPath(path.ID))...)
+}
@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/illenko/growth.md/internal/calendar"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	planCalendar string
+	planHours    float64
+	planDate     string
+	planOutput   string
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Plan study time",
+	Long:  `Plan when to study, as time blocks in your calendar.`,
+}
+
+var planWeekCmd = &cobra.Command{
+	Use:   "week",
+	Short: "Propose study time blocks for a week",
+	Long: `Propose study time blocks for the week, taking the resources in progress
+and then the top of the resource queue (see 'growth resource queue').
+
+The weekly commitment is the sum of the hours per week of your active
+learning paths, or --hours. It is spread evenly over the study days, starting
+at the same time each day. Study days and the start time are read from the
+calendar section of .growth/config.yml (studyDays, startTime) and default to
+Monday to Friday at 19:00. When planning the current week, days that have
+already passed are skipped.
+
+With --calendar google the blocks are written to Google Calendar. Set
+calendar.clientId to a desktop OAuth client ID and its secret in
+GROWTH_GOOGLE_CLIENT_SECRET; the first run opens a consent page and caches
+the token in growth/google-token.json under your user config directory
+(e.g. ~/.config), outside the repository so it is never committed. When Google can't be reached, an ICS
+file is written instead. With --calendar ics the ICS file is always written.
+
+Examples:
+  growth plan week
+  growth plan week --hours 8
+  growth plan week --date 2025-07-14 --calendar ics
+  growth plan week --calendar google`,
+	RunE: runPlanWeek,
+}
+
+func init() {
+	rootCmd.AddCommand(planCmd)
+	planCmd.AddCommand(planWeekCmd)
+
+	planWeekCmd.Flags().StringVar(&planCalendar, "calendar", "", "write the blocks to a calendar (google, ics)")
+	planWeekCmd.Flags().Float64Var(&planHours, "hours", 0, "weekly study time - defaults to your active paths' commitment")
+	planWeekCmd.Flags().StringVar(&planDate, "date", "", "any date within the week to plan (YYYY-MM-DD), defaults to this week")
+	planWeekCmd.Flags().StringVarP(&planOutput, "output", "o", "", "ICS file to write, defaults to growth-week-<date>.ics")
+}
+
+func runPlanWeek(cmd *cobra.Command, args []string) error {
+	if planCalendar != "" && planCalendar != "google" && planCalendar != "ics" {
+		return fmt.Errorf("unknown calendar '%s'. Valid options: google, ics", planCalendar)
+	}
+
+	now := time.Now()
+	from := now
+	if planDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", planDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		from = parsed
+	}
+	weekStart := core.StartOfWeek(from)
+	if weekStart.Before(core.StartOfWeek(now)) {
+		return fmt.Errorf("cannot plan a week that has already passed")
+	}
+	if weekStart.After(now) {
+		from = weekStart
+	}
+
+	window, err := core.ParseStudyWindow(config.Calendar.StudyDays, config.Calendar.StartTime)
+	if err != nil {
+		return fmt.Errorf("invalid calendar configuration: %w", err)
+	}
+
+	hours := planHours
+	if !cmd.Flags().Changed("hours") {
+		hours, err = weeklyCommitment()
+		if err != nil {
+			return err
+		}
+	} else if hours <= 0 {
+		return fmt.Errorf("hours must be greater than 0")
+	}
+
+	resources, err := planResources()
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		PrintInfo("Nothing to plan: no resources in progress or in the queue")
+		return nil
+	}
+
+	blocks := core.PlanTimeBlocks(resources, hours, weekStart, from, window)
+	if len(blocks) == 0 {
+		PrintInfo("No study days left this week")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("Week of %s: %s hours/week\n\n", formatDate(weekStart), formatNumber(hours, 1))
+		for _, block := range blocks {
+			fmt.Printf("  %s  %s-%s  %-14s  %s\n",
+				block.Start.Format("Mon Jan 2"), block.Start.Format("15:04"), block.End.Format("15:04"),
+				block.Resource.ID, truncate(block.Resource.Title, 50))
+		}
+		fmt.Println()
+	} else if planCalendar == "" {
+		return PrintOutputWithConfig(blocks)
+	}
+
+	events := planEvents(blocks)
+
+	switch planCalendar {
+	case "google":
+		return writeGoogleCalendar(cmd.Context(), events, weekStart)
+	case "ics":
+		return writePlanICS(events, weekStart)
+	}
+
+	return nil
+}
+
+// weeklyCommitment sums the hours per week of active learning paths
+func weeklyCommitment() (float64, error) {
+	paths, err := pathRepo.GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve paths: %w", err)
+	}
+
+	total := 0.0
+	for _, path := range paths {
+		if path.Status == core.StatusActive {
+			total += path.HoursPerWeek
+		}
+	}
+
+	if total <= 0 {
+		PrintInfo(fmt.Sprintf("No weekly commitment set on your active paths, planning %s hours (use --hours to change)", formatNumber(core.DefaultHoursPerWeek, 1)))
+		return core.DefaultHoursPerWeek, nil
+	}
+	return total, nil
+}
+
+// planResources returns the resources in progress followed by the resource queue
+func planResources() ([]*core.Resource, error) {
+	inProgress, err := resourceRepo.FindByStatus(core.ResourceInProgress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+
+	queue, err := buildResourceQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := inProgress
+	for _, entry := range queue {
+		resources = append(resources, entry.Resource)
+	}
+	return resources, nil
+}
+
+// planEvents turns time blocks into calendar events
+func planEvents(blocks []core.TimeBlock) []calendar.Event {
+	events := make([]calendar.Event, len(blocks))
+	for i, block := range blocks {
+		resource := block.Resource
+		description := fmt.Sprintf("%s (%s), skill %s", resource.ID, resource.Type, resource.SkillID)
+		if resource.Author != "" {
+			description = fmt.Sprintf("%s by %s\n%s", resource.Title, resource.Author, description)
+		}
+
+		events[i] = calendar.Event{
+			UID:         fmt.Sprintf("%s-%s@growth.md", resource.ID, block.Start.UTC().Format("20060102T1504")),
+			Summary:     "Study: " + resource.Title,
+			Description: description,
+			URL:         resource.URL,
+			Start:       block.Start,
+			End:         block.End,
+		}
+	}
+	return events
+}
+
+func writeGoogleCalendar(ctx context.Context, events []calendar.Event, weekStart time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	tokenFile, err := googleTokenFile()
+	if err != nil {
+		return err
+	}
+
+	cfg := calendar.GoogleConfig{
+		ClientID:   config.Calendar.ClientID,
+		CalendarID: config.Calendar.CalendarID,
+		TokenFile:  tokenFile,
+	}
+
+	gc, err := calendar.NewGoogleCalendar(ctx, cfg, func(url string) {
+		PrintInfo("Open this URL in your browser to let growth add events to your calendar:")
+		fmt.Println(url)
+	})
+	if err == nil {
+		progress := startSpinner(fmt.Sprintf("Adding %s to Google Calendar", countOf(len(events), "study block")))
+		err = gc.Insert(ctx, events)
+		progress.Stop()
+	}
+
+	if err != nil {
+		if !calendar.IsOffline(err) {
+			return err
+		}
+		PrintWarning("Google Calendar is unreachable, writing an ICS file instead")
+		return writePlanICS(events, weekStart)
+	}
+
+	PrintSuccess(fmt.Sprintf("Added %s to Google Calendar", countOf(len(events), "study block")))
+	return nil
+}
+
+// googleTokenFile returns where the Google OAuth token is cached, in the
+// user config directory so 'growth sync' can never commit it. A token left in
+// .growth by earlier versions is moved there.
+func googleTokenFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user config directory for the Google token: %w", err)
+	}
+	tokenFile := filepath.Join(dir, "growth", "google-token.json")
+
+	legacy := filepath.Join(repoPath, ".growth", "google-token.json")
+	if token, err := calendar.LoadToken(legacy); err == nil {
+		if _, err := os.Stat(tokenFile); os.IsNotExist(err) {
+			if err := calendar.SaveToken(tokenFile, token); err != nil {
+				return "", err
+			}
+		}
+		if err := os.Remove(legacy); err != nil {
+			PrintWarning(fmt.Sprintf("Could not remove the old Google token %s: %v", legacy, err))
+		}
+	}
+	return tokenFile, nil
+}
+
+func writePlanICS(events []calendar.Event, weekStart time.Time) error {
+	output := planOutput
+	if output == "" {
+		output = fmt.Sprintf("growth-week-%s.ics", weekStart.Format("2006-01-02"))
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create calendar file: %w", err)
+	}
+	defer file.Close()
+
+	if err := calendar.WriteICS(file, events, time.Now()); err != nil {
+		return err
+	}
+
+	PrintSuccess(fmt.Sprintf("Wrote %s to %s", countOf(len(events), "study block"), output))
+	return nil
+}
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanEvents(t *testing.T) {
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	tour.URL = "https://go.dev/tour"
+	tour.Author = "The Go Team"
+
+	start := time.Date(2025, 7, 7, 19, 0, 0, 0, time.UTC)
+	events := planEvents([]core.TimeBlock{{Resource: tour, Start: start, End: start.Add(2 * time.Hour)}})
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "resource-001-20250707T1900@growth.md", events[0].UID)
+	assert.Equal(t, "Study: A Tour of Go", events[0].Summary)
+	assert.Equal(t, "A Tour of Go by The Go Team\nresource-001 (course), skill skill-001", events[0].Description)
+	assert.Equal(t, "https://go.dev/tour", events[0].URL)
+	assert.Equal(t, start.Add(2*time.Hour), events[0].End)
+}
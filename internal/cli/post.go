@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// postPlatforms maps supported platforms to their post length limits
+var postPlatforms = map[string]int{
+	"linkedin": 3000,
+	"x":        280,
+}
+
+var (
+	postPlatform string
+	postDate     string
+	postProvider string
+	postModel    string
+)
+
+var postCmd = &cobra.Command{
+	Use:   "post",
+	Short: "Draft learning-in-public social posts",
+}
+
+var postWeeklyCmd = &cobra.Command{
+	Use:   "weekly",
+	Short: "Draft a post about this week's progress",
+	Long: `Draft a social post from a week of progress logs, completed resources and
+achieved milestones using the AI client.
+
+The draft is saved to the drafts/ directory for you to review and post by
+hand; nothing is published.
+
+Examples:
+  growth post weekly --platform linkedin
+  growth post weekly --platform x
+  growth post weekly --platform x --date 2025-01-06`,
+	RunE: runPostWeekly,
+}
+
+func init() {
+	rootCmd.AddCommand(postCmd)
+	postCmd.AddCommand(postWeeklyCmd)
+
+	postWeeklyCmd.Flags().StringVar(&postPlatform, "platform", "linkedin", "platform to write for: linkedin or x")
+	postWeeklyCmd.Flags().StringVar(&postDate, "date", "", "any date within the week to post about (YYYY-MM-DD), defaults to this week")
+	postWeeklyCmd.Flags().StringVar(&postProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	postWeeklyCmd.Flags().StringVar(&postModel, "model", "", "model override - defaults to config")
+}
+
+func runPostWeekly(cmd *cobra.Command, args []string) error {
+	maxLength, ok := postPlatforms[postPlatform]
+	if !ok {
+		return fmt.Errorf("invalid platform '%s', must be one of: linkedin, x", postPlatform)
+	}
+
+	day := time.Now()
+	if postDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", postDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	digest := core.ComputeWeeklyDigest(day, logs, resources, milestones)
+	if digest.ProgressLogs == 0 && len(digest.CompletedResources) == 0 && len(digest.AchievedMilestones) == 0 {
+		return fmt.Errorf("no progress logged for the week of %s. Use 'growth progress log' first", formatDate(digest.WeekStart))
+	}
+
+	weekLogs, skills, err := weekProgress(digest, logs)
+	if err != nil {
+		return err
+	}
+
+	client, err := aiService.NewClient(postProvider, postModel)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
+	defer cancel()
+
+	progress := startSpinner(fmt.Sprintf("Drafting %s post with %s", postPlatform, client.Provider()))
+	resp, err := client.DraftPost(ctx, ai.PostDraftRequest{
+		Platform:     postPlatform,
+		MaxLength:    maxLength,
+		Digest:       digest,
+		ProgressLogs: weekLogs,
+		Skills:       skills,
+	})
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to draft post: %w", err)
+	}
+
+	draft := formatPostDraft(resp)
+	path := filepath.Join(repoPath, "drafts", postDraftName(digest.WeekStart, postPlatform))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create drafts directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(draft), 0644); err != nil {
+		return fmt.Errorf("failed to write draft: %w", err)
+	}
+
+	fmt.Println()
+	fmt.Print(draft)
+	fmt.Println()
+
+	if length := utf8.RuneCountInString(strings.TrimSpace(draft)); length > maxLength {
+		PrintWarning(fmt.Sprintf("The draft is %d characters, over the %d allowed on %s. Shorten it before posting.", length, maxLength, postPlatform))
+	}
+	PrintSuccess(fmt.Sprintf("Saved draft to %s", path))
+	return nil
+}
+
+// weekProgress loads the bodies of the week's progress logs and the skills
+// they worked on
+func weekProgress(digest core.WeeklyDigest, logs []*core.ProgressLog) ([]*core.ProgressLog, []*core.Skill, error) {
+	var weekLogs []*core.ProgressLog
+	var skills []*core.Skill
+	seen := make(map[core.EntityID]bool)
+
+	for _, log := range logs {
+		if !digest.Contains(log.Date) {
+			continue
+		}
+
+		full, err := progressRepo.GetByIDWithBody(log.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load progress log %s: %w", log.ID, err)
+		}
+		weekLogs = append(weekLogs, full)
+
+		for _, skillID := range log.SkillsWorked {
+			if seen[skillID] {
+				continue
+			}
+			seen[skillID] = true
+			if skill, err := skillRepo.GetByID(skillID); err == nil {
+				skills = append(skills, skill)
+			}
+		}
+	}
+
+	return weekLogs, skills, nil
+}
+
+// formatPostDraft joins the post text and its hashtags
+func formatPostDraft(resp *ai.PostDraftResponse) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(resp.Text))
+	b.WriteString("\n")
+
+	if len(resp.Hashtags) > 0 {
+		tags := make([]string, len(resp.Hashtags))
+		for i, tag := range resp.Hashtags {
+			tags[i] = "#" + tag
+		}
+		b.WriteString("\n")
+		b.WriteString(strings.Join(tags, " "))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// postDraftName names a draft after its week and platform
+func postDraftName(weekStart time.Time, platform string) string {
+	return fmt.Sprintf("weekly-%s-%s.txt", weekStart.Format("2006-01-02"), platform)
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatPostDraft(t *testing.T) {
+	t.Run("appends hashtags", func(t *testing.T) {
+		draft := formatPostDraft(&ai.PostDraftResponse{
+			Text:     "Finished the Go course.",
+			Hashtags: []string{"golang", "learninginpublic"},
+		})
+
+		assert.Equal(t, "Finished the Go course.\n\n#golang #learninginpublic\n", draft)
+	})
+
+	t.Run("omits empty hashtags", func(t *testing.T) {
+		assert.Equal(t, "Finished the Go course.\n", formatPostDraft(&ai.PostDraftResponse{Text: "Finished the Go course. "}))
+	})
+}
+
+func TestPostDraftName(t *testing.T) {
+	weekStart := time.Date(2025, 6, 9, 0, 0, 0, 0, time.Local)
+
+	assert.Equal(t, "weekly-2025-06-09-x.txt", postDraftName(weekStart, "x"))
+}
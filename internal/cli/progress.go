@@ -1,20 +1,31 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/core"
 	"github.com/spf13/cobra"
 )
 
 var (
-	progressDate   string
-	progressHours  string
-	progressMood   string
-	progressSkills string
+	progressDate       string
+	progressHours      string
+	progressMood       string
+	progressEnergy     string
+	progressSkills     string
+	progressResources  string
+	progressMilestones string
+
+	transcriptProvider string
+	transcriptModel    string
+	transcriptInclude  bool
+	transcriptYes      bool
 )
 
 var progressCmd = &cobra.Command{
@@ -31,7 +42,13 @@ var progressLogCmd = &cobra.Command{
 Examples:
   growth progress log
   growth progress log --hours 15 --mood motivated
-  growth progress log --date 2025-12-16`,
+  growth progress log --hours 1 --mood tired --energy low
+  growth progress log --date 2025-12-16
+  growth progress log --date yesterday --hours 2
+  growth progress log --hours 3 --resources resource-004 --milestones milestone-002
+
+Completing a resource or achieving a milestone also adds it to the latest
+progress log of the current week.`,
 	RunE: runProgressLog,
 }
 
@@ -59,16 +76,43 @@ Examples:
 	RunE: runProgressView,
 }
 
+var progressImportTranscriptCmd = &cobra.Command{
+	Use:   "import-transcript <file>",
+	Short: "Create a progress log from a transcript",
+	Long: `Create a progress log from a text transcript, such as a transcribed voice memo.
+
+The AI provider extracts hours invested, skills and resources worked on,
+mood, and a summary. The result is shown for confirmation before it is saved,
+and hours are added to the resources used.
+
+Examples:
+  growth progress import-transcript memo.txt
+  growth progress import-transcript memo.txt --date 2025-12-16 --include-transcript
+  growth progress import-transcript memo.txt --provider gemini --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProgressImportTranscript,
+}
+
 func init() {
 	rootCmd.AddCommand(progressCmd)
 	progressCmd.AddCommand(progressLogCmd)
 	progressCmd.AddCommand(progressListCmd)
 	progressCmd.AddCommand(progressViewCmd)
+	progressCmd.AddCommand(progressImportTranscriptCmd)
 
-	progressLogCmd.Flags().StringVar(&progressDate, "date", "", "date for progress log (YYYY-MM-DD), defaults to today")
+	progressLogCmd.Flags().StringVar(&progressDate, "date", "", "date for progress log (YYYY-MM-DD or e.g. 'yesterday', '2 days ago'), defaults to today")
 	progressLogCmd.Flags().StringVar(&progressHours, "hours", "", "hours invested")
 	progressLogCmd.Flags().StringVar(&progressMood, "mood", "", "mood (e.g., motivated, frustrated, focused)")
+	progressLogCmd.Flags().StringVar(&progressEnergy, "energy", "", "energy level (low, medium, high)")
 	progressLogCmd.Flags().StringVar(&progressSkills, "skills", "", "comma-separated skill IDs")
+	progressLogCmd.Flags().StringVar(&progressResources, "resources", "", "comma-separated IDs of resources used")
+	progressLogCmd.Flags().StringVar(&progressMilestones, "milestones", "", "comma-separated IDs of milestones achieved")
+
+	progressImportTranscriptCmd.Flags().StringVar(&progressDate, "date", "", "date for progress log (YYYY-MM-DD or e.g. 'yesterday', '2 days ago'), defaults to today")
+	progressImportTranscriptCmd.Flags().StringVar(&transcriptProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	progressImportTranscriptCmd.Flags().StringVar(&transcriptModel, "model", "", "model override - defaults to config")
+	progressImportTranscriptCmd.Flags().BoolVar(&transcriptInclude, "include-transcript", false, "append the original transcript to the log")
+	progressImportTranscriptCmd.Flags().BoolVarP(&transcriptYes, "yes", "y", false, "save without asking for confirmation")
 }
 
 func runProgressLog(cmd *cobra.Command, args []string) error {
@@ -76,9 +120,9 @@ func runProgressLog(cmd *cobra.Command, args []string) error {
 	var err error
 
 	if progressDate != "" {
-		date, err = time.Parse("2006-01-02", progressDate)
+		date, err = parseDateFlag("date", progressDate)
 		if err != nil {
-			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+			return err
 		}
 	} else {
 		date = time.Now()
@@ -120,12 +164,28 @@ func runProgressLog(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if progressSkills != "" {
-		skills := strings.Split(progressSkills, ",")
-		for _, skillStr := range skills {
-			skillID := core.EntityID(strings.TrimSpace(skillStr))
-			log.AddSkillWorked(skillID)
+	if progressEnergy != "" {
+		if err := log.SetEnergy(core.EnergyLevel(progressEnergy)); err != nil {
+			return fmt.Errorf("invalid energy '%s'. Valid options: low, medium, high", progressEnergy)
+		}
+	}
+
+	for _, skillID := range splitIDs(progressSkills) {
+		log.AddSkillWorked(skillID)
+	}
+
+	for _, resourceID := range splitIDs(progressResources) {
+		if exists, _ := resourceRepo.Exists(resourceID); !exists {
+			return fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", resourceID)
+		}
+		log.AddResourceUsed(resourceID)
+	}
+
+	for _, milestoneID := range splitIDs(progressMilestones) {
+		if exists, _ := milestoneRepo.Exists(milestoneID); !exists {
+			return fmt.Errorf("milestone '%s' not found. Use 'growth milestone list' to see available milestones", milestoneID)
 		}
+		log.AddMilestoneAchieved(milestoneID)
 	}
 
 	summary := PromptMultiline("Daily summary (press Ctrl+D or enter '.' to finish)")
@@ -137,14 +197,17 @@ func runProgressLog(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to save progress log: %w", err)
 	}
 
-	PrintSuccess(fmt.Sprintf("Logged progress %s for %s", log.ID, log.Date.Format("2006-01-02")))
+	accrueResourceHours(log)
+	startSkillsWorked(log)
+
+	PrintSuccess(fmt.Sprintf("Logged progress %s for %s", log.ID, formatDate(log.Date)))
 
 	if verbose {
 		fmt.Printf("\nProgress log details:\n")
 		fmt.Printf("  ID: %s\n", log.ID)
-		fmt.Printf("  Date: %s\n", log.Date.Format("2006-01-02"))
+		fmt.Printf("  Date: %s\n", formatDate(log.Date))
 		if log.HoursInvested > 0 {
-			fmt.Printf("  Hours: %.1f\n", log.HoursInvested)
+			fmt.Printf("  Hours: %s\n", formatNumber(log.HoursInvested, 1))
 		}
 		if log.Mood != "" {
 			fmt.Printf("  Mood: %s\n", log.Mood)
@@ -152,6 +215,12 @@ func runProgressLog(cmd *cobra.Command, args []string) error {
 		if len(log.SkillsWorked) > 0 {
 			fmt.Printf("  Skills: %v\n", log.SkillsWorked)
 		}
+		if len(log.ResourcesUsed) > 0 {
+			fmt.Printf("  Resources: %v\n", log.ResourcesUsed)
+		}
+		if len(log.MilestonesAchieved) > 0 {
+			fmt.Printf("  Milestones: %v\n", log.MilestonesAchieved)
+		}
 	}
 
 	return nil
@@ -181,24 +250,36 @@ func runProgressView(cmd *cobra.Command, args []string) error {
 
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", log.ID)
-		fmt.Printf("Date:     %s\n", log.Date.Format("2006-01-02"))
+		fmt.Printf("Date:     %s\n", formatDate(log.Date))
 		if log.HoursInvested > 0 {
-			fmt.Printf("Hours:    %.1f\n", log.HoursInvested)
+			fmt.Printf("Hours:    %s\n", formatNumber(log.HoursInvested, 1))
 		}
 		if log.Mood != "" {
 			fmt.Printf("Mood:     %s\n", log.Mood)
 		}
+		if log.Energy != "" {
+			fmt.Printf("Energy:   %s\n", log.Energy)
+		}
+		if log.FocusMinutes > 0 {
+			fmt.Printf("Focus:    %d min, %s\n", log.FocusMinutes, countOf(log.Distractions, "distraction"))
+		}
+		if log.Source != "" {
+			fmt.Printf("Source:   %s\n", log.Source)
+		}
 		if len(log.SkillsWorked) > 0 {
 			fmt.Printf("Skills:   %v\n", log.SkillsWorked)
 		}
+		if len(log.SkillHours) > 0 {
+			fmt.Printf("Skill hours: %s\n", describeSkillHours(log.SkillHours))
+		}
 		if len(log.ResourcesUsed) > 0 {
 			fmt.Printf("Resources: %v\n", log.ResourcesUsed)
 		}
 		if len(log.MilestonesAchieved) > 0 {
 			fmt.Printf("Milestones: %v\n", log.MilestonesAchieved)
 		}
-		fmt.Printf("Created:  %s\n", log.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", log.Updated.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Created:  %s\n", formatDateTime(log.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(log.Updated))
 
 		if log.Body != "" {
 			fmt.Printf("\nSummary:\n%s\n", log.Body)
@@ -209,3 +290,172 @@ func runProgressView(cmd *cobra.Command, args []string) error {
 
 	return PrintOutputWithConfig(log)
 }
+
+func runProgressImportTranscript(cmd *cobra.Command, args []string) error {
+	date := time.Now()
+	if progressDate != "" {
+		var err error
+		date, err = parseDateFlag("date", progressDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read transcript: %w", err)
+	}
+
+	transcript := strings.TrimSpace(string(data))
+	if transcript == "" {
+		return fmt.Errorf("transcript %s is empty", args[0])
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+
+	client, err := aiService.NewClient(transcriptProvider, transcriptModel)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
+	defer cancel()
+
+	progress := startSpinner(fmt.Sprintf("Extracting progress from transcript with %s", client.Provider()))
+	resp, err := client.ExtractProgress(ctx, ai.ProgressExtractionRequest{
+		Transcript: transcript,
+		Skills:     skills,
+		Resources:  resources,
+	})
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to extract progress: %w", err)
+	}
+
+	id, err := GenerateNextID("progress")
+	if err != nil {
+		return fmt.Errorf("failed to generate progress ID: %w", err)
+	}
+
+	log, err := core.NewProgressLog(id, date)
+	if err != nil {
+		return fmt.Errorf("failed to create progress log: %w", err)
+	}
+
+	if err := log.SetHoursInvested(resp.HoursInvested); err != nil {
+		return fmt.Errorf("failed to set hours: %w", err)
+	}
+	if resp.Mood != "" {
+		log.SetMood(strings.ToLower(resp.Mood))
+	}
+	for _, skillID := range resp.SkillIDs {
+		log.AddSkillWorked(skillID)
+	}
+	for _, resourceID := range resp.ResourceIDs {
+		log.AddResourceUsed(resourceID)
+	}
+
+	log.Body = strings.TrimSpace(resp.Summary)
+	if transcriptInclude {
+		log.Body += "\n\n## Transcript\n\n" + transcript
+	}
+
+	fmt.Println()
+	fmt.Printf("Date:      %s\n", formatDate(log.Date))
+	fmt.Printf("Hours:     %s\n", formatNumber(log.HoursInvested, 1))
+	if log.Mood != "" {
+		fmt.Printf("Mood:      %s\n", log.Mood)
+	}
+	if len(log.SkillsWorked) > 0 {
+		fmt.Printf("Skills:    %v\n", log.SkillsWorked)
+	}
+	if len(log.ResourcesUsed) > 0 {
+		fmt.Printf("Resources: %v\n", log.ResourcesUsed)
+	}
+	fmt.Printf("\nSummary:\n%s\n\n", resp.Summary)
+
+	if !transcriptYes && !PromptConfirm("Save this progress log?") {
+		PrintInfo("Nothing saved")
+		return nil
+	}
+
+	if err := progressRepo.Create(log); err != nil {
+		return fmt.Errorf("failed to save progress log: %w", err)
+	}
+
+	accrueResourceHours(log)
+	startSkillsWorked(log)
+
+	PrintSuccess(fmt.Sprintf("Logged progress %s for %s", log.ID, formatDate(log.Date)))
+	return nil
+}
+
+// accrueResourceHours distributes a log's hours across the resources it used.
+// Failures are reported as warnings since the log itself is already saved.
+func accrueResourceHours(log *core.ProgressLog) {
+	if log.HoursPerResource() == 0 {
+		return
+	}
+
+	for _, resourceID := range log.ResourcesUsed {
+		resource, err := resourceRepo.GetByIDWithBody(resourceID)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Could not accrue hours to resource %s: %v", resourceID, err))
+			continue
+		}
+		if !log.AccrueHours(resource) {
+			continue
+		}
+		if err := resourceRepo.Update(resource); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to update resource %s: %v", resourceID, err))
+		}
+	}
+}
+
+// splitIDs parses a comma-separated list of entity IDs, skipping blanks
+func splitIDs(value string) []core.EntityID {
+	var ids []core.EntityID
+	for _, part := range strings.Split(value, ",") {
+		if id := strings.TrimSpace(part); id != "" {
+			ids = append(ids, core.EntityID(id))
+		}
+	}
+	return ids
+}
+
+// addToWeekLog records a completed resource or achieved milestone, described
+// by what, in the latest progress log of the current week. Failures are only
+// warnings since the change being recorded is already saved.
+func addToWeekLog(what string, add func(*core.ProgressLog)) {
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		PrintWarning(fmt.Sprintf("Could not add %s to this week's progress log: %v", what, err))
+		return
+	}
+
+	latest := core.LatestLogInWeek(logs, time.Now())
+	if latest == nil {
+		PrintInfo(fmt.Sprintf("No progress logged this week to add %s to. Use 'growth progress log' to record it", what))
+		return
+	}
+
+	log, err := progressRepo.GetByIDWithBody(latest.ID)
+	if err != nil {
+		PrintWarning(fmt.Sprintf("Could not add %s to progress log %s: %v", what, latest.ID, err))
+		return
+	}
+	add(log)
+	if err := progressRepo.Update(log); err != nil {
+		PrintWarning(fmt.Sprintf("Could not add %s to progress log %s: %v", what, log.ID, err))
+		return
+	}
+	PrintInfo(fmt.Sprintf("Added %s to progress log %s", what, log.ID))
+}
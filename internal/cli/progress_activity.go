@@ -0,0 +1,266 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/illenko/growth.md/internal/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	activitySource string
+	activityMatch  string
+	activityDryRun bool
+)
+
+var progressImportActivityCmd = &cobra.Command{
+	Use:   "import-activity <csv>",
+	Short: "Import study time from a time tracker export",
+	Long: `Turn study sessions tracked in another app into weekly progress logs, so
+they do not have to be entered again by hand.
+
+Reads CSV exports of Toggl Track, RescueTime and health or habit apps with a
+date and a duration column (H:MM:SS, seconds, minutes or hours). Sessions
+are added up per week into one progress log per week, which records the
+source the hours came from. Importing again replaces the hours of weeks
+already imported from the same source, so overlapping exports are not
+counted twice.
+
+Use --match to import only sessions whose description contains a text,
+such as a Toggl project or RescueTime activity.
+
+Examples:
+  growth progress import-activity toggl.csv
+  growth progress import-activity rescuetime.csv --match coursera
+  growth progress import-activity habits.csv --source streaks --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProgressImportActivity,
+}
+
+func init() {
+	progressCmd.AddCommand(progressImportActivityCmd)
+
+	progressImportActivityCmd.Flags().StringVar(&activitySource, "source", "", "name of the app the export came from, detected from the header by default")
+	progressImportActivityCmd.Flags().StringVar(&activityMatch, "match", "", "import only sessions whose description contains this text")
+	progressImportActivityCmd.Flags().BoolVar(&activityDryRun, "dry-run", false, "show the weekly hours without saving them")
+}
+
+// activityWeek is the time tracked in one week
+type activityWeek struct {
+	Start    time.Time
+	Hours    float64
+	Sessions int
+	Skills   map[core.EntityID]float64 // hours per skill, when known
+}
+
+func runProgressImportActivity(cmd *cobra.Command, args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer file.Close()
+
+	sessions, source, err := importer.ParseActivityCSV(file)
+	if err != nil {
+		return err
+	}
+	if activitySource != "" {
+		source = strings.ToLower(strings.TrimSpace(activitySource))
+	}
+
+	weeks := activityWeeks(sessions, activityMatch)
+	if len(weeks) == 0 {
+		PrintInfo("No sessions found to import")
+		return nil
+	}
+
+	return importActivityWeeks(cmd.Context(), weeks, source, activityDryRun)
+}
+
+// importActivityWeeks saves the weekly hours tracked in source as progress
+// logs, one per week, printing what it does. Weeks already saved are undone
+// when a later week fails or ctx is cancelled.
+func importActivityWeeks(ctx context.Context, weeks []activityWeek, source string, dryRun bool) (err error) {
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+
+	var rollback service.Rollback
+	defer func() {
+		if err == nil {
+			return
+		}
+		if undoErr := rollback.Undo(); undoErr != nil {
+			PrintWarning(fmt.Sprintf("Failed to undo the partly imported weeks: %v", undoErr))
+		}
+	}()
+
+	total := 0.0
+	for _, week := range weeks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		total += week.Hours
+		existing := importedWeekLog(logs, source, week.Start)
+
+		action := "new"
+		if existing != nil {
+			action = "update " + string(existing.ID)
+		}
+		line := fmt.Sprintf("  %s  %6sh  %-12s  %-20s  %s", formatDate(week.Start), formatNumber(week.Hours, 1),
+			countOf(week.Sessions, "session"), action, describeSkillHours(week.Skills))
+		fmt.Println(strings.TrimRight(line, " "))
+		if dryRun {
+			continue
+		}
+
+		if err := saveActivityWeek(existing, source, week, &rollback); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		PrintInfo(fmt.Sprintf("Dry run: %s hours from %s in %s, nothing saved",
+			formatNumber(total, 1), source, countOf(len(weeks), "week")))
+		return nil
+	}
+	PrintSuccess(fmt.Sprintf("Imported %s hours from %s into %s",
+		formatNumber(total, 1), source, countOf(len(weeks), "weekly progress log")))
+	return nil
+}
+
+// saveActivityWeek sets the hours of the week's log imported before, or
+// creates a log for the week, recording how to undo it in rollback
+func saveActivityWeek(existing *core.ProgressLog, source string, week activityWeek, rollback *service.Rollback) error {
+	if existing != nil {
+		log, err := progressRepo.GetByIDWithBody(existing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load progress log %s: %w", existing.ID, err)
+		}
+		hours, skills, skillHours := log.HoursInvested, slices.Clone(log.SkillsWorked), maps.Clone(log.SkillHours)
+		if err := log.SetHoursInvested(week.Hours); err != nil {
+			return err
+		}
+		setWeekSkills(log, week.Skills)
+		if err := progressRepo.Update(log); err != nil {
+			return fmt.Errorf("failed to update progress log %s: %w", log.ID, err)
+		}
+		rollback.Add(func() error {
+			log.HoursInvested, log.SkillsWorked, log.SkillHours = hours, skills, skillHours
+			return progressRepo.Update(log)
+		})
+		startSkillsWorked(log)
+		return nil
+	}
+
+	id, err := GenerateNextID("progress")
+	if err != nil {
+		return fmt.Errorf("failed to generate progress ID: %w", err)
+	}
+	log, err := core.NewProgressLog(id, week.Start)
+	if err != nil {
+		return fmt.Errorf("failed to create progress log: %w", err)
+	}
+	if err := log.SetHoursInvested(week.Hours); err != nil {
+		return err
+	}
+	setWeekSkills(log, week.Skills)
+	log.Source = source
+	log.Body = fmt.Sprintf("Imported %s from %s.", countOf(week.Sessions, "session"), source)
+	if err := progressRepo.Create(log); err != nil {
+		return fmt.Errorf("failed to save progress log: %w", err)
+	}
+	rollback.Add(func() error { return progressRepo.Delete(log.ID) })
+	startSkillsWorked(log)
+	return nil
+}
+
+// describeSkillHours lists hours per skill, like "skill-001 2.5h, skill-004 1h"
+func describeSkillHours(skills map[core.EntityID]float64) string {
+	ids := make([]core.EntityID, 0, len(skills))
+	for id := range skills {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, fmt.Sprintf("%s %sh", id, formatNumber(skills[id], 1)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// setWeekSkills records the hours per skill of an imported week, keeping
+// the log's skills when the import does not know them
+func setWeekSkills(log *core.ProgressLog, skills map[core.EntityID]float64) {
+	if len(skills) == 0 {
+		return
+	}
+
+	ids := make([]core.EntityID, 0, len(skills))
+	for id := range skills {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		log.AddSkillWorked(id)
+	}
+	log.SkillHours = skills
+}
+
+// activityWeeks adds up the sessions whose description contains match per
+// week, oldest first, with hours rounded to two decimals
+func activityWeeks(sessions []importer.Session, match string) []activityWeek {
+	match = strings.ToLower(match)
+	byStart := map[time.Time]*activityWeek{}
+	var weeks []*activityWeek
+	for _, session := range sessions {
+		if match != "" && !strings.Contains(strings.ToLower(session.Description), match) {
+			continue
+		}
+
+		start := core.StartOfWeek(session.Date)
+		week, ok := byStart[start]
+		if !ok {
+			week = &activityWeek{Start: start}
+			byStart[start] = week
+			weeks = append(weeks, week)
+		}
+		week.Hours += session.Hours
+		week.Sessions++
+	}
+
+	result := make([]activityWeek, 0, len(weeks))
+	for _, week := range weeks {
+		week.Hours = math.Round(week.Hours*100) / 100
+		result = append(result, *week)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Start.Before(result[j].Start) })
+	return result
+}
+
+// importedWeekLog finds the log imported from source for the week starting
+// on start
+func importedWeekLog(logs []*core.ProgressLog, source string, start time.Time) *core.ProgressLog {
+	for _, log := range logs {
+		if log.Source != source {
+			continue
+		}
+		if core.StartOfWeek(core.CalendarDate(log.Date)).Equal(core.CalendarDate(start)) {
+			return log
+		}
+	}
+	return nil
+}
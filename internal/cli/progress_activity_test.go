@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityWeeks(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.Local) }
+	sessions := []importer.Session{
+		{Date: day(12), Hours: 1, Description: "Coursera: Kubernetes"},
+		{Date: day(3), Hours: 1.5, Description: "Go course"},
+		{Date: day(9), Hours: 0.333, Description: "coursera quiz"},
+		{Date: day(4), Hours: 0.25, Description: "Email"},
+	}
+
+	t.Run("adds up sessions per week", func(t *testing.T) {
+		weeks := activityWeeks(sessions, "")
+
+		require.Len(t, weeks, 2)
+		assert.Equal(t, activityWeek{Start: day(3), Hours: 2.08, Sessions: 3}, weeks[0])
+		assert.Equal(t, activityWeek{Start: day(10), Hours: 1, Sessions: 1}, weeks[1])
+	})
+
+	t.Run("keeps matching sessions", func(t *testing.T) {
+		weeks := activityWeeks(sessions, "COURSERA")
+
+		require.Len(t, weeks, 2)
+		assert.Equal(t, 0.33, weeks[0].Hours)
+		assert.Equal(t, 1, weeks[0].Sessions)
+	})
+}
+
+func TestImportedWeekLog(t *testing.T) {
+	logs := []*core.ProgressLog{
+		{ID: "progress-001", Date: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: "progress-002", Date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), Source: "toggl"},
+	}
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.Local)
+
+	assert.Equal(t, core.EntityID("progress-002"), importedWeekLog(logs, "toggl", start).ID)
+	assert.Nil(t, importedWeekLog(logs, "rescuetime", start))
+	assert.Nil(t, importedWeekLog(logs, "toggl", start.AddDate(0, 0, 7)))
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quickaddDate string
+	quickaddYes  bool
+)
+
+var quickaddCmd = &cobra.Command{
+	Use:   "quickadd <note>",
+	Short: "Capture progress from a one-line note",
+	Long: `Turn a free-text note into a progress log and resource updates.
+
+The note is parsed for:
+  hours      "3h", "1.5 hours", "45 min"
+  mood       "felt focused", "feeling tired", "mood: motivated"
+  resource   a resource whose title appears in the note, abbreviations allowed
+  completion "finished", "completed" or "done with" the resource
+  skills     the resource's skill and any skill named in the note
+
+A preview of the changes is shown for confirmation before anything is saved.
+The note itself becomes the progress log summary, and its hours are added to
+the matched resource.
+
+Examples:
+  growth quickadd "read 2 chapters of Designing Data-Intensive Apps, 3h, felt focused"
+  growth quickadd "finished Kubernetes in Action, 2h" --yes
+  growth quickadd "45 min of Go katas" --date 2025-06-02`,
+	Aliases: []string{"qa"},
+	Args:    cobra.MinimumNArgs(1),
+	RunE:    runQuickadd,
+}
+
+func init() {
+	rootCmd.AddCommand(quickaddCmd)
+
+	quickaddCmd.Flags().StringVar(&quickaddDate, "date", "", "date for the progress log (YYYY-MM-DD), defaults to today")
+	quickaddCmd.Flags().BoolVarP(&quickaddYes, "yes", "y", false, "save without asking for confirmation")
+}
+
+func runQuickadd(cmd *cobra.Command, args []string) error {
+	date := time.Now()
+	if quickaddDate != "" {
+		var err error
+		date, err = time.Parse("2006-01-02", quickaddDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+	}
+
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	entry := core.ParseQuickEntry(strings.Join(args, " "), resources, skills)
+
+	skillTitles := make(map[core.EntityID]string, len(skills))
+	for _, skill := range skills {
+		skillTitles[skill.ID] = skill.Title
+	}
+
+	fmt.Printf("Progress log for %s\n", formatDate(date))
+	fmt.Printf("  Hours:    %s\n", formatNumber(entry.Hours, 1))
+	if entry.Mood != "" {
+		fmt.Printf("  Mood:     %s\n", entry.Mood)
+	}
+	if len(entry.Skills) > 0 {
+		names := make([]string, 0, len(entry.Skills))
+		for _, id := range entry.Skills {
+			names = append(names, fmt.Sprintf("%s (%s)", skillTitles[id], id))
+		}
+		fmt.Printf("  Skills:   %s\n", strings.Join(names, ", "))
+	}
+	fmt.Printf("  Summary:  %s\n", entry.Text)
+	if entry.Resource != nil {
+		fmt.Printf("Resource %s: %s\n", entry.Resource.ID, entry.Resource.Title)
+		if entry.Hours > 0 {
+			fmt.Printf("  Hours:    +%s\n", formatNumber(entry.Hours, 1))
+		}
+		if entry.ResourceStatus != "" {
+			fmt.Printf("  Status:   %s %s %s\n", entry.Resource.Status, glyph.Arrow, entry.ResourceStatus)
+		}
+	}
+	fmt.Println()
+
+	if entry.Hours == 0 && entry.Resource == nil {
+		PrintWarning("No hours or resource recognised in the note")
+	}
+
+	if !quickaddYes && !PromptConfirm("Save these changes?") {
+		PrintInfo("Nothing saved")
+		return nil
+	}
+
+	if entry.Resource != nil && entry.ResourceStatus != "" {
+		resource, err := resourceRepo.GetByIDWithBody(entry.Resource.ID)
+		if err != nil {
+			return fmt.Errorf("failed to load resource %s: %w", entry.Resource.ID, err)
+		}
+		if entry.ResourceStatus == core.ResourceCompleted {
+			resource.Complete()
+		} else {
+			resource.Start()
+		}
+		if err := resourceRepo.Update(resource); err != nil {
+			return fmt.Errorf("failed to update resource %s: %w", resource.ID, err)
+		}
+	}
+
+	id, err := GenerateNextID("progress")
+	if err != nil {
+		return fmt.Errorf("failed to generate progress ID: %w", err)
+	}
+
+	log, err := core.NewProgressLog(id, date)
+	if err != nil {
+		return fmt.Errorf("failed to create progress log: %w", err)
+	}
+
+	if err := log.SetHoursInvested(entry.Hours); err != nil {
+		return fmt.Errorf("failed to set hours: %w", err)
+	}
+	if entry.Mood != "" {
+		log.SetMood(entry.Mood)
+	}
+	for _, skillID := range entry.Skills {
+		log.AddSkillWorked(skillID)
+	}
+	if entry.Resource != nil {
+		log.AddResourceUsed(entry.Resource.ID)
+	}
+	log.Body = entry.Text
+
+	if err := progressRepo.Create(log); err != nil {
+		return fmt.Errorf("failed to save progress log: %w", err)
+	}
+
+	accrueResourceHours(log)
+	startSkillsWorked(log)
+
+	PrintSuccess(fmt.Sprintf("Logged progress %s for %s", log.ID, formatDate(log.Date)))
+	if entry.Resource != nil && entry.ResourceStatus == core.ResourceCompleted {
+		suggestMasteryReview(entry.Resource.SkillID)
+	}
+	return nil
+}
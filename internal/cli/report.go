@@ -0,0 +1,312 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportCIDate   string
+	reportCIOutput string
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate reports for automation",
+	Long:  `Generate reports meant to be produced by scripts and CI jobs rather than read in a terminal.`,
+}
+
+var reportCICmd = &cobra.Command{
+	Use:   "ci",
+	Short: "Summarize the week for a CI job",
+	Long: `Summarize a week of learning for posting as a GitHub Actions job summary or
+a commit comment when the growth repository is pushed.
+
+The report never prompts, calls the AI or prints colors, and lists
+everything in a stable order, so the same repository always produces the
+same report. It is markdown unless --format is json or yaml.
+
+Examples:
+  growth report ci --format markdown
+  growth report ci --date 2025-01-06 --output report.md
+  growth report ci --format markdown >> "$GITHUB_STEP_SUMMARY"`,
+	Args: cobra.NoArgs,
+	RunE: runReportCI,
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportCICmd)
+
+	reportCICmd.Flags().StringVar(&reportCIDate, "date", "", "any date within the week to report (YYYY-MM-DD), defaults to this week")
+	reportCICmd.Flags().StringVarP(&reportCIOutput, "output", "o", "", "write the report to a file")
+}
+
+// ciReport is a week of learning, ordered for stable output
+type ciReport struct {
+	WeekStart          string      `yaml:"weekStart" json:"weekStart"`
+	WeekEnd            string      `yaml:"weekEnd" json:"weekEnd"` // last day of the week
+	Hours              float64     `yaml:"hours" json:"hours"`
+	ProgressLogs       int         `yaml:"progressLogs" json:"progressLogs"`
+	Streak             int         `yaml:"streak" json:"streak"` // in weeks
+	Skills             []ciSkill   `yaml:"skills,omitempty" json:"skills,omitempty"`
+	CompletedResources []ciItem    `yaml:"completedResources,omitempty" json:"completedResources,omitempty"`
+	AchievedMilestones []ciItem    `yaml:"achievedMilestones,omitempty" json:"achievedMilestones,omitempty"`
+	ActiveGoals        []ciGoal    `yaml:"activeGoals,omitempty" json:"activeGoals,omitempty"`
+	weekStart, weekEnd time.Time   // for rendering
+	achieved           []time.Time // achieved dates of AchievedMilestones
+}
+
+// ciSkill is a skill worked on during the week, with the hours of the logs
+// that worked on it
+type ciSkill struct {
+	ID    string  `yaml:"id" json:"id"`
+	Title string  `yaml:"title" json:"title"`
+	Logs  int     `yaml:"logs" json:"logs"`
+	Hours float64 `yaml:"hours" json:"hours"`
+}
+
+type ciItem struct {
+	ID    string `yaml:"id" json:"id"`
+	Title string `yaml:"title" json:"title"`
+}
+
+type ciGoal struct {
+	ID                 string `yaml:"id" json:"id"`
+	Title              string `yaml:"title" json:"title"`
+	Status             string `yaml:"status" json:"status"`
+	Priority           string `yaml:"priority" json:"priority"`
+	Milestones         int    `yaml:"milestones" json:"milestones"`
+	AchievedMilestones int    `yaml:"achievedMilestones" json:"achievedMilestones"`
+}
+
+func runReportCI(cmd *cobra.Command, args []string) error {
+	format := config.Display.OutputFormat
+	switch format {
+	case "markdown", "table", "json", "yaml":
+	default:
+		return fmt.Errorf("unsupported format '%s' for the CI report. Use markdown, json or yaml", format)
+	}
+
+	day := time.Now()
+	if reportCIDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", reportCIDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load progress logs: %w", err)
+	}
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+	goals, err := goalRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load goals: %w", err)
+	}
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	report := buildCIReport(day, logs, skills, goals, resources, milestones)
+
+	if format == "json" || format == "yaml" {
+		if reportCIOutput == "" {
+			return PrintOutput(report, format)
+		}
+		return fmt.Errorf("--output only writes markdown, redirect the %s output instead", format)
+	}
+
+	markdown := renderCIReport(report)
+	if reportCIOutput != "" {
+		if err := os.WriteFile(reportCIOutput, []byte(markdown), 0644); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Wrote report to %s", reportCIOutput))
+		return nil
+	}
+
+	fmt.Print(markdown)
+	return nil
+}
+
+// buildCIReport summarizes the week containing day
+func buildCIReport(day time.Time, logs []*core.ProgressLog, skills []*core.Skill, goals []*core.Goal, resources []*core.Resource, milestones []*core.Milestone) ciReport {
+	digest := core.ComputeWeeklyDigest(day, logs, resources, milestones)
+	report := ciReport{
+		WeekStart:    digest.WeekStart.Format("2006-01-02"),
+		WeekEnd:      digest.WeekEnd.AddDate(0, 0, -1).Format("2006-01-02"),
+		Hours:        digest.Hours,
+		ProgressLogs: digest.ProgressLogs,
+		Streak:       digest.Streak,
+		weekStart:    digest.WeekStart,
+		weekEnd:      digest.WeekEnd,
+	}
+
+	titles := make(map[core.EntityID]string, len(skills))
+	for _, skill := range skills {
+		titles[skill.ID] = skill.Title
+	}
+	worked := map[core.EntityID]*ciSkill{}
+	for _, log := range logs {
+		if !digest.Contains(log.Date) {
+			continue
+		}
+		for _, id := range log.SkillsWorked {
+			skill, ok := worked[id]
+			if !ok {
+				skill = &ciSkill{ID: string(id), Title: titles[id]}
+				worked[id] = skill
+			}
+			skill.Logs++
+			skill.Hours += log.HoursInvested
+		}
+	}
+	for _, skill := range worked {
+		report.Skills = append(report.Skills, *skill)
+	}
+	sort.Slice(report.Skills, func(i, j int) bool {
+		a, b := report.Skills[i], report.Skills[j]
+		if a.Hours != b.Hours {
+			return a.Hours > b.Hours
+		}
+		return a.ID < b.ID
+	})
+
+	for _, resource := range digest.CompletedResources {
+		report.CompletedResources = append(report.CompletedResources, ciItem{ID: string(resource.ID), Title: resource.Title})
+	}
+	// Milestones achieved on the same day are ordered by ID
+	sort.SliceStable(digest.AchievedMilestones, func(i, j int) bool {
+		a, b := digest.AchievedMilestones[i], digest.AchievedMilestones[j]
+		if !core.CalendarDate(*a.AchievedDate).Equal(core.CalendarDate(*b.AchievedDate)) {
+			return a.AchievedDate.Before(*b.AchievedDate)
+		}
+		return a.ID < b.ID
+	})
+	for _, milestone := range digest.AchievedMilestones {
+		report.AchievedMilestones = append(report.AchievedMilestones, ciItem{ID: string(milestone.ID), Title: milestone.Title})
+		report.achieved = append(report.achieved, *milestone.AchievedDate)
+	}
+
+	achieved := map[core.EntityID]bool{}
+	for _, milestone := range milestones {
+		achieved[milestone.ID] = milestone.IsAchieved()
+	}
+	for _, goal := range goals {
+		if goal.Status != core.StatusActive && goal.Status != core.StatusBlocked {
+			continue
+		}
+		item := ciGoal{
+			ID:         string(goal.ID),
+			Title:      goal.Title,
+			Status:     string(goal.Status),
+			Priority:   string(goal.Priority),
+			Milestones: len(goal.Milestones),
+		}
+		for _, id := range goal.Milestones {
+			if achieved[id] {
+				item.AchievedMilestones++
+			}
+		}
+		report.ActiveGoals = append(report.ActiveGoals, item)
+	}
+	sort.Slice(report.ActiveGoals, func(i, j int) bool {
+		a, b := report.ActiveGoals[i], report.ActiveGoals[j]
+		if ciPriorityRank(a.Priority) != ciPriorityRank(b.Priority) {
+			return ciPriorityRank(a.Priority) < ciPriorityRank(b.Priority)
+		}
+		return a.ID < b.ID
+	})
+
+	return report
+}
+
+func ciPriorityRank(priority string) int {
+	switch core.Priority(priority) {
+	case core.PriorityHigh:
+		return 0
+	case core.PriorityMedium:
+		return 1
+	case core.PriorityLow:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// renderCIReport formats the report as markdown for a job summary
+func renderCIReport(report ciReport) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Growth report: %s – %s\n\n",
+		report.weekStart.Format("Jan 2"), report.weekEnd.AddDate(0, 0, -1).Format("Jan 2, 2006"))
+
+	b.WriteString("| Hours | Progress logs | Resources completed | Milestones achieved | Streak |\n")
+	b.WriteString("|------:|--------------:|--------------------:|--------------------:|-------:|\n")
+	fmt.Fprintf(&b, "| %s | %d | %d | %d | %s |\n",
+		formatNumber(report.Hours, 1), report.ProgressLogs, len(report.CompletedResources),
+		len(report.AchievedMilestones), countOf(report.Streak, "week"))
+
+	if len(report.Skills) > 0 {
+		b.WriteString("\n### Skills practiced\n\n")
+		b.WriteString("| Skill | Logs | Hours |\n")
+		b.WriteString("|-------|-----:|------:|\n")
+		for _, skill := range report.Skills {
+			fmt.Fprintf(&b, "| %s | %d | %s |\n", ciLabel(skill.Title, skill.ID), skill.Logs, formatNumber(skill.Hours, 1))
+		}
+	}
+
+	if len(report.CompletedResources) > 0 {
+		b.WriteString("\n### Completed resources\n\n")
+		for _, resource := range report.CompletedResources {
+			fmt.Fprintf(&b, "- %s\n", ciLabel(resource.Title, resource.ID))
+		}
+	}
+
+	if len(report.AchievedMilestones) > 0 {
+		b.WriteString("\n### Milestones achieved\n\n")
+		for i, milestone := range report.AchievedMilestones {
+			fmt.Fprintf(&b, "- %s, %s\n", ciLabel(milestone.Title, milestone.ID), report.achieved[i].Format("Mon Jan 2"))
+		}
+	}
+
+	if len(report.ActiveGoals) > 0 {
+		b.WriteString("\n### Active goals\n\n")
+		b.WriteString("| Goal | Status | Priority | Milestones |\n")
+		b.WriteString("|------|--------|----------|-----------:|\n")
+		for _, goal := range report.ActiveGoals {
+			milestones := "–"
+			if goal.Milestones > 0 {
+				milestones = fmt.Sprintf("%d/%d", goal.AchievedMilestones, goal.Milestones)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", ciLabel(goal.Title, goal.ID), goal.Status, goal.Priority, milestones)
+		}
+	}
+
+	return b.String()
+}
+
+// ciLabel is an entity's title and ID, escaped for markdown tables
+func ciLabel(title, id string) string {
+	if title == "" {
+		return fmt.Sprintf("`%s`", id)
+	}
+	return fmt.Sprintf("%s (`%s`)", strings.ReplaceAll(title, "|", `\|`), id)
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIReport(t *testing.T) {
+	day := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	goSkill, _ := core.NewSkill("skill-001", "Go", "backend", core.LevelIntermediate)
+	k8s, _ := core.NewSkill("skill-002", "Kubernetes", "devops", core.LevelBeginner)
+
+	first, _ := core.NewProgressLog("progress-001", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC))
+	first.SetHoursInvested(2)
+	first.SkillsWorked = []core.EntityID{"skill-002", "skill-001"}
+	second, _ := core.NewProgressLog("progress-002", time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC))
+	second.SetHoursInvested(1.5)
+	second.SkillsWorked = []core.EntityID{"skill-001"}
+	lastWeek, _ := core.NewProgressLog("progress-000", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+	lastWeek.SetHoursInvested(3)
+	lastWeek.SkillsWorked = []core.EntityID{"skill-002"}
+
+	achieved := time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)
+	later, _ := core.NewMilestone("milestone-002", "Deploy", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	later.AchievedDate = &achieved
+	later.Status = core.StatusCompleted
+	earlier, _ := core.NewMilestone("milestone-001", "First PR", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	earlier.AchievedDate = &achieved
+	earlier.Status = core.StatusCompleted
+	pending, _ := core.NewMilestone("milestone-003", "Mentor", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+
+	low, _ := core.NewGoal("goal-002", "Learn Rust", core.PriorityLow)
+	high, _ := core.NewGoal("goal-003", "Ship | launch", core.PriorityHigh)
+	career, _ := core.NewGoal("goal-001", "Become senior", core.PriorityHigh)
+	career.Milestones = []core.EntityID{"milestone-001", "milestone-002", "milestone-003"}
+	done, _ := core.NewGoal("goal-004", "Done", core.PriorityHigh)
+	done.Status = core.StatusCompleted
+
+	report := buildCIReport(day,
+		[]*core.ProgressLog{second, lastWeek, first},
+		[]*core.Skill{k8s, goSkill},
+		[]*core.Goal{low, high, done, career},
+		nil,
+		[]*core.Milestone{pending, later, earlier})
+
+	t.Run("summarizes the week in a stable order", func(t *testing.T) {
+		assert.Equal(t, "2025-01-06", report.WeekStart)
+		assert.Equal(t, "2025-01-12", report.WeekEnd)
+		assert.Equal(t, 3.5, report.Hours)
+		assert.Equal(t, 2, report.ProgressLogs)
+		assert.Equal(t, []ciSkill{
+			{ID: "skill-001", Title: "Go", Logs: 2, Hours: 3.5},
+			{ID: "skill-002", Title: "Kubernetes", Logs: 1, Hours: 2},
+		}, report.Skills)
+		assert.Equal(t, []ciItem{{ID: "milestone-001", Title: "First PR"}, {ID: "milestone-002", Title: "Deploy"}}, report.AchievedMilestones)
+		assert.Equal(t, []ciGoal{
+			{ID: "goal-001", Title: "Become senior", Status: "active", Priority: "high", Milestones: 3, AchievedMilestones: 2},
+			{ID: "goal-003", Title: "Ship | launch", Status: "active", Priority: "high"},
+			{ID: "goal-002", Title: "Learn Rust", Status: "active", Priority: "low"},
+		}, report.ActiveGoals)
+	})
+
+	t.Run("renders markdown", func(t *testing.T) {
+		md := renderCIReport(report)
+
+		assert.Contains(t, md, "## Growth report: Jan 6 – Jan 12, 2025\n")
+		assert.Contains(t, md, "| 3.5 | 2 | 0 | 2 | 2 weeks |\n")
+		assert.Contains(t, md, "| Go (`skill-001`) | 2 | 3.5 |\n")
+		assert.Contains(t, md, "- First PR (`milestone-001`), Tue Jan 7\n")
+		assert.Contains(t, md, "| Become senior (`goal-001`) | active | high | 2/3 |\n")
+		assert.Contains(t, md, "| Ship \\| launch (`goal-003`) | active | high | – |\n")
+		assert.NotContains(t, md, "### Completed resources")
+		assert.Equal(t, md, renderCIReport(buildCIReport(day,
+			[]*core.ProgressLog{first, second, lastWeek},
+			[]*core.Skill{goSkill, k8s},
+			[]*core.Goal{career, done, high, low},
+			nil,
+			[]*core.Milestone{earlier, later, pending})))
+	})
+}
@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/metadata"
 	"github.com/spf13/cobra"
 )
 
@@ -16,9 +19,15 @@ var (
 	resourceURL        string
 	resourceAuthor     string
 	resourceHours      string
+	resourceActual     string
+	resourceCost       string
 	resourceTags       string
 	resourceTitle      string
 	resourceFilterType string
+	resourceNoFetch    bool
+	resourceStrict     bool
+	resourceReason     string
+	resourceEnergy     string
 )
 
 var resourceCmd = &cobra.Command{
@@ -35,9 +44,18 @@ var resourceCreateCmd = &cobra.Command{
 You can provide the title as an argument or be prompted for it.
 A resource must be associated with a skill using --skill-id.
 
+When --url is given, the page is fetched to prefill the title, author, and
+estimated hours (reading time, or video duration for YouTube links).
+Use --no-fetch to skip this.
+
+If another resource already has the same URL (ignoring scheme, "www.",
+trailing slashes, and tracking parameters) you'll be asked whether to
+create it anyway. With --strict the command fails instead.
+
 Examples:
   growth resource create "Clean Code" --skill-id skill-001 --type book --author "Robert Martin"
   growth resource create "Python Course" --skill-id skill-002 --type course --url https://example.com
+  growth resource create --skill-id skill-003 --url https://www.youtube.com/watch?v=f6kdp27TYZs
   growth resource create`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runResourceCreate,
@@ -68,6 +86,7 @@ The output format can be controlled with the --format flag (table, json, yaml).
 
 Examples:
   growth resource view resource-001
+  growth resource view resource-001 --history
   growth resource view resource-042 --format json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runResourceView,
@@ -84,6 +103,7 @@ to update each field interactively (press Enter to keep current value).
 Examples:
   growth resource edit resource-001 --status in-progress
   growth resource edit resource-042 --url https://example.com --hours 40
+  growth resource edit resource-042 --skill-id skill-003
   growth resource edit resource-001`,
 	Args: cobra.ExactArgs(1),
 	RunE: runResourceEdit,
@@ -127,6 +147,21 @@ Examples:
 	RunE: runResourceComplete,
 }
 
+var resourceAbandonCmd = &cobra.Command{
+	Use:   "abandon <id>",
+	Short: "Mark resource as abandoned",
+	Long: `Mark a resource as abandoned, optionally recording why.
+
+Abandoned resources are kept for history but excluded from completion rates
+and remaining-hour estimates.
+
+Examples:
+  growth resource abandon resource-001
+  growth resource abandon resource-004 --reason "Too outdated"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResourceAbandon,
+}
+
 func init() {
 	rootCmd.AddCommand(resourceCmd)
 	resourceCmd.AddCommand(resourceCreateCmd)
@@ -136,12 +171,17 @@ func init() {
 	resourceCmd.AddCommand(resourceDeleteCmd)
 	resourceCmd.AddCommand(resourceStartCmd)
 	resourceCmd.AddCommand(resourceCompleteCmd)
+	resourceCmd.AddCommand(resourceAbandonCmd)
 
 	resourceCreateCmd.Flags().StringVar(&resourceSkillID, "skill-id", "", "skill ID (required)")
 	resourceCreateCmd.Flags().StringVarP(&resourceType, "type", "t", "", "resource type (book, course, video, article, project, documentation)")
 	resourceCreateCmd.Flags().StringVar(&resourceURL, "url", "", "resource URL")
+	resourceCreateCmd.Flags().BoolVar(&resourceNoFetch, "no-fetch", false, "do not fetch metadata from --url")
+	resourceCreateCmd.Flags().BoolVar(&resourceStrict, "strict", false, "fail if a resource with the same URL exists")
 	resourceCreateCmd.Flags().StringVar(&resourceAuthor, "author", "", "resource author")
 	resourceCreateCmd.Flags().StringVar(&resourceHours, "hours", "", "estimated hours")
+	resourceCreateCmd.Flags().StringVar(&resourceCost, "cost", "", "amount paid, counted against ai.learningBudget")
+	resourceCreateCmd.Flags().StringVar(&resourceEnergy, "energy", "", "energy needed (low, medium, high)")
 	resourceCreateCmd.Flags().StringVar(&resourceTags, "tags", "", "comma-separated tags")
 	resourceCreateCmd.MarkFlagRequired("skill-id")
 
@@ -151,17 +191,44 @@ func init() {
 
 	resourceEditCmd.Flags().StringVar(&resourceTitle, "title", "", "resource title")
 	resourceEditCmd.Flags().StringVarP(&resourceType, "type", "t", "", "resource type")
+	resourceEditCmd.Flags().StringVar(&resourceSkillID, "skill-id", "", "move the resource to another skill")
 	resourceEditCmd.Flags().StringVar(&resourceURL, "url", "", "resource URL")
 	resourceEditCmd.Flags().StringVar(&resourceAuthor, "author", "", "resource author")
 	resourceEditCmd.Flags().StringVar(&resourceHours, "hours", "", "estimated hours")
+	resourceEditCmd.Flags().StringVar(&resourceActual, "actual-hours", "", "actual hours invested")
+	resourceEditCmd.Flags().StringVar(&resourceCost, "cost", "", "amount paid, counted against ai.learningBudget")
+	resourceEditCmd.Flags().StringVar(&resourceEnergy, "energy", "", "energy needed (low, medium, high), empty to clear")
 	resourceEditCmd.Flags().StringVarP(&resourceStatus, "status", "s", "", "resource status")
 	resourceEditCmd.Flags().StringVar(&resourceTags, "tags", "", "comma-separated tags")
+
+	resourceAbandonCmd.Flags().StringVar(&resourceReason, "reason", "", "why the resource was abandoned")
+
+	resourceViewCmd.Flags().BoolVar(&showHistory, "history", false, "show the history of status, level, and priority changes")
+	resourceViewCmd.Flags().StringVar(&openAttachment, "open", "", "open the attachment with this name")
 }
 
 func runResourceCreate(cmd *cobra.Command, args []string) error {
+	if resourceURL != "" {
+		proceed, err := checkDuplicateResourceURL(resourceURL, resourceStrict)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			PrintInfo("Resource creation cancelled")
+			return nil
+		}
+	}
+
+	var meta *metadata.Metadata
+	if resourceURL != "" && !resourceNoFetch {
+		meta = fetchResourceMetadata(cmd.Context(), resourceURL)
+	}
+
 	var title string
 	if len(args) > 0 {
 		title = args[0]
+	} else if meta != nil && meta.Title != "" {
+		title = PromptString("Resource title", meta.Title)
 	} else {
 		title = PromptStringRequired("Resource title")
 	}
@@ -180,10 +247,17 @@ func runResourceCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	if resourceType == "" {
+		defaultType := "book"
+		if meta != nil {
+			defaultType = "article"
+			if meta.IsVideo {
+				defaultType = "video"
+			}
+		}
 		resourceType = PromptSelectWithDefault(
 			"Resource type",
 			[]string{"book", "course", "video", "article", "project", "documentation"},
-			"book",
+			defaultType,
 		)
 	}
 
@@ -208,6 +282,8 @@ func runResourceCreate(cmd *cobra.Command, args []string) error {
 
 	if resourceAuthor != "" {
 		resource.SetAuthor(resourceAuthor)
+	} else if meta != nil && meta.Author != "" {
+		resource.SetAuthor(meta.Author)
 	}
 
 	if resourceHours != "" {
@@ -218,6 +294,26 @@ func runResourceCreate(cmd *cobra.Command, args []string) error {
 		if err := resource.SetEstimatedHours(hours); err != nil {
 			return fmt.Errorf("failed to set estimated hours: %w", err)
 		}
+	} else if meta != nil && meta.EstimatedHours > 0 {
+		if err := resource.SetEstimatedHours(meta.EstimatedHours); err != nil {
+			return fmt.Errorf("failed to set estimated hours: %w", err)
+		}
+	}
+
+	if resourceCost != "" {
+		cost, err := strconv.ParseFloat(resourceCost, 64)
+		if err != nil {
+			return fmt.Errorf("invalid cost value: %w", err)
+		}
+		if err := resource.SetCost(cost); err != nil {
+			return fmt.Errorf("failed to set cost: %w", err)
+		}
+	}
+
+	if resourceEnergy != "" {
+		if err := resource.SetEnergy(core.EnergyLevel(resourceEnergy)); err != nil {
+			return fmt.Errorf("invalid energy '%s'. Valid options: low, medium, high", resourceEnergy)
+		}
 	}
 
 	if resourceTags != "" {
@@ -232,7 +328,7 @@ func runResourceCreate(cmd *cobra.Command, args []string) error {
 		resource.Body = notes
 	}
 
-	if err := resourceRepo.Create(resource); err != nil {
+	if err := links.CreateResource(resource); err != nil {
 		return fmt.Errorf("failed to save resource: %w", err)
 	}
 
@@ -255,6 +351,59 @@ func runResourceCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// checkDuplicateResourceURL looks for existing resources with the same normalized URL.
+// In strict mode a duplicate is an error; otherwise the user is asked whether to proceed.
+func checkDuplicateResourceURL(url string, strict bool) (bool, error) {
+	duplicates, err := resourceRepo.FindByURL(url)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for duplicate resources: %w", err)
+	}
+	if len(duplicates) == 0 {
+		return true, nil
+	}
+
+	ids := make([]core.EntityID, len(duplicates))
+	for i, resource := range duplicates {
+		ids[i] = resource.ID
+	}
+
+	if strict {
+		return false, fmt.Errorf("a resource with this URL already exists: %s", formatEntityIDs(ids))
+	}
+
+	PrintWarning("A resource with this URL already exists:")
+	for _, resource := range duplicates {
+		fmt.Printf("  %s  %s (%s, %s)\n", resource.ID, resource.Title, resource.SkillID, resource.Status)
+	}
+
+	return PromptConfirm("Create it anyway?"), nil
+}
+
+// fetchResourceMetadata fetches metadata for a resource URL, warning instead of failing on errors
+func fetchResourceMetadata(ctx context.Context, rawURL string) *metadata.Metadata {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	meta, err := metadata.NewFetcher(10*time.Second).Fetch(ctx, rawURL)
+	if err != nil {
+		PrintWarning(fmt.Sprintf("Could not fetch URL metadata: %v", err))
+		return nil
+	}
+
+	if verbose {
+		fmt.Printf("Fetched metadata: %q", meta.Title)
+		if meta.Author != "" {
+			fmt.Printf(" by %s", meta.Author)
+		}
+		if meta.EstimatedHours > 0 {
+			fmt.Printf(" (~%s hours)", formatNumber(meta.EstimatedHours, 2))
+		}
+		fmt.Println()
+	}
+
+	return meta
+}
+
 func runResourceList(cmd *cobra.Command, args []string) error {
 	var resources []*core.Resource
 	var err error
@@ -271,7 +420,7 @@ func runResourceList(cmd *cobra.Command, args []string) error {
 	} else if resourceStatus != "" {
 		status := core.ResourceStatus(resourceStatus)
 		if !status.IsValid() {
-			return fmt.Errorf("invalid resource status '%s'. Valid options: not-started, in-progress, completed", resourceStatus)
+			return fmt.Errorf("invalid resource status '%s'. Valid options: not-started, in-progress, completed, abandoned", resourceStatus)
 		}
 		resources, err = resourceRepo.FindByStatus(status)
 	} else {
@@ -298,12 +447,19 @@ func runResourceView(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
 	}
 
+	if openAttachment != "" {
+		return openEntityAttachment(resource.Attachments, openAttachment)
+	}
+
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", resource.ID)
 		fmt.Printf("Title:    %s\n", resource.Title)
 		fmt.Printf("Type:     %s\n", resource.Type)
 		fmt.Printf("Skill:    %s\n", resource.SkillID)
 		fmt.Printf("Status:   %s\n", resource.Status)
+		if resource.AbandonReason != "" {
+			fmt.Printf("Reason:   %s\n", resource.AbandonReason)
+		}
 		if resource.URL != "" {
 			fmt.Printf("URL:      %s\n", resource.URL)
 		}
@@ -311,18 +467,46 @@ func runResourceView(cmd *cobra.Command, args []string) error {
 			fmt.Printf("Author:   %s\n", resource.Author)
 		}
 		if resource.EstimatedHours > 0 {
-			fmt.Printf("Hours:    %.1f\n", resource.EstimatedHours)
+			fmt.Printf("Hours:    %s\n", formatNumber(resource.EstimatedHours, 1))
+		}
+		if resource.ActualHours > 0 {
+			fmt.Printf("Actual:   %s\n", formatNumber(resource.ActualHours, 1))
+		}
+		if resource.HasHoursVariance() {
+			fmt.Printf("Variance: %+.1f hours (%+.0f%%)\n", resource.HoursVariance(), resource.HoursVariancePercent())
+		}
+		if resource.Cost > 0 {
+			fmt.Printf("Cost:     %s\n", formatNumber(resource.Cost, 2))
+		}
+		if resource.Pricing != "" {
+			fmt.Printf("Pricing:  %s\n", resource.Pricing)
+		}
+		if resource.Energy != "" {
+			fmt.Printf("Energy:   %s\n", resource.Energy)
 		}
 		if len(resource.Tags) > 0 {
 			fmt.Printf("Tags:     %s\n", strings.Join(resource.Tags, ", "))
 		}
-		fmt.Printf("Created:  %s\n", resource.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", resource.Updated.Format("2006-01-02 15:04:05"))
+		if resource.Schedule != nil {
+			fmt.Printf("Planned:  %s\n", formatAssignment(*resource.Schedule))
+		}
+		fmt.Printf("Created:  %s\n", formatDateTime(resource.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(resource.Updated))
+
+		if resource.WhyRecommended != "" {
+			fmt.Printf("\nWhy recommended:\n%s\n", resource.WhyRecommended)
+		}
 
 		if resource.Body != "" {
 			fmt.Printf("\nNotes:\n%s\n", resource.Body)
 		}
 
+		printAttachments(resource.Attachments)
+
+		if showHistory {
+			printHistory(resource.History)
+		}
+
 		return nil
 	}
 
@@ -353,6 +537,20 @@ func runResourceEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("skill-id") {
+		skillID := core.EntityID(resourceSkillID)
+		exists, err := skillRepo.Exists(skillID)
+		if err != nil {
+			return fmt.Errorf("failed to check skill existence: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", skillID)
+		}
+		resource.SkillID = skillID
+		resource.Touch()
+		updated = true
+	}
+
 	if cmd.Flags().Changed("url") {
 		resource.SetURL(resourceURL)
 		updated = true
@@ -374,10 +572,42 @@ func runResourceEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("actual-hours") {
+		hours, err := strconv.ParseFloat(resourceActual, 64)
+		if err != nil {
+			return fmt.Errorf("invalid actual hours value: %w", err)
+		}
+		if err := resource.SetActualHours(hours); err != nil {
+			return fmt.Errorf("failed to set actual hours: %w", err)
+		}
+		updated = true
+	}
+
+	if cmd.Flags().Changed("cost") {
+		cost, err := strconv.ParseFloat(resourceCost, 64)
+		if err != nil {
+			return fmt.Errorf("invalid cost value: %w", err)
+		}
+		if err := resource.SetCost(cost); err != nil {
+			return fmt.Errorf("failed to set cost: %w", err)
+		}
+		updated = true
+	}
+
+	if cmd.Flags().Changed("energy") {
+		if resourceEnergy == "" {
+			resource.Energy = ""
+			resource.Touch()
+		} else if err := resource.SetEnergy(core.EnergyLevel(resourceEnergy)); err != nil {
+			return fmt.Errorf("invalid energy '%s'. Valid options: low, medium, high", resourceEnergy)
+		}
+		updated = true
+	}
+
 	if cmd.Flags().Changed("status") {
 		status := core.ResourceStatus(resourceStatus)
 		if !status.IsValid() {
-			return fmt.Errorf("invalid resource status '%s'. Valid options: not-started, in-progress, completed", resourceStatus)
+			return fmt.Errorf("invalid resource status '%s'. Valid options: not-started, in-progress, completed, abandoned", resourceStatus)
 		}
 		if err := resource.UpdateStatus(status); err != nil {
 			return fmt.Errorf("failed to update status: %w", err)
@@ -401,7 +631,7 @@ func runResourceEdit(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	if err := resourceRepo.Update(resource); err != nil {
+	if err := links.UpdateResource(resource); err != nil {
 		return fmt.Errorf("failed to update resource: %w", err)
 	}
 
@@ -428,7 +658,7 @@ func runResourceDelete(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	if err := resourceRepo.Delete(id); err != nil {
+	if err := links.DeleteResource(id); err != nil {
 		return fmt.Errorf("failed to delete resource: %w", err)
 	}
 
@@ -469,5 +699,25 @@ func runResourceComplete(cmd *cobra.Command, args []string) error {
 	}
 
 	PrintSuccess(fmt.Sprintf("Completed resource %s: %s", resource.ID, resource.Title))
+	addToWeekLog(string(resource.ID), func(log *core.ProgressLog) { log.AddResourceUsed(resource.ID) })
+	suggestMasteryReview(resource.SkillID)
+	return nil
+}
+
+func runResourceAbandon(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	resource, err := resourceRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
+	}
+
+	resource.Abandon(resourceReason)
+
+	if err := resourceRepo.Update(resource); err != nil {
+		return fmt.Errorf("failed to update resource: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Abandoned resource %s: %s", resource.ID, resource.Title))
 	return nil
 }
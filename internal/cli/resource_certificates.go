@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	certificateFormat string
+	certificateDryRun bool
+)
+
+var resourceImportCertificatesCmd = &cobra.Command{
+	Use:   "import-certificates <file>",
+	Short: "Complete resources from course completion certificates",
+	Long: `Mark courses completed from their completion certificates, keeping the
+certificate link as proof.
+
+Reads Coursera and Udemy completion emails (a saved .eml file, or an .mbox
+export of several) or a CSV export with a course and a certificate URL
+column. Each certificate is matched to a resource by its title. The
+resource is marked completed and a milestone of its skill is achieved with
+the certificate link as proof: an active milestone naming the course, or a
+new one. Certificates already used as proof are skipped.
+
+Examples:
+  growth resource import-certificates certificate.eml
+  growth resource import-certificates takeout/Courses.mbox --dry-run
+  growth resource import-certificates certificates.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResourceImportCertificates,
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceImportCertificatesCmd)
+
+	resourceImportCertificatesCmd.Flags().StringVar(&certificateFormat, "format", "", "file format (email, csv), guessed from the extension by default")
+	resourceImportCertificatesCmd.Flags().BoolVar(&certificateDryRun, "dry-run", false, "show the matches without saving them")
+}
+
+func runResourceImportCertificates(cmd *cobra.Command, args []string) error {
+	format := certificateFormat
+	if format == "" {
+		format = importer.GuessCertificateFormat(args[0])
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer file.Close()
+
+	certificates, err := importer.ParseCertificates(file, format)
+	if err != nil {
+		return err
+	}
+	if len(certificates) == 0 {
+		PrintInfo("No certificates found")
+		return nil
+	}
+
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+
+	imported := 0
+	var unmatched []string
+	var skills []core.EntityID
+	var goals []core.EntityID
+	for _, certificate := range certificates {
+		if proven := milestoneWithProof(milestones, certificate.URL); proven != nil {
+			fmt.Printf("  %-14s %-10s %s\n", proven.ID, "skipped", truncate(certificate.Course, 50))
+			continue
+		}
+
+		resource := matchCertificateResource(certificate.Course, resources)
+		if resource == nil {
+			unmatched = append(unmatched, certificate.Course)
+			continue
+		}
+		milestone := certificateMilestone(certificate.Course, resource.SkillID, milestones)
+
+		target := "new milestone"
+		if milestone != nil {
+			target = string(milestone.ID)
+		}
+		fmt.Printf("  %-14s %-10s %s (%s)\n", resource.ID, "completed", truncate(certificate.Course, 50), target)
+		imported++
+		if certificateDryRun {
+			continue
+		}
+
+		achieved, err := applyCertificate(certificate, resource, milestone)
+		if err != nil {
+			return err
+		}
+		if milestone == nil {
+			milestones = append(milestones, achieved)
+		}
+		if !slices.Contains(skills, resource.SkillID) {
+			skills = append(skills, resource.SkillID)
+		}
+		for _, goalID := range goalsForMilestone(achieved) {
+			if !slices.Contains(goals, goalID) {
+				goals = append(goals, goalID)
+			}
+		}
+	}
+
+	for _, course := range unmatched {
+		PrintWarning(fmt.Sprintf("No resource matches '%s'. Add it with 'growth resource create' and import again", course))
+	}
+	if certificateDryRun {
+		PrintInfo(fmt.Sprintf("Dry run: %s would be imported, nothing saved", countOf(imported, "certificate")))
+		return nil
+	}
+	PrintSuccess(fmt.Sprintf("Imported %s", countOf(imported, "certificate")))
+	for _, skillID := range skills {
+		suggestMasteryReview(skillID)
+	}
+	offerGoalCompletion(goals...)
+	return nil
+}
+
+// applyCertificate completes the resource and achieves the milestone, or a
+// new milestone of the resource's skill, with the certificate as proof
+func applyCertificate(certificate importer.Certificate, resource *core.Resource, milestone *core.Milestone) (*core.Milestone, error) {
+	resource, err := resourceRepo.GetByIDWithBody(resource.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resource: %w", err)
+	}
+	if resource.Status != core.ResourceCompleted {
+		resource.Complete()
+		if err := resourceRepo.Update(resource); err != nil {
+			return nil, fmt.Errorf("failed to update resource %s: %w", resource.ID, err)
+		}
+	}
+
+	created := milestone == nil
+	if !created {
+		if milestone, err = milestoneRepo.GetByIDWithBody(milestone.ID); err != nil {
+			return nil, fmt.Errorf("failed to load milestone: %w", err)
+		}
+	} else {
+		id, err := GenerateNextID("milestone")
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate milestone ID: %w", err)
+		}
+		milestone, err = core.NewMilestone(id, "Earn the "+certificate.Course+" certificate",
+			core.MilestoneSkillLevel, core.ReferenceSkill, resource.SkillID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create milestone: %w", err)
+		}
+	}
+
+	milestone.Achieve(certificate.URL)
+	if !certificate.Completed.IsZero() {
+		completed := certificate.Completed
+		milestone.AchievedDate = &completed
+	}
+
+	if created {
+		err = milestoneRepo.Create(milestone)
+	} else {
+		err = milestoneRepo.Update(milestone)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save milestone %s: %w", milestone.ID, err)
+	}
+	return milestone, nil
+}
+
+// matchCertificateResource finds the resource a certificate's course is:
+// the one with the same title, or else the only one whose title contains the
+// course or is contained in it
+func matchCertificateResource(course string, resources []*core.Resource) *core.Resource {
+	want := normalizeTitle(course)
+	if want == "" {
+		return nil
+	}
+
+	var partial []*core.Resource
+	for _, resource := range resources {
+		if resource.Status == core.ResourceAbandoned {
+			continue
+		}
+		title := normalizeTitle(resource.Title)
+		if title == want {
+			return resource
+		}
+		if title != "" && (strings.Contains(title, want) || strings.Contains(want, title)) {
+			partial = append(partial, resource)
+		}
+	}
+	if len(partial) == 1 {
+		return partial[0]
+	}
+	return nil
+}
+
+// certificateMilestone finds an active milestone of the skill that names the
+// course
+func certificateMilestone(course string, skillID core.EntityID, milestones []*core.Milestone) *core.Milestone {
+	want := normalizeTitle(course)
+	for _, milestone := range milestones {
+		if milestone.ReferenceType != core.ReferenceSkill || milestone.ReferenceID != skillID ||
+			milestone.Status == core.StatusCompleted || milestone.Status == core.StatusArchived {
+			continue
+		}
+		if strings.Contains(normalizeTitle(milestone.Title), want) {
+			return milestone
+		}
+	}
+	return nil
+}
+
+// milestoneWithProof finds the milestone that already has the link as proof
+func milestoneWithProof(milestones []*core.Milestone, link string) *core.Milestone {
+	for _, milestone := range milestones {
+		if milestone.Proof != "" && strings.TrimSuffix(milestone.Proof, "/") == strings.TrimSuffix(link, "/") {
+			return milestone
+		}
+	}
+	return nil
+}
+
+// normalizeTitle lowercases a title and reduces punctuation to single
+// spaces, so "Go: The Complete Guide" and "go - the complete guide" match
+func normalizeTitle(title string) string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
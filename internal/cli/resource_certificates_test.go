@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "go the complete developer s guide", normalizeTitle("Go: The Complete Developer's Guide!"))
+	assert.Equal(t, "", normalizeTitle(" -- "))
+}
+
+func TestMatchCertificateResource(t *testing.T) {
+	resources := []*core.Resource{
+		{ID: "resource-001", Title: "Machine Learning", Status: core.ResourceInProgress},
+		{ID: "resource-002", Title: "Go: The Complete Developer's Guide (Golang)", Status: core.ResourceNotStarted},
+		{ID: "resource-003", Title: "Kubernetes for Developers", Status: core.ResourceAbandoned},
+		{ID: "resource-004", Title: "Machine Learning Specialization", Status: core.ResourceNotStarted},
+	}
+
+	t.Run("prefers the same title", func(t *testing.T) {
+		match := matchCertificateResource("Machine learning", resources)
+
+		require.NotNil(t, match)
+		assert.Equal(t, core.EntityID("resource-001"), match.ID)
+	})
+
+	t.Run("matches a title containing the course", func(t *testing.T) {
+		match := matchCertificateResource("Go - The Complete Developer's Guide", resources)
+
+		require.NotNil(t, match)
+		assert.Equal(t, core.EntityID("resource-002"), match.ID)
+	})
+
+	t.Run("skips ambiguous and abandoned resources", func(t *testing.T) {
+		assert.Nil(t, matchCertificateResource("Learning", resources))
+		assert.Nil(t, matchCertificateResource("Kubernetes for Developers", resources))
+	})
+}
+
+func TestCertificateMilestone(t *testing.T) {
+	milestones := []*core.Milestone{
+		{ID: "milestone-001", Title: "Finish Machine Learning course", ReferenceType: core.ReferenceSkill, ReferenceID: "skill-002", Status: core.StatusCompleted},
+		{ID: "milestone-002", Title: "Finish Machine Learning course", ReferenceType: core.ReferenceSkill, ReferenceID: "skill-001", Status: core.StatusActive},
+		{ID: "milestone-003", Title: "Finish Machine Learning course", ReferenceType: core.ReferenceSkill, ReferenceID: "skill-002", Status: core.StatusActive},
+	}
+
+	match := certificateMilestone("Machine Learning", "skill-002", milestones)
+	require.NotNil(t, match)
+	assert.Equal(t, core.EntityID("milestone-003"), match.ID)
+
+	assert.Nil(t, certificateMilestone("Deep Learning", "skill-002", milestones))
+}
+
+func TestMilestoneWithProof(t *testing.T) {
+	milestones := []*core.Milestone{
+		{ID: "milestone-001"},
+		{ID: "milestone-002", Proof: "https://coursera.org/verify/ABC123/"},
+	}
+
+	match := milestoneWithProof(milestones, "https://coursera.org/verify/ABC123")
+	require.NotNil(t, match)
+	assert.Equal(t, core.EntityID("milestone-002"), match.ID)
+	assert.Nil(t, milestoneWithProof(milestones, "https://coursera.org/verify/XYZ"))
+}
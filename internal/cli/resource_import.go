@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resourceImportFormat string
+	resourceImportAI     bool
+	resourceImportYes    bool
+)
+
+var resourceImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import resources from bookmarks",
+	Long: `Bulk-create resources from exported browser bookmarks or Pocket.
+
+Supported formats:
+  netscape  Bookmark HTML exported by Chrome, Firefox, Safari, and Edge
+  pocket    Pocket export (HTML or CSV)
+
+For each bookmark you'll be asked which skill it belongs to and its type.
+Leave the skill empty to skip a bookmark. Use --skill-id to assign every
+bookmark to the same skill, or --ai to have the AI suggest skill and type.
+Bookmarks whose URL already exists as a resource are skipped.
+
+Examples:
+  growth resource import bookmarks.html --format netscape
+  growth resource import pocket.csv --format pocket --ai
+  growth resource import bookmarks.html --format netscape --skill-id skill-001 --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResourceImport,
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceImportCmd)
+
+	resourceImportCmd.Flags().StringVar(&resourceImportFormat, "format", "netscape", "export format (netscape, pocket)")
+	resourceImportCmd.Flags().StringVar(&resourceSkillID, "skill-id", "", "assign all bookmarks to this skill")
+	resourceImportCmd.Flags().StringVarP(&resourceType, "type", "t", "", "assign all bookmarks this resource type")
+	resourceImportCmd.Flags().BoolVar(&resourceImportAI, "ai", false, "use AI to suggest skill and type for each bookmark")
+	resourceImportCmd.Flags().BoolVarP(&resourceImportYes, "yes", "y", false, "accept suggested skill and type without prompting")
+}
+
+func runResourceImport(cmd *cobra.Command, args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", args[0], err)
+	}
+	defer file.Close()
+
+	bookmarks, err := importer.ParseBookmarks(file, resourceImportFormat)
+	if err != nil {
+		return err
+	}
+
+	if len(bookmarks) == 0 {
+		PrintInfo("No bookmarks found")
+		return nil
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+	if len(skills) == 0 {
+		return fmt.Errorf("no skills found. Create a skill first with 'growth skill create'")
+	}
+
+	knownSkills := make(map[core.EntityID]*core.Skill, len(skills))
+	for _, skill := range skills {
+		knownSkills[skill.ID] = skill
+	}
+
+	if resourceSkillID != "" {
+		if _, ok := knownSkills[core.EntityID(resourceSkillID)]; !ok {
+			return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", resourceSkillID)
+		}
+	}
+
+	if resourceType != "" && !core.ResourceType(resourceType).IsValid() {
+		return fmt.Errorf("invalid resource type '%s'. Valid options: book, course, video, article, project, documentation", resourceType)
+	}
+
+	existing, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load resources: %w", err)
+	}
+	existingURLs := make(map[string]bool, len(existing))
+	for _, resource := range existing {
+		if resource.URL != "" {
+			existingURLs[core.NormalizeURL(resource.URL)] = true
+		}
+	}
+
+	var pending []importer.Bookmark
+	duplicates := 0
+	for _, bookmark := range bookmarks {
+		key := core.NormalizeURL(bookmark.URL)
+		if existingURLs[key] {
+			duplicates++
+			continue
+		}
+		existingURLs[key] = true
+		pending = append(pending, bookmark)
+	}
+
+	fmt.Printf("Found %d bookmarks (%d already imported)\n", len(bookmarks), duplicates)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	suggestions := make(map[int]ai.ResourceClassification)
+	if resourceImportAI && resourceSkillID == "" {
+		suggestions = classifyBookmarks(cmd.Context(), pending, skills)
+	}
+
+	if resourceSkillID == "" && !resourceImportYes {
+		fmt.Println("\nSkills:")
+		for _, skill := range skills {
+			fmt.Printf("  %s  %s\n", skill.ID, skill.Title)
+		}
+	}
+
+	created := 0
+	skipped := 0
+	for i, bookmark := range pending {
+		// Bookmarks already imported are skipped as duplicates on a rerun
+		if err := cmd.Context().Err(); err != nil {
+			return fmt.Errorf("import interrupted after %d resources: %w", created, err)
+		}
+
+		suggestion, hasSuggestion := suggestions[i]
+
+		skillID := core.EntityID(resourceSkillID)
+		if skillID == "" && hasSuggestion {
+			skillID = suggestion.SkillID
+		}
+
+		resType := core.ResourceType(resourceType)
+		if resType == "" {
+			resType = importer.GuessResourceType(bookmark.URL)
+			if hasSuggestion {
+				resType = suggestion.Type
+			}
+		}
+
+		if !resourceImportYes {
+			fmt.Printf("\n[%d/%d] %s\n      %s\n", i+1, len(pending), bookmark.Title, bookmark.URL)
+			if resourceSkillID == "" {
+				skillID = core.EntityID(PromptString("Skill ID (empty to skip)", string(skillID)))
+			}
+			if skillID != "" && resourceType == "" {
+				resType = core.ResourceType(PromptString("Type", string(resType)))
+			}
+		}
+
+		if skillID == "" {
+			skipped++
+			continue
+		}
+		if _, ok := knownSkills[skillID]; !ok {
+			PrintWarning(fmt.Sprintf("Skill '%s' not found, skipping %s", skillID, bookmark.URL))
+			skipped++
+			continue
+		}
+		if !resType.IsValid() {
+			PrintWarning(fmt.Sprintf("Invalid resource type '%s', using article", resType))
+			resType = core.ResourceArticle
+		}
+
+		id, err := GenerateNextID("resource")
+		if err != nil {
+			return fmt.Errorf("failed to generate resource ID: %w", err)
+		}
+
+		resource, err := core.NewResource(id, bookmark.Title, resType, skillID)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Skipping %s: %v", bookmark.URL, err))
+			skipped++
+			continue
+		}
+		resource.SetURL(bookmark.URL)
+		for _, tag := range bookmark.Tags {
+			resource.AddTag(tag)
+		}
+
+		if err := links.CreateResource(resource); err != nil {
+			return fmt.Errorf("failed to save resource: %w", err)
+		}
+		created++
+
+		if verbose {
+			fmt.Printf("  Created %s: %s (%s, %s)\n", resource.ID, resource.Title, resource.Type, resource.SkillID)
+		}
+	}
+
+	fmt.Println()
+	PrintSuccess(fmt.Sprintf("Imported %d resources (%d skipped, %d duplicates)", created, skipped, duplicates))
+	return nil
+}
+
+// classifyBookmarks asks the AI for skill and type suggestions, keyed by bookmark index.
+// Failures are reported as warnings and result in no suggestions.
+func classifyBookmarks(ctx context.Context, bookmarks []importer.Bookmark, skills []*core.Skill) map[int]ai.ResourceClassification {
+	suggestions := make(map[int]ai.ResourceClassification)
+
+	client, err := aiService.NewClient("", "")
+	if err != nil {
+		PrintWarning(fmt.Sprintf("AI suggestions unavailable: %v", err))
+		return suggestions
+	}
+
+	candidates := make([]ai.ResourceCandidate, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		tags := bookmark.Tags
+		if bookmark.Folder != "" {
+			tags = append([]string{bookmark.Folder}, tags...)
+		}
+		candidates[i] = ai.ResourceCandidate{
+			Title: bookmark.Title,
+			URL:   bookmark.URL,
+			Tags:  tags,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 120*time.Second)
+	defer cancel()
+
+	progress := startSpinner(fmt.Sprintf("Classifying %d bookmarks with %s", len(bookmarks), client.Provider()))
+	resp, err := client.ClassifyResources(ctx, ai.ResourceClassificationRequest{
+		Candidates: candidates,
+		Skills:     skills,
+	})
+	progress.Stop()
+	if err != nil {
+		PrintWarning(fmt.Sprintf("AI classification failed: %v", err))
+		return suggestions
+	}
+
+	for _, c := range resp.Classifications {
+		suggestions[c.Index] = c
+	}
+
+	matched := 0
+	for _, c := range suggestions {
+		if c.SkillID != "" {
+			matched++
+		}
+	}
+	fmt.Printf("   Suggested skills for %s\n", strings.TrimSpace(fmt.Sprintf("%d/%d bookmarks", matched, len(bookmarks))))
+
+	return suggestions
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var resourceNoteCmd = &cobra.Command{
+	Use:   "note",
+	Short: "Manage the notes section of a resource",
+}
+
+var resourceNoteAddCmd = &cobra.Command{
+	Use:   "add <id> <text>",
+	Short: "Append a note to a resource",
+	Long: `Append a note to the "## Notes" section of a resource body.
+
+The section is created if it does not exist yet. The rest of the body is left
+as it was, so free text written by hand is never overwritten.
+
+Examples:
+  growth resource note add resource-001 "Chapter 3 explains channels well"`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runResourceNoteAdd,
+}
+
+var resourceLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Manage the log section of a resource",
+}
+
+var resourceLogAddCmd = &cobra.Command{
+	Use:   "add <id> <text>",
+	Short: "Append a dated log entry to a resource",
+	Long: `Append an entry prefixed with today's date to the "## Log" section of a
+resource body.
+
+Examples:
+  growth resource log add resource-001 "Finished part 2"`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runResourceLogAdd,
+}
+
+var resourceLinkCmd = &cobra.Command{
+	Use:   "link",
+	Short: "Manage the links section of a resource",
+}
+
+var resourceLinkAddCmd = &cobra.Command{
+	Use:   "add <id> <url> [title]",
+	Short: "Append a link to a resource",
+	Long: `Append a link to the "## Links" section of a resource body.
+
+Examples:
+  growth resource link add resource-001 https://go.dev/doc/effective_go
+  growth resource link add resource-001 https://go.dev/blog "Go blog"`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: runResourceLinkAdd,
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceNoteCmd)
+	resourceCmd.AddCommand(resourceLogCmd)
+	resourceCmd.AddCommand(resourceLinkCmd)
+	resourceNoteCmd.AddCommand(resourceNoteAddCmd)
+	resourceLogCmd.AddCommand(resourceLogAddCmd)
+	resourceLinkCmd.AddCommand(resourceLinkAddCmd)
+}
+
+func runResourceNoteAdd(cmd *cobra.Command, args []string) error {
+	return appendResourceSection(core.EntityID(args[0]), core.SectionNotes, strings.Join(args[1:], " "))
+}
+
+func runResourceLogAdd(cmd *cobra.Command, args []string) error {
+	entry := formatLogEntry(time.Now(), strings.Join(args[1:], " "))
+	return appendResourceSection(core.EntityID(args[0]), core.SectionLog, entry)
+}
+
+func runResourceLinkAdd(cmd *cobra.Command, args []string) error {
+	title := ""
+	if len(args) == 3 {
+		title = args[2]
+	}
+	return appendResourceSection(core.EntityID(args[0]), core.SectionLinks, formatLink(args[1], title))
+}
+
+// appendResourceSection adds a list item to a section of the resource body
+func appendResourceSection(id core.EntityID, section, item string) error {
+	if strings.TrimSpace(item) == "" {
+		return fmt.Errorf("%s entry cannot be empty", strings.ToLower(section))
+	}
+
+	resource, err := resourceRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
+	}
+
+	resource.Body = core.AppendSectionItem(resource.Body, section, item)
+	resource.Touch()
+
+	if err := resourceRepo.Update(resource); err != nil {
+		return fmt.Errorf("failed to update resource: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Added to %s of resource %s: %s", section, resource.ID, resource.Title))
+	return nil
+}
+
+// formatLogEntry prefixes a log entry with its date
+func formatLogEntry(date time.Time, text string) string {
+	return date.Format("2006-01-02") + ": " + strings.TrimSpace(text)
+}
+
+// formatLink renders a link as a markdown link when it has a title
+func formatLink(url, title string) string {
+	url = strings.TrimSpace(url)
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return url
+	}
+	return "[" + title + "](" + url + ")"
+}
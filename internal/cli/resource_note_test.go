@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLogEntry(t *testing.T) {
+	date := time.Date(2025, 3, 9, 18, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2025-03-09: Finished part 2", formatLogEntry(date, " Finished part 2 "))
+}
+
+func TestFormatLink(t *testing.T) {
+	assert.Equal(t, "https://go.dev", formatLink("https://go.dev", ""))
+	assert.Equal(t, "[Go blog](https://go.dev/blog)", formatLink("https://go.dev/blog", "Go blog"))
+}
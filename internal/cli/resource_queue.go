@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resourceQueueLimit int
+	resourceQueueStart bool
+)
+
+var resourceQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Show not-started resources in priority order",
+	Long: `Rank not-started resources by what to pick up next.
+
+Resources are ordered by:
+  1. Priority of the active goal whose learning path includes them
+  2. Order of the phase they belong to
+  3. Estimated hours (shorter first, unestimated last)
+
+Resources not linked to any active goal come last.
+
+Examples:
+  growth resource queue
+  growth resource queue --skill-id skill-001 --limit 5
+  growth resource queue next --start`,
+	RunE: runResourceQueue,
+}
+
+var resourceQueueNextCmd = &cobra.Command{
+	Use:   "next",
+	Short: "Suggest the next resource to start",
+	Long: `Suggest the single best resource to start next and offer to start it.
+
+Examples:
+  growth resource queue next
+  growth resource queue next --skill-id skill-002
+  growth resource queue next --start`,
+	RunE: runResourceQueueNext,
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceQueueCmd)
+	resourceQueueCmd.AddCommand(resourceQueueNextCmd)
+
+	resourceQueueCmd.PersistentFlags().StringVar(&resourceSkillID, "skill-id", "", "only include resources for this skill")
+	resourceQueueCmd.Flags().IntVarP(&resourceQueueLimit, "limit", "n", 0, "maximum number of resources to show")
+	resourceQueueNextCmd.Flags().BoolVar(&resourceQueueStart, "start", false, "start the suggested resource without prompting")
+}
+
+func runResourceQueue(cmd *cobra.Command, args []string) error {
+	entries, err := buildResourceQueue()
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		PrintInfo("No not-started resources in the queue")
+		return nil
+	}
+
+	if resourceQueueLimit > 0 && len(entries) > resourceQueueLimit {
+		entries = entries[:resourceQueueLimit]
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("%-3s  %-14s  %-40s  %-8s  %-7s  %s\n", "#", "ID", "TITLE", "PRIORITY", "HOURS", "VIA")
+		for i, entry := range entries {
+			priority, via := "-", ""
+			if entry.Link != nil {
+				priority = string(entry.Link.Priority)
+				via = fmt.Sprintf("%s / %s (phase %d)", entry.Link.GoalID, entry.Link.PathID, entry.Link.PhaseOrder)
+			}
+			hours := "-"
+			if entry.Resource.EstimatedHours > 0 {
+				hours = fmt.Sprintf("%sh", formatNumber(entry.Resource.EstimatedHours, 1))
+			}
+			fmt.Printf("%-3d  %-14s  %-40s  %-8s  %-7s  %s\n", i+1, entry.Resource.ID, truncate(entry.Resource.Title, 40), priority, hours, via)
+		}
+		return nil
+	}
+
+	resources := make([]*core.Resource, len(entries))
+	for i, entry := range entries {
+		resources[i] = entry.Resource
+	}
+	return PrintOutputWithConfig(resources)
+}
+
+func runResourceQueueNext(cmd *cobra.Command, args []string) error {
+	entries, err := buildResourceQueue()
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		PrintInfo("No not-started resources in the queue")
+		return nil
+	}
+
+	next := entries[0]
+	resource := next.Resource
+
+	fmt.Printf("Next up: %s  %s\n", resource.ID, resource.Title)
+	fmt.Printf("  Type:  %s\n", resource.Type)
+	fmt.Printf("  Skill: %s\n", resource.SkillID)
+	if resource.EstimatedHours > 0 {
+		fmt.Printf("  Hours: %s\n", formatNumber(resource.EstimatedHours, 1))
+	}
+	if next.Link != nil {
+		fmt.Printf("  Why:   %s priority goal %s, phase %d of %s\n", next.Link.Priority, next.Link.GoalID, next.Link.PhaseOrder, next.Link.PathID)
+	} else {
+		fmt.Printf("  Why:   not linked to an active goal's learning path\n")
+	}
+	if resource.URL != "" {
+		fmt.Printf("  URL:   %s\n", resource.URL)
+	}
+	fmt.Println()
+
+	if !resourceQueueStart && !PromptConfirm("Start it now?") {
+		return nil
+	}
+
+	withBody, err := resourceRepo.GetByIDWithBody(resource.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load resource: %w", err)
+	}
+	withBody.Start()
+
+	if err := resourceRepo.Update(withBody); err != nil {
+		return fmt.Errorf("failed to update resource: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Started resource %s: %s", withBody.ID, withBody.Title))
+	return nil
+}
+
+// buildResourceQueue ranks not-started resources, optionally limited to --skill-id.
+func buildResourceQueue() ([]core.QueueEntry, error) {
+	var resources []*core.Resource
+	var err error
+
+	if resourceSkillID != "" {
+		resources, err = resourceRepo.FindBySkillID(core.EntityID(resourceSkillID))
+	} else {
+		resources, err = resourceRepo.GetAll()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+
+	links, err := resourceGoalLinks()
+	if err != nil {
+		return nil, err
+	}
+
+	return core.RankResourceQueue(resources, links), nil
+}
+
+// resourceGoalLinks maps each resource to the best active goal reaching it through
+// an active learning path's phases.
+func resourceGoalLinks() (map[core.EntityID]*core.ResourceLink, error) {
+	goals, err := goalRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve goals: %w", err)
+	}
+
+	links := make(map[core.EntityID]*core.ResourceLink)
+	for _, goal := range goals {
+		if goal.Status != core.StatusActive {
+			continue
+		}
+
+		for _, pathID := range goal.LearningPaths {
+			path, err := pathRepo.GetByID(pathID)
+			if err != nil || path.Status != core.StatusActive {
+				continue
+			}
+
+			phases, err := phaseRepo.FindByPathID(pathID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to retrieve phases: %w", err)
+			}
+
+			for _, phase := range phases {
+				link := &core.ResourceLink{
+					GoalID:     goal.ID,
+					Priority:   goal.Priority,
+					PathID:     pathID,
+					PhaseID:    phase.ID,
+					PhaseOrder: phase.Order,
+				}
+				for _, resourceID := range phase.Resources {
+					if core.BetterLink(link, links[resourceID]) {
+						links[resourceID] = link
+					}
+				}
+			}
+		}
+	}
+
+	return links, nil
+}
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// triageAction is a status change chosen during triage
+type triageAction string
+
+// triageChange is a pending action for one resource
+type triageChange struct {
+	action triageAction
+	reason string
+}
+
+const (
+	triageComplete     triageAction = "complete"
+	triageStart        triageAction = "start"
+	triageDeprioritize triageAction = "deprioritize"
+	triageAbandon      triageAction = "abandon"
+	triageReset        triageAction = "reset"
+	triageKeep         triageAction = "keep"
+)
+
+var triageActionKeys = map[string]triageAction{
+	"c": triageComplete, "complete": triageComplete, "done": triageComplete,
+	"s": triageStart, "start": triageStart,
+	"d": triageDeprioritize, "deprioritize": triageDeprioritize, "later": triageDeprioritize,
+	"a": triageAbandon, "abandon": triageAbandon, "drop": triageAbandon,
+	"r": triageReset, "reset": triageReset,
+	"k": triageKeep, "keep": triageKeep, "undo": triageKeep,
+}
+
+var resourceTriageCmd = &cobra.Command{
+	Use:   "triage",
+	Short: "Review open resources and change their status in bulk",
+	Long: `Review in-progress and not-started resources as a checklist and change
+many statuses in one session.
+
+Enter an action followed by item numbers, one command per line:
+  c 1,3      mark items 1 and 3 completed
+  s 4        start item 4
+  d 2,5-7    deprioritize items (back to not-started, sorted last in the queue)
+  a 4 stale  abandon item 4, recording "stale" as the reason
+  r 8        reset to not-started and clear deprioritized
+  k 3        keep item 3 as it is (undo a pending change)
+
+Press Enter on an empty line to review the changes and apply them.
+
+Examples:
+  growth resource triage
+  growth resource triage --skill-id skill-001`,
+	RunE: runResourceTriage,
+}
+
+func init() {
+	resourceCmd.AddCommand(resourceTriageCmd)
+
+	resourceTriageCmd.Flags().StringVar(&resourceSkillID, "skill-id", "", "only triage resources for this skill")
+}
+
+func runResourceTriage(cmd *cobra.Command, args []string) error {
+	var resources []*core.Resource
+	var err error
+
+	if resourceSkillID != "" {
+		resources, err = resourceRepo.FindBySkillID(core.EntityID(resourceSkillID))
+	} else {
+		resources, err = resourceRepo.GetAll()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+
+	var open []*core.Resource
+	for _, resource := range resources {
+		if resource.Status == core.ResourceInProgress || resource.Status == core.ResourceNotStarted {
+			open = append(open, resource)
+		}
+	}
+
+	if len(open) == 0 {
+		PrintInfo("No open resources to triage")
+		return nil
+	}
+
+	sort.SliceStable(open, func(i, j int) bool {
+		if open[i].Status != open[j].Status {
+			return open[i].Status == core.ResourceInProgress
+		}
+		return open[i].ID < open[j].ID
+	})
+
+	pending := make(map[int]triageChange)
+
+	printTriageChecklist(open, pending)
+	fmt.Println("\nCommands: c=complete s=start d=deprioritize a=abandon r=reset k=keep, followed by item numbers (e.g. \"c 1,3-4\").")
+	fmt.Println("Enter an empty line when done.")
+
+	for {
+		line := PromptString("triage", "")
+		if line == "" {
+			break
+		}
+
+		if line == "?" || line == "l" || line == "list" {
+			printTriageChecklist(open, pending)
+			continue
+		}
+
+		action, indexes, reason, err := parseTriageCommand(line, len(open))
+		if err != nil {
+			PrintWarning(err.Error())
+			continue
+		}
+
+		for _, i := range indexes {
+			if action == triageKeep {
+				delete(pending, i)
+			} else {
+				pending[i] = triageChange{action: action, reason: reason}
+			}
+		}
+		fmt.Printf("  %d pending change(s)\n", len(pending))
+	}
+
+	if len(pending) == 0 {
+		PrintInfo("No changes")
+		return nil
+	}
+
+	fmt.Println("\nPending changes:")
+	indexes := make([]int, 0, len(pending))
+	for i := range pending {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		fmt.Printf("  %-14s  %-40s  %s -> %s\n", open[i].ID, truncate(open[i].Title, 40), open[i].Status, pending[i].action)
+	}
+	fmt.Println()
+
+	if !PromptConfirm(fmt.Sprintf("Apply %d change(s)?", len(pending))) {
+		PrintInfo("Triage cancelled")
+		return nil
+	}
+
+	applied := 0
+	for _, i := range indexes {
+		resource, err := resourceRepo.GetByIDWithBody(open[i].ID)
+		if err != nil {
+			PrintWarning(fmt.Sprintf("Skipping %s: %v", open[i].ID, err))
+			continue
+		}
+
+		switch pending[i].action {
+		case triageComplete:
+			resource.Complete()
+		case triageStart:
+			resource.Reprioritize()
+			resource.Start()
+		case triageDeprioritize:
+			resource.Deprioritize()
+		case triageAbandon:
+			resource.Reprioritize()
+			resource.Abandon(pending[i].reason)
+		case triageReset:
+			resource.Reprioritize()
+			resource.UpdateStatus(core.ResourceNotStarted)
+		}
+
+		if err := resourceRepo.Update(resource); err != nil {
+			return fmt.Errorf("failed to update resource %s: %w", resource.ID, err)
+		}
+		applied++
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated %d resources", applied))
+	return nil
+}
+
+func printTriageChecklist(resources []*core.Resource, pending map[int]triageChange) {
+	for i, resource := range resources {
+		mark := " "
+		if _, ok := pending[i]; ok {
+			mark = "x"
+		}
+		status := string(resource.Status)
+		if resource.IsDeprioritized() {
+			status += ", deprioritized"
+		}
+		line := fmt.Sprintf("[%s] %2d. %-14s  %-40s  (%s)", mark, i+1, resource.ID, truncate(resource.Title, 40), status)
+		if change, ok := pending[i]; ok {
+			line += fmt.Sprintf(" -> %s", change.action)
+		}
+		fmt.Println(line)
+	}
+}
+
+// parseTriageCommand parses a line like "c 1,3-4" or "a 2 too outdated" into an
+// action, zero-based indexes, and any trailing reason text.
+func parseTriageCommand(line string, n int) (triageAction, []int, string, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", nil, "", fmt.Errorf("empty command")
+	}
+
+	action, ok := triageActionKeys[strings.ToLower(fields[0])]
+	if !ok {
+		return "", nil, "", fmt.Errorf("unknown action '%s'. Use c, s, d, a, r, or k", fields[0])
+	}
+
+	if len(fields) < 2 {
+		return "", nil, "", fmt.Errorf("no item numbers given")
+	}
+
+	indexes := parseSelection(fields[1], n)
+	if len(indexes) == 0 {
+		return "", nil, "", fmt.Errorf("no valid item numbers in '%s'", fields[1])
+	}
+
+	return action, indexes, strings.Join(fields[2:], " "), nil
+}
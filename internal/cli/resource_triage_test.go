@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTriageCommand(t *testing.T) {
+	t.Run("parses action and items", func(t *testing.T) {
+		action, indexes, reason, err := parseTriageCommand("c 1,3-4", 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, triageComplete, action)
+		assert.Equal(t, []int{0, 2, 3}, indexes)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("keeps trailing text as reason", func(t *testing.T) {
+		action, indexes, reason, err := parseTriageCommand("a 2 too   outdated", 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, triageAbandon, action)
+		assert.Equal(t, []int{1}, indexes)
+		assert.Equal(t, "too outdated", reason)
+	})
+
+	t.Run("accepts long action names", func(t *testing.T) {
+		action, _, _, err := parseTriageCommand("deprioritize 2", 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, triageDeprioritize, action)
+	})
+
+	t.Run("fails on unknown action", func(t *testing.T) {
+		_, _, _, err := parseTriageCommand("x 1", 5)
+		assert.Error(t, err)
+	})
+
+	t.Run("fails without valid items", func(t *testing.T) {
+		_, _, _, err := parseTriageCommand("c 9", 5)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var reviewMastered string
+
+var reviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Schedule spaced reviews of mastered skills",
+	Long: `Schedule spaced reviews of mastered skills, so they stay mastered.
+
+Reviews are skill-level milestones due 1 week, 1 month and 3 months after
+a skill was mastered. Due reviews show up in 'growth today'; mark one done
+with 'growth milestone achieve'.`,
+}
+
+var reviewScheduleCmd = &cobra.Command{
+	Use:   "schedule <skill-id>",
+	Short: "Create review milestones for a mastered skill",
+	Long: `Create review milestones due 1 week, 1 month and 3 months after a skill
+was mastered. The mastery date is when the skill's status was last set to
+mastered, or --mastered. Reviews that already exist are left alone, so the
+command is safe to run again.
+
+Examples:
+  growth review schedule skill-001
+  growth review schedule skill-001 --mastered 2025-06-30`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReviewSchedule,
+}
+
+var reviewDueCmd = &cobra.Command{
+	Use:   "due",
+	Short: "List reviews that are due",
+	RunE:  runReviewDue,
+}
+
+func init() {
+	rootCmd.AddCommand(reviewCmd)
+	reviewCmd.AddCommand(reviewScheduleCmd)
+	reviewCmd.AddCommand(reviewDueCmd)
+
+	reviewScheduleCmd.Flags().StringVar(&reviewMastered, "mastered", "", "mastery date (YYYY-MM-DD) - defaults to when the skill was marked mastered")
+}
+
+func runReviewSchedule(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	skill, err := skillRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+	}
+
+	mastered := skill.MasteredAt()
+	if reviewMastered != "" {
+		mastered, err = time.ParseInLocation("2006-01-02", reviewMastered, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+	} else if skill.Status != core.SkillMastered {
+		return fmt.Errorf("skill '%s' is not mastered yet. Mark it with 'growth skill edit %s --status mastered' or pass --mastered", id, id)
+	}
+
+	existing, err := milestoneRepo.FindByReferenceID(core.ReferenceSkill, id)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve milestones: %w", err)
+	}
+	scheduled := make(map[core.ReviewInterval]bool)
+	for _, m := range existing {
+		if m.IsReview() {
+			scheduled[m.ReviewInterval] = true
+		}
+	}
+
+	created := 0
+	for _, interval := range core.ReviewIntervals {
+		if scheduled[interval] {
+			continue
+		}
+
+		milestoneID, err := GenerateNextID("milestone")
+		if err != nil {
+			return fmt.Errorf("failed to generate milestone ID: %w", err)
+		}
+
+		milestone, err := core.NewReviewMilestone(milestoneID, skill, interval, mastered)
+		if err != nil {
+			return fmt.Errorf("failed to create review milestone: %w", err)
+		}
+		if err := milestoneRepo.Create(milestone); err != nil {
+			return fmt.Errorf("failed to save milestone: %w", err)
+		}
+
+		PrintSuccess(fmt.Sprintf("Scheduled review %s: %s (due %s)", milestone.ID, milestone.Title, formatDate(*milestone.TargetDate)))
+		created++
+	}
+
+	if created == 0 {
+		PrintInfo(fmt.Sprintf("Reviews of '%s' are already scheduled", skill.Title))
+	}
+
+	return nil
+}
+
+func runReviewDue(cmd *cobra.Command, args []string) error {
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve milestones: %w", err)
+	}
+
+	due := core.DueReviews(milestones, time.Now())
+	if len(due) == 0 {
+		PrintInfo("No reviews due")
+		return nil
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(due)
+	}
+
+	for _, m := range due {
+		fmt.Printf("  %-14s  %s (due %s)\n", m.ID, truncate(m.Title, 50), formatDate(*m.TargetDate))
+	}
+	return nil
+}
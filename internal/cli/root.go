@@ -1,11 +1,21 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/i18n"
+	"github.com/illenko/growth.md/internal/service"
 	"github.com/illenko/growth.md/internal/storage"
+	"github.com/illenko/growth.md/internal/version"
 	"github.com/spf13/cobra"
 )
 
@@ -14,17 +24,39 @@ var (
 	repoPath     string
 	outputFormat string
 	verbose      bool
+	noEmoji      bool
+	timeout      time.Duration
+	aiRecord     string
+	aiReplay     string
+
+	// stopTimeout releases the --timeout deadline once the command is done
+	stopTimeout context.CancelFunc = func() {}
 )
 
 var (
-	config        *storage.Config
-	skillRepo     *storage.SkillRepository
-	goalRepo      *storage.GoalRepository
-	pathRepo      *storage.PathRepository
-	phaseRepo     *storage.PhaseRepository
-	resourceRepo  *storage.ResourceRepository
-	milestoneRepo *storage.MilestoneRepository
-	progressRepo  *storage.ProgressLogRepository
+	config         *storage.Config
+	skillRepo      *storage.SkillRepository
+	goalRepo       *storage.GoalRepository
+	pathRepo       *storage.PathRepository
+	phaseRepo      *storage.PhaseRepository
+	resourceRepo   *storage.ResourceRepository
+	milestoneRepo  *storage.MilestoneRepository
+	progressRepo   *storage.ProgressLogRepository
+	noteRepo       *storage.NoteRepository
+	feedRepo       *storage.FeedRepository
+	objectiveRepo  *storage.ObjectiveRepository
+	snapshotRepo   *storage.SnapshotRepository
+	sessionRepo    *storage.SessionRepository
+	credentialRepo *storage.CredentialRepository
+	outputRepo     *storage.OutputRepository
+	mentorRepo     *storage.MentorSessionRepository
+	feedbackRepo   *storage.FeedbackRepository
+	eventLog       *events.Log
+
+	// links keeps Skill.Resources in step with Resource.SkillID
+	links *service.LinkService
+	// aiService builds AI requests from the repositories and saves the results
+	aiService *service.AIService
 )
 
 var rootCmd = &cobra.Command{
@@ -34,15 +66,38 @@ var rootCmd = &cobra.Command{
 
 All your career development data is stored as human-readable Markdown files with
 YAML frontmatter, versioned with Git for full history and portability.`,
-	Version: "0.1.0-alpha",
+	Version: version.Version,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		return initializeApp()
+		if timeout > 0 {
+			var ctx context.Context
+			ctx, stopTimeout = context.WithTimeout(cmd.Context(), timeout)
+			cmd.SetContext(ctx)
+		}
+		return initializeApp(cmd.Context())
 	},
 	SilenceUsage: true,
 }
 
 func Execute() error {
-	return rootCmd.Execute()
+	args := os.Args[1:]
+	expanded, err := expandAliases(args, loadAliases(args), isBuiltinCommand)
+	if err != nil {
+		return err
+	}
+	rootCmd.SetArgs(expanded)
+
+	// Ctrl+C cancels the command's context so it can stop between writes and
+	// clean up; a second Ctrl+C exits immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	defer func() { stopTimeout() }()
+
+	return rootCmd.ExecuteContext(ctx)
 }
 
 func init() {
@@ -50,47 +105,178 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&repoPath, "repo", "", "growth repository path (default: current directory)")
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "format", "f", "table", "output format: table, json, yaml")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "print plain ASCII instead of emoji and unicode symbols")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "stop the command after this long, e.g. 30s or 2m (default: no limit)")
+	rootCmd.PersistentFlags().StringVar(&aiRecord, "ai-record", "", "save AI prompts and responses as fixtures in this directory")
+	rootCmd.PersistentFlags().StringVar(&aiReplay, "ai-replay", "", "answer AI requests with the fixtures recorded in this directory, without calling the provider")
+	rootCmd.MarkFlagsMutuallyExclusive("ai-record", "ai-replay")
 }
 
-func initializeApp() error {
-	if repoPath == "" {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("failed to get current directory: %w", err)
-		}
-		repoPath = cwd
-	}
-
-	if cfgFile == "" {
-		cfgFile = filepath.Join(repoPath, ".growth", "config.yml")
+func initializeApp(ctx context.Context) error {
+	if err := resolvePaths(); err != nil {
+		return err
 	}
 
 	if _, err := os.Stat(cfgFile); err == nil {
 		loadedConfig, err := storage.LoadConfig(cfgFile)
 		if err != nil {
-			if verbose {
-				fmt.Fprintf(os.Stderr, "Warning: Failed to load config: %v\n", err)
-			}
+			fmt.Fprintf(os.Stderr, "Warning: Failed to load config, using defaults: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Run 'growth config validate' for details\n")
 			config = storage.DefaultConfig()
 		} else {
 			config = loadedConfig
+			if verbose {
+				if _, warnings, err := storage.CheckConfigFile(cfgFile); err == nil {
+					for _, warning := range warnings {
+						fmt.Fprintf(os.Stderr, "Warning: config %v\n", warning)
+					}
+				}
+			}
 		}
 	} else {
 		config = storage.DefaultConfig()
 	}
 
+	if !version.IsSupported(config.Version) {
+		fmt.Fprintf(os.Stderr, "Warning: this repository uses schema version %s, but growth %s supports up to %s.\n",
+			config.Version, version.Version, version.SchemaVersion)
+		fmt.Fprintf(os.Stderr, "Upgrade growth before making changes to avoid losing data.\n")
+	}
+
 	if outputFormat != "" {
 		config.Display.OutputFormat = outputFormat
 	}
+	i18n.SetLanguage(config.User.Language)
+	glyph.SetASCII(noEmoji || !currentTheme().emoji || glyph.DetectASCII(os.Getenv))
+	if day, ok := core.ParseWeekday(config.Progress.WeekStartDay); ok {
+		core.SetWeekStart(day)
+	}
 
-	if err := initializeRepositories(); err != nil {
+	if err := initializeRepositories(ctx); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func initializeRepositories() error {
+// resolvePaths defaults the repository path to the current directory and the
+// config file to .growth/config.yml within it
+func resolvePaths() error {
+	if repoPath == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		repoPath = cwd
+	}
+
+	if cfgFile == "" {
+		cfgFile = filepath.Join(repoPath, ".growth", "config.yml")
+	}
+
+	return nil
+}
+
+func initializeRepositories(ctx context.Context) error {
+	var err error
+	if config.Storage.Backend == "sqlite" {
+		err = openSQLiteRepositories()
+	} else {
+		err = openFilesystemRepositories()
+	}
+	if err != nil {
+		return err
+	}
+	links = service.NewLinkService(skillRepo, resourceRepo)
+	aiService = service.NewAIService(config, service.Repositories{
+		Skills:     skillRepo,
+		Goals:      goalRepo,
+		Paths:      pathRepo,
+		Phases:     phaseRepo,
+		Resources:  resourceRepo,
+		Milestones: milestoneRepo,
+		Progress:   progressRepo,
+		Notes:      noteRepo,
+		Feedback:   feedbackRepo,
+	}, links, GenerateNextID)
+	aiService.OnFallback = func(failed string, err error, next string) {
+		aboveSpinner(func() {
+			PrintWarning(fmt.Sprintf("%s failed (%s), retrying with %s", failed, diagnoseAIError(err), next))
+		})
+	}
+	aiService.OnWarning = PrintWarning
+	aiService.RecordDir = aiRecord
+	aiService.ReplayDir = aiReplay
+
+	// Set config on all repositories for git integration
+	skillRepo.SetConfig(config)
+	goalRepo.SetConfig(config)
+	pathRepo.SetConfig(config)
+	phaseRepo.SetConfig(config)
+	resourceRepo.SetConfig(config)
+	milestoneRepo.SetConfig(config)
+	progressRepo.SetConfig(config)
+	noteRepo.SetConfig(config)
+	feedRepo.SetConfig(config)
+	objectiveRepo.SetConfig(config)
+	snapshotRepo.SetConfig(config)
+	sessionRepo.SetConfig(config)
+	credentialRepo.SetConfig(config)
+	outputRepo.SetConfig(config)
+	mentorRepo.SetConfig(config)
+	feedbackRepo.SetConfig(config)
+
+	// Stop git auto-commits when the command is interrupted
+	skillRepo.SetContext(ctx)
+	goalRepo.SetContext(ctx)
+	pathRepo.SetContext(ctx)
+	phaseRepo.SetContext(ctx)
+	resourceRepo.SetContext(ctx)
+	milestoneRepo.SetContext(ctx)
+	progressRepo.SetContext(ctx)
+	noteRepo.SetContext(ctx)
+	feedRepo.SetContext(ctx)
+	objectiveRepo.SetContext(ctx)
+	snapshotRepo.SetContext(ctx)
+	sessionRepo.SetContext(ctx)
+	credentialRepo.SetContext(ctx)
+	outputRepo.SetContext(ctx)
+	mentorRepo.SetContext(ctx)
+	feedbackRepo.SetContext(ctx)
+
+	// Record domain events for the activity feed
+	eventLog = events.NewLog(filepath.Join(repoPath, ".growth", "events.jsonl"))
+	skillRepo.SetEventLog(eventLog)
+	goalRepo.SetEventLog(eventLog)
+	pathRepo.SetEventLog(eventLog)
+	phaseRepo.SetEventLog(eventLog)
+	resourceRepo.SetEventLog(eventLog)
+	milestoneRepo.SetEventLog(eventLog)
+	progressRepo.SetEventLog(eventLog)
+	noteRepo.SetEventLog(eventLog)
+	feedRepo.SetEventLog(eventLog)
+	objectiveRepo.SetEventLog(eventLog)
+	snapshotRepo.SetEventLog(eventLog)
+	sessionRepo.SetEventLog(eventLog)
+	credentialRepo.SetEventLog(eventLog)
+	outputRepo.SetEventLog(eventLog)
+	mentorRepo.SetEventLog(eventLog)
+	feedbackRepo.SetEventLog(eventLog)
+
+	// Cache parsed metadata of the entities overview and stats read in bulk
+	cacheDir := filepath.Join(repoPath, ".growth", "cache")
+	skillRepo.SetCacheDir(cacheDir)
+	goalRepo.SetCacheDir(cacheDir)
+	pathRepo.SetCacheDir(cacheDir)
+	resourceRepo.SetCacheDir(cacheDir)
+	milestoneRepo.SetCacheDir(cacheDir)
+	progressRepo.SetCacheDir(cacheDir)
+
+	return nil
+}
+
+// openFilesystemRepositories stores entities as markdown files in the repository
+func openFilesystemRepositories() error {
 	skillsPath := filepath.Join(repoPath, "skills")
 	goalsPath := filepath.Join(repoPath, "goals")
 	pathsPath := filepath.Join(repoPath, "paths")
@@ -98,6 +284,15 @@ func initializeRepositories() error {
 	resourcesPath := filepath.Join(repoPath, "resources")
 	milestonesPath := filepath.Join(repoPath, "milestones")
 	progressPath := filepath.Join(repoPath, "progress")
+	notesPath := filepath.Join(repoPath, "notes")
+	feedsPath := filepath.Join(repoPath, "feeds")
+	objectivesPath := filepath.Join(repoPath, "objectives")
+	snapshotsPath := filepath.Join(repoPath, "snapshots")
+	sessionsPath := filepath.Join(repoPath, "sessions")
+	credentialsPath := filepath.Join(repoPath, "credentials")
+	outputsPath := filepath.Join(repoPath, "outputs")
+	mentoringPath := filepath.Join(repoPath, "mentoring")
+	feedbackPath := filepath.Join(repoPath, "feedback")
 
 	var err error
 
@@ -136,14 +331,50 @@ func initializeRepositories() error {
 		return fmt.Errorf("failed to initialize progress repository: %w", err)
 	}
 
-	// Set config on all repositories for git integration
-	skillRepo.SetConfig(config)
-	goalRepo.SetConfig(config)
-	pathRepo.SetConfig(config)
-	phaseRepo.SetConfig(config)
-	resourceRepo.SetConfig(config)
-	milestoneRepo.SetConfig(config)
-	progressRepo.SetConfig(config)
+	noteRepo, err = storage.NewNoteRepository(notesPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize note repository: %w", err)
+	}
+
+	feedRepo, err = storage.NewFeedRepository(feedsPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize feed repository: %w", err)
+	}
+
+	objectiveRepo, err = storage.NewObjectiveRepository(objectivesPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize objective repository: %w", err)
+	}
+
+	snapshotRepo, err = storage.NewSnapshotRepository(snapshotsPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize snapshot repository: %w", err)
+	}
+
+	sessionRepo, err = storage.NewSessionRepository(sessionsPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize session repository: %w", err)
+	}
+
+	credentialRepo, err = storage.NewCredentialRepository(credentialsPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize credential repository: %w", err)
+	}
+
+	outputRepo, err = storage.NewOutputRepository(outputsPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize output repository: %w", err)
+	}
+
+	mentorRepo, err = storage.NewMentorSessionRepository(mentoringPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize mentor session repository: %w", err)
+	}
+
+	feedbackRepo, err = storage.NewFeedbackRepository(feedbackPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize feedback repository: %w", err)
+	}
 
 	return nil
 }
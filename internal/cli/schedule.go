@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// projectPathSchedule loads the phases and resources of a path and projects its schedule from today
+func projectPathSchedule(path *core.LearningPath) (*core.ScheduleProjection, error) {
+	return projectPathScheduleWithHours(path, path.HoursPerWeek)
+}
+
+// projectPathScheduleWithHours projects a path schedule using the given weekly commitment
+func projectPathScheduleWithHours(path *core.LearningPath, hoursPerWeek float64) (*core.ScheduleProjection, error) {
+	phases, err := phaseRepo.FindByPathID(path.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make(map[core.EntityID]*core.Resource)
+	for _, phase := range phases {
+		for _, resourceID := range phase.Resources {
+			if _, ok := resources[resourceID]; ok {
+				continue
+			}
+			resource, err := resourceRepo.GetByID(resourceID)
+			if err != nil {
+				continue
+			}
+			resources[resourceID] = resource
+		}
+	}
+
+	return core.ProjectScheduleWithBias(phases, resources, hoursPerWeek, estimateBias(), time.Now()), nil
+}
+
+// loadCalibration compares the estimates of all finished resources and phases
+// with their actual effort
+func loadCalibration() (*core.Calibration, error) {
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resources: %w", err)
+	}
+	phases, err := phaseRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load phases: %w", err)
+	}
+	return core.ComputeCalibration(core.EstimateSamples(resources, phases)), nil
+}
+
+// estimateBias returns the factor schedules scale estimates by, 1 when
+// progress.ignoreEstimateBias is set or the bias cannot be worked out
+func estimateBias() float64 {
+	if config.Progress.IgnoreEstimateBias {
+		return 1
+	}
+	calibration, err := loadCalibration()
+	if err != nil {
+		return 1
+	}
+	return calibration.Bias()
+}
+
+// describeBias explains a bias factor applied to a schedule, or returns ""
+// when estimates were used as they are
+func describeBias(bias float64) string {
+	if bias == 1 {
+		return ""
+	}
+	return fmt.Sprintf("estimates scaled by %s from your track record, see 'growth stats calibration'", formatFactor(bias))
+}
+
+// formatFactor formats a bias factor like 1.30x
+func formatFactor(factor float64) string {
+	return formatNumber(factor, 2) + "x"
+}
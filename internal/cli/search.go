@@ -14,7 +14,8 @@ var (
 var searchCmd = &cobra.Command{
 	Use:   "search <query>",
 	Short: "Search across all entities",
-	Long: `Search for skills, goals, resources, paths, milestones, and progress logs.
+	Long: `Search for skills, goals, resources, paths, milestones, progress logs, notes,
+sessions, and outputs.
 
 The search looks through titles, descriptions, tags, and other text fields.
 
@@ -30,7 +31,7 @@ Examples:
 func init() {
 	rootCmd.AddCommand(searchCmd)
 
-	searchCmd.Flags().StringVarP(&searchType, "type", "t", "", "filter by entity type (skill, goal, resource, path, milestone, progress)")
+	searchCmd.Flags().StringVarP(&searchType, "type", "t", "", "filter by entity type (skill, goal, resource, path, milestone, progress, note, session, output)")
 }
 
 func runSearch(cmd *cobra.Command, args []string) error {
@@ -106,7 +107,40 @@ func runSearch(cmd *cobra.Command, args []string) error {
 	if err == nil && len(progressLogs) > 0 {
 		fmt.Printf("Progress Logs (%d):\n", len(progressLogs))
 		for _, log := range progressLogs {
-			fmt.Printf("  %s - %s (%.1f hours)\n", log.ID, log.Date.Format("2006-01-02"), log.HoursInvested)
+			fmt.Printf("  %s - %s (%s hours)\n", log.ID, formatDate(log.Date), formatNumber(log.HoursInvested, 1))
+		}
+		fmt.Println()
+		hasResults = true
+	}
+
+	// Search notes
+	notes, err := noteRepo.Search(query)
+	if err == nil && len(notes) > 0 {
+		fmt.Printf("Notes (%d):\n", len(notes))
+		for _, note := range notes {
+			fmt.Printf("  %s - %s (%s)\n", note.ID, note.Title, formatDate(note.Date))
+		}
+		fmt.Println()
+		hasResults = true
+	}
+
+	// Search sessions
+	sessions, err := sessionRepo.Search(query)
+	if err == nil && len(sessions) > 0 {
+		fmt.Printf("Sessions (%d):\n", len(sessions))
+		for _, session := range sessions {
+			fmt.Printf("  %s - %s (%s)\n", session.ID, session.Title, formatDate(session.Date))
+		}
+		fmt.Println()
+		hasResults = true
+	}
+
+	// Search outputs
+	outputs, err := outputRepo.Search(query)
+	if err == nil && len(outputs) > 0 {
+		fmt.Printf("Outputs (%d):\n", len(outputs))
+		for _, output := range outputs {
+			fmt.Printf("  %s - %s (%s, %s)\n", output.ID, output.Title, output.Type, formatDate(output.Date))
 		}
 		fmt.Println()
 		hasResults = true
@@ -189,7 +223,40 @@ func searchByType(query, entityType string) error {
 		}
 		return PrintOutputWithConfig(progressLogs)
 
+	case "note", "notes":
+		notes, err := noteRepo.Search(query)
+		if err != nil {
+			return fmt.Errorf("search failed: %w\nTry running 'growth note list' to see all notes", err)
+		}
+		if len(notes) == 0 {
+			PrintInfo("No notes found")
+			return nil
+		}
+		return PrintOutputWithConfig(notes)
+
+	case "session", "sessions":
+		sessions, err := sessionRepo.Search(query)
+		if err != nil {
+			return fmt.Errorf("search failed: %w\nTry running 'growth session list' to see all sessions", err)
+		}
+		if len(sessions) == 0 {
+			PrintInfo("No sessions found")
+			return nil
+		}
+		return PrintOutputWithConfig(sessions)
+
+	case "output", "outputs":
+		outputs, err := outputRepo.Search(query)
+		if err != nil {
+			return fmt.Errorf("search failed: %w\nTry running 'growth output list' to see all outputs", err)
+		}
+		if len(outputs) == 0 {
+			PrintInfo("No outputs found")
+			return nil
+		}
+		return PrintOutputWithConfig(outputs)
+
 	default:
-		return fmt.Errorf("unknown entity type '%s'. Valid options: skill, goal, resource, path, milestone, progress", entityType)
+		return fmt.Errorf("unknown entity type '%s'. Valid options: skill, goal, resource, path, milestone, progress, note, session, output", entityType)
 	}
 }
@@ -0,0 +1,432 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sessionTitle     string
+	sessionDate      string
+	sessionHours     float64
+	sessionAttendees string
+	sessionTopics    string
+	sessionSkills    string
+	sessionResources string
+	sessionTags      string
+	sessionNotes     string
+	sessionSkill     string
+	sessionResource  string
+	sessionSince     string
+	sessionLimit     int
+)
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "Track group learning sessions",
+	Long: `Record study group meetings, pairing and other group learning sessions with
+who attended, what was covered and which skills and resources they were about.`,
+}
+
+var sessionCreateCmd = &cobra.Command{
+	Use:   "create [title]",
+	Short: "Record a session",
+	Long: `Record a group learning session.
+
+Examples:
+  growth session create "Go study group" --hours 1.5 --attendees "Ana,Bo" --topics "generics,testing"
+  growth session create "Book club: DDIA ch. 5" --date 2025-03-19 --resources resource-004 --skills skill-002
+  growth session create`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSessionCreate,
+}
+
+var sessionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List sessions",
+	Long: `List sessions, newest first.
+
+Examples:
+  growth session list
+  growth session list --skill skill-002
+  growth session list --since 2025-01-01 --limit 10`,
+	Aliases: []string{"ls"},
+	RunE:    runSessionList,
+}
+
+var sessionViewCmd = &cobra.Command{
+	Use:   "view <id>",
+	Short: "View a session",
+	Long: `View a session with its attendees, topics and notes.
+
+Examples:
+  growth session view session-001
+  growth session view session-001 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionView,
+}
+
+var sessionEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Edit a session",
+	Long: `Edit a session. List flags replace the current values.
+
+Examples:
+  growth session edit session-001 --hours 2
+  growth session edit session-001 --attendees "Ana,Bo,Cy" --topics "generics"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionEdit,
+}
+
+var sessionDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a session",
+	Long: `Delete a session by ID. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth session delete session-001`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runSessionDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(sessionCmd)
+	sessionCmd.AddCommand(sessionCreateCmd)
+	sessionCmd.AddCommand(sessionListCmd)
+	sessionCmd.AddCommand(sessionViewCmd)
+	sessionCmd.AddCommand(sessionEditCmd)
+	sessionCmd.AddCommand(sessionDeleteCmd)
+
+	for _, cmd := range []*cobra.Command{sessionCreateCmd, sessionEditCmd} {
+		cmd.Flags().StringVar(&sessionDate, "date", "", "date of the session (YYYY-MM-DD)")
+		cmd.Flags().Float64Var(&sessionHours, "hours", 0, "length of the session in hours")
+		cmd.Flags().StringVar(&sessionAttendees, "attendees", "", "comma-separated names of the people who attended")
+		cmd.Flags().StringVar(&sessionTopics, "topics", "", "comma-separated topics covered")
+		cmd.Flags().StringVar(&sessionSkills, "skills", "", "comma-separated skill IDs the session was about")
+		cmd.Flags().StringVar(&sessionResources, "resources", "", "comma-separated resource IDs studied")
+		cmd.Flags().StringVar(&sessionTags, "tags", "", "comma-separated tags")
+		cmd.Flags().StringVar(&sessionNotes, "notes", "", "session notes")
+	}
+	sessionEditCmd.Flags().StringVar(&sessionTitle, "title", "", "session title")
+
+	sessionListCmd.Flags().StringVar(&sessionSkill, "skill", "", "filter by skill ID")
+	sessionListCmd.Flags().StringVar(&sessionResource, "resource", "", "filter by resource ID")
+	sessionListCmd.Flags().StringVar(&sessionSince, "since", "", "only show sessions on or after this date (YYYY-MM-DD)")
+	sessionListCmd.Flags().IntVarP(&sessionLimit, "limit", "n", 0, "maximum number of sessions to show")
+}
+
+func runSessionCreate(cmd *cobra.Command, args []string) error {
+	title := ""
+	if len(args) > 0 {
+		title = args[0]
+	} else {
+		title = PromptStringRequired("Title")
+	}
+
+	date := time.Now()
+	if sessionDate != "" {
+		parsed, err := time.Parse("2006-01-02", sessionDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		date = parsed
+	}
+
+	skills, resources, err := sessionReferences()
+	if err != nil {
+		return err
+	}
+
+	id, err := GenerateNextID("session")
+	if err != nil {
+		return fmt.Errorf("failed to generate session ID: %w", err)
+	}
+
+	session, err := core.NewSession(id, title, date)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	if err := session.SetHours(sessionHours); err != nil {
+		return fmt.Errorf("failed to set hours: %w", err)
+	}
+	for _, name := range splitList(sessionAttendees) {
+		session.AddAttendee(name)
+	}
+	for _, topic := range splitList(sessionTopics) {
+		session.AddTopic(topic)
+	}
+	for _, skillID := range skills {
+		session.AddSkill(skillID)
+	}
+	for _, resourceID := range resources {
+		session.AddResource(resourceID)
+	}
+	for _, tag := range splitList(sessionTags) {
+		session.AddTag(tag)
+	}
+
+	if sessionNotes != "" {
+		session.Body = sessionNotes
+	} else if len(args) == 0 {
+		session.Body = PromptMultiline("Notes (optional, press Ctrl+D or enter '.' to finish)")
+	}
+
+	if err := sessionRepo.Create(session); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Recorded session %s: %s", session.ID, session.Title))
+	return nil
+}
+
+func runSessionList(cmd *cobra.Command, args []string) error {
+	var sessions []*core.Session
+	var err error
+
+	switch {
+	case sessionSkill != "":
+		sessions, err = sessionRepo.FindByReference(core.EntityID(sessionSkill))
+	case sessionResource != "":
+		sessions, err = sessionRepo.FindByReference(core.EntityID(sessionResource))
+	default:
+		sessions, err = sessionRepo.FindSince(time.Time{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve sessions: %w", err)
+	}
+
+	if sessionSkill != "" && sessionResource != "" {
+		sessions = filterSessions(sessions, func(s *core.Session) bool {
+			return s.HasReference(core.EntityID(sessionResource))
+		})
+	}
+
+	if sessionSince != "" {
+		since, err := time.Parse("2006-01-02", sessionSince)
+		if err != nil {
+			return fmt.Errorf("invalid since date format (use YYYY-MM-DD): %w", err)
+		}
+		sessions = filterSessions(sessions, func(s *core.Session) bool {
+			return !s.Date.Before(since)
+		})
+	}
+
+	if len(sessions) == 0 {
+		PrintInfo("No sessions found")
+		return nil
+	}
+
+	if sessionLimit > 0 && len(sessions) > sessionLimit {
+		sessions = sessions[:sessionLimit]
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, session := range sessions {
+			fmt.Printf("%s  %s  %s", session.ID, formatDate(session.Date), session.Title)
+			if session.Hours > 0 {
+				fmt.Printf("  %sh", formatNumber(session.Hours, 1))
+			}
+			if len(session.Attendees) > 0 {
+				fmt.Printf("  with %s", strings.Join(session.Attendees, ", "))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(sessions)
+}
+
+func runSessionView(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	session, err := sessionRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("session '%s' not found. Use 'growth session list' to see available sessions", id)
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:        %s\n", session.ID)
+		fmt.Printf("Title:     %s\n", session.Title)
+		fmt.Printf("Date:      %s\n", formatDate(session.Date))
+		if session.Hours > 0 {
+			fmt.Printf("Hours:     %s\n", formatNumber(session.Hours, 1))
+		}
+		if len(session.Attendees) > 0 {
+			fmt.Printf("Attendees: %s\n", strings.Join(session.Attendees, ", "))
+		}
+		if len(session.Topics) > 0 {
+			fmt.Printf("Topics:    %s\n", strings.Join(session.Topics, ", "))
+		}
+		if len(session.Skills) > 0 {
+			fmt.Printf("Skills:    %s\n", formatEntityIDs(session.Skills))
+		}
+		if len(session.Resources) > 0 {
+			fmt.Printf("Resources: %s\n", formatEntityIDs(session.Resources))
+		}
+		if len(session.Tags) > 0 {
+			fmt.Printf("Tags:      %s\n", strings.Join(session.Tags, ", "))
+		}
+
+		if session.Body != "" {
+			fmt.Printf("\nNotes:\n%s\n", session.Body)
+		}
+
+		return nil
+	}
+
+	return PrintOutputWithConfig(session)
+}
+
+func runSessionEdit(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	session, err := sessionRepo.GetByIDWithBody(id)
+	if err != nil {
+		return fmt.Errorf("session '%s' not found. Use 'growth session list' to see available sessions", id)
+	}
+
+	skills, resources, err := sessionReferences()
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("title") {
+		session.Title = sessionTitle
+	}
+	if flags.Changed("date") {
+		date, err := time.Parse("2006-01-02", sessionDate)
+		if err != nil {
+			return fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+		}
+		session.Date = date
+	}
+	if flags.Changed("hours") {
+		if err := session.SetHours(sessionHours); err != nil {
+			return fmt.Errorf("failed to set hours: %w", err)
+		}
+	}
+	if flags.Changed("attendees") {
+		session.Attendees = []string{}
+		for _, name := range splitList(sessionAttendees) {
+			session.AddAttendee(name)
+		}
+	}
+	if flags.Changed("topics") {
+		session.Topics = []string{}
+		for _, topic := range splitList(sessionTopics) {
+			session.AddTopic(topic)
+		}
+	}
+	if flags.Changed("skills") {
+		session.Skills = skills
+	}
+	if flags.Changed("resources") {
+		session.Resources = resources
+	}
+	if flags.Changed("tags") {
+		session.Tags = []string{}
+		for _, tag := range splitList(sessionTags) {
+			session.AddTag(tag)
+		}
+	}
+	if flags.Changed("notes") {
+		session.Body = sessionNotes
+	}
+
+	session.Touch()
+	if err := session.Validate(); err != nil {
+		return fmt.Errorf("invalid session: %w", err)
+	}
+
+	if err := sessionRepo.Update(session); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated session %s: %s", session.ID, session.Title))
+	return nil
+}
+
+func runSessionDelete(cmd *cobra.Command, args []string) error {
+	id := core.EntityID(args[0])
+
+	session, err := sessionRepo.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("session '%s' not found. Use 'growth session list' to see available sessions", id)
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", session.ID)
+	fmt.Printf("  Title: %s\n", session.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this session?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := sessionRepo.Delete(id); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted session %s", id))
+	return nil
+}
+
+// sessionReferences parses the --skills and --resources flags, verifying
+// that each referenced entity exists
+func sessionReferences() ([]core.EntityID, []core.EntityID, error) {
+	var skills, resources []core.EntityID
+
+	for _, id := range splitList(sessionSkills) {
+		exists, err := skillRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check skill existence: %w", err)
+		}
+		if !exists {
+			return nil, nil, fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+		}
+		skills = append(skills, core.EntityID(id))
+	}
+
+	for _, id := range splitList(sessionResources) {
+		exists, err := resourceRepo.Exists(core.EntityID(id))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check resource existence: %w", err)
+		}
+		if !exists {
+			return nil, nil, fmt.Errorf("resource '%s' not found. Use 'growth resource list' to see available resources", id)
+		}
+		resources = append(resources, core.EntityID(id))
+	}
+
+	return skills, resources, nil
+}
+
+func filterSessions(sessions []*core.Session, keep func(*core.Session) bool) []*core.Session {
+	var results []*core.Session
+	for _, session := range sessions {
+		if keep(session) {
+			results = append(results, session)
+		}
+	}
+	return results
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
-	"github.com/illenko/growth.md/internal/aifactory"
+	"github.com/illenko/growth.md/internal/ai/gemini"
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/service"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +21,8 @@ var (
 	skillStatus      string
 	skillFilterLevel string
 	skillTitle       string
+	skillTaxonomy    string
+	skillConfidence  int
 
 	// Suggest resources flags
 	skillSuggestTargetLevel string
@@ -27,6 +31,7 @@ var (
 	skillSuggestProvider    string
 	skillSuggestModel       string
 	skillSuggestSave        bool
+	skillSuggestPrintPrompt bool
 )
 
 var skillCmd = &cobra.Command{
@@ -43,9 +48,13 @@ var skillCreateCmd = &cobra.Command{
 You can provide the title as an argument or be prompted for it.
 Optionally specify category, level, and tags using flags.
 
+With --from-taxonomy the title, category and description default to the
+matching entry of the imported taxonomy (see 'growth taxonomy import').
+
 Examples:
   growth skill create "Python Programming" --category backend --level intermediate
   growth skill create "Docker" --tags containers,devops
+  growth skill create --from-taxonomy PROG --level advanced
   growth skill create`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runSkillCreate,
@@ -76,6 +85,7 @@ The output format can be controlled with the --format flag (table, json, yaml).
 
 Examples:
   growth skill view skill-001
+  growth skill view skill-001 --history
   growth skill view skill-042 --format json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSkillView,
@@ -125,7 +135,8 @@ Examples:
   growth skill suggest-resources skill-001
   growth skill suggest-resources skill-001 --target-level advanced
   growth skill suggest-resources skill-001 --budget free --save
-  growth skill suggest-resources skill-001 --style project-based`,
+  growth skill suggest-resources skill-001 --style project-based
+  growth skill suggest-resources skill-001 --print-prompt`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSkillSuggestResources,
 }
@@ -142,6 +153,8 @@ func init() {
 	skillCreateCmd.Flags().StringVarP(&skillCategory, "category", "c", "", "skill category")
 	skillCreateCmd.Flags().StringVarP(&skillLevel, "level", "l", "", "proficiency level (beginner, intermediate, advanced, expert)")
 	skillCreateCmd.Flags().StringVarP(&skillTags, "tags", "t", "", "comma-separated tags")
+	skillCreateCmd.Flags().StringVar(&skillTaxonomy, "from-taxonomy", "", "code or title of a taxonomy skill to base this skill on")
+	skillCreateCmd.Flags().IntVar(&skillConfidence, "confidence", 0, "how confident you feel in the skill, 1 (shaky) to 5 (sure)")
 
 	skillListCmd.Flags().StringVarP(&skillCategory, "category", "c", "", "filter by category")
 	skillListCmd.Flags().StringVarP(&skillFilterLevel, "level", "l", "", "filter by level")
@@ -152,6 +165,7 @@ func init() {
 	skillEditCmd.Flags().StringVarP(&skillLevel, "level", "l", "", "proficiency level")
 	skillEditCmd.Flags().StringVarP(&skillStatus, "status", "s", "", "skill status")
 	skillEditCmd.Flags().StringVarP(&skillTags, "tags", "t", "", "comma-separated tags")
+	skillEditCmd.Flags().IntVar(&skillConfidence, "confidence", 0, "how confident you feel in the skill, 1 (shaky) to 5 (sure)")
 
 	skillSuggestResourcesCmd.Flags().StringVar(&skillSuggestTargetLevel, "target-level", "", "target proficiency level (defaults to next level up)")
 	skillSuggestResourcesCmd.Flags().StringVar(&skillSuggestStyle, "style", "", "learning style (top-down, bottom-up, project-based) - defaults to config")
@@ -159,12 +173,33 @@ func init() {
 	skillSuggestResourcesCmd.Flags().StringVar(&skillSuggestProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
 	skillSuggestResourcesCmd.Flags().StringVar(&skillSuggestModel, "model", "", "model override - defaults to config")
 	skillSuggestResourcesCmd.Flags().BoolVar(&skillSuggestSave, "save", false, "save suggested resources to repository")
+	skillSuggestResourcesCmd.Flags().BoolVar(&skillSuggestPrintPrompt, "print-prompt", false, "print the prompt that would be sent and exit")
+
+	skillViewCmd.Flags().BoolVar(&showHistory, "history", false, "show the history of status, level, and priority changes")
+	skillViewCmd.Flags().StringVar(&openAttachment, "open", "", "open the attachment with this name")
 }
 
 func runSkillCreate(cmd *cobra.Command, args []string) error {
+	var entry *core.TaxonomyEntry
+	if skillTaxonomy != "" {
+		taxonomy, err := loadTaxonomy()
+		if err != nil {
+			return err
+		}
+		entry = taxonomy.Find(skillTaxonomy)
+		if entry == nil {
+			return fmt.Errorf("taxonomy skill '%s' not found. Use 'growth taxonomy list' to see available skills", skillTaxonomy)
+		}
+		if skillCategory == "" {
+			skillCategory = entry.Category
+		}
+	}
+
 	var title string
 	if len(args) > 0 {
 		title = args[0]
+	} else if entry != nil {
+		title = entry.Title
 	} else {
 		title = PromptStringRequired("Skill title")
 	}
@@ -173,6 +208,11 @@ func runSkillCreate(cmd *cobra.Command, args []string) error {
 		skillCategory = PromptStringRequired("Category (e.g., backend, frontend, devops, data)")
 	}
 
+	category, err := checkSkillCategory(skillCategory)
+	if err != nil {
+		return err
+	}
+
 	if skillLevel == "" {
 		skillLevel = PromptSelectWithDefault(
 			"Proficiency level",
@@ -191,7 +231,7 @@ func runSkillCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to generate skill ID: %w", err)
 	}
 
-	skill, err := core.NewSkill(id, title, skillCategory, level)
+	skill, err := core.NewSkill(id, title, category, level)
 	if err != nil {
 		return fmt.Errorf("failed to create skill: %w", err)
 	}
@@ -203,9 +243,20 @@ func runSkillCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	description := PromptMultiline("Description (optional, press Ctrl+D or enter '.' to finish)")
-	if description != "" {
-		skill.Body = description
+	if cmd.Flags().Changed("confidence") {
+		if err := skill.SetConfidence(skillConfidence); err != nil {
+			return err
+		}
+	}
+
+	if entry != nil {
+		skill.TaxonomyCode = entry.Code
+		skill.Body = entry.Description
+	} else {
+		description := PromptMultiline("Description (optional, press Ctrl+D or enter '.' to finish)")
+		if description != "" {
+			skill.Body = description
+		}
 	}
 
 	if err := skillRepo.Create(skill); err != nil {
@@ -276,25 +327,52 @@ func runSkillView(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
 	}
 
+	if openAttachment != "" {
+		return openEntityAttachment(skill.Attachments, openAttachment)
+	}
+
 	if config.Display.OutputFormat == "table" {
 		fmt.Printf("ID:       %s\n", skill.ID)
 		fmt.Printf("Title:    %s\n", skill.Title)
 		fmt.Printf("Category: %s\n", skill.Category)
 		fmt.Printf("Level:    %s\n", skill.Level)
 		fmt.Printf("Status:   %s\n", skill.Status)
+		if skill.Confidence != 0 {
+			fmt.Printf("Confidence: %d/%d\n", skill.Confidence, core.MaxConfidence)
+		}
+		if skill.TaxonomyCode != "" {
+			fmt.Printf("Taxonomy: %s\n", skill.TaxonomyCode)
+		}
 		if len(skill.Tags) > 0 {
 			fmt.Printf("Tags:     %s\n", strings.Join(skill.Tags, ", "))
 		}
 		if len(skill.Resources) > 0 {
-			fmt.Printf("Resources: %v\n", skill.Resources)
+			fmt.Printf("Resources: %s\n", formatEntityIDs(skill.Resources))
+		}
+		if credentials, err := credentialRepo.FindBySkill(skill.ID); err == nil && len(credentials) > 0 {
+			ids := make([]core.EntityID, len(credentials))
+			for i, credential := range credentials {
+				ids[i] = credential.ID
+			}
+			fmt.Printf("Credentials: %s\n", formatEntityIDs(ids))
 		}
-		fmt.Printf("Created:  %s\n", skill.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated:  %s\n", skill.Updated.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Created:  %s\n", formatDateTime(skill.Created))
+		fmt.Printf("Updated:  %s\n", formatDateTime(skill.Updated))
 
 		if skill.Body != "" {
 			fmt.Printf("\nDescription:\n%s\n", skill.Body)
 		}
 
+		if feedback, err := feedbackRepo.FindBySkill(skill.ID); err == nil {
+			printSkillFeedback(feedback)
+		}
+
+		printAttachments(skill.Attachments)
+
+		if showHistory {
+			printHistory(skill.History)
+		}
+
 		return nil
 	}
 
@@ -317,7 +395,11 @@ func runSkillEdit(cmd *cobra.Command, args []string) error {
 	}
 
 	if cmd.Flags().Changed("category") {
-		skill.Category = skillCategory
+		category, err := checkSkillCategory(skillCategory)
+		if err != nil {
+			return err
+		}
+		skill.Category = category
 		updated = true
 	}
 
@@ -354,6 +436,13 @@ func runSkillEdit(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("confidence") {
+		if err := skill.SetConfidence(skillConfidence); err != nil {
+			return err
+		}
+		updated = true
+	}
+
 	if !updated {
 		PrintInfo("No changes specified. Use flags to update fields or run interactively.")
 
@@ -363,7 +452,11 @@ func runSkillEdit(cmd *cobra.Command, args []string) error {
 		}
 
 		if PromptConfirm("Update category?") {
-			skill.Category = PromptString("New category", skill.Category)
+			category, err := checkSkillCategory(PromptString("New category", skill.Category))
+			if err != nil {
+				return err
+			}
+			skill.Category = category
 			updated = true
 		}
 
@@ -393,6 +486,12 @@ func runSkillEdit(cmd *cobra.Command, args []string) error {
 			updated = true
 		}
 
+		if PromptConfirm("Update confidence?") {
+			if promptSkillConfidence(skill) {
+				updated = true
+			}
+		}
+
 		if PromptConfirm("Update description?") {
 			description := PromptMultiline("Description (press Ctrl+D or enter '.' to finish)")
 			skill.Body = description
@@ -443,104 +542,53 @@ func runSkillDelete(cmd *cobra.Command, args []string) error {
 func runSkillSuggestResources(cmd *cobra.Command, args []string) error {
 	skillID := core.EntityID(args[0])
 
-	// Load skill
-	skill, err := skillRepo.GetByIDWithBody(skillID)
+	req, err := aiService.BuildResourceRequest(service.ResourceSuggestionOptions{
+		SkillID:     skillID,
+		TargetLevel: core.ProficiencyLevel(skillSuggestTargetLevel),
+		Style:       skillSuggestStyle,
+		Budget:      skillSuggestBudget,
+	})
 	if err != nil {
-		return fmt.Errorf("skill '%s' not found: %w", skillID, err)
+		return err
 	}
 
-	// Determine current and target levels
-	currentLevel := skill.Level
-	var targetLevel core.ProficiencyLevel
-
-	if skillSuggestTargetLevel != "" {
-		targetLevel = core.ProficiencyLevel(skillSuggestTargetLevel)
-		if !targetLevel.IsValid() {
-			return fmt.Errorf("invalid target level: %s (must be beginner, intermediate, advanced, or expert)", skillSuggestTargetLevel)
-		}
-	} else {
-		// Default to next level up
-		targetLevel = getNextLevel(currentLevel)
-	}
-
-	// Initialize AI client - use config defaults, allow flags to override
-	provider := config.AI.Provider
-	if skillSuggestProvider != "" {
-		provider = skillSuggestProvider
+	if skillSuggestPrintPrompt {
+		return printPrompt(gemini.ResourceSuggestionPrompt, req)
 	}
 
-	model := config.AI.Model
-	if skillSuggestModel != "" {
-		model = skillSuggestModel
-	}
-
-	style := config.AI.DefaultStyle
-	if skillSuggestStyle != "" {
-		style = skillSuggestStyle
-	}
-
-	budget := config.AI.DefaultBudget
-	if skillSuggestBudget != "" {
-		budget = skillSuggestBudget
-	}
-
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: config.AI.Temperature,
-		MaxTokens:   config.AI.MaxTokens,
-	}
-
-	if err := aiConfig.Validate(); err != nil {
-		return fmt.Errorf("AI configuration error: %w", err)
-	}
-
-	client, err := aifactory.NewClient(aiConfig)
+	client, err := aiService.NewClient(skillSuggestProvider, skillSuggestModel)
 	if err != nil {
-		return fmt.Errorf("failed to initialize AI client: %w", err)
+		return err
 	}
 
 	// Show progress
-	fmt.Printf("🤖 Suggesting resources for: %s\n", skill.Title)
-	fmt.Printf("   Current Level: %s\n", currentLevel)
-	fmt.Printf("   Target Level: %s\n", targetLevel)
-	fmt.Printf("   Learning Style: %s\n", style)
-	fmt.Printf("   Budget: %s\n", budget)
+	fmt.Printf("%sSuggesting resources for: %s\n", glyph.Emoji("🤖"), req.Skill.Title)
+	fmt.Printf("   Current Level: %s\n", req.CurrentLevel)
+	fmt.Printf("   Target Level: %s\n", req.TargetLevel)
+	fmt.Printf("   Learning Style: %s\n", req.LearningStyle)
+	fmt.Printf("   Budget: %s\n", req.Budget)
+	if req.BudgetLimit > 0 {
+		fmt.Printf("   Budget Left: %s of %s this year\n", formatNumber(req.RemainingBudget, 0), formatNumber(req.BudgetLimit, 0))
+	}
+	if len(req.Subscriptions) > 0 {
+		fmt.Printf("   Subscriptions: %s\n", strings.Join(req.Subscriptions, ", "))
+	}
 	fmt.Printf("   Provider: %s\n", client.Provider())
 	fmt.Println()
-	fmt.Println("⏳ Finding best resources...")
-
-	// Request resource suggestions
-	req := ai.ResourceSuggestionRequest{
-		Skill:         skill,
-		CurrentLevel:  currentLevel,
-		TargetLevel:   targetLevel,
-		LearningStyle: style,
-		Budget:        budget,
-	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
 	defer cancel()
 
+	progress := startSpinner("Finding the best resources")
 	resp, err := client.SuggestResources(ctx, req)
+	progress.Stop()
 	if err != nil {
 		return fmt.Errorf("failed to suggest resources: %w", err)
 	}
 
 	// Optionally save resources
 	if skillSuggestSave {
-		for _, resource := range resp.Resources {
-			newID, err := GenerateNextID("resource")
-			if err != nil {
-				PrintWarning(fmt.Sprintf("Failed to generate ID for resource: %v", err))
-				continue
-			}
-			resource.ID = newID
-
-			if err := resourceRepo.Create(resource); err != nil {
-				PrintWarning(fmt.Sprintf("Failed to save resource %s: %v", resource.ID, err))
-			}
-		}
+		aiService.SaveSuggestedResources(resp.Resources)
 	}
 
 	// Display suggestions
@@ -549,33 +597,22 @@ func runSkillSuggestResources(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func getNextLevel(current core.ProficiencyLevel) core.ProficiencyLevel {
-	switch current {
-	case core.LevelBeginner:
-		return core.LevelIntermediate
-	case core.LevelIntermediate:
-		return core.LevelAdvanced
-	case core.LevelAdvanced:
-		return core.LevelExpert
-	case core.LevelExpert:
-		return core.LevelExpert // Already at max
-	default:
-		return core.LevelIntermediate
-	}
-}
-
 func displayResourceSuggestions(resp *ai.ResourceSuggestionResponse, saved bool) {
 	fmt.Println()
 	if saved {
-		PrintSuccess(fmt.Sprintf("✨ Found %d resources and saved them to your repository!", len(resp.Resources)))
+		PrintSuccess(fmt.Sprintf("%sFound %d resources and saved them to your repository!", glyph.Emoji("✨"), len(resp.Resources)))
 	} else {
-		PrintSuccess(fmt.Sprintf("✨ Found %d recommended resources!", len(resp.Resources)))
+		PrintSuccess(fmt.Sprintf("%sFound %d recommended resources!", glyph.Emoji("✨"), len(resp.Resources)))
 	}
 	fmt.Println()
 
 	for i, resource := range resp.Resources {
 		fmt.Printf("%d. %s\n", i+1, resource.Title)
-		fmt.Printf("   Type: %s | Estimated Hours: %.1f\n", resource.Type, resource.EstimatedHours)
+		fmt.Printf("   Type: %s | Estimated Hours: %s", resource.Type, formatNumber(resource.EstimatedHours, 1))
+		if resource.Pricing != "" {
+			fmt.Printf(" | Cost: %s", resource.Pricing)
+		}
+		fmt.Println()
 		if resource.Author != "" {
 			fmt.Printf("   Author: %s\n", resource.Author)
 		}
@@ -585,6 +622,9 @@ func displayResourceSuggestions(resp *ai.ResourceSuggestionResponse, saved bool)
 		if resource.Body != "" {
 			fmt.Printf("   %s\n", resource.Body)
 		}
+		if resource.WhyRecommended != "" {
+			fmt.Printf("   Why: %s\n", resource.WhyRecommended)
+		}
 		if saved {
 			fmt.Printf("   ID: %s\n", resource.ID)
 		}
@@ -592,12 +632,12 @@ func displayResourceSuggestions(resp *ai.ResourceSuggestionResponse, saved bool)
 	}
 
 	if resp.Reasoning != "" {
-		fmt.Println("💡 AI Reasoning:")
+		fmt.Println(glyph.Emoji("💡") + "AI Reasoning:")
 		fmt.Printf("   %s\n", resp.Reasoning)
 		fmt.Println()
 	}
 
 	if !saved {
-		fmt.Println("💾 Tip: Use --save flag to save these resources to your repository")
+		fmt.Println(glyph.Emoji("💾") + "Tip: Use --save flag to save these resources to your repository")
 	}
 }
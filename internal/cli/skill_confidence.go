@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var skillConfidenceCmd = &cobra.Command{
+	Use:   "confidence [id...]",
+	Short: "Chart how confident you feel in your skills over time",
+	Long: `Chart the self-reported confidence of skills over time, from the ratings
+set with --confidence on skill create and edit and asked for when a skill
+review is achieved.
+
+Confidence is separate from the proficiency level: it is how sure you feel
+using the skill. Advanced and expert skills with a confidence of 2 or less
+are flagged for reinforcement.
+
+Examples:
+  growth skill confidence
+  growth skill confidence skill-001 skill-004
+  growth skill confidence -f json`,
+	RunE: runSkillConfidence,
+}
+
+func init() {
+	skillCmd.AddCommand(skillConfidenceCmd)
+}
+
+// confidenceRow is the confidence trend of one skill
+type confidenceRow struct {
+	Skill      core.EntityID           `json:"skill" yaml:"skill"`
+	Title      string                  `json:"title" yaml:"title"`
+	Level      core.ProficiencyLevel   `json:"level" yaml:"level"`
+	Confidence int                     `json:"confidence" yaml:"confidence"`
+	Reinforce  bool                    `json:"reinforce" yaml:"reinforce"`
+	Ratings    []core.ConfidenceRating `json:"ratings" yaml:"ratings"`
+}
+
+func runSkillConfidence(cmd *cobra.Command, args []string) error {
+	var skills []*core.Skill
+	if len(args) > 0 {
+		for _, arg := range args {
+			id := core.EntityID(arg)
+			skill, err := skillRepo.GetByID(id)
+			if err != nil {
+				return fmt.Errorf("skill '%s' not found. Use 'growth skill list' to see available skills", id)
+			}
+			skills = append(skills, skill)
+		}
+	} else {
+		all, err := skillRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to load skills: %w", err)
+		}
+		for _, skill := range all {
+			if skill.Confidence != 0 {
+				skills = append(skills, skill)
+			}
+		}
+	}
+
+	var rows []confidenceRow
+	for _, skill := range skills {
+		rows = append(rows, confidenceRow{
+			Skill:      skill.ID,
+			Title:      skill.Title,
+			Level:      skill.Level,
+			Confidence: skill.Confidence,
+			Reinforce:  skill.NeedsReinforcement(),
+			Ratings:    skill.ConfidenceTrend(),
+		})
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(rows)
+	}
+
+	if len(rows) == 0 {
+		PrintInfo("No confidence ratings yet. Rate a skill with 'growth skill edit <id> --confidence 3'")
+		return nil
+	}
+
+	for _, row := range rows {
+		if len(row.Ratings) == 0 {
+			fmt.Printf("  %-12s %-25s %-13s not rated\n", row.Skill, truncate(row.Title, 25), row.Level)
+			continue
+		}
+		since := formatDate(row.Ratings[0].Date)
+		fmt.Printf("  %-12s %-25s %-13s %-12s %d/%d since %s\n", row.Skill, truncate(row.Title, 25), row.Level,
+			confidenceSparkline(row.Ratings, 12), row.Confidence, core.MaxConfidence, since)
+	}
+
+	for _, row := range rows {
+		if row.Reinforce {
+			PrintWarning(fmt.Sprintf("%s is %s but your confidence is %d/%d: reinforce it with practice, a project or 'growth review schedule %s'",
+				row.Title, row.Level, row.Confidence, core.MaxConfidence, row.Skill))
+		}
+	}
+	return nil
+}
+
+// confidenceSparkline draws the last ratings as a bar per rating, or as
+// digits in ASCII mode
+func confidenceSparkline(ratings []core.ConfidenceRating, width int) string {
+	if len(ratings) > width {
+		ratings = ratings[len(ratings)-width:]
+	}
+
+	bars := []string{"▁", "▃", "▄", "▆", "█"}
+	var b strings.Builder
+	for _, rating := range ratings {
+		level := min(max(rating.Confidence, core.MinConfidence), core.MaxConfidence)
+		if glyph.IsASCII() {
+			b.WriteString(strconv.Itoa(level))
+		} else {
+			b.WriteString(bars[level-1])
+		}
+	}
+	return b.String()
+}
+
+// promptSkillConfidence asks for a new confidence rating of the skill and
+// reports whether it changed
+func promptSkillConfidence(skill *core.Skill) bool {
+	confidence := PromptInt(fmt.Sprintf("How confident do you feel in %s now? (1-5)", skill.Title), skill.Confidence)
+	if confidence == 0 || confidence == skill.Confidence {
+		return false
+	}
+	if err := skill.SetConfidence(confidence); err != nil {
+		PrintWarning(err.Error())
+		return false
+	}
+	return true
+}
+
+// rateReviewedSkill records the confidence in a skill after its review, from
+// --confidence or by asking
+func rateReviewedSkill(skillID core.EntityID) error {
+	skill, err := skillRepo.GetByIDWithBody(skillID)
+	if err != nil {
+		return nil
+	}
+
+	if milestoneConfidence != 0 {
+		if err := skill.SetConfidence(milestoneConfidence); err != nil {
+			return err
+		}
+	} else if !promptSkillConfidence(skill) {
+		return nil
+	}
+
+	if err := skillRepo.Update(skill); err != nil {
+		return fmt.Errorf("failed to update skill: %w", err)
+	}
+	PrintSuccess(fmt.Sprintf("Confidence in %s: %d/%d", skill.Title, skill.Confidence, core.MaxConfidence))
+	if skill.NeedsReinforcement() {
+		PrintWarning(fmt.Sprintf("%s is %s but your confidence is low: consider reinforcing it", skill.Title, skill.Level))
+	}
+	return nil
+}
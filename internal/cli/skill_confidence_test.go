@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfidenceSparkline(t *testing.T) {
+	ratings := []core.ConfidenceRating{{Confidence: 1}, {Confidence: 3}, {Confidence: 5}, {Confidence: 4}}
+
+	assert.Equal(t, "▁▄█▆", confidenceSparkline(ratings, 12))
+	assert.Equal(t, "█▆", confidenceSparkline(ratings, 2))
+
+	glyph.SetASCII(true)
+	defer glyph.SetASCII(false)
+	assert.Equal(t, "1354", confidenceSparkline(ratings, 12))
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	skillPlanTargetLevel string
+	skillPlanStyle       string
+	skillPlanTime        string
+	skillPlanBackground  string
+	skillPlanProvider    string
+	skillPlanModel       string
+	skillPlanStaged      bool
+	skillPlanPrintPrompt bool
+)
+
+var skillPlanCmd = &cobra.Command{
+	Use:   "plan <skill-id>",
+	Short: "Generate a learning plan for one skill using AI",
+	Long: `Generate a small learning path for a single skill, from its current level to
+a target level, without needing a goal.
+
+The plan goes through the same generation as 'growth path generate' and is
+saved as a learning path with phases, milestones and resources. The path is
+marked with the skill instead of being linked to a goal, and its resources
+are linked to the skill.
+
+Examples:
+  growth skill plan skill-003
+  growth skill plan skill-003 --target-level expert --time "3 hours/week"
+  growth skill plan skill-003 --style project-based
+  growth skill plan skill-003 --print-prompt`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSkillPlan,
+}
+
+func init() {
+	skillCmd.AddCommand(skillPlanCmd)
+
+	skillPlanCmd.Flags().StringVar(&skillPlanTargetLevel, "target-level", "", "target proficiency level (defaults to next level up)")
+	skillPlanCmd.Flags().StringVar(&skillPlanStyle, "style", "", "learning style (top-down, bottom-up, project-based) - defaults to config")
+	skillPlanCmd.Flags().StringVar(&skillPlanTime, "time", "5 hours/week", "time commitment (e.g., '10 hours/week')")
+	skillPlanCmd.Flags().StringVar(&skillPlanBackground, "background", "", "additional background context")
+	skillPlanCmd.Flags().StringVar(&skillPlanProvider, "provider", "", "AI provider (gemini, openai) - defaults to config")
+	skillPlanCmd.Flags().StringVar(&skillPlanModel, "model", "", "model override - defaults to config")
+	skillPlanCmd.Flags().BoolVar(&skillPlanStaged, "staged", false, "plan the path first, then detail each phase in its own request")
+	skillPlanCmd.Flags().BoolVar(&skillPlanPrintPrompt, "print-prompt", false, "print the prompts that would be sent and exit")
+}
+
+func runSkillPlan(cmd *cobra.Command, args []string) error {
+	skillID := core.EntityID(args[0])
+
+	req, err := aiService.BuildSkillPlanRequest(service.SkillPlanOptions{
+		SkillID:        skillID,
+		TargetLevel:    core.ProficiencyLevel(skillPlanTargetLevel),
+		Style:          skillPlanStyle,
+		TimeCommitment: skillPlanTime,
+		Background:     skillPlanBackground,
+	})
+	if err != nil {
+		return err
+	}
+	if req.TargetLevel == req.Skill.Level {
+		return fmt.Errorf("%s is already %s. Pick a higher level with --target-level", req.Skill.Title, req.Skill.Level)
+	}
+
+	if skillPlanStaged {
+		config.AI.StagedGeneration = true
+	}
+	if skillPlanPrintPrompt {
+		return printPathPrompts(req)
+	}
+
+	client, err := aiService.NewClient(skillPlanProvider, skillPlanModel)
+	if err != nil {
+		return err
+	}
+
+	// Show progress
+	fmt.Printf("%sGenerating learning plan for: %s\n", glyph.Emoji("🤖"), req.Skill.Title)
+	fmt.Printf("   Current Level: %s\n", req.Skill.Level)
+	fmt.Printf("   Target Level: %s\n", req.TargetLevel)
+	fmt.Printf("   Provider: %s\n", client.Provider())
+	if skillPlanModel != "" {
+		fmt.Printf("   Model: %s\n", skillPlanModel)
+	}
+	fmt.Printf("   Style: %s\n", req.LearningStyle)
+	fmt.Printf("   Time Commitment: %s\n", req.TimeCommitment)
+	fmt.Println()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
+	defer cancel()
+
+	progress := startSpinner("Planning your way to " + string(req.TargetLevel))
+	resp, err := client.GenerateLearningPath(ctx, req)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to generate plan: %w", err)
+	}
+
+	if err := aiService.SaveSkillPlan(cmd.Context(), resp, skillID, req.TimeCommitment); err != nil {
+		return fmt.Errorf("failed to save plan: %w", err)
+	}
+
+	displayPathSummary(resp)
+
+	return nil
+}
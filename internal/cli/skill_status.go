@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// startSkillsWorked moves the not-started skills a progress log mentions to
+// learning, unless progress.manualSkillStatus is set in config.yml
+func startSkillsWorked(log *core.ProgressLog) {
+	if config != nil && config.Progress.ManualSkillStatus {
+		return
+	}
+
+	for _, skillID := range log.SkillsWorked {
+		skill, err := skillRepo.GetByIDWithBody(skillID)
+		if err != nil || !skill.StartLearning() {
+			continue
+		}
+		if err := skillRepo.Update(skill); err != nil {
+			PrintWarning(fmt.Sprintf("Failed to update skill %s: %v", skill.ID, err))
+			continue
+		}
+		PrintInfo(fmt.Sprintf("Skill %s (%s) is now learning", skill.ID, skill.Title))
+	}
+}
+
+// suggestMasteryReview points out a skill whose resources and milestones
+// are all done, since it may be time to mark it mastered
+func suggestMasteryReview(skillID core.EntityID) {
+	skill, err := skillRepo.GetByID(skillID)
+	if err != nil {
+		return
+	}
+	resources, err := resourceRepo.FindBySkillID(skill.ID)
+	if err != nil {
+		return
+	}
+	milestones, err := milestoneRepo.FindByReferenceID(core.ReferenceSkill, skill.ID)
+	if err != nil {
+		return
+	}
+
+	if skill.ReadyForMastery(resources, milestones) {
+		PrintInfo(fmt.Sprintf("All resources and milestones of %s (%s) are done. If you have mastered it, run 'growth skill edit %s --status mastered' and 'growth review schedule %s'",
+			skill.Title, skill.ID, skill.ID, skill.ID))
+	}
+}
@@ -0,0 +1,345 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/spf13/cobra"
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Capture and compare review period snapshots",
+	Long: `Capture immutable summaries of your repository at the end of a review period,
+and compare them to show growth between periods.
+
+A snapshot records skill levels, completed goals, paths and resources,
+achieved milestones, and total hours invested.`,
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Take a snapshot",
+	Long: `Capture the current state of the repository under a review period name.
+
+Snapshots are immutable: a name can only be used once.
+
+Examples:
+  growth snapshot create "2025-H1"
+  growth snapshot create "2025 annual review"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSnapshotCreate,
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List snapshots",
+	Long: `List snapshots, oldest first.
+
+Examples:
+  growth snapshot list`,
+	Aliases: []string{"ls"},
+	RunE:    runSnapshotList,
+}
+
+var snapshotViewCmd = &cobra.Command{
+	Use:   "view <name|id>",
+	Short: "View a snapshot",
+	Long: `View a snapshot by name or ID.
+
+Examples:
+  growth snapshot view 2025-H1
+  growth snapshot view snapshot-002 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSnapshotView,
+}
+
+var snapshotDiffCmd = &cobra.Command{
+	Use:   "diff <from> <to>",
+	Short: "Show growth between two snapshots",
+	Long: `Compare two snapshots by name or ID and show what changed between them:
+skill level changes, new skills, newly completed goals, paths and resources,
+achieved milestones, and hours invested.
+
+Examples:
+  growth snapshot diff 2024-H2 2025-H1
+  growth snapshot diff 2024-H2 2025-H1 --format yaml`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSnapshotDiff,
+}
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete <name|id>",
+	Short: "Delete a snapshot",
+	Long: `Delete a snapshot. You'll be prompted for confirmation before deletion.
+
+Examples:
+  growth snapshot delete 2025-H1`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runSnapshotDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotCmd.AddCommand(snapshotViewCmd)
+	snapshotCmd.AddCommand(snapshotDiffCmd)
+	snapshotCmd.AddCommand(snapshotDeleteCmd)
+}
+
+func runSnapshotCreate(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[0])
+
+	existing, err := snapshotRepo.FindByName(name)
+	if err != nil {
+		return fmt.Errorf("failed to check existing snapshots: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("snapshot '%s' already exists (%s). Snapshots are immutable, choose another name", name, existing.ID)
+	}
+
+	src, err := loadSnapshotSource()
+	if err != nil {
+		return err
+	}
+
+	id, err := GenerateNextID("snapshot")
+	if err != nil {
+		return fmt.Errorf("failed to generate snapshot ID: %w", err)
+	}
+
+	snapshot, err := core.NewSnapshot(id, name, time.Now(), src)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+	snapshot.Body = renderSnapshotBody(snapshot)
+
+	if err := snapshotRepo.Create(snapshot); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Created snapshot %s: %s", snapshot.ID, snapshot.Title))
+	fmt.Printf("  %d skills, %d goals, %d paths, %d resources completed, %d milestones achieved, %s hours\n",
+		len(snapshot.Skills), len(snapshot.CompletedGoals), len(snapshot.CompletedPaths),
+		len(snapshot.CompletedResources), len(snapshot.AchievedMilestones), formatNumber(snapshot.HoursInvested, 1))
+	return nil
+}
+
+func runSnapshotList(cmd *cobra.Command, args []string) error {
+	snapshots, err := snapshotRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve snapshots: %w", err)
+	}
+
+	if len(snapshots) == 0 {
+		PrintInfo("No snapshots found")
+		return nil
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].TakenAt.Before(snapshots[j].TakenAt)
+	})
+
+	if config.Display.OutputFormat == "table" {
+		for _, snapshot := range snapshots {
+			fmt.Printf("%s  %-20s  taken %s  %d skills, %s hours\n",
+				snapshot.ID, truncate(snapshot.Title, 20), formatDate(snapshot.TakenAt),
+				len(snapshot.Skills), formatNumber(snapshot.HoursInvested, 1))
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(snapshots)
+}
+
+func runSnapshotView(cmd *cobra.Command, args []string) error {
+	snapshot, err := findSnapshot(args[0], true)
+	if err != nil {
+		return err
+	}
+
+	if config.Display.OutputFormat == "table" {
+		fmt.Printf("ID:    %s\n", snapshot.ID)
+		fmt.Printf("Name:  %s\n", snapshot.Title)
+		fmt.Printf("Taken: %s\n", formatDateTime(snapshot.TakenAt))
+		fmt.Printf("\n%s\n", snapshot.Body)
+		return nil
+	}
+
+	return PrintOutputWithConfig(snapshot)
+}
+
+func runSnapshotDiff(cmd *cobra.Command, args []string) error {
+	from, err := findSnapshot(args[0], false)
+	if err != nil {
+		return err
+	}
+
+	to, err := findSnapshot(args[1], false)
+	if err != nil {
+		return err
+	}
+
+	diff := core.DiffSnapshots(from, to)
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(diff)
+	}
+
+	fmt.Printf("Growth from %s to %s\n", diff.From, diff.To)
+	fmt.Println(strings.Repeat("=", len(diff.From)+len(diff.To)+16))
+	fmt.Println()
+
+	fmt.Printf("Hours invested: %+.1f\n", diff.HoursInvested)
+
+	if len(diff.LevelChanges) > 0 {
+		fmt.Println("\nSkill levels:")
+		for _, change := range diff.LevelChanges {
+			fmt.Printf("  %s (%s): %s %s %s\n", change.Title, change.ID, change.From, glyph.Arrow, change.To)
+		}
+	}
+
+	if len(diff.NewSkills) > 0 {
+		fmt.Println("\nNew skills:")
+		for _, skill := range diff.NewSkills {
+			fmt.Printf("  %s (%s): %s\n", skill.Title, skill.ID, skill.To)
+		}
+	}
+
+	printSnapshotItems("Goals completed", diff.CompletedGoals)
+	printSnapshotItems("Paths completed", diff.CompletedPaths)
+	printSnapshotItems("Resources completed", diff.CompletedResources)
+	printSnapshotItems("Milestones achieved", diff.AchievedMilestones)
+
+	if len(diff.LevelChanges)+len(diff.NewSkills)+len(diff.CompletedGoals)+len(diff.CompletedPaths)+
+		len(diff.CompletedResources)+len(diff.AchievedMilestones) == 0 {
+		fmt.Println()
+		PrintInfo("No changes between these snapshots")
+	}
+
+	return nil
+}
+
+func runSnapshotDelete(cmd *cobra.Command, args []string) error {
+	snapshot, err := findSnapshot(args[0], false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("You are about to delete:\n")
+	fmt.Printf("  ID: %s\n", snapshot.ID)
+	fmt.Printf("  Name: %s\n", snapshot.Title)
+	fmt.Println()
+
+	if !PromptConfirm("Are you sure you want to delete this snapshot?") {
+		PrintInfo("Deletion cancelled")
+		return nil
+	}
+
+	if err := snapshotRepo.Delete(snapshot.ID); err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Deleted snapshot %s", snapshot.ID))
+	return nil
+}
+
+// findSnapshot looks a snapshot up by ID, falling back to its name
+func findSnapshot(ref string, withBody bool) (*core.Snapshot, error) {
+	id := core.EntityID(ref)
+	if exists, err := snapshotRepo.Exists(id); err == nil && exists {
+		if withBody {
+			return snapshotRepo.GetByIDWithBody(id)
+		}
+		return snapshotRepo.GetByID(id)
+	}
+
+	snapshot, err := snapshotRepo.FindByName(ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve snapshots: %w", err)
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot '%s' not found. Use 'growth snapshot list' to see available snapshots", ref)
+	}
+
+	if withBody {
+		return snapshotRepo.GetByIDWithBody(snapshot.ID)
+	}
+	return snapshot, nil
+}
+
+func loadSnapshotSource() (core.SnapshotSource, error) {
+	var src core.SnapshotSource
+	var err error
+
+	if src.Skills, err = skillRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load skills: %w", err)
+	}
+	if src.Goals, err = goalRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load goals: %w", err)
+	}
+	if src.Paths, err = pathRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load paths: %w", err)
+	}
+	if src.Resources, err = resourceRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load resources: %w", err)
+	}
+	if src.Milestones, err = milestoneRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load milestones: %w", err)
+	}
+	if src.ProgressLogs, err = progressRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load progress logs: %w", err)
+	}
+	if src.Outputs, err = outputRepo.GetAll(); err != nil {
+		return src, fmt.Errorf("failed to load outputs: %w", err)
+	}
+
+	return src, nil
+}
+
+// renderSnapshotBody writes the human-readable summary stored with a snapshot
+func renderSnapshotBody(snapshot *core.Snapshot) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Snapshot: %s\n\n", snapshot.Title)
+	fmt.Fprintf(&b, "Taken on %s. Total hours invested: %.1f.\n", snapshot.TakenAt.Format("2006-01-02"), snapshot.HoursInvested)
+
+	if len(snapshot.Skills) > 0 {
+		b.WriteString("\n## Skills\n\n")
+		for _, skill := range snapshot.Skills {
+			fmt.Fprintf(&b, "- %s: %s (%s)\n", skill.Title, skill.Level, skill.Status)
+		}
+	}
+
+	writeItems := func(heading string, items []core.SnapshotItem) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", heading)
+		for _, item := range items {
+			fmt.Fprintf(&b, "- %s (%s)\n", item.Title, item.ID)
+		}
+	}
+	writeItems("Completed Goals", snapshot.CompletedGoals)
+	writeItems("Completed Paths", snapshot.CompletedPaths)
+	writeItems("Completed Resources", snapshot.CompletedResources)
+	writeItems("Achieved Milestones", snapshot.AchievedMilestones)
+
+	return b.String()
+}
+
+func printSnapshotItems(heading string, items []core.SnapshotItem) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Printf("\n%s (%d):\n", heading, len(items))
+	for _, item := range items {
+		fmt.Printf("  %s (%s)\n", item.Title, item.ID)
+	}
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/illenko/growth.md/internal/glyph"
+	"github.com/illenko/growth.md/internal/i18n"
+)
+
+var (
+	spinnerFrames      = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	spinnerASCIIFrames = []string{"|", "/", "-", `\`}
+)
+
+// spinnerInterval is how often the spinner redraws
+const spinnerInterval = 100 * time.Millisecond
+
+// runningSpinner is the spinner on screen, if any
+var runningSpinner *spinner
+
+// spinner shows that a long operation, like an AI request, is still running:
+// an animated line with the elapsed time and how to cancel. It writes to
+// stderr, so JSON and YAML output on stdout stays clean. When stderr is not
+// a terminal it prints the message once instead.
+type spinner struct {
+	out     io.Writer
+	message string
+	started time.Time
+	done    chan struct{}
+	stopped sync.WaitGroup
+	once    sync.Once
+	mu      sync.Mutex // held while drawing
+}
+
+// startSpinner starts a spinner with a message such as "Analyzing your
+// goal and skills". Stop it before printing anything else.
+func startSpinner(message string) *spinner {
+	s := &spinner{
+		out:     os.Stderr,
+		message: i18n.T(message),
+		started: time.Now(),
+		done:    make(chan struct{}),
+	}
+
+	if !isTerminal(os.Stderr) {
+		fmt.Fprintf(s.out, "%s...\n", s.message)
+		close(s.done)
+		return s
+	}
+
+	runningSpinner = s
+	s.stopped.Add(1)
+	go s.run()
+	return s
+}
+
+func (s *spinner) run() {
+	defer s.stopped.Done()
+
+	frames := spinnerFrames
+	if glyph.IsASCII() {
+		frames = spinnerASCIIFrames
+	}
+
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		s.mu.Lock()
+		fmt.Fprintf(s.out, "\r\033[K%s", spinnerLine(frames[i%len(frames)], s.message, time.Since(s.started)))
+		s.mu.Unlock()
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			s.clear()
+			return
+		}
+	}
+}
+
+func (s *spinner) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprint(s.out, "\r\033[K")
+}
+
+// aboveSpinner runs print, which writes a line of output, without it ending
+// up on the spinner's line. The spinner redraws below it on its next frame.
+func aboveSpinner(print func()) {
+	s := runningSpinner
+	if s == nil {
+		print()
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprint(s.out, "\r\033[K")
+	print()
+}
+
+// Stop clears the spinner line. It is safe to call more than once.
+func (s *spinner) Stop() {
+	s.once.Do(func() {
+		select {
+		case <-s.done:
+		default:
+			close(s.done)
+		}
+		s.stopped.Wait()
+		if runningSpinner == s {
+			runningSpinner = nil
+		}
+	})
+}
+
+// spinnerLine is one frame of the spinner, like
+// "⠋ Analyzing your goal and skills... 12s (Ctrl+C to cancel)"
+func spinnerLine(frame, message string, elapsed time.Duration) string {
+	return fmt.Sprintf("%s %s... %s (Ctrl+C to cancel)", frame, message, elapsed.Truncate(time.Second))
+}
+
+// isTerminal reports whether f is an interactive terminal rather than a
+// pipe or file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
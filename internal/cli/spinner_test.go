@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpinnerLine(t *testing.T) {
+	assert.Equal(t, "⠋ Analyzing your goal and skills... 0s (Ctrl+C to cancel)",
+		spinnerLine("⠋", "Analyzing your goal and skills", 400*time.Millisecond))
+	assert.Equal(t, "| Fetching feeds... 1m5s (Ctrl+C to cancel)",
+		spinnerLine("|", "Fetching feeds", 65*time.Second+900*time.Millisecond))
+}
+
+func TestAboveSpinnerWithoutSpinner(t *testing.T) {
+	printed := false
+	aboveSpinner(func() { printed = true })
+	assert.True(t, printed)
+}
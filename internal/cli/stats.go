@@ -3,9 +3,11 @@ package cli
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/glyph"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +23,47 @@ Examples:
 	RunE: runStats,
 }
 
+var statsMoodSince string
+
+var statsMoodCmd = &cobra.Command{
+	Use:   "mood",
+	Short: "Correlate moods with your learning",
+	Long: `Correlate the moods recorded in progress logs with hours invested, resources
+completed, and skill categories worked on, and highlight patterns such as
+moods that cluster around one kind of work.
+
+A week counts towards the mood logged most often that week.
+
+Examples:
+  growth stats mood
+  growth stats mood --since 2025-01-01
+  growth stats mood --format json`,
+	RunE: runStatsMood,
+}
+
+var statsCalibrationCmd = &cobra.Command{
+	Use:   "calibration",
+	Short: "Compare your estimates with the actual effort",
+	Long: `Compare the estimated hours of completed resources and the estimated
+duration of finished phases with the time they actually took, and work out
+your personal bias factor: how many times the estimate things usually take.
+
+Once there are enough finished estimates, path projections and schedules
+scale estimates by the bias factor. Set progress.ignoreEstimateBias in
+.growth/config.yml to use estimates as they are.
+
+Examples:
+  growth stats calibration
+  growth stats calibration --format json`,
+	RunE: runStatsCalibration,
+}
+
 func init() {
 	rootCmd.AddCommand(statsCmd)
+	statsCmd.AddCommand(statsMoodCmd)
+	statsCmd.AddCommand(statsCalibrationCmd)
+
+	statsMoodCmd.Flags().StringVar(&statsMoodSince, "since", "", "only include logs on or after this date (YYYY-MM-DD)")
 }
 
 func runStats(cmd *cobra.Command, args []string) error {
@@ -31,14 +72,15 @@ func runStats(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Skill categories
-	skills, err := skillRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get skills: %w", err)
-	}
-
+	skillCount := 0
 	categoryCount := make(map[string]int)
-	for _, skill := range skills {
+	err := skillRepo.Iterate(func(skill *core.Skill) bool {
+		skillCount++
 		categoryCount[skill.Category]++
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get skills: %w", err)
 	}
 
 	if len(categoryCount) > 0 {
@@ -64,26 +106,27 @@ func runStats(cmd *cobra.Command, args []string) error {
 	}
 
 	// Goals progress
-	goals, err := goalRepo.GetAll()
-	if err != nil {
-		return fmt.Errorf("failed to get goals: %w", err)
-	}
-
+	goalCount := 0
 	completedGoals := 0
 	upcomingTargets := 0
 	now := time.Now()
-	for _, goal := range goals {
+	err = goalRepo.Iterate(func(goal *core.Goal) bool {
+		goalCount++
 		if goal.Status == core.StatusCompleted {
 			completedGoals++
 		}
 		if goal.TargetDate != nil && goal.TargetDate.After(now) && goal.Status == core.StatusActive {
 			upcomingTargets++
 		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get goals: %w", err)
 	}
 
-	if len(goals) > 0 {
-		completionRate := float64(completedGoals) / float64(len(goals)) * 100
-		fmt.Printf("Goal Completion: %d/%d (%.1f%%)\n", completedGoals, len(goals), completionRate)
+	if goalCount > 0 {
+		completionRate := float64(completedGoals) / float64(goalCount) * 100
+		fmt.Printf("Goal Completion: %d/%d (%s%%)\n", completedGoals, goalCount, formatNumber(completionRate, 1))
 		if upcomingTargets > 0 {
 			fmt.Printf("  Upcoming targets: %d goals\n", upcomingTargets)
 		}
@@ -98,13 +141,27 @@ func runStats(cmd *cobra.Command, args []string) error {
 
 	completedResources := 0
 	inProgressResources := 0
+	abandonedResources := 0
 	totalHours := 0.0
 	completedHours := 0.0
+	varianceEstimated := 0.0
+	varianceActual := 0.0
+	varianceCount := 0
 	for _, resource := range resources {
+		// Abandoned resources are excluded from completion denominators
+		if resource.Status == core.ResourceAbandoned {
+			abandonedResources++
+			continue
+		}
 		totalHours += resource.EstimatedHours
 		if resource.Status == core.ResourceCompleted {
 			completedResources++
 			completedHours += resource.EstimatedHours
+			if resource.HasHoursVariance() {
+				varianceEstimated += resource.EstimatedHours
+				varianceActual += resource.ActualHours
+				varianceCount++
+			}
 		} else if resource.Status == core.ResourceInProgress {
 			inProgressResources++
 		}
@@ -112,17 +169,53 @@ func runStats(cmd *cobra.Command, args []string) error {
 
 	if len(resources) > 0 {
 		fmt.Printf("Learning Resources:\n")
-		fmt.Printf("  Completed: %d/%d resources\n", completedResources, len(resources))
+		fmt.Printf("  Completed: %d/%d resources\n", completedResources, len(resources)-abandonedResources)
 		if totalHours > 0 {
-			fmt.Printf("  Hours completed: %.1f/%.1f (%.1f%%)\n", completedHours, totalHours, completedHours/totalHours*100)
+			fmt.Printf("  Hours completed: %s/%s (%s%%)\n", formatNumber(completedHours, 1), formatNumber(totalHours, 1), formatNumber(completedHours/totalHours*100, 1))
 		}
 		if inProgressResources > 0 {
 			fmt.Printf("  In progress: %d resources\n", inProgressResources)
 		}
+		if abandonedResources > 0 {
+			fmt.Printf("  Abandoned: %d resources\n", abandonedResources)
+		}
+		if varianceCount > 0 {
+			variance := varianceActual - varianceEstimated
+			fmt.Printf("  Estimate variance: %s actual vs %s estimated hours (%+.1f, %+.0f%%) across %d completed resources\n",
+				formatNumber(varianceActual, 1), formatNumber(varianceEstimated, 1), variance, variance/varianceEstimated*100, varianceCount)
+		}
+		fmt.Println()
+	}
+
+	// Learning paths
+	pathCount := 0
+	completedPaths := 0
+	abandonedPaths := 0
+	err = pathRepo.Iterate(func(path *core.LearningPath) bool {
+		pathCount++
+		switch path.Status {
+		case core.StatusCompleted:
+			completedPaths++
+		case core.StatusAbandoned:
+			abandonedPaths++
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	if pathCount-abandonedPaths > 0 {
+		fmt.Printf("Path Completion: %d/%d (%s%%)\n", completedPaths, pathCount-abandonedPaths,
+			formatNumber(float64(completedPaths)/float64(pathCount-abandonedPaths)*100, 1))
+		if abandonedPaths > 0 {
+			fmt.Printf("  Abandoned: %d paths\n", abandonedPaths)
+		}
 		fmt.Println()
 	}
 
 	// Milestones
+	spawnRecurringMilestones()
 	milestones, err := milestoneRepo.GetAll()
 	if err != nil {
 		return fmt.Errorf("failed to get milestones: %w", err)
@@ -148,6 +241,32 @@ func runStats(cmd *cobra.Command, args []string) error {
 		fmt.Println()
 	}
 
+	// Recurring milestones
+	series := make(map[core.EntityID][]*core.Milestone)
+	for _, milestone := range milestones {
+		if milestone.IsRecurring() {
+			series[milestone.SeriesID] = append(series[milestone.SeriesID], milestone)
+		}
+	}
+
+	if len(series) > 0 {
+		var habits []core.RecurringStats
+		for _, instances := range series {
+			habits = append(habits, core.ComputeRecurringStats(instances, now))
+		}
+		sort.Slice(habits, func(i, j int) bool {
+			return habits[i].SeriesID < habits[j].SeriesID
+		})
+
+		fmt.Println("Habits:")
+		for _, habit := range habits {
+			fmt.Printf("  %s (%s): %d/%d periods (%s%%), streak %d (best %d)\n",
+				habit.Title, habit.Recurrence, habit.Completed, habit.Periods,
+				formatNumber(habit.CompletionRate, 0), habit.CurrentStreak, habit.LongestStreak)
+		}
+		fmt.Println()
+	}
+
 	// Progress logs
 	progressLogs, err := progressRepo.GetAll()
 	if err != nil {
@@ -170,14 +289,88 @@ func runStats(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("Progress Tracking:\n")
 		fmt.Printf("  Total logs: %d\n", len(progressLogs))
-		fmt.Printf("  Total hours invested: %.1f\n", totalProgressHours)
+		fmt.Printf("  Total hours invested: %s\n", formatNumber(totalProgressHours, 1))
 		if len(progressLogs) > 0 {
 			avgHours := totalProgressHours / float64(len(progressLogs))
-			fmt.Printf("  Average per log: %.1f hours\n", avgHours)
+			fmt.Printf("  Average per log: %s hours\n", formatNumber(avgHours, 1))
 		}
 		if recentWeeks > 0 {
 			avgRecentHours := recentHours / float64(recentWeeks)
-			fmt.Printf("  Recent (last 4 weeks): %.1f hours/log\n", avgRecentHours)
+			fmt.Printf("  Recent (last 4 weeks): %s hours/log\n", formatNumber(avgRecentHours, 1))
+		}
+		fmt.Println()
+
+		if focus := core.ComputeFocusStats(progressLogs, time.Time{}); focus.Sessions > 0 {
+			recent := core.ComputeFocusStats(progressLogs, fourWeeksAgo)
+			fmt.Println("Focus Sessions:")
+			fmt.Printf("  Total: %s, %s hours focused\n", countOf(focus.Sessions, "session"), formatNumber(float64(focus.Minutes)/60, 1))
+			fmt.Printf("  Average session: %d min\n", focus.Minutes/focus.Sessions)
+			fmt.Printf("  Distractions: %d (%s per hour)\n", focus.Distractions, formatNumber(focus.DistractionsPerHour(), 1))
+			if recent.Sessions > 0 {
+				fmt.Printf("  Recent (last 4 weeks): %s, %s distractions per hour\n", countOf(recent.Sessions, "session"), formatNumber(recent.DistractionsPerHour(), 1))
+			}
+			fmt.Println()
+		}
+	}
+
+	// Group sessions
+	sessions, err := sessionRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	if len(sessions) > 0 {
+		total := core.ComputeSessionStats(sessions, time.Time{})
+		recent := core.ComputeSessionStats(sessions, now.AddDate(0, 0, -28))
+
+		fmt.Println("Group Sessions:")
+		fmt.Printf("  Total sessions: %d (%s hours)\n", total.Sessions, formatNumber(total.Hours, 1))
+		fmt.Printf("  Study partners: %d\n", total.Attendees)
+		if recent.Sessions > 0 {
+			fmt.Printf("  Recent (last 4 weeks): %d sessions, %s hours\n", recent.Sessions, formatNumber(recent.Hours, 1))
+		}
+		if len(total.TopTopics) > 0 {
+			fmt.Printf("  Top topics: %s\n", strings.Join(total.TopTopics, ", "))
+		}
+		fmt.Println()
+	}
+
+	// Mentoring
+	mentorSessions, err := mentorRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get mentor sessions: %w", err)
+	}
+
+	if len(mentorSessions) > 0 {
+		total := core.ComputeMentorStats(mentorSessions, time.Time{})
+		recent := core.ComputeMentorStats(mentorSessions, now.AddDate(0, 0, -28))
+
+		fmt.Println("Mentorship:")
+		fmt.Printf("  Total: %s\n", countOf(total.Sessions, "session"))
+		fmt.Printf("  Hours mentored: %s (%s)\n", formatNumber(total.HoursReceived, 1), countOf(total.Mentors, "mentor"))
+		fmt.Printf("  Hours mentoring others: %s (%s)\n", formatNumber(total.HoursGiven, 1), countOf(total.Mentees, "mentee"))
+		if recent.Sessions > 0 {
+			fmt.Printf("  Recent (last 4 weeks): %s, %s hours\n", countOf(recent.Sessions, "session"), formatNumber(recent.HoursReceived+recent.HoursGiven, 1))
+		}
+		fmt.Println()
+	}
+
+	// Talks, posts and contributions
+	outputs, err := outputRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get outputs: %w", err)
+	}
+
+	if len(outputs) > 0 {
+		total := core.ComputeOutputStats(outputs, time.Time{})
+		recent := core.ComputeOutputStats(outputs, now.AddDate(0, 0, -90))
+
+		fmt.Println("Giving Back:")
+		fmt.Printf("  Talks: %d\n", total.Talks)
+		fmt.Printf("  Blog posts: %d\n", total.BlogPosts)
+		fmt.Printf("  Open source contributions: %d\n", total.Contributions)
+		if recent.Total() > 0 {
+			fmt.Printf("  Recent (last 90 days): %s\n", countOf(recent.Total(), "output"))
 		}
 		fmt.Println()
 	}
@@ -193,7 +386,7 @@ func runStats(cmd *cobra.Command, args []string) error {
 				skillsWorked[skillID] = true
 			}
 		}
-		fmt.Printf("  Active skills: %d/%d\n", len(skillsWorked), len(skills))
+		fmt.Printf("  Active skills: %d/%d\n", len(skillsWorked), skillCount)
 
 		// Calculate resources completion rate
 		thirtyDaysAgo := now.AddDate(0, 0, -30)
@@ -211,3 +404,161 @@ func runStats(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runStatsMood(cmd *cobra.Command, args []string) error {
+	logs, err := progressRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get progress logs: %w", err)
+	}
+
+	if statsMoodSince != "" {
+		since, err := time.Parse("2006-01-02", statsMoodSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since date (use YYYY-MM-DD): %w", err)
+		}
+		var recent []*core.ProgressLog
+		for _, log := range logs {
+			if !log.Date.Before(since) {
+				recent = append(recent, log)
+			}
+		}
+		logs = recent
+	}
+
+	resources, err := resourceRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get skills: %w", err)
+	}
+
+	report := core.AnalyzeMood(logs, resources, skills)
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(report)
+	}
+
+	if len(report.Moods) == 0 {
+		PrintInfo("No moods recorded yet. Add one with 'growth progress log --mood <mood>'")
+		return nil
+	}
+
+	fmt.Println("Mood Statistics")
+	fmt.Println("===============")
+	fmt.Println()
+
+	fmt.Printf("%-14s  %5s  %8s  %9s  %6s  %9s  %s\n", "MOOD", "LOGS", "HOURS", "AVG/LOG", "WEEKS", "FINISHED", "TOP CATEGORY")
+	for _, m := range report.Moods {
+		top, _ := m.TopCategory()
+		fmt.Printf("%-14s  %5d  %8s  %9s  %6d  %9d  %s\n",
+			truncate(m.Mood, 14), m.Logs, formatNumber(m.Hours, 1), formatNumber(m.AverageHours(), 1), m.Weeks, m.ResourcesCompleted, top)
+	}
+	if report.Untagged > 0 {
+		fmt.Printf("\n%d logs have no mood\n", report.Untagged)
+	}
+
+	if len(report.Months) > 1 {
+		fmt.Println("\nOver time:")
+		for _, month := range report.Months {
+			moods := make([]string, 0, len(month.Moods))
+			for mood := range month.Moods {
+				moods = append(moods, mood)
+			}
+			sort.Slice(moods, func(i, j int) bool {
+				if month.Moods[moods[i]] != month.Moods[moods[j]] {
+					return month.Moods[moods[i]] > month.Moods[moods[j]]
+				}
+				return moods[i] < moods[j]
+			})
+			parts := make([]string, 0, len(moods))
+			for _, mood := range moods {
+				parts = append(parts, fmt.Sprintf("%s %d", mood, month.Moods[mood]))
+			}
+			fmt.Printf("  %s  %6sh  %s\n", month.Month.Format("2006-01"), formatNumber(month.Hours, 1), strings.Join(parts, ", "))
+		}
+	}
+
+	if len(report.Patterns) > 0 {
+		fmt.Println("\nPatterns:")
+		for _, pattern := range report.Patterns {
+			fmt.Printf("  %s %s\n", glyph.Bullet, pattern)
+		}
+	}
+
+	return nil
+}
+
+func runStatsCalibration(cmd *cobra.Command, args []string) error {
+	calibration, err := loadCalibration()
+	if err != nil {
+		return err
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(calibration)
+	}
+
+	if len(calibration.Samples) == 0 {
+		PrintInfo("No finished estimates yet. Completed resources need estimated and actual hours, finished phases an estimated duration and start and end dates")
+		return nil
+	}
+
+	fmt.Println("Estimate Calibration")
+	fmt.Println("====================")
+	fmt.Println()
+
+	fmt.Printf("Bias factor: %s (%s), from %s\n", formatFactor(calibration.Factor), describeFactor(calibration.Factor), countOf(len(calibration.Samples), "finished estimate"))
+	if stats := calibration.Resources; stats.Samples > 0 {
+		fmt.Printf("  Resources: %s hours actual vs %s estimated across %s (%s)\n",
+			formatNumber(stats.Actual, 1), formatNumber(stats.Estimated, 1), countOf(stats.Samples, "resource"), formatFactor(stats.Factor))
+	}
+	if stats := calibration.Phases; stats.Samples > 0 {
+		fmt.Printf("  Phases:    %s weeks actual vs %s estimated across %s (%s)\n",
+			formatNumber(stats.Actual, 1), formatNumber(stats.Estimated, 1), countOf(stats.Samples, "phase"), formatFactor(stats.Factor))
+	}
+
+	if len(calibration.Quarters) > 1 {
+		fmt.Println("\nOver time:")
+		for _, quarter := range calibration.Quarters {
+			fmt.Printf("  %d-Q%d  %-6s  %s\n", quarter.Start.Year(), (int(quarter.Start.Month())+2)/3,
+				formatFactor(quarter.Factor), countOf(quarter.Samples, "estimate"))
+		}
+	}
+
+	fmt.Println("\nFurthest off:")
+	for _, sample := range calibration.Misses(5) {
+		unit := "h"
+		if sample.Kind == core.EstimatePhase {
+			unit = "w"
+		}
+		fmt.Printf("  %-14s %-35s %s%s %s %s%s  %s\n", sample.ID, truncate(sample.Title, 35),
+			formatNumber(sample.Estimated, 1), unit, glyph.Arrow, formatNumber(sample.Actual, 1), unit, formatFactor(sample.Ratio()))
+	}
+	fmt.Println()
+
+	switch {
+	case config.Progress.IgnoreEstimateBias:
+		PrintInfo("Path schedules use estimates as they are, because progress.ignoreEstimateBias is set")
+	case calibration.Bias() == 1:
+		PrintInfo(fmt.Sprintf("Path schedules apply the bias factor once there are %d finished estimates", core.MinCalibrationSamples))
+	default:
+		PrintInfo(fmt.Sprintf("Path schedules and projections scale estimates by %s", formatFactor(calibration.Bias())))
+	}
+	return nil
+}
+
+// describeFactor puts a bias factor in words
+func describeFactor(factor float64) string {
+	percent := (factor - 1) * 100
+	switch {
+	case percent >= 0.5:
+		return fmt.Sprintf("things take %s%% longer than estimated", formatNumber(percent, 0))
+	case percent <= -0.5:
+		return fmt.Sprintf("things take %s%% less time than estimated", formatNumber(-percent, 0))
+	default:
+		return "estimates are on target"
+	}
+}
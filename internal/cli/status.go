@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/git"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show entities changed since the last commit",
+	Long: `Show the entities with uncommitted changes in the growth repository,
+summarized like "2 skills modified, 1 new milestone".
+
+Unlike 'growth git status', which lists files, changes are shown by entity
+ID and title. Files that are not entities, like the config, are listed
+separately.
+
+Examples:
+  growth status
+  growth status --format json`,
+	RunE: runStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+}
+
+// statusReport is the structured output of growth status
+type statusReport struct {
+	Branch   string         `yaml:"branch" json:"branch"`
+	Summary  string         `yaml:"summary" json:"summary"`
+	Entities []statusEntity `yaml:"entities,omitempty" json:"entities,omitempty"`
+	Files    []string       `yaml:"files,omitempty" json:"files,omitempty"` // changed files that are not entities
+}
+
+type statusEntity struct {
+	ID     string `yaml:"id" json:"id"`
+	Type   string `yaml:"type" json:"type"`
+	Title  string `yaml:"title,omitempty" json:"title,omitempty"`
+	Change string `yaml:"change" json:"change"` // create, update or delete
+	Path   string `yaml:"path" json:"path"`
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	if !git.IsRepo(repoPath) {
+		return fmt.Errorf("%s is not a git repository. Run 'git init' there first", repoPath)
+	}
+
+	status, err := git.StatusContext(cmd.Context(), repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to get git status: %w", err)
+	}
+
+	root, err := git.GetRepoRoot(repoPath)
+	if err != nil {
+		return err
+	}
+	title := titleReader(root)
+
+	var report statusReport
+	report.Branch, _ = git.GetCurrentBranch(repoPath)
+
+	var changed []changedEntity
+	for _, line := range status {
+		entity, ok := parseStatusLine(line)
+		if !ok {
+			report.Files = append(report.Files, statusPath(line))
+			continue
+		}
+		changed = append(changed, entity)
+
+		item := statusEntity{ID: entity.id, Type: entity.entityType, Change: entity.operation, Path: entity.path}
+		if entity.operation != "delete" {
+			item.Title = title(entity.path)
+		}
+		report.Entities = append(report.Entities, item)
+	}
+	sort.SliceStable(report.Entities, func(i, j int) bool {
+		return report.Entities[i].ID < report.Entities[j].ID
+	})
+	report.Summary = summarizeChanges(changed, len(report.Files))
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(report)
+	}
+
+	if report.Branch != "" {
+		fmt.Printf("On branch: %s\n\n", report.Branch)
+	}
+	if len(status) == 0 {
+		PrintSuccess("No uncommitted changes")
+		return nil
+	}
+
+	fmt.Println(report.Summary)
+
+	sections := []struct{ change, heading string }{
+		{"create", "New"},
+		{"update", "Modified"},
+		{"delete", "Deleted"},
+	}
+	for _, section := range sections {
+		printed := false
+		for _, entity := range report.Entities {
+			if entity.Change != section.change {
+				continue
+			}
+			if !printed {
+				fmt.Printf("\n%s:\n", section.heading)
+				printed = true
+			}
+			fmt.Printf("  %-16s %s\n", entity.ID, truncate(entity.Title, 60))
+		}
+	}
+
+	if len(report.Files) > 0 {
+		fmt.Println("\nOther files:")
+		for _, file := range report.Files {
+			fmt.Printf("  %s\n", file)
+		}
+	}
+
+	fmt.Println("\nRun 'growth sync' to commit and push them.")
+	return nil
+}
+
+// entityFile matches entity file names like skill-001-python.md
+var entityFile = regexp.MustCompile(`^([a-z]+)-(\d+)(-.*)?\.md$`)
+
+// changedEntity is an entity file in git status output
+type changedEntity struct {
+	entityType string
+	id         string
+	operation  string
+	path       string
+}
+
+// statusPath returns the path of a porcelain status line, the new one for
+// renames
+func statusPath(line string) string {
+	if len(line) < 4 {
+		return ""
+	}
+
+	file := line[3:]
+	if _, to, renamed := strings.Cut(file, " -> "); renamed {
+		file = to
+	}
+	return strings.Trim(file, `"`)
+}
+
+// parseStatusLine returns the entity a porcelain status line is about
+func parseStatusLine(line string) (changedEntity, bool) {
+	file := statusPath(line)
+	match := entityFile.FindStringSubmatch(path.Base(file))
+	if match == nil {
+		return changedEntity{}, false
+	}
+
+	code := line[:2]
+	operation := "update"
+	switch {
+	case code == "??" || strings.Contains(code, "A"):
+		operation = "create"
+	case strings.Contains(code, "D"):
+		operation = "delete"
+	}
+
+	return changedEntity{
+		entityType: match[1],
+		id:         match[1] + "-" + match[2],
+		operation:  operation,
+		path:       file,
+	}, true
+}
+
+// entityNoun is how an entity type is named in summaries
+func entityNoun(entityType string) string {
+	if entityType == "progress" {
+		return "progress log"
+	}
+	return entityType
+}
+
+// summarizeChanges describes changed entities by change and type, like
+// "2 skills modified, 1 new milestone, 1 goal deleted", followed by the
+// number of other changed files
+func summarizeChanges(changed []changedEntity, otherFiles int) string {
+	counts := map[string]map[string]int{}
+	for _, entity := range changed {
+		if counts[entity.operation] == nil {
+			counts[entity.operation] = map[string]int{}
+		}
+		counts[entity.operation][entity.entityType]++
+	}
+
+	var parts []string
+	for _, operation := range []string{"update", "create", "delete"} {
+		types := make([]string, 0, len(counts[operation]))
+		for entityType := range counts[operation] {
+			types = append(types, entityType)
+		}
+		sort.Strings(types)
+
+		for _, entityType := range types {
+			count := counts[operation][entityType]
+			switch operation {
+			case "create":
+				parts = append(parts, countOf(count, "new "+entityNoun(entityType)))
+			case "update":
+				parts = append(parts, countOf(count, entityNoun(entityType))+" modified")
+			case "delete":
+				parts = append(parts, countOf(count, entityNoun(entityType))+" deleted")
+			}
+		}
+	}
+
+	if otherFiles > 0 {
+		parts = append(parts, countOf(otherFiles, "other file")+" changed")
+	}
+	if len(parts) == 0 {
+		return "No uncommitted changes"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatusLine(t *testing.T) {
+	entity, ok := parseStatusLine("?? milestones/milestone-004-first-pr.md")
+	assert.True(t, ok)
+	assert.Equal(t, changedEntity{entityType: "milestone", id: "milestone-004", operation: "create", path: "milestones/milestone-004-first-pr.md"}, entity)
+
+	entity, _ = parseStatusLine("MM skills/skill-001-python.md")
+	assert.Equal(t, "update", entity.operation)
+
+	entity, _ = parseStatusLine(" D goals/goal-002-staff.md")
+	assert.Equal(t, "delete", entity.operation)
+
+	_, ok = parseStatusLine(" M .growth/config.yml")
+	assert.False(t, ok)
+	_, ok = parseStatusLine("?? skills/")
+	assert.False(t, ok)
+}
+
+func TestSummarizeChanges(t *testing.T) {
+	changed := []changedEntity{
+		{entityType: "skill", id: "skill-001", operation: "update"},
+		{entityType: "skill", id: "skill-002", operation: "update"},
+		{entityType: "milestone", id: "milestone-004", operation: "create"},
+		{entityType: "progress", id: "progress-010", operation: "create"},
+		{entityType: "progress", id: "progress-011", operation: "create"},
+		{entityType: "goal", id: "goal-002", operation: "delete"},
+	}
+
+	assert.Equal(t, "2 skills modified, 1 new milestone, 2 new progress logs, 1 goal deleted, 1 other file changed",
+		summarizeChanges(changed, 1))
+	assert.Equal(t, "No uncommitted changes", summarizeChanges(nil, 0))
+}
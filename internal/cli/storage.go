@@ -0,0 +1,350 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/git"
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// database is the open SQLite database when storage.backend is sqlite
+var database *sql.DB
+
+var (
+	storageMigrateTo            string
+	storageMaterializeFromFiles bool
+	storageMaterializeCommit    bool
+)
+
+var storageCmd = &cobra.Command{
+	Use:   "storage",
+	Short: "Manage the storage backend",
+	Long: `Manage where entities are stored.
+
+By default every entity is a markdown file. Very large repositories can use a
+SQLite database instead (storage.backend: sqlite in .growth/config.yml), which
+keeps the same commands but answers queries faster. Changes to a SQLite
+repository are not auto-committed to git.`,
+}
+
+var storageMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy all entities to another storage backend",
+	Long: `Copy every entity, including its markdown body, to another storage backend
+and switch the config to it.
+
+Entities that already exist in the target are skipped, so an interrupted
+migration can be rerun. The source is left untouched; remove the markdown
+directories or the database file yourself once you are happy with the result.
+
+Examples:
+  growth storage migrate --to sqlite
+  growth storage migrate --to fs`,
+	RunE: runStorageMigrate,
+}
+
+var storageMaterializeCmd = &cobra.Command{
+	Use:   "materialize",
+	Short: "Write the markdown files from the database",
+	Long: `Write the canonical markdown files from the SQLite database, so the repository
+stays readable as plain text and its history can be kept in git.
+
+Files are created, updated and deleted to match the database exactly; files
+whose content already matches are not rewritten. With --from-files the
+direction is reversed and the database is updated from the markdown files,
+for example after editing them by hand or pulling changes.
+
+Requires storage.backend: sqlite.
+
+Examples:
+  growth storage materialize
+  growth storage materialize --commit
+  growth storage materialize --from-files`,
+	RunE: runStorageMaterialize,
+}
+
+func init() {
+	rootCmd.AddCommand(storageCmd)
+	storageCmd.AddCommand(storageMigrateCmd)
+	storageCmd.AddCommand(storageMaterializeCmd)
+
+	storageMigrateCmd.Flags().StringVar(&storageMigrateTo, "to", "sqlite", "target backend: sqlite or fs")
+
+	storageMaterializeCmd.Flags().BoolVar(&storageMaterializeFromFiles, "from-files", false, "update the database from the markdown files instead")
+	storageMaterializeCmd.Flags().BoolVar(&storageMaterializeCommit, "commit", false, "commit the changed markdown files to git")
+}
+
+// sqlitePath returns the database file, by default .growth/growth.db
+func sqlitePath() string {
+	path := config.Storage.Path
+	if path == "" {
+		return filepath.Join(repoPath, ".growth", "growth.db")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoPath, path)
+	}
+	return path
+}
+
+// ignoreDatabase keeps the database file and its journals out of git, so
+// 'growth sync' does not commit them next to the markdown
+func ignoreDatabase() error {
+	rel, err := filepath.Rel(repoPath, sqlitePath())
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return nil
+	}
+	rel = filepath.ToSlash(rel)
+	return ensureGitignored(repoPath, rel, rel+"-wal", rel+"-shm")
+}
+
+// openSQLiteRepositories stores entities in the SQLite database
+func openSQLiteRepositories() error {
+	var err error
+	database, err = storage.OpenSQLite(sqlitePath())
+	if err != nil {
+		return err
+	}
+
+	if skillRepo, err = storage.NewSkillSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize skill repository: %w", err)
+	}
+	if goalRepo, err = storage.NewGoalSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize goal repository: %w", err)
+	}
+	if pathRepo, err = storage.NewPathSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize path repository: %w", err)
+	}
+	if phaseRepo, err = storage.NewPhaseSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize phase repository: %w", err)
+	}
+	if resourceRepo, err = storage.NewResourceSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize resource repository: %w", err)
+	}
+	if milestoneRepo, err = storage.NewMilestoneSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize milestone repository: %w", err)
+	}
+	if progressRepo, err = storage.NewProgressLogSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize progress repository: %w", err)
+	}
+	if noteRepo, err = storage.NewNoteSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize note repository: %w", err)
+	}
+	if feedRepo, err = storage.NewFeedSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize feed repository: %w", err)
+	}
+	if objectiveRepo, err = storage.NewObjectiveSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize objective repository: %w", err)
+	}
+	if snapshotRepo, err = storage.NewSnapshotSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize snapshot repository: %w", err)
+	}
+	if sessionRepo, err = storage.NewSessionSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize session repository: %w", err)
+	}
+	if credentialRepo, err = storage.NewCredentialSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize credential repository: %w", err)
+	}
+	if outputRepo, err = storage.NewOutputSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize output repository: %w", err)
+	}
+	if mentorRepo, err = storage.NewMentorSessionSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize mentor session repository: %w", err)
+	}
+	if feedbackRepo, err = storage.NewFeedbackSQLiteRepository(database); err != nil {
+		return fmt.Errorf("failed to initialize feedback repository: %w", err)
+	}
+
+	return nil
+}
+
+// storageEntity moves the entities of one type between their markdown
+// directory and the database
+type storageEntity struct {
+	entityType  string
+	dir         string
+	migrate     func(ctx context.Context, entityType, dir string, db *sql.DB, toSQLite bool) (int, error)
+	materialize func(ctx context.Context, entityType, dir string, db *sql.DB, fromFiles bool) (storage.SyncResult, error)
+}
+
+var storageEntities = []storageEntity{
+	{"skill", "skills", migrateEntities[core.Skill], materializeEntities[core.Skill]},
+	{"goal", "goals", migrateEntities[core.Goal], materializeEntities[core.Goal]},
+	{"path", "paths", migrateEntities[core.LearningPath], materializeEntities[core.LearningPath]},
+	{"phase", "phases", migrateEntities[core.Phase], materializeEntities[core.Phase]},
+	{"resource", "resources", migrateEntities[core.Resource], materializeEntities[core.Resource]},
+	{"milestone", "milestones", migrateEntities[core.Milestone], materializeEntities[core.Milestone]},
+	{"progress", "progress", migrateEntities[core.ProgressLog], materializeEntities[core.ProgressLog]},
+	{"note", "notes", migrateEntities[core.Note], materializeEntities[core.Note]},
+	{"feed", "feeds", migrateEntities[core.Feed], materializeEntities[core.Feed]},
+	{"objective", "objectives", migrateEntities[core.Objective], materializeEntities[core.Objective]},
+	{"snapshot", "snapshots", migrateEntities[core.Snapshot], materializeEntities[core.Snapshot]},
+	{"session", "sessions", migrateEntities[core.Session], materializeEntities[core.Session]},
+	{"credential", "credentials", migrateEntities[core.Credential], materializeEntities[core.Credential]},
+	{"output", "outputs", migrateEntities[core.Output], materializeEntities[core.Output]},
+	{"mentor", "mentoring", migrateEntities[core.MentorSession], materializeEntities[core.MentorSession]},
+	{"feedback", "feedback", migrateEntities[core.Feedback], materializeEntities[core.Feedback]},
+}
+
+// backendRepositories opens the markdown and database repositories of one
+// entity type without git or event integration
+func backendRepositories[T any, P storage.EntityPointer[T]](entityType, dir string, db *sql.DB) (storage.Repository[T], storage.Repository[T], error) {
+	fsRepo, err := storage.NewFilesystemRepository[T, P](filepath.Join(repoPath, dir), entityType)
+	if err != nil {
+		return nil, nil, err
+	}
+	sqlRepo, err := storage.NewSQLiteRepository[T, P](db, entityType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fsRepo, sqlRepo, nil
+}
+
+func migrateEntities[T any, P storage.EntityPointer[T]](ctx context.Context, entityType, dir string, db *sql.DB, toSQLite bool) (int, error) {
+	fsRepo, sqlRepo, err := backendRepositories[T, P](entityType, dir, db)
+	if err != nil {
+		return 0, err
+	}
+
+	if toSQLite {
+		return storage.CopyEntities[T, P](ctx, fsRepo, sqlRepo)
+	}
+	return storage.CopyEntities[T, P](ctx, sqlRepo, fsRepo)
+}
+
+func materializeEntities[T any, P storage.EntityPointer[T]](ctx context.Context, entityType, dir string, db *sql.DB, fromFiles bool) (storage.SyncResult, error) {
+	fsRepo, sqlRepo, err := backendRepositories[T, P](entityType, dir, db)
+	if err != nil {
+		return storage.SyncResult{}, err
+	}
+
+	if fromFiles {
+		return storage.SyncEntities[T, P](ctx, fsRepo, sqlRepo)
+	}
+	return storage.SyncEntities[T, P](ctx, sqlRepo, fsRepo)
+}
+
+func runStorageMigrate(cmd *cobra.Command, args []string) error {
+	if storageMigrateTo != "sqlite" && storageMigrateTo != "fs" {
+		return fmt.Errorf("invalid target backend '%s', must be one of: sqlite, fs", storageMigrateTo)
+	}
+
+	current := config.Storage.Backend
+	if current == "" {
+		current = "fs"
+	}
+	if current == storageMigrateTo {
+		return fmt.Errorf("repository already uses the %s backend", current)
+	}
+
+	fileConfig, err := storage.LoadConfig(cfgFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config %s: %w", cfgFile, err)
+	}
+
+	db := database
+	if db == nil {
+		if db, err = storage.OpenSQLite(sqlitePath()); err != nil {
+			return err
+		}
+		defer db.Close()
+	}
+
+	toSQLite := storageMigrateTo == "sqlite"
+	total := 0
+	for _, m := range storageEntities {
+		copied, err := m.migrate(cmd.Context(), m.entityType, m.dir, db, toSQLite)
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s entities (%d copied): %w", m.entityType, copied, err)
+		}
+		if copied > 0 {
+			fmt.Printf("  %-10s %d\n", m.entityType, copied)
+		}
+		total += copied
+	}
+
+	if toSQLite {
+		if err := ignoreDatabase(); err != nil {
+			PrintWarning(fmt.Sprintf("Could not add the database to .gitignore: %v", err))
+		}
+	}
+
+	fileConfig.Storage.Backend = storageMigrateTo
+	if err := storage.SaveConfig(fileConfig, cfgFile); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Copied %d entities from %s to %s and switched the config to %s", total, current, storageMigrateTo, storageMigrateTo))
+	if toSQLite {
+		PrintInfo(fmt.Sprintf("Database: %s. The markdown files were left in place.", sqlitePath()))
+	} else {
+		PrintInfo(fmt.Sprintf("The database %s was left in place.", sqlitePath()))
+	}
+
+	return nil
+}
+
+func runStorageMaterialize(cmd *cobra.Command, args []string) error {
+	if database == nil {
+		return fmt.Errorf("storage materialize requires the sqlite backend. Use 'growth storage migrate --to sqlite' to switch")
+	}
+
+	target := "markdown files"
+	if storageMaterializeFromFiles {
+		target = "database"
+
+		// Syncing from an empty tree would delete every entity in the database
+		found := false
+		for _, e := range storageEntities {
+			if matches, _ := filepath.Glob(filepath.Join(repoPath, e.dir, e.entityType+"-*.md")); len(matches) > 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no markdown files found. Run 'growth storage materialize' first")
+		}
+	}
+
+	var total storage.SyncResult
+	var changedDirs []string
+	for _, e := range storageEntities {
+		result, err := e.materialize(cmd.Context(), e.entityType, e.dir, database, storageMaterializeFromFiles)
+		if err != nil {
+			return fmt.Errorf("failed to materialize %s entities: %w", e.entityType, err)
+		}
+		if result.Changed() {
+			fmt.Printf("  %-10s %d created, %d updated, %d deleted\n", e.entityType, result.Created, result.Updated, result.Deleted)
+			changedDirs = append(changedDirs, e.dir)
+		}
+		total.Created += result.Created
+		total.Updated += result.Updated
+		total.Deleted += result.Deleted
+		total.Unchanged += result.Unchanged
+	}
+
+	if !total.Changed() {
+		PrintSuccess(fmt.Sprintf("The %s are up to date (%d entities)", target, total.Unchanged))
+		return nil
+	}
+
+	PrintSuccess(fmt.Sprintf("Updated the %s: %d created, %d updated, %d deleted, %d unchanged",
+		target, total.Created, total.Updated, total.Deleted, total.Unchanged))
+
+	if storageMaterializeCommit && !storageMaterializeFromFiles {
+		if err := git.AddContext(cmd.Context(), repoPath, changedDirs); err != nil {
+			return fmt.Errorf("failed to stage markdown files: %w", err)
+		}
+		if err := git.CommitWithOptions(cmd.Context(), repoPath, "Materialize markdown files from database", nil, config.CommitOptions()); err != nil {
+			return fmt.Errorf("failed to commit markdown files: %w", err)
+		}
+		PrintSuccess("Committed the markdown files")
+	}
+
+	return nil
+}
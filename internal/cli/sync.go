@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/git"
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncMessage string
+	syncRemote  string
+	syncNoPush  bool
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Commit changes and sync with the git remote",
+	Long: `Commit all uncommitted changes in the growth repository, then pull from
+and push to the git remote.
+
+The commit message is filled from git.commitMessageTemplate in the config,
+describing the changed entity or, when several changed, how many of each.
+Pulling and pushing are skipped when the remote is not set up. HTTPS remotes
+use the token in GROWTH_GIT_TOKEN when it is set.
+
+Examples:
+  growth sync
+  growth sync -m "Weekly review"
+  growth sync --no-push`,
+	RunE: runSync,
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+
+	syncCmd.Flags().StringVarP(&syncMessage, "message", "m", "", "commit message instead of the template")
+	syncCmd.Flags().StringVar(&syncRemote, "remote", git.DefaultRemote, "git remote to sync with")
+	syncCmd.Flags().BoolVar(&syncNoPush, "no-push", false, "commit and pull without pushing")
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if !git.IsRepo(repoPath) {
+		return fmt.Errorf("%s is not a git repository. Run 'git init' there first", repoPath)
+	}
+
+	status, err := git.StatusContext(ctx, repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to get git status: %w", err)
+	}
+
+	if len(status) > 0 {
+		message := syncMessage
+		if message == "" {
+			root, err := git.GetRepoRoot(repoPath)
+			if err != nil {
+				return err
+			}
+			message = config.Git.CommitMessage(describeChanges(status, titleReader(root)))
+		}
+		if err := git.CommitWithOptions(ctx, repoPath, message, []string{"."}, config.CommitOptions()); err != nil {
+			return fmt.Errorf("failed to commit changes: %w", err)
+		}
+		PrintSuccess(fmt.Sprintf("Committed %s: %s", countOf(len(status), "changed file"), message))
+	} else {
+		PrintInfo("No changes to commit")
+	}
+
+	if url, _ := git.GetConfig(repoPath, "remote."+syncRemote+".url"); url == "" {
+		PrintInfo(fmt.Sprintf("No remote '%s' set up, skipping pull and push", syncRemote))
+		return nil
+	}
+
+	auth := git.AuthFromEnv()
+	progress := startSpinner("Pulling from " + syncRemote)
+	err = pull(cmd, auth)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to pull from %s: %w", syncRemote, err)
+	}
+
+	if syncNoPush {
+		PrintSuccess(fmt.Sprintf("Pulled from %s", syncRemote))
+		return nil
+	}
+
+	progress = startSpinner("Pushing to " + syncRemote)
+	err = git.PushContext(ctx, repoPath, syncRemote, "", auth)
+	progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to push to %s: %w", syncRemote, err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Synced with %s", syncRemote))
+	return nil
+}
+
+// pull fetches the remote and merges the current branch from it, unless the
+// branch was never pushed
+func pull(cmd *cobra.Command, auth git.Auth) error {
+	if err := git.FetchContext(cmd.Context(), repoPath, syncRemote, auth); err != nil {
+		return err
+	}
+
+	pushed, err := git.HasRemoteBranch(repoPath, syncRemote, "")
+	if err != nil || !pushed {
+		return err
+	}
+	return git.PullContext(cmd.Context(), repoPath, syncRemote, "", auth)
+}
+
+// describeChanges builds the commit message context for git status output.
+// A single changed entity is described by its ID and title, read with
+// title; several are summarized by type, like "2 skills, 1 goal".
+func describeChanges(status []string, title func(file string) string) storage.CommitMessage {
+	var changed []changedEntity
+	for _, line := range status {
+		if entity, ok := parseStatusLine(line); ok {
+			changed = append(changed, entity)
+		}
+	}
+
+	switch len(changed) {
+	case 0:
+		return storage.CommitMessage{
+			Operation:  "sync",
+			EntityType: "repository",
+			Title:      countOf(len(status), "changed file"),
+		}
+	case 1:
+		entity := changed[0]
+		msg := storage.CommitMessage{
+			Operation:  entity.operation,
+			EntityType: entity.entityType,
+			ID:         entity.id,
+			Title:      entity.id,
+			Count:      1,
+		}
+		if entity.operation != "delete" {
+			if t := title(entity.path); t != "" {
+				msg.Title = t
+			}
+		}
+		return msg
+	}
+
+	counts := map[string]int{}
+	for _, entity := range changed {
+		counts[entity.entityType]++
+	}
+	types := make([]string, 0, len(counts))
+	for entityType := range counts {
+		types = append(types, entityType)
+	}
+	sort.Strings(types)
+
+	entityType := "entities"
+	if len(types) == 1 {
+		entityType = types[0]
+	}
+
+	parts := make([]string, len(types))
+	for i, t := range types {
+		parts[i] = countOf(counts[t], entityNoun(t))
+	}
+
+	return storage.CommitMessage{
+		Operation:  "sync",
+		EntityType: entityType,
+		Title:      strings.Join(parts, ", "),
+		Count:      len(changed),
+	}
+}
+
+// titleReader returns a function reading the frontmatter title of a file,
+// given relative to the git repository root
+func titleReader(root string) func(file string) string {
+	return func(file string) string {
+		content, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(file)))
+		if err != nil {
+			return ""
+		}
+
+		frontmatter, _, err := storage.ParseFrontmatter(content)
+		if err != nil {
+			return ""
+		}
+
+		title, _ := frontmatter["title"].(string)
+		return title
+	}
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+var syncTasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Sync milestones with Taskwarrior or Todoist",
+	Long: `Mirror active milestones into your task manager and pull back which of
+them you completed there.
+
+Each active milestone gets a task with its title and target date as due
+date, tagged 'growth'. Changes to milestones are copied to their tasks on
+every sync. Completing a task achieves its milestone, and reopening it
+reopens the milestone. Deleting a task stops syncing its milestone.
+
+Configure the task manager in .growth/config.yml:
+
+  tasks:
+    provider: taskwarrior   # or todoist
+    project: learning       # Taskwarrior project or Todoist project ID
+    tag: growth
+
+Taskwarrior needs the task command installed. Todoist reads its API token
+from GROWTH_TODOIST_TOKEN. Which task belongs to which milestone is kept in
+.growth/tasks.yml.
+
+Examples:
+  growth sync tasks`,
+	Args: cobra.NoArgs,
+	RunE: runSyncTasks,
+}
+
+func init() {
+	syncCmd.AddCommand(syncTasksCmd)
+}
+
+func runSyncTasks(cmd *cobra.Command, args []string) error {
+	provider, err := tasks.NewProvider(tasks.Config{
+		Provider: config.Tasks.Provider,
+		Project:  config.Tasks.Project,
+		Tag:      config.Tasks.Tag,
+	}, &http.Client{Timeout: 30 * time.Second})
+	if err != nil {
+		return err
+	}
+
+	statePath := filepath.Join(repoPath, ".growth", "tasks.yml")
+	state, err := tasks.LoadState(statePath)
+	if err != nil {
+		return err
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load milestones: %w", err)
+	}
+	items := make([]tasks.Item, 0, len(milestones))
+	for _, milestone := range milestones {
+		if milestone.Status == core.StatusArchived {
+			continue
+		}
+		items = append(items, tasks.Item{
+			Key:   string(milestone.ID),
+			Title: milestone.Title,
+			Due:   milestone.TargetDate,
+			Done:  milestone.IsAchieved(),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Minute)
+	defer cancel()
+
+	progress := startSpinner(fmt.Sprintf("Syncing milestones with %s", provider.Name()))
+	changes, syncErr := tasks.Sync(ctx, provider, items, state)
+	progress.Stop()
+
+	// Apply and save what did sync, even when the sync stopped halfway
+	applied, err := applyTaskChanges(changes)
+	if saveErr := state.Save(statePath); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	if syncErr != nil {
+		return syncErr
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		fmt.Printf("  %-16s %-10s %s\n", change.Key, change.Action, truncate(change.Title, 50))
+	}
+	if len(changes) == 0 {
+		PrintSuccess(fmt.Sprintf("Milestones are in sync with %s", provider.Name()))
+		return nil
+	}
+	PrintSuccess(fmt.Sprintf("Synced with %s: %s, %s updated in growth",
+		provider.Name(), countOf(len(changes), "change"), countOf(applied, "milestone")))
+	return nil
+}
+
+// applyTaskChanges achieves or reopens the milestones whose tasks were
+// completed or reopened, returning how many were changed
+func applyTaskChanges(changes []tasks.Change) (int, error) {
+	applied := 0
+	for _, change := range changes {
+		if change.Action != tasks.Completed && change.Action != tasks.Reopened {
+			continue
+		}
+
+		milestone, err := milestoneRepo.GetByIDWithBody(core.EntityID(change.Key))
+		if err != nil {
+			return applied, fmt.Errorf("milestone '%s' not found", change.Key)
+		}
+		if err := applyTaskChange(milestone, change); err != nil {
+			return applied, err
+		}
+		if err := milestoneRepo.Update(milestone); err != nil {
+			return applied, fmt.Errorf("failed to update milestone %s: %w", milestone.ID, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+// applyTaskChange achieves a milestone on the day its task was completed, or
+// reopens it
+func applyTaskChange(milestone *core.Milestone, change tasks.Change) error {
+	if change.Action == tasks.Reopened {
+		return milestone.UpdateStatus(core.StatusActive)
+	}
+
+	if err := milestone.UpdateStatus(core.StatusCompleted); err != nil {
+		return err
+	}
+	achieved := time.Now()
+	if change.Date != nil {
+		achieved = *change.Date
+	}
+	milestone.AchievedDate = &achieved
+	return nil
+}
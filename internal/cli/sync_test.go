@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/illenko/growth.md/internal/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeChanges(t *testing.T) {
+	titles := func(file string) string {
+		if file == "skills/skill-001-python.md" {
+			return "Python"
+		}
+		return ""
+	}
+
+	t.Run("describes a single entity", func(t *testing.T) {
+		msg := describeChanges([]string{"?? skills/skill-001-python.md"}, titles)
+		assert.Equal(t, storage.CommitMessage{Operation: "create", EntityType: "skill", ID: "skill-001", Title: "Python", Count: 1}, msg)
+
+		msg = describeChanges([]string{" M skills/skill-001-python.md", " M .growth/config.yml"}, titles)
+		assert.Equal(t, "update", msg.Operation)
+		assert.Equal(t, "Python", msg.Title)
+	})
+
+	t.Run("uses the ID of deleted entities", func(t *testing.T) {
+		msg := describeChanges([]string{" D goals/goal-002-staff.md"}, titles)
+		assert.Equal(t, "delete", msg.Operation)
+		assert.Equal(t, "goal-002", msg.Title)
+	})
+
+	t.Run("follows renames", func(t *testing.T) {
+		msg := describeChanges([]string{"R  skills/skill-001-py.md -> skills/skill-001-python.md"}, titles)
+		assert.Equal(t, "skill-001", msg.ID)
+		assert.Equal(t, "Python", msg.Title)
+	})
+
+	t.Run("summarizes several entities by type", func(t *testing.T) {
+		msg := describeChanges([]string{
+			" M skills/skill-001-python.md",
+			"?? skills/skill-002-go.md",
+			"?? goals/goal-001-staff.md",
+			"?? progress/progress-003-2025-01-06.md",
+		}, titles)
+		assert.Equal(t, storage.CommitMessage{Operation: "sync", EntityType: "entities", Title: "1 goal, 1 progress log, 2 skills", Count: 4}, msg)
+
+		msg = describeChanges([]string{" M skills/skill-001-python.md", "?? skills/skill-002-go.md"}, titles)
+		assert.Equal(t, "skill", msg.EntityType)
+	})
+
+	t.Run("counts files without entities", func(t *testing.T) {
+		msg := describeChanges([]string{" M .growth/config.yml", "?? README.md"}, titles)
+		assert.Equal(t, storage.CommitMessage{Operation: "sync", EntityType: "repository", Title: "2 changed files"}, msg)
+	})
+}
+
+func TestApplyTaskChange(t *testing.T) {
+	milestone, _ := core.NewMilestone("milestone-001", "Ship", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	completed := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, applyTaskChange(milestone, tasks.Change{Key: "milestone-001", Action: tasks.Completed, Date: &completed}))
+	assert.True(t, milestone.IsAchieved())
+	assert.Equal(t, completed, *milestone.AchievedDate)
+
+	require.NoError(t, applyTaskChange(milestone, tasks.Change{Key: "milestone-001", Action: tasks.Reopened}))
+	assert.False(t, milestone.IsAchieved())
+
+	require.NoError(t, applyTaskChange(milestone, tasks.Change{Key: "milestone-001", Action: tasks.Completed}))
+	assert.True(t, milestone.IsAchieved())
+	assert.True(t, milestone.AchievedDate.After(completed), "completing again without a date should use today")
+}
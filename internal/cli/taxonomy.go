@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/importer"
+	"github.com/illenko/growth.md/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taxonomyName     string
+	taxonomyFormat   string
+	taxonomyCategory string
+	taxonomySearch   string
+)
+
+var taxonomyCmd = &cobra.Command{
+	Use:   "taxonomy",
+	Short: "Manage the skills taxonomy",
+	Long: `Import a standard skills taxonomy such as SFIA or ESCO to get consistent
+skill names and categories, and to find gaps against industry-standard
+skill definitions.
+
+The imported taxonomy is stored in .growth/taxonomy.yml.`,
+}
+
+var taxonomyImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a skills taxonomy",
+	Long: `Import a skills taxonomy from a CSV or YAML file, replacing any previous import.
+
+CSV files need a header row. Recognised columns:
+  code         code, skill code, id, conceptUri
+  title        title, name, skill, preferredLabel
+  category     category, subcategory, group, skillType
+  description  description, overall description, definition
+
+This covers the SFIA skills spreadsheet and ESCO skills downloads. YAML files
+contain a list of entries with code, title, category and description, either
+at the top level or under an entries key.
+
+Examples:
+  growth taxonomy import sfia-8.csv --name "SFIA 8"
+  growth taxonomy import esco_skills_en.csv --name ESCO
+  growth taxonomy import team-skills.yml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaxonomyImport,
+}
+
+var taxonomyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List taxonomy skills",
+	Long: `List skills defined in the imported taxonomy.
+
+Examples:
+  growth taxonomy list
+  growth taxonomy list --category "Development and implementation"
+  growth taxonomy list --search database`,
+	Aliases: []string{"ls"},
+	RunE:    runTaxonomyList,
+}
+
+var taxonomyGapsCmd = &cobra.Command{
+	Use:   "gaps",
+	Short: "Show taxonomy skills you are not tracking",
+	Long: `Compare your skills against the imported taxonomy and list the
+taxonomy skills you do not track yet, grouped by category.
+
+Skills match by taxonomy code (set with 'skill create --from-taxonomy')
+or by title.
+
+Examples:
+  growth taxonomy gaps
+  growth taxonomy gaps --category "Delivery and operation"`,
+	RunE: runTaxonomyGaps,
+}
+
+func init() {
+	rootCmd.AddCommand(taxonomyCmd)
+	taxonomyCmd.AddCommand(taxonomyImportCmd)
+	taxonomyCmd.AddCommand(taxonomyListCmd)
+	taxonomyCmd.AddCommand(taxonomyGapsCmd)
+
+	taxonomyImportCmd.Flags().StringVar(&taxonomyName, "name", "", "taxonomy name (defaults to the file name)")
+	taxonomyImportCmd.Flags().StringVar(&taxonomyFormat, "format", "", "file format: csv, yaml (defaults to the file extension)")
+
+	taxonomyListCmd.Flags().StringVarP(&taxonomyCategory, "category", "c", "", "filter by category")
+	taxonomyListCmd.Flags().StringVar(&taxonomySearch, "search", "", "filter by text in the code, title or description")
+
+	taxonomyGapsCmd.Flags().StringVarP(&taxonomyCategory, "category", "c", "", "only show gaps in this category")
+}
+
+func runTaxonomyImport(cmd *cobra.Command, args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open taxonomy file: %w", err)
+	}
+	defer file.Close()
+
+	format := taxonomyFormat
+	if format == "" {
+		format = importer.GuessTaxonomyFormat(args[0])
+	}
+
+	entries, err := importer.ParseTaxonomy(file, format)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		return fmt.Errorf("no skills found in %s", args[0])
+	}
+
+	name := taxonomyName
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+	}
+
+	taxonomy := &core.Taxonomy{
+		Name:     name,
+		Source:   filepath.Base(args[0]),
+		Imported: time.Now(),
+		Entries:  entries,
+	}
+
+	if err := storage.SaveTaxonomy(taxonomy, taxonomyPath()); err != nil {
+		return fmt.Errorf("failed to save taxonomy: %w", err)
+	}
+
+	PrintSuccess(fmt.Sprintf("Imported taxonomy %s: %d skills in %d categories", taxonomy.Name, len(entries), len(taxonomy.Categories())))
+	fmt.Println("Create skills from it with: growth skill create --from-taxonomy <code>")
+	return nil
+}
+
+func runTaxonomyList(cmd *cobra.Command, args []string) error {
+	taxonomy, err := loadTaxonomy()
+	if err != nil {
+		return err
+	}
+
+	entries := filterTaxonomyEntries(taxonomy.Entries, taxonomyCategory, taxonomySearch)
+	if len(entries) == 0 {
+		PrintInfo("No taxonomy skills found")
+		return nil
+	}
+
+	if config.Display.OutputFormat == "table" {
+		for _, entry := range entries {
+			fmt.Printf("%-12s  %-45s  %s\n", truncate(entry.Code, 12), truncate(entry.Title, 45), entry.Category)
+		}
+		return nil
+	}
+
+	return PrintOutputWithConfig(entries)
+}
+
+func runTaxonomyGaps(cmd *cobra.Command, args []string) error {
+	taxonomy, err := loadTaxonomy()
+	if err != nil {
+		return err
+	}
+
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	gaps := filterTaxonomyEntries(core.TaxonomyGaps(taxonomy, skills), taxonomyCategory, "")
+	if len(gaps) == 0 {
+		PrintSuccess("You track every skill in this taxonomy")
+		return nil
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(gaps)
+	}
+
+	byCategory := make(map[string][]core.TaxonomyEntry)
+	for _, gap := range gaps {
+		byCategory[gap.Category] = append(byCategory[gap.Category], gap)
+	}
+
+	fmt.Printf("%d of %d %s skills are not tracked yet\n", len(gaps), len(taxonomy.Entries), taxonomy.Name)
+	for _, category := range append(taxonomy.Categories(), "") {
+		entries := byCategory[category]
+		if len(entries) == 0 {
+			continue
+		}
+		if category == "" {
+			category = "Uncategorized"
+		}
+		fmt.Printf("\n%s (%d):\n", category, len(entries))
+		for _, entry := range entries {
+			fmt.Printf("  %-12s  %s\n", truncate(entry.Code, 12), entry.Title)
+		}
+	}
+
+	return nil
+}
+
+func taxonomyPath() string {
+	return filepath.Join(repoPath, ".growth", "taxonomy.yml")
+}
+
+// loadTaxonomy reads the imported taxonomy with a hint when there is none
+func loadTaxonomy() (*core.Taxonomy, error) {
+	taxonomy, err := storage.LoadTaxonomy(taxonomyPath())
+	if errors.Is(err, storage.ErrNoTaxonomy) {
+		return nil, errors.New("no taxonomy imported. Use 'growth taxonomy import <file>' first")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load taxonomy: %w", err)
+	}
+	return taxonomy, nil
+}
+
+func filterTaxonomyEntries(entries []core.TaxonomyEntry, category, search string) []core.TaxonomyEntry {
+	search = strings.ToLower(search)
+
+	var results []core.TaxonomyEntry
+	for _, entry := range entries {
+		if category != "" && !strings.EqualFold(entry.Category, category) {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(entry.Code), search) &&
+			!strings.Contains(strings.ToLower(entry.Title), search) &&
+			!strings.Contains(strings.ToLower(entry.Description), search) {
+			continue
+		}
+		results = append(results, entry)
+	}
+	return results
+}
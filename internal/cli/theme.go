@@ -0,0 +1,60 @@
+package cli
+
+const colorReset = "\033[0m"
+
+// theme decorates terminal output: the ANSI colors of the status prefixes
+// and whether emoji and unicode symbols are used, see the glyph package. A
+// color is empty when it is not used.
+type theme struct {
+	success string
+	failure string
+	warning string
+	info    string
+	emoji   bool
+}
+
+// themes are the values of display.theme in config.yml
+var themes = map[string]theme{
+	"default": {
+		success: "\033[32m",
+		failure: "\033[31m",
+		warning: "\033[33m",
+		info:    "\033[34m",
+		emoji:   true,
+	},
+	// dark uses the bright variants, which stay readable on dark backgrounds
+	"dark": {
+		success: "\033[92m",
+		failure: "\033[91m",
+		warning: "\033[93m",
+		info:    "\033[96m",
+		emoji:   true,
+	},
+	"plain": {},
+	// no-emoji keeps the colors but prints ASCII in place of emoji and symbols
+	"no-emoji": {
+		success: "\033[32m",
+		failure: "\033[31m",
+		warning: "\033[33m",
+		info:    "\033[34m",
+	},
+}
+
+// currentTheme returns the configured theme, or the default one when
+// display.theme is unset or unknown
+func currentTheme() theme {
+	if config != nil {
+		if t, ok := themes[config.Display.Theme]; ok {
+			return t
+		}
+	}
+	return themes["default"]
+}
+
+// paint wraps text in the color, if there is one
+func paint(color, text string) string {
+	if color == "" {
+		return text
+	}
+	return color + text + colorReset
+}
@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ticketLinks   []string
+	ticketUnlinks []string
+	ticketOffline bool
+)
+
+var ticketsCmd = &cobra.Command{
+	Use:   "tickets [id...]",
+	Short: "Poll the status of Jira tickets linked to goals and milestones",
+	Long: `Poll Jira for the status of the tickets linked to goals and milestones,
+so work deliverables that evidence growth are tracked alongside personal
+milestones.
+
+Link tickets with --link on goal or milestone create and edit. Without IDs,
+all goals and milestones with tickets are polled. Status changes are kept in
+the entity's history. When all tickets of an open goal or milestone are done,
+growth suggests completing it.
+
+Configure the Jira site in .growth/config.yml:
+
+  jira:
+    url: https://example.atlassian.net
+    email: you@example.com   # omit for a personal access token
+
+The API token is read from GROWTH_JIRA_TOKEN.
+
+Examples:
+  growth goal edit goal-001 --link WORK-123
+  growth tickets
+  growth tickets milestone-004
+  growth tickets --offline -f json`,
+	RunE: runTickets,
+}
+
+func init() {
+	rootCmd.AddCommand(ticketsCmd)
+
+	ticketsCmd.Flags().BoolVar(&ticketOffline, "offline", false, "show the last polled statuses without contacting Jira")
+}
+
+// ticketRow is the status of one linked ticket
+type ticketRow struct {
+	Entity  core.EntityID `json:"entity" yaml:"entity"`
+	Ticket  string        `json:"ticket" yaml:"ticket"`
+	Status  string        `json:"status" yaml:"status"`
+	Done    bool          `json:"done" yaml:"done"`
+	Checked *time.Time    `json:"checked,omitempty" yaml:"checked,omitempty"`
+}
+
+func runTickets(cmd *cobra.Command, args []string) error {
+	goals, milestones, err := loadTicketEntities(args)
+	if err != nil {
+		return err
+	}
+	if len(goals) == 0 && len(milestones) == 0 {
+		PrintInfo("No tickets linked. Use --link on goal or milestone create and edit to link one")
+		return nil
+	}
+
+	if !ticketOffline {
+		client, err := newJiraClient()
+		if err != nil {
+			return err
+		}
+		if err := pollEntityTickets(cmd.Context(), client, goals, milestones); err != nil {
+			return err
+		}
+	}
+
+	var rows []ticketRow
+	for _, goal := range goals {
+		rows = append(rows, ticketRows(goal.ID, goal.Tickets)...)
+	}
+	for _, milestone := range milestones {
+		rows = append(rows, ticketRows(milestone.ID, milestone.Tickets)...)
+	}
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(rows)
+	}
+
+	for _, row := range rows {
+		checked := ""
+		if row.Checked != nil {
+			checked = "checked " + formatDate(*row.Checked)
+		}
+		fmt.Printf("  %-16s %-12s %-20s %s\n", row.Entity, row.Ticket, truncate(row.Status, 20), checked)
+	}
+
+	for _, goal := range goals {
+		if goal.Tickets.AllDone() && goal.Status != core.StatusCompleted && goal.Status != core.StatusArchived {
+			PrintInfo(fmt.Sprintf("All tickets of %s are done: complete it with 'growth goal edit %s --status completed'", goal.ID, goal.ID))
+		}
+	}
+	for _, milestone := range milestones {
+		if milestone.Tickets.AllDone() && !milestone.IsAchieved() && milestone.Status != core.StatusArchived {
+			PrintInfo(fmt.Sprintf("All tickets of %s are done: achieve it with 'growth milestone achieve %s'", milestone.ID, milestone.ID))
+		}
+	}
+	return nil
+}
+
+// loadTicketEntities loads the goals and milestones with the IDs, or all of
+// them that have tickets
+func loadTicketEntities(ids []string) ([]*core.Goal, []*core.Milestone, error) {
+	var goals []*core.Goal
+	var milestones []*core.Milestone
+
+	if len(ids) > 0 {
+		for _, arg := range ids {
+			id := core.EntityID(arg)
+			switch {
+			case strings.HasPrefix(arg, "goal-"):
+				goal, err := goalRepo.GetByIDWithBody(id)
+				if err != nil {
+					return nil, nil, fmt.Errorf("goal '%s' not found. Use 'growth goal list' to see available goals", id)
+				}
+				goals = append(goals, goal)
+			case strings.HasPrefix(arg, "milestone-"):
+				milestone, err := milestoneRepo.GetByIDWithBody(id)
+				if err != nil {
+					return nil, nil, fmt.Errorf("milestone '%s' not found. Use 'growth milestone list' to see available milestones", id)
+				}
+				milestones = append(milestones, milestone)
+			default:
+				return nil, nil, fmt.Errorf("tickets can only be linked to goals and milestones, not '%s'", arg)
+			}
+		}
+		return goals, milestones, nil
+	}
+
+	allGoals, err := goalRepo.GetAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load goals: %w", err)
+	}
+	for _, goal := range allGoals {
+		if len(goal.Tickets) == 0 {
+			continue
+		}
+		withBody, err := goalRepo.GetByIDWithBody(goal.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load goal %s: %w", goal.ID, err)
+		}
+		goals = append(goals, withBody)
+	}
+
+	allMilestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load milestones: %w", err)
+	}
+	for _, milestone := range allMilestones {
+		if len(milestone.Tickets) == 0 {
+			continue
+		}
+		withBody, err := milestoneRepo.GetByIDWithBody(milestone.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load milestone %s: %w", milestone.ID, err)
+		}
+		milestones = append(milestones, withBody)
+	}
+
+	sort.Slice(goals, func(i, j int) bool { return goals[i].ID < goals[j].ID })
+	sort.Slice(milestones, func(i, j int) bool { return milestones[i].ID < milestones[j].ID })
+	return goals, milestones, nil
+}
+
+// newJiraClient creates a client for the configured Jira site
+func newJiraClient() (*jira.Client, error) {
+	if config.Jira.URL == "" {
+		return nil, fmt.Errorf("no Jira site configured: set jira.url in .growth/config.yml")
+	}
+	token := os.Getenv(jira.TokenEnvVar)
+	if token == "" {
+		return nil, fmt.Errorf("jira needs an API token: set %s", jira.TokenEnvVar)
+	}
+	return jira.NewClient(&http.Client{Timeout: 30 * time.Second}, config.Jira.URL, config.Jira.Email, token), nil
+}
+
+// pollEntityTickets updates the tickets of the goals and milestones and saves
+// the ones whose tickets changed. Tickets that cannot be read keep their last
+// status.
+func pollEntityTickets(ctx context.Context, client *jira.Client, goals []*core.Goal, milestones []*core.Milestone) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	progress := startSpinner("Polling Jira")
+	issues := map[string]jira.Issue{}
+	var failed []string
+	fetch := func(key string) (jira.Issue, bool) {
+		if issue, ok := issues[key]; ok {
+			return issue, true
+		}
+		issue, err := client.Issue(ctx, key)
+		if err != nil {
+			failed = append(failed, err.Error())
+			return jira.Issue{}, false
+		}
+		issues[key] = issue
+		return issue, true
+	}
+
+	now := time.Now()
+	var changedGoals []*core.Goal
+	var changedMilestones []*core.Milestone
+	for _, goal := range goals {
+		if updateTickets(goal.Tickets, &goal.History, fetch, now) {
+			changedGoals = append(changedGoals, goal)
+		}
+	}
+	for _, milestone := range milestones {
+		if updateTickets(milestone.Tickets, &milestone.History, fetch, now) {
+			changedMilestones = append(changedMilestones, milestone)
+		}
+	}
+	progress.Stop()
+
+	for _, msg := range failed {
+		PrintWarning(msg)
+	}
+	for _, goal := range changedGoals {
+		if err := goalRepo.Update(goal); err != nil {
+			return fmt.Errorf("failed to update goal %s: %w", goal.ID, err)
+		}
+	}
+	for _, milestone := range changedMilestones {
+		if err := milestoneRepo.Update(milestone); err != nil {
+			return fmt.Errorf("failed to update milestone %s: %w", milestone.ID, err)
+		}
+	}
+	return nil
+}
+
+// updateTickets sets the polled status of each ticket, recording status
+// changes in the history. It reports whether any ticket was polled.
+func updateTickets(tickets core.Tickets, history *core.History, fetch func(key string) (jira.Issue, bool), now time.Time) bool {
+	polled := false
+	for i := range tickets {
+		ticket := &tickets[i]
+		issue, ok := fetch(ticket.Key)
+		if !ok {
+			continue
+		}
+
+		history.Record("ticket "+ticket.Key, ticket.Status, issue.Status)
+		ticket.Status = issue.Status
+		ticket.Done = issue.Done
+		checked := now
+		ticket.Checked = &checked
+		polled = true
+	}
+	return polled
+}
+
+// linkTickets links and unlinks the ticket keys given with --link and
+// --unlink, reporting whether anything changed
+func linkTickets(tickets *core.Tickets, link, unlink []string) (bool, error) {
+	changed := false
+	for _, key := range unlink {
+		normalized, err := core.ParseTicketKey(key)
+		if err != nil {
+			return false, err
+		}
+		if !tickets.Unlink(normalized) {
+			return false, fmt.Errorf("ticket %s is not linked", normalized)
+		}
+		changed = true
+	}
+	for _, key := range link {
+		normalized, err := core.ParseTicketKey(key)
+		if err != nil {
+			return false, err
+		}
+		if tickets.Link(normalized) {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func ticketRows(id core.EntityID, tickets core.Tickets) []ticketRow {
+	rows := make([]ticketRow, 0, len(tickets))
+	for _, ticket := range tickets {
+		status := ticket.Status
+		if status == "" {
+			status = "unknown"
+		}
+		rows = append(rows, ticketRow{Entity: id, Ticket: ticket.Key, Status: status, Done: ticket.Done, Checked: ticket.Checked})
+	}
+	return rows
+}
+
+// printTickets lists the linked tickets with their last polled status
+func printTickets(tickets core.Tickets) {
+	if len(tickets) == 0 {
+		return
+	}
+
+	fmt.Println("\nTickets:")
+	for _, ticket := range tickets {
+		status := ticket.Status
+		if status == "" {
+			status = "not polled yet"
+		}
+		if ticket.Checked != nil {
+			status += ", checked " + formatDate(*ticket.Checked)
+		}
+		fmt.Printf("  %-12s %s\n", ticket.Key, status)
+	}
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/jira"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkTickets(t *testing.T) {
+	t.Run("links and unlinks normalized keys", func(t *testing.T) {
+		tickets := core.Tickets{{Key: "WORK-1", Status: "Done", Done: true}}
+
+		changed, err := linkTickets(&tickets, []string{"work-12", "WORK-1"}, []string{"WORK-1"})
+
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, core.Tickets{{Key: "WORK-12"}, {Key: "WORK-1"}}, tickets)
+	})
+
+	t.Run("reports no change for linked tickets", func(t *testing.T) {
+		tickets := core.Tickets{{Key: "WORK-1"}}
+
+		changed, err := linkTickets(&tickets, []string{"WORK-1"}, nil)
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("rejects invalid and unlinked keys", func(t *testing.T) {
+		var tickets core.Tickets
+
+		_, err := linkTickets(&tickets, []string{"https://jira/WORK-1"}, nil)
+		assert.ErrorContains(t, err, "invalid ticket key")
+
+		_, err = linkTickets(&tickets, nil, []string{"WORK-2"})
+		assert.ErrorContains(t, err, "WORK-2 is not linked")
+	})
+}
+
+func TestUpdateTickets(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	fetch := func(key string) (jira.Issue, bool) {
+		if key == "WORK-404" {
+			return jira.Issue{}, false
+		}
+		return jira.Issue{Key: key, Status: "Done", Done: true}, true
+	}
+
+	t.Run("updates statuses and records changes", func(t *testing.T) {
+		tickets := core.Tickets{{Key: "WORK-1", Status: "In Review"}, {Key: "WORK-404", Status: "Open"}}
+		var history core.History
+
+		polled := updateTickets(tickets, &history, fetch, now)
+
+		assert.True(t, polled)
+		assert.Equal(t, core.Ticket{Key: "WORK-1", Status: "Done", Done: true, Checked: &now}, tickets[0])
+		assert.Equal(t, core.Ticket{Key: "WORK-404", Status: "Open"}, tickets[1])
+		require.Len(t, history, 1)
+		assert.Equal(t, "ticket WORK-1", history[0].Field)
+		assert.Equal(t, "In Review", history[0].From)
+		assert.Equal(t, "Done", history[0].To)
+	})
+
+	t.Run("keeps history quiet when the status did not change", func(t *testing.T) {
+		tickets := core.Tickets{{Key: "WORK-1", Status: "Done", Done: true}}
+		var history core.History
+
+		assert.True(t, updateTickets(tickets, &history, fetch, now))
+		assert.Empty(t, history)
+	})
+
+	t.Run("reports when no ticket could be polled", func(t *testing.T) {
+		tickets := core.Tickets{{Key: "WORK-404"}}
+		var history core.History
+
+		assert.False(t, updateTickets(tickets, &history, fetch, now))
+	})
+}
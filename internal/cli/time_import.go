@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/timetrack"
+	"github.com/spf13/cobra"
+)
+
+var (
+	timeProvider string
+	timeProject  string
+	timeSince    string
+	timeUntil    string
+	timeDryRun   bool
+)
+
+var timeCmd = &cobra.Command{
+	Use:   "time",
+	Short: "Import tracked time",
+	Long:  `Bring time tracked in Toggl Track or Clockify into progress logs.`,
+}
+
+var timeImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import time entries into weekly progress logs",
+	Long: `Pull time entries from Toggl Track or Clockify and fill weekly progress
+logs with the hours tracked and how they split across skills.
+
+Entries are added up per week into one progress log per week, which records
+the time tracker as its source. Importing again replaces the hours of weeks
+already imported, so a week is never counted twice. Import starts on the
+first day of the week containing --since.
+
+Tags of entries are mapped to skills: a tag names a skill by its ID or its
+title (system-design matches System Design), or is mapped under time.tags in
+.growth/config.yml. The hours of an entry are split evenly across its
+skills; entries without one only count towards the week's hours.
+
+  time:
+    provider: toggl        # or clockify
+    project: Learning
+    tags:
+      k8s: skill-004
+
+The API token is read from GROWTH_TOGGL_TOKEN or GROWTH_CLOCKIFY_TOKEN.
+
+Examples:
+  growth time import --provider toggl --project Learning
+  growth time import --since "8 weeks ago" --dry-run
+  growth time import --provider clockify --since 2025-01-01`,
+	Args: cobra.NoArgs,
+	RunE: runTimeImport,
+}
+
+func init() {
+	rootCmd.AddCommand(timeCmd)
+	timeCmd.AddCommand(timeImportCmd)
+
+	timeImportCmd.Flags().StringVar(&timeProvider, "provider", "", "time tracker (toggl, clockify) - defaults to config")
+	timeImportCmd.Flags().StringVar(&timeProject, "project", "", "project to import - defaults to config, or all projects")
+	timeImportCmd.Flags().StringVar(&timeSince, "since", "", "import from the week containing this date, defaults to 4 weeks ago")
+	timeImportCmd.Flags().StringVar(&timeUntil, "until", "", "import up to this date, defaults to today")
+	timeImportCmd.Flags().BoolVar(&timeDryRun, "dry-run", false, "show the weekly hours without saving them")
+}
+
+func runTimeImport(cmd *cobra.Command, args []string) error {
+	cfg := timetrack.Config{Provider: config.Time.Provider, Workspace: config.Time.Workspace}
+	if timeProvider != "" {
+		cfg.Provider = timeProvider
+	}
+	project := config.Time.Project
+	if cmd.Flags().Changed("project") {
+		project = timeProject
+	}
+
+	since := time.Now().AddDate(0, 0, -28)
+	if timeSince != "" {
+		var err error
+		if since, err = parseDateFlag("since", timeSince); err != nil {
+			return err
+		}
+	}
+	until := time.Now()
+	if timeUntil != "" {
+		var err error
+		if until, err = parseDateFlag("until", timeUntil); err != nil {
+			return err
+		}
+	}
+	from := core.StartOfWeek(since)
+	to := time.Date(until.Year(), until.Month(), until.Day()+1, 0, 0, 0, 0, until.Location())
+	if !from.Before(to) {
+		return fmt.Errorf("--since must be before --until")
+	}
+
+	provider, err := timetrack.NewProvider(cfg, &http.Client{Timeout: 30 * time.Second})
+	if err != nil {
+		return err
+	}
+	skills, err := skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 2*time.Minute)
+	defer cancel()
+
+	progress := startSpinner(fmt.Sprintf("Reading time entries from %s", provider.Name()))
+	entries, err := provider.Entries(ctx, from, to, project)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	weeks, unmapped := timeWeeks(entries, func(tag string) (core.EntityID, bool) {
+		return skillForTag(tag, config.Time.Tags, skills)
+	})
+	if len(weeks) == 0 {
+		PrintInfo(fmt.Sprintf("No time entries found since %s", formatDate(from)))
+		return nil
+	}
+
+	if err := importActivityWeeks(cmd.Context(), weeks, provider.Name(), timeDryRun); err != nil {
+		return err
+	}
+	if len(unmapped) > 0 {
+		PrintInfo(fmt.Sprintf("Tags without a skill: %s. Map them to skills under time.tags in .growth/config.yml",
+			strings.Join(unmapped, ", ")))
+	}
+	return nil
+}
+
+// timeWeeks adds up time entries per week, oldest first, splitting the
+// hours of each entry evenly across the skills its tags stand for. It also
+// returns the tags that stand for no skill.
+func timeWeeks(entries []timetrack.Entry, skillFor func(tag string) (core.EntityID, bool)) ([]activityWeek, []string) {
+	byStart := map[time.Time]*activityWeek{}
+	unmapped := map[string]bool{}
+	for _, entry := range entries {
+		start := core.StartOfWeek(entry.Start)
+		week, ok := byStart[start]
+		if !ok {
+			week = &activityWeek{Start: start, Skills: map[core.EntityID]float64{}}
+			byStart[start] = week
+		}
+		week.Hours += entry.Hours
+		week.Sessions++
+
+		var skills []core.EntityID
+		for _, tag := range entry.Tags {
+			id, ok := skillFor(tag)
+			if !ok {
+				unmapped[tag] = true
+				continue
+			}
+			if !slices.Contains(skills, id) {
+				skills = append(skills, id)
+			}
+		}
+		for _, id := range skills {
+			week.Skills[id] += entry.Hours / float64(len(skills))
+		}
+	}
+
+	weeks := make([]activityWeek, 0, len(byStart))
+	for _, week := range byStart {
+		week.Hours = math.Round(week.Hours*100) / 100
+		for id, hours := range week.Skills {
+			week.Skills[id] = math.Round(hours*100) / 100
+		}
+		weeks = append(weeks, *week)
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].Start.Before(weeks[j].Start) })
+
+	tags := make([]string, 0, len(unmapped))
+	for tag := range unmapped {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return weeks, tags
+}
+
+// skillForTag finds the skill a time entry tag stands for: the skill mapped
+// to it in config, the skill with the tag as ID, or the skill whose title
+// matches the tag
+func skillForTag(tag string, mapping map[string]string, skills []*core.Skill) (core.EntityID, bool) {
+	for name, id := range mapping {
+		if strings.EqualFold(name, tag) {
+			return core.EntityID(id), true
+		}
+	}
+
+	normalize := func(s string) string {
+		s = strings.ToLower(strings.TrimSpace(s))
+		return strings.NewReplacer(" ", "-", "_", "-").Replace(s)
+	}
+	want := normalize(tag)
+	for _, skill := range skills {
+		if string(skill.ID) == want || normalize(skill.Title) == want {
+			return skill.ID, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/timetrack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeWeeks(t *testing.T) {
+	at := func(d, hour int) time.Time { return time.Date(2025, 3, d, hour, 0, 0, 0, time.Local) }
+	skillFor := func(tag string) (core.EntityID, bool) {
+		switch tag {
+		case "go", "golang":
+			return "skill-001", true
+		case "k8s":
+			return "skill-004", true
+		}
+		return "", false
+	}
+	entries := []timetrack.Entry{
+		{Start: at(10, 19), Hours: 1, Tags: []string{"k8s"}},
+		{Start: at(3, 19), Hours: 1.5, Tags: []string{"go", "golang"}},
+		{Start: at(4, 19), Hours: 1, Tags: []string{"go", "k8s", "deep-work"}},
+		{Start: at(9, 23), Hours: 0.5},
+	}
+
+	weeks, unmapped := timeWeeks(entries, skillFor)
+
+	require.Len(t, weeks, 2)
+	assert.Equal(t, activityWeek{
+		Start:    at(3, 0),
+		Hours:    3,
+		Sessions: 3,
+		Skills:   map[core.EntityID]float64{"skill-001": 2, "skill-004": 0.5},
+	}, weeks[0])
+	assert.Equal(t, at(10, 0), weeks[1].Start)
+	assert.Equal(t, map[core.EntityID]float64{"skill-004": 1}, weeks[1].Skills)
+	assert.Equal(t, []string{"deep-work"}, unmapped)
+}
+
+func TestSkillForTag(t *testing.T) {
+	skills := []*core.Skill{
+		{ID: "skill-001", Title: "Go"},
+		{ID: "skill-002", Title: "System Design"},
+	}
+	mapping := map[string]string{"K8s": "skill-004"}
+
+	tests := []struct {
+		tag  string
+		want core.EntityID
+	}{
+		{"k8s", "skill-004"},
+		{"skill-001", "skill-001"},
+		{"system-design", "skill-002"},
+		{"System_Design", "skill-002"},
+		{"GO", "skill-001"},
+		{"meetings", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			id, ok := skillForTag(tt.tag, mapping, skills)
+			assert.Equal(t, tt.want, id)
+			assert.Equal(t, tt.want != "", ok)
+		})
+	}
+}
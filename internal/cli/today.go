@@ -0,0 +1,260 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	todayEnergy string
+	todayLimit  int
+)
+
+var todayCmd = &cobra.Command{
+	Use:   "today",
+	Short: "Suggest what to study today",
+	Long: `Suggest what to study today, paced to your energy this week.
+
+Your energy comes from the progress logs of the current week: the logged
+energy (progress log --energy) or, failing that, the mood. Tired weeks put
+light resources such as videos and articles first, motivated weeks put
+projects and other demanding work first.
+
+A resource needs the energy set with 'growth resource edit --energy', else
+the energy of its phase ('growth phase edit --energy'), else a default for
+its type: low for videos and articles, high for projects, medium otherwise.
+
+Blocked goals and their blockers are listed first, then spaced reviews of
+mastered skills that are due (see 'growth review schedule') and credentials
+that expire within 60 days or expired in the last 60 days.
+
+Examples:
+  growth today
+  growth today --energy low
+  growth today --limit 5`,
+	RunE: runToday,
+}
+
+func init() {
+	rootCmd.AddCommand(todayCmd)
+
+	todayCmd.Flags().StringVar(&todayEnergy, "energy", "", "energy to plan for (low, medium, high) - defaults to this week's logs")
+	todayCmd.Flags().IntVarP(&todayLimit, "limit", "n", 3, "number of new resources to suggest")
+}
+
+// todayPlan is the structured output of growth today
+type todayPlan struct {
+	Energy   core.EnergyLevel  `yaml:"energy" json:"energy"`
+	Reported int               `yaml:"reported" json:"reported"` // logs this week that reported mood or energy
+	Reviews  []todayReview     `yaml:"reviews,omitempty" json:"reviews,omitempty"`
+	Expiring []todayCredential `yaml:"expiring,omitempty" json:"expiring,omitempty"`
+	Continue []todaySuggestion `yaml:"continue,omitempty" json:"continue,omitempty"`
+	UpNext   []todaySuggestion `yaml:"upNext,omitempty" json:"upNext,omitempty"`
+}
+
+type todaySuggestion struct {
+	ID     core.EntityID     `yaml:"id" json:"id"`
+	Title  string            `yaml:"title" json:"title"`
+	Type   core.ResourceType `yaml:"type" json:"type"`
+	Energy core.EnergyLevel  `yaml:"energy" json:"energy"`
+	Hours  float64           `yaml:"hours,omitempty" json:"hours,omitempty"` // remaining estimate
+}
+
+type todayReview struct {
+	ID    core.EntityID `yaml:"id" json:"id"`
+	Title string        `yaml:"title" json:"title"`
+	Skill core.EntityID `yaml:"skill" json:"skill"`
+	Due   time.Time     `yaml:"due" json:"due"`
+}
+
+type todayCredential struct {
+	ID      core.EntityID `yaml:"id" json:"id"`
+	Title   string        `yaml:"title" json:"title"`
+	Issuer  string        `yaml:"issuer" json:"issuer"`
+	Expires time.Time     `yaml:"expires" json:"expires"`
+	Days    int           `yaml:"days" json:"days"` // until expiry, negative once expired
+}
+
+func runToday(cmd *cobra.Command, args []string) error {
+	now := time.Now()
+
+	plan := todayPlan{Energy: core.EnergyLevel(todayEnergy)}
+	if todayEnergy != "" {
+		if !plan.Energy.IsValid() {
+			return fmt.Errorf("invalid energy '%s'. Valid options: low, medium, high", todayEnergy)
+		}
+	} else {
+		logs, err := progressRepo.GetAll()
+		if err != nil {
+			return fmt.Errorf("failed to retrieve progress logs: %w", err)
+		}
+		plan.Energy, plan.Reported = core.WeeklyEnergy(logs, now)
+		if plan.Energy == "" {
+			plan.Energy = core.EnergyMedium
+		}
+	}
+
+	milestones, err := milestoneRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve milestones: %w", err)
+	}
+	for _, m := range core.DueReviews(milestones, now) {
+		plan.Reviews = append(plan.Reviews, todayReview{ID: m.ID, Title: m.Title, Skill: m.ReferenceID, Due: *m.TargetDate})
+	}
+
+	credentials, err := credentialRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+	for _, c := range core.ExpiringCredentials(credentials, now, credentialWarningDays) {
+		if c.DaysUntilExpiry(now) < -credentialWarningDays {
+			continue
+		}
+		plan.Expiring = append(plan.Expiring, todayCredential{
+			ID: c.ID, Title: c.Title, Issuer: c.Issuer, Expires: *c.ExpiryDate, Days: c.DaysUntilExpiry(now),
+		})
+	}
+
+	energyOf, err := resourceEnergies()
+	if err != nil {
+		return err
+	}
+
+	inProgress, err := resourceRepo.FindByStatus(core.ResourceInProgress)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+	for _, resource := range core.PaceResources(inProgress, energyOf, plan.Energy) {
+		plan.Continue = append(plan.Continue, newTodaySuggestion(resource, energyOf))
+	}
+
+	queue, err := buildResourceQueue()
+	if err != nil {
+		return err
+	}
+	queued := make([]*core.Resource, len(queue))
+	for i, entry := range queue {
+		queued[i] = entry.Resource
+	}
+	for _, resource := range core.PaceResources(queued, energyOf, plan.Energy) {
+		if len(plan.UpNext) >= todayLimit {
+			break
+		}
+		plan.UpNext = append(plan.UpNext, newTodaySuggestion(resource, energyOf))
+	}
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(plan)
+	}
+
+	fmt.Printf("Today: %s\n\n", now.Format("Mon, Jan 2"))
+
+	switch {
+	case todayEnergy != "":
+		fmt.Printf("Energy: %s\n", plan.Energy)
+	case plan.Reported > 0:
+		fmt.Printf("Energy this week: %s (from %s)\n", plan.Energy, countOf(plan.Reported, "log"))
+	default:
+		fmt.Println("Energy this week: not reported, assuming medium")
+		fmt.Println("  Report it with 'growth progress log --mood tired' or '--energy low'")
+	}
+	fmt.Printf("  %s\n\n", pacingHint(plan.Energy))
+
+	if err := printBlockedGoals(); err != nil {
+		return err
+	}
+
+	if len(plan.Reviews) > 0 {
+		fmt.Println("Reviews due:")
+		for _, r := range plan.Reviews {
+			fmt.Printf("  %-14s  %s (due %s)\n", r.ID, truncate(r.Title, 50), formatDate(r.Due))
+		}
+		fmt.Println("  Mark a review done with 'growth milestone achieve <id>'")
+		fmt.Println()
+	}
+
+	for _, c := range plan.Expiring {
+		PrintWarning(fmt.Sprintf("Credential %s: %s (%s) %s", c.ID, c.Title, c.Issuer, describeExpiry(c.Days)))
+	}
+	if len(plan.Expiring) > 0 {
+		fmt.Println()
+	}
+
+	if len(plan.Continue) > 0 {
+		fmt.Println("Continue:")
+		printTodaySuggestions(plan.Continue)
+		fmt.Println()
+	}
+
+	if len(plan.UpNext) > 0 {
+		fmt.Println("Up next:")
+		printTodaySuggestions(plan.UpNext)
+		fmt.Println()
+	}
+
+	if len(plan.Reviews) == 0 && len(plan.Continue) == 0 && len(plan.UpNext) == 0 {
+		PrintInfo("Nothing to study: no resources in progress or in the queue")
+	}
+
+	return nil
+}
+
+// resourceEnergies returns a lookup of the energy each resource needs,
+// falling back to the energy of the phase that includes it
+func resourceEnergies() (func(*core.Resource) core.EnergyLevel, error) {
+	phases, err := phaseRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve phases: %w", err)
+	}
+
+	phaseEnergy := make(map[core.EntityID]core.EnergyLevel)
+	for _, phase := range phases {
+		if phase.Energy == "" {
+			continue
+		}
+		for _, resourceID := range phase.Resources {
+			if _, ok := phaseEnergy[resourceID]; !ok {
+				phaseEnergy[resourceID] = phase.Energy
+			}
+		}
+	}
+
+	return func(r *core.Resource) core.EnergyLevel {
+		return core.ResourceEnergy(r, phaseEnergy[r.ID])
+	}, nil
+}
+
+func newTodaySuggestion(resource *core.Resource, energyOf func(*core.Resource) core.EnergyLevel) todaySuggestion {
+	return todaySuggestion{
+		ID:     resource.ID,
+		Title:  resource.Title,
+		Type:   resource.Type,
+		Energy: energyOf(resource),
+		Hours:  resource.RemainingHours(),
+	}
+}
+
+func printTodaySuggestions(suggestions []todaySuggestion) {
+	for _, s := range suggestions {
+		detail := fmt.Sprintf("%s, %s energy", s.Type, s.Energy)
+		if s.Hours > 0 {
+			detail += fmt.Sprintf(", %sh left", formatNumber(s.Hours, 1))
+		}
+		fmt.Printf("  %-14s  %s (%s)\n", s.ID, truncate(s.Title, 50), detail)
+	}
+}
+
+// pacingHint explains how suggestions are paced for an energy level
+func pacingHint(energy core.EnergyLevel) string {
+	switch energy {
+	case core.EnergyLow:
+		return "Low-energy week: lighter resources like videos and articles first."
+	case core.EnergyHigh:
+		return "High-energy week: a good time for projects and deep work."
+	default:
+		return "Steady week: a balanced mix."
+	}
+}
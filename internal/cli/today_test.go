@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplayBlockedGoals(t *testing.T) {
+	capture := func(goals []*core.Goal) string {
+		old := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		displayBlockedGoals(goals)
+
+		w.Close()
+		os.Stdout = old
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+		return buf.String()
+	}
+
+	t.Run("lists blocked goals with their blockers", func(t *testing.T) {
+		goal := &core.Goal{ID: "goal-002", Title: "Become staff engineer", Priority: core.PriorityHigh, Blockers: []string{"Waiting on promo cycle"}}
+
+		out := capture([]*core.Goal{goal})
+		assert.Contains(t, out, "1 blocked goal(s):")
+		assert.Contains(t, out, "goal-002  Become staff engineer (high priority)")
+		assert.Contains(t, out, "- Waiting on promo cycle")
+	})
+
+	t.Run("prints nothing without blocked goals", func(t *testing.T) {
+		assert.Empty(t, capture(nil))
+	})
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/illenko/growth.md/internal/version"
+	"github.com/spf13/cobra"
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Show version and build information",
+	Long: `Show the growth version, commit, build date, and the repository schema
+version this binary supports.
+
+Examples:
+  growth version
+  growth version --format json`,
+	RunE: runVersion,
+}
+
+func init() {
+	rootCmd.AddCommand(versionCmd)
+}
+
+// versionReport adds the current repository's schema to the build metadata
+type versionReport struct {
+	version.Info     `yaml:",inline"`
+	RepositorySchema string `json:"repositorySchema,omitempty" yaml:"repositorySchema,omitempty"`
+}
+
+func runVersion(cmd *cobra.Command, args []string) error {
+	info := version.Get()
+
+	if config.Display.OutputFormat != "table" {
+		return PrintOutputWithConfig(versionReport{Info: info, RepositorySchema: config.Version})
+	}
+
+	fmt.Printf("growth %s\n", info.Version)
+	if info.Commit != "" {
+		fmt.Printf("  Commit:      %s\n", info.Commit)
+	}
+	if info.BuildDate != "" {
+		fmt.Printf("  Built:       %s\n", info.BuildDate)
+	}
+	fmt.Printf("  Go:          %s %s\n", info.GoVersion, info.Platform)
+	fmt.Printf("  Schema:      %s (this repository: %s)\n", info.SchemaVersion, config.Version)
+
+	return nil
+}
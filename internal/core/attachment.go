@@ -0,0 +1,48 @@
+package core
+
+import (
+	"path"
+	"time"
+)
+
+// AttachmentsDir is the repository directory attachments are copied into,
+// one subdirectory per entity ID
+const AttachmentsDir = "attachments"
+
+// Attachment is a file kept alongside an entity, such as a certificate or a
+// screenshot proving a milestone
+type Attachment struct {
+	Name  string    `yaml:"name"`
+	Path  string    `yaml:"path"` // relative to the repository root
+	Added time.Time `yaml:"added"`
+}
+
+// Attachments lists the files attached to an entity, stored in frontmatter
+type Attachments []Attachment
+
+// AttachmentPath returns where a file named name is stored for an entity,
+// relative to the repository root
+func AttachmentPath(id EntityID, name string) string {
+	return path.Join(AttachmentsDir, string(id), name)
+}
+
+// Add records an attachment, replacing an earlier one with the same name
+func (a *Attachments) Add(attachment Attachment) {
+	for i, existing := range *a {
+		if existing.Name == attachment.Name {
+			(*a)[i] = attachment
+			return
+		}
+	}
+	*a = append(*a, attachment)
+}
+
+// Find returns the attachment with the given name
+func (a Attachments) Find(name string) (Attachment, bool) {
+	for _, attachment := range a {
+		if attachment.Name == name {
+			return attachment, true
+		}
+	}
+	return Attachment{}, false
+}
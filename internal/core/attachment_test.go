@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachments(t *testing.T) {
+	var attachments Attachments
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 1, 0)
+
+	attachments.Add(Attachment{Name: "cert.pdf", Path: AttachmentPath("milestone-001", "cert.pdf"), Added: first})
+	attachments.Add(Attachment{Name: "photo.png", Path: AttachmentPath("milestone-001", "photo.png"), Added: first})
+
+	t.Run("stores files per entity", func(t *testing.T) {
+		assert.Equal(t, "attachments/milestone-001/cert.pdf", attachments[0].Path)
+	})
+
+	t.Run("replaces attachments with the same name", func(t *testing.T) {
+		attachments.Add(Attachment{Name: "cert.pdf", Path: AttachmentPath("milestone-001", "cert.pdf"), Added: second})
+
+		assert.Len(t, attachments, 2)
+		found, ok := attachments.Find("cert.pdf")
+		assert.True(t, ok)
+		assert.Equal(t, second, found.Added)
+	})
+
+	t.Run("reports missing attachments", func(t *testing.T) {
+		_, ok := attachments.Find("missing.pdf")
+		assert.False(t, ok)
+	})
+}
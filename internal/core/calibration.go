@@ -0,0 +1,172 @@
+package core
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// MinCalibrationSamples is how many finished estimates a bias factor needs
+// before schedules apply it
+const MinCalibrationSamples = 3
+
+// Kinds of estimates compared by a calibration
+const (
+	EstimateResource = "resource" // estimated vs actual hours
+	EstimatePhase    = "phase"    // estimated vs actual weeks
+)
+
+// EstimateSample is the estimate and the actual effort of a finished
+// resource or phase
+type EstimateSample struct {
+	Kind      string    `yaml:"kind" json:"kind"`
+	ID        EntityID  `yaml:"id" json:"id"`
+	Title     string    `yaml:"title" json:"title"`
+	Estimated float64   `yaml:"estimated" json:"estimated"` // hours for resources, weeks for phases
+	Actual    float64   `yaml:"actual" json:"actual"`
+	Finished  time.Time `yaml:"finished" json:"finished"`
+}
+
+// Ratio is how many times the estimate the actual effort was
+func (s EstimateSample) Ratio() float64 {
+	return s.Actual / s.Estimated
+}
+
+// EstimateStats sums up the samples of one kind
+type EstimateStats struct {
+	Samples   int     `yaml:"samples" json:"samples"`
+	Estimated float64 `yaml:"estimated" json:"estimated"`
+	Actual    float64 `yaml:"actual" json:"actual"`
+	Factor    float64 `yaml:"factor" json:"factor"` // median ratio, 1 without samples
+}
+
+// CalibrationQuarter is the bias factor of the estimates finished in a
+// calendar quarter
+type CalibrationQuarter struct {
+	Start   time.Time `yaml:"start" json:"start"`
+	Samples int       `yaml:"samples" json:"samples"`
+	Factor  float64   `yaml:"factor" json:"factor"`
+}
+
+// Calibration compares estimates with actual effort. Factor is the personal
+// bias: the median of actual/estimated over all samples, so 1.3 means work
+// usually takes 30% longer than estimated.
+type Calibration struct {
+	Factor    float64              `yaml:"factor" json:"factor"`
+	Resources EstimateStats        `yaml:"resources" json:"resources"`
+	Phases    EstimateStats        `yaml:"phases" json:"phases"`
+	Quarters  []CalibrationQuarter `yaml:"quarters,omitempty" json:"quarters,omitempty"` // oldest first
+	Samples   []EstimateSample     `yaml:"samples,omitempty" json:"samples,omitempty"`   // oldest first
+}
+
+// EstimateSamples collects the completed resources with estimated and actual
+// hours, and the finished phases with an estimated duration and dates
+func EstimateSamples(resources []*Resource, phases []*Phase) []EstimateSample {
+	var samples []EstimateSample
+	for _, r := range resources {
+		if r.Status != ResourceCompleted || r.EstimatedHours <= 0 || r.ActualHours <= 0 {
+			continue
+		}
+		samples = append(samples, EstimateSample{
+			Kind:      EstimateResource,
+			ID:        r.ID,
+			Title:     r.Title,
+			Estimated: r.EstimatedHours,
+			Actual:    r.ActualHours,
+			Finished:  r.CompletedAt(),
+		})
+	}
+
+	for _, p := range phases {
+		weeks := p.EstimatedDurationWeeks()
+		if !p.IsFinished() || p.StartDate == nil || weeks <= 0 {
+			continue
+		}
+		actual := p.EndDate.Sub(*p.StartDate).Hours() / 24 / 7
+		if actual <= 0 {
+			continue
+		}
+		samples = append(samples, EstimateSample{
+			Kind:      EstimatePhase,
+			ID:        p.ID,
+			Title:     p.Title,
+			Estimated: weeks,
+			Actual:    actual,
+			Finished:  *p.EndDate,
+		})
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Finished.Before(samples[j].Finished) })
+	return samples
+}
+
+// ComputeCalibration works out the bias factor of the samples, overall, by
+// kind and by the quarter they were finished in
+func ComputeCalibration(samples []EstimateSample) *Calibration {
+	c := &Calibration{Factor: 1, Samples: samples}
+
+	var all, resources, phases []float64
+	quarters := make(map[time.Time][]float64)
+	for _, s := range samples {
+		ratio := s.Ratio()
+		all = append(all, ratio)
+		stats := &c.Resources
+		if s.Kind == EstimatePhase {
+			stats = &c.Phases
+			phases = append(phases, ratio)
+		} else {
+			resources = append(resources, ratio)
+		}
+		stats.Samples++
+		stats.Estimated += s.Estimated
+		stats.Actual += s.Actual
+
+		quarter := time.Date(s.Finished.Year(), (s.Finished.Month()-1)/3*3+1, 1, 0, 0, 0, 0, s.Finished.Location())
+		quarters[quarter] = append(quarters[quarter], ratio)
+	}
+
+	c.Factor = medianRatio(all)
+	c.Resources.Factor = medianRatio(resources)
+	c.Phases.Factor = medianRatio(phases)
+	for start, ratios := range quarters {
+		c.Quarters = append(c.Quarters, CalibrationQuarter{Start: start, Samples: len(ratios), Factor: medianRatio(ratios)})
+	}
+	sort.Slice(c.Quarters, func(i, j int) bool { return c.Quarters[i].Start.Before(c.Quarters[j].Start) })
+
+	return c
+}
+
+// Bias returns the factor schedules should scale estimates by: the bias
+// factor once there are enough samples, 1 before that
+func (c *Calibration) Bias() float64 {
+	if len(c.Samples) < MinCalibrationSamples {
+		return 1
+	}
+	return c.Factor
+}
+
+// Misses returns up to n samples furthest off their estimate, in either
+// direction
+func (c *Calibration) Misses(n int) []EstimateSample {
+	misses := append([]EstimateSample{}, c.Samples...)
+	sort.SliceStable(misses, func(i, j int) bool {
+		return math.Abs(math.Log(misses[i].Ratio())) > math.Abs(math.Log(misses[j].Ratio()))
+	})
+	if len(misses) > n {
+		misses = misses[:n]
+	}
+	return misses
+}
+
+func medianRatio(ratios []float64) float64 {
+	if len(ratios) == 0 {
+		return 1
+	}
+	sorted := append([]float64{}, ratios...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateSamples(t *testing.T) {
+	newResource := func(id EntityID, estimated, actual float64, completed bool) *Resource {
+		r, _ := NewResource(id, string(id), ResourceBook, "skill-001")
+		r.EstimatedHours = estimated
+		r.ActualHours = actual
+		if completed {
+			r.Complete()
+		}
+		return r
+	}
+
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	finished, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+	finished.EstimatedDuration = "2 weeks"
+	require.NoError(t, finished.SetStartDate(start))
+	require.NoError(t, finished.Complete(start.AddDate(0, 0, 21)))
+	open, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+	open.EstimatedDuration = "2 weeks"
+
+	samples := EstimateSamples([]*Resource{
+		newResource("resource-001", 10, 12, true),
+		newResource("resource-002", 10, 0, true),
+		newResource("resource-003", 0, 5, true),
+		newResource("resource-004", 10, 4, false),
+	}, []*Phase{finished, open})
+
+	require.Len(t, samples, 2)
+	assert.Equal(t, EstimateSample{Kind: EstimatePhase, ID: "phase-001", Title: "Basics", Estimated: 2, Actual: 3, Finished: start.AddDate(0, 0, 21)}, samples[0])
+	assert.Equal(t, EntityID("resource-001"), samples[1].ID)
+	assert.Equal(t, 1.2, samples[1].Ratio())
+}
+
+func TestComputeCalibration(t *testing.T) {
+	date := func(month time.Month) time.Time { return time.Date(2025, month, 10, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("takes the median ratio overall, by kind and by quarter", func(t *testing.T) {
+		calibration := ComputeCalibration([]EstimateSample{
+			{Kind: EstimateResource, ID: "resource-001", Estimated: 10, Actual: 20, Finished: date(1)},
+			{Kind: EstimateResource, ID: "resource-002", Estimated: 10, Actual: 12, Finished: date(2)},
+			{Kind: EstimatePhase, ID: "phase-001", Estimated: 4, Actual: 6, Finished: date(5)},
+			{Kind: EstimateResource, ID: "resource-003", Estimated: 10, Actual: 8, Finished: date(6)},
+		})
+
+		assert.InDelta(t, 1.35, calibration.Factor, 1e-9)
+		assert.Equal(t, 1.35, calibration.Bias())
+		assert.Equal(t, EstimateStats{Samples: 3, Estimated: 30, Actual: 40, Factor: 1.2}, calibration.Resources)
+		assert.Equal(t, EstimateStats{Samples: 1, Estimated: 4, Actual: 6, Factor: 1.5}, calibration.Phases)
+		require.Len(t, calibration.Quarters, 2)
+		assert.Equal(t, CalibrationQuarter{Start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Samples: 2, Factor: 1.6}, calibration.Quarters[0])
+		assert.Equal(t, CalibrationQuarter{Start: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Samples: 2, Factor: 1.15}, calibration.Quarters[1])
+		assert.Equal(t, []EntityID{"resource-001", "phase-001"}, []EntityID{calibration.Misses(2)[0].ID, calibration.Misses(2)[1].ID})
+	})
+
+	t.Run("does not apply a bias from too few samples", func(t *testing.T) {
+		calibration := ComputeCalibration([]EstimateSample{
+			{Kind: EstimateResource, ID: "resource-001", Estimated: 10, Actual: 20, Finished: date(1)},
+		})
+
+		assert.Equal(t, 2.0, calibration.Factor)
+		assert.Equal(t, 1.0, calibration.Bias())
+		assert.Equal(t, 1.0, calibration.Phases.Factor)
+	})
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CategoryList is the managed list of skill categories. In strict mode skills
+// may only use categories from the list.
+type CategoryList struct {
+	Strict     bool     `yaml:"strict"`
+	Categories []string `yaml:"categories"`
+}
+
+// Find returns the managed spelling of a category, matched case-insensitively
+func (c *CategoryList) Find(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	for _, category := range c.Categories {
+		if strings.EqualFold(category, name) {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// Add adds a category to the list, keeping it sorted
+func (c *CategoryList) Add(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("category name cannot be empty")
+	}
+	if existing, ok := c.Find(name); ok {
+		return fmt.Errorf("category '%s' already exists", existing)
+	}
+
+	c.Categories = append(c.Categories, name)
+	sort.Slice(c.Categories, func(i, j int) bool {
+		return strings.ToLower(c.Categories[i]) < strings.ToLower(c.Categories[j])
+	})
+	return nil
+}
+
+// Rename renames a managed category. Renaming onto an existing category
+// merges the two.
+func (c *CategoryList) Rename(from, to string) error {
+	to = strings.TrimSpace(to)
+	if to == "" {
+		return errors.New("category name cannot be empty")
+	}
+
+	current, ok := c.Find(from)
+	if !ok {
+		return fmt.Errorf("category '%s' not found", from)
+	}
+
+	remaining := c.Categories[:0]
+	for _, category := range c.Categories {
+		if category != current {
+			remaining = append(remaining, category)
+		}
+	}
+	c.Categories = remaining
+
+	if _, exists := c.Find(to); exists {
+		return nil
+	}
+	return c.Add(to)
+}
+
+// Check validates a category against the list. It returns the managed
+// spelling of a known category, and an error for unknown categories in strict
+// mode.
+func (c *CategoryList) Check(name string) (string, error) {
+	if category, ok := c.Find(name); ok {
+		return category, nil
+	}
+
+	if c.Strict {
+		if suggestion := c.Suggest(name); suggestion != "" {
+			return "", fmt.Errorf("unknown category '%s' (did you mean '%s'?)", name, suggestion)
+		}
+		return "", fmt.Errorf("unknown category '%s'", name)
+	}
+
+	return strings.TrimSpace(name), nil
+}
+
+// Suggest returns the managed category closest to name, or "" when none is
+// close enough to be a likely typo
+func (c *CategoryList) Suggest(name string) string {
+	return ClosestMatch(name, c.Categories)
+}
+
+// ClosestMatch returns the option closest to value, ignoring case, or "" when
+// none is within two edits and so unlikely to be what was meant
+func ClosestMatch(value string, options []string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	best, bestDistance := "", 3
+	for _, option := range options {
+		if d := editDistance(value, strings.ToLower(option)); d < bestDistance {
+			best, bestDistance = option, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
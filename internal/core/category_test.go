@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryList_Add(t *testing.T) {
+	list := &CategoryList{}
+
+	require.NoError(t, list.Add("frontend"))
+	require.NoError(t, list.Add(" Backend "))
+	assert.Equal(t, []string{"Backend", "frontend"}, list.Categories)
+
+	assert.Error(t, list.Add("backend"), "duplicates are case-insensitive")
+	assert.Error(t, list.Add("  "))
+}
+
+func TestCategoryList_Rename(t *testing.T) {
+	t.Run("renames", func(t *testing.T) {
+		list := &CategoryList{Categories: []string{"backend", "devops"}}
+
+		require.NoError(t, list.Rename("DevOps", "platform"))
+		assert.Equal(t, []string{"backend", "platform"}, list.Categories)
+	})
+
+	t.Run("merges into an existing category", func(t *testing.T) {
+		list := &CategoryList{Categories: []string{"backend", "server"}}
+
+		require.NoError(t, list.Rename("server", "backend"))
+		assert.Equal(t, []string{"backend"}, list.Categories)
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		list := &CategoryList{Categories: []string{"backend"}}
+
+		assert.Error(t, list.Rename("frontend", "web"))
+	})
+}
+
+func TestCategoryList_Check(t *testing.T) {
+	t.Run("returns the managed spelling", func(t *testing.T) {
+		list := &CategoryList{Strict: true, Categories: []string{"Backend"}}
+
+		category, err := list.Check("backend")
+		require.NoError(t, err)
+		assert.Equal(t, "Backend", category)
+	})
+
+	t.Run("strict mode rejects unknown categories with a suggestion", func(t *testing.T) {
+		list := &CategoryList{Strict: true, Categories: []string{"backend", "frontend"}}
+
+		_, err := list.Check("bakend")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "did you mean 'backend'")
+
+		_, err = list.Check("databases")
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "did you mean")
+	})
+
+	t.Run("lenient mode accepts unknown categories", func(t *testing.T) {
+		list := &CategoryList{Categories: []string{"backend"}}
+
+		category, err := list.Check(" data ")
+		require.NoError(t, err)
+		assert.Equal(t, "data", category)
+	})
+}
+
+func TestCategoryList_Suggest(t *testing.T) {
+	list := &CategoryList{Categories: []string{"backend", "frontend", "devops"}}
+
+	assert.Equal(t, "frontend", list.Suggest("fronted"))
+	assert.Equal(t, "devops", list.Suggest("DevOp"))
+	assert.Equal(t, "", list.Suggest("machine learning"))
+}
@@ -0,0 +1,192 @@
+package core
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// DefaultResourceHours is the effort assumed for a resource without an estimate
+// when planning a cohort schedule
+const DefaultResourceHours = 2.0
+
+// Assignment places a phase or resource in a cohort schedule. Weeks are
+// numbered from 1; Due is the last day of EndWeek.
+type Assignment struct {
+	StartWeek int       `yaml:"startWeek"`
+	EndWeek   int       `yaml:"endWeek"`
+	Start     time.Time `yaml:"start"`
+	Due       time.Time `yaml:"due"`
+}
+
+// CohortSchedule is a week-by-week plan for a group that follows a path from
+// the same start date. It ignores individual progress: every resource is
+// planned at its full estimate, except abandoned ones, which are left out.
+type CohortSchedule struct {
+	Start        time.Time
+	HoursPerWeek float64
+	Bias         float64 // factor the estimates were scaled by, see Calibration
+	Phases       []CohortPhase
+	Weeks        int
+}
+
+// CohortPhase is a phase with its assignment and its resources in study order
+type CohortPhase struct {
+	Phase      *Phase
+	Assignment Assignment
+	Resources  []CohortResource
+}
+
+// CohortResource is a resource with its assignment
+type CohortResource struct {
+	Resource   *Resource
+	Hours      float64
+	Assignment Assignment
+}
+
+// CohortWeek lists what is studied and due in one week of a cohort schedule
+type CohortWeek struct {
+	Number    int
+	Start     time.Time
+	End       time.Time
+	Phases    []*Phase
+	Resources []CohortResource // resources studied during the week
+	Due       []CohortResource // resources due by the end of the week
+}
+
+// BuildCohortSchedule assigns weeks to the phases and resources of a path,
+// starting at start. Each phase begins on a new week and its resources are
+// studied one after another at hoursPerWeek. Phases with no estimated
+// resource hours fall back to their EstimatedDuration.
+func BuildCohortSchedule(phases []*Phase, resources map[EntityID]*Resource, hoursPerWeek float64, start time.Time) *CohortSchedule {
+	return BuildCohortScheduleWithBias(phases, resources, hoursPerWeek, 1, start)
+}
+
+// BuildCohortScheduleWithBias builds a schedule like BuildCohortSchedule with
+// every estimate scaled by an estimate bias factor
+func BuildCohortScheduleWithBias(phases []*Phase, resources map[EntityID]*Resource, hoursPerWeek, bias float64, start time.Time) *CohortSchedule {
+	if hoursPerWeek <= 0 {
+		hoursPerWeek = DefaultHoursPerWeek
+	}
+	if bias <= 0 {
+		bias = 1
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	ordered := append([]*Phase{}, phases...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+
+	schedule := &CohortSchedule{Start: start, HoursPerWeek: hoursPerWeek, Bias: bias}
+	week := 1
+	for _, phase := range ordered {
+		cp := CohortPhase{Phase: phase}
+
+		var planned []*Resource
+		estimated := 0.0
+		for _, id := range phase.Resources {
+			resource, ok := resources[id]
+			if !ok || resource.Status == ResourceAbandoned {
+				continue
+			}
+			planned = append(planned, resource)
+			estimated += resource.EstimatedHours
+		}
+
+		// Without estimates, spread the phase's expected duration over its resources
+		fallback := DefaultResourceHours * bias
+		weeks := phase.EstimatedDurationWeeks() * bias
+		if estimated == 0 && weeks > 0 && len(planned) > 0 {
+			fallback = weeks * hoursPerWeek / float64(len(planned))
+		}
+
+		startWeek := week
+		used := 0.0 // hours already planned in the current week
+		for _, resource := range planned {
+			hours := resource.EstimatedHours * bias
+			if hours <= 0 {
+				hours = fallback
+			}
+
+			first := week
+			used += hours
+			for used > hoursPerWeek+1e-9 {
+				used -= hoursPerWeek
+				week++
+			}
+			last := week
+			if used >= hoursPerWeek-1e-9 {
+				week++
+				used = 0
+			}
+
+			cp.Resources = append(cp.Resources, CohortResource{
+				Resource:   resource,
+				Hours:      hours,
+				Assignment: schedule.assignment(first, last),
+			})
+		}
+
+		endWeek := week
+		switch {
+		case len(planned) == 0:
+			endWeek = startWeek + int(math.Max(1, math.Ceil(weeks))) - 1
+			week = endWeek + 1
+		case used == 0:
+			// The last resource filled its week exactly
+			endWeek = week - 1
+		default:
+			week++
+		}
+
+		cp.Assignment = schedule.assignment(startWeek, endWeek)
+		schedule.Phases = append(schedule.Phases, cp)
+		schedule.Weeks = endWeek
+	}
+
+	return schedule
+}
+
+func (s *CohortSchedule) assignment(startWeek, endWeek int) Assignment {
+	return Assignment{
+		StartWeek: startWeek,
+		EndWeek:   endWeek,
+		Start:     s.WeekStart(startWeek),
+		Due:       s.WeekStart(endWeek).AddDate(0, 0, 6),
+	}
+}
+
+// WeekStart returns the first day of a schedule week
+func (s *CohortSchedule) WeekStart(week int) time.Time {
+	return s.Start.AddDate(0, 0, 7*(week-1))
+}
+
+// End returns the last day of the schedule
+func (s *CohortSchedule) End() time.Time {
+	return s.WeekStart(s.Weeks).AddDate(0, 0, 6)
+}
+
+// WeekByWeek lists every week of the schedule with its phases and resources
+func (s *CohortSchedule) WeekByWeek() []CohortWeek {
+	weeks := make([]CohortWeek, s.Weeks)
+	for i := range weeks {
+		weeks[i] = CohortWeek{
+			Number: i + 1,
+			Start:  s.WeekStart(i + 1),
+			End:    s.WeekStart(i+1).AddDate(0, 0, 6),
+		}
+	}
+
+	for _, cp := range s.Phases {
+		for n := cp.Assignment.StartWeek; n <= cp.Assignment.EndWeek; n++ {
+			weeks[n-1].Phases = append(weeks[n-1].Phases, cp.Phase)
+		}
+		for _, cr := range cp.Resources {
+			for n := cr.Assignment.StartWeek; n <= cr.Assignment.EndWeek; n++ {
+				weeks[n-1].Resources = append(weeks[n-1].Resources, cr)
+			}
+			weeks[cr.Assignment.EndWeek-1].Due = append(weeks[cr.Assignment.EndWeek-1].Due, cr)
+		}
+	}
+
+	return weeks
+}
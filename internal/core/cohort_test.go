@@ -0,0 +1,135 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCohortSchedule(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	newResource := func(id EntityID, hours float64) *Resource {
+		r, _ := NewResource(id, string(id), ResourceCourse, "skill-001")
+		r.SetEstimatedHours(hours)
+		return r
+	}
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("fills weeks with resources in order", func(t *testing.T) {
+		basics, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		basics.Resources = []EntityID{"resource-001", "resource-002", "resource-003"}
+		resources := map[EntityID]*Resource{
+			"resource-001": newResource("resource-001", 3),
+			"resource-002": newResource("resource-002", 4),
+			"resource-003": newResource("resource-003", 3),
+		}
+
+		schedule := BuildCohortSchedule([]*Phase{basics}, resources, 5, start)
+
+		require.Len(t, schedule.Phases, 1)
+		cp := schedule.Phases[0]
+		assert.Equal(t, Assignment{StartWeek: 1, EndWeek: 2, Start: date(7, 1), Due: date(7, 14)}, cp.Assignment)
+		require.Len(t, cp.Resources, 3)
+		assert.Equal(t, 1, cp.Resources[0].Assignment.EndWeek)
+		assert.Equal(t, Assignment{StartWeek: 1, EndWeek: 2, Start: date(7, 1), Due: date(7, 14)}, cp.Resources[1].Assignment)
+		assert.Equal(t, 2, cp.Resources[2].Assignment.StartWeek)
+		assert.Equal(t, 2, schedule.Weeks)
+		assert.Equal(t, date(7, 14), schedule.End())
+	})
+
+	t.Run("scales estimates by the bias factor", func(t *testing.T) {
+		basics, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		basics.Resources = []EntityID{"resource-001"}
+		review, _ := NewPhase("phase-002", "path-001", "Review", 2)
+		review.EstimatedDuration = "2 weeks"
+		resources := map[EntityID]*Resource{"resource-001": newResource("resource-001", 5)}
+
+		schedule := BuildCohortScheduleWithBias([]*Phase{basics, review}, resources, 5, 1.5, start)
+
+		require.Len(t, schedule.Phases, 2)
+		assert.Equal(t, 1.5, schedule.Bias)
+		assert.Equal(t, 7.5, schedule.Phases[0].Resources[0].Hours)
+		assert.Equal(t, 2, schedule.Phases[0].Assignment.EndWeek)
+		assert.Equal(t, Assignment{StartWeek: 3, EndWeek: 5, Start: date(7, 15), Due: date(8, 4)}, schedule.Phases[1].Assignment)
+	})
+
+	t.Run("starts each phase on a new week", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.Resources = []EntityID{"resource-001"}
+		p2, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+		p2.Resources = []EntityID{"resource-002"}
+		resources := map[EntityID]*Resource{
+			"resource-001": newResource("resource-001", 2),
+			"resource-002": newResource("resource-002", 10),
+		}
+
+		schedule := BuildCohortSchedule([]*Phase{p2, p1}, resources, 5, start)
+
+		require.Len(t, schedule.Phases, 2)
+		assert.Equal(t, "Basics", schedule.Phases[0].Phase.Title)
+		assert.Equal(t, 1, schedule.Phases[0].Assignment.EndWeek)
+		assert.Equal(t, Assignment{StartWeek: 2, EndWeek: 3, Start: date(7, 8), Due: date(7, 21)}, schedule.Phases[1].Assignment)
+		assert.Equal(t, 3, schedule.Weeks)
+	})
+
+	t.Run("ignores progress but leaves out abandoned resources", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		phase.Resources = []EntityID{"resource-001", "resource-002"}
+		done := newResource("resource-001", 5)
+		done.Complete()
+		dropped := newResource("resource-002", 5)
+		dropped.Abandon("too long")
+		resources := map[EntityID]*Resource{done.ID: done, dropped.ID: dropped}
+
+		schedule := BuildCohortSchedule([]*Phase{phase}, resources, 5, start)
+
+		require.Len(t, schedule.Phases[0].Resources, 1)
+		assert.Equal(t, done, schedule.Phases[0].Resources[0].Resource)
+		assert.Equal(t, 1, schedule.Weeks)
+	})
+
+	t.Run("falls back to the estimated duration", func(t *testing.T) {
+		empty, _ := NewPhase("phase-001", "path-001", "Reading", 1)
+		empty.EstimatedDuration = "3 weeks"
+		unestimated, _ := NewPhase("phase-002", "path-001", "Project", 2)
+		unestimated.EstimatedDuration = "2 weeks"
+		unestimated.Resources = []EntityID{"resource-001", "resource-002"}
+		resources := map[EntityID]*Resource{
+			"resource-001": newResource("resource-001", 0),
+			"resource-002": newResource("resource-002", 0),
+		}
+
+		schedule := BuildCohortSchedule([]*Phase{empty, unestimated}, resources, 4, start)
+
+		assert.Equal(t, 1, schedule.Phases[0].Assignment.StartWeek)
+		assert.Equal(t, 3, schedule.Phases[0].Assignment.EndWeek)
+		assert.Equal(t, 4, schedule.Phases[1].Assignment.StartWeek)
+		assert.Equal(t, 5, schedule.Phases[1].Assignment.EndWeek)
+		assert.Equal(t, 4.0, schedule.Phases[1].Resources[0].Hours)
+	})
+
+	t.Run("lists the work and deadlines of every week", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		phase.Resources = []EntityID{"resource-001", "resource-002"}
+		resources := map[EntityID]*Resource{
+			"resource-001": newResource("resource-001", 8),
+			"resource-002": newResource("resource-002", 1),
+		}
+
+		weeks := BuildCohortSchedule([]*Phase{phase}, resources, 5, start).WeekByWeek()
+
+		require.Len(t, weeks, 2)
+		assert.Equal(t, date(7, 8), weeks[1].Start)
+		assert.Equal(t, date(7, 14), weeks[1].End)
+		assert.Len(t, weeks[0].Resources, 1)
+		assert.Empty(t, weeks[0].Due)
+		assert.Len(t, weeks[1].Resources, 2)
+		assert.Len(t, weeks[1].Due, 2)
+		assert.Equal(t, []*Phase{phase}, weeks[1].Phases)
+	})
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Credential represents a certificate or credential earned from an issuer,
+// such as a cloud certification or a course certificate
+type Credential struct {
+	ID           EntityID   `yaml:"id"`
+	Title        string     `yaml:"title"`
+	Issuer       string     `yaml:"issuer"`
+	CredentialID string     `yaml:"credentialId,omitempty"` // the issuer's ID for the credential
+	IssueDate    time.Time  `yaml:"issueDate"`
+	ExpiryDate   *time.Time `yaml:"expiryDate,omitempty"`
+	URL          string     `yaml:"url,omitempty"` // verification link
+	Skills       []EntityID `yaml:"skills,omitempty"`
+	Tags         []string   `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains notes (exam details, renewal requirements)
+	Body string `yaml:"-"`
+}
+
+// NewCredential creates a new Credential
+func NewCredential(id EntityID, title, issuer string, issueDate time.Time) (*Credential, error) {
+	credential := &Credential{
+		ID:         id,
+		Title:      title,
+		Issuer:     issuer,
+		IssueDate:  issueDate,
+		Skills:     []EntityID{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+	}
+
+	if err := credential.Validate(); err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func (c *Credential) Validate() error {
+	if c.ID == "" {
+		return errors.New("credential ID is required")
+	}
+
+	if strings.TrimSpace(c.Title) == "" {
+		return errors.New("credential title is required and cannot be empty")
+	}
+
+	if strings.TrimSpace(c.Issuer) == "" {
+		return errors.New("credential issuer is required and cannot be empty")
+	}
+
+	if c.IssueDate.IsZero() {
+		return errors.New("credential issue date is required")
+	}
+
+	if c.ExpiryDate != nil && c.ExpiryDate.Before(c.IssueDate) {
+		return errors.New("credential expiry date cannot be before the issue date")
+	}
+
+	if c.Created.IsZero() {
+		return errors.New("credential created timestamp is required")
+	}
+
+	if c.Updated.IsZero() {
+		return errors.New("credential updated timestamp is required")
+	}
+
+	return nil
+}
+
+// SetExpiryDate sets when the credential expires
+func (c *Credential) SetExpiryDate(date time.Time) error {
+	if date.Before(c.IssueDate) {
+		return errors.New("credential expiry date cannot be before the issue date")
+	}
+	c.ExpiryDate = &date
+	c.Touch()
+	return nil
+}
+
+// ClearExpiryDate marks the credential as not expiring
+func (c *Credential) ClearExpiryDate() {
+	c.ExpiryDate = nil
+	c.Touch()
+}
+
+// AddSkill links a skill the credential certifies
+func (c *Credential) AddSkill(skillID EntityID) {
+	for _, id := range c.Skills {
+		if id == skillID {
+			return
+		}
+	}
+	c.Skills = append(c.Skills, skillID)
+	c.Touch()
+}
+
+// HasSkill returns true if the credential certifies the given skill
+func (c *Credential) HasSkill(skillID EntityID) bool {
+	for _, id := range c.Skills {
+		if id == skillID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag to the credential (normalized to lowercase)
+func (c *Credential) AddTag(tag string) {
+	normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+	if normalizedTag == "" {
+		return
+	}
+
+	for _, t := range c.Tags {
+		if t == normalizedTag {
+			return
+		}
+	}
+	c.Tags = append(c.Tags, normalizedTag)
+	c.Touch()
+}
+
+// IsExpired returns true if the credential expired before the day containing now
+func (c *Credential) IsExpired(now time.Time) bool {
+	return c.ExpiryDate != nil && c.DaysUntilExpiry(now) < 0
+}
+
+// DaysUntilExpiry returns the whole days from the day containing now to the
+// expiry date, negative once expired. It returns 0 for credentials that don't expire.
+func (c *Credential) DaysUntilExpiry(now time.Time) int {
+	if c.ExpiryDate == nil {
+		return 0
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(c.ExpiryDate.Year(), c.ExpiryDate.Month(), c.ExpiryDate.Day(), 0, 0, 0, 0, time.UTC)
+	return int(expiry.Sub(today).Hours() / 24)
+}
+
+// ExpiringCredentials returns the credentials that have expired or expire
+// within the given number of days of now, soonest expiry first
+func ExpiringCredentials(credentials []*Credential, now time.Time, within int) []*Credential {
+	var expiring []*Credential
+	for _, c := range credentials {
+		if c.ExpiryDate != nil && c.DaysUntilExpiry(now) <= within {
+			expiring = append(expiring, c)
+		}
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiryDate.Before(*expiring[j].ExpiryDate)
+	})
+	return expiring
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredential(t *testing.T) {
+	issued := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates valid credential", func(t *testing.T) {
+		credential, err := NewCredential("credential-001", "AWS Solutions Architect", "Amazon Web Services", issued)
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("credential-001"), credential.ID)
+		assert.Equal(t, "Amazon Web Services", credential.Issuer)
+		assert.Equal(t, issued, credential.IssueDate)
+		assert.Nil(t, credential.ExpiryDate)
+	})
+
+	t.Run("fails with empty ID", func(t *testing.T) {
+		_, err := NewCredential("", "CKA", "CNCF", issued)
+		assert.ErrorContains(t, err, "ID is required")
+	})
+
+	t.Run("fails with empty title", func(t *testing.T) {
+		_, err := NewCredential("credential-001", " ", "CNCF", issued)
+		assert.ErrorContains(t, err, "title is required")
+	})
+
+	t.Run("fails with empty issuer", func(t *testing.T) {
+		_, err := NewCredential("credential-001", "CKA", "", issued)
+		assert.ErrorContains(t, err, "issuer is required")
+	})
+
+	t.Run("fails with zero issue date", func(t *testing.T) {
+		_, err := NewCredential("credential-001", "CKA", "CNCF", time.Time{})
+		assert.ErrorContains(t, err, "issue date is required")
+	})
+}
+
+func TestCredential_Expiry(t *testing.T) {
+	credential, _ := NewCredential("credential-001", "CKA", "CNCF", time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC))
+	now := time.Date(2026, 4, 20, 15, 0, 0, 0, time.UTC)
+
+	assert.False(t, credential.IsExpired(now))
+	assert.Equal(t, 0, credential.DaysUntilExpiry(now))
+
+	assert.Error(t, credential.SetExpiryDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, credential.SetExpiryDate(time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 12, credential.DaysUntilExpiry(now))
+	assert.False(t, credential.IsExpired(now))
+	assert.False(t, credential.IsExpired(time.Date(2026, 5, 2, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, credential.IsExpired(time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC)))
+
+	credential.ClearExpiryDate()
+	assert.Nil(t, credential.ExpiryDate)
+}
+
+func TestCredential_AddSkill(t *testing.T) {
+	credential, _ := NewCredential("credential-001", "CKA", "CNCF", time.Now())
+
+	credential.AddSkill("skill-002")
+	credential.AddSkill("skill-002")
+
+	assert.Equal(t, []EntityID{"skill-002"}, credential.Skills)
+	assert.True(t, credential.HasSkill("skill-002"))
+	assert.False(t, credential.HasSkill("skill-001"))
+}
+
+func TestExpiringCredentials(t *testing.T) {
+	newCredential := func(id EntityID, expiry *time.Time) *Credential {
+		c, _ := NewCredential(id, string(id), "CNCF", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		c.ExpiryDate = expiry
+		return c
+	}
+	date := func(month time.Month, day int) *time.Time {
+		d := time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	later := newCredential("credential-001", date(9, 1))
+	soon := newCredential("credential-002", date(5, 10))
+	expired := newCredential("credential-003", date(3, 1))
+	lifetime := newCredential("credential-004", nil)
+
+	now := time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []*Credential{expired, soon}, ExpiringCredentials([]*Credential{later, soon, expired, lifetime}, now, 60))
+}
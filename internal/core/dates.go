@@ -0,0 +1,181 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	inPattern           = regexp.MustCompile(`^in (\d+|an?|one) (day|week|month|year)s?$`)
+	agoPattern          = regexp.MustCompile(`^(\d+|an?|one) (day|week|month|year)s? ago$`)
+	adjacentPattern     = regexp.MustCompile(`^(next|last) ([a-z]+)$`)
+	boundaryPattern     = regexp.MustCompile(`^(start|beginning|end) of (?:(this|next|last) )?(week|month|quarter|year)$`)
+	namedQuarterPattern = regexp.MustCompile(`^(start|beginning|end) of q([1-4])(?: (\d{4}))?$`)
+)
+
+// ParseDate resolves a date given as YYYY-MM-DD or in words, relative to now:
+// today, yesterday, tomorrow, "in 2 weeks", "3 days ago", "next friday",
+// "last month", "end of this week", "start of next quarter" or "end of Q3"
+// (of now's year unless a year follows). The result is midnight in now's
+// location.
+func ParseDate(input string, now time.Time) (time.Time, error) {
+	text := strings.Join(strings.Fields(strings.ToLower(input)), " ")
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if date, err := time.ParseInLocation("2006-01-02", text, now.Location()); err == nil {
+		return date, nil
+	}
+
+	switch text {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	if match := inPattern.FindStringSubmatch(text); match != nil {
+		return addUnits(today, match[2], relativeCount(match[1])), nil
+	}
+
+	if match := agoPattern.FindStringSubmatch(text); match != nil {
+		return addUnits(today, match[2], -relativeCount(match[1])), nil
+	}
+
+	if match := adjacentPattern.FindStringSubmatch(text); match != nil {
+		step := 1
+		if match[1] == "last" {
+			step = -1
+		}
+		if day, ok := ParseWeekday(match[2]); ok {
+			date := today.AddDate(0, 0, step)
+			for date.Weekday() != day {
+				date = date.AddDate(0, 0, step)
+			}
+			return date, nil
+		}
+		if isPeriodUnit(match[2]) {
+			return addUnits(periodStart(today, match[2]), match[2], step), nil
+		}
+	}
+
+	if match := boundaryPattern.FindStringSubmatch(text); match != nil {
+		shift := map[string]int{"": 0, "this": 0, "next": 1, "last": -1}[match[2]]
+		start := addUnits(periodStart(today, match[3]), match[3], shift)
+		return boundary(match[1], start, match[3]), nil
+	}
+
+	if match := namedQuarterPattern.FindStringSubmatch(text); match != nil {
+		year := today.Year()
+		if match[3] != "" {
+			year, _ = strconv.Atoi(match[3])
+		}
+		quarter, _ := strconv.Atoi(match[2])
+		start := time.Date(year, time.Month(3*quarter-2), 1, 0, 0, 0, 0, now.Location())
+		return boundary(match[1], start, "quarter"), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date '%s' (use YYYY-MM-DD, today, yesterday, 'in 2 weeks', '3 days ago', 'next friday' or 'end of Q3')", input)
+}
+
+// relativeCount parses the number in a relative date, where "a", "an" and "one" mean 1
+func relativeCount(text string) int {
+	if n, err := strconv.Atoi(text); err == nil {
+		return n
+	}
+	return 1
+}
+
+func isPeriodUnit(unit string) bool {
+	switch unit {
+	case "week", "month", "quarter", "year":
+		return true
+	}
+	return false
+}
+
+// periodStart returns the first day of the week, month, quarter or year containing day
+func periodStart(day time.Time, unit string) time.Time {
+	switch unit {
+	case "week":
+		return StartOfWeek(day)
+	case "month":
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	case "quarter":
+		return time.Date(day.Year(), day.Month()-(day.Month()-1)%3, 1, 0, 0, 0, 0, day.Location())
+	default:
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+	}
+}
+
+// boundary returns the first day of the period starting at start, or its
+// last day for "end"
+func boundary(side string, start time.Time, unit string) time.Time {
+	if side == "end" {
+		return addUnits(start, unit, 1).AddDate(0, 0, -1)
+	}
+	return start
+}
+
+// addUnits moves day by n days, weeks, months, quarters or years. Month
+// based moves keep the day of month where possible and otherwise land on
+// the last day of the month, so a month after January 31 is February 28.
+func addUnits(day time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "day":
+		return day.AddDate(0, 0, n)
+	case "week":
+		return day.AddDate(0, 0, 7*n)
+	}
+
+	months := n
+	switch unit {
+	case "quarter":
+		months = 3 * n
+	case "year":
+		months = 12 * n
+	}
+
+	first := time.Date(day.Year(), day.Month()+time.Month(months), 1, 0, 0, 0, 0, day.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day.Day(), last)-1)
+}
+
+// RelativeDate describes the day t falls on relative to now, such as
+// "today", "tomorrow", "in 12 days", "3 weeks ago" or "in 2 months"
+func RelativeDate(t, now time.Time) string {
+	days := int(CalendarDate(t).Sub(CalendarDate(now)).Hours() / 24)
+
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	case -1:
+		return "yesterday"
+	}
+
+	span := max(days, -days)
+	n, unit := span, "day"
+	switch {
+	case span >= 365:
+		n, unit = span/365, "year"
+	case span >= 60:
+		n, unit = span/30, "month"
+	case span >= 14:
+		n, unit = span/7, "week"
+	}
+
+	amount := fmt.Sprintf("%d %s", n, unit)
+	if n != 1 {
+		amount += "s"
+	}
+	if days > 0 {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2026, 1, 31, 15, 30, 0, 0, time.UTC) // Saturday
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2026-03-15", date(2026, 3, 15)},
+		{"today", date(2026, 1, 31)},
+		{" Yesterday ", date(2026, 1, 30)},
+		{"tomorrow", date(2026, 2, 1)},
+		{"in 3 days", date(2026, 2, 3)},
+		{"in 2 weeks", date(2026, 2, 14)},
+		{"in a month", date(2026, 2, 28)},
+		{"in 1 year", date(2027, 1, 31)},
+		{"3 days ago", date(2026, 1, 28)},
+		{"a week ago", date(2026, 1, 24)},
+		{"next friday", date(2026, 2, 6)},
+		{"next saturday", date(2026, 2, 7)},
+		{"last saturday", date(2026, 1, 24)},
+		{"next week", date(2026, 2, 2)},
+		{"next month", date(2026, 2, 1)},
+		{"last year", date(2025, 1, 1)},
+		{"end of week", date(2026, 2, 1)},
+		{"end of this month", date(2026, 1, 31)},
+		{"end of next month", date(2026, 2, 28)},
+		{"start of next quarter", date(2026, 4, 1)},
+		{"beginning of last quarter", date(2025, 10, 1)},
+		{"end of year", date(2026, 12, 31)},
+		{"end of Q3", date(2026, 9, 30)},
+		{"start of q2 2027", date(2027, 4, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDate(tt.input, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseDate_Invalid(t *testing.T) {
+	now := time.Date(2026, 1, 31, 15, 30, 0, 0, time.UTC)
+
+	for _, input := range []string{"", "someday", "in two weeks", "next fortnight", "end of Q5", "2026-13-01"} {
+		_, err := ParseDate(input, now)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseDate_KeepsLocation(t *testing.T) {
+	kyiv := time.FixedZone("EET", 2*60*60)
+	now := time.Date(2026, 6, 10, 23, 30, 0, 0, kyiv)
+
+	got, err := ParseDate("tomorrow", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 6, 11, 0, 0, 0, 0, kyiv), got)
+}
+
+func TestRelativeDate(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time {
+		return time.Date(2026, 3, 10+offset, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{day(0), "today"},
+		{day(1), "tomorrow"},
+		{day(-1), "yesterday"},
+		{day(12), "in 12 days"},
+		{day(-13), "13 days ago"},
+		{day(14), "in 2 weeks"},
+		{day(-21), "3 weeks ago"},
+		{day(75), "in 2 months"},
+		{day(-400), "1 year ago"},
+		{day(800), "in 2 years"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, RelativeDate(tt.date, now))
+		})
+	}
+}
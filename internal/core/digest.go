@@ -0,0 +1,85 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// WeeklyDigest summarizes a single week of learning activity
+type WeeklyDigest struct {
+	WeekStart          time.Time
+	WeekEnd            time.Time // exclusive
+	Hours              float64
+	ProgressLogs       int
+	CompletedResources []*Resource
+	AchievedMilestones []*Milestone
+	Streak             int // consecutive weeks with logged progress, ending with this week
+}
+
+// ComputeWeeklyDigest builds the digest for the week containing day
+func ComputeWeeklyDigest(day time.Time, logs []*ProgressLog, resources []*Resource, milestones []*Milestone) WeeklyDigest {
+	start := StartOfWeek(day)
+	digest := WeeklyDigest{WeekStart: start, WeekEnd: start.AddDate(0, 0, 7)}
+	inWeek := digest.Contains
+
+	for _, log := range logs {
+		if inWeek(log.Date) {
+			digest.Hours += log.HoursInvested
+			digest.ProgressLogs++
+		}
+	}
+
+	for _, resource := range resources {
+		if resource.Status != ResourceCompleted {
+			continue
+		}
+		if inWeek(resource.CompletedAt()) {
+			digest.CompletedResources = append(digest.CompletedResources, resource)
+		}
+	}
+	sort.Slice(digest.CompletedResources, func(i, j int) bool {
+		return digest.CompletedResources[i].ID < digest.CompletedResources[j].ID
+	})
+
+	for _, milestone := range milestones {
+		if milestone.IsAchieved() && inWeek(*milestone.AchievedDate) {
+			digest.AchievedMilestones = append(digest.AchievedMilestones, milestone)
+		}
+	}
+	sort.Slice(digest.AchievedMilestones, func(i, j int) bool {
+		return digest.AchievedMilestones[i].AchievedDate.Before(*digest.AchievedMilestones[j].AchievedDate)
+	})
+
+	digest.Streak = WeeklyProgressStreak(logs, day)
+
+	return digest
+}
+
+// Contains reports whether t falls on one of the days of the digest's week
+func (d WeeklyDigest) Contains(t time.Time) bool {
+	day := CalendarDate(t)
+	return !day.Before(CalendarDate(d.WeekStart)) && day.Before(CalendarDate(d.WeekEnd))
+}
+
+// WeeklyProgressStreak counts consecutive weeks with at least one progress log,
+// ending with the week containing day. A week without logs yet does not break
+// the streak until it is over, so the current week is skipped when empty.
+func WeeklyProgressStreak(logs []*ProgressLog, day time.Time) int {
+	weeks := make(map[time.Time]bool)
+	for _, log := range logs {
+		weeks[StartOfWeek(CalendarDate(log.Date))] = true
+	}
+
+	week := StartOfWeek(CalendarDate(day))
+	if !weeks[week] {
+		week = week.AddDate(0, 0, -7)
+	}
+
+	streak := 0
+	for weeks[week] {
+		streak++
+		week = week.AddDate(0, 0, -7)
+	}
+
+	return streak
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func digestLog(t *testing.T, id string, date time.Time, hours float64) *ProgressLog {
+	t.Helper()
+	log, err := NewProgressLog(EntityID(id), date)
+	require.NoError(t, err)
+	log.HoursInvested = hours
+	return log
+}
+
+func TestComputeWeeklyDigest(t *testing.T) {
+	wednesday := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	logs := []*ProgressLog{
+		digestLog(t, "progress-001", time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), 2),
+		digestLog(t, "progress-002", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), 3),
+		digestLog(t, "progress-003", time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC), 1.5),
+		digestLog(t, "progress-004", time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), 4),
+	}
+
+	completed, _ := NewResource("resource-001", "Go Book", ResourceBook, "skill-001")
+	completed.History = History{{Timestamp: time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC), Field: "status", From: "in-progress", To: "completed"}}
+	completed.Status = ResourceCompleted
+
+	earlier, _ := NewResource("resource-002", "Old Course", ResourceCourse, "skill-001")
+	earlier.History = History{{Timestamp: time.Date(2024, 12, 20, 9, 0, 0, 0, time.UTC), Field: "status", From: "in-progress", To: "completed"}}
+	earlier.Status = ResourceCompleted
+
+	started, _ := NewResource("resource-003", "Started Video", ResourceVideo, "skill-001")
+	started.Status = ResourceInProgress
+
+	achieved, _ := NewMilestone("milestone-001", "First PR", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	achievedAt := time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC)
+	achieved.Status = StatusCompleted
+	achieved.AchievedDate = &achievedAt
+
+	pending, _ := NewMilestone("milestone-002", "Talk", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+
+	digest := ComputeWeeklyDigest(wednesday, logs, []*Resource{completed, earlier, started}, []*Milestone{achieved, pending})
+
+	assert.Equal(t, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), digest.WeekStart)
+	assert.Equal(t, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), digest.WeekEnd)
+	assert.Equal(t, 4.5, digest.Hours)
+	assert.Equal(t, 2, digest.ProgressLogs)
+	require.Len(t, digest.CompletedResources, 1)
+	assert.Equal(t, EntityID("resource-001"), digest.CompletedResources[0].ID)
+	require.Len(t, digest.AchievedMilestones, 1)
+	assert.Equal(t, EntityID("milestone-001"), digest.AchievedMilestones[0].ID)
+	assert.Equal(t, 2, digest.Streak)
+}
+
+func TestWeeklyProgressStreak(t *testing.T) {
+	logs := []*ProgressLog{
+		digestLog(t, "progress-001", time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC), 1),
+		digestLog(t, "progress-002", time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), 1),
+		digestLog(t, "progress-003", time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC), 1),
+	}
+
+	t.Run("counts consecutive weeks", func(t *testing.T) {
+		assert.Equal(t, 2, WeeklyProgressStreak(logs, time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("empty current week does not break streak", func(t *testing.T) {
+		assert.Equal(t, 2, WeeklyProgressStreak(logs, time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("missed week resets streak", func(t *testing.T) {
+		assert.Equal(t, 0, WeeklyProgressStreak(logs, time.Date(2025, 1, 21, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestResource_CompletedAt(t *testing.T) {
+	t.Run("uses history", func(t *testing.T) {
+		r, _ := NewResource("resource-001", "Go Book", ResourceBook, "skill-001")
+		r.Complete()
+
+		changes := r.History.ForField("status")
+		assert.Equal(t, changes[len(changes)-1].Timestamp, r.CompletedAt())
+	})
+
+	t.Run("falls back to updated", func(t *testing.T) {
+		r, _ := NewResource("resource-001", "Go Book", ResourceBook, "skill-001")
+		r.Status = ResourceCompleted
+
+		assert.Equal(t, r.Updated, r.CompletedAt())
+	})
+}
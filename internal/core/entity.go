@@ -0,0 +1,192 @@
+package core
+
+// Entity is implemented by every type stored as a markdown file. Repositories
+// use it to name files, write commit messages and move the markdown body in
+// and out of the frontmatter.
+type Entity interface {
+	GetID() EntityID
+	GetTitle() string
+	GetBody() string
+	SetBody(body string)
+}
+
+// Tagged is implemented by entities whose tags are searchable
+type Tagged interface {
+	GetTags() []string
+}
+
+// Tracked is implemented by entities that record a change history
+type Tracked interface {
+	GetHistory() History
+}
+
+// Attachable is implemented by entities that can have files attached.
+// AddAttachment also marks the entity as updated.
+type Attachable interface {
+	Entity
+	GetAttachments() Attachments
+	AddAttachment(attachment Attachment)
+}
+
+var (
+	_ Entity = (*Skill)(nil)
+	_ Entity = (*Goal)(nil)
+	_ Entity = (*LearningPath)(nil)
+	_ Entity = (*Phase)(nil)
+	_ Entity = (*Resource)(nil)
+	_ Entity = (*Milestone)(nil)
+	_ Entity = (*ProgressLog)(nil)
+	_ Entity = (*Note)(nil)
+	_ Entity = (*Feed)(nil)
+	_ Entity = (*Objective)(nil)
+	_ Entity = (*Snapshot)(nil)
+	_ Entity = (*Session)(nil)
+	_ Entity = (*Credential)(nil)
+	_ Entity = (*Output)(nil)
+	_ Entity = (*MentorSession)(nil)
+	_ Entity = (*Feedback)(nil)
+
+	_ Tagged = (*Skill)(nil)
+	_ Tagged = (*Goal)(nil)
+	_ Tagged = (*LearningPath)(nil)
+	_ Tagged = (*Resource)(nil)
+	_ Tagged = (*Note)(nil)
+	_ Tagged = (*Feed)(nil)
+	_ Tagged = (*Objective)(nil)
+	_ Tagged = (*Session)(nil)
+	_ Tagged = (*Credential)(nil)
+	_ Tagged = (*Output)(nil)
+
+	_ Tracked = (*Skill)(nil)
+	_ Tracked = (*Goal)(nil)
+	_ Tracked = (*LearningPath)(nil)
+	_ Tracked = (*Resource)(nil)
+	_ Tracked = (*Milestone)(nil)
+
+	_ Attachable = (*Skill)(nil)
+	_ Attachable = (*Goal)(nil)
+	_ Attachable = (*LearningPath)(nil)
+	_ Attachable = (*Resource)(nil)
+	_ Attachable = (*Milestone)(nil)
+)
+
+func (s *Skill) GetID() EntityID             { return s.ID }
+func (s *Skill) GetTitle() string            { return s.Title }
+func (s *Skill) GetBody() string             { return s.Body }
+func (s *Skill) SetBody(body string)         { s.Body = body }
+func (s *Skill) GetTags() []string           { return s.Tags }
+func (s *Skill) GetHistory() History         { return s.History }
+func (s *Skill) GetAttachments() Attachments { return s.Attachments }
+func (s *Skill) AddAttachment(a Attachment)  { s.Attachments.Add(a); s.Touch() }
+
+func (g *Goal) GetID() EntityID             { return g.ID }
+func (g *Goal) GetTitle() string            { return g.Title }
+func (g *Goal) GetBody() string             { return g.Body }
+func (g *Goal) SetBody(body string)         { g.Body = body }
+func (g *Goal) GetTags() []string           { return g.Tags }
+func (g *Goal) GetHistory() History         { return g.History }
+func (g *Goal) GetAttachments() Attachments { return g.Attachments }
+func (g *Goal) AddAttachment(a Attachment)  { g.Attachments.Add(a); g.Touch() }
+
+func (p *LearningPath) GetID() EntityID             { return p.ID }
+func (p *LearningPath) GetTitle() string            { return p.Title }
+func (p *LearningPath) GetBody() string             { return p.Body }
+func (p *LearningPath) SetBody(body string)         { p.Body = body }
+func (p *LearningPath) GetTags() []string           { return p.Tags }
+func (p *LearningPath) GetHistory() History         { return p.History }
+func (p *LearningPath) GetAttachments() Attachments { return p.Attachments }
+func (p *LearningPath) AddAttachment(a Attachment)  { p.Attachments.Add(a); p.Touch() }
+
+func (p *Phase) GetID() EntityID     { return p.ID }
+func (p *Phase) GetTitle() string    { return p.Title }
+func (p *Phase) GetBody() string     { return p.Body }
+func (p *Phase) SetBody(body string) { p.Body = body }
+
+func (r *Resource) GetID() EntityID             { return r.ID }
+func (r *Resource) GetTitle() string            { return r.Title }
+func (r *Resource) GetBody() string             { return r.Body }
+func (r *Resource) SetBody(body string)         { r.Body = body }
+func (r *Resource) GetTags() []string           { return r.Tags }
+func (r *Resource) GetHistory() History         { return r.History }
+func (r *Resource) GetAttachments() Attachments { return r.Attachments }
+func (r *Resource) AddAttachment(a Attachment)  { r.Attachments.Add(a); r.Touch() }
+
+func (m *Milestone) GetID() EntityID             { return m.ID }
+func (m *Milestone) GetTitle() string            { return m.Title }
+func (m *Milestone) GetBody() string             { return m.Body }
+func (m *Milestone) SetBody(body string)         { m.Body = body }
+func (m *Milestone) GetHistory() History         { return m.History }
+func (m *Milestone) GetAttachments() Attachments { return m.Attachments }
+func (m *Milestone) AddAttachment(a Attachment)  { m.Attachments.Add(a); m.Touch() }
+
+func (p *ProgressLog) GetID() EntityID { return p.ID }
+
+// GetTitle returns the log date, as progress logs have no title
+func (p *ProgressLog) GetTitle() string {
+	if p.Date.IsZero() {
+		return ""
+	}
+	return p.Date.Format("2006-01-02")
+}
+
+func (p *ProgressLog) GetBody() string     { return p.Body }
+func (p *ProgressLog) SetBody(body string) { p.Body = body }
+
+func (n *Note) GetID() EntityID { return n.ID }
+
+// GetTitle falls back to the note date for untitled notes
+func (n *Note) GetTitle() string {
+	if n.Title == "" && !n.Date.IsZero() {
+		return n.Date.Format("2006-01-02")
+	}
+	return n.Title
+}
+
+func (n *Note) GetBody() string     { return n.Body }
+func (n *Note) SetBody(body string) { n.Body = body }
+func (n *Note) GetTags() []string   { return n.Tags }
+
+func (f *Feed) GetID() EntityID     { return f.ID }
+func (f *Feed) GetTitle() string    { return f.Title }
+func (f *Feed) GetBody() string     { return f.Body }
+func (f *Feed) SetBody(body string) { f.Body = body }
+func (f *Feed) GetTags() []string   { return f.Tags }
+
+func (o *Objective) GetID() EntityID     { return o.ID }
+func (o *Objective) GetTitle() string    { return o.Title }
+func (o *Objective) GetBody() string     { return o.Body }
+func (o *Objective) SetBody(body string) { o.Body = body }
+func (o *Objective) GetTags() []string   { return o.Tags }
+
+func (s *Snapshot) GetID() EntityID     { return s.ID }
+func (s *Snapshot) GetTitle() string    { return s.Title }
+func (s *Snapshot) GetBody() string     { return s.Body }
+func (s *Snapshot) SetBody(body string) { s.Body = body }
+
+func (s *Session) GetID() EntityID     { return s.ID }
+func (s *Session) GetTitle() string    { return s.Title }
+func (s *Session) GetBody() string     { return s.Body }
+func (s *Session) SetBody(body string) { s.Body = body }
+func (s *Session) GetTags() []string   { return s.Tags }
+
+func (c *Credential) GetID() EntityID     { return c.ID }
+func (c *Credential) GetTitle() string    { return c.Title }
+func (c *Credential) GetBody() string     { return c.Body }
+func (c *Credential) SetBody(body string) { c.Body = body }
+func (c *Credential) GetTags() []string   { return c.Tags }
+
+func (o *Output) GetID() EntityID     { return o.ID }
+func (o *Output) GetTitle() string    { return o.Title }
+func (o *Output) GetBody() string     { return o.Body }
+func (o *Output) SetBody(body string) { o.Body = body }
+func (o *Output) GetTags() []string   { return o.Tags }
+
+func (m *MentorSession) GetID() EntityID     { return m.ID }
+func (m *MentorSession) GetTitle() string    { return m.Title }
+func (m *MentorSession) GetBody() string     { return m.Body }
+func (m *MentorSession) SetBody(body string) { m.Body = body }
+
+func (f *Feedback) GetID() EntityID     { return f.ID }
+func (f *Feedback) GetTitle() string    { return f.Title }
+func (f *Feedback) GetBody() string     { return f.Body }
+func (f *Feedback) SetBody(body string) { f.Body = body }
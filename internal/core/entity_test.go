@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntity(t *testing.T) {
+	t.Run("exposes id, title and body", func(t *testing.T) {
+		skill, err := NewSkill("skill-001", "Go", "programming", LevelBeginner)
+		require.NoError(t, err)
+
+		var entity Entity = skill
+		entity.SetBody("Notes")
+
+		assert.Equal(t, EntityID("skill-001"), entity.GetID())
+		assert.Equal(t, "Go", entity.GetTitle())
+		assert.Equal(t, "Notes", skill.Body)
+		assert.Equal(t, "Notes", entity.GetBody())
+	})
+
+	t.Run("progress logs are titled by date", func(t *testing.T) {
+		log, err := NewProgressLog("progress-001", time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		assert.Equal(t, "2025-06-02", log.GetTitle())
+		assert.Empty(t, (&ProgressLog{}).GetTitle())
+	})
+
+	t.Run("untitled notes fall back to their date", func(t *testing.T) {
+		note := &Note{ID: "note-001", Date: time.Date(2025, 3, 19, 0, 0, 0, 0, time.UTC)}
+
+		assert.Equal(t, "2025-03-19", note.GetTitle())
+	})
+
+	t.Run("only some entities are tagged or tracked", func(t *testing.T) {
+		var phase any = &Phase{}
+		var resource any = &Resource{Tags: []string{"go"}}
+
+		_, phaseTagged := phase.(Tagged)
+		assert.False(t, phaseTagged)
+
+		tagged, ok := resource.(Tagged)
+		require.True(t, ok)
+		assert.Equal(t, []string{"go"}, tagged.GetTags())
+
+		_, tracked := resource.(Tracked)
+		assert.True(t, tracked)
+	})
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxSeenFeedItems caps how many item IDs a feed remembers
+const maxSeenFeedItems = 500
+
+// Feed represents an RSS/Atom feed or newsletter watched for a skill
+type Feed struct {
+	ID          EntityID   `yaml:"id"`
+	Title       string     `yaml:"title"`
+	URL         string     `yaml:"url"`
+	SkillID     EntityID   `yaml:"skillId"`
+	LastFetched *time.Time `yaml:"lastFetched,omitempty"`
+	SeenItems   []string   `yaml:"seenItems,omitempty"` // item GUIDs already offered, newest last
+	Tags        []string   `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains optional notes about the feed
+	Body string `yaml:"-"`
+}
+
+// NewFeed creates a new Feed with validation
+func NewFeed(id EntityID, title, feedURL string, skillID EntityID) (*Feed, error) {
+	feed := &Feed{
+		ID:         id,
+		Title:      title,
+		URL:        strings.TrimSpace(feedURL),
+		SkillID:    skillID,
+		SeenItems:  []string{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+	}
+
+	if err := feed.Validate(); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+func (f *Feed) Validate() error {
+	if f.ID == "" {
+		return errors.New("feed ID is required")
+	}
+
+	if strings.TrimSpace(f.Title) == "" {
+		return errors.New("feed title is required and cannot be empty")
+	}
+
+	if f.URL == "" {
+		return errors.New("feed URL is required")
+	}
+
+	parsed, err := url.Parse(f.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("feed URL must be an http(s) URL")
+	}
+
+	if f.SkillID == "" {
+		return errors.New("skill ID is required")
+	}
+
+	if f.Created.IsZero() {
+		return errors.New("feed created timestamp is required")
+	}
+
+	if f.Updated.IsZero() {
+		return errors.New("feed updated timestamp is required")
+	}
+
+	return nil
+}
+
+// HasSeen returns true if the item was already offered from this feed
+func (f *Feed) HasSeen(itemID string) bool {
+	for _, seen := range f.SeenItems {
+		if seen == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkSeen records items as offered, dropping the oldest once the cap is reached
+func (f *Feed) MarkSeen(itemIDs ...string) {
+	for _, id := range itemIDs {
+		if id == "" || f.HasSeen(id) {
+			continue
+		}
+		f.SeenItems = append(f.SeenItems, id)
+	}
+
+	if len(f.SeenItems) > maxSeenFeedItems {
+		f.SeenItems = f.SeenItems[len(f.SeenItems)-maxSeenFeedItems:]
+	}
+	f.Touch()
+}
+
+// MarkFetched records when the feed was last fetched
+func (f *Feed) MarkFetched(at time.Time) {
+	f.LastFetched = &at
+	f.Touch()
+}
+
+// AddTag adds a tag to the feed (normalized to lowercase)
+func (f *Feed) AddTag(tag string) {
+	normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+	if normalizedTag == "" {
+		return
+	}
+
+	for _, t := range f.Tags {
+		if t == normalizedTag {
+			return
+		}
+	}
+	f.Tags = append(f.Tags, normalizedTag)
+	f.Touch()
+}
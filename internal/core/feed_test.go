@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeed(t *testing.T) {
+	t.Run("creates valid feed", func(t *testing.T) {
+		feed, err := NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("feed-001"), feed.ID)
+		assert.Equal(t, "https://golangweekly.com/rss", feed.URL)
+		assert.Equal(t, EntityID("skill-001"), feed.SkillID)
+		assert.Nil(t, feed.LastFetched)
+		assert.Empty(t, feed.SeenItems)
+	})
+
+	t.Run("fails with empty title", func(t *testing.T) {
+		_, err := NewFeed("feed-001", "", "https://golangweekly.com/rss", "skill-001")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "title is required")
+	})
+
+	t.Run("fails with non-http URL", func(t *testing.T) {
+		_, err := NewFeed("feed-001", "Go Weekly", "ftp://example.com/rss", "skill-001")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "http(s) URL")
+	})
+
+	t.Run("fails with empty skill ID", func(t *testing.T) {
+		_, err := NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "skill ID is required")
+	})
+}
+
+func TestFeed_MarkSeen(t *testing.T) {
+	t.Run("records items once", func(t *testing.T) {
+		feed, _ := NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+
+		feed.MarkSeen("a", "b", "a", "")
+
+		assert.Equal(t, []string{"a", "b"}, feed.SeenItems)
+		assert.True(t, feed.HasSeen("a"))
+		assert.False(t, feed.HasSeen("c"))
+	})
+
+	t.Run("drops oldest items past the cap", func(t *testing.T) {
+		feed, _ := NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+
+		for i := 0; i < maxSeenFeedItems+10; i++ {
+			feed.MarkSeen(fmt.Sprintf("item-%d", i))
+		}
+
+		assert.Len(t, feed.SeenItems, maxSeenFeedItems)
+		assert.False(t, feed.HasSeen("item-0"))
+		assert.True(t, feed.HasSeen(fmt.Sprintf("item-%d", maxSeenFeedItems+9)))
+	})
+}
+
+func TestFeed_MarkFetched(t *testing.T) {
+	feed, _ := NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+	at := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	feed.MarkFetched(at)
+
+	require.NotNil(t, feed.LastFetched)
+	assert.Equal(t, at, *feed.LastFetched)
+}
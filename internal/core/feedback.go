@@ -0,0 +1,130 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxFeedbackRating is the top of the 1-5 scale feedback rates skills on
+const MaxFeedbackRating = 5
+
+// Feedback is one piece of 360 feedback: what a peer, manager or report said
+// about the skills they saw you use
+type Feedback struct {
+	ID           EntityID             `yaml:"id"`
+	Title        string               `yaml:"title"`
+	From         string               `yaml:"from"` // the person who gave it
+	Relationship FeedbackRelationship `yaml:"relationship"`
+	Date         time.Time            `yaml:"date"`
+	Cycle        string               `yaml:"cycle,omitempty"` // review cycle, e.g. "2025 H1"
+	Skills       []EntityID           `yaml:"skills,omitempty"`
+	Rating       int                  `yaml:"rating,omitempty"` // 1-5, 0 when not rated
+	Strengths    []string             `yaml:"strengths,omitempty"`
+	Improvements []string             `yaml:"improvements,omitempty"`
+	Timestamps
+
+	// Body contains the feedback in the giver's own words
+	Body string `yaml:"-"`
+}
+
+// NewFeedback creates a new Feedback titled after the person who gave it
+func NewFeedback(id EntityID, from string, relationship FeedbackRelationship, date time.Time) (*Feedback, error) {
+	from = strings.TrimSpace(from)
+	feedback := &Feedback{
+		ID:           id,
+		Title:        FeedbackTitle(from, relationship),
+		From:         from,
+		Relationship: relationship,
+		Date:         date,
+		Skills:       []EntityID{},
+		Timestamps:   NewTimestamps(),
+	}
+
+	if err := feedback.Validate(); err != nil {
+		return nil, err
+	}
+
+	return feedback, nil
+}
+
+// FeedbackTitle describes feedback by who gave it
+func FeedbackTitle(from string, relationship FeedbackRelationship) string {
+	return fmt.Sprintf("Feedback from %s (%s)", from, relationship)
+}
+
+func (f *Feedback) Validate() error {
+	if f.ID == "" {
+		return errors.New("feedback ID is required")
+	}
+
+	if strings.TrimSpace(f.From) == "" {
+		return errors.New("feedback needs the person who gave it")
+	}
+
+	if !f.Relationship.IsValid() {
+		return errors.New("invalid feedback relationship: must be one of: peer, manager, report")
+	}
+
+	if f.Date.IsZero() {
+		return errors.New("feedback date is required")
+	}
+
+	if f.Rating < 0 || f.Rating > MaxFeedbackRating {
+		return fmt.Errorf("feedback rating must be between 1 and %d", MaxFeedbackRating)
+	}
+
+	if f.Created.IsZero() {
+		return errors.New("feedback created timestamp is required")
+	}
+
+	if f.Updated.IsZero() {
+		return errors.New("feedback updated timestamp is required")
+	}
+
+	return nil
+}
+
+// SetRating sets the 1-5 rating, or clears it with 0
+func (f *Feedback) SetRating(rating int) error {
+	if rating < 0 || rating > MaxFeedbackRating {
+		return fmt.Errorf("feedback rating must be between 1 and %d", MaxFeedbackRating)
+	}
+	f.Rating = rating
+	f.Touch()
+	return nil
+}
+
+// AddSkill links a skill the feedback is about
+func (f *Feedback) AddSkill(skillID EntityID) {
+	if f.HasSkill(skillID) {
+		return
+	}
+	f.Skills = append(f.Skills, skillID)
+	f.Touch()
+}
+
+// HasSkill returns true if the feedback is about the given skill
+func (f *Feedback) HasSkill(skillID EntityID) bool {
+	for _, id := range f.Skills {
+		if id == skillID {
+			return true
+		}
+	}
+	return false
+}
+
+// SameAs reports whether other is the same feedback imported again: from the
+// same person on the same day about the same skills
+func (f *Feedback) SameAs(other *Feedback) bool {
+	if !strings.EqualFold(f.From, other.From) || f.Date.Format("2006-01-02") != other.Date.Format("2006-01-02") || len(f.Skills) != len(other.Skills) {
+		return false
+	}
+	for _, id := range other.Skills {
+		if !f.HasSkill(id) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedback(t *testing.T) {
+	date := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates valid feedback", func(t *testing.T) {
+		feedback, err := NewFeedback("feedback-001", " Alice ", FeedbackManager, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", feedback.From)
+		assert.Equal(t, "Feedback from Alice (manager)", feedback.Title)
+		assert.Equal(t, date, feedback.Date)
+		assert.Empty(t, feedback.Skills)
+	})
+
+	t.Run("fails without the giver", func(t *testing.T) {
+		_, err := NewFeedback("feedback-001", " ", FeedbackPeer, date)
+		assert.ErrorContains(t, err, "person who gave it")
+	})
+
+	t.Run("fails with invalid relationship", func(t *testing.T) {
+		_, err := NewFeedback("feedback-001", "Alice", "friend", date)
+		assert.ErrorContains(t, err, "invalid feedback relationship")
+	})
+
+	t.Run("fails with zero date", func(t *testing.T) {
+		_, err := NewFeedback("feedback-001", "Alice", FeedbackPeer, time.Time{})
+		assert.ErrorContains(t, err, "date is required")
+	})
+}
+
+func TestFeedback_SetRating(t *testing.T) {
+	feedback, _ := NewFeedback("feedback-001", "Alice", FeedbackPeer, time.Now())
+
+	require.NoError(t, feedback.SetRating(5))
+	assert.Equal(t, 5, feedback.Rating)
+	require.NoError(t, feedback.SetRating(0))
+	assert.Equal(t, 0, feedback.Rating)
+	assert.Error(t, feedback.SetRating(6))
+	assert.Error(t, feedback.SetRating(-1))
+}
+
+func TestFeedback_SameAs(t *testing.T) {
+	date := time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC)
+	feedback, _ := NewFeedback("feedback-001", "Alice", FeedbackPeer, date)
+	feedback.AddSkill("skill-001")
+	feedback.AddSkill("skill-002")
+	feedback.AddSkill("skill-001")
+	assert.Equal(t, []EntityID{"skill-001", "skill-002"}, feedback.Skills)
+
+	again, _ := NewFeedback("feedback-002", "alice", FeedbackPeer, date.Add(5*time.Hour))
+	again.AddSkill("skill-002")
+	again.AddSkill("skill-001")
+	assert.True(t, feedback.SameAs(again))
+
+	other, _ := NewFeedback("feedback-003", "Alice", FeedbackPeer, date)
+	other.AddSkill("skill-001")
+	assert.False(t, feedback.SameAs(other))
+
+	later, _ := NewFeedback("feedback-004", "Alice", FeedbackPeer, date.AddDate(0, 0, 1))
+	later.AddSkill("skill-001")
+	later.AddSkill("skill-002")
+	assert.False(t, feedback.SameAs(later))
+}
@@ -0,0 +1,115 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Distraction is an interruption logged during a focus session
+type Distraction struct {
+	At   time.Time
+	Note string
+}
+
+// FocusSession tracks a timed, single-resource study session
+type FocusSession struct {
+	ResourceID   EntityID
+	Started      time.Time
+	Stopped      time.Time
+	Distractions []Distraction
+}
+
+// NewFocusSession starts a focus session on a resource
+func NewFocusSession(resourceID EntityID, started time.Time) *FocusSession {
+	return &FocusSession{ResourceID: resourceID, Started: started}
+}
+
+// LogDistraction records an interruption with an optional note
+func (f *FocusSession) LogDistraction(at time.Time, note string) {
+	f.Distractions = append(f.Distractions, Distraction{At: at, Note: strings.TrimSpace(note)})
+}
+
+// Stop ends the session
+func (f *FocusSession) Stop(at time.Time) {
+	if f.Stopped.IsZero() {
+		f.Stopped = at
+	}
+}
+
+// Minutes returns the whole minutes focused, up to now for a running session
+func (f *FocusSession) Minutes(now time.Time) int {
+	end := f.Stopped
+	if end.IsZero() {
+		end = now
+	}
+	return int(end.Sub(f.Started).Minutes())
+}
+
+// Summary renders the session as a markdown progress log body
+func (f *FocusSession) Summary(resourceTitle string) string {
+	var b strings.Builder
+	noun := "distractions"
+	if len(f.Distractions) == 1 {
+		noun = "distraction"
+	}
+	fmt.Fprintf(&b, "Focus session on %s (%s): %d min, %d %s.\n",
+		resourceTitle, f.ResourceID, f.Minutes(f.Stopped), len(f.Distractions), noun)
+
+	if len(f.Distractions) > 0 {
+		b.WriteString("\n## Distractions\n\n")
+		for _, d := range f.Distractions {
+			line := "- " + d.At.Format("15:04")
+			if d.Note != "" {
+				line += " " + d.Note
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// RecordFocus stores the focused minutes and distraction count of a focus
+// session, adding the minutes to the hours invested
+func (p *ProgressLog) RecordFocus(minutes, distractions int) error {
+	if minutes < 0 || distractions < 0 {
+		return errors.New("focus minutes and distractions cannot be negative")
+	}
+	p.FocusMinutes += minutes
+	p.Distractions += distractions
+	p.HoursInvested += float64(minutes) / 60
+	p.Touch()
+	return nil
+}
+
+// FocusStats summarizes focus sessions recorded in progress logs
+type FocusStats struct {
+	Sessions     int
+	Minutes      int
+	Distractions int
+}
+
+// DistractionsPerHour returns the average number of distractions per focused hour
+func (s FocusStats) DistractionsPerHour() float64 {
+	if s.Minutes == 0 {
+		return 0
+	}
+	return float64(s.Distractions) / (float64(s.Minutes) / 60)
+}
+
+// ComputeFocusStats summarizes the focus sessions of logs dated at or after
+// since; a zero since includes every log
+func ComputeFocusStats(logs []*ProgressLog, since time.Time) FocusStats {
+	var stats FocusStats
+	for _, log := range logs {
+		if log.FocusMinutes == 0 || log.Date.Before(since) {
+			continue
+		}
+		stats.Sessions++
+		stats.Minutes += log.FocusMinutes
+		stats.Distractions += log.Distractions
+	}
+	return stats
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFocusSession(t *testing.T) {
+	start := time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)
+
+	session := NewFocusSession("resource-004", start)
+	session.LogDistraction(start.Add(10*time.Minute), " slack ")
+	session.LogDistraction(start.Add(31*time.Minute), "")
+
+	assert.Equal(t, 20, session.Minutes(start.Add(20*time.Minute+40*time.Second)))
+
+	session.Stop(start.Add(45 * time.Minute))
+	session.Stop(start.Add(50 * time.Minute))
+
+	assert.Equal(t, 45, session.Minutes(start.Add(time.Hour)))
+	assert.Equal(t, "Focus session on Effective Go (resource-004): 45 min, 2 distractions.\n\n## Distractions\n\n- 09:10 slack\n- 09:31\n",
+		session.Summary("Effective Go"))
+}
+
+func TestProgressLog_RecordFocus(t *testing.T) {
+	log, _ := NewProgressLog("progress-001", time.Now())
+
+	require.NoError(t, log.RecordFocus(45, 2))
+	require.NoError(t, log.RecordFocus(15, 0))
+
+	assert.Equal(t, 60, log.FocusMinutes)
+	assert.Equal(t, 2, log.Distractions)
+	assert.Equal(t, 1.0, log.HoursInvested)
+	assert.Error(t, log.RecordFocus(-1, 0))
+}
+
+func TestComputeFocusStats(t *testing.T) {
+	newLog := func(day, minutes, distractions int) *ProgressLog {
+		log, _ := NewProgressLog("progress-001", time.Date(2025, 7, day, 0, 0, 0, 0, time.UTC))
+		log.FocusMinutes = minutes
+		log.Distractions = distractions
+		return log
+	}
+	logs := []*ProgressLog{newLog(1, 60, 3), newLog(5, 0, 0), newLog(8, 30, 0)}
+
+	stats := ComputeFocusStats(logs, time.Time{})
+	assert.Equal(t, FocusStats{Sessions: 2, Minutes: 90, Distractions: 3}, stats)
+	assert.Equal(t, 2.0, stats.DistractionsPerHour())
+
+	recent := ComputeFocusStats(logs, time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, 1, recent.Sessions)
+	assert.Equal(t, 0.0, recent.DistractionsPerHour())
+	assert.Equal(t, 0.0, FocusStats{}.DistractionsPerHour())
+}
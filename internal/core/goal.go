@@ -2,20 +2,27 @@ package core
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 )
 
 // Goal represents a high-level career objective
 type Goal struct {
-	ID            EntityID   `yaml:"id"`
-	Title         string     `yaml:"title"`
-	Status        Status     `yaml:"status"`
-	Priority      Priority   `yaml:"priority"`
-	TargetDate    *time.Time `yaml:"targetDate,omitempty"`
-	LearningPaths []EntityID `yaml:"learningPaths,omitempty"`
-	Milestones    []EntityID `yaml:"milestones,omitempty"`
-	Tags          []string   `yaml:"tags,omitempty"`
+	ID            EntityID              `yaml:"id"`
+	Title         string                `yaml:"title"`
+	Status        Status                `yaml:"status"`
+	Priority      Priority              `yaml:"priority"`
+	TargetDate    *time.Time            `yaml:"targetDate,omitempty"`
+	LearningPaths []EntityID            `yaml:"learningPaths,omitempty"`
+	Milestones    []EntityID            `yaml:"milestones,omitempty"`
+	Blockers      []string              `yaml:"blockers,omitempty"` // what is stalling the goal while blocked
+	CompleteWhen  []CompletionCriterion `yaml:"completeWhen,omitempty"`
+	Tags          []string              `yaml:"tags,omitempty"`
+	Tickets       Tickets               `yaml:"tickets,omitempty"` // work tickets that evidence the goal
+	History       History               `yaml:"history,omitempty"`
+	Attachments   Attachments           `yaml:"attachments,omitempty"`
 	Timestamps
 
 	// Body contains the markdown content (motivation, success criteria, timeline, notes)
@@ -50,14 +57,20 @@ func (g *Goal) Validate() error {
 		return errors.New("goal title is required and cannot be empty")
 	}
 
-	if !g.Status.IsValid() {
-		return errors.New("invalid goal status: must be one of: active, completed, archived")
+	if !g.Status.IsValidForGoal() {
+		return errors.New("invalid goal status: must be one of: active, blocked, completed, archived")
 	}
 
 	if !g.Priority.IsValid() {
 		return errors.New("invalid goal priority: must be one of: high, medium, low")
 	}
 
+	for _, criterion := range g.CompleteWhen {
+		if !criterion.IsValid() {
+			return errors.New("invalid goal completion criterion: must be one of: milestones, paths")
+		}
+	}
+
 	if g.Created.IsZero() {
 		return errors.New("goal created timestamp is required")
 	}
@@ -125,18 +138,77 @@ func (g *Goal) AddTag(tag string) {
 }
 
 func (g *Goal) UpdateStatus(status Status) error {
-	if !status.IsValid() {
-		return errors.New("invalid goal status: must be one of: active, completed, archived")
+	if !status.IsValidForGoal() {
+		return errors.New("invalid goal status: must be one of: active, blocked, completed, archived")
+	}
+	if status != StatusBlocked {
+		g.Blockers = nil
+	}
+	g.setStatus(status)
+	g.Touch()
+	return nil
+}
+
+// Block marks the goal as blocked and records what is blocking it
+func (g *Goal) Block(blocker string) {
+	g.AddBlocker(blocker)
+	g.setStatus(StatusBlocked)
+	g.Touch()
+}
+
+// AddBlocker records a blocker without changing the status
+func (g *Goal) AddBlocker(blocker string) {
+	blocker = strings.TrimSpace(blocker)
+	if blocker == "" {
+		return
+	}
+
+	for _, b := range g.Blockers {
+		if b == blocker {
+			return
+		}
+	}
+	g.Blockers = append(g.Blockers, blocker)
+	g.Touch()
+}
+
+// RemoveBlocker removes the blocker at the given 1-based position.
+// When the last blocker is removed from a blocked goal it becomes active again.
+func (g *Goal) RemoveBlocker(position int) error {
+	if position < 1 || position > len(g.Blockers) {
+		return errors.New("invalid blocker number")
+	}
+
+	g.Blockers = append(g.Blockers[:position-1], g.Blockers[position:]...)
+	if len(g.Blockers) == 0 && g.Status == StatusBlocked {
+		g.setStatus(StatusActive)
 	}
-	g.Status = status
 	g.Touch()
 	return nil
 }
 
+// Unblock clears all blockers and makes the goal active again
+func (g *Goal) Unblock() {
+	g.Blockers = nil
+	g.setStatus(StatusActive)
+	g.Touch()
+}
+
+func (g *Goal) setStatus(status Status) {
+	g.History.Record("status", string(g.Status), string(status))
+	g.Status = status
+}
+
+// IsBlocked returns true if the goal is blocked
+func (g *Goal) IsBlocked() bool {
+	return g.Status == StatusBlocked
+}
+
 func (g *Goal) UpdatePriority(priority Priority) error {
 	if !priority.IsValid() {
 		return errors.New("invalid goal priority: must be one of: high, medium, low")
 	}
+	g.History.Record("priority", string(g.Priority), string(priority))
 	g.Priority = priority
 	g.Touch()
 	return nil
@@ -151,3 +223,63 @@ func (g *Goal) ClearTargetDate() {
 	g.TargetDate = nil
 	g.Touch()
 }
+
+// ParseCompletionCriteria parses a comma-separated list of completion
+// criteria, such as "milestones,paths"
+func ParseCompletionCriteria(value string) ([]CompletionCriterion, error) {
+	var criteria []CompletionCriterion
+	for _, part := range strings.Split(value, ",") {
+		criterion := CompletionCriterion(strings.ToLower(strings.TrimSpace(part)))
+		if criterion == "" || slices.Contains(criteria, criterion) {
+			continue
+		}
+		if !criterion.IsValid() {
+			return nil, fmt.Errorf("invalid completion criterion '%s': must be one of: milestones, paths", criterion)
+		}
+		criteria = append(criteria, criterion)
+	}
+	return criteria, nil
+}
+
+// CriteriaMet reports whether an active goal with completion criteria has
+// met all of them. milestones and paths may include ones unrelated to the
+// goal. A criterion needs at least one milestone or path to be met; archived
+// and review milestones and abandoned or archived paths do not count.
+func (g *Goal) CriteriaMet(milestones []*Milestone, paths []*LearningPath) bool {
+	if g.Status != StatusActive || len(g.CompleteWhen) == 0 {
+		return false
+	}
+
+	for _, criterion := range g.CompleteWhen {
+		linked, done := 0, 0
+		switch criterion {
+		case CriterionMilestones:
+			for _, milestone := range milestones {
+				belongs := slices.Contains(g.Milestones, milestone.ID) ||
+					(milestone.ReferenceType == ReferenceGoal && milestone.ReferenceID == g.ID)
+				if !belongs || milestone.IsReview() || milestone.Status == StatusArchived {
+					continue
+				}
+				linked++
+				if milestone.IsAchieved() {
+					done++
+				}
+			}
+		case CriterionPaths:
+			for _, path := range paths {
+				if !slices.Contains(g.LearningPaths, path.ID) ||
+					path.Status == StatusAbandoned || path.Status == StatusArchived {
+					continue
+				}
+				linked++
+				if path.Status == StatusCompleted {
+					done++
+				}
+			}
+		}
+		if linked == 0 || done < linked {
+			return false
+		}
+	}
+	return true
+}
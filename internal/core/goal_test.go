@@ -246,3 +246,123 @@ func TestGoal_TargetDate(t *testing.T) {
 		assert.Nil(t, goal.TargetDate)
 	})
 }
+
+func TestGoal_Blockers(t *testing.T) {
+	t.Run("blocks with reason", func(t *testing.T) {
+		goal, _ := NewGoal("goal-001", "Become ML Engineer", PriorityHigh)
+
+		goal.Block("Waiting for GPU budget approval")
+		goal.Block("Waiting for GPU budget approval")
+
+		assert.True(t, goal.IsBlocked())
+		assert.Equal(t, []string{"Waiting for GPU budget approval"}, goal.Blockers)
+		assert.NoError(t, goal.Validate())
+	})
+
+	t.Run("removing last blocker reactivates goal", func(t *testing.T) {
+		goal, _ := NewGoal("goal-001", "Become ML Engineer", PriorityHigh)
+		goal.Block("No mentor")
+		goal.AddBlocker("No time")
+
+		require.NoError(t, goal.RemoveBlocker(1))
+		assert.True(t, goal.IsBlocked())
+		assert.Equal(t, []string{"No time"}, goal.Blockers)
+
+		require.NoError(t, goal.RemoveBlocker(1))
+		assert.Equal(t, StatusActive, goal.Status)
+		assert.Error(t, goal.RemoveBlocker(1))
+	})
+
+	t.Run("unblock clears blockers", func(t *testing.T) {
+		goal, _ := NewGoal("goal-001", "Become ML Engineer", PriorityHigh)
+		goal.Block("No mentor")
+
+		goal.Unblock()
+
+		assert.Equal(t, StatusActive, goal.Status)
+		assert.Empty(t, goal.Blockers)
+	})
+
+	t.Run("status change away from blocked clears blockers", func(t *testing.T) {
+		goal, _ := NewGoal("goal-001", "Become ML Engineer", PriorityHigh)
+		goal.Block("No mentor")
+
+		require.NoError(t, goal.UpdateStatus(StatusArchived))
+		assert.Empty(t, goal.Blockers)
+	})
+
+	t.Run("blocked is goal-only", func(t *testing.T) {
+		assert.True(t, StatusBlocked.IsValidForGoal())
+		assert.False(t, StatusBlocked.IsValid())
+		assert.False(t, StatusBlocked.IsValidForPath())
+	})
+}
+
+func TestParseCompletionCriteria(t *testing.T) {
+	criteria, err := ParseCompletionCriteria("Milestones, paths,milestones")
+	require.NoError(t, err)
+	assert.Equal(t, []CompletionCriterion{CriterionMilestones, CriterionPaths}, criteria)
+
+	criteria, err = ParseCompletionCriteria("")
+	require.NoError(t, err)
+	assert.Empty(t, criteria)
+
+	_, err = ParseCompletionCriteria("milestones,skills")
+	assert.ErrorContains(t, err, "invalid completion criterion 'skills'")
+}
+
+func TestGoal_CriteriaMet(t *testing.T) {
+	newGoal := func(criteria ...CompletionCriterion) *Goal {
+		goal, _ := NewGoal("goal-001", "Become a platform engineer", PriorityHigh)
+		goal.CompleteWhen = criteria
+		goal.AddLearningPath("path-001")
+		return goal
+	}
+
+	linked, _ := NewMilestone("milestone-001", "Pass CKA", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	listed, _ := NewMilestone("milestone-002", "Give a talk", MilestoneSkillLevel, ReferenceSkill, "skill-001")
+	other, _ := NewMilestone("milestone-003", "Unrelated", MilestoneGoalLevel, ReferenceGoal, "goal-002")
+	milestones := []*Milestone{linked, listed, other}
+
+	path, _ := NewLearningPath("path-001", "Kubernetes", PathTypeManual)
+	otherPath, _ := NewLearningPath("path-002", "Rust", PathTypeManual)
+	paths := []*LearningPath{path, otherPath}
+
+	t.Run("false without criteria", func(t *testing.T) {
+		linked.Achieve("")
+		defer func() { linked.Status, linked.AchievedDate = StatusActive, nil }()
+		assert.False(t, newGoal().CriteriaMet(milestones, paths))
+	})
+
+	t.Run("milestones criterion", func(t *testing.T) {
+		goal := newGoal(CriterionMilestones)
+		goal.AddMilestone("milestone-002")
+		assert.False(t, goal.CriteriaMet(milestones, paths))
+
+		linked.Achieve("")
+		assert.False(t, goal.CriteriaMet(milestones, paths), "milestone-002 is listed on the goal and still open")
+
+		listed.Achieve("")
+		assert.True(t, goal.CriteriaMet(milestones, paths))
+	})
+
+	t.Run("paths criterion", func(t *testing.T) {
+		goal := newGoal(CriterionMilestones, CriterionPaths)
+		assert.False(t, goal.CriteriaMet(milestones, paths))
+
+		require.NoError(t, path.UpdateStatus(StatusCompleted))
+		assert.True(t, goal.CriteriaMet(milestones, paths))
+	})
+
+	t.Run("false without linked paths", func(t *testing.T) {
+		goal := newGoal(CriterionPaths)
+		goal.LearningPaths = nil
+		assert.False(t, goal.CriteriaMet(milestones, paths))
+	})
+
+	t.Run("false unless active", func(t *testing.T) {
+		goal := newGoal(CriterionPaths)
+		goal.Status = StatusCompleted
+		assert.False(t, goal.CriteriaMet(milestones, paths))
+	})
+}
@@ -0,0 +1,125 @@
+package core
+
+import "slices"
+
+// GraphNode is an entity in a relationship graph
+type GraphNode struct {
+	ID     EntityID `yaml:"id"`
+	Title  string   `yaml:"title"`
+	Status string   `yaml:"status,omitempty"`
+}
+
+// GraphEdge links an entity to one it contains or owns, such as a goal to
+// its paths or a skill to its resources
+type GraphEdge struct {
+	From EntityID `yaml:"from"`
+	To   EntityID `yaml:"to"`
+}
+
+// Graph is the relationships between entities: goals to paths and
+// milestones, paths to phases, phases to milestones and resources, and
+// skills to resources and milestones
+type Graph struct {
+	Nodes []GraphNode `yaml:"nodes"`
+	Edges []GraphEdge `yaml:"edges"`
+}
+
+// AddNode adds an entity to the graph, once
+func (g *Graph) AddNode(id EntityID, title, status string) {
+	if _, ok := g.Node(id); ok {
+		return
+	}
+	g.Nodes = append(g.Nodes, GraphNode{ID: id, Title: title, Status: status})
+}
+
+// AddEdge links two entities of the graph, once. Links to entities that are
+// not in the graph, such as deleted ones, are ignored.
+func (g *Graph) AddEdge(from, to EntityID) {
+	if _, ok := g.Node(from); !ok {
+		return
+	}
+	if _, ok := g.Node(to); !ok {
+		return
+	}
+	edge := GraphEdge{From: from, To: to}
+	if !slices.Contains(g.Edges, edge) {
+		g.Edges = append(g.Edges, edge)
+	}
+}
+
+// Node returns the node of an entity
+func (g *Graph) Node(id EntityID) (GraphNode, bool) {
+	for _, node := range g.Nodes {
+		if node.ID == id {
+			return node, true
+		}
+	}
+	return GraphNode{}, false
+}
+
+// Children returns the entities an entity links to, in the order they were
+// linked
+func (g *Graph) Children(id EntityID) []EntityID {
+	var children []EntityID
+	for _, edge := range g.Edges {
+		if edge.From == id {
+			children = append(children, edge.To)
+		}
+	}
+	return children
+}
+
+// Roots returns the entities nothing links to, in node order
+func (g *Graph) Roots() []EntityID {
+	linked := make(map[EntityID]bool, len(g.Edges))
+	for _, edge := range g.Edges {
+		linked[edge.To] = true
+	}
+
+	var roots []EntityID
+	for _, node := range g.Nodes {
+		if !linked[node.ID] {
+			roots = append(roots, node.ID)
+		}
+	}
+	return roots
+}
+
+// Neighborhood returns the part of the graph around an entity: the entity,
+// everything it links to directly or indirectly, and everything that links
+// to it the same way
+func (g *Graph) Neighborhood(id EntityID) *Graph {
+	included := map[EntityID]bool{id: true}
+	g.walk(id, included, func(edge GraphEdge) (EntityID, EntityID) { return edge.From, edge.To })
+	g.walk(id, included, func(edge GraphEdge) (EntityID, EntityID) { return edge.To, edge.From })
+
+	neighborhood := &Graph{}
+	for _, node := range g.Nodes {
+		if included[node.ID] {
+			neighborhood.Nodes = append(neighborhood.Nodes, node)
+		}
+	}
+	for _, edge := range g.Edges {
+		if included[edge.From] && included[edge.To] {
+			neighborhood.Edges = append(neighborhood.Edges, edge)
+		}
+	}
+	return neighborhood
+}
+
+// walk marks the entities reachable from id along the edges, in the
+// direction given by ends
+func (g *Graph) walk(id EntityID, seen map[EntityID]bool, ends func(GraphEdge) (EntityID, EntityID)) {
+	queue := []EntityID{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range g.Edges {
+			from, to := ends(edge)
+			if from == current && !seen[to] {
+				seen[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testGraph() *Graph {
+	g := &Graph{}
+	g.AddNode("goal-001", "Backend developer", "active")
+	g.AddNode("path-001", "Go path", "active")
+	g.AddNode("phase-001", "Basics", "finished")
+	g.AddNode("phase-002", "Web", "not started")
+	g.AddNode("resource-001", "A Tour of Go", "completed")
+	g.AddNode("skill-001", "Go", "learning")
+	g.AddNode("skill-002", "Rust", "not-started")
+
+	g.AddEdge("goal-001", "path-001")
+	g.AddEdge("path-001", "phase-001")
+	g.AddEdge("path-001", "phase-002")
+	g.AddEdge("phase-001", "resource-001")
+	g.AddEdge("skill-001", "resource-001")
+	return g
+}
+
+func TestGraphAdd(t *testing.T) {
+	g := testGraph()
+
+	g.AddNode("goal-001", "Duplicate", "")
+	g.AddEdge("goal-001", "path-001")
+	g.AddEdge("goal-001", "path-009")
+
+	assert.Len(t, g.Nodes, 7)
+	assert.Len(t, g.Edges, 5)
+	node, ok := g.Node("goal-001")
+	assert.True(t, ok)
+	assert.Equal(t, "Backend developer", node.Title)
+}
+
+func TestGraphChildrenAndRoots(t *testing.T) {
+	g := testGraph()
+
+	assert.Equal(t, []EntityID{"phase-001", "phase-002"}, g.Children("path-001"))
+	assert.Empty(t, g.Children("resource-001"))
+	assert.Equal(t, []EntityID{"goal-001", "skill-001", "skill-002"}, g.Roots())
+}
+
+func TestGraphNeighborhood(t *testing.T) {
+	g := testGraph()
+
+	t.Run("descendants of a goal", func(t *testing.T) {
+		n := g.Neighborhood("goal-001")
+		assert.Len(t, n.Nodes, 5)
+		assert.Equal(t, []EntityID{"goal-001"}, n.Roots())
+	})
+
+	t.Run("ancestors of a resource", func(t *testing.T) {
+		n := g.Neighborhood("resource-001")
+
+		var ids []EntityID
+		for _, node := range n.Nodes {
+			ids = append(ids, node.ID)
+		}
+		assert.Equal(t, []EntityID{"goal-001", "path-001", "phase-001", "resource-001", "skill-001"}, ids)
+		assert.Equal(t, []EntityID{"phase-001"}, n.Children("path-001"))
+	})
+
+	t.Run("unlinked entity", func(t *testing.T) {
+		n := g.Neighborhood("skill-002")
+		assert.Len(t, n.Nodes, 1)
+		assert.Empty(t, n.Edges)
+	})
+}
@@ -0,0 +1,49 @@
+package core
+
+import "time"
+
+// Change records a single transition of a key field (status, level, priority)
+type Change struct {
+	Timestamp time.Time `yaml:"timestamp"`
+	Field     string    `yaml:"field"`
+	From      string    `yaml:"from,omitempty"`
+	To        string    `yaml:"to"`
+}
+
+// History is an append-only audit trail of changes, stored in frontmatter so it
+// survives without git
+type History []Change
+
+// Record appends a change for field, ignoring no-op updates
+func (h *History) Record(field, from, to string) {
+	if from == to {
+		return
+	}
+	*h = append(*h, Change{
+		Timestamp: time.Now(),
+		Field:     field,
+		From:      from,
+		To:        to,
+	})
+}
+
+// ForField returns the changes recorded for a single field, oldest first
+func (h History) ForField(field string) History {
+	var changes History
+	for _, c := range h {
+		if c.Field == field {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// LastChangeTo returns when field was last changed to the given value
+func (h History) LastChangeTo(field, to string) (time.Time, bool) {
+	for i := len(h) - 1; i >= 0; i-- {
+		if h[i].Field == field && h[i].To == to {
+			return h[i].Timestamp, true
+		}
+	}
+	return time.Time{}, false
+}
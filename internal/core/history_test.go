@@ -0,0 +1,90 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory_Record(t *testing.T) {
+	var history History
+
+	history.Record("status", "active", "completed")
+	history.Record("status", "completed", "completed")
+	history.Record("priority", "low", "high")
+
+	require.Len(t, history, 2)
+	assert.Equal(t, "status", history[0].Field)
+	assert.Equal(t, "active", history[0].From)
+	assert.Equal(t, "completed", history[0].To)
+	assert.False(t, history[0].Timestamp.IsZero())
+
+	assert.Len(t, history.ForField("priority"), 1)
+	assert.Empty(t, history.ForField("level"))
+}
+
+func TestHistory_LastChangeTo(t *testing.T) {
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	history := History{
+		{Timestamp: first, Field: "status", From: "active", To: "completed"},
+		{Timestamp: first.AddDate(0, 0, 1), Field: "status", From: "completed", To: "active"},
+		{Timestamp: second, Field: "status", From: "active", To: "completed"},
+	}
+
+	at, ok := history.LastChangeTo("status", "completed")
+	assert.True(t, ok)
+	assert.Equal(t, second, at)
+
+	_, ok = history.LastChangeTo("level", "expert")
+	assert.False(t, ok)
+}
+
+func TestEntityHistory(t *testing.T) {
+	t.Run("goal records status and priority", func(t *testing.T) {
+		goal, _ := NewGoal("goal-001", "Become ML Engineer", PriorityMedium)
+
+		require.NoError(t, goal.UpdatePriority(PriorityHigh))
+		goal.Block("No mentor")
+		goal.Unblock()
+
+		assert.Len(t, goal.History.ForField("priority"), 1)
+		statuses := goal.History.ForField("status")
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "blocked", statuses[0].To)
+		assert.Equal(t, "active", statuses[1].To)
+	})
+
+	t.Run("skill records level and status", func(t *testing.T) {
+		skill, _ := NewSkill("skill-001", "Go", "programming", LevelBeginner)
+
+		require.NoError(t, skill.UpdateLevel(LevelIntermediate))
+		require.NoError(t, skill.UpdateStatus(SkillLearning))
+
+		require.Len(t, skill.History, 2)
+		assert.Equal(t, "level", skill.History[0].Field)
+		assert.Equal(t, "beginner", skill.History[0].From)
+	})
+
+	t.Run("resource records status transitions", func(t *testing.T) {
+		resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+
+		resource.Start()
+		resource.Start()
+		resource.Complete()
+
+		require.Len(t, resource.History, 2)
+		assert.Equal(t, "in-progress", resource.History[1].From)
+	})
+
+	t.Run("milestone records achievement", func(t *testing.T) {
+		milestone, _ := NewMilestone("milestone-001", "Ship it", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+
+		milestone.Achieve("")
+
+		require.Len(t, milestone.History, 1)
+		assert.Equal(t, "completed", milestone.History[0].To)
+	})
+}
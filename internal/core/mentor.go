@@ -0,0 +1,154 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MentorSession represents a one-to-one mentoring conversation, either as the
+// mentor or as the mentee
+type MentorSession struct {
+	ID          EntityID   `yaml:"id"`
+	Title       string     `yaml:"title"`
+	With        string     `yaml:"with"` // the other person
+	Role        MentorRole `yaml:"role"` // your role in the session
+	Date        time.Time  `yaml:"date"`
+	Hours       float64    `yaml:"hours,omitempty"`
+	Skills      []EntityID `yaml:"skills,omitempty"`      // skills discussed
+	ActionItems []EntityID `yaml:"actionItems,omitempty"` // milestones created from the session's action items
+	Timestamps
+
+	// Body contains the session notes
+	Body string `yaml:"-"`
+}
+
+// NewMentorSession creates a new MentorSession titled after the other person
+func NewMentorSession(id EntityID, with string, role MentorRole, date time.Time) (*MentorSession, error) {
+	with = strings.TrimSpace(with)
+	session := &MentorSession{
+		ID:          id,
+		Title:       MentorSessionTitle(with, role),
+		With:        with,
+		Role:        role,
+		Date:        date,
+		Skills:      []EntityID{},
+		ActionItems: []EntityID{},
+		Timestamps:  NewTimestamps(),
+	}
+
+	if err := session.Validate(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// MentorSessionTitle describes a session with a person from your role in it
+func MentorSessionTitle(with string, role MentorRole) string {
+	if role == RoleMentor {
+		return fmt.Sprintf("Mentoring %s", with)
+	}
+	return fmt.Sprintf("Mentored by %s", with)
+}
+
+func (m *MentorSession) Validate() error {
+	if m.ID == "" {
+		return errors.New("mentor session ID is required")
+	}
+
+	if strings.TrimSpace(m.With) == "" {
+		return errors.New("mentor session needs the person it was with")
+	}
+
+	if !m.Role.IsValid() {
+		return errors.New("invalid mentor role: must be one of: mentor, mentee")
+	}
+
+	if m.Date.IsZero() {
+		return errors.New("mentor session date is required")
+	}
+
+	if m.Hours < 0 {
+		return errors.New("mentor session hours cannot be negative")
+	}
+
+	if m.Created.IsZero() {
+		return errors.New("mentor session created timestamp is required")
+	}
+
+	if m.Updated.IsZero() {
+		return errors.New("mentor session updated timestamp is required")
+	}
+
+	return nil
+}
+
+// SetHours sets the length of the session
+func (m *MentorSession) SetHours(hours float64) error {
+	if hours < 0 {
+		return errors.New("mentor session hours cannot be negative")
+	}
+	m.Hours = hours
+	m.Touch()
+	return nil
+}
+
+// AddSkill links a skill discussed in the session
+func (m *MentorSession) AddSkill(skillID EntityID) {
+	for _, id := range m.Skills {
+		if id == skillID {
+			return
+		}
+	}
+	m.Skills = append(m.Skills, skillID)
+	m.Touch()
+}
+
+// AddActionItem links a milestone created from one of the session's action items
+func (m *MentorSession) AddActionItem(milestoneID EntityID) {
+	for _, id := range m.ActionItems {
+		if id == milestoneID {
+			return
+		}
+	}
+	m.ActionItems = append(m.ActionItems, milestoneID)
+	m.Touch()
+}
+
+// MentorStats summarizes mentoring sessions from both sides
+type MentorStats struct {
+	Sessions      int
+	HoursGiven    float64 // as the mentor
+	HoursReceived float64 // as the mentee
+	Mentees       int     // distinct people you mentored
+	Mentors       int     // distinct people who mentored you
+}
+
+// ComputeMentorStats summarizes sessions dated at or after since; a zero
+// since includes every session
+func ComputeMentorStats(sessions []*MentorSession, since time.Time) MentorStats {
+	var stats MentorStats
+	mentees := make(map[string]bool)
+	mentors := make(map[string]bool)
+
+	for _, session := range sessions {
+		if session.Date.Before(since) {
+			continue
+		}
+		stats.Sessions++
+		person := strings.ToLower(session.With)
+		if session.Role == RoleMentor {
+			stats.HoursGiven += session.Hours
+			mentees[person] = true
+		} else {
+			stats.HoursReceived += session.Hours
+			mentors[person] = true
+		}
+	}
+
+	stats.Mentees = len(mentees)
+	stats.Mentors = len(mentors)
+	return stats
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMentorSession(t *testing.T) {
+	date := time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates session as mentee", func(t *testing.T) {
+		session, err := NewMentorSession("mentor-001", " Alice ", RoleMentee, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", session.With)
+		assert.Equal(t, "Mentored by Alice", session.Title)
+		assert.Equal(t, date, session.Date)
+	})
+
+	t.Run("creates session as mentor", func(t *testing.T) {
+		session, err := NewMentorSession("mentor-002", "Bo", RoleMentor, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Mentoring Bo", session.Title)
+	})
+
+	t.Run("fails without a person", func(t *testing.T) {
+		_, err := NewMentorSession("mentor-001", " ", RoleMentee, date)
+		assert.ErrorContains(t, err, "person it was with")
+	})
+
+	t.Run("fails with invalid role", func(t *testing.T) {
+		_, err := NewMentorSession("mentor-001", "Alice", MentorRole("buddy"), date)
+		assert.ErrorContains(t, err, "invalid mentor role")
+	})
+
+	t.Run("fails with zero date", func(t *testing.T) {
+		_, err := NewMentorSession("mentor-001", "Alice", RoleMentee, time.Time{})
+		assert.ErrorContains(t, err, "date is required")
+	})
+}
+
+func TestMentorSession_Links(t *testing.T) {
+	session, _ := NewMentorSession("mentor-001", "Alice", RoleMentee, time.Now())
+
+	session.AddSkill("skill-003")
+	session.AddSkill("skill-003")
+	session.AddActionItem("milestone-004")
+	session.AddActionItem("milestone-004")
+
+	assert.Equal(t, []EntityID{"skill-003"}, session.Skills)
+	assert.Equal(t, []EntityID{"milestone-004"}, session.ActionItems)
+	assert.Error(t, session.SetHours(-1))
+}
+
+func TestComputeMentorStats(t *testing.T) {
+	newSession := func(with string, role MentorRole, month time.Month, hours float64) *MentorSession {
+		s, _ := NewMentorSession("mentor-001", with, role, time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC))
+		s.Hours = hours
+		return s
+	}
+	sessions := []*MentorSession{
+		newSession("Alice", RoleMentee, 1, 1),
+		newSession("alice", RoleMentee, 3, 0.5),
+		newSession("Bo", RoleMentor, 3, 1),
+		newSession("Cy", RoleMentor, 4, 0.75),
+	}
+
+	stats := ComputeMentorStats(sessions, time.Time{})
+	assert.Equal(t, MentorStats{Sessions: 4, HoursGiven: 1.75, HoursReceived: 1.5, Mentees: 2, Mentors: 1}, stats)
+
+	recent := ComputeMentorStats(sessions, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, MentorStats{Sessions: 1, HoursGiven: 0.75, Mentees: 1}, recent)
+}
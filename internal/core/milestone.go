@@ -8,15 +8,22 @@ import (
 
 // Milestone represents a significant achievement
 type Milestone struct {
-	ID            EntityID      `yaml:"id"`
-	Title         string        `yaml:"title"`
-	Type          MilestoneType `yaml:"type"`
-	ReferenceType ReferenceType `yaml:"referenceType"`
-	ReferenceID   EntityID      `yaml:"referenceId"`
-	Status        Status        `yaml:"status"` // pending or completed
-	AchievedDate  *time.Time    `yaml:"achievedDate,omitempty"`
-	TargetDate    *time.Time    `yaml:"targetDate,omitempty"`
-	Proof         string        `yaml:"proof,omitempty"` // URL to evidence
+	ID             EntityID       `yaml:"id"`
+	Title          string         `yaml:"title"`
+	Type           MilestoneType  `yaml:"type"`
+	ReferenceType  ReferenceType  `yaml:"referenceType"`
+	ReferenceID    EntityID       `yaml:"referenceId"`
+	Status         Status         `yaml:"status"` // pending or completed
+	AchievedDate   *time.Time     `yaml:"achievedDate,omitempty"`
+	TargetDate     *time.Time     `yaml:"targetDate,omitempty"`
+	Proof          string         `yaml:"proof,omitempty"` // URL to evidence
+	Recurrence     Recurrence     `yaml:"recurrence,omitempty"`
+	SeriesID       EntityID       `yaml:"seriesId,omitempty"` // ID of the first instance of a recurring milestone
+	PeriodStart    *time.Time     `yaml:"periodStart,omitempty"`
+	ReviewInterval ReviewInterval `yaml:"reviewInterval,omitempty"` // spaced review interval after mastering the referenced skill
+	Tickets        Tickets        `yaml:"tickets,omitempty"`        // work tickets that evidence the milestone
+	History        History        `yaml:"history,omitempty"`
+	Attachments    Attachments    `yaml:"attachments,omitempty"`
 	Timestamps
 
 	// Body contains the markdown content (definition of done, success metrics, importance, notes)
@@ -67,6 +74,14 @@ func (m *Milestone) Validate() error {
 		return errors.New("invalid milestone status: must be one of: active, completed, archived")
 	}
 
+	if m.Recurrence != "" && !m.Recurrence.IsValid() {
+		return errors.New("invalid milestone recurrence: must be one of: daily, weekly, monthly")
+	}
+
+	if m.ReviewInterval != "" && !m.ReviewInterval.IsValid() {
+		return errors.New("invalid milestone review interval: must be one of: 1w, 1m, 3m")
+	}
+
 	if m.Created.IsZero() {
 		return errors.New("milestone created timestamp is required")
 	}
@@ -80,6 +95,7 @@ func (m *Milestone) Validate() error {
 
 func (m *Milestone) Achieve(proof string) {
 	now := time.Now()
+	m.History.Record("status", string(m.Status), string(StatusCompleted))
 	m.Status = StatusCompleted
 	m.AchievedDate = &now
 	if proof != "" {
@@ -88,6 +104,21 @@ func (m *Milestone) Achieve(proof string) {
 	m.Touch()
 }
 
+// UpdateStatus changes the milestone status, recording the achieved date on completion
+func (m *Milestone) UpdateStatus(status Status) error {
+	if !status.IsValid() {
+		return errors.New("invalid milestone status: must be one of: active, completed, archived")
+	}
+	m.History.Record("status", string(m.Status), string(status))
+	m.Status = status
+	if status == StatusCompleted && m.AchievedDate == nil {
+		now := time.Now()
+		m.AchievedDate = &now
+	}
+	m.Touch()
+	return nil
+}
+
 // SetTargetDate sets when the milestone should be achieved
 func (m *Milestone) SetTargetDate(date time.Time) {
 	m.TargetDate = &date
@@ -109,3 +140,55 @@ func (m *Milestone) SetProof(proof string) {
 func (m *Milestone) IsAchieved() bool {
 	return m.Status == StatusCompleted && m.AchievedDate != nil
 }
+
+// SetRecurrence makes the milestone the first instance of a recurring series
+// covering the period that contains from.
+func (m *Milestone) SetRecurrence(recurrence Recurrence, from time.Time) error {
+	if !recurrence.IsValid() {
+		return errors.New("invalid recurrence: must be one of: daily, weekly, monthly")
+	}
+	start := recurrence.PeriodStart(from)
+	end := recurrence.PeriodEnd(from)
+	m.Recurrence = recurrence
+	m.SeriesID = m.ID
+	m.PeriodStart = &start
+	m.TargetDate = &end
+	m.Touch()
+	return nil
+}
+
+// IsRecurring returns true if the milestone is an instance of a recurring series
+func (m *Milestone) IsRecurring() bool {
+	return m.Recurrence != "" && m.SeriesID != ""
+}
+
+// NextInstance creates a fresh, pending instance of a recurring milestone for the period containing at
+func (m *Milestone) NextInstance(id EntityID, at time.Time) (*Milestone, error) {
+	if !m.IsRecurring() {
+		return nil, errors.New("milestone is not recurring")
+	}
+
+	start := m.Recurrence.PeriodStart(at)
+	end := m.Recurrence.PeriodEnd(at)
+
+	instance := &Milestone{
+		ID:            id,
+		Title:         m.Title,
+		Type:          m.Type,
+		ReferenceType: m.ReferenceType,
+		ReferenceID:   m.ReferenceID,
+		Status:        StatusActive,
+		TargetDate:    &end,
+		Recurrence:    m.Recurrence,
+		SeriesID:      m.SeriesID,
+		PeriodStart:   &start,
+		Timestamps:    NewTimestamps(),
+		Body:          m.Body,
+	}
+
+	if err := instance.Validate(); err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MoodStats summarizes the progress logs and weeks that share a mood
+type MoodStats struct {
+	Mood               string         `yaml:"mood" json:"mood"`
+	Logs               int            `yaml:"logs" json:"logs"`
+	Hours              float64        `yaml:"hours" json:"hours"`
+	Weeks              int            `yaml:"weeks" json:"weeks"`                               // weeks where this was the most logged mood
+	ResourcesCompleted int            `yaml:"resourcesCompleted" json:"resourcesCompleted"`     // completed during those weeks
+	Categories         map[string]int `yaml:"categories,omitempty" json:"categories,omitempty"` // skill categories worked, by log count
+}
+
+// AverageHours is the mean hours invested per log with this mood
+func (m MoodStats) AverageHours() float64 {
+	if m.Logs == 0 {
+		return 0
+	}
+	return m.Hours / float64(m.Logs)
+}
+
+// TopCategory returns the skill category most worked on with this mood
+func (m MoodStats) TopCategory() (string, int) {
+	best, count := "", 0
+	for category, n := range m.Categories {
+		if n > count || (n == count && category < best) {
+			best, count = category, n
+		}
+	}
+	return best, count
+}
+
+// MoodMonth is the mood mix of a calendar month
+type MoodMonth struct {
+	Month time.Time      `yaml:"month" json:"month"` // first day of the month
+	Moods map[string]int `yaml:"moods" json:"moods"` // log count per mood
+	Hours float64        `yaml:"hours" json:"hours"`
+}
+
+// MoodReport correlates logged moods with hours, completions and skill categories
+type MoodReport struct {
+	Moods    []MoodStats `yaml:"moods" json:"moods"`
+	Months   []MoodMonth `yaml:"months,omitempty" json:"months,omitempty"` // oldest first
+	Untagged int         `yaml:"untagged" json:"untagged"`                 // logs without a mood
+	Patterns []string    `yaml:"patterns,omitempty" json:"patterns,omitempty"`
+}
+
+// AnalyzeMood builds a mood report from progress logs. Categories come from
+// the skills worked and the skills of the resources used in each log.
+func AnalyzeMood(logs []*ProgressLog, resources []*Resource, skills []*Skill) MoodReport {
+	var report MoodReport
+
+	categoryOf := make(map[EntityID]string, len(skills))
+	for _, s := range skills {
+		categoryOf[s.ID] = s.Category
+	}
+	resourceSkill := make(map[EntityID]EntityID, len(resources))
+	for _, r := range resources {
+		resourceSkill[r.ID] = r.SkillID
+	}
+
+	byMood := make(map[string]*MoodStats)
+	byMonth := make(map[time.Time]*MoodMonth)
+	weekMoods := make(map[time.Time]map[string]int)
+	overallCategories := make(map[string]int)
+	var totalHours float64
+	var taggedLogs int
+
+	for _, log := range logs {
+		mood := strings.ToLower(strings.TrimSpace(log.Mood))
+		if mood == "" {
+			report.Untagged++
+			continue
+		}
+
+		stats, ok := byMood[mood]
+		if !ok {
+			stats = &MoodStats{Mood: mood, Categories: make(map[string]int)}
+			byMood[mood] = stats
+		}
+		stats.Logs++
+		stats.Hours += log.HoursInvested
+		totalHours += log.HoursInvested
+		taggedLogs++
+
+		categories := make(map[string]bool)
+		for _, id := range log.SkillsWorked {
+			if c := categoryOf[id]; c != "" {
+				categories[c] = true
+			}
+		}
+		for _, id := range log.ResourcesUsed {
+			if c := categoryOf[resourceSkill[id]]; c != "" {
+				categories[c] = true
+			}
+		}
+		for c := range categories {
+			stats.Categories[c]++
+			overallCategories[c]++
+		}
+
+		week := StartOfWeek(log.Date)
+		if weekMoods[week] == nil {
+			weekMoods[week] = make(map[string]int)
+		}
+		weekMoods[week][mood]++
+
+		month := time.Date(log.Date.Year(), log.Date.Month(), 1, 0, 0, 0, 0, log.Date.Location())
+		if byMonth[month] == nil {
+			byMonth[month] = &MoodMonth{Month: month, Moods: make(map[string]int)}
+		}
+		byMonth[month].Moods[mood]++
+		byMonth[month].Hours += log.HoursInvested
+	}
+
+	for _, month := range byMonth {
+		report.Months = append(report.Months, *month)
+	}
+	sort.Slice(report.Months, func(i, j int) bool { return report.Months[i].Month.Before(report.Months[j].Month) })
+
+	weekDominant := make(map[time.Time]string, len(weekMoods))
+	for week, counts := range weekMoods {
+		best, n := "", 0
+		for mood, count := range counts {
+			if count > n || (count == n && mood < best) {
+				best, n = mood, count
+			}
+		}
+		weekDominant[week] = best
+		byMood[best].Weeks++
+	}
+
+	for _, r := range resources {
+		if r.Status != ResourceCompleted {
+			continue
+		}
+		if mood, ok := weekDominant[StartOfWeek(r.CompletedAt())]; ok {
+			byMood[mood].ResourcesCompleted++
+		}
+	}
+
+	for _, stats := range byMood {
+		report.Moods = append(report.Moods, *stats)
+	}
+	sort.Slice(report.Moods, func(i, j int) bool {
+		if report.Moods[i].Logs != report.Moods[j].Logs {
+			return report.Moods[i].Logs > report.Moods[j].Logs
+		}
+		return report.Moods[i].Mood < report.Moods[j].Mood
+	})
+
+	report.Patterns = moodPatterns(report.Moods, overallCategories, taggedLogs, totalHours, len(weekMoods))
+
+	return report
+}
+
+// moodPatterns describes moods whose categories, hours or completions stand
+// out from the overall picture. Moods need at least three logs to count.
+func moodPatterns(moods []MoodStats, overallCategories map[string]int, logs int, hours float64, weeks int) []string {
+	const minLogs = 3
+	var patterns []string
+	if logs == 0 {
+		return nil
+	}
+	averageHours := hours / float64(logs)
+
+	totalCompletions := 0
+	for _, m := range moods {
+		totalCompletions += m.ResourcesCompleted
+	}
+
+	for _, m := range moods {
+		if m.Logs < minLogs || m.Logs == logs {
+			continue
+		}
+
+		if category, n := m.TopCategory(); n > 0 {
+			share := float64(n) / float64(m.Logs)
+			overall := float64(overallCategories[category]) / float64(logs)
+			if share >= 0.5 && share >= overall*1.5 {
+				patterns = append(patterns, fmt.Sprintf("%s logs cluster around %s work (%.0f%% vs %.0f%% overall)",
+					capitalize(m.Mood), category, share*100, overall*100))
+			}
+		}
+
+		if averageHours > 0 {
+			ratio := m.AverageHours() / averageHours
+			if ratio >= 1.25 || ratio <= 0.75 {
+				patterns = append(patterns, fmt.Sprintf("%s sessions average %.1fh vs %.1fh overall",
+					capitalize(m.Mood), m.AverageHours(), averageHours))
+			}
+		}
+
+		if m.Weeks > 0 && weeks > m.Weeks && totalCompletions > 0 {
+			rate := float64(m.ResourcesCompleted) / float64(m.Weeks)
+			overall := float64(totalCompletions) / float64(weeks)
+			if rate >= overall*1.5 {
+				patterns = append(patterns, fmt.Sprintf("%s weeks finish %.1f resources per week vs %.1f overall",
+					capitalize(m.Mood), rate, overall))
+			}
+		}
+	}
+
+	return patterns
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeMood(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) } // June 2 2025 is a Monday
+	logAt := func(d int, mood string, hours float64, skills ...EntityID) *ProgressLog {
+		return &ProgressLog{Date: day(d), Mood: mood, HoursInvested: hours, SkillsWorked: skills}
+	}
+
+	skills := []*Skill{
+		{ID: "skill-001", Title: "Kubernetes", Category: "devops"},
+		{ID: "skill-002", Title: "Go", Category: "backend"},
+	}
+	completed := &Resource{ID: "resource-001", SkillID: "skill-002", Status: ResourceCompleted,
+		History: History{{Timestamp: day(11), Field: "status", From: "in-progress", To: "completed"}}}
+
+	logs := []*ProgressLog{
+		logAt(2, "frustrated", 1, "skill-001"),
+		logAt(3, "frustrated", 1, "skill-001"),
+		logAt(4, "Frustrated", 1, "skill-001"),
+		logAt(9, "focused", 3, "skill-002"),
+		logAt(10, "focused", 3, "skill-002"),
+		logAt(11, "focused", 3, "skill-002"),
+		logAt(12, "", 2),
+	}
+
+	report := AnalyzeMood(logs, []*Resource{completed}, skills)
+
+	assert.Equal(t, 1, report.Untagged)
+	require.Len(t, report.Moods, 2)
+
+	focused := report.Moods[0]
+	assert.Equal(t, "focused", focused.Mood)
+	assert.Equal(t, 3, focused.Logs)
+	assert.Equal(t, 3.0, focused.AverageHours())
+	assert.Equal(t, 1, focused.Weeks)
+	assert.Equal(t, 1, focused.ResourcesCompleted)
+
+	frustrated := report.Moods[1]
+	assert.Equal(t, "frustrated", frustrated.Mood, "moods are case-insensitive")
+	category, n := frustrated.TopCategory()
+	assert.Equal(t, "devops", category)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, 0, frustrated.ResourcesCompleted)
+
+	require.Len(t, report.Months, 1)
+	assert.Equal(t, map[string]int{"focused": 3, "frustrated": 3}, report.Months[0].Moods)
+	assert.Equal(t, 12.0, report.Months[0].Hours)
+
+	assert.Contains(t, report.Patterns, "Frustrated logs cluster around devops work (100% vs 50% overall)")
+	assert.Contains(t, report.Patterns, "Frustrated sessions average 1.0h vs 2.0h overall")
+	assert.Contains(t, report.Patterns, "Focused weeks finish 1.0 resources per week vs 0.5 overall")
+}
+
+func TestAnalyzeMood_NoMoods(t *testing.T) {
+	report := AnalyzeMood([]*ProgressLog{{Date: time.Now()}}, nil, nil)
+
+	assert.Empty(t, report.Moods)
+	assert.Empty(t, report.Patterns)
+	assert.Equal(t, 1, report.Untagged)
+}
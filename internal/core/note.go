@@ -0,0 +1,115 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// noteTitleLength is the maximum length of a title derived from note text
+const noteTitleLength = 60
+
+// Note represents a quick, timestamped capture attached to other entities
+type Note struct {
+	ID         EntityID   `yaml:"id"`
+	Title      string     `yaml:"title"`
+	Date       time.Time  `yaml:"date"`
+	References []EntityID `yaml:"references,omitempty"` // e.g., skill-002, goal-001, resource-004
+	Tags       []string   `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains the full note text
+	Body string `yaml:"-"`
+}
+
+// NewNote creates a new Note from free-form text.
+// The title is derived from the first line of the text.
+func NewNote(id EntityID, text string, date time.Time) (*Note, error) {
+	note := &Note{
+		ID:         id,
+		Title:      noteTitle(text),
+		Date:       date,
+		References: []EntityID{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+		Body:       strings.TrimSpace(text),
+	}
+
+	if err := note.Validate(); err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (n *Note) Validate() error {
+	if n.ID == "" {
+		return errors.New("note ID is required")
+	}
+
+	if strings.TrimSpace(n.Title) == "" {
+		return errors.New("note text is required and cannot be empty")
+	}
+
+	if n.Date.IsZero() {
+		return errors.New("note date is required")
+	}
+
+	if n.Created.IsZero() {
+		return errors.New("note created timestamp is required")
+	}
+
+	if n.Updated.IsZero() {
+		return errors.New("note updated timestamp is required")
+	}
+
+	return nil
+}
+
+// AddReference attaches the note to another entity
+func (n *Note) AddReference(id EntityID) {
+	if n.HasReference(id) {
+		return
+	}
+	n.References = append(n.References, id)
+	n.Touch()
+}
+
+// HasReference returns true if the note is attached to the given entity
+func (n *Note) HasReference(id EntityID) bool {
+	for _, ref := range n.References {
+		if ref == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag to the note (normalized to lowercase)
+func (n *Note) AddTag(tag string) {
+	normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+	if normalizedTag == "" {
+		return
+	}
+
+	for _, t := range n.Tags {
+		if t == normalizedTag {
+			return
+		}
+	}
+	n.Tags = append(n.Tags, normalizedTag)
+	n.Touch()
+}
+
+func noteTitle(text string) string {
+	line := strings.TrimSpace(text)
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = strings.TrimSpace(line[:i])
+	}
+
+	runes := []rune(line)
+	if len(runes) > noteTitleLength {
+		return strings.TrimSpace(string(runes[:noteTitleLength])) + "..."
+	}
+	return line
+}
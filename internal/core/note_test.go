@@ -0,0 +1,81 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNote(t *testing.T) {
+	date := time.Date(2025, 3, 19, 14, 30, 0, 0, time.UTC)
+
+	t.Run("creates valid note", func(t *testing.T) {
+		note, err := NewNote("note-001", "TIL about epoll", date)
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("note-001"), note.ID)
+		assert.Equal(t, "TIL about epoll", note.Title)
+		assert.Equal(t, "TIL about epoll", note.Body)
+		assert.Equal(t, date, note.Date)
+		assert.Empty(t, note.References)
+	})
+
+	t.Run("derives title from first line", func(t *testing.T) {
+		note, err := NewNote("note-001", "Epoll vs kqueue\nBoth are readiness based.", date)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Epoll vs kqueue", note.Title)
+		assert.Contains(t, note.Body, "readiness based")
+	})
+
+	t.Run("truncates long titles", func(t *testing.T) {
+		note, err := NewNote("note-001", strings.Repeat("a", 100), date)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(note.Title, "..."))
+		assert.Len(t, note.Body, 100)
+	})
+
+	t.Run("fails with empty ID", func(t *testing.T) {
+		_, err := NewNote("", "TIL about epoll", date)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "ID is required")
+	})
+
+	t.Run("fails with empty text", func(t *testing.T) {
+		_, err := NewNote("note-001", "   ", date)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "text is required")
+	})
+
+	t.Run("fails with zero date", func(t *testing.T) {
+		_, err := NewNote("note-001", "TIL about epoll", time.Time{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "date is required")
+	})
+}
+
+func TestNote_AddReference(t *testing.T) {
+	note, _ := NewNote("note-001", "TIL about epoll", time.Now())
+
+	note.AddReference("skill-002")
+	note.AddReference("skill-002")
+	note.AddReference("resource-001")
+
+	assert.Len(t, note.References, 2)
+	assert.True(t, note.HasReference("skill-002"))
+	assert.False(t, note.HasReference("goal-001"))
+}
+
+func TestNote_AddTag(t *testing.T) {
+	note, _ := NewNote("note-001", "TIL about epoll", time.Now())
+
+	note.AddTag("Linux")
+	note.AddTag("linux")
+	note.AddTag(" ")
+
+	assert.Equal(t, []string{"linux"}, note.Tags)
+}
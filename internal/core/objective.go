@@ -0,0 +1,252 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var quarterPattern = regexp.MustCompile(`^\d{4}-Q[1-4]$`)
+
+// KeyResultMetric is how a key result's progress is measured from its goal
+type KeyResultMetric string
+
+const (
+	// MetricCompletion measures the share of the goal's milestones achieved,
+	// or full progress once the goal itself is completed
+	MetricCompletion KeyResultMetric = "completion"
+	// MetricMilestones counts achieved goal milestones against the target
+	MetricMilestones KeyResultMetric = "milestones"
+)
+
+func (m KeyResultMetric) IsValid() bool {
+	switch m {
+	case MetricCompletion, MetricMilestones:
+		return true
+	}
+	return false
+}
+
+// KeyResult links a goal to an objective with a measurable target
+type KeyResult struct {
+	GoalID      EntityID        `yaml:"goalId"`
+	Description string          `yaml:"description,omitempty"`
+	Metric      KeyResultMetric `yaml:"metric"`
+	Target      float64         `yaml:"target,omitempty"` // required for the milestones metric
+}
+
+// Objective groups goals as key results for a quarterly review cycle
+type Objective struct {
+	ID         EntityID    `yaml:"id"`
+	Title      string      `yaml:"title"`
+	Quarter    string      `yaml:"quarter"` // e.g. 2025-Q1
+	Status     Status      `yaml:"status"`
+	KeyResults []KeyResult `yaml:"keyResults,omitempty"`
+	Tags       []string    `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains the markdown content (why this objective matters, context)
+	Body string `yaml:"-"`
+}
+
+// NewObjective creates a new active Objective for a quarter
+func NewObjective(id EntityID, title, quarter string) (*Objective, error) {
+	objective := &Objective{
+		ID:         id,
+		Title:      title,
+		Quarter:    strings.ToUpper(strings.TrimSpace(quarter)),
+		Status:     StatusActive,
+		KeyResults: []KeyResult{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+	}
+
+	if err := objective.Validate(); err != nil {
+		return nil, err
+	}
+
+	return objective, nil
+}
+
+func (o *Objective) Validate() error {
+	if o.ID == "" {
+		return errors.New("objective ID is required")
+	}
+
+	if strings.TrimSpace(o.Title) == "" {
+		return errors.New("objective title is required and cannot be empty")
+	}
+
+	if !quarterPattern.MatchString(o.Quarter) {
+		return errors.New("invalid objective quarter: must look like 2025-Q1")
+	}
+
+	if !o.Status.IsValid() {
+		return errors.New("invalid objective status: must be one of: active, completed, archived")
+	}
+
+	for _, kr := range o.KeyResults {
+		if err := kr.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if o.Created.IsZero() {
+		return errors.New("objective created timestamp is required")
+	}
+
+	if o.Updated.IsZero() {
+		return errors.New("objective updated timestamp is required")
+	}
+
+	return nil
+}
+
+func (kr KeyResult) Validate() error {
+	if kr.GoalID == "" {
+		return errors.New("key result goal ID is required")
+	}
+
+	if !kr.Metric.IsValid() {
+		return errors.New("invalid key result metric: must be one of: completion, milestones")
+	}
+
+	if kr.Metric == MetricMilestones && kr.Target <= 0 {
+		return errors.New("key result target must be greater than 0 for the milestones metric")
+	}
+
+	if kr.Target < 0 {
+		return errors.New("key result target cannot be negative")
+	}
+
+	return nil
+}
+
+// AddKeyResult links a goal as a key result; each goal can be linked once
+func (o *Objective) AddKeyResult(kr KeyResult) error {
+	if err := kr.Validate(); err != nil {
+		return err
+	}
+
+	for _, existing := range o.KeyResults {
+		if existing.GoalID == kr.GoalID {
+			return fmt.Errorf("goal %s is already a key result of this objective", kr.GoalID)
+		}
+	}
+
+	o.KeyResults = append(o.KeyResults, kr)
+	o.Touch()
+	return nil
+}
+
+// RemoveKeyResult unlinks a goal, returning false if it was not linked
+func (o *Objective) RemoveKeyResult(goalID EntityID) bool {
+	for i, kr := range o.KeyResults {
+		if kr.GoalID == goalID {
+			o.KeyResults = append(o.KeyResults[:i], o.KeyResults[i+1:]...)
+			o.Touch()
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateStatus changes the objective's status
+func (o *Objective) UpdateStatus(status Status) error {
+	if !status.IsValid() {
+		return errors.New("invalid status")
+	}
+	o.Status = status
+	o.Touch()
+	return nil
+}
+
+// AddTag adds a tag to the objective
+func (o *Objective) AddTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return
+	}
+
+	for _, t := range o.Tags {
+		if t == tag {
+			return
+		}
+	}
+	o.Tags = append(o.Tags, tag)
+	o.Touch()
+}
+
+// KeyResultProgress computes how far a key result is, from 0 to 1, using its
+// goal and the milestones attached to that goal
+func KeyResultProgress(kr KeyResult, goal *Goal, milestones []*Milestone) float64 {
+	if goal == nil {
+		return 0
+	}
+
+	switch kr.Metric {
+	case MetricMilestones:
+		achieved, _ := GoalMilestoneCounts(goal.ID, milestones)
+		return clampProgress(float64(achieved) / kr.Target)
+	default:
+		return GoalProgress(goal, milestones)
+	}
+}
+
+// GoalProgress computes how far a goal is, from 0 to 1: complete once the goal
+// is completed, otherwise the share of its milestones achieved
+func GoalProgress(goal *Goal, milestones []*Milestone) float64 {
+	if goal.Status == StatusCompleted {
+		return 1
+	}
+	achieved, total := GoalMilestoneCounts(goal.ID, milestones)
+	if total == 0 {
+		return 0
+	}
+	return clampProgress(float64(achieved) / float64(total))
+}
+
+// GoalMilestoneCounts counts the milestones attached to a goal and how many
+// of them are achieved
+func GoalMilestoneCounts(goalID EntityID, milestones []*Milestone) (achieved, total int) {
+	for _, m := range milestones {
+		if m.ReferenceType != ReferenceGoal || m.ReferenceID != goalID {
+			continue
+		}
+		total++
+		if m.IsAchieved() {
+			achieved++
+		}
+	}
+	return achieved, total
+}
+
+// ObjectiveProgress averages the progress of an objective's key results
+func ObjectiveProgress(o *Objective, goals map[EntityID]*Goal, milestones []*Milestone) float64 {
+	if len(o.KeyResults) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, kr := range o.KeyResults {
+		sum += KeyResultProgress(kr, goals[kr.GoalID], milestones)
+	}
+	return sum / float64(len(o.KeyResults))
+}
+
+// QuarterOf returns the quarter containing t, e.g. 2025-Q1
+func QuarterOf(t time.Time) string {
+	return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())-1)/3+1)
+}
+
+func clampProgress(p float64) float64 {
+	if p > 1 {
+		return 1
+	}
+	if p < 0 {
+		return 0
+	}
+	return p
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObjective(t *testing.T) {
+	t.Run("creates valid objective", func(t *testing.T) {
+		o, err := NewObjective("objective-001", "Grow as a backend engineer", "2025-q1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "2025-Q1", o.Quarter)
+		assert.Equal(t, StatusActive, o.Status)
+		assert.Empty(t, o.KeyResults)
+	})
+
+	t.Run("fails with empty title", func(t *testing.T) {
+		_, err := NewObjective("objective-001", " ", "2025-Q1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "title is required")
+	})
+
+	t.Run("fails with invalid quarter", func(t *testing.T) {
+		_, err := NewObjective("objective-001", "Grow", "2025-Q5")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "quarter")
+	})
+}
+
+func TestObjective_KeyResults(t *testing.T) {
+	o, _ := NewObjective("objective-001", "Grow", "2025-Q1")
+
+	t.Run("adds key result", func(t *testing.T) {
+		err := o.AddKeyResult(KeyResult{GoalID: "goal-001", Metric: MetricCompletion})
+
+		require.NoError(t, err)
+		assert.Len(t, o.KeyResults, 1)
+	})
+
+	t.Run("rejects duplicate goal", func(t *testing.T) {
+		err := o.AddKeyResult(KeyResult{GoalID: "goal-001", Metric: MetricMilestones, Target: 2})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already a key result")
+	})
+
+	t.Run("requires target for milestones metric", func(t *testing.T) {
+		err := o.AddKeyResult(KeyResult{GoalID: "goal-002", Metric: MetricMilestones})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "target must be greater than 0")
+	})
+
+	t.Run("removes key result", func(t *testing.T) {
+		assert.True(t, o.RemoveKeyResult("goal-001"))
+		assert.False(t, o.RemoveKeyResult("goal-001"))
+		assert.Empty(t, o.KeyResults)
+	})
+}
+
+func TestKeyResultProgress(t *testing.T) {
+	goal, _ := NewGoal("goal-001", "Ship a service", PriorityHigh)
+
+	achieved, _ := NewMilestone("milestone-001", "Design", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	achieved.Achieve("")
+	pending, _ := NewMilestone("milestone-002", "Launch", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	other, _ := NewMilestone("milestone-003", "Other", MilestoneGoalLevel, ReferenceGoal, "goal-002")
+	milestones := []*Milestone{achieved, pending, other}
+
+	t.Run("completion uses milestone share", func(t *testing.T) {
+		p := KeyResultProgress(KeyResult{GoalID: "goal-001", Metric: MetricCompletion}, goal, milestones)
+		assert.Equal(t, 0.5, p)
+	})
+
+	t.Run("milestones metric uses target", func(t *testing.T) {
+		p := KeyResultProgress(KeyResult{GoalID: "goal-001", Metric: MetricMilestones, Target: 4}, goal, milestones)
+		assert.Equal(t, 0.25, p)
+
+		p = KeyResultProgress(KeyResult{GoalID: "goal-001", Metric: MetricMilestones, Target: 1}, goal, milestones)
+		assert.Equal(t, 1.0, p)
+	})
+
+	t.Run("completed goal is fully done", func(t *testing.T) {
+		done, _ := NewGoal("goal-003", "Done", PriorityLow)
+		done.UpdateStatus(StatusCompleted)
+
+		p := KeyResultProgress(KeyResult{GoalID: "goal-003", Metric: MetricCompletion}, done, nil)
+		assert.Equal(t, 1.0, p)
+	})
+
+	t.Run("counts only the goal's milestones", func(t *testing.T) {
+		achievedCount, total := GoalMilestoneCounts("goal-001", milestones)
+
+		assert.Equal(t, 1, achievedCount)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, 0.5, GoalProgress(goal, milestones))
+	})
+
+	t.Run("missing goal has no progress", func(t *testing.T) {
+		assert.Equal(t, 0.0, KeyResultProgress(KeyResult{GoalID: "goal-404", Metric: MetricCompletion}, nil, milestones))
+	})
+
+	t.Run("objective averages key results", func(t *testing.T) {
+		o, _ := NewObjective("objective-001", "Grow", "2025-Q1")
+		o.AddKeyResult(KeyResult{GoalID: "goal-001", Metric: MetricCompletion})
+		o.AddKeyResult(KeyResult{GoalID: "goal-404", Metric: MetricCompletion})
+
+		p := ObjectiveProgress(o, map[EntityID]*Goal{"goal-001": goal}, milestones)
+		assert.Equal(t, 0.25, p)
+	})
+}
+
+func TestQuarterOf(t *testing.T) {
+	assert.Equal(t, "2025-Q1", QuarterOf(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2025-Q2", QuarterOf(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2025-Q4", QuarterOf(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Output represents something produced and shared with others, such as a
+// conference talk, a blog post or an open source contribution
+type Output struct {
+	ID     EntityID   `yaml:"id"`
+	Title  string     `yaml:"title"`
+	Type   OutputType `yaml:"type"`
+	Date   time.Time  `yaml:"date"`
+	URL    string     `yaml:"url,omitempty"`   // recording, post or pull request
+	Venue  string     `yaml:"venue,omitempty"` // conference, publication or repository
+	Skills []EntityID `yaml:"skills,omitempty"`
+	Goals  []EntityID `yaml:"goals,omitempty"`
+	Tags   []string   `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains notes (abstract, feedback, follow-ups)
+	Body string `yaml:"-"`
+}
+
+// NewOutput creates a new Output
+func NewOutput(id EntityID, title string, outputType OutputType, date time.Time) (*Output, error) {
+	output := &Output{
+		ID:         id,
+		Title:      title,
+		Type:       outputType,
+		Date:       date,
+		Skills:     []EntityID{},
+		Goals:      []EntityID{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+	}
+
+	if err := output.Validate(); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (o *Output) Validate() error {
+	if o.ID == "" {
+		return errors.New("output ID is required")
+	}
+
+	if strings.TrimSpace(o.Title) == "" {
+		return errors.New("output title is required and cannot be empty")
+	}
+
+	if !o.Type.IsValid() {
+		return errors.New("invalid output type: must be one of: talk, blog-post, oss-contribution")
+	}
+
+	if o.Date.IsZero() {
+		return errors.New("output date is required")
+	}
+
+	if o.Created.IsZero() {
+		return errors.New("output created timestamp is required")
+	}
+
+	if o.Updated.IsZero() {
+		return errors.New("output updated timestamp is required")
+	}
+
+	return nil
+}
+
+// AddSkill links a skill the output draws on
+func (o *Output) AddSkill(skillID EntityID) {
+	for _, id := range o.Skills {
+		if id == skillID {
+			return
+		}
+	}
+	o.Skills = append(o.Skills, skillID)
+	o.Touch()
+}
+
+// AddGoal links a goal the output contributes to
+func (o *Output) AddGoal(goalID EntityID) {
+	for _, id := range o.Goals {
+		if id == goalID {
+			return
+		}
+	}
+	o.Goals = append(o.Goals, goalID)
+	o.Touch()
+}
+
+// HasReference returns true if the output is linked to the given skill or goal
+func (o *Output) HasReference(id EntityID) bool {
+	for _, ref := range o.Skills {
+		if ref == id {
+			return true
+		}
+	}
+	for _, ref := range o.Goals {
+		if ref == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag to the output (normalized to lowercase)
+func (o *Output) AddTag(tag string) {
+	normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+	if normalizedTag == "" {
+		return
+	}
+
+	for _, t := range o.Tags {
+		if t == normalizedTag {
+			return
+		}
+	}
+	o.Tags = append(o.Tags, normalizedTag)
+	o.Touch()
+}
+
+// OutputStats counts outputs by type
+type OutputStats struct {
+	Talks         int
+	BlogPosts     int
+	Contributions int
+}
+
+// Total returns the number of outputs of every type
+func (s OutputStats) Total() int {
+	return s.Talks + s.BlogPosts + s.Contributions
+}
+
+// ComputeOutputStats counts outputs dated at or after since; a zero since
+// includes every output
+func ComputeOutputStats(outputs []*Output, since time.Time) OutputStats {
+	var stats OutputStats
+	for _, output := range outputs {
+		if output.Date.Before(since) {
+			continue
+		}
+		switch output.Type {
+		case OutputTalk:
+			stats.Talks++
+		case OutputBlogPost:
+			stats.BlogPosts++
+		case OutputContribution:
+			stats.Contributions++
+		}
+	}
+	return stats
+}
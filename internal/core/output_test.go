@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOutput(t *testing.T) {
+	date := time.Date(2025, 6, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates valid output", func(t *testing.T) {
+		output, err := NewOutput("output-001", "Profiling Go services", OutputTalk, date)
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("output-001"), output.ID)
+		assert.Equal(t, OutputTalk, output.Type)
+		assert.Equal(t, date, output.Date)
+		assert.Empty(t, output.Skills)
+	})
+
+	t.Run("fails with empty ID", func(t *testing.T) {
+		_, err := NewOutput("", "Profiling Go services", OutputTalk, date)
+		assert.ErrorContains(t, err, "ID is required")
+	})
+
+	t.Run("fails with empty title", func(t *testing.T) {
+		_, err := NewOutput("output-001", " ", OutputTalk, date)
+		assert.ErrorContains(t, err, "title is required")
+	})
+
+	t.Run("fails with invalid type", func(t *testing.T) {
+		_, err := NewOutput("output-001", "Profiling Go services", OutputType("podcast"), date)
+		assert.ErrorContains(t, err, "invalid output type")
+	})
+
+	t.Run("fails with zero date", func(t *testing.T) {
+		_, err := NewOutput("output-001", "Profiling Go services", OutputTalk, time.Time{})
+		assert.ErrorContains(t, err, "date is required")
+	})
+}
+
+func TestOutput_References(t *testing.T) {
+	output, _ := NewOutput("output-001", "Fix race in scheduler", OutputContribution, time.Now())
+
+	output.AddSkill("skill-001")
+	output.AddSkill("skill-001")
+	output.AddGoal("goal-002")
+	output.AddTag(" Go ")
+
+	assert.Equal(t, []EntityID{"skill-001"}, output.Skills)
+	assert.Equal(t, []EntityID{"goal-002"}, output.Goals)
+	assert.Equal(t, []string{"go"}, output.Tags)
+	assert.True(t, output.HasReference("skill-001"))
+	assert.True(t, output.HasReference("goal-002"))
+	assert.False(t, output.HasReference("skill-002"))
+}
+
+func TestComputeOutputStats(t *testing.T) {
+	newOutput := func(outputType OutputType, month time.Month) *Output {
+		o, _ := NewOutput("output-001", "Output", outputType, time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC))
+		return o
+	}
+	outputs := []*Output{
+		newOutput(OutputTalk, 1),
+		newOutput(OutputBlogPost, 2),
+		newOutput(OutputBlogPost, 5),
+		newOutput(OutputContribution, 6),
+	}
+
+	stats := ComputeOutputStats(outputs, time.Time{})
+	assert.Equal(t, OutputStats{Talks: 1, BlogPosts: 2, Contributions: 1}, stats)
+	assert.Equal(t, 4, stats.Total())
+
+	recent := ComputeOutputStats(outputs, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, OutputStats{BlogPosts: 1, Contributions: 1}, recent)
+}
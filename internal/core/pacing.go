@@ -0,0 +1,136 @@
+package core
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// energyRank orders energy levels; unset levels rank as medium
+func energyRank(e EnergyLevel) int {
+	switch e {
+	case EnergyLow:
+		return 1
+	case EnergyHigh:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// DefaultEnergy returns the energy a resource type usually needs: videos and
+// articles are light, projects are demanding
+func DefaultEnergy(t ResourceType) EnergyLevel {
+	switch t {
+	case ResourceVideo, ResourceArticle:
+		return EnergyLow
+	case ResourceProject:
+		return EnergyHigh
+	default:
+		return EnergyMedium
+	}
+}
+
+// ResourceEnergy returns the energy a resource needs: its own rating, else the
+// rating of its phase, else the default for its type
+func ResourceEnergy(r *Resource, phaseEnergy EnergyLevel) EnergyLevel {
+	if r.Energy != "" {
+		return r.Energy
+	}
+	if phaseEnergy != "" {
+		return phaseEnergy
+	}
+	return DefaultEnergy(r.Type)
+}
+
+var moodEnergy = map[string]EnergyLevel{
+	"tired":       EnergyLow,
+	"exhausted":   EnergyLow,
+	"drained":     EnergyLow,
+	"stressed":    EnergyLow,
+	"frustrated":  EnergyLow,
+	"overwhelmed": EnergyLow,
+	"sick":        EnergyLow,
+	"sleepy":      EnergyLow,
+	"burned out":  EnergyLow,
+	"burnt out":   EnergyLow,
+	"motivated":   EnergyHigh,
+	"energized":   EnergyHigh,
+	"energetic":   EnergyHigh,
+	"excited":     EnergyHigh,
+	"inspired":    EnergyHigh,
+	"focused":     EnergyHigh,
+	"productive":  EnergyHigh,
+}
+
+// MoodEnergy guesses the energy level behind a logged mood. Unrecognized moods
+// count as medium; an empty mood returns ""
+func MoodEnergy(mood string) EnergyLevel {
+	mood = strings.ToLower(strings.TrimSpace(mood))
+	if energy, ok := moodEnergy[mood]; ok {
+		return energy
+	}
+	if mood != "" {
+		return EnergyMedium
+	}
+	return ""
+}
+
+// WeeklyEnergy averages the energy reported in the progress logs of the week
+// containing now, using the logged energy or else the energy behind the mood.
+// It returns "" and 0 when nothing was reported.
+func WeeklyEnergy(logs []*ProgressLog, now time.Time) (EnergyLevel, int) {
+	start := StartOfWeek(now)
+	end := start.AddDate(0, 0, 7)
+
+	total, count := 0, 0
+	for _, log := range logs {
+		if log.Date.Before(start) || !log.Date.Before(end) {
+			continue
+		}
+		energy := log.Energy
+		if energy == "" {
+			energy = MoodEnergy(log.Mood)
+		}
+		if energy == "" {
+			continue
+		}
+		total += energyRank(energy)
+		count++
+	}
+
+	if count == 0 {
+		return "", 0
+	}
+
+	switch average := float64(total) / float64(count); {
+	case average < 1.5:
+		return EnergyLow, count
+	case average > 2.5:
+		return EnergyHigh, count
+	default:
+		return EnergyMedium, count
+	}
+}
+
+// PaceResources orders resources by how well the energy they need fits the
+// energy available, keeping the given order among equally good fits. With
+// no reported energy, medium is assumed.
+func PaceResources(resources []*Resource, energyOf func(*Resource) EnergyLevel, available EnergyLevel) []*Resource {
+	paced := make([]*Resource, len(resources))
+	copy(paced, resources)
+
+	target := energyRank(available)
+	distance := func(r *Resource) int {
+		d := energyRank(energyOf(r)) - target
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+
+	sort.SliceStable(paced, func(i, j int) bool {
+		return distance(paced[i]) < distance(paced[j])
+	})
+	return paced
+}
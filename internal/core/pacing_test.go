@@ -0,0 +1,77 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceEnergy(t *testing.T) {
+	video, _ := NewResource("resource-001", "Talk", ResourceVideo, "skill-001")
+	project, _ := NewResource("resource-002", "Build a CLI", ResourceProject, "skill-001")
+	book, _ := NewResource("resource-003", "Book", ResourceBook, "skill-001")
+	book.Energy = EnergyHigh
+
+	assert.Equal(t, EnergyLow, ResourceEnergy(video, ""))
+	assert.Equal(t, EnergyHigh, ResourceEnergy(project, ""))
+	assert.Equal(t, EnergyMedium, ResourceEnergy(project, EnergyMedium))
+	assert.Equal(t, EnergyHigh, ResourceEnergy(book, EnergyLow))
+}
+
+func TestMoodEnergy(t *testing.T) {
+	assert.Equal(t, EnergyLow, MoodEnergy(" Tired "))
+	assert.Equal(t, EnergyHigh, MoodEnergy("motivated"))
+	assert.Equal(t, EnergyMedium, MoodEnergy("curious"))
+	assert.Equal(t, EnergyLevel(""), MoodEnergy(""))
+}
+
+func TestWeeklyEnergy(t *testing.T) {
+	now := time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC) // Thursday
+	newLog := func(day int, mood string, energy EnergyLevel) *ProgressLog {
+		log, _ := NewProgressLog("progress-001", time.Date(2025, 7, day, 0, 0, 0, 0, time.UTC))
+		log.Mood = mood
+		log.Energy = energy
+		return log
+	}
+
+	t.Run("averages moods and energy of this week", func(t *testing.T) {
+		logs := []*ProgressLog{
+			newLog(4, "motivated", ""), // last week
+			newLog(7, "tired", ""),
+			newLog(8, "frustrated", ""),
+			newLog(9, "motivated", EnergyLow),
+			newLog(10, "", ""),
+		}
+
+		energy, count := WeeklyEnergy(logs, now)
+		assert.Equal(t, EnergyLow, energy)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("mixed moods average to medium", func(t *testing.T) {
+		energy, _ := WeeklyEnergy([]*ProgressLog{newLog(7, "tired", ""), newLog(8, "excited", "")}, now)
+		assert.Equal(t, EnergyMedium, energy)
+	})
+
+	t.Run("nothing reported", func(t *testing.T) {
+		energy, count := WeeklyEnergy([]*ProgressLog{newLog(4, "tired", "")}, now)
+		assert.Equal(t, EnergyLevel(""), energy)
+		assert.Zero(t, count)
+	})
+}
+
+func TestPaceResources(t *testing.T) {
+	video, _ := NewResource("resource-001", "Talk", ResourceVideo, "skill-001")
+	book, _ := NewResource("resource-002", "Book", ResourceBook, "skill-001")
+	project, _ := NewResource("resource-003", "Build a CLI", ResourceProject, "skill-001")
+	article, _ := NewResource("resource-004", "Post", ResourceArticle, "skill-001")
+	queue := []*Resource{project, book, video, article}
+
+	energyOf := func(r *Resource) EnergyLevel { return ResourceEnergy(r, "") }
+
+	assert.Equal(t, []*Resource{video, article, book, project}, PaceResources(queue, energyOf, EnergyLow))
+	assert.Equal(t, []*Resource{project, book, video, article}, PaceResources(queue, energyOf, EnergyHigh))
+	assert.Equal(t, []*Resource{book, project, video, article}, PaceResources(queue, energyOf, ""))
+	assert.Equal(t, project, queue[0], "input order is kept")
+}
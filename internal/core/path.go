@@ -3,23 +3,40 @@ package core
 import (
 	"errors"
 	"strings"
+	"time"
 )
 
 // LearningPath represents a structured plan for achieving a goal
 type LearningPath struct {
-	ID                EntityID   `yaml:"id"`
-	Title             string     `yaml:"title"`
-	Type              PathType   `yaml:"type"`
-	Status            Status     `yaml:"status"`
-	GeneratedBy       string     `yaml:"generatedBy,omitempty"`
-	GenerationContext string     `yaml:"generationContext,omitempty"`
-	Phases            []EntityID `yaml:"phases,omitempty"`
-	Tags              []string   `yaml:"tags,omitempty"`
+	ID                EntityID    `yaml:"id"`
+	Title             string      `yaml:"title"`
+	Type              PathType    `yaml:"type"`
+	Status            Status      `yaml:"status"`
+	GeneratedBy       string      `yaml:"generatedBy,omitempty"`
+	GenerationContext string      `yaml:"generationContext,omitempty"`
+	Provenance        *Provenance `yaml:"provenance,omitempty"`
+	HoursPerWeek      float64     `yaml:"hoursPerWeek,omitempty"`
+	SkillID           EntityID    `yaml:"skillId,omitempty"` // set on plans scoped to one skill instead of a goal
+	AbandonReason     string      `yaml:"abandonReason,omitempty"`
+	Phases            []EntityID  `yaml:"phases,omitempty"`
+	Tags              []string    `yaml:"tags,omitempty"`
+	History           History     `yaml:"history,omitempty"`
+	Attachments       Attachments `yaml:"attachments,omitempty"`
 	Timestamps
 
 	Body string `yaml:"-"`
 }
 
+// Provenance records how an AI-generated path was produced, so the
+// generation can be audited and reproduced later
+type Provenance struct {
+	Provider    string    `yaml:"provider"`
+	Model       string    `yaml:"model"`
+	Temperature float32   `yaml:"temperature"`
+	PromptHash  string    `yaml:"promptHash"`  // sha256 of the rendered prompt
+	RespondedAt time.Time `yaml:"respondedAt"` // when the provider's response arrived
+}
+
 func NewLearningPath(id EntityID, title string, pathType PathType) (*LearningPath, error) {
 	path := &LearningPath{
 		ID:         id,
@@ -51,8 +68,12 @@ func (p *LearningPath) Validate() error {
 		return errors.New("invalid path type: must be one of: manual, ai-generated")
 	}
 
-	if !p.Status.IsValid() {
-		return errors.New("invalid path status: must be one of: active, completed, archived")
+	if !p.Status.IsValidForPath() {
+		return errors.New("invalid path status: must be one of: active, completed, archived, abandoned")
+	}
+
+	if p.HoursPerWeek < 0 {
+		return errors.New("path hours per week cannot be negative")
 	}
 
 	if p.Created.IsZero() {
@@ -102,16 +123,38 @@ func (p *LearningPath) AddTag(tag string) {
 }
 
 func (p *LearningPath) UpdateStatus(status Status) error {
-	if !status.IsValid() {
-		return errors.New("invalid path status: must be one of: active, completed, archived")
+	if !status.IsValidForPath() {
+		return errors.New("invalid path status: must be one of: active, completed, archived, abandoned")
 	}
+	if status != StatusAbandoned {
+		p.AbandonReason = ""
+	}
+	p.History.Record("status", string(p.Status), string(status))
 	p.Status = status
 	p.Touch()
 	return nil
 }
 
+// Abandon marks the path as dropped, with an optional reason
+func (p *LearningPath) Abandon(reason string) {
+	p.History.Record("status", string(p.Status), string(StatusAbandoned))
+	p.Status = StatusAbandoned
+	p.AbandonReason = strings.TrimSpace(reason)
+	p.Touch()
+}
+
 func (p *LearningPath) SetGenerationInfo(model, context string) {
 	p.GeneratedBy = model
 	p.GenerationContext = context
 	p.Touch()
 }
+
+// SetHoursPerWeek sets the weekly time commitment used for schedule projections
+func (p *LearningPath) SetHoursPerWeek(hours float64) error {
+	if hours < 0 {
+		return errors.New("hours per week cannot be negative (must be >= 0)")
+	}
+	p.HoursPerWeek = hours
+	p.Touch()
+	return nil
+}
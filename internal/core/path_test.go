@@ -165,3 +165,23 @@ func TestLearningPath_UpdateStatus(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestLearningPath_Abandon(t *testing.T) {
+	path, _ := NewLearningPath("path-001", "ML Engineer Track", PathTypeAIGenerated)
+
+	path.Abandon("  switched to a different role  ")
+
+	assert.Equal(t, StatusAbandoned, path.Status)
+	assert.Equal(t, "switched to a different role", path.AbandonReason)
+	assert.NoError(t, path.Validate())
+
+	t.Run("reactivating clears the reason", func(t *testing.T) {
+		require.NoError(t, path.UpdateStatus(StatusActive))
+		assert.Empty(t, path.AbandonReason)
+	})
+
+	t.Run("abandoned is not a valid goal status", func(t *testing.T) {
+		assert.False(t, StatusAbandoned.IsValid())
+		assert.True(t, StatusAbandoned.IsValidForPath())
+	})
+}
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var periodPattern = regexp.MustCompile(`^(\d{4})(?:-(H[12]|Q[1-4]))?$`)
+
+// ParsePeriod resolves a review period such as 2025, 2025-H1 or 2025-Q3 into
+// its start (inclusive) and end (exclusive) in loc
+func ParsePeriod(period string, loc *time.Location) (time.Time, time.Time, error) {
+	match := periodPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(period)))
+	if match == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid period '%s' (use YYYY, YYYY-H1/H2 or YYYY-Q1..Q4)", period)
+	}
+
+	year, _ := strconv.Atoi(match[1])
+	startMonth, months := time.January, 12
+
+	switch part := match[2]; {
+	case part == "":
+	case part[0] == 'H':
+		startMonth, months = time.Month(1+6*int(part[1]-'1')), 6
+	default:
+		startMonth, months = time.Month(1+3*int(part[1]-'1')), 3
+	}
+
+	start := time.Date(year, startMonth, 1, 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, months, 0), nil
+}
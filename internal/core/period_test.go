@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		period string
+		start  time.Time
+		end    time.Time
+	}{
+		{"2025", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-H1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-h2", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-Q3", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			start, end, err := ParsePeriod(tt.period, time.UTC)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+
+	t.Run("rejects invalid periods", func(t *testing.T) {
+		for _, period := range []string{"", "25-H1", "2025-H3", "2025-Q5", "H1-2025"} {
+			_, _, err := ParsePeriod(period, time.UTC)
+			assert.Error(t, err, period)
+		}
+	})
+}
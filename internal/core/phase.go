@@ -2,7 +2,9 @@ package core
 
 import (
 	"errors"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // SkillRequirement defines a skill needed for a phase with target level
@@ -18,8 +20,13 @@ type Phase struct {
 	Title             string             `yaml:"title"`
 	Order             int                `yaml:"order"`
 	EstimatedDuration string             `yaml:"estimatedDuration,omitempty"` // e.g., "2 months"
+	Energy            EnergyLevel        `yaml:"energy,omitempty"`            // difficulty: low, medium or high energy needed
 	RequiredSkills    []SkillRequirement `yaml:"requiredSkills,omitempty"`
 	Milestones        []EntityID         `yaml:"milestones,omitempty"`
+	Resources         []EntityID         `yaml:"resources,omitempty"`
+	StartDate         *time.Time         `yaml:"startDate,omitempty"`
+	EndDate           *time.Time         `yaml:"endDate,omitempty"`
+	Schedule          *Assignment        `yaml:"schedule,omitempty"` // weeks planned by a cohort schedule
 	Timestamps
 
 	// Body contains the markdown content (goal, projects, timeline)
@@ -35,6 +42,7 @@ func NewPhase(id, pathID EntityID, title string, order int) (*Phase, error) {
 		Order:          order,
 		RequiredSkills: []SkillRequirement{},
 		Milestones:     []EntityID{},
+		Resources:      []EntityID{},
 		Timestamps:     NewTimestamps(),
 	}
 
@@ -72,6 +80,14 @@ func (p *Phase) Validate() error {
 		}
 	}
 
+	if p.StartDate != nil && p.EndDate != nil && p.EndDate.Before(*p.StartDate) {
+		return errors.New("phase end date cannot be before start date")
+	}
+
+	if p.Energy != "" && !p.Energy.IsValid() {
+		return errors.New("invalid phase energy: must be one of: low, medium, high")
+	}
+
 	if p.Created.IsZero() {
 		return errors.New("phase created timestamp is required")
 	}
@@ -128,3 +144,112 @@ func (p *Phase) AddMilestone(milestoneID EntityID) {
 	p.Milestones = append(p.Milestones, milestoneID)
 	p.Touch()
 }
+
+// AddResource links a resource to the phase
+func (p *Phase) AddResource(resourceID EntityID) {
+	for _, id := range p.Resources {
+		if id == resourceID {
+			return
+		}
+	}
+	p.Resources = append(p.Resources, resourceID)
+	p.Touch()
+}
+
+// SetStartDate records when work on the phase started
+func (p *Phase) SetStartDate(date time.Time) error {
+	if p.EndDate != nil && p.EndDate.Before(date) {
+		return errors.New("phase start date cannot be after end date")
+	}
+	p.StartDate = &date
+	p.Touch()
+	return nil
+}
+
+// SetEndDate records when the phase was finished
+func (p *Phase) SetEndDate(date time.Time) error {
+	if p.StartDate != nil && date.Before(*p.StartDate) {
+		return errors.New("phase end date cannot be before start date")
+	}
+	p.EndDate = &date
+	p.Touch()
+	return nil
+}
+
+// ClearDates removes both start and end dates
+func (p *Phase) ClearDates() {
+	p.StartDate = nil
+	p.EndDate = nil
+	p.Touch()
+}
+
+// SetEnergy rates how much energy the phase's work needs
+func (p *Phase) SetEnergy(energy EnergyLevel) error {
+	if !energy.IsValid() {
+		return errors.New("invalid energy: must be one of: low, medium, high")
+	}
+	p.Energy = energy
+	p.Touch()
+	return nil
+}
+
+// AssignSchedule records the weeks planned for the phase by a cohort schedule
+func (p *Phase) AssignSchedule(assignment Assignment) {
+	p.Schedule = &assignment
+	p.Touch()
+}
+
+// IsFinished reports whether the phase has an end date
+func (p *Phase) IsFinished() bool {
+	return p.EndDate != nil
+}
+
+// Complete finishes the phase on date, which also becomes its start date
+// when it has none
+func (p *Phase) Complete(date time.Time) error {
+	if p.StartDate == nil {
+		p.StartDate = &date
+	}
+	return p.SetEndDate(date)
+}
+
+// CountFinished returns how many of the phases are finished
+func CountFinished(phases []*Phase) int {
+	finished := 0
+	for _, phase := range phases {
+		if phase.IsFinished() {
+			finished++
+		}
+	}
+	return finished
+}
+
+// EstimatedDurationWeeks parses EstimatedDuration (e.g., "3 weeks", "2 months", "10 days")
+// into a number of weeks. Returns 0 if the duration is missing or unparseable.
+func (p *Phase) EstimatedDurationWeeks() float64 {
+	fields := strings.Fields(strings.ToLower(p.EstimatedDuration))
+	if len(fields) == 0 {
+		return 0
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || value < 0 {
+		return 0
+	}
+
+	unit := "weeks"
+	if len(fields) > 1 {
+		unit = fields[1]
+	}
+
+	switch {
+	case strings.HasPrefix(unit, "day"):
+		return value / 7
+	case strings.HasPrefix(unit, "month"):
+		return value * 52 / 12
+	case strings.HasPrefix(unit, "week"):
+		return value
+	default:
+		return 0
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -169,3 +170,83 @@ func TestPhase_AddMilestone(t *testing.T) {
 		assert.Len(t, phase.Milestones, 1)
 	})
 }
+
+func TestPhase_Dates(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("sets start and end dates", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+
+		require.NoError(t, phase.SetStartDate(start))
+		require.NoError(t, phase.SetEndDate(start.AddDate(0, 0, 14)))
+
+		assert.True(t, phase.IsFinished())
+		assert.NoError(t, phase.Validate())
+	})
+
+	t.Run("rejects end before start", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+		require.NoError(t, phase.SetStartDate(start))
+
+		err := phase.SetEndDate(start.AddDate(0, 0, -1))
+		assert.Error(t, err)
+		assert.False(t, phase.IsFinished())
+	})
+
+	t.Run("clears dates", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+		phase.SetStartDate(start)
+		phase.ClearDates()
+
+		assert.Nil(t, phase.StartDate)
+		assert.Nil(t, phase.EndDate)
+	})
+
+	t.Run("completes a phase", func(t *testing.T) {
+		phase, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+		require.NoError(t, phase.Complete(start))
+
+		assert.True(t, phase.IsFinished())
+		assert.Equal(t, start, *phase.StartDate, "a phase without a start date starts when it is completed")
+	})
+
+	t.Run("counts finished phases", func(t *testing.T) {
+		done, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+		require.NoError(t, done.Complete(start))
+		open, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+
+		assert.Equal(t, 1, CountFinished([]*Phase{done, open}))
+		assert.Equal(t, 0, CountFinished(nil))
+	})
+}
+
+func TestPhase_AddResource(t *testing.T) {
+	phase, _ := NewPhase("phase-001", "path-001", "Foundations", 1)
+
+	phase.AddResource("resource-001")
+	phase.AddResource("resource-001")
+
+	assert.Equal(t, []EntityID{"resource-001"}, phase.Resources)
+}
+
+func TestPhase_EstimatedDurationWeeks(t *testing.T) {
+	tests := []struct {
+		duration string
+		expected float64
+	}{
+		{"3 weeks", 3},
+		{"1 week", 1},
+		{"14 days", 2},
+		{"6 months", 26},
+		{"2", 2},
+		{"", 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			phase := &Phase{EstimatedDuration: tt.duration}
+			assert.InDelta(t, tt.expected, phase.EstimatedDurationWeeks(), 0.001)
+		})
+	}
+}
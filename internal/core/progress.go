@@ -2,18 +2,24 @@ package core
 
 import (
 	"errors"
+	"slices"
 	"time"
 )
 
 // ProgressLog represents a time-based journal entry
 type ProgressLog struct {
-	ID                 EntityID   `yaml:"id"`
-	Date               time.Time  `yaml:"date"`
-	HoursInvested      float64    `yaml:"hoursInvested,omitempty"`
-	SkillsWorked       []EntityID `yaml:"skillsWorked,omitempty"`
-	ResourcesUsed      []EntityID `yaml:"resourcesUsed,omitempty"`
-	MilestonesAchieved []EntityID `yaml:"milestonesAchieved,omitempty"`
-	Mood               string     `yaml:"mood,omitempty"` // e.g., "motivated", "frustrated", "focused"
+	ID                 EntityID             `yaml:"id"`
+	Date               time.Time            `yaml:"date"`
+	HoursInvested      float64              `yaml:"hoursInvested,omitempty"`
+	SkillsWorked       []EntityID           `yaml:"skillsWorked,omitempty"`
+	SkillHours         map[EntityID]float64 `yaml:"skillHours,omitempty"` // hours per skill worked, when tracked
+	ResourcesUsed      []EntityID           `yaml:"resourcesUsed,omitempty"`
+	MilestonesAchieved []EntityID           `yaml:"milestonesAchieved,omitempty"`
+	Mood               string               `yaml:"mood,omitempty"`         // e.g., "motivated", "frustrated", "focused"
+	Energy             EnergyLevel          `yaml:"energy,omitempty"`       // energy available: low, medium or high
+	FocusMinutes       int                  `yaml:"focusMinutes,omitempty"` // minutes spent in focus mode
+	Distractions       int                  `yaml:"distractions,omitempty"` // distractions logged in focus mode
+	Source             string               `yaml:"source,omitempty"`       // app the hours were imported from, e.g. toggl
 	Timestamps
 
 	// Body contains the markdown content (summary, accomplishments, challenges,
@@ -55,6 +61,20 @@ func (p *ProgressLog) Validate() error {
 		return errors.New("progress log hours invested cannot be negative (must be >= 0)")
 	}
 
+	if p.Energy != "" && !p.Energy.IsValid() {
+		return errors.New("invalid progress log energy: must be one of: low, medium, high")
+	}
+
+	for _, hours := range p.SkillHours {
+		if hours < 0 {
+			return errors.New("progress log skill hours cannot be negative (must be >= 0)")
+		}
+	}
+
+	if p.FocusMinutes < 0 || p.Distractions < 0 {
+		return errors.New("progress log focus minutes and distractions cannot be negative")
+	}
+
 	if p.Created.IsZero() {
 		return errors.New("progress log created timestamp is required")
 	}
@@ -114,3 +134,62 @@ func (p *ProgressLog) SetMood(mood string) {
 	p.Mood = mood
 	p.Touch()
 }
+
+// SetEnergy sets the energy level for this period
+func (p *ProgressLog) SetEnergy(energy EnergyLevel) error {
+	if !energy.IsValid() {
+		return errors.New("invalid energy: must be one of: low, medium, high")
+	}
+	p.Energy = energy
+	p.Touch()
+	return nil
+}
+
+// HoursPerResource splits the invested hours evenly across the resources used
+func (p *ProgressLog) HoursPerResource() float64 {
+	if len(p.ResourcesUsed) == 0 {
+		return 0
+	}
+	return p.HoursInvested / float64(len(p.ResourcesUsed))
+}
+
+// AccrueHours adds the log's share of hours to a resource it used, and
+// reports whether the resource changed
+func (p *ProgressLog) AccrueHours(resource *Resource) bool {
+	hours := p.HoursPerResource()
+	if hours <= 0 || !slices.Contains(p.ResourcesUsed, resource.ID) {
+		return false
+	}
+	return resource.AddActualHours(hours) == nil
+}
+
+// LatestLogInWeek returns the most recent of the logs dated in the week
+// containing day, or nil when none are. Logs dated the same are ordered by
+// when they were created, then by ID.
+func LatestLogInWeek(logs []*ProgressLog, day time.Time) *ProgressLog {
+	start := StartOfWeek(CalendarDate(day))
+	end := start.AddDate(0, 0, 7)
+
+	var latest *ProgressLog
+	for _, log := range logs {
+		date := CalendarDate(log.Date)
+		if date.Before(start) || !date.Before(end) {
+			continue
+		}
+		if latest == nil || loggedAfter(log, latest) {
+			latest = log
+		}
+	}
+	return latest
+}
+
+// loggedAfter reports whether a was logged after b
+func loggedAfter(a, b *ProgressLog) bool {
+	if !a.Date.Equal(b.Date) {
+		return a.Date.After(b.Date)
+	}
+	if !a.Created.Equal(b.Created) {
+		return a.Created.After(b.Created)
+	}
+	return a.ID > b.ID
+}
@@ -86,6 +86,17 @@ func TestProgressLog_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "cannot be negative",
 		},
+		{
+			name: "negative skill hours",
+			log: &ProgressLog{
+				ID:         "progress-001",
+				Date:       time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC),
+				SkillHours: map[EntityID]float64{"skill-001": -1},
+				Timestamps: NewTimestamps(),
+			},
+			wantErr: true,
+			errMsg:  "skill hours cannot be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -195,3 +206,79 @@ func TestProgressLog_SetMood(t *testing.T) {
 
 	assert.Equal(t, "motivated", log.Mood)
 }
+
+func TestProgressLog_HoursPerResource(t *testing.T) {
+	log, _ := NewProgressLog("progress-001", time.Now())
+
+	t.Run("returns zero without resources", func(t *testing.T) {
+		log.SetHoursInvested(6)
+		assert.Equal(t, 0.0, log.HoursPerResource())
+	})
+
+	t.Run("splits hours evenly", func(t *testing.T) {
+		log.AddResourceUsed("resource-001")
+		log.AddResourceUsed("resource-002")
+		assert.Equal(t, 3.0, log.HoursPerResource())
+	})
+}
+
+func TestProgressLog_AccrueHours(t *testing.T) {
+	log, _ := NewProgressLog("progress-001", time.Now())
+	log.SetHoursInvested(6)
+	log.AddResourceUsed("resource-001")
+	log.AddResourceUsed("resource-002")
+
+	used, _ := NewResource("resource-001", "Tour of Go", ResourceCourse, "skill-001")
+	used.SetActualHours(1)
+	assert.True(t, log.AccrueHours(used))
+	assert.Equal(t, 4.0, used.ActualHours)
+
+	other, _ := NewResource("resource-003", "Go blog", ResourceArticle, "skill-001")
+	assert.False(t, log.AccrueHours(other))
+	assert.Equal(t, 0.0, other.ActualHours)
+
+	log.SetHoursInvested(0)
+	assert.False(t, log.AccrueHours(used))
+	assert.Equal(t, 4.0, used.ActualHours)
+}
+
+func TestLatestLogInWeek(t *testing.T) {
+	newLog := func(id EntityID, date time.Time) *ProgressLog {
+		log, err := NewProgressLog(id, date)
+		require.NoError(t, err)
+		return log
+	}
+
+	// Wednesday, June 4 2025; the week runs Monday June 2 to Sunday June 8
+	day := time.Date(2025, 6, 4, 15, 0, 0, 0, time.UTC)
+	logs := []*ProgressLog{
+		newLog("progress-001", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+		newLog("progress-002", time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)),
+		newLog("progress-003", time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)),
+		newLog("progress-004", time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)),
+	}
+
+	t.Run("returns the latest log of the week", func(t *testing.T) {
+		latest := LatestLogInWeek(logs, day)
+		require.NotNil(t, latest)
+		assert.Equal(t, EntityID("progress-003"), latest.ID)
+	})
+
+	t.Run("returns nil for a week without logs", func(t *testing.T) {
+		assert.Nil(t, LatestLogInWeek(logs, day.AddDate(0, 0, 14)))
+	})
+
+	t.Run("picks the log created last on the same day", func(t *testing.T) {
+		morning := newLog("progress-005", time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC))
+		evening := newLog("progress-006", time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC))
+		evening.Created = morning.Created.Add(time.Hour)
+
+		latest := LatestLogInWeek([]*ProgressLog{evening, morning}, day)
+		assert.Equal(t, EntityID("progress-006"), latest.ID)
+
+		// With the same creation time, the higher ID wins
+		evening.Created = morning.Created
+		latest = LatestLogInWeek([]*ProgressLog{evening, morning}, day)
+		assert.Equal(t, EntityID("progress-006"), latest.ID)
+	})
+}
@@ -0,0 +1,92 @@
+package core
+
+import "sort"
+
+// ResourceLink describes how a resource connects to a goal through a path phase
+type ResourceLink struct {
+	GoalID     EntityID
+	Priority   Priority
+	PathID     EntityID
+	PhaseID    EntityID
+	PhaseOrder int
+}
+
+// QueueEntry is a ranked, not-started resource
+type QueueEntry struct {
+	Resource *Resource
+	Link     *ResourceLink // nil when the resource isn't part of any active goal's path
+}
+
+// priorityRank orders priorities from most to least important; unlinked resources rank last
+func priorityRank(link *ResourceLink) int {
+	if link == nil {
+		return 3
+	}
+	switch link.Priority {
+	case PriorityHigh:
+		return 0
+	case PriorityMedium:
+		return 1
+	case PriorityLow:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// BetterLink reports whether a should be preferred over b when a resource
+// is reachable from several goals or phases.
+func BetterLink(a, b *ResourceLink) bool {
+	if b == nil {
+		return a != nil
+	}
+	if a == nil {
+		return false
+	}
+	if ra, rb := priorityRank(a), priorityRank(b); ra != rb {
+		return ra < rb
+	}
+	return a.PhaseOrder < b.PhaseOrder
+}
+
+// RankResourceQueue returns not-started resources ordered by what to pick up next:
+// resources linked to higher-priority goals first, then earlier phases, then
+// shorter estimated hours (unestimated resources last), then ID.
+// Deprioritized resources always come after the rest.
+func RankResourceQueue(resources []*Resource, links map[EntityID]*ResourceLink) []QueueEntry {
+	var entries []QueueEntry
+	for _, resource := range resources {
+		if resource.Status != ResourceNotStarted {
+			continue
+		}
+		entries = append(entries, QueueEntry{Resource: resource, Link: links[resource.ID]})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+
+		if da, db := a.Resource.IsDeprioritized(), b.Resource.IsDeprioritized(); da != db {
+			return db
+		}
+
+		if ra, rb := priorityRank(a.Link), priorityRank(b.Link); ra != rb {
+			return ra < rb
+		}
+
+		if a.Link != nil && b.Link != nil && a.Link.PhaseOrder != b.Link.PhaseOrder {
+			return a.Link.PhaseOrder < b.Link.PhaseOrder
+		}
+
+		ha, hb := a.Resource.EstimatedHours, b.Resource.EstimatedHours
+		if (ha > 0) != (hb > 0) {
+			return ha > 0
+		}
+		if ha != hb {
+			return ha < hb
+		}
+
+		return a.Resource.ID < b.Resource.ID
+	})
+
+	return entries
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankResourceQueue(t *testing.T) {
+	newRes := func(id EntityID, hours float64) *Resource {
+		r, _ := NewResource(id, string(id), ResourceBook, "skill-001")
+		r.SetEstimatedHours(hours)
+		return r
+	}
+
+	lowGoal := newRes("resource-001", 2)
+	highLatePhase := newRes("resource-002", 10)
+	highEarlyPhase := newRes("resource-003", 20)
+	unlinkedShort := newRes("resource-004", 1)
+	unlinkedUnestimated := newRes("resource-005", 0)
+	started := newRes("resource-006", 1)
+	started.Start()
+	deprioritized := newRes("resource-007", 1)
+	deprioritized.Deprioritize()
+
+	links := map[EntityID]*ResourceLink{
+		"resource-001": {GoalID: "goal-002", Priority: PriorityLow, PhaseOrder: 1},
+		"resource-002": {GoalID: "goal-001", Priority: PriorityHigh, PhaseOrder: 2},
+		"resource-003": {GoalID: "goal-001", Priority: PriorityHigh, PhaseOrder: 1},
+		"resource-006": {GoalID: "goal-001", Priority: PriorityHigh, PhaseOrder: 1},
+		"resource-007": {GoalID: "goal-001", Priority: PriorityHigh, PhaseOrder: 1},
+	}
+
+	entries := RankResourceQueue([]*Resource{
+		deprioritized, unlinkedUnestimated, lowGoal, unlinkedShort, started, highLatePhase, highEarlyPhase,
+	}, links)
+
+	require.Len(t, entries, 6)
+	var order []EntityID
+	for _, e := range entries {
+		order = append(order, e.Resource.ID)
+	}
+	assert.Equal(t, []EntityID{"resource-003", "resource-002", "resource-001", "resource-004", "resource-005", "resource-007"}, order)
+	assert.Nil(t, entries[3].Link)
+}
+
+func TestBetterLink(t *testing.T) {
+	high := &ResourceLink{Priority: PriorityHigh, PhaseOrder: 3}
+	medium := &ResourceLink{Priority: PriorityMedium, PhaseOrder: 1}
+	highEarly := &ResourceLink{Priority: PriorityHigh, PhaseOrder: 1}
+
+	assert.True(t, BetterLink(high, medium))
+	assert.False(t, BetterLink(medium, high))
+	assert.True(t, BetterLink(highEarly, high))
+	assert.True(t, BetterLink(medium, nil))
+	assert.False(t, BetterLink(nil, medium))
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// QuickEntry is a free-text progress note parsed into entity updates
+type QuickEntry struct {
+	Text           string
+	Hours          float64
+	Mood           string
+	Resource       *Resource      // resource the note is about, if one matched
+	ResourceStatus ResourceStatus // new status for Resource, empty when unchanged
+	Skills         []EntityID     // skills worked, including the resource's skill
+}
+
+var (
+	quickHoursPattern   = regexp.MustCompile(`(?i)\b(\d+(?:\.\d+)?)\s*(?:h|hrs?|hours?)\b`)
+	quickMinutesPattern = regexp.MustCompile(`(?i)\b(\d+)\s*(?:m|mins?|minutes?)\b`)
+	quickMoodPattern    = regexp.MustCompile(`(?i)\b(?:felt|feeling|feel|mood:?)\s+([a-z-]+)`)
+	quickDonePattern    = regexp.MustCompile(`(?i)\b(?:finished|completed|done with|wrapped up)\b`)
+	quickWordPattern    = regexp.MustCompile(`[a-z0-9]+`)
+)
+
+// quickStopWords are ignored when matching resource titles
+var quickStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "and": true, "to": true,
+	"in": true, "on": true, "for": true, "with": true, "by": true,
+}
+
+// ParseQuickEntry extracts hours, mood, the resource worked on and the skills
+// involved from a free-text note such as
+// "read 2 chapters of Designing Data-Intensive Apps, 3h, felt focused"
+func ParseQuickEntry(text string, resources []*Resource, skills []*Skill) QuickEntry {
+	entry := QuickEntry{Text: strings.TrimSpace(text)}
+
+	for _, m := range quickHoursPattern.FindAllStringSubmatch(text, -1) {
+		hours, _ := strconv.ParseFloat(m[1], 64)
+		entry.Hours += hours
+	}
+	for _, m := range quickMinutesPattern.FindAllStringSubmatch(text, -1) {
+		minutes, _ := strconv.ParseFloat(m[1], 64)
+		entry.Hours += minutes / 60
+	}
+
+	if m := quickMoodPattern.FindStringSubmatch(text); m != nil {
+		entry.Mood = strings.ToLower(m[1])
+	}
+
+	words := quickWords(text)
+
+	entry.Resource = matchQuickResource(words, resources)
+	if entry.Resource != nil {
+		switch {
+		case quickDonePattern.MatchString(text) && entry.Resource.Status != ResourceCompleted:
+			entry.ResourceStatus = ResourceCompleted
+		case entry.Resource.Status == ResourceNotStarted:
+			entry.ResourceStatus = ResourceInProgress
+		}
+		if entry.Resource.SkillID != "" {
+			entry.Skills = append(entry.Skills, entry.Resource.SkillID)
+		}
+	}
+
+	for _, skill := range skills {
+		if entry.Resource != nil && skill.ID == entry.Resource.SkillID {
+			continue
+		}
+		if titleWords := quickWords(skill.Title); len(titleWords) > 0 && containsWords(words, titleWords) {
+			entry.Skills = append(entry.Skills, skill.ID)
+		}
+	}
+
+	return entry
+}
+
+// matchQuickResource returns the resource whose title best matches words.
+// Abbreviations count, so "Apps" matches "Applications". At least two thirds
+// of a title's words must match; open resources win ties.
+func matchQuickResource(words []string, resources []*Resource) *Resource {
+	var best *Resource
+	bestScore := 0.0
+
+	for _, r := range resources {
+		var titleWords []string
+		for _, w := range quickWords(r.Title) {
+			if !quickStopWords[w] {
+				titleWords = append(titleWords, w)
+			}
+		}
+		if len(titleWords) == 0 {
+			continue
+		}
+
+		matched := 0
+		for _, tw := range titleWords {
+			for _, w := range words {
+				if quickWordMatch(tw, w) {
+					matched++
+					break
+				}
+			}
+		}
+
+		score := float64(matched) / float64(len(titleWords))
+		if score < 2.0/3.0 {
+			continue
+		}
+		if score > bestScore || (score == bestScore && best != nil && best.Status == ResourceCompleted && r.Status != ResourceCompleted) {
+			best, bestScore = r, score
+		}
+	}
+
+	return best
+}
+
+// quickWordMatch reports whether two words are equal or one abbreviates the
+// other: they share a prefix of at least three letters covering three
+// quarters of the shorter word
+func quickWordMatch(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	shorter := min(len(a), len(b))
+	prefix := 0
+	for prefix < shorter && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return prefix >= 3 && prefix*4 >= shorter*3
+}
+
+// containsWords reports whether needle appears as a run of whole words in words
+func containsWords(words, needle []string) bool {
+	for i := 0; i+len(needle) <= len(words); i++ {
+		match := true
+		for j, w := range needle {
+			if words[i+j] != w {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func quickWords(s string) []string {
+	return quickWordPattern.FindAllString(strings.ToLower(s), -1)
+}
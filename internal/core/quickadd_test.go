@@ -0,0 +1,70 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuickEntry(t *testing.T) {
+	ddia := &Resource{ID: "resource-001", Title: "Designing Data-Intensive Applications", SkillID: "skill-001", Status: ResourceInProgress}
+	k8s := &Resource{ID: "resource-002", Title: "Kubernetes in Action", SkillID: "skill-002", Status: ResourceNotStarted}
+	resources := []*Resource{ddia, k8s}
+	skills := []*Skill{
+		{ID: "skill-001", Title: "Distributed Systems"},
+		{ID: "skill-002", Title: "Kubernetes"},
+		{ID: "skill-003", Title: "Go"},
+	}
+
+	t.Run("parses hours, mood and resource", func(t *testing.T) {
+		entry := ParseQuickEntry("read 2 chapters of Designing Data-Intensive Apps, 3h, felt focused", resources, skills)
+
+		assert.Equal(t, 3.0, entry.Hours)
+		assert.Equal(t, "focused", entry.Mood)
+		require.NotNil(t, entry.Resource)
+		assert.Equal(t, EntityID("resource-001"), entry.Resource.ID)
+		assert.Empty(t, entry.ResourceStatus)
+		assert.Equal(t, []EntityID{"skill-001"}, entry.Skills)
+	})
+
+	t.Run("adds minutes to hours", func(t *testing.T) {
+		entry := ParseQuickEntry("1.5 hours of go katas plus 30 min review", resources, skills)
+
+		assert.Equal(t, 2.0, entry.Hours)
+		assert.Nil(t, entry.Resource)
+		assert.Equal(t, []EntityID{"skill-003"}, entry.Skills)
+	})
+
+	t.Run("starts a resource that was not started", func(t *testing.T) {
+		entry := ParseQuickEntry("first chapter of kubernetes in action, 1h", resources, skills)
+
+		require.NotNil(t, entry.Resource)
+		assert.Equal(t, EntityID("resource-002"), entry.Resource.ID)
+		assert.Equal(t, ResourceInProgress, entry.ResourceStatus)
+		assert.Equal(t, []EntityID{"skill-002"}, entry.Skills)
+	})
+
+	t.Run("completes a finished resource", func(t *testing.T) {
+		entry := ParseQuickEntry("finished Designing Data-Intensive Applications!", resources, skills)
+
+		require.NotNil(t, entry.Resource)
+		assert.Equal(t, ResourceCompleted, entry.ResourceStatus)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		entry := ParseQuickEntry("gardening", resources, skills)
+
+		assert.Zero(t, entry.Hours)
+		assert.Empty(t, entry.Mood)
+		assert.Nil(t, entry.Resource)
+		assert.Empty(t, entry.Skills)
+	})
+}
+
+func TestQuickWordMatch(t *testing.T) {
+	assert.True(t, quickWordMatch("apps", "applications"))
+	assert.True(t, quickWordMatch("design", "designing"))
+	assert.False(t, quickWordMatch("progress", "programming"))
+	assert.False(t, quickWordMatch("go", "good"))
+}
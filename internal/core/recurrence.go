@@ -0,0 +1,111 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// PeriodStart returns the start of the recurrence period containing t
+func (r Recurrence) PeriodStart(t time.Time) time.Time {
+	switch r {
+	case RecurrenceDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	case RecurrenceMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return StartOfWeek(t)
+	}
+}
+
+// NextPeriodStart returns the start of the period following the one containing t
+func (r Recurrence) NextPeriodStart(t time.Time) time.Time {
+	start := r.PeriodStart(t)
+	switch r {
+	case RecurrenceDaily:
+		return start.AddDate(0, 0, 1)
+	case RecurrenceMonthly:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 7)
+	}
+}
+
+// PeriodEnd returns the last day of the period containing t
+func (r Recurrence) PeriodEnd(t time.Time) time.Time {
+	return r.NextPeriodStart(t).AddDate(0, 0, -1)
+}
+
+// RecurringStats summarizes completion of a recurring milestone series
+type RecurringStats struct {
+	SeriesID       EntityID
+	Title          string
+	Recurrence     Recurrence
+	Periods        int
+	Completed      int
+	CompletionRate float64
+	CurrentStreak  int
+	LongestStreak  int
+}
+
+// ComputeRecurringStats calculates completion rate and streaks for the instances of a series.
+// Every period from the first instance up to the one containing now counts, so periods
+// without an instance are treated as missed. The current period only breaks the streak
+// once it has ended.
+func ComputeRecurringStats(instances []*Milestone, now time.Time) RecurringStats {
+	stats := RecurringStats{}
+	if len(instances) == 0 {
+		return stats
+	}
+
+	sorted := make([]*Milestone, 0, len(instances))
+	for _, m := range instances {
+		if m.PeriodStart != nil {
+			sorted = append(sorted, m)
+		}
+	}
+	if len(sorted) == 0 {
+		return stats
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].PeriodStart.Before(*sorted[j].PeriodStart)
+	})
+
+	first := sorted[0]
+	stats.SeriesID = first.SeriesID
+	stats.Title = first.Title
+	stats.Recurrence = first.Recurrence
+
+	achieved := make(map[time.Time]bool)
+	for _, m := range sorted {
+		if m.IsAchieved() {
+			achieved[first.Recurrence.PeriodStart(*m.PeriodStart)] = true
+		}
+	}
+
+	current := first.Recurrence.PeriodStart(now)
+	streak := 0
+	for period := first.Recurrence.PeriodStart(*first.PeriodStart); !period.After(current); period = first.Recurrence.NextPeriodStart(period) {
+		if achieved[period] {
+			stats.Periods++
+			stats.Completed++
+			streak++
+			if streak > stats.LongestStreak {
+				stats.LongestStreak = streak
+			}
+			continue
+		}
+		if period.Equal(current) {
+			// Current period is still open
+			continue
+		}
+		stats.Periods++
+		streak = 0
+	}
+
+	stats.CurrentStreak = streak
+	if stats.Periods > 0 {
+		stats.CompletionRate = float64(stats.Completed) / float64(stats.Periods) * 100
+	}
+
+	return stats
+}
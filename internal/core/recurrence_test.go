@@ -0,0 +1,113 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrence_Periods(t *testing.T) {
+	date := time.Date(2025, 3, 13, 10, 0, 0, 0, time.UTC) // Thursday
+
+	assert.Equal(t, time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC), RecurrenceDaily.PeriodStart(date))
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), RecurrenceWeekly.PeriodStart(date))
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), RecurrenceMonthly.PeriodStart(date))
+
+	assert.Equal(t, time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC), RecurrenceWeekly.PeriodEnd(date))
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), RecurrenceMonthly.PeriodEnd(date))
+}
+
+func TestMilestone_Recurrence(t *testing.T) {
+	from := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	t.Run("sets recurrence", func(t *testing.T) {
+		m, _ := NewMilestone("milestone-001", "Write weekly blog post", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+
+		require.NoError(t, m.SetRecurrence(RecurrenceWeekly, from))
+
+		assert.True(t, m.IsRecurring())
+		assert.Equal(t, EntityID("milestone-001"), m.SeriesID)
+		assert.Equal(t, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), *m.PeriodStart)
+		assert.Equal(t, time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC), *m.TargetDate)
+	})
+
+	t.Run("fails with invalid recurrence", func(t *testing.T) {
+		m, _ := NewMilestone("milestone-001", "Write weekly blog post", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+
+		assert.Error(t, m.SetRecurrence(Recurrence("hourly"), from))
+		assert.False(t, m.IsRecurring())
+	})
+
+	t.Run("creates next instance", func(t *testing.T) {
+		m, _ := NewMilestone("milestone-001", "Write weekly blog post", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+		m.SetRecurrence(RecurrenceWeekly, from)
+		m.Achieve("")
+
+		next, err := m.NextInstance("milestone-002", from.AddDate(0, 0, 7))
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("milestone-001"), next.SeriesID)
+		assert.Equal(t, StatusActive, next.Status)
+		assert.Nil(t, next.AchievedDate)
+		assert.Equal(t, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), *next.PeriodStart)
+	})
+
+	t.Run("non-recurring cannot spawn instance", func(t *testing.T) {
+		m, _ := NewMilestone("milestone-001", "One-off", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+
+		_, err := m.NextInstance("milestone-002", from)
+		assert.Error(t, err)
+	})
+}
+
+func TestComputeRecurringStats(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	instance := func(id EntityID, week int, achieved bool) *Milestone {
+		m, _ := NewMilestone(id, "Write weekly blog post", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+		m.SetRecurrence(RecurrenceWeekly, start.AddDate(0, 0, 7*week))
+		m.SeriesID = "milestone-001"
+		if achieved {
+			m.Achieve("")
+		}
+		return m
+	}
+
+	t.Run("counts completions and streaks", func(t *testing.T) {
+		instances := []*Milestone{
+			instance("milestone-001", 0, true),
+			instance("milestone-002", 1, false),
+			instance("milestone-003", 2, true),
+			instance("milestone-004", 3, true),
+			instance("milestone-005", 4, false), // current, still open
+		}
+
+		stats := ComputeRecurringStats(instances, start.AddDate(0, 0, 30))
+
+		assert.Equal(t, 4, stats.Periods)
+		assert.Equal(t, 3, stats.Completed)
+		assert.Equal(t, 75.0, stats.CompletionRate)
+		assert.Equal(t, 2, stats.CurrentStreak)
+		assert.Equal(t, 2, stats.LongestStreak)
+	})
+
+	t.Run("missing periods count as missed", func(t *testing.T) {
+		instances := []*Milestone{
+			instance("milestone-001", 0, true),
+			instance("milestone-002", 3, true),
+		}
+
+		stats := ComputeRecurringStats(instances, start.AddDate(0, 0, 22))
+
+		assert.Equal(t, 4, stats.Periods)
+		assert.Equal(t, 2, stats.Completed)
+		assert.Equal(t, 1, stats.CurrentStreak)
+	})
+
+	t.Run("empty series", func(t *testing.T) {
+		stats := ComputeRecurringStats(nil, start)
+		assert.Equal(t, 0, stats.Periods)
+	})
+}
@@ -3,8 +3,12 @@ package core
 import (
 	"errors"
 	"strings"
+	"time"
 )
 
+// TagDeprioritized marks resources pushed to the back of the reading queue
+const TagDeprioritized = "deprioritized"
+
 // Resource represents a learning material
 type Resource struct {
 	ID             EntityID       `yaml:"id"`
@@ -15,7 +19,16 @@ type Resource struct {
 	URL            string         `yaml:"url,omitempty"`
 	Author         string         `yaml:"author,omitempty"`
 	EstimatedHours float64        `yaml:"estimatedHours,omitempty"`
+	ActualHours    float64        `yaml:"actualHours,omitempty"`
+	Cost           float64        `yaml:"cost,omitempty"`    // amount paid, counted against ai.learningBudget
+	Energy         EnergyLevel    `yaml:"energy,omitempty"`  // difficulty: low, medium or high energy needed
+	Pricing        string         `yaml:"pricing,omitempty"` // "free" or "paid", as given when the AI suggested it
+	WhyRecommended string         `yaml:"whyRecommended,omitempty"`
+	AbandonReason  string         `yaml:"abandonReason,omitempty"`
+	Schedule       *Assignment    `yaml:"schedule,omitempty"` // weeks planned by a cohort schedule
 	Tags           []string       `yaml:"tags,omitempty"`
+	History        History        `yaml:"history,omitempty"`
+	Attachments    Attachments    `yaml:"attachments,omitempty"`
 	Timestamps
 
 	// Body contains the markdown content (overview, progress, key takeaways, application, rating)
@@ -66,6 +79,18 @@ func (r *Resource) Validate() error {
 		return errors.New("resource estimated hours cannot be negative")
 	}
 
+	if r.ActualHours < 0 {
+		return errors.New("resource actual hours cannot be negative")
+	}
+
+	if r.Cost < 0 {
+		return errors.New("resource cost cannot be negative")
+	}
+
+	if r.Energy != "" && !r.Energy.IsValid() {
+		return errors.New("invalid resource energy: must be one of: low, medium, high")
+	}
+
 	if r.Created.IsZero() {
 		return errors.New("resource created timestamp is required")
 	}
@@ -80,25 +105,75 @@ func (r *Resource) Validate() error {
 // UpdateStatus updates the resource's status
 func (r *Resource) UpdateStatus(status ResourceStatus) error {
 	if !status.IsValid() {
-		return errors.New("invalid resource status: must be one of: not-started, in-progress, completed")
+		return errors.New("invalid resource status: must be one of: not-started, in-progress, completed, abandoned")
 	}
-	r.Status = status
+	if status != ResourceAbandoned {
+		r.AbandonReason = ""
+	}
+	r.setStatus(status)
 	r.Touch()
 	return nil
 }
 
 // Start marks the resource as in-progress
 func (r *Resource) Start() {
-	r.Status = ResourceInProgress
+	r.setStatus(ResourceInProgress)
+	r.AbandonReason = ""
 	r.Touch()
 }
 
 // Complete marks the resource as completed
 func (r *Resource) Complete() {
-	r.Status = ResourceCompleted
+	r.setStatus(ResourceCompleted)
+	r.AbandonReason = ""
 	r.Touch()
 }
 
+// Abandon marks the resource as dropped, with an optional reason
+func (r *Resource) Abandon(reason string) {
+	r.setStatus(ResourceAbandoned)
+	r.AbandonReason = strings.TrimSpace(reason)
+	r.Touch()
+}
+
+// Deprioritize moves the resource back to not-started and tags it so it sorts
+// to the end of the reading queue
+func (r *Resource) Deprioritize() {
+	r.setStatus(ResourceNotStarted)
+	r.AbandonReason = ""
+	r.AddTag(TagDeprioritized)
+	r.Touch()
+}
+
+// Reprioritize removes the deprioritized tag
+func (r *Resource) Reprioritize() {
+	r.RemoveTag(TagDeprioritized)
+}
+
+// IsDeprioritized returns true if the resource was deprioritized
+func (r *Resource) IsDeprioritized() bool {
+	for _, t := range r.Tags {
+		if t == TagDeprioritized {
+			return true
+		}
+	}
+	return false
+}
+
+// CompletedAt returns when the resource was last marked completed, falling back
+// to the last update for resources completed before history was recorded
+func (r *Resource) CompletedAt() time.Time {
+	if at, ok := r.History.LastChangeTo("status", string(ResourceCompleted)); ok {
+		return at
+	}
+	return r.Updated
+}
+
+func (r *Resource) setStatus(status ResourceStatus) {
+	r.History.Record("status", string(r.Status), string(status))
+	r.Status = status
+}
+
 // AddTag adds a tag to the resource
 func (r *Resource) AddTag(tag string) {
 	tag = strings.ToLower(strings.TrimSpace(tag))
@@ -115,6 +190,24 @@ func (r *Resource) AddTag(tag string) {
 	r.Touch()
 }
 
+// RemoveTag removes a tag from the resource
+func (r *Resource) RemoveTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for i, t := range r.Tags {
+		if t == tag {
+			r.Tags = append(r.Tags[:i], r.Tags[i+1:]...)
+			r.Touch()
+			return
+		}
+	}
+}
+
+// AssignSchedule records the weeks planned for the resource by a cohort schedule
+func (r *Resource) AssignSchedule(assignment Assignment) {
+	r.Schedule = &assignment
+	r.Touch()
+}
+
 // SetURL sets the resource URL
 func (r *Resource) SetURL(url string) {
 	r.URL = url
@@ -136,3 +229,79 @@ func (r *Resource) SetEstimatedHours(hours float64) error {
 	r.Touch()
 	return nil
 }
+
+// SetCost sets the amount paid for the resource
+func (r *Resource) SetCost(cost float64) error {
+	if cost < 0 {
+		return errors.New("cost cannot be negative (must be >= 0)")
+	}
+	r.Cost = cost
+	r.Touch()
+	return nil
+}
+
+// SetEnergy rates how much energy the resource needs
+func (r *Resource) SetEnergy(energy EnergyLevel) error {
+	if !energy.IsValid() {
+		return errors.New("invalid energy: must be one of: low, medium, high")
+	}
+	r.Energy = energy
+	r.Touch()
+	return nil
+}
+
+// SetActualHours sets the time actually invested in the resource
+func (r *Resource) SetActualHours(hours float64) error {
+	if hours < 0 {
+		return errors.New("actual hours cannot be negative (must be >= 0)")
+	}
+	r.ActualHours = hours
+	r.Touch()
+	return nil
+}
+
+// AddActualHours accrues time spent on the resource (e.g., from a progress log)
+func (r *Resource) AddActualHours(hours float64) error {
+	if hours < 0 {
+		return errors.New("actual hours cannot be negative (must be >= 0)")
+	}
+	if hours == 0 {
+		return nil
+	}
+	r.ActualHours += hours
+	r.Touch()
+	return nil
+}
+
+// HasHoursVariance reports whether both estimated and actual hours are known
+func (r *Resource) HasHoursVariance() bool {
+	return r.EstimatedHours > 0 && r.ActualHours > 0
+}
+
+// HoursVariance returns actual minus estimated hours (positive means over budget)
+func (r *Resource) HoursVariance() float64 {
+	return r.ActualHours - r.EstimatedHours
+}
+
+// HoursVariancePercent returns the variance relative to the estimate
+func (r *Resource) HoursVariancePercent() float64 {
+	if r.EstimatedHours == 0 {
+		return 0
+	}
+	return r.HoursVariance() / r.EstimatedHours * 100
+}
+
+// RemainingBudget returns what is left of a yearly learning budget after the
+// cost of resources added in the same calendar year as now, never below zero
+func RemainingBudget(budget float64, resources []*Resource, now time.Time) float64 {
+	remaining := budget
+	for _, r := range resources {
+		if r.Created.Year() == now.Year() {
+			remaining -= r.Cost
+		}
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -178,3 +179,106 @@ func TestResource_SetEstimatedHours(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot be negative")
 	})
 }
+
+func TestResource_ActualHours(t *testing.T) {
+	t.Run("accrues hours", func(t *testing.T) {
+		resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+
+		require.NoError(t, resource.AddActualHours(3))
+		require.NoError(t, resource.AddActualHours(2.5))
+
+		assert.Equal(t, 5.5, resource.ActualHours)
+	})
+
+	t.Run("fails with negative hours", func(t *testing.T) {
+		resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+
+		assert.Error(t, resource.AddActualHours(-1))
+		assert.Error(t, resource.SetActualHours(-1))
+	})
+
+	t.Run("computes variance", func(t *testing.T) {
+		resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+		resource.SetEstimatedHours(40)
+		resource.SetActualHours(50)
+
+		assert.True(t, resource.HasHoursVariance())
+		assert.Equal(t, 10.0, resource.HoursVariance())
+		assert.Equal(t, 25.0, resource.HoursVariancePercent())
+	})
+
+	t.Run("no variance without estimate", func(t *testing.T) {
+		resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+		resource.SetActualHours(5)
+
+		assert.False(t, resource.HasHoursVariance())
+		assert.Equal(t, 0.0, resource.HoursVariancePercent())
+	})
+}
+
+func TestResource_Deprioritize(t *testing.T) {
+	resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+	resource.Start()
+
+	resource.Deprioritize()
+
+	assert.Equal(t, ResourceNotStarted, resource.Status)
+	assert.True(t, resource.IsDeprioritized())
+
+	resource.Reprioritize()
+
+	assert.False(t, resource.IsDeprioritized())
+	assert.Empty(t, resource.Tags)
+}
+
+func TestResource_Abandon(t *testing.T) {
+	resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+	resource.SetEstimatedHours(40)
+
+	resource.Abandon("too outdated")
+
+	assert.Equal(t, ResourceAbandoned, resource.Status)
+	assert.Equal(t, "too outdated", resource.AbandonReason)
+	assert.Equal(t, 0.0, resource.RemainingHours())
+	assert.NoError(t, resource.Validate())
+
+	resource.Start()
+
+	assert.Equal(t, ResourceInProgress, resource.Status)
+	assert.Empty(t, resource.AbandonReason)
+}
+
+func TestResource_SetCost(t *testing.T) {
+	resource, _ := NewResource("resource-001", "Kubernetes in Action", ResourceBook, "skill-001")
+
+	assert.NoError(t, resource.SetCost(49.99))
+	assert.Equal(t, 49.99, resource.Cost)
+
+	assert.Error(t, resource.SetCost(-1))
+	assert.Equal(t, 49.99, resource.Cost)
+
+	resource.Cost = -5
+	assert.Error(t, resource.Validate())
+}
+
+func TestRemainingBudget(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	book, _ := NewResource("resource-001", "Kubernetes in Action", ResourceBook, "skill-001")
+	book.SetCost(50)
+	book.Created = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	course, _ := NewResource("resource-002", "CKA Course", ResourceCourse, "skill-001")
+	course.SetCost(120)
+	course.Created = time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	lastYear, _ := NewResource("resource-003", "Go Course", ResourceCourse, "skill-002")
+	lastYear.SetCost(300)
+	lastYear.Created = time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	resources := []*Resource{book, course, lastYear}
+
+	assert.Equal(t, 330.0, RemainingBudget(500, resources, now))
+	assert.Equal(t, 0.0, RemainingBudget(100, resources, now))
+	assert.Equal(t, 500.0, RemainingBudget(500, nil, now))
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"sort"
+)
+
+// PathRetrospective gathers what a learning path produced, phase by phase,
+// as the raw material for a write-up
+type PathRetrospective struct {
+	Path       *LearningPath
+	Phases     []PhaseRetrospective
+	Milestones []*Milestone // achieved milestones of the path, its phases and goals, oldest first
+	Hours      float64      // actual hours logged on the path's resources
+}
+
+// PhaseRetrospective is one phase with its resources ranked
+type PhaseRetrospective struct {
+	Phase     *Phase
+	Resources []*Resource // finished or in progress, best first
+	Dropped   []*Resource // abandoned or never started
+}
+
+// BuildPathRetrospective collects the phases, resources and achieved
+// milestones of a path. Milestones count when they reference the path, are
+// listed in one of its phases, or reference one of goalIDs.
+func BuildPathRetrospective(path *LearningPath, phases []*Phase, resources map[EntityID]*Resource, milestones []*Milestone, goalIDs []EntityID) PathRetrospective {
+	retro := PathRetrospective{Path: path}
+
+	sorted := append([]*Phase{}, phases...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Order < sorted[j].Order })
+
+	phaseMilestones := make(map[EntityID]bool)
+	for _, phase := range sorted {
+		for _, id := range phase.Milestones {
+			phaseMilestones[id] = true
+		}
+
+		pr := PhaseRetrospective{Phase: phase}
+		var used []*Resource
+		for _, id := range phase.Resources {
+			resource, ok := resources[id]
+			if !ok {
+				continue
+			}
+			retro.Hours += resource.ActualHours
+			if resource.Status == ResourceCompleted || resource.Status == ResourceInProgress {
+				used = append(used, resource)
+			} else {
+				pr.Dropped = append(pr.Dropped, resource)
+			}
+		}
+		pr.Resources = RankResources(used)
+		retro.Phases = append(retro.Phases, pr)
+	}
+
+	goals := make(map[EntityID]bool, len(goalIDs))
+	for _, id := range goalIDs {
+		goals[id] = true
+	}
+
+	for _, m := range milestones {
+		if !m.IsAchieved() {
+			continue
+		}
+		related := phaseMilestones[m.ID] ||
+			(m.ReferenceType == ReferencePath && m.ReferenceID == path.ID) ||
+			(m.ReferenceType == ReferenceGoal && goals[m.ReferenceID])
+		if related {
+			retro.Milestones = append(retro.Milestones, m)
+		}
+	}
+	sort.Slice(retro.Milestones, func(i, j int) bool {
+		return retro.Milestones[i].AchievedDate.Before(*retro.Milestones[j].AchievedDate)
+	})
+
+	return retro
+}
+
+// RankResources orders resources by how much they contributed: completed
+// before in progress, then by actual hours invested, most first
+func RankResources(resources []*Resource) []*Resource {
+	ranked := append([]*Resource{}, resources...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if (a.Status == ResourceCompleted) != (b.Status == ResourceCompleted) {
+			return a.Status == ResourceCompleted
+		}
+		return a.ActualHours > b.ActualHours
+	})
+	return ranked
+}
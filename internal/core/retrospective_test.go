@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPathRetrospective(t *testing.T) {
+	path, _ := NewLearningPath("path-001", "Backend in Go", PathTypeManual)
+
+	basics, _ := NewPhase("phase-002", "path-001", "Basics", 1)
+	basics.Resources = []EntityID{"resource-001", "resource-002", "resource-003"}
+	advanced, _ := NewPhase("phase-001", "path-001", "Advanced", 2)
+	advanced.Resources = []EntityID{"resource-004", "resource-404"}
+	advanced.Milestones = []EntityID{"milestone-003"}
+
+	tour, _ := NewResource("resource-001", "Tour of Go", ResourceCourse, "skill-001")
+	tour.Complete()
+	tour.ActualHours = 4
+	book, _ := NewResource("resource-002", "The Go Book", ResourceBook, "skill-001")
+	book.Complete()
+	book.ActualHours = 20
+	video, _ := NewResource("resource-003", "Old video", ResourceVideo, "skill-001")
+	video.Abandon("outdated")
+	video.ActualHours = 1
+	project, _ := NewResource("resource-004", "Build an API", ResourceProject, "skill-001")
+	project.Start()
+	resources := map[EntityID]*Resource{
+		tour.ID: tour, book.ID: book, video.ID: video, project.ID: project,
+	}
+
+	pathMilestone, _ := NewMilestone("milestone-001", "Finish basics", MilestonePathLevel, ReferencePath, "path-001")
+	pathMilestone.Achieve("https://example.com/cert")
+	goalMilestone, _ := NewMilestone("milestone-002", "Ship", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	goalMilestone.Achieve("")
+	phaseMilestone, _ := NewMilestone("milestone-003", "API live", MilestoneSkillLevel, ReferenceSkill, "skill-001")
+	pending, _ := NewMilestone("milestone-004", "Pending", MilestonePathLevel, ReferencePath, "path-001")
+	other, _ := NewMilestone("milestone-005", "Other goal", MilestoneGoalLevel, ReferenceGoal, "goal-002")
+	other.Achieve("")
+	milestones := []*Milestone{phaseMilestone, goalMilestone, pathMilestone, pending, other}
+
+	retro := BuildPathRetrospective(path, []*Phase{advanced, basics}, resources, milestones, []EntityID{"goal-001"})
+
+	t.Run("orders phases and ranks resources", func(t *testing.T) {
+		require.Len(t, retro.Phases, 2)
+		assert.Equal(t, "Basics", retro.Phases[0].Phase.Title)
+		assert.Equal(t, []*Resource{book, tour}, retro.Phases[0].Resources)
+		assert.Equal(t, []*Resource{video}, retro.Phases[0].Dropped)
+		assert.Equal(t, []*Resource{project}, retro.Phases[1].Resources)
+	})
+
+	t.Run("sums actual hours", func(t *testing.T) {
+		assert.Equal(t, 25.0, retro.Hours)
+	})
+
+	t.Run("keeps achieved milestones of the path, phases and goals", func(t *testing.T) {
+		require.Len(t, retro.Milestones, 2)
+		ids := []EntityID{retro.Milestones[0].ID, retro.Milestones[1].ID}
+		assert.ElementsMatch(t, []EntityID{"milestone-001", "milestone-002"}, ids)
+	})
+}
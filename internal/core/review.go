@@ -0,0 +1,134 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// ReviewItem is an accomplishment within a review period
+type ReviewItem struct {
+	ID       EntityID
+	Title    string
+	Date     time.Time
+	Evidence string // link to proof, e.g. a milestone's proof URL or a resource URL
+	Kind     string // kind of item where a section mixes several, e.g. an output's type
+}
+
+// ReviewSkill is a skill whose level changed within a review period
+type ReviewSkill struct {
+	ID       EntityID
+	Title    string
+	Category string
+	From     ProficiencyLevel
+	To       ProficiencyLevel
+}
+
+// ReviewPacket gathers what was achieved during a review period, for filling
+// performance review templates
+type ReviewPacket struct {
+	Period             string
+	Start              time.Time
+	End                time.Time // exclusive
+	Hours              float64
+	GoalsCompleted     []ReviewItem
+	PathsCompleted     []ReviewItem
+	ResourcesCompleted []ReviewItem
+	Milestones         []ReviewItem
+	Outputs            []ReviewItem // talks, blog posts and open source contributions
+	SkillsProgressed   []ReviewSkill
+}
+
+// Evidence returns every accomplishment that has an evidence link
+func (p ReviewPacket) Evidence() []ReviewItem {
+	var items []ReviewItem
+	for _, group := range [][]ReviewItem{p.Milestones, p.Outputs, p.GoalsCompleted, p.PathsCompleted, p.ResourcesCompleted} {
+		for _, item := range group {
+			if item.Evidence != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// BuildReviewPacket collects the accomplishments in src that fall in [start, end)
+func BuildReviewPacket(period string, start, end time.Time, src SnapshotSource) ReviewPacket {
+	packet := ReviewPacket{Period: period, Start: start, End: end}
+	within := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+	completedAt := func(history History, updated time.Time) time.Time {
+		if at, ok := history.LastChangeTo("status", string(StatusCompleted)); ok {
+			return at
+		}
+		return updated
+	}
+
+	for _, log := range src.ProgressLogs {
+		if within(log.Date) {
+			packet.Hours += log.HoursInvested
+		}
+	}
+
+	for _, g := range src.Goals {
+		if g.Status == StatusCompleted {
+			if at := completedAt(g.History, g.Updated); within(at) {
+				packet.GoalsCompleted = append(packet.GoalsCompleted, ReviewItem{ID: g.ID, Title: g.Title, Date: at})
+			}
+		}
+	}
+
+	for _, p := range src.Paths {
+		if p.Status == StatusCompleted {
+			if at := completedAt(p.History, p.Updated); within(at) {
+				packet.PathsCompleted = append(packet.PathsCompleted, ReviewItem{ID: p.ID, Title: p.Title, Date: at})
+			}
+		}
+	}
+
+	for _, r := range src.Resources {
+		if r.Status == ResourceCompleted {
+			if at := r.CompletedAt(); within(at) {
+				packet.ResourcesCompleted = append(packet.ResourcesCompleted, ReviewItem{ID: r.ID, Title: r.Title, Date: at, Evidence: r.URL})
+			}
+		}
+	}
+
+	for _, m := range src.Milestones {
+		if m.IsAchieved() && within(*m.AchievedDate) {
+			packet.Milestones = append(packet.Milestones, ReviewItem{ID: m.ID, Title: m.Title, Date: *m.AchievedDate, Evidence: m.Proof})
+		}
+	}
+
+	for _, o := range src.Outputs {
+		if within(o.Date) {
+			packet.Outputs = append(packet.Outputs, ReviewItem{ID: o.ID, Title: o.Title, Date: o.Date, Evidence: o.URL, Kind: string(o.Type)})
+		}
+	}
+
+	for _, s := range src.Skills {
+		var changes History
+		for _, c := range s.History.ForField("level") {
+			if within(c.Timestamp) {
+				changes = append(changes, c)
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		from := ProficiencyLevel(changes[0].From)
+		to := ProficiencyLevel(changes[len(changes)-1].To)
+		if from != to {
+			packet.SkillsProgressed = append(packet.SkillsProgressed, ReviewSkill{ID: s.ID, Title: s.Title, Category: s.Category, From: from, To: to})
+		}
+	}
+
+	for _, items := range [][]ReviewItem{packet.GoalsCompleted, packet.PathsCompleted, packet.ResourcesCompleted, packet.Milestones, packet.Outputs} {
+		sort.Slice(items, func(i, j int) bool { return items[i].Date.Before(items[j].Date) })
+	}
+	sort.Slice(packet.SkillsProgressed, func(i, j int) bool {
+		return packet.SkillsProgressed[i].ID < packet.SkillsProgressed[j].ID
+	})
+
+	return packet
+}
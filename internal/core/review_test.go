@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildReviewPacket(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	inPeriod := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	goal, _ := NewGoal("goal-001", "Ship service", PriorityHigh)
+	goal.Status = StatusCompleted
+	goal.History = History{{Timestamp: inPeriod, Field: "status", From: "active", To: "completed"}}
+
+	oldGoal, _ := NewGoal("goal-002", "Old goal", PriorityLow)
+	oldGoal.Status = StatusCompleted
+	oldGoal.History = History{{Timestamp: before, Field: "status", From: "active", To: "completed"}}
+
+	resource, _ := NewResource("resource-001", "Go Book", ResourceBook, "skill-001")
+	resource.URL = "https://example.com/go"
+	resource.Status = ResourceCompleted
+	resource.History = History{{Timestamp: inPeriod.AddDate(0, 0, 5), Field: "status", From: "in-progress", To: "completed"}}
+
+	milestone, _ := NewMilestone("milestone-001", "Conference talk", MilestoneGoalLevel, ReferenceGoal, "goal-001")
+	achieved := inPeriod.AddDate(0, 0, -3)
+	milestone.Status = StatusCompleted
+	milestone.AchievedDate = &achieved
+	milestone.Proof = "https://example.com/talk"
+
+	skill, _ := NewSkill("skill-001", "Go", "programming", LevelAdvanced)
+	skill.History = History{
+		{Timestamp: before, Field: "level", From: "beginner", To: "intermediate"},
+		{Timestamp: inPeriod, Field: "level", From: "intermediate", To: "advanced"},
+	}
+	flat, _ := NewSkill("skill-002", "SQL", "data", LevelBeginner)
+
+	log, _ := NewProgressLog("progress-001", inPeriod)
+	log.HoursInvested = 6
+	oldLog, _ := NewProgressLog("progress-002", before)
+	oldLog.HoursInvested = 10
+
+	post, _ := NewOutput("output-001", "Profiling Go services", OutputBlogPost, inPeriod)
+	post.URL = "https://example.com/post"
+	oldTalk, _ := NewOutput("output-002", "Old talk", OutputTalk, before)
+
+	packet := BuildReviewPacket("2025-H1", start, end, SnapshotSource{
+		Skills:       []*Skill{skill, flat},
+		Goals:        []*Goal{goal, oldGoal},
+		Resources:    []*Resource{resource},
+		Milestones:   []*Milestone{milestone},
+		ProgressLogs: []*ProgressLog{log, oldLog},
+		Outputs:      []*Output{oldTalk, post},
+	})
+
+	assert.Equal(t, "2025-H1", packet.Period)
+	assert.Equal(t, 6.0, packet.Hours)
+	require.Len(t, packet.GoalsCompleted, 1)
+	assert.Equal(t, EntityID("goal-001"), packet.GoalsCompleted[0].ID)
+	require.Len(t, packet.ResourcesCompleted, 1)
+	assert.Equal(t, "https://example.com/go", packet.ResourcesCompleted[0].Evidence)
+	require.Len(t, packet.Milestones, 1)
+	assert.Equal(t, achieved, packet.Milestones[0].Date)
+	assert.Equal(t, []ReviewSkill{{ID: "skill-001", Title: "Go", Category: "programming", From: LevelIntermediate, To: LevelAdvanced}}, packet.SkillsProgressed)
+
+	assert.Equal(t, []ReviewItem{{ID: "output-001", Title: "Profiling Go services", Date: inPeriod, Evidence: "https://example.com/post", Kind: "blog-post"}}, packet.Outputs)
+
+	evidence := packet.Evidence()
+	require.Len(t, evidence, 3)
+	assert.Equal(t, "https://example.com/talk", evidence[0].Evidence)
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultHoursPerWeek is the weekly commitment assumed when a path does not specify one
+const DefaultHoursPerWeek = 5.0
+
+// PhaseProjection is the projected (or actual) schedule for a single phase
+type PhaseProjection struct {
+	PhaseID        EntityID
+	Title          string
+	Order          int
+	Start          time.Time
+	End            time.Time
+	RemainingHours float64
+	Weeks          float64
+	Finished       bool
+}
+
+// ScheduleProjection is the projected schedule for a learning path
+type ScheduleProjection struct {
+	HoursPerWeek   float64
+	Bias           float64 // factor the estimates were scaled by, see Calibration
+	RemainingHours float64
+	Phases         []PhaseProjection
+	End            time.Time
+}
+
+// RemainingHours returns the estimated hours left on a resource.
+// Completed and abandoned resources have no remaining hours; in-progress ones are credited with actual hours spent.
+func (r *Resource) RemainingHours() float64 {
+	return r.remainingHours(1)
+}
+
+// remainingHours returns the hours left once the estimate is scaled by an
+// estimate bias factor; hours already spent are not scaled
+func (r *Resource) remainingHours(bias float64) float64 {
+	if r.Status == ResourceCompleted || r.Status == ResourceAbandoned {
+		return 0
+	}
+	return max(r.EstimatedHours*bias-r.ActualHours, 0)
+}
+
+// ProjectSchedule projects start and end dates for each phase of a path, starting at from.
+// Finished phases keep their recorded dates. Unfinished phases are scheduled back to back,
+// using the remaining estimated hours of their resources at hoursPerWeek, or the phase's
+// EstimatedDuration when none of its resources has an hour estimate.
+func ProjectSchedule(phases []*Phase, resources map[EntityID]*Resource, hoursPerWeek float64, from time.Time) *ScheduleProjection {
+	return ProjectScheduleWithBias(phases, resources, hoursPerWeek, 1, from)
+}
+
+// ProjectScheduleWithBias projects a schedule like ProjectSchedule with the
+// remaining hours and estimated durations scaled by an estimate bias factor
+func ProjectScheduleWithBias(phases []*Phase, resources map[EntityID]*Resource, hoursPerWeek, bias float64, from time.Time) *ScheduleProjection {
+	if hoursPerWeek <= 0 {
+		hoursPerWeek = DefaultHoursPerWeek
+	}
+	if bias <= 0 {
+		bias = 1
+	}
+
+	ordered := make([]*Phase, len(phases))
+	copy(ordered, phases)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Order < ordered[j].Order
+	})
+
+	projection := &ScheduleProjection{
+		HoursPerWeek: hoursPerWeek,
+		Bias:         bias,
+		Phases:       make([]PhaseProjection, 0, len(ordered)),
+		End:          from,
+	}
+
+	cursor := from
+	for _, phase := range ordered {
+		if phase.IsFinished() {
+			start := *phase.EndDate
+			if phase.StartDate != nil {
+				start = *phase.StartDate
+			}
+			projection.Phases = append(projection.Phases, PhaseProjection{
+				PhaseID:  phase.ID,
+				Title:    phase.Title,
+				Order:    phase.Order,
+				Start:    start,
+				End:      *phase.EndDate,
+				Finished: true,
+			})
+			if phase.EndDate.After(projection.End) {
+				projection.End = *phase.EndDate
+			}
+			continue
+		}
+
+		remaining := 0.0
+		estimated := false
+		for _, resourceID := range phase.Resources {
+			if resource, ok := resources[resourceID]; ok {
+				remaining += resource.remainingHours(bias)
+				estimated = estimated || resource.EstimatedHours > 0
+			}
+		}
+
+		// Resources with estimates and no hours left take no time; only
+		// without any estimates does the phase fall back to its duration
+		weeks := phase.EstimatedDurationWeeks() * bias
+		if estimated {
+			weeks = remaining / hoursPerWeek
+		}
+
+		start := cursor
+		end := start.Add(time.Duration(weeks * 7 * 24 * float64(time.Hour)))
+
+		projection.Phases = append(projection.Phases, PhaseProjection{
+			PhaseID:        phase.ID,
+			Title:          phase.Title,
+			Order:          phase.Order,
+			Start:          start,
+			End:            end,
+			RemainingHours: remaining,
+			Weeks:          weeks,
+		})
+		projection.RemainingHours += remaining
+
+		cursor = end
+		if end.After(projection.End) {
+			projection.End = end
+		}
+	}
+
+	return projection
+}
+
+// ExceedsTarget reports whether the projected end falls after the target date
+func (s *ScheduleProjection) ExceedsTarget(target *time.Time) bool {
+	if target == nil {
+		return false
+	}
+	return s.End.After(*target)
+}
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource_RemainingHours(t *testing.T) {
+	resource, _ := NewResource("resource-001", "Fluent Python", ResourceBook, "skill-002")
+	resource.SetEstimatedHours(10)
+
+	assert.Equal(t, 10.0, resource.RemainingHours())
+
+	resource.SetActualHours(4)
+	assert.Equal(t, 6.0, resource.RemainingHours())
+
+	resource.SetActualHours(12)
+	assert.Equal(t, 0.0, resource.RemainingHours())
+
+	resource.SetActualHours(1)
+	resource.Complete()
+	assert.Equal(t, 0.0, resource.RemainingHours())
+}
+
+func TestProjectSchedule(t *testing.T) {
+	from := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	newResource := func(id EntityID, hours float64) *Resource {
+		r, _ := NewResource(id, string(id), ResourceCourse, "skill-001")
+		r.SetEstimatedHours(hours)
+		return r
+	}
+
+	t.Run("schedules phases back to back from resource hours", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.AddResource("resource-001")
+		p2, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+		p2.AddResource("resource-002")
+
+		resources := map[EntityID]*Resource{
+			"resource-001": newResource("resource-001", 10),
+			"resource-002": newResource("resource-002", 20),
+		}
+
+		projection := ProjectSchedule([]*Phase{p2, p1}, resources, 10, from)
+
+		require.Len(t, projection.Phases, 2)
+		assert.Equal(t, EntityID("phase-001"), projection.Phases[0].PhaseID)
+		assert.Equal(t, from.AddDate(0, 0, 7), projection.Phases[0].End)
+		assert.Equal(t, from.AddDate(0, 0, 21), projection.Phases[1].End)
+		assert.Equal(t, from.AddDate(0, 0, 21), projection.End)
+		assert.Equal(t, 30.0, projection.RemainingHours)
+	})
+
+	t.Run("scales estimates by the bias factor", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.AddResource("resource-001")
+		p2, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+		p2.EstimatedDuration = "2 weeks"
+
+		projection := ProjectScheduleWithBias([]*Phase{p1, p2}, map[EntityID]*Resource{"resource-001": newResource("resource-001", 10)}, 10, 1.5, from)
+
+		assert.Equal(t, 1.5, projection.Bias)
+		assert.Equal(t, 15.0, projection.RemainingHours)
+		assert.Equal(t, 1.5, projection.Phases[0].Weeks)
+		assert.Equal(t, 3.0, projection.Phases[1].Weeks)
+	})
+
+	t.Run("scales the estimate before subtracting hours spent", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.AddResource("resource-001")
+		started := newResource("resource-001", 10)
+		started.SetActualHours(8)
+
+		projection := ProjectScheduleWithBias([]*Phase{p1}, map[EntityID]*Resource{"resource-001": started}, 10, 2, from)
+
+		assert.Equal(t, 12.0, projection.RemainingHours)
+	})
+
+	t.Run("falls back to estimated duration", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.EstimatedDuration = "2 weeks"
+
+		projection := ProjectSchedule([]*Phase{p1}, nil, 0, from)
+
+		assert.Equal(t, DefaultHoursPerWeek, projection.HoursPerWeek)
+		assert.Equal(t, from.AddDate(0, 0, 14), projection.End)
+	})
+
+	t.Run("takes no time for an unfinished phase with all resources done", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.EstimatedDuration = "4 weeks"
+		p1.AddResource("resource-001")
+		done := newResource("resource-001", 10)
+		done.Complete()
+
+		projection := ProjectSchedule([]*Phase{p1}, map[EntityID]*Resource{"resource-001": done}, 10, from)
+
+		assert.Equal(t, 0.0, projection.Phases[0].Weeks)
+		assert.Equal(t, from, projection.End)
+	})
+
+	t.Run("keeps finished phases at recorded dates", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.EstimatedDuration = "4 weeks"
+		require.NoError(t, p1.SetStartDate(from.AddDate(0, 0, -30)))
+		require.NoError(t, p1.SetEndDate(from.AddDate(0, 0, -2)))
+		p2, _ := NewPhase("phase-002", "path-001", "Advanced", 2)
+		p2.EstimatedDuration = "1 week"
+
+		projection := ProjectSchedule([]*Phase{p1, p2}, nil, 5, from)
+
+		assert.True(t, projection.Phases[0].Finished)
+		assert.Equal(t, from.AddDate(0, 0, -2), projection.Phases[0].End)
+		assert.Equal(t, from, projection.Phases[1].Start)
+		assert.Equal(t, from.AddDate(0, 0, 7), projection.End)
+	})
+
+	t.Run("detects target overrun", func(t *testing.T) {
+		p1, _ := NewPhase("phase-001", "path-001", "Basics", 1)
+		p1.EstimatedDuration = "4 weeks"
+
+		projection := ProjectSchedule([]*Phase{p1}, nil, 5, from)
+
+		early := from.AddDate(0, 0, 14)
+		late := from.AddDate(0, 0, 60)
+		assert.True(t, projection.ExceedsTarget(&early))
+		assert.False(t, projection.ExceedsTarget(&late))
+		assert.False(t, projection.ExceedsTarget(nil))
+	})
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"strings"
+)
+
+// Conventional sections of an entity body. Commands append to them without
+// touching the rest of the text.
+const (
+	SectionNotes   = "Notes"
+	SectionLog     = "Log"
+	SectionLinks   = "Links"
+	SectionReviews = "Reviews"
+)
+
+// BodySection is a "## Heading" section of a markdown body
+type BodySection struct {
+	Heading string
+	Content string
+}
+
+// ParseSections splits a body into the free text before the first "## "
+// heading and the sections that follow. Deeper headings stay part of their
+// section, and headings inside fenced code blocks are ignored.
+func ParseSections(body string) (preamble string, sections []BodySection) {
+	lines := strings.Split(body, "\n")
+	starts := sectionStarts(lines)
+	if len(starts) == 0 {
+		return strings.TrimSpace(body), nil
+	}
+
+	preamble = strings.TrimSpace(strings.Join(lines[:starts[0]], "\n"))
+	for i, start := range starts {
+		end := len(lines)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		sections = append(sections, BodySection{
+			Heading: headingText(lines[start]),
+			Content: strings.TrimSpace(strings.Join(lines[start+1:end], "\n")),
+		})
+	}
+
+	return preamble, sections
+}
+
+// Section returns the content of the named section, matched case-insensitively
+func Section(body, heading string) (string, bool) {
+	_, sections := ParseSections(body)
+	for _, s := range sections {
+		if strings.EqualFold(s.Heading, heading) {
+			return s.Content, true
+		}
+	}
+	return "", false
+}
+
+// SectionItems returns the top-level list items ("- " or "* ") of the named
+// section
+func SectionItems(body, heading string) []string {
+	content, ok := Section(body, heading)
+	if !ok {
+		return nil
+	}
+
+	var items []string
+	for _, line := range strings.Split(content, "\n") {
+		if item, ok := strings.CutPrefix(line, "- "); ok {
+			items = append(items, strings.TrimSpace(item))
+		} else if item, ok := strings.CutPrefix(line, "* "); ok {
+			items = append(items, strings.TrimSpace(item))
+		}
+	}
+	return items
+}
+
+// AppendToSection adds text at the end of the named section, creating the
+// section at the end of the body if it does not exist. Everything outside the
+// section is left as it was.
+func AppendToSection(body, heading, text string) string {
+	text = strings.TrimSpace(text)
+	if strings.TrimSpace(body) == "" {
+		return "## " + heading + "\n\n" + text
+	}
+
+	lines := strings.Split(body, "\n")
+	starts := sectionStarts(lines)
+	for i, start := range starts {
+		if !strings.EqualFold(headingText(lines[start]), heading) {
+			continue
+		}
+
+		end := len(lines)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+
+		// Insert after the last non-blank line of the section
+		last := end - 1
+		for last > start && strings.TrimSpace(lines[last]) == "" {
+			last--
+		}
+
+		insert := []string{text}
+		if last == start {
+			insert = []string{"", text}
+		}
+		if end < len(lines) {
+			insert = append(insert, "")
+		}
+
+		result := append([]string{}, lines[:last+1]...)
+		result = append(result, insert...)
+		result = append(result, lines[end:]...)
+		return strings.Join(result, "\n")
+	}
+
+	return strings.TrimRight(body, "\n ") + "\n\n## " + heading + "\n\n" + text
+}
+
+// AppendSectionItem adds a list item to the end of the named section
+func AppendSectionItem(body, heading, item string) string {
+	return AppendToSection(body, heading, "- "+strings.TrimSpace(item))
+}
+
+// sectionStarts returns the indexes of the "## " heading lines outside fenced
+// code blocks
+func sectionStarts(lines []string) []int {
+	var starts []int
+	fenced := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fenced = !fenced
+			continue
+		}
+		if !fenced && strings.HasPrefix(line, "## ") {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+func headingText(line string) string {
+	return strings.TrimSpace(strings.TrimPrefix(line, "## "))
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSections(t *testing.T) {
+	body := "Free text about the book.\n\n## Notes\n\n- Chapter 3 is key\n\n### Details\nmore\n\n## Links\n- https://go.dev"
+
+	preamble, sections := ParseSections(body)
+
+	assert.Equal(t, "Free text about the book.", preamble)
+	require.Len(t, sections, 2)
+	assert.Equal(t, "Notes", sections[0].Heading)
+	assert.Equal(t, "- Chapter 3 is key\n\n### Details\nmore", sections[0].Content)
+	assert.Equal(t, "Links", sections[1].Heading)
+
+	t.Run("ignores headings in code blocks", func(t *testing.T) {
+		preamble, sections := ParseSections("```\n## not a heading\n```")
+
+		assert.Equal(t, "```\n## not a heading\n```", preamble)
+		assert.Empty(t, sections)
+	})
+}
+
+func TestSectionItems(t *testing.T) {
+	body := "## Log\n\n- 2025-01-02: started\n* 2025-01-05: finished\n  continued line\n"
+
+	assert.Equal(t, []string{"2025-01-02: started", "2025-01-05: finished"}, SectionItems(body, "log"))
+	assert.Nil(t, SectionItems(body, SectionLinks))
+}
+
+func TestAppendToSection(t *testing.T) {
+	t.Run("creates the section in an empty body", func(t *testing.T) {
+		assert.Equal(t, "## Notes\n\n- first", AppendSectionItem("", SectionNotes, "first"))
+	})
+
+	t.Run("adds the section after free text", func(t *testing.T) {
+		body := AppendSectionItem("My own notes.\n", SectionNotes, "first")
+
+		assert.Equal(t, "My own notes.\n\n## Notes\n\n- first", body)
+	})
+
+	t.Run("appends to the end of an existing section", func(t *testing.T) {
+		body := "Intro\n\n## Notes\n\n- first\n\n## Links\n\n- https://go.dev"
+
+		body = AppendSectionItem(body, SectionNotes, "second")
+
+		assert.Equal(t, "Intro\n\n## Notes\n\n- first\n- second\n\n## Links\n\n- https://go.dev", body)
+	})
+
+	t.Run("fills an empty section", func(t *testing.T) {
+		body := AppendSectionItem("## Log\n## Links\n- x", SectionLog, "entry")
+
+		assert.Equal(t, "## Log\n\n- entry\n\n## Links\n- x", body)
+	})
+}
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Session represents a group learning session, such as a study group meeting
+// or a pairing session
+type Session struct {
+	ID        EntityID   `yaml:"id"`
+	Title     string     `yaml:"title"`
+	Date      time.Time  `yaml:"date"`
+	Hours     float64    `yaml:"hours,omitempty"` // length of the session
+	Attendees []string   `yaml:"attendees,omitempty"`
+	Topics    []string   `yaml:"topics,omitempty"`
+	Skills    []EntityID `yaml:"skills,omitempty"`
+	Resources []EntityID `yaml:"resources,omitempty"`
+	Tags      []string   `yaml:"tags,omitempty"`
+	Timestamps
+
+	// Body contains the session notes (agenda, discussion, action items)
+	Body string `yaml:"-"`
+}
+
+// NewSession creates a new Session
+func NewSession(id EntityID, title string, date time.Time) (*Session, error) {
+	session := &Session{
+		ID:         id,
+		Title:      title,
+		Date:       date,
+		Attendees:  []string{},
+		Topics:     []string{},
+		Skills:     []EntityID{},
+		Resources:  []EntityID{},
+		Tags:       []string{},
+		Timestamps: NewTimestamps(),
+	}
+
+	if err := session.Validate(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *Session) Validate() error {
+	if s.ID == "" {
+		return errors.New("session ID is required")
+	}
+
+	if strings.TrimSpace(s.Title) == "" {
+		return errors.New("session title is required and cannot be empty")
+	}
+
+	if s.Date.IsZero() {
+		return errors.New("session date is required")
+	}
+
+	if s.Hours < 0 {
+		return errors.New("session hours cannot be negative")
+	}
+
+	if s.Created.IsZero() {
+		return errors.New("session created timestamp is required")
+	}
+
+	if s.Updated.IsZero() {
+		return errors.New("session updated timestamp is required")
+	}
+
+	return nil
+}
+
+// SetHours sets the length of the session
+func (s *Session) SetHours(hours float64) error {
+	if hours < 0 {
+		return errors.New("session hours cannot be negative")
+	}
+	s.Hours = hours
+	s.Touch()
+	return nil
+}
+
+// AddAttendee adds a person to the session, ignoring duplicates regardless of case
+func (s *Session) AddAttendee(name string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+	for _, attendee := range s.Attendees {
+		if strings.EqualFold(attendee, name) {
+			return
+		}
+	}
+	s.Attendees = append(s.Attendees, name)
+	s.Touch()
+}
+
+// AddTopic adds a discussed topic to the session
+func (s *Session) AddTopic(topic string) {
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return
+	}
+	for _, t := range s.Topics {
+		if strings.EqualFold(t, topic) {
+			return
+		}
+	}
+	s.Topics = append(s.Topics, topic)
+	s.Touch()
+}
+
+// AddSkill links a skill practised in the session
+func (s *Session) AddSkill(skillID EntityID) {
+	for _, id := range s.Skills {
+		if id == skillID {
+			return
+		}
+	}
+	s.Skills = append(s.Skills, skillID)
+	s.Touch()
+}
+
+// AddResource links a resource studied in the session
+func (s *Session) AddResource(resourceID EntityID) {
+	for _, id := range s.Resources {
+		if id == resourceID {
+			return
+		}
+	}
+	s.Resources = append(s.Resources, resourceID)
+	s.Touch()
+}
+
+// HasReference returns true if the session is linked to the given skill or resource
+func (s *Session) HasReference(id EntityID) bool {
+	for _, ref := range s.Skills {
+		if ref == id {
+			return true
+		}
+	}
+	for _, ref := range s.Resources {
+		if ref == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag to the session (normalized to lowercase)
+func (s *Session) AddTag(tag string) {
+	normalizedTag := strings.ToLower(strings.TrimSpace(tag))
+	if normalizedTag == "" {
+		return
+	}
+
+	for _, t := range s.Tags {
+		if t == normalizedTag {
+			return
+		}
+	}
+	s.Tags = append(s.Tags, normalizedTag)
+	s.Touch()
+}
+
+// SessionStats summarizes group learning sessions
+type SessionStats struct {
+	Sessions  int
+	Hours     float64
+	Attendees int      // distinct people across sessions
+	TopTopics []string // most discussed topics, most frequent first
+}
+
+// ComputeSessionStats summarizes sessions dated at or after since; a zero
+// since includes every session. At most five top topics are returned.
+func ComputeSessionStats(sessions []*Session, since time.Time) SessionStats {
+	var stats SessionStats
+	attendees := make(map[string]bool)
+	topics := make(map[string]int)
+	names := make(map[string]string)
+
+	for _, session := range sessions {
+		if session.Date.Before(since) {
+			continue
+		}
+		stats.Sessions++
+		stats.Hours += session.Hours
+		for _, attendee := range session.Attendees {
+			attendees[strings.ToLower(attendee)] = true
+		}
+		for _, topic := range session.Topics {
+			key := strings.ToLower(topic)
+			if _, ok := names[key]; !ok {
+				names[key] = topic
+			}
+			topics[key]++
+		}
+	}
+
+	stats.Attendees = len(attendees)
+
+	keys := make([]string, 0, len(topics))
+	for key := range topics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if topics[keys[i]] != topics[keys[j]] {
+			return topics[keys[i]] > topics[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for i, key := range keys {
+		if i >= 5 {
+			break
+		}
+		stats.TopTopics = append(stats.TopTopics, names[key])
+	}
+
+	return stats
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSession(t *testing.T) {
+	date := time.Date(2025, 3, 19, 18, 0, 0, 0, time.UTC)
+
+	t.Run("creates valid session", func(t *testing.T) {
+		session, err := NewSession("session-001", "Go study group", date)
+
+		require.NoError(t, err)
+		assert.Equal(t, EntityID("session-001"), session.ID)
+		assert.Equal(t, "Go study group", session.Title)
+		assert.Equal(t, date, session.Date)
+		assert.Empty(t, session.Attendees)
+	})
+
+	t.Run("fails with empty ID", func(t *testing.T) {
+		_, err := NewSession("", "Go study group", date)
+		assert.ErrorContains(t, err, "ID is required")
+	})
+
+	t.Run("fails with empty title", func(t *testing.T) {
+		_, err := NewSession("session-001", "  ", date)
+		assert.ErrorContains(t, err, "title is required")
+	})
+
+	t.Run("fails with zero date", func(t *testing.T) {
+		_, err := NewSession("session-001", "Go study group", time.Time{})
+		assert.ErrorContains(t, err, "date is required")
+	})
+}
+
+func TestSession_Add(t *testing.T) {
+	session, _ := NewSession("session-001", "Go study group", time.Now())
+
+	t.Run("adds attendees once", func(t *testing.T) {
+		session.AddAttendee("Ana")
+		session.AddAttendee(" ana ")
+		session.AddAttendee("")
+		session.AddAttendee("Bo")
+
+		assert.Equal(t, []string{"Ana", "Bo"}, session.Attendees)
+	})
+
+	t.Run("adds topics once", func(t *testing.T) {
+		session.AddTopic("Generics")
+		session.AddTopic("generics")
+
+		assert.Equal(t, []string{"Generics"}, session.Topics)
+	})
+
+	t.Run("links skills and resources", func(t *testing.T) {
+		session.AddSkill("skill-001")
+		session.AddSkill("skill-001")
+		session.AddResource("resource-004")
+
+		assert.Equal(t, []EntityID{"skill-001"}, session.Skills)
+		assert.True(t, session.HasReference("skill-001"))
+		assert.True(t, session.HasReference("resource-004"))
+		assert.False(t, session.HasReference("skill-002"))
+	})
+
+	t.Run("rejects negative hours", func(t *testing.T) {
+		assert.Error(t, session.SetHours(-1))
+		require.NoError(t, session.SetHours(1.5))
+		assert.Equal(t, 1.5, session.Hours)
+	})
+}
+
+func TestComputeSessionStats(t *testing.T) {
+	newSession := func(id EntityID, date time.Time, hours float64, attendees, topics []string) *Session {
+		s, _ := NewSession(id, string(id), date)
+		s.Hours = hours
+		s.Attendees = attendees
+		s.Topics = topics
+		return s
+	}
+
+	sessions := []*Session{
+		newSession("session-001", time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), 2, []string{"Ana", "Bo"}, []string{"Generics", "Testing"}),
+		newSession("session-002", time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), 1.5, []string{"ana", "Cy"}, []string{"generics"}),
+		newSession("session-003", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 1, []string{"Dee"}, []string{"Concurrency"}),
+	}
+
+	t.Run("summarizes all sessions", func(t *testing.T) {
+		stats := ComputeSessionStats(sessions, time.Time{})
+
+		assert.Equal(t, 3, stats.Sessions)
+		assert.Equal(t, 4.5, stats.Hours)
+		assert.Equal(t, 4, stats.Attendees)
+		assert.Equal(t, []string{"Generics", "Concurrency", "Testing"}, stats.TopTopics)
+	})
+
+	t.Run("skips sessions before since", func(t *testing.T) {
+		stats := ComputeSessionStats(sessions, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 2, stats.Sessions)
+		assert.Equal(t, 2.5, stats.Hours)
+		assert.Equal(t, 3, stats.Attendees)
+	})
+}
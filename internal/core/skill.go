@@ -2,18 +2,30 @@ package core
 
 import (
 	"errors"
+	"strconv"
 	"strings"
+	"time"
+)
+
+// Self-reported confidence in a skill, separate from its proficiency level
+const (
+	MinConfidence = 1
+	MaxConfidence = 5
 )
 
 // Skill represents a technical or professional competency
 type Skill struct {
-	ID        EntityID         `yaml:"id"`
-	Title     string           `yaml:"title"`
-	Category  string           `yaml:"category"`
-	Level     ProficiencyLevel `yaml:"level"`
-	Status    SkillStatus      `yaml:"status"`
-	Resources []EntityID       `yaml:"resources,omitempty"`
-	Tags      []string         `yaml:"tags,omitempty"`
+	ID           EntityID         `yaml:"id"`
+	Title        string           `yaml:"title"`
+	Category     string           `yaml:"category"`
+	Level        ProficiencyLevel `yaml:"level"`
+	Status       SkillStatus      `yaml:"status"`
+	Confidence   int              `yaml:"confidence,omitempty"` // self-reported, 1 (shaky) to 5 (sure); 0 when not rated
+	Resources    []EntityID       `yaml:"resources,omitempty"`
+	TaxonomyCode string           `yaml:"taxonomyCode,omitempty"` // code of the matching taxonomy entry
+	Tags         []string         `yaml:"tags,omitempty"`
+	History      History          `yaml:"history,omitempty"`
+	Attachments  Attachments      `yaml:"attachments,omitempty"`
 	Timestamps
 
 	// Free-form notes, learning goals, projects, etc.
@@ -60,6 +72,10 @@ func (s *Skill) Validate() error {
 		return errors.New("invalid skill status: must be one of: not-started, learning, mastered")
 	}
 
+	if s.Confidence != 0 && (s.Confidence < MinConfidence || s.Confidence > MaxConfidence) {
+		return errors.New("invalid skill confidence: must be between 1 and 5")
+	}
+
 	if s.Created.IsZero() {
 		return errors.New("skill created timestamp is required")
 	}
@@ -110,6 +126,7 @@ func (s *Skill) UpdateLevel(level ProficiencyLevel) error {
 	if !level.IsValid() {
 		return errors.New("invalid proficiency level: must be one of: beginner, intermediate, advanced, expert")
 	}
+	s.History.Record("level", string(s.Level), string(level))
 	s.Level = level
 	s.Touch()
 	return nil
@@ -119,7 +136,95 @@ func (s *Skill) UpdateStatus(status SkillStatus) error {
 	if !status.IsValid() {
 		return errors.New("invalid skill status: must be one of: not-started, learning, mastered")
 	}
+	s.History.Record("status", string(s.Status), string(status))
 	s.Status = status
 	s.Touch()
 	return nil
 }
+
+// SetConfidence records a new self-reported confidence, keeping the previous
+// one in the history
+func (s *Skill) SetConfidence(confidence int) error {
+	if confidence < MinConfidence || confidence > MaxConfidence {
+		return errors.New("invalid confidence: must be between 1 and 5")
+	}
+	from := ""
+	if s.Confidence != 0 {
+		from = strconv.Itoa(s.Confidence)
+	}
+	s.History.Record("confidence", from, strconv.Itoa(confidence))
+	s.Confidence = confidence
+	s.Touch()
+	return nil
+}
+
+// NeedsReinforcement reports whether the skill is rated at an advanced or
+// expert level but with low confidence (2 or less)
+func (s *Skill) NeedsReinforcement() bool {
+	return s.Confidence != 0 && s.Confidence <= 2 && (s.Level == LevelAdvanced || s.Level == LevelExpert)
+}
+
+// ConfidenceRating is a confidence recorded at a point in time
+type ConfidenceRating struct {
+	Date       time.Time `yaml:"date" json:"date"`
+	Confidence int       `yaml:"confidence" json:"confidence"`
+}
+
+// ConfidenceTrend returns the confidence ratings from the history, oldest
+// first. A rating set before history was recorded is dated at the last update.
+func (s *Skill) ConfidenceTrend() []ConfidenceRating {
+	var trend []ConfidenceRating
+	for _, change := range s.History.ForField("confidence") {
+		if confidence, err := strconv.Atoi(change.To); err == nil {
+			trend = append(trend, ConfidenceRating{Date: change.Timestamp, Confidence: confidence})
+		}
+	}
+	if s.Confidence != 0 && (len(trend) == 0 || trend[len(trend)-1].Confidence != s.Confidence) {
+		trend = append(trend, ConfidenceRating{Date: s.Updated, Confidence: s.Confidence})
+	}
+	return trend
+}
+
+// StartLearning moves a not-started skill to learning and reports whether
+// its status changed
+func (s *Skill) StartLearning() bool {
+	if s.Status != SkillNotStarted {
+		return false
+	}
+	s.History.Record("status", string(s.Status), string(SkillLearning))
+	s.Status = SkillLearning
+	s.Touch()
+	return true
+}
+
+// ReadyForMastery reports whether a skill that is not mastered yet has work
+// linked to it and all of that work is done: each of its resources is
+// completed or abandoned and each of its milestones is achieved. Review and
+// archived milestones are left out.
+func (s *Skill) ReadyForMastery(resources []*Resource, milestones []*Milestone) bool {
+	if s.Status == SkillMastered {
+		return false
+	}
+
+	linked := 0
+	for _, resource := range resources {
+		if resource.SkillID != s.ID || resource.Status == ResourceAbandoned {
+			continue
+		}
+		if resource.Status != ResourceCompleted {
+			return false
+		}
+		linked++
+	}
+	for _, milestone := range milestones {
+		if milestone.ReferenceType != ReferenceSkill || milestone.ReferenceID != s.ID ||
+			milestone.IsReview() || milestone.Status == StatusArchived {
+			continue
+		}
+		if !milestone.IsAchieved() {
+			return false
+		}
+		linked++
+	}
+	return linked > 0
+}
@@ -117,6 +117,20 @@ func TestSkill_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid skill status",
 		},
+		{
+			name: "invalid confidence",
+			skill: &Skill{
+				ID:         "skill-001",
+				Title:      "Python",
+				Category:   "programming",
+				Level:      LevelIntermediate,
+				Status:     SkillLearning,
+				Confidence: 6,
+				Timestamps: NewTimestamps(),
+			},
+			wantErr: true,
+			errMsg:  "invalid skill confidence",
+		},
 	}
 
 	for _, tt := range tests {
@@ -238,3 +252,99 @@ func TestSkill_UpdateStatus(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid skill status")
 	})
 }
+
+func TestSkill_SetConfidence(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Python", "programming", LevelAdvanced)
+
+	t.Run("records confidence changes", func(t *testing.T) {
+		require.NoError(t, skill.SetConfidence(2))
+		require.NoError(t, skill.SetConfidence(4))
+
+		assert.Equal(t, 4, skill.Confidence)
+		require.Len(t, skill.History, 2)
+		assert.Equal(t, Change{Timestamp: skill.History[1].Timestamp, Field: "confidence", From: "2", To: "4"}, skill.History[1])
+
+		trend := skill.ConfidenceTrend()
+		require.Len(t, trend, 2)
+		assert.Equal(t, 2, trend[0].Confidence)
+		assert.Equal(t, 4, trend[1].Confidence)
+	})
+
+	t.Run("fails out of range", func(t *testing.T) {
+		err := skill.SetConfidence(0)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "between 1 and 5")
+	})
+
+	t.Run("dates a rating without history at the last update", func(t *testing.T) {
+		imported := &Skill{Confidence: 3, Timestamps: NewTimestamps()}
+
+		assert.Equal(t, []ConfidenceRating{{Date: imported.Updated, Confidence: 3}}, imported.ConfidenceTrend())
+		assert.Empty(t, (&Skill{}).ConfidenceTrend())
+	})
+}
+
+func TestSkill_NeedsReinforcement(t *testing.T) {
+	tests := []struct {
+		level      ProficiencyLevel
+		confidence int
+		want       bool
+	}{
+		{LevelExpert, 1, true},
+		{LevelAdvanced, 2, true},
+		{LevelAdvanced, 3, false},
+		{LevelAdvanced, 0, false},
+		{LevelIntermediate, 1, false},
+	}
+
+	for _, tt := range tests {
+		skill := &Skill{Level: tt.level, Confidence: tt.confidence}
+		assert.Equal(t, tt.want, skill.NeedsReinforcement(), "%s with confidence %d", tt.level, tt.confidence)
+	}
+}
+
+func TestSkill_StartLearning(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Python", "programming", LevelIntermediate)
+
+	assert.True(t, skill.StartLearning())
+	assert.Equal(t, SkillLearning, skill.Status)
+	require.Len(t, skill.History, 1)
+	assert.Equal(t, "learning", skill.History[0].To)
+
+	assert.False(t, skill.StartLearning(), "a skill that is already learning stays as it is")
+
+	skill.Status = SkillMastered
+	assert.False(t, skill.StartLearning())
+	assert.Equal(t, SkillMastered, skill.Status)
+}
+
+func TestSkill_ReadyForMastery(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Python", "programming", LevelIntermediate)
+	skill.Status = SkillLearning
+
+	book, _ := NewResource("resource-001", "Fluent Python", ResourceBook, skill.ID)
+	course, _ := NewResource("resource-002", "Python course", ResourceCourse, skill.ID)
+	other, _ := NewResource("resource-003", "Go book", ResourceBook, "skill-002")
+	milestone, _ := NewMilestone("milestone-001", "Ship a CLI", MilestoneSkillLevel, ReferenceSkill, skill.ID)
+
+	t.Run("false without linked work", func(t *testing.T) {
+		assert.False(t, skill.ReadyForMastery([]*Resource{other}, nil))
+	})
+
+	t.Run("false while work is open", func(t *testing.T) {
+		book.Complete()
+		assert.False(t, skill.ReadyForMastery([]*Resource{book, course}, []*Milestone{milestone}))
+	})
+
+	t.Run("true when everything is done", func(t *testing.T) {
+		course.Abandon("")
+		milestone.Achieve("")
+		assert.True(t, skill.ReadyForMastery([]*Resource{book, course, other}, []*Milestone{milestone}))
+	})
+
+	t.Run("false once mastered", func(t *testing.T) {
+		mastered := *skill
+		mastered.Status = SkillMastered
+		assert.False(t, mastered.ReadyForMastery([]*Resource{book}, []*Milestone{milestone}))
+	})
+}
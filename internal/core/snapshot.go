@@ -0,0 +1,195 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SnapshotSkill is a skill as it stood when a snapshot was taken
+type SnapshotSkill struct {
+	ID       EntityID         `yaml:"id"`
+	Title    string           `yaml:"title"`
+	Category string           `yaml:"category,omitempty"`
+	Level    ProficiencyLevel `yaml:"level"`
+	Status   SkillStatus      `yaml:"status"`
+}
+
+// SnapshotItem is a completed goal, path, resource or achieved milestone
+type SnapshotItem struct {
+	ID    EntityID `yaml:"id"`
+	Title string   `yaml:"title"`
+}
+
+// Snapshot is an immutable summary of the repository at the end of a review
+// period, e.g. 2025-H1
+type Snapshot struct {
+	ID                 EntityID        `yaml:"id"`
+	Title              string          `yaml:"title"` // review period name, e.g. 2025-H1
+	TakenAt            time.Time       `yaml:"takenAt"`
+	Skills             []SnapshotSkill `yaml:"skills,omitempty"`
+	CompletedGoals     []SnapshotItem  `yaml:"completedGoals,omitempty"`
+	CompletedPaths     []SnapshotItem  `yaml:"completedPaths,omitempty"`
+	CompletedResources []SnapshotItem  `yaml:"completedResources,omitempty"`
+	AchievedMilestones []SnapshotItem  `yaml:"achievedMilestones,omitempty"`
+	HoursInvested      float64         `yaml:"hoursInvested"`
+	Timestamps
+
+	// Body contains a human-readable summary generated when the snapshot is taken
+	Body string `yaml:"-"`
+}
+
+// SnapshotSource holds the entities captured in a snapshot
+type SnapshotSource struct {
+	Skills       []*Skill
+	Goals        []*Goal
+	Paths        []*LearningPath
+	Resources    []*Resource
+	Milestones   []*Milestone
+	ProgressLogs []*ProgressLog
+	Outputs      []*Output
+}
+
+// NewSnapshot captures the current state of src under a review period name
+func NewSnapshot(id EntityID, name string, takenAt time.Time, src SnapshotSource) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		ID:         id,
+		Title:      strings.TrimSpace(name),
+		TakenAt:    takenAt,
+		Timestamps: NewTimestamps(),
+	}
+
+	for _, s := range src.Skills {
+		snapshot.Skills = append(snapshot.Skills, SnapshotSkill{
+			ID: s.ID, Title: s.Title, Category: s.Category, Level: s.Level, Status: s.Status,
+		})
+	}
+	for _, g := range src.Goals {
+		if g.Status == StatusCompleted {
+			snapshot.CompletedGoals = append(snapshot.CompletedGoals, SnapshotItem{g.ID, g.Title})
+		}
+	}
+	for _, p := range src.Paths {
+		if p.Status == StatusCompleted {
+			snapshot.CompletedPaths = append(snapshot.CompletedPaths, SnapshotItem{p.ID, p.Title})
+		}
+	}
+	for _, r := range src.Resources {
+		if r.Status == ResourceCompleted {
+			snapshot.CompletedResources = append(snapshot.CompletedResources, SnapshotItem{r.ID, r.Title})
+		}
+	}
+	for _, m := range src.Milestones {
+		if m.IsAchieved() {
+			snapshot.AchievedMilestones = append(snapshot.AchievedMilestones, SnapshotItem{m.ID, m.Title})
+		}
+	}
+	for _, log := range src.ProgressLogs {
+		snapshot.HoursInvested += log.HoursInvested
+	}
+
+	sort.Slice(snapshot.Skills, func(i, j int) bool { return snapshot.Skills[i].ID < snapshot.Skills[j].ID })
+	sortSnapshotItems(snapshot.CompletedGoals)
+	sortSnapshotItems(snapshot.CompletedPaths)
+	sortSnapshotItems(snapshot.CompletedResources)
+	sortSnapshotItems(snapshot.AchievedMilestones)
+
+	if err := snapshot.Validate(); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (s *Snapshot) Validate() error {
+	if s.ID == "" {
+		return errors.New("snapshot ID is required")
+	}
+
+	if s.Title == "" {
+		return errors.New("snapshot name is required and cannot be empty")
+	}
+
+	if s.TakenAt.IsZero() {
+		return errors.New("snapshot time is required")
+	}
+
+	if s.Created.IsZero() {
+		return errors.New("snapshot created timestamp is required")
+	}
+
+	return nil
+}
+
+// SkillLevelChange describes how a skill moved between two snapshots
+type SkillLevelChange struct {
+	ID    EntityID         `yaml:"id" json:"id"`
+	Title string           `yaml:"title" json:"title"`
+	From  ProficiencyLevel `yaml:"from,omitempty" json:"from,omitempty"` // empty for skills added since
+	To    ProficiencyLevel `yaml:"to" json:"to"`
+}
+
+// SnapshotDiff is the growth between two snapshots
+type SnapshotDiff struct {
+	From               string             `yaml:"from" json:"from"`
+	To                 string             `yaml:"to" json:"to"`
+	LevelChanges       []SkillLevelChange `yaml:"levelChanges,omitempty" json:"levelChanges,omitempty"`
+	NewSkills          []SkillLevelChange `yaml:"newSkills,omitempty" json:"newSkills,omitempty"`
+	CompletedGoals     []SnapshotItem     `yaml:"completedGoals,omitempty" json:"completedGoals,omitempty"`
+	CompletedPaths     []SnapshotItem     `yaml:"completedPaths,omitempty" json:"completedPaths,omitempty"`
+	CompletedResources []SnapshotItem     `yaml:"completedResources,omitempty" json:"completedResources,omitempty"`
+	AchievedMilestones []SnapshotItem     `yaml:"achievedMilestones,omitempty" json:"achievedMilestones,omitempty"`
+	HoursInvested      float64            `yaml:"hoursInvested" json:"hoursInvested"`
+}
+
+// DiffSnapshots reports what changed from an earlier snapshot to a later one
+func DiffSnapshots(from, to *Snapshot) SnapshotDiff {
+	diff := SnapshotDiff{
+		From:          from.Title,
+		To:            to.Title,
+		HoursInvested: to.HoursInvested - from.HoursInvested,
+	}
+
+	before := make(map[EntityID]SnapshotSkill, len(from.Skills))
+	for _, s := range from.Skills {
+		before[s.ID] = s
+	}
+
+	for _, s := range to.Skills {
+		prev, existed := before[s.ID]
+		switch {
+		case !existed:
+			diff.NewSkills = append(diff.NewSkills, SkillLevelChange{ID: s.ID, Title: s.Title, To: s.Level})
+		case prev.Level != s.Level:
+			diff.LevelChanges = append(diff.LevelChanges, SkillLevelChange{ID: s.ID, Title: s.Title, From: prev.Level, To: s.Level})
+		}
+	}
+
+	diff.CompletedGoals = newSnapshotItems(from.CompletedGoals, to.CompletedGoals)
+	diff.CompletedPaths = newSnapshotItems(from.CompletedPaths, to.CompletedPaths)
+	diff.CompletedResources = newSnapshotItems(from.CompletedResources, to.CompletedResources)
+	diff.AchievedMilestones = newSnapshotItems(from.AchievedMilestones, to.AchievedMilestones)
+
+	return diff
+}
+
+// newSnapshotItems returns the items in after that are not in before
+func newSnapshotItems(before, after []SnapshotItem) []SnapshotItem {
+	seen := make(map[EntityID]bool, len(before))
+	for _, item := range before {
+		seen[item.ID] = true
+	}
+
+	var added []SnapshotItem
+	for _, item := range after {
+		if !seen[item.ID] {
+			added = append(added, item)
+		}
+	}
+	return added
+}
+
+func sortSnapshotItems(items []SnapshotItem) {
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	takenAt := time.Date(2025, 6, 30, 18, 0, 0, 0, time.UTC)
+
+	goSkill, _ := NewSkill("skill-002", "Go", "programming", LevelIntermediate)
+	k8s, _ := NewSkill("skill-001", "Kubernetes", "devops", LevelBeginner)
+
+	done, _ := NewGoal("goal-001", "Ship service", PriorityHigh)
+	done.UpdateStatus(StatusCompleted)
+	open, _ := NewGoal("goal-002", "Lead team", PriorityLow)
+
+	book, _ := NewResource("resource-001", "Go Book", ResourceBook, "skill-002")
+	book.Complete()
+
+	log, _ := NewProgressLog("progress-001", takenAt)
+	log.HoursInvested = 5
+
+	t.Run("captures state", func(t *testing.T) {
+		snapshot, err := NewSnapshot("snapshot-001", " 2025-H1 ", takenAt, SnapshotSource{
+			Skills:       []*Skill{goSkill, k8s},
+			Goals:        []*Goal{done, open},
+			Resources:    []*Resource{book},
+			ProgressLogs: []*ProgressLog{log},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "2025-H1", snapshot.Title)
+		require.Len(t, snapshot.Skills, 2)
+		assert.Equal(t, EntityID("skill-001"), snapshot.Skills[0].ID)
+		assert.Equal(t, []SnapshotItem{{"goal-001", "Ship service"}}, snapshot.CompletedGoals)
+		assert.Equal(t, []SnapshotItem{{"resource-001", "Go Book"}}, snapshot.CompletedResources)
+		assert.Empty(t, snapshot.CompletedPaths)
+		assert.Equal(t, 5.0, snapshot.HoursInvested)
+	})
+
+	t.Run("fails with empty name", func(t *testing.T) {
+		_, err := NewSnapshot("snapshot-001", "  ", takenAt, SnapshotSource{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "name is required")
+	})
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	from := &Snapshot{
+		Title: "2024-H2",
+		Skills: []SnapshotSkill{
+			{ID: "skill-001", Title: "Go", Level: LevelBeginner},
+			{ID: "skill-002", Title: "SQL", Level: LevelAdvanced},
+		},
+		CompletedGoals: []SnapshotItem{{"goal-001", "Old goal"}},
+		HoursInvested:  40,
+	}
+	to := &Snapshot{
+		Title: "2025-H1",
+		Skills: []SnapshotSkill{
+			{ID: "skill-001", Title: "Go", Level: LevelIntermediate},
+			{ID: "skill-002", Title: "SQL", Level: LevelAdvanced},
+			{ID: "skill-003", Title: "Rust", Level: LevelBeginner},
+		},
+		CompletedGoals:     []SnapshotItem{{"goal-001", "Old goal"}, {"goal-002", "New goal"}},
+		AchievedMilestones: []SnapshotItem{{"milestone-001", "First talk"}},
+		HoursInvested:      95.5,
+	}
+
+	diff := DiffSnapshots(from, to)
+
+	assert.Equal(t, "2024-H2", diff.From)
+	assert.Equal(t, "2025-H1", diff.To)
+	assert.Equal(t, []SkillLevelChange{{ID: "skill-001", Title: "Go", From: LevelBeginner, To: LevelIntermediate}}, diff.LevelChanges)
+	assert.Equal(t, []SkillLevelChange{{ID: "skill-003", Title: "Rust", To: LevelBeginner}}, diff.NewSkills)
+	assert.Equal(t, []SnapshotItem{{"goal-002", "New goal"}}, diff.CompletedGoals)
+	assert.Equal(t, []SnapshotItem{{"milestone-001", "First talk"}}, diff.AchievedMilestones)
+	assert.Empty(t, diff.CompletedResources)
+	assert.Equal(t, 55.5, diff.HoursInvested)
+}
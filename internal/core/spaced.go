@@ -0,0 +1,89 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ReviewIntervals are the spaced review intervals scheduled after mastering a skill
+var ReviewIntervals = []ReviewInterval{ReviewOneWeek, ReviewOneMonth, ReviewThreeMonths}
+
+// After returns when a review falls due after t
+func (r ReviewInterval) After(t time.Time) time.Time {
+	switch r {
+	case ReviewOneMonth:
+		return t.AddDate(0, 1, 0)
+	case ReviewThreeMonths:
+		return t.AddDate(0, 3, 0)
+	default:
+		return t.AddDate(0, 0, 7)
+	}
+}
+
+// Label describes the interval in words
+func (r ReviewInterval) Label() string {
+	switch r {
+	case ReviewOneMonth:
+		return "1 month"
+	case ReviewThreeMonths:
+		return "3 months"
+	default:
+		return "1 week"
+	}
+}
+
+// MasteredAt returns when the skill was last marked mastered, falling back to
+// the last update for skills mastered before history was recorded
+func (s *Skill) MasteredAt() time.Time {
+	if at, ok := s.History.LastChangeTo("status", string(SkillMastered)); ok {
+		return at
+	}
+	return s.Updated
+}
+
+// NewReviewMilestone creates a pending review milestone for a mastered skill,
+// due the given interval after masteredAt
+func NewReviewMilestone(id EntityID, skill *Skill, interval ReviewInterval, masteredAt time.Time) (*Milestone, error) {
+	if !interval.IsValid() {
+		return nil, errors.New("invalid review interval: must be one of: 1w, 1m, 3m")
+	}
+
+	title := fmt.Sprintf("Review %s (%s after mastery)", skill.Title, interval.Label())
+	milestone, err := NewMilestone(id, title, MilestoneSkillLevel, ReferenceSkill, skill.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := time.Date(masteredAt.Year(), masteredAt.Month(), masteredAt.Day(), 0, 0, 0, 0, masteredAt.Location())
+	milestone.ReviewInterval = interval
+	milestone.SetTargetDate(interval.After(day))
+	return milestone, nil
+}
+
+// IsReview returns true if the milestone is a spaced review of a skill
+func (m *Milestone) IsReview() bool {
+	return m.ReviewInterval != ""
+}
+
+// DueReviews returns the pending review milestones due on or before the day
+// containing now, oldest first
+func DueReviews(milestones []*Milestone, now time.Time) []*Milestone {
+	endOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+
+	var due []*Milestone
+	for _, m := range milestones {
+		if !m.IsReview() || m.Status != StatusActive || m.TargetDate == nil {
+			continue
+		}
+		if m.TargetDate.Before(endOfDay) {
+			due = append(due, m)
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].TargetDate.Before(*due[j].TargetDate)
+	})
+	return due
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewInterval_After(t *testing.T) {
+	mastered := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2025, 2, 7, 0, 0, 0, 0, time.UTC), ReviewOneWeek.After(mastered))
+	assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), ReviewOneMonth.After(mastered))
+	assert.Equal(t, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), ReviewThreeMonths.After(mastered))
+}
+
+func TestSkill_MasteredAt(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Go", "programming", LevelAdvanced)
+	skill.Updated = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, skill.Updated, skill.MasteredAt())
+
+	require.NoError(t, skill.UpdateStatus(SkillMastered))
+	at := skill.MasteredAt()
+	assert.Equal(t, skill.History[len(skill.History)-1].Timestamp, at)
+}
+
+func TestNewReviewMilestone(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Go", "programming", LevelAdvanced)
+	mastered := time.Date(2025, 7, 7, 18, 30, 0, 0, time.UTC)
+
+	milestone, err := NewReviewMilestone("milestone-004", skill, ReviewOneMonth, mastered)
+	require.NoError(t, err)
+	assert.Equal(t, "Review Go (1 month after mastery)", milestone.Title)
+	assert.Equal(t, MilestoneSkillLevel, milestone.Type)
+	assert.Equal(t, ReferenceSkill, milestone.ReferenceType)
+	assert.Equal(t, EntityID("skill-001"), milestone.ReferenceID)
+	assert.Equal(t, ReviewOneMonth, milestone.ReviewInterval)
+	assert.True(t, milestone.IsReview())
+	require.NotNil(t, milestone.TargetDate)
+	assert.Equal(t, time.Date(2025, 8, 7, 0, 0, 0, 0, time.UTC), *milestone.TargetDate)
+
+	_, err = NewReviewMilestone("milestone-005", skill, ReviewInterval("2y"), mastered)
+	assert.Error(t, err)
+
+	milestone.ReviewInterval = "2y"
+	assert.Error(t, milestone.Validate())
+}
+
+func TestDueReviews(t *testing.T) {
+	skill, _ := NewSkill("skill-001", "Go", "programming", LevelAdvanced)
+	mastered := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	week, _ := NewReviewMilestone("milestone-001", skill, ReviewOneWeek, mastered)
+	month, _ := NewReviewMilestone("milestone-002", skill, ReviewOneMonth, mastered)
+	done, _ := NewReviewMilestone("milestone-003", skill, ReviewOneWeek, mastered.AddDate(0, 0, -7))
+	done.Achieve("")
+	plain, _ := NewMilestone("milestone-004", "Ship it", MilestoneSkillLevel, ReferenceSkill, "skill-001")
+	plain.SetTargetDate(mastered)
+
+	milestones := []*Milestone{month, plain, done, week}
+
+	assert.Empty(t, DueReviews(milestones, time.Date(2025, 7, 7, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []*Milestone{week}, DueReviews(milestones, time.Date(2025, 7, 8, 9, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []*Milestone{week, month}, DueReviews(milestones, time.Date(2025, 8, 2, 9, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TaxonomyEntry is a skill definition from a standard taxonomy such as SFIA or ESCO
+type TaxonomyEntry struct {
+	Code        string `yaml:"code"`
+	Title       string `yaml:"title"`
+	Category    string `yaml:"category,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// Taxonomy is an imported skills taxonomy used for consistent categories and
+// gap analysis
+type Taxonomy struct {
+	Name     string          `yaml:"name"`
+	Source   string          `yaml:"source,omitempty"` // file the taxonomy was imported from
+	Imported time.Time       `yaml:"imported"`
+	Entries  []TaxonomyEntry `yaml:"entries"`
+}
+
+// Find looks an entry up by code, falling back to its title (both case-insensitive)
+func (t *Taxonomy) Find(ref string) *TaxonomyEntry {
+	ref = strings.TrimSpace(ref)
+	for i := range t.Entries {
+		if strings.EqualFold(t.Entries[i].Code, ref) {
+			return &t.Entries[i]
+		}
+	}
+	for i := range t.Entries {
+		if strings.EqualFold(t.Entries[i].Title, ref) {
+			return &t.Entries[i]
+		}
+	}
+	return nil
+}
+
+// Categories returns the distinct categories in the taxonomy, sorted
+func (t *Taxonomy) Categories() []string {
+	seen := make(map[string]bool)
+	var categories []string
+	for _, e := range t.Entries {
+		if e.Category != "" && !seen[e.Category] {
+			seen[e.Category] = true
+			categories = append(categories, e.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// TaxonomyGaps returns the entries not yet tracked by any skill, matched by
+// taxonomy code and falling back to a case-insensitive title match
+func TaxonomyGaps(t *Taxonomy, skills []*Skill) []TaxonomyEntry {
+	codes := make(map[string]bool)
+	titles := make(map[string]bool)
+	for _, s := range skills {
+		if s.TaxonomyCode != "" {
+			codes[strings.ToLower(s.TaxonomyCode)] = true
+		}
+		titles[strings.ToLower(s.Title)] = true
+	}
+
+	var gaps []TaxonomyEntry
+	for _, e := range t.Entries {
+		if !codes[strings.ToLower(e.Code)] && !titles[strings.ToLower(e.Title)] {
+			gaps = append(gaps, e)
+		}
+	}
+	return gaps
+}
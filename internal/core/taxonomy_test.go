@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTaxonomy() *Taxonomy {
+	return &Taxonomy{
+		Name: "SFIA 8",
+		Entries: []TaxonomyEntry{
+			{Code: "PROG", Title: "Programming/software development", Category: "Development and implementation"},
+			{Code: "DBAD", Title: "Database administration", Category: "Delivery and operation"},
+			{Code: "ARCH", Title: "Solution architecture", Category: "Change and transformation"},
+			{Code: "TEST", Title: "Functional testing", Category: "Development and implementation"},
+		},
+	}
+}
+
+func TestTaxonomy_Find(t *testing.T) {
+	taxonomy := testTaxonomy()
+
+	t.Run("finds by code", func(t *testing.T) {
+		entry := taxonomy.Find("prog")
+		require.NotNil(t, entry)
+		assert.Equal(t, "Programming/software development", entry.Title)
+	})
+
+	t.Run("finds by title", func(t *testing.T) {
+		entry := taxonomy.Find("database administration")
+		require.NotNil(t, entry)
+		assert.Equal(t, "DBAD", entry.Code)
+	})
+
+	t.Run("returns nil when missing", func(t *testing.T) {
+		assert.Nil(t, taxonomy.Find("NOPE"))
+	})
+}
+
+func TestTaxonomy_Categories(t *testing.T) {
+	assert.Equal(t, []string{
+		"Change and transformation",
+		"Delivery and operation",
+		"Development and implementation",
+	}, testTaxonomy().Categories())
+}
+
+func TestTaxonomyGaps(t *testing.T) {
+	byCode, _ := NewSkill("skill-001", "Go", "programming", LevelAdvanced)
+	byCode.TaxonomyCode = "PROG"
+	byTitle, _ := NewSkill("skill-002", "Solution Architecture", "design", LevelBeginner)
+
+	gaps := TaxonomyGaps(testTaxonomy(), []*Skill{byCode, byTitle})
+
+	require.Len(t, gaps, 2)
+	assert.Equal(t, "DBAD", gaps[0].Code)
+	assert.Equal(t, "TEST", gaps[1].Code)
+}
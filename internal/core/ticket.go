@@ -0,0 +1,72 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ticketKey = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[1-9][0-9]*$`)
+
+// Ticket is an issue in an external tracker, like JIRA-123, that evidences
+// work on a goal or milestone. Its status is polled from the tracker.
+type Ticket struct {
+	Key     string     `yaml:"key"`
+	Status  string     `yaml:"status,omitempty"`  // status name in the tracker, e.g. In Review
+	Done    bool       `yaml:"done,omitempty"`    // whether the status counts as done
+	Checked *time.Time `yaml:"checked,omitempty"` // when the status was last polled
+}
+
+// Tickets lists the tickets linked to an entity, stored in frontmatter
+type Tickets []Ticket
+
+// ParseTicketKey validates an issue key like JIRA-123, uppercasing it
+func ParseTicketKey(key string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(key))
+	if !ticketKey.MatchString(normalized) {
+		return "", fmt.Errorf("invalid ticket key '%s': must look like PROJ-123", key)
+	}
+	return normalized, nil
+}
+
+// Link adds a ticket unless it is already linked, reporting whether it was
+// added
+func (t *Tickets) Link(key string) bool {
+	if _, ok := t.Find(key); ok {
+		return false
+	}
+	*t = append(*t, Ticket{Key: key})
+	return true
+}
+
+// Unlink removes a ticket, reporting whether it was linked
+func (t *Tickets) Unlink(key string) bool {
+	for i, ticket := range *t {
+		if ticket.Key == key {
+			*t = append((*t)[:i], (*t)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the linked ticket with the key
+func (t Tickets) Find(key string) (Ticket, bool) {
+	for _, ticket := range t {
+		if ticket.Key == key {
+			return ticket, true
+		}
+	}
+	return Ticket{}, false
+}
+
+// AllDone reports whether there are tickets and all of them are done
+func (t Tickets) AllDone() bool {
+	for _, ticket := range t {
+		if !ticket.Done {
+			return false
+		}
+	}
+	return len(t) > 0
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTicketKey(t *testing.T) {
+	key, err := ParseTicketKey(" jira-123 ")
+	require.NoError(t, err)
+	assert.Equal(t, "JIRA-123", key)
+
+	key, err = ParseTicketKey("PLAT2_OPS-7")
+	require.NoError(t, err)
+	assert.Equal(t, "PLAT2_OPS-7", key)
+
+	for _, invalid := range []string{"", "123", "JIRA", "JIRA-", "JIRA-0", "2FA-1", "JIRA 123"} {
+		_, err := ParseTicketKey(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestTickets(t *testing.T) {
+	var tickets Tickets
+	assert.False(t, tickets.AllDone())
+
+	assert.True(t, tickets.Link("JIRA-1"))
+	assert.True(t, tickets.Link("JIRA-2"))
+	assert.False(t, tickets.Link("JIRA-1"))
+	assert.Len(t, tickets, 2)
+	assert.False(t, tickets.AllDone())
+
+	tickets[0].Done = true
+	assert.True(t, tickets.Unlink("JIRA-2"))
+	assert.False(t, tickets.Unlink("JIRA-2"))
+	assert.True(t, tickets.AllDone())
+
+	ticket, ok := tickets.Find("JIRA-1")
+	assert.True(t, ok)
+	assert.True(t, ticket.Done)
+}
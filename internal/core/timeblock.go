@@ -0,0 +1,132 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// blockRounding is the granularity of planned study blocks
+const blockRounding = 15 * time.Minute
+
+// TimeBlock is a planned study session for a single resource
+type TimeBlock struct {
+	Resource *Resource
+	Start    time.Time
+	End      time.Time
+}
+
+// Hours returns the length of the block in hours
+func (b TimeBlock) Hours() float64 {
+	return b.End.Sub(b.Start).Hours()
+}
+
+// StudyWindow describes when study blocks may be placed during a week
+type StudyWindow struct {
+	Days     []time.Weekday
+	DayStart time.Duration // offset from midnight of the first block each day
+}
+
+// ParseStudyWindow parses weekday names and a HH:MM start time. Study days
+// default to Monday through Friday and the start time to 19:00.
+func ParseStudyWindow(days []string, start string) (StudyWindow, error) {
+	window := StudyWindow{DayStart: 19 * time.Hour}
+
+	if len(days) == 0 {
+		days = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+	}
+	for _, name := range days {
+		day, ok := ParseWeekday(name)
+		if !ok {
+			return StudyWindow{}, fmt.Errorf("invalid study day '%s'", name)
+		}
+		window.Days = append(window.Days, day)
+	}
+
+	if start != "" {
+		parsed, err := time.Parse("15:04", start)
+		if err != nil {
+			return StudyWindow{}, fmt.Errorf("invalid start time '%s' (use HH:MM)", start)
+		}
+		window.DayStart = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	}
+
+	return window, nil
+}
+
+// ParseWeekday parses a full or three-letter English weekday name, ignoring case
+func ParseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// PlanTimeBlocks spreads hoursPerWeek evenly over the study days of the week
+// starting at weekStart, filling each day with the resources in order. Each
+// resource gets its remaining estimate, or DefaultResourceHours when it has
+// none, so a long resource continues on the following days. Days whose study
+// time starts before from are skipped, so a plan made mid-week only fills the
+// days left.
+func PlanTimeBlocks(resources []*Resource, hoursPerWeek float64, weekStart, from time.Time, window StudyWindow) []TimeBlock {
+	if hoursPerWeek <= 0 {
+		hoursPerWeek = DefaultHoursPerWeek
+	}
+	if len(window.Days) == 0 || len(resources) == 0 {
+		return nil
+	}
+
+	daily := (time.Duration(hoursPerWeek*float64(time.Hour)) / time.Duration(len(window.Days))).Round(blockRounding)
+	if daily <= 0 {
+		daily = blockRounding
+	}
+
+	days := make(map[time.Weekday]bool)
+	for _, day := range window.Days {
+		days[day] = true
+	}
+
+	remaining := make([]time.Duration, len(resources))
+	for i, resource := range resources {
+		hours := resource.RemainingHours()
+		if resource.EstimatedHours <= 0 {
+			hours = DefaultResourceHours
+		}
+		remaining[i] = time.Duration(hours * float64(time.Hour)).Round(blockRounding)
+		if remaining[i] <= 0 {
+			remaining[i] = blockRounding
+		}
+	}
+
+	var blocks []TimeBlock
+	current := 0
+	for offset := 0; offset < 7 && current < len(resources); offset++ {
+		day := weekStart.AddDate(0, 0, offset)
+		cursor := day.Add(window.DayStart)
+		if !days[day.Weekday()] || cursor.Before(from) {
+			continue
+		}
+
+		left := daily
+		for left > 0 && current < len(resources) {
+			length := min(left, remaining[current])
+			blocks = append(blocks, TimeBlock{
+				Resource: resources[current],
+				Start:    cursor,
+				End:      cursor.Add(length),
+			})
+			cursor = cursor.Add(length)
+			left -= length
+			remaining[current] -= length
+			if remaining[current] <= 0 {
+				current++
+			}
+		}
+	}
+
+	return blocks
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanTimeBlocks(t *testing.T) {
+	monday := time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC)
+	window := StudyWindow{
+		Days:     []time.Weekday{time.Monday, time.Wednesday, time.Friday},
+		DayStart: 19 * time.Hour,
+	}
+
+	course, _ := NewResource("resource-001", "Course", ResourceCourse, "skill-001")
+	course.EstimatedHours = 3
+	book, _ := NewResource("resource-002", "Book", ResourceBook, "skill-001")
+	book.EstimatedHours = 10
+	video, _ := NewResource("resource-003", "Talk", ResourceVideo, "skill-001")
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 7, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	t.Run("fills study days in queue order", func(t *testing.T) {
+		blocks := PlanTimeBlocks([]*Resource{course, book}, 6, monday, monday, window)
+
+		require.Len(t, blocks, 4)
+		assert.Equal(t, course, blocks[0].Resource)
+		assert.Equal(t, at(7, 19, 0), blocks[0].Start)
+		assert.Equal(t, at(7, 21, 0), blocks[0].End)
+		assert.Equal(t, course, blocks[1].Resource)
+		assert.Equal(t, at(9, 19, 0), blocks[1].Start)
+		assert.Equal(t, 1.0, blocks[1].Hours())
+		assert.Equal(t, book, blocks[2].Resource)
+		assert.Equal(t, at(9, 20, 0), blocks[2].Start)
+		assert.Equal(t, at(9, 21, 0), blocks[2].End)
+		assert.Equal(t, at(11, 19, 0), blocks[3].Start)
+		assert.Equal(t, 2.0, blocks[3].Hours())
+	})
+
+	t.Run("uses default hours for unestimated resources", func(t *testing.T) {
+		blocks := PlanTimeBlocks([]*Resource{video, course}, 9, monday, monday, window)
+
+		require.Len(t, blocks, 3)
+		assert.Equal(t, video, blocks[0].Resource)
+		assert.Equal(t, DefaultResourceHours, blocks[0].Hours())
+		assert.Equal(t, course, blocks[1].Resource)
+		assert.Equal(t, 1.0, blocks[1].Hours())
+		assert.Equal(t, at(9, 19, 0), blocks[2].Start)
+	})
+
+	t.Run("skips days that have started", func(t *testing.T) {
+		blocks := PlanTimeBlocks([]*Resource{book}, 6, monday, at(9, 19, 30), window)
+
+		require.Len(t, blocks, 1)
+		assert.Equal(t, at(11, 19, 0), blocks[0].Start)
+	})
+
+	t.Run("rounds daily time to quarter hours", func(t *testing.T) {
+		blocks := PlanTimeBlocks([]*Resource{book}, 5, monday, monday, window)
+
+		require.Len(t, blocks, 3)
+		assert.Equal(t, 1.75, blocks[0].Hours())
+	})
+
+	t.Run("returns nothing without study days", func(t *testing.T) {
+		assert.Empty(t, PlanTimeBlocks([]*Resource{book}, 6, monday, monday, StudyWindow{}))
+	})
+}
+
+func TestParseStudyWindow(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		window, err := ParseStudyWindow(nil, "")
+
+		require.NoError(t, err)
+		assert.Len(t, window.Days, 5)
+		assert.Equal(t, time.Monday, window.Days[0])
+		assert.Equal(t, 19*time.Hour, window.DayStart)
+	})
+
+	t.Run("parses days and start time", func(t *testing.T) {
+		window, err := ParseStudyWindow([]string{"Sat", "sunday"}, "08:30")
+
+		require.NoError(t, err)
+		assert.Equal(t, []time.Weekday{time.Saturday, time.Sunday}, window.Days)
+		assert.Equal(t, 8*time.Hour+30*time.Minute, window.DayStart)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		_, err := ParseStudyWindow([]string{"someday"}, "")
+		assert.ErrorContains(t, err, "invalid study day")
+
+		_, err = ParseStudyWindow(nil, "7pm")
+		assert.ErrorContains(t, err, "invalid start time")
+	})
+}
@@ -12,6 +12,8 @@ const (
 	StatusActive    Status = "active"
 	StatusCompleted Status = "completed"
 	StatusArchived  Status = "archived"
+	StatusAbandoned Status = "abandoned" // learning paths only
+	StatusBlocked   Status = "blocked"   // goals only
 )
 
 func (s Status) IsValid() bool {
@@ -22,6 +24,18 @@ func (s Status) IsValid() bool {
 	return false
 }
 
+// IsValidForPath reports whether the status can be used on a learning path,
+// which additionally allows abandoned
+func (s Status) IsValidForPath() bool {
+	return s.IsValid() || s == StatusAbandoned
+}
+
+// IsValidForGoal reports whether the status can be used on a goal,
+// which additionally allows blocked
+func (s Status) IsValidForGoal() bool {
+	return s.IsValid() || s == StatusBlocked
+}
+
 // Priority represents the priority level of goals
 type Priority string
 
@@ -39,6 +53,24 @@ func (p Priority) IsValid() bool {
 	return false
 }
 
+// EnergyLevel rates how demanding a resource or phase is, or how much energy
+// was available in a week
+type EnergyLevel string
+
+const (
+	EnergyLow    EnergyLevel = "low"
+	EnergyMedium EnergyLevel = "medium"
+	EnergyHigh   EnergyLevel = "high"
+)
+
+func (e EnergyLevel) IsValid() bool {
+	switch e {
+	case EnergyLow, EnergyMedium, EnergyHigh:
+		return true
+	}
+	return false
+}
+
 // ProficiencyLevel represents skill proficiency levels
 type ProficiencyLevel string
 
@@ -101,11 +133,12 @@ const (
 	ResourceNotStarted ResourceStatus = "not-started"
 	ResourceInProgress ResourceStatus = "in-progress"
 	ResourceCompleted  ResourceStatus = "completed"
+	ResourceAbandoned  ResourceStatus = "abandoned"
 )
 
 func (r ResourceStatus) IsValid() bool {
 	switch r {
-	case ResourceNotStarted, ResourceInProgress, ResourceCompleted:
+	case ResourceNotStarted, ResourceInProgress, ResourceCompleted, ResourceAbandoned:
 		return true
 	}
 	return false
@@ -144,6 +177,90 @@ func (m MilestoneType) IsValid() bool {
 	return false
 }
 
+// Recurrence represents how often a recurring milestone repeats
+type Recurrence string
+
+const (
+	RecurrenceDaily   Recurrence = "daily"
+	RecurrenceWeekly  Recurrence = "weekly"
+	RecurrenceMonthly Recurrence = "monthly"
+)
+
+func (r Recurrence) IsValid() bool {
+	switch r {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+// OutputType represents a kind of work given back to the community
+type OutputType string
+
+const (
+	OutputTalk         OutputType = "talk"
+	OutputBlogPost     OutputType = "blog-post"
+	OutputContribution OutputType = "oss-contribution"
+)
+
+func (o OutputType) IsValid() bool {
+	switch o {
+	case OutputTalk, OutputBlogPost, OutputContribution:
+		return true
+	}
+	return false
+}
+
+// MentorRole is your side of a mentoring session
+type MentorRole string
+
+const (
+	RoleMentor MentorRole = "mentor" // you mentored the other person
+	RoleMentee MentorRole = "mentee" // the other person mentored you
+)
+
+func (r MentorRole) IsValid() bool {
+	switch r {
+	case RoleMentor, RoleMentee:
+		return true
+	}
+	return false
+}
+
+// FeedbackRelationship is how the giver of feedback works with you
+type FeedbackRelationship string
+
+const (
+	FeedbackPeer    FeedbackRelationship = "peer"
+	FeedbackManager FeedbackRelationship = "manager"
+	FeedbackReport  FeedbackRelationship = "report" // someone who reports to you
+)
+
+func (r FeedbackRelationship) IsValid() bool {
+	switch r {
+	case FeedbackPeer, FeedbackManager, FeedbackReport:
+		return true
+	}
+	return false
+}
+
+// ReviewInterval is how long after mastering a skill a spaced review falls due
+type ReviewInterval string
+
+const (
+	ReviewOneWeek     ReviewInterval = "1w"
+	ReviewOneMonth    ReviewInterval = "1m"
+	ReviewThreeMonths ReviewInterval = "3m"
+)
+
+func (r ReviewInterval) IsValid() bool {
+	switch r {
+	case ReviewOneWeek, ReviewOneMonth, ReviewThreeMonths:
+		return true
+	}
+	return false
+}
+
 // ReferenceType represents the type of entity being referenced
 type ReferenceType string
 
@@ -161,6 +278,22 @@ func (r ReferenceType) IsValid() bool {
 	return false
 }
 
+// CompletionCriterion is a condition that, once met, means a goal is done
+type CompletionCriterion string
+
+const (
+	CriterionMilestones CompletionCriterion = "milestones" // all of the goal's milestones are achieved
+	CriterionPaths      CompletionCriterion = "paths"      // all of the goal's learning paths are completed
+)
+
+func (c CompletionCriterion) IsValid() bool {
+	switch c {
+	case CriterionMilestones, CriterionPaths:
+		return true
+	}
+	return false
+}
+
 type Timestamps struct {
 	Created time.Time `yaml:"created"`
 	Updated time.Time `yaml:"updated"`
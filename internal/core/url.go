@@ -0,0 +1,73 @@
+package core
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// trackingParams are query parameters that never change what a URL points to
+var trackingParams = map[string]bool{
+	"fbclid": true,
+	"gclid":  true,
+	"mc_cid": true,
+	"mc_eid": true,
+	"ref":    true,
+}
+
+// NormalizeURL returns a canonical form of a URL for duplicate detection.
+// Scheme and host are lowercased, "www." and default ports are dropped,
+// fragments, trailing slashes, and tracking parameters (utm_*, fbclid, ...)
+// are removed, and remaining query parameters are sorted.
+// Unparseable input is returned trimmed and lowercased.
+func NormalizeURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return strings.ToLower(raw)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") || trackingParams[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(host)
+	b.WriteString(strings.TrimRight(u.EscapedPath(), "/"))
+	for i, key := range keys {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		values := query[key]
+		sort.Strings(values)
+		for j, value := range values {
+			if j > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(url.QueryEscape(key) + "=" + url.QueryEscape(value))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{"scheme and case", "http://Example.com/Course", "https://example.com/Course"},
+		{"www prefix", "https://www.udemy.com/course/go", "https://udemy.com/course/go"},
+		{"trailing slash", "https://example.com/course/", "https://example.com/course"},
+		{"fragment", "https://example.com/docs#install", "https://example.com/docs"},
+		{"tracking params", "https://example.com/c?utm_source=x&id=1&fbclid=abc", "https://example.com/c?id=1"},
+		{"query order", "https://example.com/watch?v=1&t=30", "https://example.com/watch?t=30&v=1"},
+		{"default port", "https://example.com:443/a", "https://example.com/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, NormalizeURL(tt.a), NormalizeURL(tt.b))
+		})
+	}
+
+	t.Run("keeps meaningful differences", func(t *testing.T) {
+		assert.NotEqual(t, NormalizeURL("https://youtube.com/watch?v=1"), NormalizeURL("https://youtube.com/watch?v=2"))
+		assert.NotEqual(t, NormalizeURL("https://example.com/a"), NormalizeURL("https://example.com/b"))
+		assert.NotEqual(t, NormalizeURL("https://example.com:8080/a"), NormalizeURL("https://example.com/a"))
+	})
+
+	t.Run("handles empty and non-URL input", func(t *testing.T) {
+		assert.Equal(t, "", NormalizeURL("  "))
+		assert.Equal(t, "not a url", NormalizeURL("Not a URL"))
+	})
+}
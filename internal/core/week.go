@@ -0,0 +1,32 @@
+package core
+
+import "time"
+
+// weekStart is the first day of the week, progress.weekStartDay in config.yml
+var weekStart = time.Monday
+
+// SetWeekStart sets the day weeks start on for StartOfWeek and everything
+// grouped by week, such as digests, streaks and weekly recurrences
+func SetWeekStart(day time.Weekday) {
+	weekStart = day
+}
+
+// StartOfWeek returns midnight, in t's location, on the first day of the
+// week containing t
+func StartOfWeek(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// CalendarDate returns the day t falls on as midnight UTC, so days can be
+// compared whatever location they were recorded in. Dates stored without a
+// time of day, like progress log dates, are midnight in their own location
+// and keep their day; other times are converted to local time first, so
+// something completed late on Sunday evening counts for Sunday.
+func CalendarDate(t time.Time) time.Time {
+	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 || t.Nanosecond() != 0 {
+		t = t.Local()
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
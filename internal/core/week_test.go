@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartOfWeek(t *testing.T) {
+	monday := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"monday", time.Date(2025, 1, 6, 15, 30, 0, 0, time.UTC)},
+		{"wednesday", time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)},
+		{"sunday", time.Date(2025, 1, 12, 23, 59, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, monday, StartOfWeek(tt.date))
+		})
+	}
+}
+
+func TestStartOfWeek_ConfiguredStart(t *testing.T) {
+	t.Cleanup(func() { SetWeekStart(time.Monday) })
+	wednesday := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	SetWeekStart(time.Sunday)
+	assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), StartOfWeek(wednesday))
+	assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), StartOfWeek(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)))
+
+	SetWeekStart(time.Saturday)
+	assert.Equal(t, time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC), StartOfWeek(wednesday))
+	assert.Equal(t, time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC), StartOfWeek(time.Date(2025, 1, 11, 8, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendarDate(t *testing.T) {
+	kyiv := time.FixedZone("EET", 2*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+	local := time.Local
+	time.Local = newYork
+	t.Cleanup(func() { time.Local = local })
+
+	want := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	// Dates without a time of day keep their day in any location
+	assert.Equal(t, want, CalendarDate(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, want, CalendarDate(time.Date(2025, 1, 6, 0, 0, 0, 0, kyiv)))
+
+	// Other times fall on their local day
+	assert.Equal(t, want, CalendarDate(time.Date(2025, 1, 7, 3, 0, 0, 0, time.UTC)))
+	assert.Equal(t, want.AddDate(0, 0, 1), CalendarDate(time.Date(2025, 1, 7, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestWeeklyDigest_Contains(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("EST", -5*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	// A week computed from local time still contains logs dated in UTC
+	digest := ComputeWeeklyDigest(time.Date(2025, 1, 8, 12, 0, 0, 0, time.Local), nil, nil, nil)
+
+	assert.True(t, digest.Contains(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, digest.Contains(time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, digest.Contains(time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, digest.Contains(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)))
+	// Sunday evening in New York is already Monday in UTC
+	assert.True(t, digest.Contains(time.Date(2025, 1, 13, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestWeeklyProgressStreak_MixedLocations(t *testing.T) {
+	kyiv := time.FixedZone("EET", 2*60*60)
+	logs := []*ProgressLog{
+		digestLog(t, "progress-001", time.Date(2024, 12, 30, 0, 0, 0, 0, kyiv), 1),
+		digestLog(t, "progress-002", time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), 1),
+	}
+
+	assert.Equal(t, 2, WeeklyProgressStreak(logs, time.Date(2025, 1, 8, 0, 0, 0, 0, kyiv)))
+}
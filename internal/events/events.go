@@ -0,0 +1,167 @@
+// Package events records domain events to an append-only JSON Lines log.
+//
+// Each line of the log is a single JSON-encoded Event, so the file can be
+// tailed or parsed by hooks and notification scripts without using growth.
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// Type identifies what happened
+type Type string
+
+const (
+	EntityCreated     Type = "entity.created"
+	EntityDeleted     Type = "entity.deleted"
+	StatusChanged     Type = "status.changed"
+	FieldChanged      Type = "field.changed"
+	GoalCompleted     Type = "goal.completed"
+	PathCompleted     Type = "path.completed"
+	ResourceCompleted Type = "resource.completed"
+	MilestoneAchieved Type = "milestone.achieved"
+)
+
+// Event is a single entry in the activity log
+type Event struct {
+	Time       time.Time     `json:"time"`
+	Type       Type          `json:"type"`
+	EntityType string        `json:"entityType"`
+	EntityID   core.EntityID `json:"entityId"`
+	Title      string        `json:"title,omitempty"`
+	Field      string        `json:"field,omitempty"`
+	From       string        `json:"from,omitempty"`
+	To         string        `json:"to,omitempty"`
+}
+
+// ForChange builds the event for a recorded field change, using a specific
+// completion type where one exists
+func ForChange(entityType string, id core.EntityID, title string, change core.Change) Event {
+	event := Event{
+		Time:       change.Timestamp,
+		Type:       FieldChanged,
+		EntityType: entityType,
+		EntityID:   id,
+		Title:      title,
+		Field:      change.Field,
+		From:       change.From,
+		To:         change.To,
+	}
+
+	if change.Field != "status" {
+		return event
+	}
+
+	event.Type = StatusChanged
+	if change.To == "completed" {
+		switch entityType {
+		case "goal":
+			event.Type = GoalCompleted
+		case "path":
+			event.Type = PathCompleted
+		case "resource":
+			event.Type = ResourceCompleted
+		case "milestone":
+			event.Type = MilestoneAchieved
+		}
+	}
+
+	return event
+}
+
+// Log appends events to a JSON Lines file and notifies in-process subscribers
+type Log struct {
+	path        string
+	mu          sync.Mutex
+	subscribers []func(Event)
+}
+
+// NewLog creates a log writing to path. The file is created on first append.
+func NewLog(path string) *Log {
+	return &Log{path: path}
+}
+
+// Path returns the location of the log file
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Subscribe registers fn to be called after each appended event
+func (l *Log) Subscribe(fn func(Event)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.subscribers = append(l.subscribers, fn)
+}
+
+// Append writes an event to the end of the log
+func (l *Log) Append(event Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create event log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+
+	for _, fn := range l.subscribers {
+		fn(event)
+	}
+
+	return nil
+}
+
+// Read returns all events in the order they were appended.
+// Malformed lines are skipped; a missing file yields no events.
+func (l *Log) Read() ([]Event, error) {
+	file, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
+	defer file.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event log: %w", err)
+	}
+
+	return events, nil
+}
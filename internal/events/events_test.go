@@ -0,0 +1,82 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_AppendAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".growth", "events.jsonl")
+	log := NewLog(path)
+
+	t.Run("missing file has no events", func(t *testing.T) {
+		events, err := log.Read()
+
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	var notified []Event
+	log.Subscribe(func(e Event) { notified = append(notified, e) })
+
+	require.NoError(t, log.Append(Event{Type: EntityCreated, EntityType: "skill", EntityID: "skill-001", Title: "Go"}))
+	require.NoError(t, log.Append(Event{Type: ResourceCompleted, EntityType: "resource", EntityID: "resource-001"}))
+
+	t.Run("reads events in order", func(t *testing.T) {
+		events, err := log.Read()
+
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, EntityCreated, events[0].Type)
+		assert.Equal(t, core.EntityID("resource-001"), events[1].EntityID)
+		assert.False(t, events[0].Time.IsZero())
+	})
+
+	t.Run("notifies subscribers", func(t *testing.T) {
+		assert.Len(t, notified, 2)
+	})
+
+	t.Run("skips malformed lines", func(t *testing.T) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		f.WriteString("not json\n")
+		f.Close()
+
+		events, err := log.Read()
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+	})
+}
+
+func TestForChange(t *testing.T) {
+	at := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		entityType string
+		change     core.Change
+		want       Type
+	}{
+		{"milestone", core.Change{Field: "status", From: "active", To: "completed"}, MilestoneAchieved},
+		{"resource", core.Change{Field: "status", From: "in-progress", To: "completed"}, ResourceCompleted},
+		{"goal", core.Change{Field: "status", From: "active", To: "completed"}, GoalCompleted},
+		{"resource", core.Change{Field: "status", From: "not-started", To: "in-progress"}, StatusChanged},
+		{"skill", core.Change{Field: "level", From: "beginner", To: "intermediate"}, FieldChanged},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			tt.change.Timestamp = at
+			event := ForChange(tt.entityType, "x-001", "X", tt.change)
+
+			assert.Equal(t, tt.want, event.Type)
+			assert.Equal(t, at, event.Time)
+			assert.Equal(t, tt.change.To, event.To)
+		})
+	}
+}
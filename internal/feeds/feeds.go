@@ -0,0 +1,165 @@
+// Package feeds fetches and parses RSS and Atom feeds.
+package feeds
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxFeedSize limits how much of a feed is read
+const maxFeedSize = 10 << 20
+
+// Feed is a parsed RSS or Atom feed
+type Feed struct {
+	Title string
+	Items []Item
+}
+
+// Item is a single entry in a feed
+type Item struct {
+	ID        string // GUID or Atom id, falling back to the link
+	Title     string
+	Link      string
+	Author    string
+	Published time.Time
+}
+
+// Fetch downloads and parses the feed at url
+func Fetch(ctx context.Context, client *http.Client, url string) (*Feed, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "growth.md feed reader")
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml, text/xml")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch feed: status %d", resp.StatusCode)
+	}
+
+	return Parse(io.LimitReader(resp.Body, maxFeedSize))
+}
+
+type rssDocument struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel struct {
+		Title string `xml:"title"`
+		Items []struct {
+			Title   string `xml:"title"`
+			Link    string `xml:"link"`
+			GUID    string `xml:"guid"`
+			Author  string `xml:"author"`
+			Creator string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atomDocument struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string   `xml:"title"`
+	Entries []struct {
+		ID    string `xml:"id"`
+		Title string `xml:"title"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Author struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Published string `xml:"published"`
+		Updated   string `xml:"updated"`
+	} `xml:"entry"`
+}
+
+// Parse reads an RSS 2.0 or Atom feed
+func Parse(r io.Reader) (*Feed, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+
+	var rss rssDocument
+	if err := xml.Unmarshal(data, &rss); err == nil {
+		feed := &Feed{Title: strings.TrimSpace(rss.Channel.Title)}
+		for _, it := range rss.Channel.Items {
+			author := it.Author
+			if author == "" {
+				author = it.Creator
+			}
+			feed.Items = append(feed.Items, newItem(it.GUID, it.Title, it.Link, author, parseTime(it.PubDate)))
+		}
+		return feed, nil
+	}
+
+	var atom atomDocument
+	if err := xml.Unmarshal(data, &atom); err == nil {
+		feed := &Feed{Title: strings.TrimSpace(atom.Title)}
+		for _, entry := range atom.Entries {
+			link := ""
+			for _, l := range entry.Links {
+				if l.Rel == "" || l.Rel == "alternate" {
+					link = l.Href
+					break
+				}
+			}
+			published := parseTime(entry.Published)
+			if published.IsZero() {
+				published = parseTime(entry.Updated)
+			}
+			feed.Items = append(feed.Items, newItem(entry.ID, entry.Title, link, entry.Author.Name, published))
+		}
+		return feed, nil
+	}
+
+	return nil, errors.New("unrecognized feed format (expected RSS or Atom)")
+}
+
+func newItem(id, title, link, author string, published time.Time) Item {
+	item := Item{
+		ID:        strings.TrimSpace(id),
+		Title:     strings.TrimSpace(title),
+		Link:      strings.TrimSpace(link),
+		Author:    strings.TrimSpace(author),
+		Published: published,
+	}
+	if item.ID == "" {
+		item.ID = item.Link
+	}
+	if item.Title == "" {
+		item.Title = item.Link
+	}
+	return item
+}
+
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+}
+
+func parseTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,99 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rssFeed = `<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<channel>
+  <title>Go Weekly</title>
+  <item>
+    <title>Understanding generics</title>
+    <link>https://example.com/generics</link>
+    <guid>item-1</guid>
+    <dc:creator>Jane Doe</dc:creator>
+    <pubDate>Mon, 06 Jan 2025 10:00:00 +0000</pubDate>
+  </item>
+  <item>
+    <title>Profiling in practice</title>
+    <link>https://example.com/pprof</link>
+  </item>
+</channel>
+</rss>`
+
+const atomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Rust Blog</title>
+  <entry>
+    <id>tag:blog.rust-lang.org,2025:1</id>
+    <title>Announcing Rust 1.84</title>
+    <link rel="alternate" href="https://blog.rust-lang.org/2025/01/09/Rust-1.84.0.html"/>
+    <author><name>The Rust Release Team</name></author>
+    <published>2025-01-09T00:00:00Z</published>
+  </entry>
+</feed>`
+
+func TestParse_RSS(t *testing.T) {
+	feed, err := Parse(strings.NewReader(rssFeed))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Go Weekly", feed.Title)
+	require.Len(t, feed.Items, 2)
+
+	assert.Equal(t, "item-1", feed.Items[0].ID)
+	assert.Equal(t, "Jane Doe", feed.Items[0].Author)
+	assert.Equal(t, time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC), feed.Items[0].Published.UTC())
+
+	// Falls back to link when no GUID is present
+	assert.Equal(t, "https://example.com/pprof", feed.Items[1].ID)
+}
+
+func TestParse_Atom(t *testing.T) {
+	feed, err := Parse(strings.NewReader(atomFeed))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Rust Blog", feed.Title)
+	require.Len(t, feed.Items, 1)
+	assert.Equal(t, "https://blog.rust-lang.org/2025/01/09/Rust-1.84.0.html", feed.Items[0].Link)
+	assert.Equal(t, "The Rust Release Team", feed.Items[0].Author)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("<html><body>not a feed</body></html>"))
+	assert.Error(t, err)
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/feed.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, rssFeed)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	t.Run("fetches feed", func(t *testing.T) {
+		feed, err := Fetch(context.Background(), client, server.URL+"/feed.xml")
+
+		require.NoError(t, err)
+		assert.Len(t, feed.Items, 2)
+	})
+
+	t.Run("fails on missing feed", func(t *testing.T) {
+		_, err := Fetch(context.Background(), client, server.URL+"/missing.xml")
+		assert.Error(t, err)
+	})
+}
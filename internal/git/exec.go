@@ -0,0 +1,268 @@
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// The operations below run the git binary. They are the fallback for
+// repositories go-git cannot handle, see withFallback.
+
+// gitInstalled reports whether the git binary is on the PATH
+func gitInstalled() bool {
+	_, err := exec.LookPath("git")
+	return err == nil
+}
+
+// command prepares a git command in dir that is interrupted when ctx is
+// cancelled, giving git a moment to remove its lock files before it is
+// killed
+func command(ctx context.Context, dir string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = 5 * time.Second
+	return cmd
+}
+
+func execInit(path string) error {
+	cmd := exec.Command("git", "init")
+	cmd.Dir = path
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to initialize git repository: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func execIsRepo(path string) bool {
+	cmd := exec.Command("git", "rev-parse", "--git-dir")
+	cmd.Dir = path
+	err := cmd.Run()
+	return err == nil
+}
+
+func execRepoRoot(path string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	cmd.Dir = path
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("not a git repository (or any of the parent directories)")
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func execStatus(ctx context.Context, repoPath string) ([]string, error) {
+	cmd := command(ctx, repoPath, "status", "--porcelain")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git status: %w", err)
+	}
+
+	if len(output) == 0 {
+		return []string{}, nil
+	}
+
+	// Only trim the newline: a line may start with a space, like " M file"
+	lines := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+	return lines, nil
+}
+
+func execAdd(ctx context.Context, repoPath string, files []string) error {
+	args := append([]string{"add"}, files...)
+	cmd := command(ctx, repoPath, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to add files: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func execCommit(ctx context.Context, repoPath string, message string, opts CommitOptions) error {
+	var args []string
+	if opts.Name != "" {
+		args = append(args, "-c", "user.name="+opts.Name)
+	}
+	if opts.Email != "" {
+		args = append(args, "-c", "user.email="+opts.Email)
+	}
+	switch opts.Signing {
+	case "gpg":
+		args = append(args, "-c", "gpg.format=openpgp")
+	case "ssh":
+		args = append(args, "-c", "gpg.format=ssh")
+	}
+	if opts.Signing != "" && opts.SigningKey != "" {
+		args = append(args, "-c", "user.signingkey="+opts.SigningKey)
+	}
+
+	args = append(args, "commit", "-m", message)
+	if opts.Signing != "" {
+		args = append(args, "-S")
+	}
+
+	cmd := command(ctx, repoPath, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		// Check if it's just "nothing to commit"
+		if strings.Contains(string(output), "nothing to commit") ||
+			strings.Contains(string(output), "no changes added to commit") {
+			return nil // Not an error, just nothing changed
+		}
+		return fmt.Errorf("failed to commit: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func execLog(ctx context.Context, repoPath string, count int) ([]string, error) {
+	cmd := command(ctx, repoPath, "log", fmt.Sprintf("-%d", count), "--oneline")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		// Empty repo has no commits - check both error and output
+		outputStr := string(output)
+		if strings.Contains(outputStr, "does not have any commits yet") ||
+			strings.Contains(outputStr, "your current branch") ||
+			strings.Contains(err.Error(), "does not have any commits yet") {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("failed to get git log: %w\nOutput: %s", err, outputStr)
+	}
+
+	if len(output) == 0 {
+		return []string{}, nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	return lines, nil
+}
+
+func execCurrentBranch(repoPath string) (string, error) {
+	cmd := exec.Command("git", "branch", "--show-current")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func execSetConfig(repoPath string, key string, value string, global bool) error {
+	args := []string{"config"}
+	if global {
+		args = append(args, "--global")
+	}
+	args = append(args, key, value)
+
+	cmd := exec.Command("git", args...)
+	if !global && repoPath != "" {
+		cmd.Dir = repoPath
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to set git config: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
+
+func execGetConfig(repoPath string, key string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", key)
+	if repoPath != "" {
+		cmd.Dir = repoPath
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		// Config key doesn't exist
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get git config: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// remoteCommand is command with the token of auth sent as an HTTP header.
+// It goes through the environment, so it does not show up in the process
+// list like a -c option would.
+func remoteCommand(ctx context.Context, dir string, auth Auth, args ...string) *exec.Cmd {
+	cmd := command(ctx, dir, args...)
+	if auth.Token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(auth.username() + ":" + auth.Token))
+		cmd.Env = append(os.Environ(),
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+	return cmd
+}
+
+func execFetch(ctx context.Context, repoPath, remote string, auth Auth) error {
+	output, err := remoteCommand(ctx, repoPath, auth, "fetch", remote).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to fetch from %s: %w\nOutput: %s", remote, err, string(output))
+	}
+	return nil
+}
+
+func execPull(ctx context.Context, repoPath, remote, branch string, auth Auth) error {
+	output, err := remoteCommand(ctx, repoPath, auth, "pull", "--no-edit", remote, branch).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to pull %s from %s: %w\nOutput: %s", branch, remote, err, string(output))
+	}
+	return nil
+}
+
+func execPush(ctx context.Context, repoPath, remote, branch string, auth Auth) error {
+	ref := "refs/heads/" + branch
+	output, err := remoteCommand(ctx, repoPath, auth, "push", remote, ref+":"+ref).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to push %s to %s: %w\nOutput: %s", branch, remote, err, string(output))
+	}
+	return nil
+}
+
+func execCreateBranch(repoPath, name string) error {
+	cmd := exec.Command("git", "branch", name)
+	cmd.Dir = repoPath
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to create branch %q: %w\nOutput: %s", name, err, string(output))
+	}
+	return nil
+}
+
+func execCheckout(repoPath, branch string) error {
+	cmd := exec.Command("git", "checkout", branch, "--")
+	cmd.Dir = repoPath
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to check out %s: %w\nOutput: %s", branch, err, string(output))
+	}
+	return nil
+}
+
+func execHasRemoteBranch(repoPath, remote, branch string) (bool, error) {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", "refs/remotes/"+remote+"/"+branch)
+	cmd.Dir = repoPath
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up %s/%s: %w", remote, branch, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,502 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+// The operations below use go-git, so they work without the git binary.
+
+// openRepo opens the repository containing path, looking in parent
+// directories like git does
+func openRepo(path string) (*gogit.Repository, error) {
+	return gogit.PlainOpenWithOptions(path, &gogit.PlainOpenOptions{
+		DetectDotGit:          true,
+		EnableDotGitCommonDir: true,
+	})
+}
+
+// openWorktree opens the repository containing path and its working tree
+func openWorktree(path string) (*gogit.Repository, *gogit.Worktree, error) {
+	repo, err := openRepo(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, nil, err
+	}
+	return repo, worktree, nil
+}
+
+// goGitInit creates a repository on init.defaultBranch from the global
+// config, or master, like git init
+func goGitInit(path string) error {
+	branch := plumbing.Master
+	if global, err := gitconfig.LoadConfig(gitconfig.GlobalScope); err == nil && global.Init.DefaultBranch != "" {
+		branch = plumbing.NewBranchReferenceName(global.Init.DefaultBranch)
+	}
+
+	_, err := gogit.PlainInitWithOptions(path, &gogit.PlainInitOptions{
+		InitOptions: gogit.InitOptions{DefaultBranch: branch},
+	})
+	if err != nil && !errors.Is(err, gogit.ErrRepositoryAlreadyExists) {
+		return fmt.Errorf("failed to initialize git repository: %w", err)
+	}
+	return nil
+}
+
+func goGitRepoRoot(path string) (string, error) {
+	_, worktree, err := openWorktree(path)
+	if err != nil {
+		return "", fmt.Errorf("not a git repository (or any of the parent directories)")
+	}
+	return worktree.Filesystem.Root(), nil
+}
+
+// goGitStatus returns the changes in the format of git status --porcelain,
+// sorted by path
+func goGitStatus(repoPath string) ([]string, error) {
+	_, worktree, err := openWorktree(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git status: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git status: %w", err)
+	}
+
+	lines := []string{}
+	for path, file := range status {
+		if file.Staging == gogit.Unmodified && file.Worktree == gogit.Unmodified {
+			continue
+		}
+		if file.Staging == gogit.Renamed {
+			path = fmt.Sprintf("%s -> %s", path, file.Extra)
+		}
+		lines = append(lines, fmt.Sprintf("%c%c %s", file.Staging, file.Worktree, path))
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i][3:] < lines[j][3:] })
+	return lines, nil
+}
+
+// goGitAdd stages files, given relative to repoPath like for git add
+func goGitAdd(repoPath string, files []string) error {
+	_, worktree, err := openWorktree(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to add files: %w", err)
+	}
+
+	for _, file := range files {
+		path, err := worktreePath(worktree, repoPath, file)
+		if err != nil {
+			return fmt.Errorf("failed to add files: %w", err)
+		}
+
+		if path == "." {
+			err = worktree.AddWithOptions(&gogit.AddOptions{All: true})
+		} else {
+			_, err = worktree.Add(path)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// worktreePath turns a path relative to dir into one relative to the root
+// of the working tree, which is what go-git expects
+func worktreePath(worktree *gogit.Worktree, dir, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(absDir, path)
+	}
+
+	root := worktree.Filesystem.Root()
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(filepath.Dir(path)); err == nil {
+		path = filepath.Join(resolved, filepath.Base(path))
+	}
+
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside the repository", path)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// goGitCommit commits the staged changes, doing nothing when there are none
+func goGitCommit(repoPath string, message string, opts CommitOptions) error {
+	repo, worktree, err := openWorktree(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	_, err = worktree.Commit(message, &gogit.CommitOptions{Author: goGitSignature(repo, opts)})
+	if errors.Is(err, gogit.ErrEmptyCommit) {
+		return nil // Not an error, just nothing changed
+	}
+	if err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	return nil
+}
+
+// goGitSignature returns the author set by opts, completed from the git
+// config, or nil to leave it to go-git when opts sets none
+func goGitSignature(repo *gogit.Repository, opts CommitOptions) *object.Signature {
+	if opts.Name == "" && opts.Email == "" {
+		return nil
+	}
+
+	signature := &object.Signature{Name: opts.Name, Email: opts.Email, When: time.Now()}
+	if signature.Name != "" && signature.Email != "" {
+		return signature
+	}
+
+	if cfg, err := repo.ConfigScoped(gitconfig.SystemScope); err == nil {
+		if signature.Name == "" {
+			signature.Name = cfg.User.Name
+		}
+		if signature.Email == "" {
+			signature.Email = cfg.User.Email
+		}
+	}
+	return signature
+}
+
+// goGitLog returns the last count commits in the format of git log
+// --oneline
+func goGitLog(repoPath string, count int) ([]string, error) {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git log: %w", err)
+	}
+
+	head, err := repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return []string{}, nil // No commits yet
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git log: %w", err)
+	}
+
+	commits, err := repo.Log(&gogit.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git log: %w", err)
+	}
+	defer commits.Close()
+
+	lines := []string{}
+	for len(lines) < count {
+		commit, err := commits.Next()
+		if err != nil {
+			break
+		}
+		lines = append(lines, onelineCommit(commit))
+	}
+	return lines, nil
+}
+
+func onelineCommit(commit *object.Commit) string {
+	subject, _, _ := strings.Cut(strings.TrimSpace(commit.Message), "\n")
+	return commit.Hash.String()[:7] + " " + subject
+}
+
+// goGitCurrentBranch returns the branch HEAD points to, even before its
+// first commit, and nothing when HEAD is detached
+func goGitCurrentBranch(repoPath string) (string, error) {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current branch: %w", err)
+	}
+	if head.Type() != plumbing.SymbolicReference || !head.Target().IsBranch() {
+		return "", nil
+	}
+	return head.Target().Short(), nil
+}
+
+// configKey splits a key like user.name or branch.main.remote into its
+// section, subsection and option
+func configKey(key string) (section, subsection, option string, err error) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+	if first <= 0 || last == len(key)-1 {
+		return "", "", "", fmt.Errorf("invalid git config key %q, must look like section.name", key)
+	}
+	if first != last {
+		subsection = key[first+1 : last]
+	}
+	return key[:first], subsection, key[last+1:], nil
+}
+
+func rawOption(raw *config.Config, section, subsection, option string) string {
+	if !raw.HasSection(section) {
+		return ""
+	}
+	s := raw.Section(section)
+	if subsection == "" {
+		return s.Option(option)
+	}
+	if !s.HasSubsection(subsection) {
+		return ""
+	}
+	return s.Subsection(subsection).Option(option)
+}
+
+// goGitGetConfig reads a value from the repository, global or system
+// config, in that order of precedence
+func goGitGetConfig(repoPath string, key string) (string, error) {
+	section, subsection, option, err := configKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	var scopes []*gitconfig.Config
+	if repo, err := openRepo(repoPath); err == nil {
+		local, err := repo.Config()
+		if err != nil {
+			return "", fmt.Errorf("failed to get git config: %w", err)
+		}
+		scopes = append(scopes, local)
+	}
+	for _, scope := range []gitconfig.Scope{gitconfig.GlobalScope, gitconfig.SystemScope} {
+		cfg, err := gitconfig.LoadConfig(scope)
+		if err != nil {
+			return "", fmt.Errorf("failed to get git config: %w", err)
+		}
+		scopes = append(scopes, cfg)
+	}
+
+	for _, cfg := range scopes {
+		if value := rawOption(cfg.Raw, section, subsection, option); value != "" {
+			return value, nil
+		}
+	}
+	return "", nil
+}
+
+// goGitSetConfig sets a value in the repository config. go-git cannot write
+// the global config, which is left to the git binary.
+func goGitSetConfig(repoPath string, key string, value string) error {
+	section, subsection, option, err := configKey(key)
+	if err != nil {
+		return err
+	}
+
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to set git config: %w", err)
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to set git config: %w", err)
+	}
+
+	if subsection == "" {
+		cfg.Raw.Section(section).SetOption(option, value)
+	} else {
+		cfg.Raw.Section(section).Subsection(subsection).SetOption(option, value)
+	}
+
+	// Reparse the raw config, or writing it would restore the old values of
+	// the options go-git keeps in fields, like user.name
+	var buf bytes.Buffer
+	if err := config.NewEncoder(&buf).Encode(cfg.Raw); err != nil {
+		return fmt.Errorf("failed to set git config: %w", err)
+	}
+	updated := gitconfig.NewConfig()
+	if err := updated.Unmarshal(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to set git config: %w", err)
+	}
+
+	if err := repo.SetConfig(updated); err != nil {
+		return fmt.Errorf("failed to set git config: %w", err)
+	}
+	return nil
+}
+
+// goGitAuth picks the auth method for the first URL of a remote
+func goGitAuth(repo *gogit.Repository, remote string, auth Auth) (transport.AuthMethod, error) {
+	r, err := repo.Remote(remote)
+	if err != nil {
+		return nil, fmt.Errorf("remote %q: %w", remote, err)
+	}
+	urls := r.Config().URLs
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("remote %q has no URL", remote)
+	}
+
+	endpoint, err := transport.NewEndpoint(urls[0])
+	if err != nil {
+		return nil, fmt.Errorf("remote %q: %w", remote, err)
+	}
+
+	switch endpoint.Protocol {
+	case "ssh":
+		user := endpoint.User
+		if user == "" {
+			user = "git"
+		}
+		return gitssh.NewSSHAgentAuth(user)
+	case "http", "https":
+		if auth.Token != "" {
+			return &githttp.BasicAuth{Username: auth.username(), Password: auth.Token}, nil
+		}
+	}
+	return nil, nil
+}
+
+func goGitFetch(ctx context.Context, repoPath, remote string, auth Auth) error {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to fetch: %w", err)
+	}
+	method, err := goGitAuth(repo, remote, auth)
+	if err != nil {
+		return fmt.Errorf("failed to fetch: %w", err)
+	}
+
+	err = repo.FetchContext(ctx, &gogit.FetchOptions{RemoteName: remote, Auth: method})
+	if err != nil && !errors.Is(err, gogit.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to fetch from %s: %w", remote, err)
+	}
+	return nil
+}
+
+// goGitPull only fast-forwards
+func goGitPull(ctx context.Context, repoPath, remote, branch string, auth Auth) error {
+	repo, worktree, err := openWorktree(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to pull: %w", err)
+	}
+	method, err := goGitAuth(repo, remote, auth)
+	if err != nil {
+		return fmt.Errorf("failed to pull: %w", err)
+	}
+
+	err = worktree.PullContext(ctx, &gogit.PullOptions{
+		RemoteName:    remote,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		Auth:          method,
+	})
+	if err != nil && !errors.Is(err, gogit.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to pull %s from %s: %w", branch, remote, err)
+	}
+	return nil
+}
+
+func goGitPush(ctx context.Context, repoPath, remote, branch string, auth Auth) error {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to push: %w", err)
+	}
+	method, err := goGitAuth(repo, remote, auth)
+	if err != nil {
+		return fmt.Errorf("failed to push: %w", err)
+	}
+
+	ref := plumbing.NewBranchReferenceName(branch)
+	err = repo.PushContext(ctx, &gogit.PushOptions{
+		RemoteName: remote,
+		RefSpecs:   []gitconfig.RefSpec{gitconfig.RefSpec(ref + ":" + ref)},
+		Auth:       method,
+	})
+	if err != nil && !errors.Is(err, gogit.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to push %s to %s: %w", branch, remote, err)
+	}
+	return nil
+}
+
+func goGitCreateBranch(repoPath, name string) error {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to create branch: %w", err)
+	}
+
+	ref := plumbing.NewBranchReferenceName(name)
+	if err := ref.Validate(); err != nil {
+		return fmt.Errorf("invalid branch name %q: %w", name, err)
+	}
+	if _, err := repo.Reference(ref, false); err == nil {
+		return fmt.Errorf("branch %q already exists", name)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return fmt.Errorf("failed to create branch %q: no commits yet: %w", name, err)
+	}
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(ref, head.Hash())); err != nil {
+		return fmt.Errorf("failed to create branch %q: %w", name, err)
+	}
+	return nil
+}
+
+// errUncommittedChanges stops go-git from checking out over changes, which
+// it would move HEAD for before failing; the git binary carries them over
+var errUncommittedChanges = errors.New("the working tree has uncommitted changes")
+
+func goGitCheckout(repoPath, branch string) error {
+	repo, worktree, err := openWorktree(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to check out %s: %w", branch, err)
+	}
+
+	ref := plumbing.NewBranchReferenceName(branch)
+	if _, err := repo.Reference(ref, false); err != nil {
+		return fmt.Errorf("branch %q not found", branch)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return fmt.Errorf("failed to check out %s: %w", branch, err)
+	}
+	if !status.IsClean() {
+		return fmt.Errorf("failed to check out %s: %w", branch, errUncommittedChanges)
+	}
+
+	if err := worktree.Checkout(&gogit.CheckoutOptions{Branch: ref}); err != nil {
+		return fmt.Errorf("failed to check out %s: %w", branch, err)
+	}
+	return nil
+}
+
+func goGitHasRemoteBranch(repoPath, remote, branch string) (bool, error) {
+	repo, err := openRepo(repoPath)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = repo.Reference(plumbing.NewRemoteReferenceName(remote, branch), true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
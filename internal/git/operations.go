@@ -1,26 +1,38 @@
+// Package git reads and writes the git repository the growth files live in.
+// Operations use go-git, so they work on systems without the git binary;
+// when go-git fails and git is installed, they are retried with git, which
+// handles repository features go-git does not.
 package git
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
 	"path/filepath"
-	"strings"
 )
 
+// withFallback runs an operation with go-git and, if that fails and the git
+// binary is installed, again with git. Cancellation is not retried.
+func withFallback(ctx context.Context, goGit, execGit func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := goGit()
+	if err == nil || ctx.Err() != nil || !gitInstalled() {
+		return err
+	}
+	return execGit()
+}
+
 // InitRepo initializes a new git repository at the specified path
 func InitRepo(path string) error {
 	if path == "" {
 		return fmt.Errorf("repository path cannot be empty")
 	}
 
-	cmd := exec.Command("git", "init")
-	cmd.Dir = path
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to initialize git repository: %w\nOutput: %s", err, string(output))
-	}
-
-	return nil
+	return withFallback(context.Background(),
+		func() error { return goGitInit(path) },
+		func() error { return execInit(path) })
 }
 
 // IsRepo checks if the specified path is inside a git repository
@@ -29,10 +41,10 @@ func IsRepo(path string) bool {
 		return false
 	}
 
-	cmd := exec.Command("git", "rev-parse", "--git-dir")
-	cmd.Dir = path
-	err := cmd.Run()
-	return err == nil
+	if _, err := openRepo(path); err == nil {
+		return true
+	}
+	return gitInstalled() && execIsRepo(path)
 }
 
 // GetRepoRoot returns the root directory of the git repository
@@ -41,15 +53,14 @@ func GetRepoRoot(path string) (string, error) {
 		return "", fmt.Errorf("path cannot be empty")
 	}
 
-	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
-	cmd.Dir = path
-	output, err := cmd.Output()
+	var root string
+	err := withFallback(context.Background(),
+		func() (err error) { root, err = goGitRepoRoot(path); return err },
+		func() (err error) { root, err = execRepoRoot(path); return err })
 	if err != nil {
-		return "", fmt.Errorf("not a git repository (or any of the parent directories)")
+		return "", err
 	}
 
-	root := strings.TrimSpace(string(output))
-
 	// Resolve symlinks (e.g., /var -> /private/var on macOS)
 	resolved, err := filepath.EvalSymlinks(root)
 	if err != nil {
@@ -61,27 +72,32 @@ func GetRepoRoot(path string) (string, error) {
 
 // Status returns the list of modified/untracked files in the repository
 func Status(repoPath string) ([]string, error) {
+	return StatusContext(context.Background(), repoPath)
+}
+
+// StatusContext is Status, stopped when ctx is cancelled
+func StatusContext(ctx context.Context, repoPath string) ([]string, error) {
 	if !IsRepo(repoPath) {
 		return nil, fmt.Errorf("not a git repository: %s", repoPath)
 	}
 
-	cmd := exec.Command("git", "status", "--porcelain")
-	cmd.Dir = repoPath
-	output, err := cmd.Output()
+	var lines []string
+	err := withFallback(ctx,
+		func() (err error) { lines, err = goGitStatus(repoPath); return err },
+		func() (err error) { lines, err = execStatus(ctx, repoPath); return err })
 	if err != nil {
-		return nil, fmt.Errorf("failed to get git status: %w", err)
-	}
-
-	if len(output) == 0 {
-		return []string{}, nil
+		return nil, err
 	}
-
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	return lines, nil
 }
 
 // Add stages files for commit
 func Add(repoPath string, files []string) error {
+	return AddContext(context.Background(), repoPath, files)
+}
+
+// AddContext is Add, stopped when ctx is cancelled
+func AddContext(ctx context.Context, repoPath string, files []string) error {
 	if !IsRepo(repoPath) {
 		return fmt.Errorf("not a git repository: %s", repoPath)
 	}
@@ -90,20 +106,37 @@ func Add(repoPath string, files []string) error {
 		return nil
 	}
 
-	args := append([]string{"add"}, files...)
-	cmd := exec.Command("git", args...)
-	cmd.Dir = repoPath
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to add files: %w\nOutput: %s", err, string(output))
-	}
+	return withFallback(ctx,
+		func() error { return goGitAdd(repoPath, files) },
+		func() error { return execAdd(ctx, repoPath, files) })
+}
 
-	return nil
+// CommitOptions sets who a commit is attributed to and how it is signed
+type CommitOptions struct {
+	Name  string // author and committer name, user.name from the git config when empty
+	Email string // author and committer email, user.email from the git config when empty
+
+	// Signing is "gpg" or "ssh" to sign the commit, which needs the git
+	// binary, or empty for an unsigned commit
+	Signing string
+	// SigningKey is the GPG key ID or SSH key file, user.signingkey from the
+	// git config when empty
+	SigningKey string
 }
 
 // Commit creates a commit with the specified message and files
 // If files is empty, commits all staged changes
 func Commit(repoPath string, message string, files []string) error {
+	return CommitContext(context.Background(), repoPath, message, files)
+}
+
+// CommitContext is Commit, stopped when ctx is cancelled
+func CommitContext(ctx context.Context, repoPath string, message string, files []string) error {
+	return CommitWithOptions(ctx, repoPath, message, files, CommitOptions{})
+}
+
+// CommitWithOptions is CommitContext with the author and signing set by opts
+func CommitWithOptions(ctx context.Context, repoPath string, message string, files []string, opts CommitOptions) error {
 	if !IsRepo(repoPath) {
 		return fmt.Errorf("not a git repository: %s", repoPath)
 	}
@@ -112,30 +145,40 @@ func Commit(repoPath string, message string, files []string) error {
 		return fmt.Errorf("commit message cannot be empty")
 	}
 
+	switch opts.Signing {
+	case "", "gpg", "ssh":
+	default:
+		return fmt.Errorf("invalid commit signing %q, must be gpg or ssh", opts.Signing)
+	}
+
 	// Stage files if provided
 	if len(files) > 0 {
-		if err := Add(repoPath, files); err != nil {
+		if err := AddContext(ctx, repoPath, files); err != nil {
 			return err
 		}
 	}
 
-	// Commit
-	cmd := exec.Command("git", "commit", "-m", message)
-	cmd.Dir = repoPath
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		// Check if it's just "nothing to commit"
-		if strings.Contains(string(output), "nothing to commit") {
-			return nil // Not an error, just nothing changed
+	// go-git can only sign with GPG keys it is handed, not through the
+	// agents and key files git uses
+	if opts.Signing != "" {
+		if !gitInstalled() {
+			return fmt.Errorf("signing commits needs git installed")
 		}
-		return fmt.Errorf("failed to commit: %w\nOutput: %s", err, string(output))
+		return execCommit(ctx, repoPath, message, opts)
 	}
 
-	return nil
+	return withFallback(ctx,
+		func() error { return goGitCommit(repoPath, message, opts) },
+		func() error { return execCommit(ctx, repoPath, message, opts) })
 }
 
 // Log returns the last n commit messages
 func Log(repoPath string, count int) ([]string, error) {
+	return LogContext(context.Background(), repoPath, count)
+}
+
+// LogContext is Log, stopped when ctx is cancelled
+func LogContext(ctx context.Context, repoPath string, count int) ([]string, error) {
 	if !IsRepo(repoPath) {
 		return nil, fmt.Errorf("not a git repository: %s", repoPath)
 	}
@@ -144,25 +187,13 @@ func Log(repoPath string, count int) ([]string, error) {
 		count = 10
 	}
 
-	cmd := exec.Command("git", "log", fmt.Sprintf("-%d", count), "--oneline")
-	cmd.Dir = repoPath
-	output, err := cmd.CombinedOutput()
+	var lines []string
+	err := withFallback(ctx,
+		func() (err error) { lines, err = goGitLog(repoPath, count); return err },
+		func() (err error) { lines, err = execLog(ctx, repoPath, count); return err })
 	if err != nil {
-		// Empty repo has no commits - check both error and output
-		outputStr := string(output)
-		if strings.Contains(outputStr, "does not have any commits yet") ||
-			strings.Contains(outputStr, "your current branch") ||
-			strings.Contains(err.Error(), "does not have any commits yet") {
-			return []string{}, nil
-		}
-		return nil, fmt.Errorf("failed to get git log: %w\nOutput: %s", err, outputStr)
-	}
-
-	if len(output) == 0 {
-		return []string{}, nil
+		return nil, err
 	}
-
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	return lines, nil
 }
 
@@ -203,22 +234,19 @@ func GetCurrentBranch(repoPath string) (string, error) {
 		return "", fmt.Errorf("not a git repository: %s", repoPath)
 	}
 
-	cmd := exec.Command("git", "branch", "--show-current")
-	cmd.Dir = repoPath
-	output, err := cmd.Output()
+	var branch string
+	err := withFallback(context.Background(),
+		func() (err error) { branch, err = goGitCurrentBranch(repoPath); return err },
+		func() (err error) { branch, err = execCurrentBranch(repoPath); return err })
 	if err != nil {
-		return "", fmt.Errorf("failed to get current branch: %w", err)
+		return "", err
 	}
-
-	return strings.TrimSpace(string(output)), nil
+	return branch, nil
 }
 
-// EnsureGitInstalled checks if git is installed and available
+// EnsureGitInstalled checks that git operations are available. They are
+// built on go-git, so this always succeeds; the git binary is optional.
 func EnsureGitInstalled() error {
-	cmd := exec.Command("git", "--version")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("git is not installed or not available in PATH")
-	}
 	return nil
 }
 
@@ -228,22 +256,17 @@ func SetConfig(repoPath string, key string, value string, global bool) error {
 		return fmt.Errorf("config key and value cannot be empty")
 	}
 
-	args := []string{"config"}
 	if global {
-		args = append(args, "--global")
+		return execSetConfig(repoPath, key, value, global)
 	}
-	args = append(args, key, value)
 
-	cmd := exec.Command("git", args...)
-	if !global && repoPath != "" {
-		cmd.Dir = repoPath
-	}
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to set git config: %w\nOutput: %s", err, string(output))
+	dir := repoPath
+	if dir == "" {
+		dir = "."
 	}
-
-	return nil
+	return withFallback(context.Background(),
+		func() error { return goGitSetConfig(dir, key, value) },
+		func() error { return execSetConfig(repoPath, key, value, global) })
 }
 
 // GetConfig gets a git config value
@@ -252,18 +275,17 @@ func GetConfig(repoPath string, key string) (string, error) {
 		return "", fmt.Errorf("config key cannot be empty")
 	}
 
-	cmd := exec.Command("git", "config", "--get", key)
-	if repoPath != "" {
-		cmd.Dir = repoPath
+	dir := repoPath
+	if dir == "" {
+		dir = "."
 	}
-	output, err := cmd.Output()
+
+	var value string
+	err := withFallback(context.Background(),
+		func() (err error) { value, err = goGitGetConfig(dir, key); return err },
+		func() (err error) { value, err = execGetConfig(repoPath, key); return err })
 	if err != nil {
-		// Config key doesn't exist
-		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
-			return "", nil
-		}
-		return "", fmt.Errorf("failed to get git config: %w", err)
+		return "", err
 	}
-
-	return strings.TrimSpace(string(output)), nil
+	return value, nil
 }
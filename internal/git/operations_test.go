@@ -1,9 +1,15 @@
 package git
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 func setupTestRepo(t *testing.T) string {
@@ -254,6 +260,25 @@ func TestAddAndCommit(t *testing.T) {
 			t.Error("Expected error for empty commit message, got nil")
 		}
 	})
+
+	t.Run("does not commit when the context is cancelled", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+
+		os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("test content"), 0644)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := CommitContext(ctx, tmpDir, "Cancelled commit", []string{"test.txt"})
+		if err == nil {
+			t.Error("Expected error for cancelled context, got nil")
+		}
+
+		if logs, _ := Log(tmpDir, 1); len(logs) != 0 {
+			t.Errorf("Expected no commits, got %v", logs)
+		}
+	})
 }
 
 func TestCommitFile(t *testing.T) {
@@ -300,6 +325,97 @@ func TestCommitFile(t *testing.T) {
 	})
 }
 
+func TestCommitWithOptions(t *testing.T) {
+	headCommit := func(t *testing.T, repoPath string) *object.Commit {
+		t.Helper()
+		repo, _ := openRepo(repoPath)
+		head, err := repo.Head()
+		if err != nil {
+			t.Fatalf("Failed to read HEAD: %v", err)
+		}
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			t.Fatalf("Failed to read HEAD commit: %v", err)
+		}
+		return commit
+	}
+
+	t.Run("sets the author", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("content"), 0644)
+
+		opts := CommitOptions{Name: "Ada Lovelace", Email: "ada@example.com"}
+		if err := CommitWithOptions(context.Background(), tmpDir, "Add test file", []string{"test.txt"}, opts); err != nil {
+			t.Fatalf("CommitWithOptions() error = %v", err)
+		}
+
+		commit := headCommit(t, tmpDir)
+		if commit.Author.Name != "Ada Lovelace" || commit.Author.Email != "ada@example.com" {
+			t.Errorf("author = %s <%s>", commit.Author.Name, commit.Author.Email)
+		}
+		if commit.Committer.Name != "Ada Lovelace" {
+			t.Errorf("committer = %s, want Ada Lovelace", commit.Committer.Name)
+		}
+	})
+
+	t.Run("completes the author from the git config", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("content"), 0644)
+
+		opts := CommitOptions{Name: "Ada Lovelace"}
+		if err := CommitWithOptions(context.Background(), tmpDir, "Add test file", []string{"test.txt"}, opts); err != nil {
+			t.Fatalf("CommitWithOptions() error = %v", err)
+		}
+
+		if commit := headCommit(t, tmpDir); commit.Author.Email != "test@example.com" {
+			t.Errorf("author email = %s, want test@example.com", commit.Author.Email)
+		}
+	})
+
+	t.Run("signs with an SSH key", func(t *testing.T) {
+		if !gitInstalled() {
+			t.Skip("signing needs git installed")
+		}
+		keygen, err := exec.LookPath("ssh-keygen")
+		if err != nil {
+			t.Skip("ssh-keygen is not installed")
+		}
+
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		key := filepath.Join(t.TempDir(), "id_ed25519")
+		if output, err := exec.Command(keygen, "-q", "-t", "ed25519", "-N", "", "-f", key).CombinedOutput(); err != nil {
+			t.Fatalf("ssh-keygen failed: %v\n%s", err, output)
+		}
+		os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("content"), 0644)
+
+		opts := CommitOptions{Name: "Ada Lovelace", Email: "ada@example.com", Signing: "ssh", SigningKey: key}
+		if err := CommitWithOptions(context.Background(), tmpDir, "Add test file", []string{"test.txt"}, opts); err != nil {
+			t.Fatalf("CommitWithOptions() error = %v", err)
+		}
+
+		commit := headCommit(t, tmpDir)
+		if !strings.Contains(commit.PGPSignature, "BEGIN SSH SIGNATURE") {
+			t.Errorf("commit is not signed: %q", commit.PGPSignature)
+		}
+		if commit.Author.Name != "Ada Lovelace" {
+			t.Errorf("author = %s, want Ada Lovelace", commit.Author.Name)
+		}
+	})
+
+	t.Run("fails for an unknown signing format", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+
+		err := CommitWithOptions(context.Background(), tmpDir, "Add test file", nil, CommitOptions{Signing: "x509"})
+		if err == nil {
+			t.Error("Expected error for unknown signing format, got nil")
+		}
+	})
+}
+
 func TestLog(t *testing.T) {
 	t.Run("returns commit history", func(t *testing.T) {
 		tmpDir := setupTestRepo(t)
@@ -435,3 +551,88 @@ func TestEnsureGitInstalled(t *testing.T) {
 		}
 	})
 }
+
+func TestWithoutGitBinary(t *testing.T) {
+	t.Run("commits and reads history without git installed", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		t.Setenv("PATH", "")
+
+		os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("content"), 0644)
+		if err := Commit(tmpDir, "Initial commit", []string{"test.txt"}); err != nil {
+			t.Fatalf("Commit() error = %v", err)
+		}
+
+		commits, err := Log(tmpDir, 10)
+		if err != nil {
+			t.Fatalf("Log() error = %v", err)
+		}
+		if len(commits) != 1 || !strings.HasSuffix(commits[0], " Initial commit") {
+			t.Errorf("Log() = %v, want the initial commit", commits)
+		}
+	})
+}
+
+func TestMatchesGitBinary(t *testing.T) {
+	if !gitInstalled() {
+		t.Skip("git is not installed")
+	}
+
+	tmpDir := setupTestRepo(t)
+	defer os.RemoveAll(tmpDir)
+
+	os.WriteFile(filepath.Join(tmpDir, "committed.txt"), []byte("one"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "staged.txt"), []byte("one"), 0644)
+	if err := Commit(tmpDir, "First commit\n\nWith a body", []string{"committed.txt", "staged.txt"}); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	os.WriteFile(filepath.Join(tmpDir, "committed.txt"), []byte("two"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "staged.txt"), []byte("two"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "untracked.txt"), []byte("new"), 0644)
+	if err := Add(tmpDir, []string{"staged.txt"}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	t.Run("status", func(t *testing.T) {
+		got, err := goGitStatus(tmpDir)
+		if err != nil {
+			t.Fatalf("goGitStatus() error = %v", err)
+		}
+		want, err := execStatus(context.Background(), tmpDir)
+		if err != nil {
+			t.Fatalf("execStatus() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("goGitStatus() = %q, git status = %q", got, want)
+		}
+	})
+
+	t.Run("log", func(t *testing.T) {
+		got, err := goGitLog(tmpDir, 10)
+		if err != nil {
+			t.Fatalf("goGitLog() error = %v", err)
+		}
+		want, err := execLog(context.Background(), tmpDir, 10)
+		if err != nil {
+			t.Fatalf("execLog() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("goGitLog() = %q, git log = %q", got, want)
+		}
+	})
+
+	t.Run("current branch", func(t *testing.T) {
+		got, err := goGitCurrentBranch(tmpDir)
+		if err != nil {
+			t.Fatalf("goGitCurrentBranch() error = %v", err)
+		}
+		want, err := execCurrentBranch(tmpDir)
+		if err != nil {
+			t.Fatalf("execCurrentBranch() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("goGitCurrentBranch() = %q, git branch = %q", got, want)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// DefaultRemote is the remote used when none is given
+const DefaultRemote = "origin"
+
+// TokenEnvVar holds the token for HTTPS remotes, see AuthFromEnv
+const TokenEnvVar = "GROWTH_GIT_TOKEN"
+
+// Auth is how remote operations authenticate. SSH remotes always use the
+// SSH agent. HTTPS remotes use Token if set, and otherwise no credentials,
+// leaving it to the git credential helper when the git binary runs.
+type Auth struct {
+	Token    string // e.g. a GitHub or GitLab personal access token
+	Username string // sent with Token, "git" when empty
+}
+
+// AuthFromEnv returns the auth with the token from GROWTH_GIT_TOKEN
+func AuthFromEnv() Auth {
+	return Auth{Token: os.Getenv(TokenEnvVar)}
+}
+
+func (a Auth) username() string {
+	if a.Username == "" {
+		return "git"
+	}
+	return a.Username
+}
+
+// Fetch downloads the branches of a remote, "origin" when empty
+func Fetch(repoPath string, remote string, auth Auth) error {
+	return FetchContext(context.Background(), repoPath, remote, auth)
+}
+
+// FetchContext is Fetch, stopped when ctx is cancelled
+func FetchContext(ctx context.Context, repoPath string, remote string, auth Auth) error {
+	if !IsRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+	if remote == "" {
+		remote = DefaultRemote
+	}
+
+	return withFallback(ctx,
+		func() error { return goGitFetch(ctx, repoPath, remote, auth) },
+		func() error { return execFetch(ctx, repoPath, remote, auth) })
+}
+
+// Pull fetches a branch from a remote and merges it into the current
+// branch. The branch defaults to the current one and the remote to
+// "origin". go-git only fast-forwards; other merges need the git binary.
+func Pull(repoPath string, remote string, branch string, auth Auth) error {
+	return PullContext(context.Background(), repoPath, remote, branch, auth)
+}
+
+// PullContext is Pull, stopped when ctx is cancelled
+func PullContext(ctx context.Context, repoPath string, remote string, branch string, auth Auth) error {
+	remote, branch, err := remoteBranch(repoPath, remote, branch)
+	if err != nil {
+		return err
+	}
+
+	return withFallback(ctx,
+		func() error { return goGitPull(ctx, repoPath, remote, branch, auth) },
+		func() error { return execPull(ctx, repoPath, remote, branch, auth) })
+}
+
+// Push uploads a branch to the branch of the same name on a remote. The
+// branch defaults to the current one and the remote to "origin".
+func Push(repoPath string, remote string, branch string, auth Auth) error {
+	return PushContext(context.Background(), repoPath, remote, branch, auth)
+}
+
+// PushContext is Push, stopped when ctx is cancelled
+func PushContext(ctx context.Context, repoPath string, remote string, branch string, auth Auth) error {
+	remote, branch, err := remoteBranch(repoPath, remote, branch)
+	if err != nil {
+		return err
+	}
+
+	return withFallback(ctx,
+		func() error { return goGitPush(ctx, repoPath, remote, branch, auth) },
+		func() error { return execPush(ctx, repoPath, remote, branch, auth) })
+}
+
+// remoteBranch fills in the default remote and the current branch
+func remoteBranch(repoPath, remote, branch string) (string, string, error) {
+	if !IsRepo(repoPath) {
+		return "", "", fmt.Errorf("not a git repository: %s", repoPath)
+	}
+	if remote == "" {
+		remote = DefaultRemote
+	}
+	if branch == "" {
+		current, err := GetCurrentBranch(repoPath)
+		if err != nil {
+			return "", "", err
+		}
+		if current == "" {
+			return "", "", fmt.Errorf("no branch is checked out; name the branch to use")
+		}
+		branch = current
+	}
+	return remote, branch, nil
+}
+
+// CreateBranch creates a branch at the current commit without checking it
+// out
+func CreateBranch(repoPath string, name string) error {
+	if !IsRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+	if name == "" {
+		return fmt.Errorf("branch name cannot be empty")
+	}
+
+	return withFallback(context.Background(),
+		func() error { return goGitCreateBranch(repoPath, name) },
+		func() error { return execCreateBranch(repoPath, name) })
+}
+
+// Checkout switches to an existing branch. Uncommitted changes are kept
+// when they don't conflict with the branch, which needs the git binary.
+func Checkout(repoPath string, branch string) error {
+	if !IsRepo(repoPath) {
+		return fmt.Errorf("not a git repository: %s", repoPath)
+	}
+	if branch == "" {
+		return fmt.Errorf("branch name cannot be empty")
+	}
+
+	return withFallback(context.Background(),
+		func() error { return goGitCheckout(repoPath, branch) },
+		func() error { return execCheckout(repoPath, branch) })
+}
+
+// HasRemoteBranch reports whether a remote had a branch when it was last
+// fetched. A branch that was never pushed is missing, and pulling it fails.
+func HasRemoteBranch(repoPath string, remote string, branch string) (bool, error) {
+	remote, branch, err := remoteBranch(repoPath, remote, branch)
+	if err != nil {
+		return false, err
+	}
+
+	var found bool
+	err = withFallback(context.Background(),
+		func() (err error) { found, err = goGitHasRemoteBranch(repoPath, remote, branch); return err },
+		func() (err error) { found, err = execHasRemoteBranch(repoPath, remote, branch); return err })
+	return found, err
+}
@@ -0,0 +1,221 @@
+package git
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	gogit "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// setupRemote creates a bare repository and a repository with it as origin
+func setupRemote(t *testing.T) (local, remote string) {
+	t.Helper()
+	if !gitInstalled() {
+		t.Skip("local remotes need git installed")
+	}
+
+	remote = filepath.Join(t.TempDir(), "remote.git")
+	if _, err := gogit.PlainInit(remote, true); err != nil {
+		t.Fatalf("Failed to init remote: %v", err)
+	}
+
+	local = setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(local) })
+	addRemote(t, local, remote)
+	return local, remote
+}
+
+func addRemote(t *testing.T, repoPath, url string) {
+	t.Helper()
+	repo, err := gogit.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("Failed to open repo: %v", err)
+	}
+	if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: DefaultRemote, URLs: []string{url}}); err != nil {
+		t.Fatalf("Failed to add remote: %v", err)
+	}
+}
+
+func commitFile(t *testing.T, repoPath, name, content, message string) {
+	t.Helper()
+	os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644)
+	if err := Commit(repoPath, message, []string{name}); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+}
+
+func TestPushFetchPull(t *testing.T) {
+	t.Run("pushes, fetches and pulls a branch", func(t *testing.T) {
+		local, remote := setupRemote(t)
+		commitFile(t, local, "goal.md", "v1", "Add goal")
+
+		if err := Push(local, "", "", Auth{}); err != nil {
+			t.Fatalf("Push() error = %v", err)
+		}
+
+		branch, _ := GetCurrentBranch(local)
+		if found, err := HasRemoteBranch(local, "", branch); err != nil || !found {
+			t.Errorf("HasRemoteBranch() after push = %v, %v, want true", found, err)
+		}
+		if found, _ := HasRemoteBranch(local, "", "missing"); found {
+			t.Error("HasRemoteBranch() found a branch that was never pushed")
+		}
+
+		other := setupTestRepo(t)
+		defer os.RemoveAll(other)
+		addRemote(t, other, remote)
+
+		if err := Fetch(other, "", Auth{}); err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		if err := Pull(other, "", branch, Auth{}); err != nil {
+			t.Fatalf("Pull() error = %v", err)
+		}
+
+		want, _ := Log(local, 10)
+		got, _ := Log(other, 10)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Log() after pull = %v, want %v", got, want)
+		}
+
+		// Nothing new is not an error
+		if err := Push(local, "", "", Auth{}); err != nil {
+			t.Errorf("Push() with nothing new error = %v", err)
+		}
+		if err := Pull(other, "", branch, Auth{}); err != nil {
+			t.Errorf("Pull() with nothing new error = %v", err)
+		}
+	})
+
+	t.Run("fails for a missing remote", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+
+		if err := Fetch(tmpDir, "upstream", Auth{}); err == nil {
+			t.Error("Expected error for missing remote, got nil")
+		}
+	})
+}
+
+func TestCreateBranchAndCheckout(t *testing.T) {
+	t.Run("creates and checks out a branch", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		commitFile(t, tmpDir, "goal.md", "v1", "Add goal")
+		main, _ := GetCurrentBranch(tmpDir)
+
+		if err := CreateBranch(tmpDir, "snapshot-2025"); err != nil {
+			t.Fatalf("CreateBranch() error = %v", err)
+		}
+		if branch, _ := GetCurrentBranch(tmpDir); branch != main {
+			t.Errorf("CreateBranch() switched to %s", branch)
+		}
+
+		if err := Checkout(tmpDir, "snapshot-2025"); err != nil {
+			t.Fatalf("Checkout() error = %v", err)
+		}
+		if branch, _ := GetCurrentBranch(tmpDir); branch != "snapshot-2025" {
+			t.Errorf("GetCurrentBranch() = %v, want snapshot-2025", branch)
+		}
+
+		commitFile(t, tmpDir, "goal.md", "v2", "Update goal")
+		if err := Checkout(tmpDir, main); err != nil {
+			t.Fatalf("Checkout() error = %v", err)
+		}
+		content, _ := os.ReadFile(filepath.Join(tmpDir, "goal.md"))
+		if string(content) != "v1" {
+			t.Errorf("goal.md = %q after checking out %s, want v1", content, main)
+		}
+	})
+
+	t.Run("fails for an existing branch", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		commitFile(t, tmpDir, "goal.md", "v1", "Add goal")
+
+		CreateBranch(tmpDir, "feature")
+		if err := CreateBranch(tmpDir, "feature"); err == nil {
+			t.Error("Expected error for existing branch, got nil")
+		}
+	})
+
+	t.Run("fails for a missing branch", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		commitFile(t, tmpDir, "goal.md", "v1", "Add goal")
+
+		if err := Checkout(tmpDir, "missing"); err == nil {
+			t.Error("Expected error for missing branch, got nil")
+		}
+	})
+
+	t.Run("keeps uncommitted changes", func(t *testing.T) {
+		if !gitInstalled() {
+			t.Skip("carrying changes over needs git installed")
+		}
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		commitFile(t, tmpDir, "goal.md", "v1", "Add goal")
+		CreateBranch(tmpDir, "feature")
+
+		os.WriteFile(filepath.Join(tmpDir, "notes.md"), []byte("draft"), 0644)
+		if err := Checkout(tmpDir, "feature"); err != nil {
+			t.Fatalf("Checkout() error = %v", err)
+		}
+		if content, _ := os.ReadFile(filepath.Join(tmpDir, "notes.md")); string(content) != "draft" {
+			t.Errorf("notes.md = %q after checkout, want draft", content)
+		}
+	})
+}
+
+func TestAuth(t *testing.T) {
+	t.Run("sends the token to HTTPS remotes", func(t *testing.T) {
+		tmpDir := setupTestRepo(t)
+		defer os.RemoveAll(tmpDir)
+		addRemote(t, tmpDir, "https://github.com/example/growth.git")
+
+		repo, _ := openRepo(tmpDir)
+		method, err := goGitAuth(repo, DefaultRemote, Auth{Token: "secret"})
+		if err != nil {
+			t.Fatalf("goGitAuth() error = %v", err)
+		}
+		basic, ok := method.(*githttp.BasicAuth)
+		if !ok || basic.Username != "git" || basic.Password != "secret" {
+			t.Errorf("goGitAuth() = %#v, want basic auth with the token", method)
+		}
+
+		method, err = goGitAuth(repo, DefaultRemote, Auth{})
+		if err != nil || method != nil {
+			t.Errorf("goGitAuth() without a token = %v, %v, want no auth", method, err)
+		}
+	})
+
+	t.Run("passes the token to git in the environment", func(t *testing.T) {
+		cmd := remoteCommand(t.Context(), ".", Auth{Token: "secret", Username: "me"}, "fetch")
+
+		header := "GIT_CONFIG_VALUE_0=Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte("me:secret"))
+		found := false
+		for _, env := range cmd.Env {
+			found = found || env == header
+		}
+		if !found {
+			t.Errorf("expected %s in the environment", header)
+		}
+		if strings.Contains(strings.Join(cmd.Args, " "), "secret") {
+			t.Errorf("the token should not be in the arguments: %v", cmd.Args)
+		}
+	})
+
+	t.Run("reads the token from the environment", func(t *testing.T) {
+		t.Setenv(TokenEnvVar, "from-env")
+		if auth := AuthFromEnv(); auth.Token != "from-env" {
+			t.Errorf("AuthFromEnv() = %+v", auth)
+		}
+	})
+}
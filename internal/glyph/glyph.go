@@ -0,0 +1,75 @@
+// Package glyph holds the non-ASCII symbols and emoji the CLI prints, with
+// ASCII fallbacks for terminals and logs that cannot display them.
+package glyph
+
+import "strings"
+
+// Glyph is a symbol and the ASCII text that replaces it in ASCII mode
+type Glyph struct {
+	Symbol string
+	ASCII  string
+}
+
+var (
+	Check   = Glyph{Symbol: "✓", ASCII: "+"}
+	Cross   = Glyph{Symbol: "✗", ASCII: "x"}
+	Warning = Glyph{Symbol: "⚠", ASCII: "!"}
+	Info    = Glyph{Symbol: "ℹ", ASCII: "i"}
+	Bullet  = Glyph{Symbol: "•", ASCII: "*"}
+	Arrow   = Glyph{Symbol: "→", ASCII: "->"}
+
+	// Tree lines, for nested output
+	Branch     = Glyph{Symbol: "├─", ASCII: "|-"}
+	LastBranch = Glyph{Symbol: "└─", ASCII: "`-"}
+	Pipe       = Glyph{Symbol: "│ ", ASCII: "| "}
+)
+
+// ascii is set by SetASCII when output must stay plain ASCII
+var ascii bool
+
+// SetASCII turns ASCII mode on or off for all output
+func SetASCII(enabled bool) {
+	ascii = enabled
+}
+
+// IsASCII reports whether ASCII mode is on
+func IsASCII() bool {
+	return ascii
+}
+
+// String returns the symbol, or its ASCII fallback in ASCII mode
+func (g Glyph) String() string {
+	if ascii {
+		return g.ASCII
+	}
+	return g.Symbol
+}
+
+// Emoji returns the emoji followed by a space, to prefix a heading with, or
+// nothing in ASCII mode
+func Emoji(emoji string) string {
+	if ascii {
+		return ""
+	}
+	return emoji + " "
+}
+
+// DetectASCII reports whether the environment asks for ASCII output: a dumb
+// terminal, or a locale that is set but is not UTF-8. getenv is usually
+// os.Getenv.
+func DetectASCII(getenv func(string) string) bool {
+	if getenv("TERM") == "dumb" {
+		return true
+	}
+
+	// The first of these that is set decides the character encoding
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		locale := getenv(name)
+		if locale == "" {
+			continue
+		}
+		locale = strings.ToLower(locale)
+		return !strings.Contains(locale, "utf-8") && !strings.Contains(locale, "utf8")
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package glyph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlyphString(t *testing.T) {
+	t.Cleanup(func() { SetASCII(false) })
+
+	assert.Equal(t, "✓", Check.String())
+	assert.Equal(t, "🤖 ", Emoji("🤖"))
+
+	SetASCII(true)
+	assert.True(t, IsASCII())
+	assert.Equal(t, "+", Check.String())
+	assert.Equal(t, "->", Arrow.String())
+	assert.Empty(t, Emoji("🤖"))
+}
+
+func TestDetectASCII(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"unset locale", map[string]string{}, false},
+		{"utf-8 locale", map[string]string{"LANG": "en_US.UTF-8"}, false},
+		{"utf8 spelling", map[string]string{"LANG": "de_DE.utf8"}, false},
+		{"C locale", map[string]string{"LANG": "C"}, true},
+		{"LC_ALL wins over LANG", map[string]string{"LC_ALL": "POSIX", "LANG": "en_US.UTF-8"}, true},
+		{"LC_CTYPE wins over LANG", map[string]string{"LC_CTYPE": "C.UTF-8", "LANG": "C"}, false},
+		{"dumb terminal", map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(name string) string { return tt.env[name] }
+			assert.Equal(t, tt.want, DetectASCII(getenv))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package i18n translates CLI messages and names the language AI output
+// should be written in.
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Language is a supported language, identified by its ISO 639-1 code
+type Language struct {
+	Code         string
+	Name         string // English name, as used in AI prompts
+	DecimalComma bool   // numbers are written as 1,5 rather than 1.5
+}
+
+// Languages lists the supported languages, English first
+var Languages = []Language{
+	{Code: "en", Name: "English"},
+	{Code: "uk", Name: "Ukrainian", DecimalComma: true},
+	{Code: "de", Name: "German", DecimalComma: true},
+	{Code: "es", Name: "Spanish", DecimalComma: true},
+	{Code: "fr", Name: "French", DecimalComma: true},
+	{Code: "pl", Name: "Polish", DecimalComma: true},
+}
+
+// current is the code of the language messages are translated to
+var current = "en"
+
+// Codes returns the codes of the supported languages
+func Codes() []string {
+	codes := make([]string, len(Languages))
+	for i, language := range Languages {
+		codes[i] = language.Code
+	}
+	return codes
+}
+
+// Lookup finds a supported language by code, ignoring case
+func Lookup(code string) (Language, bool) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	for _, language := range Languages {
+		if language.Code == code {
+			return language, true
+		}
+	}
+	return Language{}, false
+}
+
+// SetLanguage selects the language T translates to. Unsupported codes,
+// including an empty one, select English.
+func SetLanguage(code string) {
+	current = "en"
+	if language, ok := Lookup(code); ok {
+		current = language.Code
+	}
+}
+
+// T returns the translation of an English message in the selected language,
+// or the message itself when there is none
+func T(message string) string {
+	if translated, ok := messages[current][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// FormatNumber formats v with the given number of decimals, using the
+// decimal separator of the selected language
+func FormatNumber(v float64, decimals int) string {
+	text := strconv.FormatFloat(v, 'f', decimals, 64)
+	if language, ok := Lookup(current); ok && language.DecimalComma {
+		text = strings.Replace(text, ".", ",", 1)
+	}
+	return text
+}
+
+// PromptLanguage returns the name of the language AI output should be
+// written in, or an empty string for English and unsupported codes
+func PromptLanguage(code string) string {
+	language, ok := Lookup(code)
+	if !ok || language.Code == "en" {
+		return ""
+	}
+	return language.Name
+}
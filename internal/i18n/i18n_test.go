@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	language, ok := Lookup(" UK ")
+	assert.True(t, ok)
+	assert.Equal(t, "Ukrainian", language.Name)
+
+	_, ok = Lookup("xx")
+	assert.False(t, ok)
+}
+
+func TestT(t *testing.T) {
+	t.Cleanup(func() { SetLanguage("en") })
+
+	assert.Equal(t, "No goals found", T("No goals found"))
+
+	SetLanguage("de")
+	assert.Equal(t, "Keine Ziele gefunden", T("No goals found"))
+	assert.Equal(t, "Created goal goal-001", T("Created goal goal-001"), "untranslated messages stay in English")
+
+	SetLanguage("xx")
+	assert.Equal(t, "No goals found", T("No goals found"))
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Cleanup(func() { SetLanguage("en") })
+
+	assert.Equal(t, "12.5", FormatNumber(12.5, 1))
+	assert.Equal(t, "3", FormatNumber(2.6, 0))
+
+	SetLanguage("uk")
+	assert.Equal(t, "12,5", FormatNumber(12.5, 1))
+	assert.Equal(t, "-0,25", FormatNumber(-0.25, 2))
+}
+
+func TestPromptLanguage(t *testing.T) {
+	assert.Equal(t, "", PromptLanguage(""))
+	assert.Equal(t, "", PromptLanguage("en"))
+	assert.Equal(t, "", PromptLanguage("xx"))
+	assert.Equal(t, "Polish", PromptLanguage("pl"))
+}
+
+func TestCatalogsAreComplete(t *testing.T) {
+	reference := messages["uk"]
+	for _, language := range Languages[1:] {
+		catalog, ok := messages[language.Code]
+		if !assert.True(t, ok, "missing catalog for %s", language.Code) {
+			continue
+		}
+		for message := range reference {
+			assert.Contains(t, catalog, message, "%s has no translation for %q", language.Code, message)
+		}
+		assert.Len(t, catalog, len(reference), language.Code)
+	}
+}
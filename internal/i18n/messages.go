@@ -0,0 +1,82 @@
+package i18n
+
+// messages holds the translations of common CLI messages, keyed by language
+// code and then by the English message. Messages without a translation are
+// shown in English.
+var messages = map[string]map[string]string{
+	"uk": {
+		"Deletion cancelled": "Видалення скасовано",
+		"No changes specified. Use flags to update fields.": "Зміни не вказано. Використайте прапорці, щоб оновити поля.",
+		"No changes made":                       "Змін не внесено",
+		"Nothing saved":                         "Нічого не збережено",
+		"No goals found":                        "Цілей не знайдено",
+		"No skills found":                       "Навичок не знайдено",
+		"No paths found":                        "Навчальних шляхів не знайдено",
+		"No resources found":                    "Ресурсів не знайдено",
+		"No progress logs found":                "Записів про прогрес не знайдено",
+		"No milestones found":                   "Віх не знайдено",
+		"No notes found":                        "Нотаток не знайдено",
+		"this field is required":                "це поле обов'язкове",
+		"Invalid selection, using first option": "Неправильний вибір, використано перший варіант",
+	},
+	"de": {
+		"Deletion cancelled": "Löschen abgebrochen",
+		"No changes specified. Use flags to update fields.": "Keine Änderungen angegeben. Verwende Flags, um Felder zu aktualisieren.",
+		"No changes made":                       "Keine Änderungen vorgenommen",
+		"Nothing saved":                         "Nichts gespeichert",
+		"No goals found":                        "Keine Ziele gefunden",
+		"No skills found":                       "Keine Fähigkeiten gefunden",
+		"No paths found":                        "Keine Lernpfade gefunden",
+		"No resources found":                    "Keine Ressourcen gefunden",
+		"No progress logs found":                "Keine Fortschrittseinträge gefunden",
+		"No milestones found":                   "Keine Meilensteine gefunden",
+		"No notes found":                        "Keine Notizen gefunden",
+		"this field is required":                "dieses Feld ist erforderlich",
+		"Invalid selection, using first option": "Ungültige Auswahl, erste Option wird verwendet",
+	},
+	"es": {
+		"Deletion cancelled": "Eliminación cancelada",
+		"No changes specified. Use flags to update fields.": "No se especificaron cambios. Usa flags para actualizar campos.",
+		"No changes made":                       "No se hicieron cambios",
+		"Nothing saved":                         "No se guardó nada",
+		"No goals found":                        "No se encontraron objetivos",
+		"No skills found":                       "No se encontraron habilidades",
+		"No paths found":                        "No se encontraron rutas de aprendizaje",
+		"No resources found":                    "No se encontraron recursos",
+		"No progress logs found":                "No se encontraron registros de progreso",
+		"No milestones found":                   "No se encontraron hitos",
+		"No notes found":                        "No se encontraron notas",
+		"this field is required":                "este campo es obligatorio",
+		"Invalid selection, using first option": "Selección no válida, se usa la primera opción",
+	},
+	"fr": {
+		"Deletion cancelled": "Suppression annulée",
+		"No changes specified. Use flags to update fields.": "Aucune modification indiquée. Utilisez les options pour mettre à jour les champs.",
+		"No changes made":                       "Aucune modification effectuée",
+		"Nothing saved":                         "Rien n'a été enregistré",
+		"No goals found":                        "Aucun objectif trouvé",
+		"No skills found":                       "Aucune compétence trouvée",
+		"No paths found":                        "Aucun parcours trouvé",
+		"No resources found":                    "Aucune ressource trouvée",
+		"No progress logs found":                "Aucun journal de progression trouvé",
+		"No milestones found":                   "Aucun jalon trouvé",
+		"No notes found":                        "Aucune note trouvée",
+		"this field is required":                "ce champ est obligatoire",
+		"Invalid selection, using first option": "Sélection invalide, première option utilisée",
+	},
+	"pl": {
+		"Deletion cancelled": "Usuwanie anulowane",
+		"No changes specified. Use flags to update fields.": "Nie podano zmian. Użyj flag, aby zaktualizować pola.",
+		"No changes made":                       "Nie wprowadzono zmian",
+		"Nothing saved":                         "Nic nie zapisano",
+		"No goals found":                        "Nie znaleziono celów",
+		"No skills found":                       "Nie znaleziono umiejętności",
+		"No paths found":                        "Nie znaleziono ścieżek nauki",
+		"No resources found":                    "Nie znaleziono zasobów",
+		"No progress logs found":                "Nie znaleziono wpisów postępu",
+		"No milestones found":                   "Nie znaleziono kamieni milowych",
+		"No notes found":                        "Nie znaleziono notatek",
+		"this field is required":                "to pole jest wymagane",
+		"Invalid selection, using first option": "Nieprawidłowy wybór, użyto pierwszej opcji",
+	},
+}
@@ -0,0 +1,207 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Activity sources recognised by ParseActivityCSV from the export's header
+const (
+	SourceToggl      = "toggl"
+	SourceRescueTime = "rescuetime"
+	SourceCSV        = "csv"
+)
+
+// Session is a block of time tracked in a time tracker or activity app
+type Session struct {
+	Date        time.Time // day the session started, at midnight
+	Hours       float64
+	Description string
+}
+
+// Header names recognised in activity CSV exports, in order of preference.
+// They cover Toggl Track (Start date, Duration, Description), RescueTime
+// (Date, Time Spent (seconds), Activity) and health-app style exports with a
+// date and a minutes column.
+var (
+	activityDateColumns        = []string{"start date", "date", "day", "start", "start time"}
+	activityDescriptionColumns = []string{"description", "activity", "task", "title", "project", "category"}
+
+	// duration columns and the hours one unit of them is
+	activityDurationColumns = []struct {
+		name  string
+		hours float64
+	}{
+		{"duration", 0},
+		{"time spent (seconds)", 1.0 / 3600},
+		{"duration (seconds)", 1.0 / 3600},
+		{"seconds", 1.0 / 3600},
+		{"duration (minutes)", 1.0 / 60},
+		{"duration (min)", 1.0 / 60},
+		{"minutes", 1.0 / 60},
+		{"hours", 1},
+	}
+)
+
+var activityDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	"01/02/2006",
+	"2006/01/02",
+}
+
+// ParseActivityCSV reads sessions from a CSV export of a time tracker and
+// returns them with the source the header looks like it came from. Rows
+// without a date or with no time spent are skipped.
+func ParseActivityCSV(r io.Reader) ([]Session, string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read activity header: %w", err)
+	}
+
+	names := make([]string, len(header))
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))
+		names[i] = name
+		if _, exists := columns[name]; !exists {
+			columns[name] = i
+		}
+	}
+
+	column := func(candidates []string) int {
+		for _, name := range candidates {
+			if i, ok := columns[name]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+
+	dateCol := column(activityDateColumns)
+	if dateCol < 0 {
+		return nil, "", errors.New("activity export is missing a date column (start date, date or day)")
+	}
+	durationCol, unit := -1, 0.0
+	for _, candidate := range activityDurationColumns {
+		if i, ok := columns[candidate.name]; ok {
+			durationCol, unit = i, candidate.hours
+			break
+		}
+	}
+	if durationCol < 0 {
+		return nil, "", errors.New("activity export is missing a duration column (duration, time spent (seconds), minutes or hours)")
+	}
+	descriptionCol := column(activityDescriptionColumns)
+
+	source := SourceCSV
+	switch {
+	case names[dateCol] == "start date" && names[durationCol] == "duration":
+		source = SourceToggl
+	case names[durationCol] == "time spent (seconds)":
+		source = SourceRescueTime
+	}
+
+	field := func(record []string, i int) string {
+		if i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var sessions []Session
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read activity export: %w", err)
+		}
+
+		date, ok := parseActivityDate(field(record, dateCol))
+		if !ok {
+			continue
+		}
+		hours, err := parseActivityHours(field(record, durationCol), unit)
+		if err != nil {
+			return nil, "", fmt.Errorf("line %d: %w", line, err)
+		}
+		if hours <= 0 {
+			continue
+		}
+
+		sessions = append(sessions, Session{
+			Date:        date,
+			Hours:       hours,
+			Description: field(record, descriptionCol),
+		})
+	}
+
+	return sessions, source, nil
+}
+
+// parseActivityDate reads the day of a date or timestamp
+func parseActivityDate(value string) (time.Time, bool) {
+	for _, layout := range activityDateLayouts {
+		if date, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			if layout == time.RFC3339 {
+				date = date.Local()
+			}
+			return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseActivityHours converts a duration to hours. A unit of 0 means the
+// duration is written as H:MM:SS, H:MM, a Go duration like 1h30m, or hours.
+func parseActivityHours(value string, unit float64) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if unit > 0 {
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+		return amount * unit, nil
+	}
+
+	if strings.Contains(value, ":") {
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 {
+			return 0, fmt.Errorf("invalid duration '%s', must be H:MM:SS", value)
+		}
+		hours := 0.0
+		for i, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid duration '%s', must be H:MM:SS", value)
+			}
+			hours += float64(n) / math.Pow(60, float64(i))
+		}
+		return hours, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return duration.Hours(), nil
+	}
+	hours, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration '%s', must be H:MM:SS or hours", value)
+	}
+	return hours, nil
+}
@@ -0,0 +1,88 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.Local)
+}
+
+func TestParseActivityCSV(t *testing.T) {
+	t.Run("parses Toggl exports", func(t *testing.T) {
+		data := "User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags\n" +
+			"Ann,ann@example.com,,Learning,,Go concurrency course,No,2025-03-03,19:00:00,2025-03-03,20:30:00,01:30:00,study\n" +
+			"Ann,ann@example.com,,Learning,,Kubernetes lab,No,2025-03-05,19:00:00,2025-03-05,19:45:00,00:45:00,\n"
+
+		sessions, source, err := ParseActivityCSV(strings.NewReader(data))
+
+		require.NoError(t, err)
+		assert.Equal(t, SourceToggl, source)
+		assert.Equal(t, []Session{
+			{Date: day(2025, 3, 3), Hours: 1.5, Description: "Go concurrency course"},
+			{Date: day(2025, 3, 5), Hours: 0.75, Description: "Kubernetes lab"},
+		}, sessions)
+	})
+
+	t.Run("parses RescueTime exports in seconds", func(t *testing.T) {
+		data := "Date,Time Spent (seconds),Number of People,Activity,Category,Productivity\n" +
+			"2025-03-03T00:00:00,5400,1,coursera.org,Learning,2\n" +
+			"2025-03-04T00:00:00,0,1,youtube.com,Video,-2\n"
+
+		sessions, source, err := ParseActivityCSV(strings.NewReader(data))
+
+		require.NoError(t, err)
+		assert.Equal(t, SourceRescueTime, source)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, 1.5, sessions[0].Hours)
+		assert.Equal(t, "coursera.org", sessions[0].Description)
+	})
+
+	t.Run("parses health-app style exports in minutes", func(t *testing.T) {
+		data := "\uFEFFDay,Minutes\n03/10/2025,45\nnot a date,30\n"
+
+		sessions, source, err := ParseActivityCSV(strings.NewReader(data))
+
+		require.NoError(t, err)
+		assert.Equal(t, SourceCSV, source)
+		assert.Equal(t, []Session{{Date: day(2025, 3, 10), Hours: 0.75}}, sessions)
+	})
+
+	t.Run("reports invalid durations with their line", func(t *testing.T) {
+		_, _, err := ParseActivityCSV(strings.NewReader("date,duration\n2025-03-03,1:30\n2025-03-04,soon\n"))
+		assert.ErrorContains(t, err, "line 3")
+	})
+
+	t.Run("fails without a date or duration column", func(t *testing.T) {
+		_, _, err := ParseActivityCSV(strings.NewReader("when,duration\n"))
+		assert.ErrorContains(t, err, "missing a date column")
+
+		_, _, err = ParseActivityCSV(strings.NewReader("date,effort\n"))
+		assert.ErrorContains(t, err, "missing a duration column")
+	})
+}
+
+func TestParseActivityHours(t *testing.T) {
+	tests := []struct {
+		value string
+		want  float64
+	}{
+		{"1:30:00", 1.5},
+		{"0:45", 0.75},
+		{"1h15m", 1.25},
+		{"2.5", 2.5},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			hours, err := parseActivityHours(tt.value, 0)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, hours, 1e-9)
+		})
+	}
+}
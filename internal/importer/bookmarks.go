@@ -0,0 +1,234 @@
+// Package importer parses exports from other tools into data growth.md can store.
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"golang.org/x/net/html"
+)
+
+// Bookmark formats supported by ParseBookmarks
+const (
+	FormatNetscape = "netscape"
+	FormatPocket   = "pocket"
+)
+
+// Bookmark is a saved link from a browser or read-it-later service
+type Bookmark struct {
+	Title  string
+	URL    string
+	Tags   []string
+	Folder string
+	Added  time.Time
+}
+
+// ParseBookmarks parses bookmarks in the given format.
+// Pocket exports are accepted both as the legacy HTML file and as CSV.
+func ParseBookmarks(r io.Reader, format string) ([]Bookmark, error) {
+	switch format {
+	case FormatNetscape:
+		return parseBookmarkHTML(r)
+	case FormatPocket:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		trimmed := strings.TrimSpace(string(data))
+		if strings.HasPrefix(trimmed, "<") {
+			return parseBookmarkHTML(strings.NewReader(trimmed))
+		}
+		return parsePocketCSV(strings.NewReader(trimmed))
+	default:
+		return nil, fmt.Errorf("unsupported bookmark format '%s' (must be netscape or pocket)", format)
+	}
+}
+
+// parseBookmarkHTML reads anchors from Netscape bookmark files and Pocket HTML exports.
+// Folder names come from the nearest preceding <H3> heading.
+func parseBookmarkHTML(r io.Reader) ([]Bookmark, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bookmarks: %w", err)
+	}
+
+	var bookmarks []Bookmark
+	folder := ""
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "h3", "h1":
+				folder = strings.TrimSpace(textContent(n))
+			case "a":
+				href := strings.TrimSpace(attr(n, "href"))
+				if isWebURL(href) {
+					title := strings.TrimSpace(textContent(n))
+					if title == "" {
+						title = href
+					}
+					bookmarks = append(bookmarks, Bookmark{
+						Title:  title,
+						URL:    href,
+						Tags:   splitTags(attr(n, "tags")),
+						Folder: folder,
+						Added:  parseUnix(firstNonEmpty(attr(n, "add_date"), attr(n, "time_added"))),
+					})
+				}
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return bookmarks, nil
+}
+
+// parsePocketCSV reads Pocket CSV exports with a header row such as
+// title,url,time_added,tags,status.
+func parsePocketCSV(r io.Reader) ([]Bookmark, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pocket export header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	urlCol, ok := columns["url"]
+	if !ok {
+		return nil, errors.New("pocket export is missing a 'url' column")
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var bookmarks []Bookmark
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pocket export: %w", err)
+		}
+		if urlCol >= len(record) || !isWebURL(strings.TrimSpace(record[urlCol])) {
+			continue
+		}
+
+		link := strings.TrimSpace(record[urlCol])
+		title := field(record, "title")
+		if title == "" {
+			title = link
+		}
+
+		bookmarks = append(bookmarks, Bookmark{
+			Title: title,
+			URL:   link,
+			Tags:  splitTags(strings.ReplaceAll(field(record, "tags"), "|", ",")),
+			Added: parseUnix(field(record, "time_added")),
+		})
+	}
+
+	return bookmarks, nil
+}
+
+// GuessResourceType infers a resource type from a URL
+func GuessResourceType(rawURL string) core.ResourceType {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return core.ResourceArticle
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.ToLower(u.Path)
+
+	switch {
+	case host == "youtube.com" || host == "youtu.be" || host == "vimeo.com":
+		return core.ResourceVideo
+	case host == "github.com" || host == "gitlab.com":
+		return core.ResourceProject
+	case host == "coursera.org" || host == "udemy.com" || host == "edx.org" || host == "pluralsight.com":
+		return core.ResourceCourse
+	case strings.HasPrefix(host, "docs.") || strings.Contains(path, "/docs/") || strings.Contains(path, "/documentation/"):
+		return core.ResourceDocumentation
+	default:
+		return core.ResourceArticle
+	}
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(n)
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func isWebURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func parseUnix(s string) time.Time {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const netscapeExport = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3 ADD_DATE="1700000000">Go</H3>
+    <DL><p>
+        <DT><A HREF="https://go.dev/blog/pipelines" ADD_DATE="1700000100" TAGS="go,concurrency">Go Concurrency Patterns: Pipelines</A>
+        <DT><A HREF="https://www.youtube.com/watch?v=f6kdp27TYZs" ADD_DATE="1700000200">Google I/O 2012 - Go Concurrency Patterns</A>
+    </DL><p>
+    <DT><A HREF="javascript:void(0)">Bookmarklet</A>
+    <DT><A HREF="https://github.com/golang/go">golang/go</A>
+</DL><p>`
+
+func TestParseBookmarks_Netscape(t *testing.T) {
+	bookmarks, err := ParseBookmarks(strings.NewReader(netscapeExport), FormatNetscape)
+
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 3)
+
+	assert.Equal(t, "Go Concurrency Patterns: Pipelines", bookmarks[0].Title)
+	assert.Equal(t, "https://go.dev/blog/pipelines", bookmarks[0].URL)
+	assert.Equal(t, []string{"go", "concurrency"}, bookmarks[0].Tags)
+	assert.Equal(t, "Go", bookmarks[0].Folder)
+	assert.Equal(t, time.Unix(1700000100, 0), bookmarks[0].Added)
+
+	assert.Equal(t, "golang/go", bookmarks[2].Title)
+}
+
+func TestParseBookmarks_Pocket(t *testing.T) {
+	t.Run("parses HTML export", func(t *testing.T) {
+		input := `<!DOCTYPE html><html><body>
+<h1>Unread</h1>
+<ul>
+<li><a href="https://example.com/epoll" time_added="1700000000" tags="linux,io">Epoll explained</a></li>
+</ul>
+<h1>Read Archive</h1>
+<ul>
+<li><a href="https://example.com/kqueue" time_added="1700000500" tags="">Kqueue</a></li>
+</ul>
+</body></html>`
+
+		bookmarks, err := ParseBookmarks(strings.NewReader(input), FormatPocket)
+
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 2)
+		assert.Equal(t, "Epoll explained", bookmarks[0].Title)
+		assert.Equal(t, []string{"linux", "io"}, bookmarks[0].Tags)
+		assert.Equal(t, "Unread", bookmarks[0].Folder)
+		assert.Equal(t, "Read Archive", bookmarks[1].Folder)
+	})
+
+	t.Run("parses CSV export", func(t *testing.T) {
+		input := `title,url,time_added,tags,status
+Epoll explained,https://example.com/epoll,1700000000,linux|io,unread
+,https://example.com/untitled,1700000001,,archive
+Broken,not-a-url,1700000002,,unread`
+
+		bookmarks, err := ParseBookmarks(strings.NewReader(input), FormatPocket)
+
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 2)
+		assert.Equal(t, []string{"linux", "io"}, bookmarks[0].Tags)
+		assert.Equal(t, "https://example.com/untitled", bookmarks[1].Title)
+	})
+
+	t.Run("fails without url column", func(t *testing.T) {
+		_, err := ParseBookmarks(strings.NewReader("title,link\nA,B"), FormatPocket)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseBookmarks_UnknownFormat(t *testing.T) {
+	_, err := ParseBookmarks(strings.NewReader(""), "chrome")
+	assert.Error(t, err)
+}
+
+func TestGuessResourceType(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected core.ResourceType
+	}{
+		{"https://www.youtube.com/watch?v=abc", core.ResourceVideo},
+		{"https://github.com/golang/go", core.ResourceProject},
+		{"https://www.coursera.org/learn/go", core.ResourceCourse},
+		{"https://docs.python.org/3/", core.ResourceDocumentation},
+		{"https://go.dev/blog/pipelines", core.ResourceArticle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GuessResourceType(tt.url))
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FormatEmail is a completion email, or an mbox of them, for
+// ParseCertificates, which also reads FormatCSV
+const FormatEmail = "email"
+
+// Certificate is a course completion certificate from a course platform
+type Certificate struct {
+	Course    string
+	Platform  string // e.g. coursera or udemy
+	URL       string // verification link
+	Completed time.Time
+}
+
+// Header names recognised in certificate CSV exports, in order of preference
+var (
+	certificateCourseColumns   = []string{"course", "course name", "course title", "title", "name"}
+	certificateURLColumns      = []string{"certificate url", "certificate link", "verify url", "certificate", "url", "link"}
+	certificateDateColumns     = []string{"completion date", "completed", "completed at", "completed on", "issue date", "issued", "date"}
+	certificatePlatformColumns = []string{"platform", "provider", "issuer", "source"}
+)
+
+var (
+	// certificateURL matches the verification links of Coursera and Udemy
+	// certificates
+	certificateURL = regexp.MustCompile(`https?://(?:www\.)?(?:coursera\.org/(?:verify|share|account/accomplishments/(?:verify|certificate|specialization|professional-cert))/[A-Za-z0-9_-]+|udemy\.com/certificate/UC-[A-Za-z0-9-]+|ude\.my/UC-[A-Za-z0-9-]+)/?`)
+
+	// certificateCourse finds the course in completion email subjects like
+	// "Congratulations on completing Go: The Complete Developer's Guide!"
+	certificateCourse = regexp.MustCompile(`(?i)(?:completed|completing|finished|certificate of completion for|certificate for|accomplishment for)\s+(?:the\s+course\s+)?["“]?(.+?)["”]?\s*(?:[!.]|\s+is ready|\s+on (?:coursera|udemy))?\s*$`)
+)
+
+// GuessCertificateFormat picks a format from a file extension
+func GuessCertificateFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".eml", ".mbox", ".msg":
+		return FormatEmail
+	default:
+		return FormatCSV
+	}
+}
+
+// ParseCertificates reads certificates in the given format. Email files may
+// be a single message or an mbox with several, like a mail client export.
+// Entries without a course or certificate link are skipped.
+func ParseCertificates(r io.Reader, format string) ([]Certificate, error) {
+	var certificates []Certificate
+	var err error
+
+	switch format {
+	case FormatCSV:
+		certificates, err = parseCertificateCSV(r)
+	case FormatEmail:
+		certificates, err = parseCertificateEmails(r)
+	default:
+		return nil, fmt.Errorf("unsupported certificate format '%s' (must be csv or email)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Certificate
+	for _, c := range certificates {
+		c.Course = strings.TrimSpace(c.Course)
+		if c.Course == "" || c.URL == "" {
+			continue
+		}
+		if c.Platform == "" {
+			c.Platform = certificatePlatform(c.URL)
+		}
+		results = append(results, c)
+	}
+	return results, nil
+}
+
+func parseCertificateCSV(r io.Reader) ([]Certificate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))
+		if _, exists := columns[name]; !exists {
+			columns[name] = i
+		}
+	}
+
+	column := func(candidates []string) int {
+		for _, name := range candidates {
+			if i, ok := columns[name]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+
+	courseCol := column(certificateCourseColumns)
+	if courseCol < 0 {
+		return nil, errors.New("certificate export is missing a course column (course, course name or title)")
+	}
+	urlCol := column(certificateURLColumns)
+	if urlCol < 0 {
+		return nil, errors.New("certificate export is missing a certificate URL column (certificate url or url)")
+	}
+	dateCol := column(certificateDateColumns)
+	platformCol := column(certificatePlatformColumns)
+
+	field := func(record []string, i int) string {
+		if i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var certificates []Certificate
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificates: %w", err)
+		}
+
+		link := field(record, urlCol)
+		if !isWebURL(link) {
+			continue
+		}
+		completed, _ := parseActivityDate(field(record, dateCol))
+		certificates = append(certificates, Certificate{
+			Course:    field(record, courseCol),
+			Platform:  strings.ToLower(field(record, platformCol)),
+			URL:       link,
+			Completed: completed,
+		})
+	}
+
+	return certificates, nil
+}
+
+// parseCertificateEmails splits an mbox into messages and reads the
+// certificate of each completion email
+func parseCertificateEmails(r io.Reader) ([]Certificate, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []Certificate
+	for _, raw := range splitMbox(data) {
+		msg, err := mail.ReadMessage(bytes.NewReader(raw))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse email: %w", err)
+		}
+		certificate, ok := parseCertificateEmail(msg)
+		if ok {
+			certificates = append(certificates, certificate)
+		}
+	}
+	return certificates, nil
+}
+
+// splitMbox returns the messages of an mbox file, or the data itself when
+// it is a single message
+func splitMbox(data []byte) [][]byte {
+	if !bytes.HasPrefix(data, []byte("From ")) {
+		return [][]byte{data}
+	}
+
+	var messages [][]byte
+	var current bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 10<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if bytes.HasPrefix(line, []byte("From ")) {
+			if current.Len() > 0 {
+				messages = append(messages, bytes.Clone(current.Bytes()))
+				current.Reset()
+			}
+			continue
+		}
+		if bytes.HasPrefix(line, []byte(">From ")) {
+			line = line[1:]
+		}
+		current.Write(line)
+		current.WriteByte('\n')
+	}
+	if current.Len() > 0 {
+		messages = append(messages, current.Bytes())
+	}
+	return messages
+}
+
+// parseCertificateEmail finds the certificate link in the body and the
+// course in the subject, or the first line of the body naming it
+func parseCertificateEmail(msg *mail.Message) (Certificate, bool) {
+	body := emailText(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	link := certificateURL.FindString(body)
+	if link == "" {
+		return Certificate{}, false
+	}
+
+	subject := msg.Header.Get("Subject")
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err == nil {
+		subject = decoded
+	}
+	course := courseFromLine(subject)
+	if course == "" {
+		for _, line := range strings.Split(body, "\n") {
+			if course = courseFromLine(line); course != "" {
+				break
+			}
+		}
+	}
+
+	certificate := Certificate{Course: course, URL: strings.TrimSuffix(link, "/")}
+	if date, err := msg.Header.Date(); err == nil {
+		certificate.Completed = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	}
+	return certificate, true
+}
+
+func courseFromLine(line string) string {
+	text := html.UnescapeString(htmlTags.ReplaceAllString(line, " "))
+	match := certificateCourse.FindStringSubmatch(strings.TrimSpace(text))
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(match[1])
+}
+
+var htmlTags = regexp.MustCompile(`<[^>]*>`)
+
+// emailText decodes a message body to text, joining the text and HTML parts
+// of multipart messages
+func emailText(contentType, encoding string, body io.Reader) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		var parts []string
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err != nil {
+				break
+			}
+			parts = append(parts, emailText(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part))
+		}
+		return strings.Join(parts, "\n")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	data, _ := io.ReadAll(io.LimitReader(body, 10<<20))
+	return string(data)
+}
+
+// certificatePlatform names the platform a certificate link belongs to
+func certificatePlatform(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case strings.HasSuffix(host, "coursera.org"):
+		return "coursera"
+	case strings.HasSuffix(host, "udemy.com"), host == "ude.my":
+		return "udemy"
+	default:
+		return host
+	}
+}
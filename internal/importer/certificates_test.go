@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const udemyEmail = `From: Udemy <no-reply@e.udemymail.com>
+To: ann@example.com
+Subject: Congratulations on completing Go: The Complete Developer's Guide!
+Date: Mon, 3 Mar 2025 18:04:00 +0000
+Content-Type: text/plain; charset=utf-8
+
+Hi Ann,
+
+You can share your certificate: https://www.udemy.com/certificate/UC-1f2e3d4c-5b6a-7980/
+`
+
+const courseraEmail = `From: Coursera <no-reply@t.mail.coursera.org>
+Subject: =?UTF-8?Q?Your_Course_Certificate_is_ready?=
+Date: Fri, 14 Mar 2025 09:00:00 +0000
+MIME-Version: 1.0
+Content-Type: multipart/alternative; boundary="b1"
+
+--b1
+Content-Type: text/html; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+<p>Congratulations! You completed <b>Machine Learning</b>.</p>
+<a href=3D"https://coursera.org/verify/ABC123XYZ">View certificate</a>
+--b1--
+`
+
+func TestParseCertificates_Email(t *testing.T) {
+	t.Run("reads the course from the subject", func(t *testing.T) {
+		certificates, err := ParseCertificates(strings.NewReader(udemyEmail), FormatEmail)
+
+		require.NoError(t, err)
+		require.Len(t, certificates, 1)
+		assert.Equal(t, "Go: The Complete Developer's Guide", certificates[0].Course)
+		assert.Equal(t, "udemy", certificates[0].Platform)
+		assert.Equal(t, "https://www.udemy.com/certificate/UC-1f2e3d4c-5b6a-7980", certificates[0].URL)
+		assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.Local), certificates[0].Completed)
+	})
+
+	t.Run("reads the course from an HTML body", func(t *testing.T) {
+		certificates, err := ParseCertificates(strings.NewReader(courseraEmail), FormatEmail)
+
+		require.NoError(t, err)
+		require.Len(t, certificates, 1)
+		assert.Equal(t, "Machine Learning", certificates[0].Course)
+		assert.Equal(t, "coursera", certificates[0].Platform)
+		assert.Equal(t, "https://coursera.org/verify/ABC123XYZ", certificates[0].URL)
+	})
+
+	t.Run("reads every message of an mbox", func(t *testing.T) {
+		mbox := "From udemy Mon Mar  3 18:04:00 2025\n" + udemyEmail +
+			"From news Tue Mar  4 10:00:00 2025\nSubject: Weekly picks\n\nNo certificate here\n" +
+			"From coursera Fri Mar 14 09:00:00 2025\n" + courseraEmail
+
+		certificates, err := ParseCertificates(strings.NewReader(mbox), FormatEmail)
+
+		require.NoError(t, err)
+		require.Len(t, certificates, 2)
+		assert.Equal(t, "udemy", certificates[0].Platform)
+		assert.Equal(t, "coursera", certificates[1].Platform)
+	})
+}
+
+func TestParseCertificates_CSV(t *testing.T) {
+	t.Run("parses certificate exports", func(t *testing.T) {
+		data := "Course Name,Completion Date,Certificate URL\n" +
+			"Kubernetes for Developers,2025-02-10,https://ude.my/UC-98765\n" +
+			"No certificate yet,,\n"
+
+		certificates, err := ParseCertificates(strings.NewReader(data), FormatCSV)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Certificate{{
+			Course:    "Kubernetes for Developers",
+			Platform:  "udemy",
+			URL:       "https://ude.my/UC-98765",
+			Completed: time.Date(2025, 2, 10, 0, 0, 0, 0, time.Local),
+		}}, certificates)
+	})
+
+	t.Run("fails without a certificate column", func(t *testing.T) {
+		_, err := ParseCertificates(strings.NewReader("course,date\n"), FormatCSV)
+		assert.ErrorContains(t, err, "missing a certificate URL column")
+	})
+}
+
+func TestGuessCertificateFormat(t *testing.T) {
+	assert.Equal(t, FormatEmail, GuessCertificateFormat("Certificate.eml"))
+	assert.Equal(t, FormatEmail, GuessCertificateFormat("takeout/Courses.mbox"))
+	assert.Equal(t, FormatCSV, GuessCertificateFormat("certificates.csv"))
+}
@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// FeedbackFile is a round of 360 feedback written up as YAML. Date and cycle
+// apply to every entry that does not set its own.
+type FeedbackFile struct {
+	Cycle   string          `yaml:"cycle,omitempty"`
+	Date    string          `yaml:"date,omitempty"`
+	Entries []FeedbackEntry `yaml:"feedback"`
+}
+
+// FeedbackEntry is what one person said. Skills are skill IDs or titles, to
+// be matched against the importer's own skills.
+type FeedbackEntry struct {
+	From         string                    `yaml:"from"`
+	Relationship core.FeedbackRelationship `yaml:"relationship"`
+	Date         string                    `yaml:"date,omitempty"`
+	Cycle        string                    `yaml:"cycle,omitempty"`
+	Skills       []string                  `yaml:"skills,omitempty"`
+	Rating       int                       `yaml:"rating,omitempty"`
+	Strengths    []string                  `yaml:"strengths,omitempty"`
+	Improvements []string                  `yaml:"improvements,omitempty"`
+	Comment      string                    `yaml:"comment,omitempty"`
+
+	// Given is the parsed date of the entry, or of the file
+	Given time.Time `yaml:"-"`
+}
+
+// ParseFeedback reads and validates a feedback file, filling in each entry's
+// date and cycle from the file
+func ParseFeedback(r io.Reader) (*FeedbackFile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var file FeedbackFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse feedback: %w", err)
+	}
+	if len(file.Entries) == 0 {
+		return nil, errors.New("no feedback entries found: list them under 'feedback:'")
+	}
+
+	for i := range file.Entries {
+		entry := &file.Entries[i]
+		if err := entry.normalize(file); err != nil {
+			return nil, fmt.Errorf("feedback entry %d: %w", i+1, err)
+		}
+	}
+
+	return &file, nil
+}
+
+func (e *FeedbackEntry) normalize(file FeedbackFile) error {
+	e.From = strings.TrimSpace(e.From)
+	if e.From == "" {
+		return errors.New("'from' is required")
+	}
+
+	e.Relationship = core.FeedbackRelationship(strings.ToLower(strings.TrimSpace(string(e.Relationship))))
+	if e.Relationship == "" {
+		e.Relationship = core.FeedbackPeer
+	}
+	if !e.Relationship.IsValid() {
+		return fmt.Errorf("invalid relationship '%s': must be one of: peer, manager, report", e.Relationship)
+	}
+
+	if e.Rating < 0 || e.Rating > core.MaxFeedbackRating {
+		return fmt.Errorf("rating must be between 1 and %d", core.MaxFeedbackRating)
+	}
+
+	date := e.Date
+	if date == "" {
+		date = file.Date
+	}
+	if date == "" {
+		return errors.New("'date' is required, on the entry or the file")
+	}
+	given, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return fmt.Errorf("invalid date '%s' (use YYYY-MM-DD)", date)
+	}
+	e.Given = given
+
+	if e.Cycle == "" {
+		e.Cycle = file.Cycle
+	}
+	e.Skills = trimmedList(e.Skills)
+	e.Strengths = trimmedList(e.Strengths)
+	e.Improvements = trimmedList(e.Improvements)
+	e.Comment = strings.TrimSpace(e.Comment)
+	return nil
+}
+
+// trimmedList trims the items and drops empty ones
+func trimmedList(items []string) []string {
+	var trimmed []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			trimmed = append(trimmed, item)
+		}
+	}
+	return trimmed
+}
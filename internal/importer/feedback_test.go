@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleFeedback = `cycle: 2025 H1
+date: 2025-06-30
+feedback:
+  - from: Alice
+    relationship: Peer
+    skills: [skill-001, Kubernetes]
+    rating: 4
+    strengths:
+      - Thorough code reviews
+      - " "
+    improvements:
+      - Share designs earlier
+    comment: |
+      Great to work with on the payments migration.
+  - from: Bo
+    relationship: manager
+    date: 2025-07-02
+    cycle: 2025 mid-year
+    skills: [System design]
+`
+
+func TestParseFeedback(t *testing.T) {
+	t.Run("parses entries with file defaults", func(t *testing.T) {
+		file, err := ParseFeedback(strings.NewReader(sampleFeedback))
+
+		require.NoError(t, err)
+		require.Len(t, file.Entries, 2)
+
+		alice := file.Entries[0]
+		assert.Equal(t, "Alice", alice.From)
+		assert.Equal(t, core.FeedbackPeer, alice.Relationship)
+		assert.Equal(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), alice.Given)
+		assert.Equal(t, "2025 H1", alice.Cycle)
+		assert.Equal(t, []string{"skill-001", "Kubernetes"}, alice.Skills)
+		assert.Equal(t, 4, alice.Rating)
+		assert.Equal(t, []string{"Thorough code reviews"}, alice.Strengths)
+		assert.Equal(t, "Great to work with on the payments migration.", alice.Comment)
+
+		bo := file.Entries[1]
+		assert.Equal(t, core.FeedbackManager, bo.Relationship)
+		assert.Equal(t, time.Date(2025, 7, 2, 0, 0, 0, 0, time.UTC), bo.Given)
+		assert.Equal(t, "2025 mid-year", bo.Cycle)
+	})
+
+	t.Run("defaults the relationship to peer", func(t *testing.T) {
+		file, err := ParseFeedback(strings.NewReader("feedback:\n  - from: Carol\n    date: 2025-01-10\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, core.FeedbackPeer, file.Entries[0].Relationship)
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		for name, input := range map[string]string{
+			"no entries":   "cycle: 2025 H1\n",
+			"no giver":     "feedback:\n  - date: 2025-01-10\n",
+			"no date":      "feedback:\n  - from: Carol\n",
+			"bad date":     "feedback:\n  - from: Carol\n    date: 10/01/2025\n",
+			"bad rating":   "feedback:\n  - from: Carol\n    date: 2025-01-10\n    rating: 7\n",
+			"relationship": "feedback:\n  - from: Carol\n    date: 2025-01-10\n    relationship: friend\n",
+		} {
+			_, err := ParseFeedback(strings.NewReader(input))
+			assert.Error(t, err, name)
+		}
+	})
+}
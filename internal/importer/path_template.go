@@ -0,0 +1,337 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// PathTemplateVersion is the version of the shared path template format
+const PathTemplateVersion = 1
+
+// ImportedFromPrefix starts the line that records where an imported path came from
+const ImportedFromPrefix = "Imported from "
+
+// maxTemplateSize limits how much of a downloaded template is read
+const maxTemplateSize = 1 << 20
+
+// PathTemplate is a learning path shared without personal data: IDs are
+// replaced by keys local to the template, and skills are described by title
+// so they can be mapped to the importer's own skills
+type PathTemplate struct {
+	Version      int                 `yaml:"version"`
+	Title        string              `yaml:"title"`
+	Description  string              `yaml:"description,omitempty"`
+	Author       string              `yaml:"author,omitempty"`
+	HoursPerWeek float64             `yaml:"hoursPerWeek,omitempty"`
+	Tags         []string            `yaml:"tags,omitempty"`
+	Skills       []TemplateSkill     `yaml:"skills,omitempty"`
+	Phases       []TemplatePhase     `yaml:"phases"`
+	Milestones   []TemplateMilestone `yaml:"milestones,omitempty"` // path-level milestones outside any phase
+}
+
+// TemplateSkill is a skill the path teaches, referenced by key
+type TemplateSkill struct {
+	Key      string `yaml:"key"`
+	Title    string `yaml:"title"`
+	Category string `yaml:"category,omitempty"`
+}
+
+// TemplatePhase is one stage of a shared path
+type TemplatePhase struct {
+	Title             string                `yaml:"title"`
+	EstimatedDuration string                `yaml:"estimatedDuration,omitempty"`
+	Description       string                `yaml:"description,omitempty"`
+	RequiredSkills    []TemplateRequirement `yaml:"requiredSkills,omitempty"`
+	Resources         []TemplateResource    `yaml:"resources,omitempty"`
+	Milestones        []TemplateMilestone   `yaml:"milestones,omitempty"`
+}
+
+// TemplateRequirement is a skill level a phase works towards
+type TemplateRequirement struct {
+	Skill       string                `yaml:"skill"`
+	TargetLevel core.ProficiencyLevel `yaml:"targetLevel"`
+}
+
+// TemplateResource is a resource placeholder; it becomes a not-started
+// resource for the skill it is mapped to
+type TemplateResource struct {
+	Title          string            `yaml:"title"`
+	Type           core.ResourceType `yaml:"type"`
+	Skill          string            `yaml:"skill,omitempty"` // defaults to the only skill of the template
+	URL            string            `yaml:"url,omitempty"`
+	Author         string            `yaml:"author,omitempty"`
+	EstimatedHours float64           `yaml:"estimatedHours,omitempty"`
+	Description    string            `yaml:"description,omitempty"`
+}
+
+// TemplateMilestone is a milestone of a shared path
+type TemplateMilestone struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// ParsePathTemplate reads and validates a YAML path template
+func ParsePathTemplate(r io.Reader) (*PathTemplate, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var template PathTemplate
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("failed to parse path template: %w", err)
+	}
+
+	template.normalize()
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+// FetchPathTemplate downloads and parses the path template at url
+func FetchPathTemplate(ctx context.Context, client *http.Client, url string) (*PathTemplate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "growth.md path importer")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch path template: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch path template: status %d", resp.StatusCode)
+	}
+
+	return ParsePathTemplate(io.LimitReader(resp.Body, maxTemplateSize))
+}
+
+// normalize trims titles and fills in the resource skill when the template
+// only teaches one skill
+func (t *PathTemplate) normalize() {
+	if t.Version == 0 {
+		t.Version = PathTemplateVersion
+	}
+	t.Title = strings.TrimSpace(t.Title)
+
+	for i := range t.Skills {
+		t.Skills[i].Key = strings.TrimSpace(t.Skills[i].Key)
+		t.Skills[i].Title = strings.TrimSpace(t.Skills[i].Title)
+		if t.Skills[i].Key == "" {
+			t.Skills[i].Key = t.Skills[i].Title
+		}
+	}
+
+	for i := range t.Phases {
+		phase := &t.Phases[i]
+		phase.Title = strings.TrimSpace(phase.Title)
+		for j := range phase.Resources {
+			resource := &phase.Resources[j]
+			resource.Title = strings.TrimSpace(resource.Title)
+			if resource.Skill == "" && len(t.Skills) == 1 {
+				resource.Skill = t.Skills[0].Key
+			}
+		}
+	}
+}
+
+// Validate checks that the template can be imported
+func (t *PathTemplate) Validate() error {
+	if t.Version > PathTemplateVersion {
+		return fmt.Errorf("path template version %d is newer than supported version %d", t.Version, PathTemplateVersion)
+	}
+	if t.Title == "" {
+		return errors.New("path template title is required")
+	}
+	if len(t.Phases) == 0 {
+		return errors.New("path template has no phases")
+	}
+
+	skills := make(map[string]bool, len(t.Skills))
+	for _, skill := range t.Skills {
+		if skill.Title == "" {
+			return errors.New("path template skill title is required")
+		}
+		if skills[skill.Key] {
+			return fmt.Errorf("path template skill '%s' is defined twice", skill.Key)
+		}
+		skills[skill.Key] = true
+	}
+
+	for i, phase := range t.Phases {
+		if phase.Title == "" {
+			return fmt.Errorf("phase %d: title is required", i+1)
+		}
+		for _, req := range phase.RequiredSkills {
+			if !skills[req.Skill] {
+				return fmt.Errorf("phase '%s': unknown skill '%s'", phase.Title, req.Skill)
+			}
+			if !req.TargetLevel.IsValid() {
+				return fmt.Errorf("phase '%s': invalid target level '%s'", phase.Title, req.TargetLevel)
+			}
+		}
+		for _, resource := range phase.Resources {
+			if resource.Title == "" {
+				return fmt.Errorf("phase '%s': resource title is required", phase.Title)
+			}
+			if !resource.Type.IsValid() {
+				return fmt.Errorf("resource '%s': invalid type '%s'", resource.Title, resource.Type)
+			}
+			if !skills[resource.Skill] {
+				return fmt.Errorf("resource '%s': unknown skill '%s'", resource.Title, resource.Skill)
+			}
+		}
+		for _, milestone := range phase.Milestones {
+			if strings.TrimSpace(milestone.Title) == "" {
+				return fmt.Errorf("phase '%s': milestone title is required", phase.Title)
+			}
+		}
+	}
+
+	for _, milestone := range t.Milestones {
+		if strings.TrimSpace(milestone.Title) == "" {
+			return errors.New("path template milestone title is required")
+		}
+	}
+
+	return nil
+}
+
+// BuildPathTemplate turns one of the user's paths into a shareable template.
+// IDs, dates, statuses, hours spent and personal notes are left out: only the
+// path and phase descriptions (without their ## sections), titles, links and
+// estimates are kept. Abandoned resources are dropped, and milestones count
+// when they are listed in a phase or reference the path.
+func BuildPathTemplate(path *core.LearningPath, phases []*core.Phase, resources map[core.EntityID]*core.Resource, milestones []*core.Milestone, skills map[core.EntityID]*core.Skill) *PathTemplate {
+	template := &PathTemplate{
+		Version:      PathTemplateVersion,
+		Title:        path.Title,
+		Description:  templateDescription(path.Body),
+		HoursPerWeek: path.HoursPerWeek,
+	}
+	if len(path.Tags) > 0 {
+		template.Tags = path.Tags
+	}
+
+	keys := make(map[core.EntityID]string)
+	skillKey := func(id core.EntityID) string {
+		if key, ok := keys[id]; ok {
+			return key
+		}
+		skill := skills[id]
+		if skill == nil {
+			skill = &core.Skill{Title: string(id)}
+		}
+		key := templateKey(skill.Title)
+		for n := 2; slices.ContainsFunc(template.Skills, func(s TemplateSkill) bool { return s.Key == key }); n++ {
+			key = fmt.Sprintf("%s-%d", templateKey(skill.Title), n)
+		}
+		keys[id] = key
+		template.Skills = append(template.Skills, TemplateSkill{Key: key, Title: skill.Title, Category: skill.Category})
+		return key
+	}
+
+	byID := make(map[core.EntityID]*core.Milestone, len(milestones))
+	for _, m := range milestones {
+		byID[m.ID] = m
+	}
+
+	sorted := append([]*core.Phase{}, phases...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Order < sorted[j].Order })
+
+	inPhase := make(map[core.EntityID]bool)
+	for _, phase := range sorted {
+		tp := TemplatePhase{
+			Title:             phase.Title,
+			EstimatedDuration: phase.EstimatedDuration,
+			Description:       templateDescription(phase.Body),
+		}
+		for _, req := range phase.RequiredSkills {
+			tp.RequiredSkills = append(tp.RequiredSkills, TemplateRequirement{Skill: skillKey(req.SkillID), TargetLevel: req.TargetLevel})
+		}
+		for _, id := range phase.Resources {
+			resource, ok := resources[id]
+			if !ok || resource.Status == core.ResourceAbandoned {
+				continue
+			}
+			tp.Resources = append(tp.Resources, TemplateResource{
+				Title:          resource.Title,
+				Type:           resource.Type,
+				Skill:          skillKey(resource.SkillID),
+				URL:            resource.URL,
+				Author:         resource.Author,
+				EstimatedHours: resource.EstimatedHours,
+			})
+		}
+		for _, id := range phase.Milestones {
+			inPhase[id] = true
+			if m, ok := byID[id]; ok {
+				tp.Milestones = append(tp.Milestones, TemplateMilestone{Title: m.Title})
+			}
+		}
+		template.Phases = append(template.Phases, tp)
+	}
+
+	for _, m := range milestones {
+		if inPhase[m.ID] || m.ReferenceType != core.ReferencePath || m.ReferenceID != path.ID {
+			continue
+		}
+		// Recurring milestones are shared once, not per instance
+		if m.SeriesID != "" && m.SeriesID != m.ID {
+			continue
+		}
+		template.Milestones = append(template.Milestones, TemplateMilestone{Title: m.Title})
+	}
+
+	return template
+}
+
+// Encode writes the template as YAML
+func (t *PathTemplate) Encode(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(t); err != nil {
+		return fmt.Errorf("failed to encode path template: %w", err)
+	}
+	return encoder.Close()
+}
+
+// templateDescription keeps the free text of a body before its ## sections,
+// which hold notes, logs and links, without the line recording its origin
+func templateDescription(body string) string {
+	preamble, _ := core.ParseSections(body)
+
+	var lines []string
+	for _, line := range strings.Split(preamble, "\n") {
+		if !strings.HasPrefix(line, ImportedFromPrefix) {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// templateKey derives a skill key from its title
+func templateKey(title string) string {
+	key := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, title)
+	return strings.Trim(key, "-")
+}
@@ -0,0 +1,197 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const samplePathTemplate = `version: 1
+title: Backend in Go
+description: From the tour to a production API
+hoursPerWeek: 6
+tags: [go, backend]
+skills:
+  - key: go
+    title: Go
+    category: programming
+phases:
+  - title: Basics
+    estimatedDuration: 1 month
+    requiredSkills:
+      - skill: go
+        targetLevel: intermediate
+    resources:
+      - title: A Tour of Go
+        type: course
+        url: https://go.dev/tour
+        estimatedHours: 4
+    milestones:
+      - title: Finish the tour
+  - title: Services
+    resources:
+      - title: Let's Go
+        type: book
+        skill: go
+milestones:
+  - title: Ship an API
+`
+
+func TestParsePathTemplate(t *testing.T) {
+	t.Run("parses phases, resources and milestones", func(t *testing.T) {
+		template, err := ParsePathTemplate(strings.NewReader(samplePathTemplate))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Backend in Go", template.Title)
+		assert.Equal(t, 6.0, template.HoursPerWeek)
+		require.Len(t, template.Phases, 2)
+		assert.Equal(t, core.LevelIntermediate, template.Phases[0].RequiredSkills[0].TargetLevel)
+		assert.Equal(t, "Finish the tour", template.Phases[0].Milestones[0].Title)
+		require.Len(t, template.Milestones, 1)
+	})
+
+	t.Run("defaults resources to the only skill", func(t *testing.T) {
+		template, err := ParsePathTemplate(strings.NewReader(samplePathTemplate))
+
+		require.NoError(t, err)
+		assert.Equal(t, "go", template.Phases[0].Resources[0].Skill)
+	})
+
+	t.Run("defaults skill keys to titles", func(t *testing.T) {
+		input := "title: T\nskills:\n  - title: Go\nphases:\n  - title: P\n    resources:\n      - title: R\n        type: book\n        skill: Go\n"
+
+		template, err := ParsePathTemplate(strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Go", template.Skills[0].Key)
+		assert.Equal(t, PathTemplateVersion, template.Version)
+	})
+
+	t.Run("rejects invalid templates", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			err   string
+		}{
+			{"missing title", "phases:\n  - title: P\n", "title is required"},
+			{"no phases", "title: T\n", "no phases"},
+			{"newer version", "version: 99\ntitle: T\nphases:\n  - title: P\n", "newer than supported"},
+			{"unknown skill", "title: T\nphases:\n  - title: P\n    requiredSkills:\n      - skill: rust\n        targetLevel: expert\n", "unknown skill 'rust'"},
+			{"invalid resource type", "title: T\nskills:\n  - key: go\n    title: Go\nphases:\n  - title: P\n    resources:\n      - title: R\n        type: podcast\n", "invalid type 'podcast'"},
+			{"duplicate skill", "title: T\nskills:\n  - key: go\n    title: Go\n  - key: go\n    title: Golang\nphases:\n  - title: P\n", "defined twice"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ParsePathTemplate(strings.NewReader(tt.input))
+				assert.ErrorContains(t, err, tt.err)
+			})
+		}
+	})
+}
+
+func TestFetchPathTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/go.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(samplePathTemplate))
+	}))
+	defer server.Close()
+
+	t.Run("downloads a template", func(t *testing.T) {
+		template, err := FetchPathTemplate(context.Background(), server.Client(), server.URL+"/go.yaml")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Backend in Go", template.Title)
+	})
+
+	t.Run("reports bad status", func(t *testing.T) {
+		_, err := FetchPathTemplate(context.Background(), server.Client(), server.URL+"/missing.yaml")
+
+		assert.ErrorContains(t, err, "status 404")
+	})
+}
+
+func TestBuildPathTemplate(t *testing.T) {
+	path, _ := core.NewLearningPath("path-001", "Backend in Go", core.PathTypeManual)
+	path.Body = "Learn Go for services.\n\nImported from go.yaml\n\n## Notes\n\n- personal note\n"
+	path.HoursPerWeek = 5
+
+	golang, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelAdvanced)
+	sql, _ := core.NewSkill("skill-002", "SQL", "data", core.LevelBeginner)
+	skills := map[core.EntityID]*core.Skill{golang.ID: golang, sql.ID: sql}
+
+	data, _ := core.NewPhase("phase-002", "path-001", "Data", 2)
+	data.Resources = []core.EntityID{"resource-002", "resource-003"}
+	basics, _ := core.NewPhase("phase-001", "path-001", "Basics", 1)
+	basics.Body = "Start here."
+	basics.RequiredSkills = []core.SkillRequirement{{SkillID: "skill-001", TargetLevel: core.LevelIntermediate}}
+	basics.Resources = []core.EntityID{"resource-001"}
+	basics.Milestones = []core.EntityID{"milestone-001"}
+
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	tour.URL = "https://go.dev/tour"
+	tour.Complete()
+	tour.ActualHours = 6
+	tour.Body = "## Notes\n\n- my takeaway\n"
+	book, _ := core.NewResource("resource-002", "SQL book", core.ResourceBook, "skill-002")
+	dropped, _ := core.NewResource("resource-003", "Old video", core.ResourceVideo, "skill-002")
+	dropped.Abandon("outdated")
+	resources := map[core.EntityID]*core.Resource{tour.ID: tour, book.ID: book, dropped.ID: dropped}
+
+	tourDone, _ := core.NewMilestone("milestone-001", "Finish the tour", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	tourDone.Achieve("https://example.com/proof")
+	ship, _ := core.NewMilestone("milestone-002", "Ship an API", core.MilestonePathLevel, core.ReferencePath, "path-001")
+	other, _ := core.NewMilestone("milestone-003", "Other", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	milestones := []*core.Milestone{tourDone, ship, other}
+
+	template := BuildPathTemplate(path, []*core.Phase{data, basics}, resources, milestones, skills)
+
+	t.Run("keeps the shareable structure", func(t *testing.T) {
+		assert.Equal(t, "Learn Go for services.", template.Description)
+		require.Len(t, template.Phases, 2)
+		assert.Equal(t, "Basics", template.Phases[0].Title)
+		assert.Equal(t, "Start here.", template.Phases[0].Description)
+		assert.Equal(t, []TemplateRequirement{{Skill: "go", TargetLevel: core.LevelIntermediate}}, template.Phases[0].RequiredSkills)
+		assert.Equal(t, []TemplateResource{{Title: "A Tour of Go", Type: core.ResourceCourse, Skill: "go", URL: "https://go.dev/tour"}}, template.Phases[0].Resources)
+		assert.Equal(t, []TemplateMilestone{{Title: "Finish the tour"}}, template.Phases[0].Milestones)
+		assert.Equal(t, []TemplateMilestone{{Title: "Ship an API"}}, template.Milestones)
+	})
+
+	t.Run("describes skills without personal levels", func(t *testing.T) {
+		assert.Equal(t, []TemplateSkill{{Key: "go", Title: "Go", Category: "programming"}, {Key: "sql", Title: "SQL", Category: "data"}}, template.Skills)
+	})
+
+	t.Run("drops abandoned resources", func(t *testing.T) {
+		require.Len(t, template.Phases[1].Resources, 1)
+		assert.Equal(t, "SQL book", template.Phases[1].Resources[0].Title)
+	})
+
+	t.Run("round-trips through the import format", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, template.Encode(&buf))
+
+		assert.NotContains(t, buf.String(), "path-001")
+		assert.NotContains(t, buf.String(), "personal note")
+		assert.NotContains(t, buf.String(), "proof")
+
+		parsed, err := ParsePathTemplate(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, template, parsed)
+	})
+}
+
+func TestTemplateKey(t *testing.T) {
+	assert.Equal(t, "machine-learning", templateKey("Machine Learning"))
+	assert.Equal(t, "c", templateKey("C++"))
+	assert.Equal(t, "ci-cd", templateKey("CI/CD"))
+}
@@ -0,0 +1,170 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Taxonomy formats supported by ParseTaxonomy
+const (
+	FormatCSV  = "csv"
+	FormatYAML = "yaml"
+)
+
+// Header names recognised in taxonomy CSV exports, in order of preference.
+// They cover SFIA (Code, Skill, Category, Overall description) and
+// ESCO (conceptUri, preferredLabel, description) downloads.
+var (
+	taxonomyCodeColumns        = []string{"code", "skill code", "id", "concepturi"}
+	taxonomyTitleColumns       = []string{"title", "name", "skill", "preferredlabel"}
+	taxonomyCategoryColumns    = []string{"category", "subcategory", "group", "skilltype"}
+	taxonomyDescriptionColumns = []string{"description", "overall description", "definition"}
+)
+
+// GuessTaxonomyFormat picks a format from a file extension
+func GuessTaxonomyFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return FormatYAML
+	default:
+		return FormatCSV
+	}
+}
+
+// ParseTaxonomy reads taxonomy entries in the given format.
+// YAML files may be a list of entries or a mapping with an entries (or skills) list.
+func ParseTaxonomy(r io.Reader, format string) ([]core.TaxonomyEntry, error) {
+	var entries []core.TaxonomyEntry
+	var err error
+
+	switch format {
+	case FormatCSV:
+		entries, err = parseTaxonomyCSV(r)
+	case FormatYAML:
+		entries, err = parseTaxonomyYAML(r)
+	default:
+		return nil, fmt.Errorf("unsupported taxonomy format '%s' (must be csv or yaml)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeTaxonomyEntries(entries), nil
+}
+
+func parseTaxonomyCSV(r io.Reader) ([]core.TaxonomyEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read taxonomy header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))
+		if _, exists := columns[name]; !exists {
+			columns[name] = i
+		}
+	}
+
+	column := func(candidates []string) int {
+		for _, name := range candidates {
+			if i, ok := columns[name]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+
+	titleCol := column(taxonomyTitleColumns)
+	if titleCol < 0 {
+		return nil, errors.New("taxonomy is missing a title column (title, name, skill or preferredLabel)")
+	}
+	codeCol := column(taxonomyCodeColumns)
+	categoryCol := column(taxonomyCategoryColumns)
+	descriptionCol := column(taxonomyDescriptionColumns)
+
+	field := func(record []string, i int) string {
+		if i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []core.TaxonomyEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read taxonomy: %w", err)
+		}
+
+		entries = append(entries, core.TaxonomyEntry{
+			Code:        field(record, codeCol),
+			Title:       field(record, titleCol),
+			Category:    field(record, categoryCol),
+			Description: field(record, descriptionCol),
+		})
+	}
+
+	return entries, nil
+}
+
+func parseTaxonomyYAML(r io.Reader) ([]core.TaxonomyEntry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []core.TaxonomyEntry
+	if err := yaml.Unmarshal(data, &list); err == nil {
+		return list, nil
+	}
+
+	var doc struct {
+		Entries []core.TaxonomyEntry `yaml:"entries"`
+		Skills  []core.TaxonomyEntry `yaml:"skills"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse taxonomy: %w", err)
+	}
+
+	return append(doc.Entries, doc.Skills...), nil
+}
+
+// normalizeTaxonomyEntries drops untitled rows, fills missing codes from the
+// title, and removes duplicate codes keeping the first
+func normalizeTaxonomyEntries(entries []core.TaxonomyEntry) []core.TaxonomyEntry {
+	seen := make(map[string]bool)
+	var results []core.TaxonomyEntry
+	for _, e := range entries {
+		e.Title = strings.TrimSpace(e.Title)
+		if e.Title == "" {
+			continue
+		}
+		e.Code = strings.TrimSpace(e.Code)
+		if e.Code == "" {
+			e.Code = e.Title
+		}
+
+		key := strings.ToLower(e.Code)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		results = append(results, e)
+	}
+	return results
+}
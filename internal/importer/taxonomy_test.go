@@ -0,0 +1,81 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxonomy_CSV(t *testing.T) {
+	t.Run("parses SFIA style export", func(t *testing.T) {
+		input := "\uFEFFCode,Skill,Category,Subcategory,Overall description\n" +
+			"PROG,Programming/software development,Development and implementation,Systems development,\"Designing, coding, testing\"\n" +
+			"DBAD,Database administration,Delivery and operation,Technology management,Installing and maintaining databases\n" +
+			"PROG,Duplicate,Other,,\n"
+
+		entries, err := ParseTaxonomy(strings.NewReader(input), FormatCSV)
+
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "PROG", entries[0].Code)
+		assert.Equal(t, "Programming/software development", entries[0].Title)
+		assert.Equal(t, "Development and implementation", entries[0].Category)
+		assert.Equal(t, "Designing, coding, testing", entries[0].Description)
+	})
+
+	t.Run("parses ESCO style export", func(t *testing.T) {
+		input := "conceptType,conceptUri,skillType,preferredLabel,description\n" +
+			"KnowledgeSkillCompetence,http://data.europa.eu/esco/skill/abc,skill/competence,use Go,Write programs in Go.\n" +
+			"KnowledgeSkillCompetence,http://data.europa.eu/esco/skill/def,knowledge,,\n"
+
+		entries, err := ParseTaxonomy(strings.NewReader(input), FormatCSV)
+
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "http://data.europa.eu/esco/skill/abc", entries[0].Code)
+		assert.Equal(t, "use Go", entries[0].Title)
+		assert.Equal(t, "skill/competence", entries[0].Category)
+	})
+
+	t.Run("requires a title column", func(t *testing.T) {
+		_, err := ParseTaxonomy(strings.NewReader("code,category\nA,B\n"), FormatCSV)
+
+		assert.ErrorContains(t, err, "missing a title column")
+	})
+}
+
+func TestParseTaxonomy_YAML(t *testing.T) {
+	t.Run("parses mapping with entries", func(t *testing.T) {
+		input := `name: Team skills
+entries:
+  - code: GO
+    title: Go
+    category: backend
+  - title: Kubernetes
+    category: devops
+`
+		entries, err := ParseTaxonomy(strings.NewReader(input), FormatYAML)
+
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "GO", entries[0].Code)
+		assert.Equal(t, "Kubernetes", entries[1].Code)
+	})
+
+	t.Run("parses plain list", func(t *testing.T) {
+		input := "- code: SQL\n  title: SQL\n"
+
+		entries, err := ParseTaxonomy(strings.NewReader(input), FormatYAML)
+
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+}
+
+func TestGuessTaxonomyFormat(t *testing.T) {
+	assert.Equal(t, FormatYAML, GuessTaxonomyFormat("sfia.yml"))
+	assert.Equal(t, FormatYAML, GuessTaxonomyFormat("team.YAML"))
+	assert.Equal(t, FormatCSV, GuessTaxonomyFormat("esco_skills.csv"))
+}
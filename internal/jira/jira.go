@@ -0,0 +1,101 @@
+// Package jira reads the status of issues from Jira, so tickets linked to
+// goals and milestones show how the work they evidence is going.
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TokenEnvVar holds the Jira API token
+const TokenEnvVar = "GROWTH_JIRA_TOKEN"
+
+// maxResponseSize limits how much of an API response is read
+const maxResponseSize = 1 << 20
+
+// Issue is the status of one Jira issue
+type Issue struct {
+	Key     string
+	Summary string
+	Status  string // status name, e.g. In Review
+	Done    bool   // whether the status is in the done category
+}
+
+// Client reads issues through the Jira REST API
+type Client struct {
+	client  *http.Client
+	baseURL string
+	email   string
+	token   string
+}
+
+// NewClient creates a client for the Jira site at baseURL. With an email the
+// token is sent as a Jira Cloud API token, without one as a personal access
+// token of Jira Server or Data Center.
+func NewClient(client *http.Client, baseURL, email, token string) *Client {
+	return &Client{client: client, baseURL: strings.TrimRight(baseURL, "/"), email: email, token: token}
+}
+
+type issueResponse struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		Status  struct {
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+	} `json:"fields"`
+}
+
+// Issue looks up an issue by key
+func (c *Client) Issue(ctx context.Context, key string) (Issue, error) {
+	endpoint := c.baseURL + "/rest/api/2/issue/" + url.PathEscape(key) + "?fields=summary,status"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Issue{}, err
+	}
+	if c.email != "" {
+		req.SetBasicAuth(c.email, c.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Issue{}, fmt.Errorf("failed to reach Jira: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return Issue{}, fmt.Errorf("jira issue %s not found", key)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return Issue{}, fmt.Errorf("jira rejected the API token in %s (status %d)", TokenEnvVar, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return Issue{}, fmt.Errorf("failed to read jira issue %s: status %d", key, resp.StatusCode)
+	}
+
+	var body issueResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return Issue{}, fmt.Errorf("failed to parse Jira issue %s: %w", key, err)
+	}
+
+	issue := Issue{
+		Key:     body.Key,
+		Summary: body.Fields.Summary,
+		Status:  body.Fields.Status.Name,
+		Done:    body.Fields.Status.StatusCategory.Key == "done",
+	}
+	if issue.Key == "" {
+		issue.Key = key
+	}
+	return issue, nil
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Issue(t *testing.T) {
+	var gotAuth, gotFields string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotFields = r.URL.Query().Get("fields")
+		switch r.URL.Path {
+		case "/rest/api/2/issue/WORK-12":
+			fmt.Fprint(w, `{"key": "WORK-12", "fields": {"summary": "Migrate billing to Kafka",
+				"status": {"name": "In Review", "statusCategory": {"key": "indeterminate"}}}}`)
+		case "/rest/api/2/issue/WORK-7":
+			fmt.Fprint(w, `{"key": "WORK-7", "fields": {"summary": "Design review",
+				"status": {"name": "Closed", "statusCategory": {"key": "done"}}}}`)
+		case "/rest/api/2/issue/SECRET-1":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("reads the status of an open issue", func(t *testing.T) {
+		client := NewClient(server.Client(), server.URL+"/", "me@example.com", "secret")
+
+		issue, err := client.Issue(context.Background(), "WORK-12")
+
+		require.NoError(t, err)
+		assert.Equal(t, Issue{Key: "WORK-12", Summary: "Migrate billing to Kafka", Status: "In Review"}, issue)
+		assert.Equal(t, "summary,status", gotFields)
+		assert.Equal(t, "Basic bWVAZXhhbXBsZS5jb206c2VjcmV0", gotAuth)
+	})
+
+	t.Run("marks issues in the done category as done", func(t *testing.T) {
+		client := NewClient(server.Client(), server.URL, "", "pat")
+
+		issue, err := client.Issue(context.Background(), "WORK-7")
+
+		require.NoError(t, err)
+		assert.True(t, issue.Done)
+		assert.Equal(t, "Closed", issue.Status)
+		assert.Equal(t, "Bearer pat", gotAuth)
+	})
+
+	t.Run("reports missing issues", func(t *testing.T) {
+		client := NewClient(server.Client(), server.URL, "", "pat")
+
+		_, err := client.Issue(context.Background(), "WORK-404")
+
+		assert.ErrorContains(t, err, "WORK-404 not found")
+	})
+
+	t.Run("reports rejected tokens", func(t *testing.T) {
+		client := NewClient(server.Client(), server.URL, "", "pat")
+
+		_, err := client.Issue(context.Background(), "SECRET-1")
+
+		assert.ErrorContains(t, err, TokenEnvVar)
+	})
+}
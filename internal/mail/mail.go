@@ -0,0 +1,82 @@
+// Package mail sends plain-text and markdown messages over SMTP.
+package mail
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds SMTP connection settings
+type Config struct {
+	Host     string
+	Port     int // defaults to 587
+	Username string
+	Password string // loaded from GROWTH_SMTP_PASSWORD when empty
+	From     string
+	To       []string
+}
+
+func (c *Config) Validate() error {
+	if c.Host == "" {
+		return errors.New("SMTP host is required")
+	}
+
+	if c.From == "" {
+		return errors.New("sender address is required")
+	}
+
+	if len(c.To) == 0 {
+		return errors.New("at least one recipient is required")
+	}
+
+	if c.Port == 0 {
+		c.Port = 587
+	}
+
+	if c.Password == "" {
+		c.Password = os.Getenv("GROWTH_SMTP_PASSWORD")
+	}
+
+	return nil
+}
+
+// BuildMessage formats an RFC 5322 message with a markdown body
+func BuildMessage(from string, to []string, subject, body string, date time.Time) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/markdown; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// Send delivers a message to all configured recipients
+func Send(cfg Config, subject, body string) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid email configuration: %w", err)
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	msg := BuildMessage(cfg.From, cfg.To, subject, body, time.Now())
+
+	if err := smtp.SendMail(addr, auth, cfg.From, cfg.To, msg); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		t.Setenv("GROWTH_SMTP_PASSWORD", "secret")
+		cfg := Config{Host: "smtp.example.com", From: "me@example.com", To: []string{"me@example.com"}}
+
+		require.NoError(t, cfg.Validate())
+		assert.Equal(t, 587, cfg.Port)
+		assert.Equal(t, "secret", cfg.Password)
+	})
+
+	t.Run("requires host, sender and recipients", func(t *testing.T) {
+		assert.Error(t, (&Config{From: "me@example.com", To: []string{"a@example.com"}}).Validate())
+		assert.Error(t, (&Config{Host: "smtp.example.com", To: []string{"a@example.com"}}).Validate())
+		assert.Error(t, (&Config{Host: "smtp.example.com", From: "me@example.com"}).Validate())
+	})
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2025, 1, 12, 18, 0, 0, 0, time.UTC)
+
+	msg := string(BuildMessage("me@example.com", []string{"a@example.com", "b@example.com"}, "Weekly digest", "# Week\n\n- item", date))
+
+	assert.Contains(t, msg, "From: me@example.com\r\n")
+	assert.Contains(t, msg, "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, msg, "Subject: Weekly digest\r\n")
+	assert.Contains(t, msg, "Date: Sun, 12 Jan 2025 18:00:00 +0000\r\n")
+	assert.Contains(t, msg, "\r\n\r\n# Week\r\n\r\n- item")
+}
+
+func TestSend_InvalidConfig(t *testing.T) {
+	err := Send(Config{}, "subject", "body")
+
+	assert.ErrorContains(t, err, "invalid email configuration")
+}
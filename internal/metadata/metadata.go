@@ -0,0 +1,263 @@
+// Package metadata fetches descriptive information about learning resources from their URLs.
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	// wordsPerMinute is the reading speed used to estimate reading time
+	wordsPerMinute = 230
+
+	// maxBodySize limits how much of a page is read
+	maxBodySize = 5 << 20
+
+	defaultOEmbedEndpoint = "https://www.youtube.com/oembed"
+)
+
+// Metadata describes a resource found at a URL
+type Metadata struct {
+	Title          string
+	Author         string
+	EstimatedHours float64
+	IsVideo        bool
+}
+
+// Fetcher retrieves metadata for URLs over HTTP
+type Fetcher struct {
+	client         *http.Client
+	oembedEndpoint string
+}
+
+// NewFetcher creates a Fetcher with the given request timeout
+func NewFetcher(timeout time.Duration) *Fetcher {
+	return &Fetcher{
+		client:         &http.Client{Timeout: timeout},
+		oembedEndpoint: defaultOEmbedEndpoint,
+	}
+}
+
+// Fetch returns metadata for rawURL. YouTube URLs are resolved through oEmbed
+// with the duration read from the watch page; other pages are parsed for their
+// title, author, and word count.
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Metadata, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid URL: %s", rawURL)
+	}
+
+	if IsYouTubeURL(u) {
+		return f.fetchYouTube(ctx, rawURL)
+	}
+
+	body, err := f.get(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	page, err := parsePage(body)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &Metadata{
+		Title:  page.title,
+		Author: page.author,
+	}
+	if page.words > 0 {
+		meta.EstimatedHours = roundHours(float64(page.words) / wordsPerMinute / 60)
+	}
+
+	return meta, nil
+}
+
+// IsYouTubeURL reports whether u points at a YouTube video
+func IsYouTubeURL(u *url.URL) bool {
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch host {
+	case "youtube.com", "m.youtube.com", "youtu.be":
+		return true
+	}
+	return false
+}
+
+func (f *Fetcher) fetchYouTube(ctx context.Context, rawURL string) (*Metadata, error) {
+	endpoint := fmt.Sprintf("%s?format=json&url=%s", f.oembedEndpoint, url.QueryEscape(rawURL))
+
+	body, err := f.get(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var oembed struct {
+		Title      string `json:"title"`
+		AuthorName string `json:"author_name"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, maxBodySize)).Decode(&oembed); err != nil {
+		return nil, fmt.Errorf("failed to decode oEmbed response: %w", err)
+	}
+
+	meta := &Metadata{
+		Title:   oembed.Title,
+		Author:  oembed.AuthorName,
+		IsVideo: true,
+	}
+
+	// oEmbed does not include duration; read it from the watch page when available
+	if page, err := f.get(ctx, rawURL); err == nil {
+		defer page.Close()
+		if parsed, err := parsePage(page); err == nil && parsed.duration > 0 {
+			meta.EstimatedHours = roundHours(parsed.duration.Hours())
+		}
+	}
+
+	return meta, nil
+}
+
+func (f *Fetcher) get(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "growth.md metadata fetcher")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to fetch %s: status %d", rawURL, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+type page struct {
+	title    string
+	author   string
+	words    int
+	duration time.Duration
+}
+
+func parsePage(r io.Reader) (*page, error) {
+	doc, err := html.Parse(io.LimitReader(r, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
+	}
+
+	p := &page{}
+	var htmlTitle, ogTitle string
+
+	var walk func(n *html.Node, inBody bool)
+	walk = func(n *html.Node, inBody bool) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "script", "style", "noscript", "template":
+				return
+			case "title":
+				if htmlTitle == "" && n.FirstChild != nil {
+					htmlTitle = strings.TrimSpace(n.FirstChild.Data)
+				}
+				return
+			case "meta":
+				key := strings.ToLower(attr(n, "property"))
+				if key == "" {
+					key = strings.ToLower(attr(n, "name"))
+				}
+				if key == "" {
+					key = strings.ToLower(attr(n, "itemprop"))
+				}
+				content := strings.TrimSpace(attr(n, "content"))
+				switch key {
+				case "og:title":
+					ogTitle = content
+				case "author", "article:author":
+					if p.author == "" && !strings.HasPrefix(content, "http") {
+						p.author = content
+					}
+				case "duration":
+					if d, err := ParseISODuration(content); err == nil {
+						p.duration = d
+					}
+				}
+			case "body":
+				inBody = true
+			}
+		}
+
+		if n.Type == html.TextNode && inBody {
+			p.words += len(strings.Fields(n.Data))
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, inBody)
+		}
+	}
+	walk(doc, false)
+
+	p.title = ogTitle
+	if p.title == "" {
+		p.title = htmlTitle
+	}
+
+	return p, nil
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?T?(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+
+// ParseISODuration parses ISO 8601 durations such as "PT1H2M3S" or "PT12M"
+func ParseISODuration(s string) (time.Duration, error) {
+	m := isoDurationPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil || s == "P" || s == "PT" {
+		return 0, errors.New("invalid ISO 8601 duration: " + s)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var total time.Duration
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, err
+		}
+		total += time.Duration(n) * unit
+	}
+
+	return total, nil
+}
+
+// roundHours rounds to the nearest quarter hour, with a minimum of a quarter hour
+func roundHours(hours float64) float64 {
+	rounded := float64(int(hours*4+0.5)) / 4
+	if rounded < 0.25 {
+		return 0.25
+	}
+	return rounded
+}
@@ -0,0 +1,126 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetcher_Fetch(t *testing.T) {
+	article := fmt.Sprintf(`<html><head>
+		<title>Fallback Title</title>
+		<meta property="og:title" content="Understanding epoll">
+		<meta name="author" content="Jane Doe">
+		<script>var ignored = "lots of words here";</script>
+	</head><body><p>%s</p></body></html>`, strings.Repeat("word ", 2300))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/article":
+			fmt.Fprint(w, article)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher(5 * time.Second)
+
+	t.Run("extracts title, author and reading time", func(t *testing.T) {
+		meta, err := fetcher.Fetch(context.Background(), server.URL+"/article")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Understanding epoll", meta.Title)
+		assert.Equal(t, "Jane Doe", meta.Author)
+		assert.Equal(t, 0.25, meta.EstimatedHours) // 10 minutes
+		assert.False(t, meta.IsVideo)
+	})
+
+	t.Run("fails on missing page", func(t *testing.T) {
+		_, err := fetcher.Fetch(context.Background(), server.URL+"/missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects non-http URLs", func(t *testing.T) {
+		_, err := fetcher.Fetch(context.Background(), "ftp://example.com/file")
+		assert.Error(t, err)
+	})
+}
+
+func TestFetcher_FetchYouTube(t *testing.T) {
+	oembed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Query().Get("url"), "youtube.com/watch")
+		fmt.Fprint(w, `{"title":"Go Concurrency Patterns","author_name":"Google for Developers"}`)
+	}))
+	defer oembed.Close()
+
+	fetcher := NewFetcher(time.Second)
+	fetcher.oembedEndpoint = oembed.URL
+
+	meta, err := fetcher.fetchYouTube(context.Background(), "https://www.youtube.com/watch?v=f6kdp27TYZs")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Go Concurrency Patterns", meta.Title)
+	assert.Equal(t, "Google for Developers", meta.Author)
+	assert.True(t, meta.IsVideo)
+}
+
+func TestParsePage_Duration(t *testing.T) {
+	p, err := parsePage(strings.NewReader(`<html><head><meta itemprop="duration" content="PT51M20S"></head><body></body></html>`))
+
+	require.NoError(t, err)
+	assert.Equal(t, 51*time.Minute+20*time.Second, p.duration)
+}
+
+func TestIsYouTubeURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected bool
+	}{
+		{"https://www.youtube.com/watch?v=abc", true},
+		{"https://youtu.be/abc", true},
+		{"https://m.youtube.com/watch?v=abc", true},
+		{"https://example.com/youtube", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, _ := url.Parse(tt.url)
+			assert.Equal(t, tt.expected, IsYouTubeURL(u))
+		})
+	}
+}
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"PT1H2M3S", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"PT12M", 12 * time.Minute, false},
+		{"P1DT2H", 26 * time.Hour, false},
+		{"", 0, true},
+		{"12 minutes", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseISODuration(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Package orgmode writes outlines of headings as org-mode files and reads
+// them back after they were edited in Emacs, so learning paths can be
+// followed from org-agenda.
+package orgmode
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TODO states of headings
+const (
+	Todo = "TODO"
+	Done = "DONE"
+)
+
+// IDProperty is the property that links a heading to a growth entity. It is
+// not :ID:, which org-id manages.
+const IDProperty = "GROWTH_ID"
+
+// Heading is an org-mode heading with its TODO state and planning
+// timestamps. Only dates are kept, times of day are dropped.
+type Heading struct {
+	Level     int // number of stars
+	State     string
+	Title     string
+	ID        string // the GROWTH_ID property
+	Scheduled *time.Time
+	Deadline  *time.Time
+	Closed    *time.Time
+}
+
+const dateLayout = "2006-01-02"
+
+var (
+	headingLine  = regexp.MustCompile(`^(\*+)\s+(.*)$`)
+	priority     = regexp.MustCompile(`^\[#[A-Za-z0-9]\]\s*`)
+	tags         = regexp.MustCompile(`\s+:[\w@#%:]+:\s*$`)
+	planningItem = regexp.MustCompile(`(CLOSED|DEADLINE|SCHEDULED):\s*[<\[](\d{4}-\d{2}-\d{2})[^>\]]*[>\]]`)
+	propertyLine = regexp.MustCompile(`^\s*:([^:\s]+):\s*(.*?)\s*$`)
+)
+
+// Write writes headings as an org-mode file with title as its #+TITLE
+func Write(w io.Writer, title string, headings []Heading) error {
+	var b strings.Builder
+
+	if title != "" {
+		fmt.Fprintf(&b, "#+TITLE: %s\n", title)
+	}
+	fmt.Fprintf(&b, "#+TODO: %s | %s\n", Todo, Done)
+
+	for _, h := range headings {
+		b.WriteString("\n" + strings.Repeat("*", max(h.Level, 1)) + " ")
+		if h.State != "" {
+			b.WriteString(h.State + " ")
+		}
+		b.WriteString(strings.ReplaceAll(h.Title, "\n", " ") + "\n")
+
+		var planning []string
+		if h.Closed != nil {
+			planning = append(planning, "CLOSED: "+stamp(*h.Closed, '[', ']'))
+		}
+		if h.Deadline != nil {
+			planning = append(planning, "DEADLINE: "+stamp(*h.Deadline, '<', '>'))
+		}
+		if h.Scheduled != nil {
+			planning = append(planning, "SCHEDULED: "+stamp(*h.Scheduled, '<', '>'))
+		}
+		if len(planning) > 0 {
+			b.WriteString(strings.Join(planning, " ") + "\n")
+		}
+
+		if h.ID != "" {
+			fmt.Fprintf(&b, ":PROPERTIES:\n:%s: %s\n:END:\n", IDProperty, h.ID)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write org file: %w", err)
+	}
+	return nil
+}
+
+// stamp formats an org timestamp like <2025-01-06 Mon>
+func stamp(date time.Time, open, close byte) string {
+	return string(open) + date.Format("2006-01-02 Mon") + string(close)
+}
+
+// Parse reads the headings of an org-mode file. Planning timestamps are read
+// from the line after a heading and the ID from its property drawer; body
+// text is skipped.
+func Parse(r io.Reader) ([]Heading, error) {
+	var headings []Heading
+	var current *Heading
+	afterHeading := false
+	inDrawer := false
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if match := headingLine.FindStringSubmatch(line); match != nil {
+			headings = append(headings, parseHeading(len(match[1]), match[2]))
+			current = &headings[len(headings)-1]
+			afterHeading = true
+			inDrawer = false
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case afterHeading && planningItem.MatchString(trimmed):
+			if err := parsePlanning(current, trimmed); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+		case strings.EqualFold(trimmed, ":PROPERTIES:"):
+			inDrawer = true
+		case inDrawer && strings.EqualFold(trimmed, ":END:"):
+			inDrawer = false
+		case inDrawer:
+			if match := propertyLine.FindStringSubmatch(line); match != nil && strings.EqualFold(match[1], IDProperty) {
+				current.ID = match[2]
+			}
+		}
+		afterHeading = false
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read org file: %w", err)
+	}
+
+	return headings, nil
+}
+
+// parseHeading parses the text of a heading after its stars
+func parseHeading(level int, text string) Heading {
+	h := Heading{Level: level}
+
+	if keyword, rest, found := strings.Cut(text, " "); found || keyword == text {
+		if keyword == Todo || keyword == Done {
+			h.State = keyword
+			text = rest
+		}
+	}
+
+	text = priority.ReplaceAllString(text, "")
+	text = tags.ReplaceAllString(text, "")
+	h.Title = strings.TrimSpace(text)
+	return h
+}
+
+func parsePlanning(h *Heading, line string) error {
+	for _, match := range planningItem.FindAllStringSubmatch(line, -1) {
+		date, err := time.ParseInLocation(dateLayout, match[2], time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid %s date %q: %w", match[1], match[2], err)
+		}
+
+		switch match[1] {
+		case "CLOSED":
+			h.Closed = &date
+		case "DEADLINE":
+			h.Deadline = &date
+		case "SCHEDULED":
+			h.Scheduled = &date
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package orgmode
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	return &d
+}
+
+func TestWrite(t *testing.T) {
+	headings := []Heading{
+		{Level: 1, Title: "Backend Go", ID: "path-001"},
+		{Level: 2, State: Done, Title: "Foundations", ID: "phase-001", Scheduled: date(2025, 1, 6), Closed: date(2025, 1, 20)},
+		{Level: 3, State: Todo, Title: "Write a CLI", ID: "milestone-001", Deadline: date(2025, 2, 1)},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Write(&b, "growth.md", headings))
+
+	assert.Equal(t, `#+TITLE: growth.md
+#+TODO: TODO | DONE
+
+* Backend Go
+:PROPERTIES:
+:GROWTH_ID: path-001
+:END:
+
+** DONE Foundations
+CLOSED: [2025-01-20 Mon] SCHEDULED: <2025-01-06 Mon>
+:PROPERTIES:
+:GROWTH_ID: phase-001
+:END:
+
+*** TODO Write a CLI
+DEADLINE: <2025-02-01 Sat>
+:PROPERTIES:
+:GROWTH_ID: milestone-001
+:END:
+`, b.String())
+
+	parsed, err := Parse(strings.NewReader(b.String()))
+	require.NoError(t, err)
+	assert.Equal(t, headings, parsed)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("reads headings edited in Emacs", func(t *testing.T) {
+		org := `#+TITLE: growth.md
+Intro text
+* Backend Go                                                  :learning:
+  :PROPERTIES:
+  :growth_id: path-001
+  :END:
+** DONE [#A] Write a CLI :go:cli:
+   CLOSED: [2025-01-09 Thu 18:42] DEADLINE: <2025-01-10 Fri +1w>
+   :PROPERTIES:
+   :ID:        8f6c5a3e
+   :GROWTH_ID: milestone-001
+   :END:
+   Notes about the CLI.
+   SCHEDULED: <2025-03-01 Sat>
+** TODO
+** A new idea
+`
+		headings, err := Parse(strings.NewReader(org))
+		require.NoError(t, err)
+		require.Len(t, headings, 4)
+
+		assert.Equal(t, Heading{Level: 1, Title: "Backend Go", ID: "path-001"}, headings[0])
+		assert.Equal(t, Heading{
+			Level:    2,
+			State:    Done,
+			Title:    "Write a CLI",
+			ID:       "milestone-001",
+			Deadline: date(2025, 1, 10),
+			Closed:   date(2025, 1, 9),
+		}, headings[1])
+		assert.Equal(t, Heading{Level: 2, State: Todo}, headings[2])
+		assert.Equal(t, Heading{Level: 2, Title: "A new idea"}, headings[3])
+	})
+
+	t.Run("fails on invalid dates", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("* TODO Task\nDEADLINE: <2025-13-40 Mon>\n"))
+		assert.ErrorContains(t, err, "line 2")
+	})
+}
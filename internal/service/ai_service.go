@@ -1,347 +1,704 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/aifactory"
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/i18n"
 	"github.com/illenko/growth.md/internal/storage"
 )
 
 type AIService struct {
-	config        *storage.Config
-	skillRepo     *storage.SkillRepository
-	goalRepo      *storage.GoalRepository
-	pathRepo      *storage.PathRepository
-	phaseRepo     *storage.PhaseRepository
-	resourceRepo  *storage.ResourceRepository
-	milestoneRepo *storage.MilestoneRepository
-	progressRepo  *storage.ProgressLogRepository
+	config *storage.Config
+	repos  Repositories
+	links  *LinkService
+	nextID IDGenerator
+
+	// OnFallback, if set, is called when a provider fails and the request
+	// is retried with the next of ai.fallbackProviders
+	OnFallback func(failed string, err error, next string)
+
+	// OnWarning, if set, receives problems that do not stop an operation,
+	// such as a learning path that fails to load for an analysis
+	OnWarning func(message string)
+
+	// RecordDir and ReplayDir record provider responses as fixtures and
+	// replay them instead of calling the provider, see ai.Fixtures
+	RecordDir string
+	ReplayDir string
 }
 
-func NewAIService(
-	config *storage.Config,
-	skillRepo *storage.SkillRepository,
-	goalRepo *storage.GoalRepository,
-	pathRepo *storage.PathRepository,
-	phaseRepo *storage.PhaseRepository,
-	resourceRepo *storage.ResourceRepository,
-	milestoneRepo *storage.MilestoneRepository,
-	progressRepo *storage.ProgressLogRepository,
-) *AIService {
+func NewAIService(config *storage.Config, repos Repositories, links *LinkService, nextID IDGenerator) *AIService {
 	return &AIService{
-		config:        config,
-		skillRepo:     skillRepo,
-		goalRepo:      goalRepo,
-		pathRepo:      pathRepo,
-		phaseRepo:     phaseRepo,
-		resourceRepo:  resourceRepo,
-		milestoneRepo: milestoneRepo,
-		progressRepo:  progressRepo,
+		config: config,
+		repos:  repos,
+		links:  links,
+		nextID: nextID,
 	}
 }
 
+func (s *AIService) warn(message string) {
+	if s.OnWarning != nil {
+		s.OnWarning(message)
+	}
+}
+
+// AIConfig builds the AI client config from the config, with optional
+// provider and model overrides
+func (s *AIService) AIConfig(providerOverride, modelOverride string) ai.Config {
+	provider := s.config.AI.Provider
+	if providerOverride != "" {
+		provider = providerOverride
+	}
+
+	model := s.config.AI.Model
+	if modelOverride != "" {
+		model = modelOverride
+	}
+
+	return ai.Config{
+		Provider:         provider,
+		Model:            model,
+		Temperature:      s.config.AI.Temperature,
+		MaxTokens:        s.config.AI.MaxTokens,
+		StagedGeneration: s.config.AI.StagedGeneration,
+		ParallelRequests: s.config.AI.ParallelRequests,
+		RecordDir:        s.RecordDir,
+		ReplayDir:        s.ReplayDir,
+	}
+}
+
+// NewClient creates an AI client from the config, with optional provider and
+// model overrides. Unless a provider is given, requests fall back to
+// ai.fallbackProviders when the configured provider fails.
+func (s *AIService) NewClient(providerOverride, modelOverride string) (ai.AIClient, error) {
+	aiConfig := s.AIConfig(providerOverride, modelOverride)
+
+	if err := aiConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("AI configuration error: %w", err)
+	}
+
+	var fallbacks []string
+	if providerOverride == "" {
+		fallbacks = s.config.AI.FallbackProviders
+	}
+
+	client, err := aifactory.NewClientWithFallbacks(aiConfig, fallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize AI client: %w", err)
+	}
+
+	if chain, ok := client.(*ai.FallbackClient); ok {
+		chain.OnFallback = s.OnFallback
+	}
+
+	return client, nil
+}
+
 type PathGenerationOptions struct {
 	GoalID         core.EntityID
-	Style          string
+	Style          string // defaults to ai.defaultStyle
 	TimeCommitment string
 	Background     string
 	Provider       string
 	Model          string
 }
 
-type PathGenerationResult struct {
-	Path       *core.LearningPath
-	Phases     []*core.Phase
-	Resources  []*core.Resource
-	Milestones []*core.Milestone
-	Reasoning  string
-}
-
-func (s *AIService) GenerateLearningPath(ctx context.Context, opts PathGenerationOptions) (*PathGenerationResult, error) {
-	goal, err := s.goalRepo.GetByIDWithBody(opts.GoalID)
+// BuildPathRequest loads the goal and current skills into a path generation
+// request, falling back to the configured learning style
+func (s *AIService) BuildPathRequest(opts PathGenerationOptions) (ai.PathGenerationRequest, error) {
+	goal, err := s.repos.Goals.GetByIDWithBody(opts.GoalID)
 	if err != nil {
-		return nil, fmt.Errorf("goal '%s' not found: %w", opts.GoalID, err)
+		return ai.PathGenerationRequest{}, fmt.Errorf("goal '%s' not found: %w", opts.GoalID, err)
 	}
 
-	skills, err := s.skillRepo.GetAll()
+	skills, err := s.repos.Skills.GetAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load skills: %w", err)
+		return ai.PathGenerationRequest{}, fmt.Errorf("failed to load skills: %w", err)
 	}
 
-	provider := s.config.AI.Provider
-	if opts.Provider != "" {
-		provider = opts.Provider
+	style := opts.Style
+	if style == "" {
+		style = s.config.AI.DefaultStyle
 	}
 
-	model := s.config.AI.Model
-	if opts.Model != "" {
-		model = opts.Model
-	}
+	return ai.PathGenerationRequest{
+		Goal:           goal,
+		CurrentSkills:  skills,
+		Background:     opts.Background,
+		LearningStyle:  style,
+		TimeCommitment: opts.TimeCommitment,
+		TargetDate:     goal.TargetDate,
+		Feedback:       s.recentFeedback(skills, time.Now()),
+		Language:       i18n.PromptLanguage(s.config.User.Language),
+	}, nil
+}
 
-	style := s.config.AI.DefaultStyle
-	if opts.Style != "" {
-		style = opts.Style
-	}
+type SkillPlanOptions struct {
+	SkillID        core.EntityID
+	TargetLevel    core.ProficiencyLevel // defaults to the next level up
+	Style          string                // defaults to ai.defaultStyle
+	TimeCommitment string
+	Background     string
+}
 
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: s.config.AI.Temperature,
-		MaxTokens:   s.config.AI.MaxTokens,
+// BuildSkillPlanRequest loads the skill and current skills into a path
+// generation request scoped to the skill, without a goal
+func (s *AIService) BuildSkillPlanRequest(opts SkillPlanOptions) (ai.PathGenerationRequest, error) {
+	skill, err := s.repos.Skills.GetByIDWithBody(opts.SkillID)
+	if err != nil {
+		return ai.PathGenerationRequest{}, fmt.Errorf("skill '%s' not found: %w", opts.SkillID, err)
 	}
 
-	if err := aiConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("AI configuration error: %w", err)
+	targetLevel := getNextLevel(skill.Level)
+	if opts.TargetLevel != "" {
+		targetLevel = opts.TargetLevel
+		if !targetLevel.IsValid() {
+			return ai.PathGenerationRequest{}, fmt.Errorf("invalid target level: %s (must be beginner, intermediate, advanced, or expert)", targetLevel)
+		}
 	}
 
-	client, err := aifactory.NewClient(aiConfig)
+	skills, err := s.repos.Skills.GetAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize AI client: %w", err)
+		return ai.PathGenerationRequest{}, fmt.Errorf("failed to load skills: %w", err)
 	}
 
-	req := ai.PathGenerationRequest{
-		Goal:           goal,
+	style := opts.Style
+	if style == "" {
+		style = s.config.AI.DefaultStyle
+	}
+
+	return ai.PathGenerationRequest{
+		Skill:          skill,
+		TargetLevel:    targetLevel,
 		CurrentSkills:  skills,
 		Background:     opts.Background,
 		LearningStyle:  style,
 		TimeCommitment: opts.TimeCommitment,
-		TargetDate:     goal.TargetDate,
+		Feedback:       s.recentFeedback(skills, time.Now()),
+		Language:       i18n.PromptLanguage(s.config.User.Language),
+	}, nil
+}
+
+// BuildPathAuditRequest loads an existing path with its phases, resources
+// and milestones into an audit request, along with the goal it belongs to or
+// the skill it was planned for
+func (s *AIService) BuildPathAuditRequest(pathID core.EntityID) (ai.PathAuditRequest, error) {
+	path, err := s.repos.Paths.GetByIDWithBody(pathID)
+	if err != nil {
+		return ai.PathAuditRequest{}, fmt.Errorf("learning path '%s' not found: %w", pathID, err)
 	}
 
-	resp, err := client.GenerateLearningPath(ctx, req)
+	goals, err := s.repos.Goals.GetAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate path: %w", err)
+		return ai.PathAuditRequest{}, fmt.Errorf("failed to load goals: %w", err)
+	}
+	var goal *core.Goal
+	for _, g := range goals {
+		if slices.Contains(g.LearningPaths, path.ID) {
+			if goal, err = s.repos.Goals.GetByIDWithBody(g.ID); err != nil {
+				return ai.PathAuditRequest{}, fmt.Errorf("failed to load goal: %w", err)
+			}
+			break
+		}
+	}
+
+	var skill *core.Skill
+	if path.SkillID != "" {
+		if skill, err = s.repos.Skills.GetByIDWithBody(path.SkillID); err != nil {
+			// Non-fatal: the path can be audited on its own
+			s.warn(fmt.Sprintf("Could not load skill: %v", err))
+		}
 	}
 
-	resp.Path.GenerationContext = fmt.Sprintf("Goal: %s | Style: %s | Time: %s",
-		goal.Title, style, opts.TimeCommitment)
+	phases, err := s.repos.Phases.FindByPathID(path.ID)
+	if err != nil {
+		return ai.PathAuditRequest{}, fmt.Errorf("failed to load phases: %w", err)
+	}
 
-	return &PathGenerationResult{
-		Path:       resp.Path,
-		Phases:     resp.Phases,
-		Resources:  resp.Resources,
-		Milestones: resp.Milestones,
-		Reasoning:  resp.Reasoning,
+	var auditPhases []ai.AuditPhase
+	for i, p := range phases {
+		phase, err := s.repos.Phases.GetByIDWithBody(p.ID)
+		if err != nil {
+			return ai.PathAuditRequest{}, fmt.Errorf("failed to load phase: %w", err)
+		}
+
+		auditPhase := ai.AuditPhase{Phase: phase, Number: i + 1}
+		for _, id := range phase.Resources {
+			resource, err := s.repos.Resources.GetByID(id)
+			if err != nil {
+				s.warn(fmt.Sprintf("Could not load resource %s: %v", id, err))
+				continue
+			}
+			auditPhase.Resources = append(auditPhase.Resources, resource)
+		}
+		for _, id := range phase.Milestones {
+			milestone, err := s.repos.Milestones.GetByID(id)
+			if err != nil {
+				s.warn(fmt.Sprintf("Could not load milestone %s: %v", id, err))
+				continue
+			}
+			auditPhase.Milestones = append(auditPhase.Milestones, milestone)
+		}
+		auditPhases = append(auditPhases, auditPhase)
+	}
+
+	skills, err := s.repos.Skills.GetAll()
+	if err != nil {
+		return ai.PathAuditRequest{}, fmt.Errorf("failed to load skills: %w", err)
+	}
+
+	return ai.PathAuditRequest{
+		Path:          path,
+		Goal:          goal,
+		Skill:         skill,
+		Phases:        auditPhases,
+		CurrentSkills: skills,
+		Language:      i18n.PromptLanguage(s.config.User.Language),
 	}, nil
 }
 
-func (s *AIService) SaveGeneratedPath(result *PathGenerationResult, goalID core.EntityID) error {
-	if err := s.pathRepo.Create(result.Path); err != nil {
+// recentFeedback loads the 360 feedback of the last year for a request,
+// newest first. Feedback is extra context, so failing to load it only warns.
+func (s *AIService) recentFeedback(skills []*core.Skill, now time.Time) []ai.FeedbackEntry {
+	if s.repos.Feedback == nil {
+		return nil
+	}
+
+	feedback, err := s.repos.Feedback.FindSince(now.AddDate(-1, 0, 0))
+	if err != nil {
+		s.warn(fmt.Sprintf("Could not load feedback: %v", err))
+		return nil
+	}
+	return feedbackEntries(feedback, skills)
+}
+
+// feedbackEntries names the skills of each piece of feedback by title,
+// falling back to the ID of skills that no longer exist
+func feedbackEntries(feedback []*core.Feedback, skills []*core.Skill) []ai.FeedbackEntry {
+	titles := make(map[core.EntityID]string, len(skills))
+	for _, skill := range skills {
+		titles[skill.ID] = skill.Title
+	}
+
+	entries := make([]ai.FeedbackEntry, 0, len(feedback))
+	for _, f := range feedback {
+		entry := ai.FeedbackEntry{Feedback: f}
+		for _, id := range f.Skills {
+			entry.SkillTitles = append(entry.SkillTitles, cmp.Or(titles[id], string(id)))
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GenerateLearningPath asks the AI provider for a learning path toward a
+// goal. The path is not saved; see SaveGeneratedPath.
+func (s *AIService) GenerateLearningPath(ctx context.Context, opts PathGenerationOptions) (*ai.PathGenerationResponse, error) {
+	req, err := s.BuildPathRequest(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.NewClient(opts.Provider, opts.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GenerateLearningPath(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate path: %w", err)
+	}
+	return resp, nil
+}
+
+// SaveGeneratedPath saves a generated or imported path with its phases,
+// resources and milestones under new sequential IDs, and links it to the
+// goal, if any. The time commitment, like "5 hours/week", sets the path's weekly
+// hours when it starts with a number. When a write fails or ctx is
+// cancelled, the entities already saved are deleted again.
+func (s *AIService) SaveGeneratedPath(ctx context.Context, resp *ai.PathGenerationResponse, goalID core.EntityID, timeCommitment string) (err error) {
+	if err := s.reassignGeneratedIDs(resp); err != nil {
+		return fmt.Errorf("failed to assign IDs: %w", err)
+	}
+
+	if hours, ok := parseHoursPerWeek(timeCommitment); ok {
+		resp.Path.HoursPerWeek = hours
+	}
+
+	// A path saved halfway is worse than none: undo every write on failure
+	var rollback Rollback
+	defer func() {
+		if err == nil {
+			return
+		}
+		if undoErr := rollback.Undo(); undoErr != nil {
+			err = fmt.Errorf("%w (failed to remove the partly saved path: %v)", err, undoErr)
+		}
+	}()
+
+	if err := s.repos.Paths.Create(resp.Path); err != nil {
 		return fmt.Errorf("failed to save path: %w", err)
 	}
+	rollback.Add(func() error { return s.repos.Paths.Delete(resp.Path.ID) })
 
-	for _, phase := range result.Phases {
-		if err := s.phaseRepo.Create(phase); err != nil {
-			return fmt.Errorf("failed to save phase: %w", err)
+	for _, phase := range resp.Phases {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.repos.Phases.Create(phase); err != nil {
+			return fmt.Errorf("failed to save phase %s: %w", phase.ID, err)
 		}
+		rollback.Add(func() error { return s.repos.Phases.Delete(phase.ID) })
 	}
 
-	for _, resource := range result.Resources {
-		if err := s.resourceRepo.Create(resource); err != nil {
-			return fmt.Errorf("failed to save resource: %w", err)
+	for _, resource := range resp.Resources {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.links.CreateResource(resource); err != nil {
+			return fmt.Errorf("failed to save resource %s: %w", resource.ID, err)
 		}
+		rollback.Add(func() error { return s.links.DeleteResource(resource.ID) })
 	}
 
-	for _, milestone := range result.Milestones {
-		if err := s.milestoneRepo.Create(milestone); err != nil {
-			return fmt.Errorf("failed to save milestone: %w", err)
+	for _, milestone := range resp.Milestones {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		if err := s.repos.Milestones.Create(milestone); err != nil {
+			return fmt.Errorf("failed to save milestone %s: %w", milestone.ID, err)
+		}
+		rollback.Add(func() error { return s.repos.Milestones.Delete(milestone.ID) })
 	}
 
-	goal, err := s.goalRepo.GetByIDWithBody(goalID)
+	// Non-fatal from here: the path is already created
+	if goalID == "" {
+		return nil
+	}
+	goal, err := s.repos.Goals.GetByIDWithBody(goalID)
 	if err != nil {
-		return fmt.Errorf("failed to load goal: %w", err)
+		return nil
 	}
-
-	goal.AddLearningPath(result.Path.ID)
-	if err := s.goalRepo.Update(goal); err != nil {
-		return fmt.Errorf("failed to update goal: %w", err)
+	goal.AddLearningPath(resp.Path.ID)
+	if err := s.repos.Goals.Update(goal); err != nil {
+		s.warn(fmt.Sprintf("Failed to link path to goal: %v", err))
 	}
 
 	return nil
 }
 
-type ResourceSuggestionOptions struct {
-	SkillID     core.EntityID
-	TargetLevel core.ProficiencyLevel
-	Style       string
-	Budget      string
-	Provider    string
-	Model       string
+// SaveSkillPlan saves a path generated for one skill like SaveGeneratedPath,
+// without a goal: the path is marked with the skill, and so are its
+// resources, which the response leaves without one
+func (s *AIService) SaveSkillPlan(ctx context.Context, resp *ai.PathGenerationResponse, skillID core.EntityID, timeCommitment string) error {
+	resp.Path.SkillID = skillID
+	for _, resource := range resp.Resources {
+		if resource.SkillID == "" {
+			resource.SkillID = skillID
+		}
+	}
+	return s.SaveGeneratedPath(ctx, resp, "", timeCommitment)
 }
 
-type ResourceSuggestionResult struct {
-	Resources []*core.Resource
-	Reasoning string
-}
+// reassignGeneratedIDs replaces the placeholder IDs of a generated path with
+// the next free ones, updating the references between its entities
+func (s *AIService) reassignGeneratedIDs(resp *ai.PathGenerationResponse) error {
+	milestoneIDMap := make(map[core.EntityID]core.EntityID)
+	phaseIDMap := make(map[core.EntityID]core.EntityID)
+	resourceIDMap := make(map[core.EntityID]core.EntityID)
 
-func (s *AIService) SuggestResources(ctx context.Context, opts ResourceSuggestionOptions) (*ResourceSuggestionResult, error) {
-	skill, err := s.skillRepo.GetByIDWithBody(opts.SkillID)
+	newPathID, err := s.nextID("path")
 	if err != nil {
-		return nil, fmt.Errorf("skill '%s' not found: %w", opts.SkillID, err)
+		return fmt.Errorf("failed to generate path ID: %w", err)
 	}
+	oldPathID := resp.Path.ID
+	resp.Path.ID = newPathID
 
-	currentLevel := skill.Level
-	targetLevel := opts.TargetLevel
-	if targetLevel == "" {
-		targetLevel = getNextLevel(currentLevel)
+	if len(resp.Phases) > 0 {
+		startPhaseID, err := s.nextID("phase")
+		if err != nil {
+			return fmt.Errorf("failed to generate phase ID: %w", err)
+		}
+		phaseCounter := extractIDNumber(startPhaseID)
+
+		for _, phase := range resp.Phases {
+			newPhaseID := core.EntityID(fmt.Sprintf("phase-%03d", phaseCounter))
+			phaseIDMap[phase.ID] = newPhaseID
+			phase.ID = newPhaseID
+			phase.PathID = newPathID
+			phaseCounter++
+		}
 	}
 
-	provider := s.config.AI.Provider
-	if opts.Provider != "" {
-		provider = opts.Provider
+	// The path lists its phases by their placeholder IDs too
+	for i, oldPhaseID := range resp.Path.Phases {
+		if newPhaseID, ok := phaseIDMap[oldPhaseID]; ok {
+			resp.Path.Phases[i] = newPhaseID
+		}
 	}
 
-	model := s.config.AI.Model
-	if opts.Model != "" {
-		model = opts.Model
+	if len(resp.Resources) > 0 {
+		startResourceID, err := s.nextID("resource")
+		if err != nil {
+			return fmt.Errorf("failed to generate resource ID: %w", err)
+		}
+		resourceCounter := extractIDNumber(startResourceID)
+
+		for _, resource := range resp.Resources {
+			newResourceID := core.EntityID(fmt.Sprintf("resource-%03d", resourceCounter))
+			resourceIDMap[resource.ID] = newResourceID
+			resource.ID = newResourceID
+			resourceCounter++
+		}
 	}
 
-	style := s.config.AI.DefaultStyle
-	if opts.Style != "" {
-		style = opts.Style
+	if len(resp.Milestones) > 0 {
+		startMilestoneID, err := s.nextID("milestone")
+		if err != nil {
+			return fmt.Errorf("failed to generate milestone ID: %w", err)
+		}
+		milestoneCounter := extractIDNumber(startMilestoneID)
+
+		for _, milestone := range resp.Milestones {
+			newMilestoneID := core.EntityID(fmt.Sprintf("milestone-%03d", milestoneCounter))
+			milestoneIDMap[milestone.ID] = newMilestoneID
+			milestone.ID = newMilestoneID
+			milestoneCounter++
+
+			// Path milestones reference the path by its placeholder ID
+			if milestone.ReferenceType == core.ReferencePath && milestone.ReferenceID == oldPathID {
+				milestone.ReferenceID = newPathID
+			}
+		}
 	}
 
-	budget := s.config.AI.DefaultBudget
-	if opts.Budget != "" {
-		budget = opts.Budget
+	for _, phase := range resp.Phases {
+		var newMilestones []core.EntityID
+		for _, oldMilestoneID := range phase.Milestones {
+			if newMilestoneID, ok := milestoneIDMap[oldMilestoneID]; ok {
+				newMilestones = append(newMilestones, newMilestoneID)
+			}
+		}
+		phase.Milestones = newMilestones
+
+		var newResources []core.EntityID
+		for _, oldResourceID := range phase.Resources {
+			if newResourceID, ok := resourceIDMap[oldResourceID]; ok {
+				newResources = append(newResources, newResourceID)
+			}
+		}
+		phase.Resources = newResources
+	}
+
+	return nil
+}
+
+type ResourceSuggestionOptions struct {
+	SkillID     core.EntityID
+	TargetLevel core.ProficiencyLevel // defaults to the next level up
+	Style       string                // defaults to ai.defaultStyle
+	Budget      string                // defaults to ai.defaultBudget
+	Provider    string
+	Model       string
+}
+
+// BuildResourceRequest loads the skill into a resource suggestion request,
+// with the remaining yearly learning budget when ai.learningBudget is set
+func (s *AIService) BuildResourceRequest(opts ResourceSuggestionOptions) (ai.ResourceSuggestionRequest, error) {
+	skill, err := s.repos.Skills.GetByIDWithBody(opts.SkillID)
+	if err != nil {
+		return ai.ResourceSuggestionRequest{}, fmt.Errorf("skill '%s' not found: %w", opts.SkillID, err)
 	}
 
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: s.config.AI.Temperature,
-		MaxTokens:   s.config.AI.MaxTokens,
+	targetLevel := getNextLevel(skill.Level)
+	if opts.TargetLevel != "" {
+		targetLevel = opts.TargetLevel
+		if !targetLevel.IsValid() {
+			return ai.ResourceSuggestionRequest{}, fmt.Errorf("invalid target level: %s (must be beginner, intermediate, advanced, or expert)", targetLevel)
+		}
 	}
 
-	if err := aiConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("AI configuration error: %w", err)
+	style := opts.Style
+	if style == "" {
+		style = s.config.AI.DefaultStyle
+	}
+	budget := opts.Budget
+	if budget == "" {
+		budget = s.config.AI.DefaultBudget
 	}
 
-	client, err := aifactory.NewClient(aiConfig)
+	var remaining float64
+	if s.config.AI.LearningBudget > 0 {
+		resources, err := s.repos.Resources.GetAll()
+		if err != nil {
+			return ai.ResourceSuggestionRequest{}, fmt.Errorf("failed to load resources: %w", err)
+		}
+		remaining = core.RemainingBudget(s.config.AI.LearningBudget, resources, time.Now())
+	}
+
+	return ai.ResourceSuggestionRequest{
+		Skill:           skill,
+		CurrentLevel:    skill.Level,
+		TargetLevel:     targetLevel,
+		LearningStyle:   style,
+		Budget:          budget,
+		Subscriptions:   s.config.AI.Subscriptions,
+		BudgetLimit:     s.config.AI.LearningBudget,
+		RemainingBudget: remaining,
+		Language:        i18n.PromptLanguage(s.config.User.Language),
+	}, nil
+}
+
+// SuggestResources asks the AI provider for resources to learn a skill.
+// The resources are not saved; see SaveSuggestedResources.
+func (s *AIService) SuggestResources(ctx context.Context, opts ResourceSuggestionOptions) (*ai.ResourceSuggestionResponse, error) {
+	req, err := s.BuildResourceRequest(opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize AI client: %w", err)
+		return nil, err
 	}
 
-	req := ai.ResourceSuggestionRequest{
-		Skill:         skill,
-		CurrentLevel:  currentLevel,
-		TargetLevel:   targetLevel,
-		LearningStyle: style,
-		Budget:        budget,
+	client, err := s.NewClient(opts.Provider, opts.Model)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := client.SuggestResources(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to suggest resources: %w", err)
 	}
+	return resp, nil
+}
 
-	return &ResourceSuggestionResult{
-		Resources: resp.Resources,
-		Reasoning: resp.Reasoning,
-	}, nil
+// SaveSuggestedResources saves suggested resources under new IDs and lists
+// them on their skill, returning how many were saved. Resources that fail
+// to save are reported through OnWarning and skipped.
+func (s *AIService) SaveSuggestedResources(resources []*core.Resource) int {
+	saved := 0
+	for _, resource := range resources {
+		newID, err := s.nextID("resource")
+		if err != nil {
+			s.warn(fmt.Sprintf("Failed to generate ID for resource: %v", err))
+			continue
+		}
+		resource.ID = newID
+
+		if err := s.links.CreateResource(resource); err != nil {
+			s.warn(fmt.Sprintf("Failed to save resource %s: %v", resource.ID, err))
+			continue
+		}
+		saved++
+	}
+	return saved
 }
 
 type ProgressAnalysisOptions struct {
-	GoalID   core.EntityID
+	GoalID   core.EntityID // analyzes overall progress when empty
 	Days     int
+	Until    time.Time // end of the analyzed days, now when zero
 	Provider string
 	Model    string
 }
 
-type ProgressAnalysisResult struct {
-	Summary         string
-	Insights        []string
-	Recommendations []string
-	IsOnTrack       bool
-	SuggestedFocus  []string
-	LogCount        int
-}
-
-func (s *AIService) AnalyzeProgress(ctx context.Context, opts ProgressAnalysisOptions) (*ProgressAnalysisResult, error) {
+// BuildAnalysisRequest collects the progress logs, notes and skills from
+// the last days into an analysis request, scoped to the goal and its first
+// learning path when a goal is given
+func (s *AIService) BuildAnalysisRequest(opts ProgressAnalysisOptions) (ai.ProgressAnalysisRequest, error) {
 	var goal *core.Goal
 	var path *core.LearningPath
 	var err error
 
 	if opts.GoalID != "" {
-		goal, err = s.goalRepo.GetByIDWithBody(opts.GoalID)
+		goal, err = s.repos.Goals.GetByIDWithBody(opts.GoalID)
 		if err != nil {
-			return nil, fmt.Errorf("goal '%s' not found: %w", opts.GoalID, err)
+			return ai.ProgressAnalysisRequest{}, fmt.Errorf("goal '%s' not found: %w", opts.GoalID, err)
 		}
 
 		if len(goal.LearningPaths) > 0 {
-			path, _ = s.pathRepo.GetByIDWithBody(goal.LearningPaths[0])
+			path, err = s.repos.Paths.GetByIDWithBody(goal.LearningPaths[0])
+			if err != nil {
+				// Non-fatal: can analyze without path
+				s.warn(fmt.Sprintf("Could not load learning path: %v", err))
+			}
 		}
 	}
 
-	cutoffDate := time.Now().AddDate(0, 0, -opts.Days)
-	logs, err := s.progressRepo.GetAll()
+	logs, err := s.repos.Progress.GetAll()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load progress logs: %w", err)
+		return ai.ProgressAnalysisRequest{}, fmt.Errorf("failed to load progress logs: %w", err)
 	}
 
+	now := time.Now()
+	if !opts.Until.IsZero() {
+		now = opts.Until
+	}
+	cutoffDate := now.AddDate(0, 0, -opts.Days)
 	var recentLogs []*core.ProgressLog
 	for _, log := range logs {
-		if log.Date.After(cutoffDate) {
+		if log.Date.After(cutoffDate) && (opts.Until.IsZero() || log.Date.Before(opts.Until)) {
 			recentLogs = append(recentLogs, log)
 		}
 	}
 
-	skills, err := s.skillRepo.GetAll()
-	if err != nil {
-		return nil, fmt.Errorf("failed to load skills: %w", err)
+	if len(recentLogs) == 0 {
+		return ai.ProgressAnalysisRequest{}, fmt.Errorf("no progress logs found in the last %d days", opts.Days)
 	}
+	// Oldest first, so the same logs always make the same prompts
+	slices.SortFunc(recentLogs, func(a, b *core.ProgressLog) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.ID, b.ID))
+	})
 
-	provider := s.config.AI.Provider
-	if opts.Provider != "" {
-		provider = opts.Provider
-	}
-
-	model := s.config.AI.Model
-	if opts.Model != "" {
-		model = opts.Model
-	}
-
-	aiConfig := ai.Config{
-		Provider:    provider,
-		Model:       model,
-		Temperature: s.config.AI.Temperature,
-		MaxTokens:   s.config.AI.MaxTokens,
-	}
-
-	if err := aiConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("AI configuration error: %w", err)
+	skills, err := s.repos.Skills.GetAll()
+	if err != nil {
+		return ai.ProgressAnalysisRequest{}, fmt.Errorf("failed to load skills: %w", err)
 	}
 
-	client, err := aifactory.NewClient(aiConfig)
+	notes, err := s.repos.Notes.FindSince(cutoffDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize AI client: %w", err)
+		// Non-fatal: notes are extra context
+		s.warn(fmt.Sprintf("Could not load notes: %v", err))
 	}
+	slices.SortFunc(notes, func(a, b *core.Note) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.ID, b.ID))
+	})
 
-	req := ai.ProgressAnalysisRequest{
+	return ai.ProgressAnalysisRequest{
 		Goal:          goal,
 		Path:          path,
 		ProgressLogs:  recentLogs,
 		CurrentSkills: skills,
+		Notes:         notes,
+		Feedback:      s.recentFeedback(skills, now),
+		Language:      i18n.PromptLanguage(s.config.User.Language),
+	}, nil
+}
+
+// AnalyzeProgress asks the AI provider for insights into recent progress,
+// summarizing long histories month by month first
+func (s *AIService) AnalyzeProgress(ctx context.Context, opts ProgressAnalysisOptions) (*ai.ProgressAnalysisResponse, error) {
+	req, err := s.BuildAnalysisRequest(opts)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := client.AnalyzeProgress(ctx, req)
+	client, err := s.NewClient(opts.Provider, opts.Model)
 	if err != nil {
-		return nil, fmt.Errorf("failed to analyze progress: %w", err)
+		return nil, err
 	}
 
-	return &ProgressAnalysisResult{
-		Summary:         resp.Summary,
-		Insights:        resp.Insights,
-		Recommendations: resp.Recommendations,
-		IsOnTrack:       resp.IsOnTrack,
-		SuggestedFocus:  resp.SuggestedFocus,
-		LogCount:        len(recentLogs),
-	}, nil
+	resp, err := RunAnalysis(ctx, client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze progress: %w", err)
+	}
+	return resp, nil
 }
 
 func getNextLevel(current core.ProficiencyLevel) core.ProficiencyLevel {
@@ -353,8 +710,36 @@ func getNextLevel(current core.ProficiencyLevel) core.ProficiencyLevel {
 	case core.LevelAdvanced:
 		return core.LevelExpert
 	case core.LevelExpert:
-		return core.LevelExpert
+		return core.LevelExpert // Already at max
 	default:
 		return core.LevelIntermediate
 	}
 }
+
+// parseHoursPerWeek extracts the number of hours from a commitment like "10 hours/week"
+func parseHoursPerWeek(commitment string) (float64, bool) {
+	fields := strings.Fields(commitment)
+	if len(fields) == 0 {
+		return 0, false
+	}
+
+	hours, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || hours <= 0 {
+		return 0, false
+	}
+
+	return hours, true
+}
+
+// extractIDNumber returns the number of an ID like "phase-001", or 1 when it
+// has none
+func extractIDNumber(id core.EntityID) int {
+	parts := strings.Split(string(id), "-")
+	if len(parts) >= 2 {
+		var result int
+		if _, err := fmt.Sscanf(parts[len(parts)-1], "%d", &result); err == nil {
+			return result
+		}
+	}
+	return 1
+}
@@ -1,9 +1,17 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/illenko/growth.md/internal/ai"
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
 )
 
 func TestGetNextLevel(t *testing.T) {
@@ -26,3 +34,318 @@ func TestGetNextLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestRecentFeedback(t *testing.T) {
+	repos := Repositories{}
+	var err error
+	if repos.Feedback, err = storage.NewFeedbackRepository(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	s := NewAIService(&storage.Config{}, repos, nil, nil)
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	old, _ := core.NewFeedback("feedback-001", "Alice", core.FeedbackPeer, now.AddDate(-2, 0, 0))
+	recent, _ := core.NewFeedback("feedback-002", "Bo", core.FeedbackManager, now.AddDate(0, -1, 0))
+	recent.AddSkill("skill-001")
+	recent.AddSkill("skill-009")
+	for _, f := range []*core.Feedback{old, recent} {
+		if err := repos.Feedback.Create(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	skills := []*core.Skill{{ID: "skill-001", Title: "Kubernetes"}}
+	entries := s.recentFeedback(skills, now)
+	if len(entries) != 1 || entries[0].ID != "feedback-002" {
+		t.Fatalf("entries = %v, want only feedback-002", entries)
+	}
+	// Deleted skills keep their ID
+	if want := []string{"Kubernetes", "skill-009"}; !reflect.DeepEqual(entries[0].SkillTitles, want) {
+		t.Errorf("skill titles = %v, want %v", entries[0].SkillTitles, want)
+	}
+
+	if entries := NewAIService(&storage.Config{}, Repositories{}, nil, nil).recentFeedback(skills, now); entries != nil {
+		t.Errorf("entries without a feedback repository = %v, want none", entries)
+	}
+}
+
+// newTestRepositories opens the repositories a path is saved to in a
+// temporary directory
+func newTestRepositories(t *testing.T) Repositories {
+	t.Helper()
+	dir := t.TempDir()
+	repos := Repositories{}
+	var err error
+	if repos.Skills, err = storage.NewSkillRepository(filepath.Join(dir, "skills")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Goals, err = storage.NewGoalRepository(filepath.Join(dir, "goals")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Paths, err = storage.NewPathRepository(filepath.Join(dir, "paths")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Phases, err = storage.NewPhaseRepository(filepath.Join(dir, "phases")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Resources, err = storage.NewResourceRepository(filepath.Join(dir, "resources")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Milestones, err = storage.NewMilestoneRepository(filepath.Join(dir, "milestones")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Progress, err = storage.NewProgressLogRepository(filepath.Join(dir, "progress")); err != nil {
+		t.Fatal(err)
+	}
+	if repos.Notes, err = storage.NewNoteRepository(filepath.Join(dir, "notes")); err != nil {
+		t.Fatal(err)
+	}
+	return repos
+}
+
+func TestSaveGeneratedPath(t *testing.T) {
+	repos := newTestRepositories(t)
+
+	goal, _ := core.NewGoal("goal-001", "Backend developer", core.PriorityHigh)
+	if err := repos.Goals.Create(goal); err != nil {
+		t.Fatal(err)
+	}
+
+	// IDs 1 to 4 of every type are taken
+	nextID := func(entityType string) (core.EntityID, error) {
+		return core.EntityID(entityType + "-005"), nil
+	}
+	s := NewAIService(&storage.Config{}, repos, NewLinkService(repos.Skills, repos.Resources), nextID)
+
+	resp := newTestPathResponse()
+	if err := s.SaveGeneratedPath(context.Background(), resp, "goal-001", "8 hours/week"); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := repos.Paths.GetByID("path-005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []core.EntityID{"phase-005", "phase-006"}; !reflect.DeepEqual(saved.Phases, want) {
+		t.Errorf("path phases = %v, want %v", saved.Phases, want)
+	}
+	if saved.HoursPerWeek != 8 {
+		t.Errorf("hours per week = %v, want 8", saved.HoursPerWeek)
+	}
+
+	phase, err := repos.Phases.GetByID("phase-005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phase.PathID != "path-005" || !reflect.DeepEqual(phase.Resources, []core.EntityID{"resource-005"}) || !reflect.DeepEqual(phase.Milestones, []core.EntityID{"milestone-005"}) {
+		t.Errorf("phase = path %s, resources %v, milestones %v", phase.PathID, phase.Resources, phase.Milestones)
+	}
+
+	milestone, err := repos.Milestones.GetByID("milestone-005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if milestone.ReferenceType != core.ReferencePath || milestone.ReferenceID != "path-005" {
+		t.Errorf("milestone references %s %s, want path path-005", milestone.ReferenceType, milestone.ReferenceID)
+	}
+
+	linked, err := repos.Goals.GetByID("goal-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(linked.LearningPaths, []core.EntityID{"path-005"}) {
+		t.Errorf("goal paths = %v, want [path-005]", linked.LearningPaths)
+	}
+}
+
+func TestSaveGeneratedPathRollback(t *testing.T) {
+	repos := newTestRepositories(t)
+	nextID := func(entityType string) (core.EntityID, error) {
+		return core.EntityID(entityType + "-005"), nil
+	}
+	s := NewAIService(&storage.Config{}, repos, NewLinkService(repos.Skills, repos.Resources), nextID)
+
+	// The milestone's new ID is taken, so saving fails after everything else
+	taken, _ := core.NewMilestone("milestone-005", "Taken", core.MilestonePathLevel, core.ReferenceGoal, "goal-001")
+	if err := repos.Milestones.Create(taken); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.SaveGeneratedPath(context.Background(), newTestPathResponse(), "goal-001", ""); err == nil {
+		t.Fatal("expected an error for the taken milestone ID")
+	}
+
+	for _, exists := range []func() (bool, error){
+		func() (bool, error) { return repos.Paths.Exists("path-005") },
+		func() (bool, error) { return repos.Phases.Exists("phase-005") },
+		func() (bool, error) { return repos.Phases.Exists("phase-006") },
+		func() (bool, error) { return repos.Resources.Exists("resource-005") },
+	} {
+		if ok, err := exists(); err != nil || ok {
+			t.Errorf("partly saved entity left behind (exists %v, err %v)", ok, err)
+		}
+	}
+	if ok, _ := repos.Milestones.Exists("milestone-005"); !ok {
+		t.Error("rollback deleted the milestone that already existed")
+	}
+
+	t.Run("saves nothing once the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if err := s.SaveGeneratedPath(ctx, newTestPathResponse(), "goal-001", ""); !errors.Is(err, context.Canceled) {
+			t.Fatalf("err = %v, want context.Canceled", err)
+		}
+		if ok, _ := repos.Paths.Exists("path-005"); ok {
+			t.Error("path left behind after cancellation")
+		}
+	})
+}
+
+func TestSkillPlan(t *testing.T) {
+	repos := newTestRepositories(t)
+	skill, _ := core.NewSkill("skill-001", "Kubernetes", "devops", core.LevelBeginner)
+	if err := repos.Skills.Create(skill); err != nil {
+		t.Fatal(err)
+	}
+	nextID := func(entityType string) (core.EntityID, error) {
+		return core.EntityID(entityType + "-005"), nil
+	}
+	s := NewAIService(&storage.Config{}, repos, NewLinkService(repos.Skills, repos.Resources), nextID)
+
+	req, err := s.BuildSkillPlanRequest(SkillPlanOptions{SkillID: "skill-001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Goal != nil || req.Skill.ID != "skill-001" || req.TargetLevel != core.LevelIntermediate {
+		t.Errorf("request = goal %v, skill %v, target %s, want skill-001 to intermediate without a goal", req.Goal, req.Skill, req.TargetLevel)
+	}
+	if _, err := s.BuildSkillPlanRequest(SkillPlanOptions{SkillID: "skill-001", TargetLevel: "guru"}); err == nil {
+		t.Error("expected an error for an invalid target level")
+	}
+
+	if err := s.SaveSkillPlan(context.Background(), newTestPathResponse(), "skill-001", "3 hours/week"); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := repos.Paths.GetByID("path-005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path.SkillID != "skill-001" {
+		t.Errorf("path skill = %q, want skill-001", path.SkillID)
+	}
+	resource, err := repos.Resources.GetByID("resource-005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resource.SkillID != "skill-001" {
+		t.Errorf("resource skill = %q, want skill-001", resource.SkillID)
+	}
+	linked, err := repos.Skills.GetByID("skill-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(linked.Resources, []core.EntityID{"resource-005"}) {
+		t.Errorf("skill resources = %v, want [resource-005]", linked.Resources)
+	}
+}
+
+func TestBuildPathAuditRequest(t *testing.T) {
+	repos := newTestRepositories(t)
+	s := NewAIService(&storage.Config{}, repos, NewLinkService(repos.Skills, repos.Resources), nil)
+
+	path, _ := core.NewLearningPath("path-001", "Go backend", core.PathTypeManual)
+	goal, _ := core.NewGoal("goal-001", "Backend engineer", core.PriorityHigh)
+	goal.AddLearningPath(path.ID)
+	// Created out of order, to check phases are numbered by their order
+	web, _ := core.NewPhase("phase-002", path.ID, "Web", 2)
+	basics, _ := core.NewPhase("phase-001", path.ID, "Basics", 1)
+	basics.AddResource("resource-001")
+	basics.AddResource("resource-404")
+	basics.AddMilestone("milestone-001")
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	finish, _ := core.NewMilestone("milestone-001", "Finish the tour", core.MilestonePathLevel, core.ReferencePath, path.ID)
+	for _, err := range []error{
+		repos.Paths.Create(path), repos.Goals.Create(goal), repos.Phases.Create(web), repos.Phases.Create(basics),
+		repos.Resources.Create(tour), repos.Milestones.Create(finish),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req, err := s.BuildPathAuditRequest("path-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Goal == nil || req.Goal.ID != "goal-001" || req.Skill != nil {
+		t.Errorf("request goal = %v, skill = %v, want goal-001 and no skill", req.Goal, req.Skill)
+	}
+	if len(req.Phases) != 2 || req.Phases[0].ID != "phase-001" || req.Phases[0].Number != 1 || req.Phases[1].Number != 2 {
+		t.Fatalf("phases = %+v, want phase-001 then phase-002 numbered 1 and 2", req.Phases)
+	}
+	if len(req.Phases[0].Resources) != 1 || req.Phases[0].Resources[0].ID != "resource-001" {
+		t.Errorf("resources = %v, want only resource-001", req.Phases[0].Resources)
+	}
+	if len(req.Phases[0].Milestones) != 1 {
+		t.Errorf("milestones = %v, want milestone-001", req.Phases[0].Milestones)
+	}
+
+	if _, err := s.BuildPathAuditRequest("path-404"); err == nil {
+		t.Error("expected an error for a missing path")
+	}
+}
+
+// newTestPathResponse returns a generated path with placeholder IDs: two
+// phases, the first with a resource and a milestone
+func newTestPathResponse() *ai.PathGenerationResponse {
+	path, _ := core.NewLearningPath("path-000", "Go path", core.PathTypeAIGenerated)
+	path.Phases = []core.EntityID{"phase-001", "phase-002"}
+	basics, _ := core.NewPhase("phase-001", "path-000", "Basics", 1)
+	basics.Resources = []core.EntityID{"resource-001"}
+	basics.Milestones = []core.EntityID{"milestone-001"}
+	web, _ := core.NewPhase("phase-002", "path-000", "Web", 2)
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	finish, _ := core.NewMilestone("milestone-001", "Finish the tour", core.MilestonePathLevel, core.ReferencePath, "path-000")
+
+	return &ai.PathGenerationResponse{
+		Path:       path,
+		Phases:     []*core.Phase{basics, web},
+		Resources:  []*core.Resource{tour},
+		Milestones: []*core.Milestone{finish},
+	}
+}
+
+func TestAnalyzeProgress(t *testing.T) {
+	repos := newTestRepositories(t)
+	until := time.Date(2025, 1, 13, 0, 0, 0, 0, time.Local)
+	for i, date := range []time.Time{until.AddDate(0, 0, -40), until.AddDate(0, 0, -3), until.AddDate(0, 0, 2)} {
+		log, _ := core.NewProgressLog(core.EntityID(fmt.Sprintf("progress-%03d", i+1)), date)
+		log.HoursInvested = 2
+		if err := repos.Progress.Create(log); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config := &storage.Config{}
+	config.AI.Provider = "mock"
+	s := NewAIService(config, repos, NewLinkService(repos.Skills, repos.Resources), nil)
+
+	// Overall progress, as the weekly digest asks for it
+	opts := ProgressAnalysisOptions{Days: 30, Until: until}
+	req, err := s.BuildAnalysisRequest(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(req.ProgressLogs) != 1 || req.ProgressLogs[0].ID != "progress-002" {
+		t.Errorf("logs = %v, want only progress-002", req.ProgressLogs)
+	}
+
+	resp, err := s.AnalyzeProgress(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.SuggestedFocus, []string{"Practice"}) {
+		t.Errorf("suggested focus = %v, want the mock's [Practice]", resp.SuggestedFocus)
+	}
+}
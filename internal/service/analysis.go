@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+// A progress analysis prompt holds at most this many logs, or this many
+// characters of log and note text, before the logs are summarized per month
+const (
+	maxAnalysisLogs  = 60
+	maxAnalysisChars = 30000
+)
+
+// RunAnalysis sends an analysis request to the client. A long history is
+// map-reduced so it stays within the provider's token limits: each month of
+// logs is summarized on its own, then one more request synthesizes the
+// monthly summaries, with each month standing in as a single log.
+func RunAnalysis(ctx context.Context, client ai.AIClient, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+	if fitsInOnePrompt(req) {
+		return client.AnalyzeProgress(ctx, req)
+	}
+
+	months := groupByMonth(req.ProgressLogs)
+	summaries := make([]*core.ProgressLog, 0, len(months))
+	for _, month := range months {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		monthReq := req
+		monthReq.ProgressLogs = month.logs
+		monthReq.Notes = notesInMonth(req.Notes, month.start)
+
+		resp, err := client.AnalyzeProgress(ctx, monthReq)
+		if err != nil {
+			return nil, fmt.Errorf("failed to summarize %s: %w", month.start.Format("January 2006"), err)
+		}
+		summaries = append(summaries, monthSummaryLog(month, resp))
+	}
+
+	synthesis := req
+	synthesis.ProgressLogs = summaries
+	synthesis.Notes = nil // already part of the monthly summaries
+	return client.AnalyzeProgress(ctx, synthesis)
+}
+
+func fitsInOnePrompt(req ai.ProgressAnalysisRequest) bool {
+	if len(req.ProgressLogs) > maxAnalysisLogs {
+		return false
+	}
+
+	chars := 0
+	for _, log := range req.ProgressLogs {
+		chars += len(log.Body)
+	}
+	for _, note := range req.Notes {
+		chars += len(note.Body)
+	}
+	return chars <= maxAnalysisChars
+}
+
+// logMonth is the progress logs of one calendar month
+type logMonth struct {
+	start time.Time
+	logs  []*core.ProgressLog
+}
+
+// groupByMonth splits logs, sorted by date, into calendar months in order
+func groupByMonth(logs []*core.ProgressLog) []logMonth {
+	var months []logMonth
+	for _, log := range logs {
+		start := monthStart(log.Date)
+		if len(months) == 0 || !months[len(months)-1].start.Equal(start) {
+			months = append(months, logMonth{start: start})
+		}
+		months[len(months)-1].logs = append(months[len(months)-1].logs, log)
+	}
+	return months
+}
+
+func notesInMonth(notes []*core.Note, start time.Time) []*core.Note {
+	var inMonth []*core.Note
+	for _, note := range notes {
+		if monthStart(note.Date).Equal(start) {
+			inMonth = append(inMonth, note)
+		}
+	}
+	return inMonth
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// monthSummaryLog turns the analysis of one month into a log dated at the
+// start of the month, with the month's total hours
+func monthSummaryLog(month logMonth, resp *ai.ProgressAnalysisResponse) *core.ProgressLog {
+	hours := 0.0
+	for _, log := range month.logs {
+		hours += log.HoursInvested
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Summary of %d logs in %s: %s", len(month.logs), month.start.Format("January 2006"), resp.Summary)
+	if len(resp.Insights) > 0 {
+		fmt.Fprintf(&body, " Insights: %s.", strings.Join(resp.Insights, "; "))
+	}
+	if len(resp.SuggestedFocus) > 0 {
+		fmt.Fprintf(&body, " Focus: %s.", strings.Join(resp.SuggestedFocus, "; "))
+	}
+
+	return &core.ProgressLog{
+		Date:          month.start,
+		HoursInvested: hours,
+		Body:          body.String(),
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/ai"
+	"github.com/illenko/growth.md/internal/core"
+)
+
+func newTestLogs(start time.Time, count int) []*core.ProgressLog {
+	logs := make([]*core.ProgressLog, count)
+	for i := range logs {
+		logs[i] = &core.ProgressLog{
+			ID:            core.EntityID(fmt.Sprintf("progress-%03d", i+1)),
+			Date:          start.AddDate(0, 0, i),
+			HoursInvested: 1,
+			Body:          "Worked on Go",
+		}
+	}
+	return logs
+}
+
+func TestRunAnalysisSinglePrompt(t *testing.T) {
+	var calls int
+	client := &ai.MockClient{
+		AnalyzeProgressFunc: func(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+			calls++
+			if len(req.ProgressLogs) != 10 {
+				t.Errorf("got %d logs, want 10", len(req.ProgressLogs))
+			}
+			return &ai.ProgressAnalysisResponse{Summary: "done"}, nil
+		},
+	}
+
+	req := ai.ProgressAnalysisRequest{ProgressLogs: newTestLogs(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 10)}
+	resp, err := RunAnalysis(context.Background(), client, req)
+	if err != nil {
+		t.Fatalf("RunAnalysis: %v", err)
+	}
+	if calls != 1 || resp.Summary != "done" {
+		t.Errorf("got %d calls and summary %q, want 1 call and %q", calls, resp.Summary, "done")
+	}
+}
+
+func TestRunAnalysisByMonth(t *testing.T) {
+	var requests []ai.ProgressAnalysisRequest
+	client := &ai.MockClient{
+		AnalyzeProgressFunc: func(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+			requests = append(requests, req)
+			return &ai.ProgressAnalysisResponse{
+				Summary:  fmt.Sprintf("summary %d", len(requests)),
+				Insights: []string{"steady"},
+			}, nil
+		},
+	}
+
+	// January 1 to March 11: 31 + 28 + 11 logs
+	logs := newTestLogs(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 70)
+	notes := []*core.Note{
+		{ID: "note-001", Date: time.Date(2025, 2, 14, 0, 0, 0, 0, time.UTC), Body: "February note"},
+	}
+	req := ai.ProgressAnalysisRequest{ProgressLogs: logs, Notes: notes}
+
+	resp, err := RunAnalysis(context.Background(), client, req)
+	if err != nil {
+		t.Fatalf("RunAnalysis: %v", err)
+	}
+	if resp.Summary != "summary 4" {
+		t.Errorf("got summary %q, want the synthesis %q", resp.Summary, "summary 4")
+	}
+	if len(requests) != 4 {
+		t.Fatalf("got %d requests, want 3 months and a synthesis", len(requests))
+	}
+
+	for i, want := range []int{31, 28, 11} {
+		if got := len(requests[i].ProgressLogs); got != want {
+			t.Errorf("month %d: got %d logs, want %d", i+1, got, want)
+		}
+	}
+	if len(requests[0].Notes) != 0 || len(requests[1].Notes) != 1 {
+		t.Errorf("the February note should only go with February, got %d and %d notes", len(requests[0].Notes), len(requests[1].Notes))
+	}
+
+	synthesis := requests[3]
+	if len(synthesis.ProgressLogs) != 3 || synthesis.Notes != nil {
+		t.Fatalf("synthesis got %d logs and %d notes, want 3 and none", len(synthesis.ProgressLogs), len(synthesis.Notes))
+	}
+	february := synthesis.ProgressLogs[1]
+	if !february.Date.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) || february.HoursInvested != 28 {
+		t.Errorf("got February summary dated %s with %v hours", february.Date, february.HoursInvested)
+	}
+	if !strings.Contains(february.Body, "28 logs in February 2025: summary 2") || !strings.Contains(february.Body, "steady") {
+		t.Errorf("got February summary %q", february.Body)
+	}
+}
+
+func TestRunAnalysisLongLogs(t *testing.T) {
+	var calls int
+	client := &ai.MockClient{
+		AnalyzeProgressFunc: func(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+			calls++
+			return &ai.ProgressAnalysisResponse{Summary: "ok"}, nil
+		},
+	}
+
+	logs := newTestLogs(time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), 20)
+	for _, log := range logs {
+		log.Body = strings.Repeat("a", maxAnalysisChars/10)
+	}
+
+	if _, err := RunAnalysis(context.Background(), client, ai.ProgressAnalysisRequest{ProgressLogs: logs}); err != nil {
+		t.Fatalf("RunAnalysis: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls, want January, February and a synthesis", calls)
+	}
+}
+
+func TestRunAnalysisMonthError(t *testing.T) {
+	client := &ai.MockClient{
+		AnalyzeProgressFunc: func(ctx context.Context, req ai.ProgressAnalysisRequest) (*ai.ProgressAnalysisResponse, error) {
+			return nil, fmt.Errorf("quota exceeded")
+		},
+	}
+
+	logs := newTestLogs(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 70)
+	_, err := RunAnalysis(context.Background(), client, ai.ProgressAnalysisRequest{ProgressLogs: logs})
+	if err == nil || !strings.Contains(err.Error(), "January 2025") {
+		t.Errorf("got error %v, want one naming January 2025", err)
+	}
+}
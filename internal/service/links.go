@@ -0,0 +1,160 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
+)
+
+// LinkService keeps the links between skills and resources consistent in
+// both directions: a resource names its skill in Resource.SkillID, and the
+// skill lists the resource in Skill.Resources. Resources created, moved or
+// deleted through the service update their skills as well.
+type LinkService struct {
+	skillRepo    *storage.SkillRepository
+	resourceRepo *storage.ResourceRepository
+}
+
+func NewLinkService(skillRepo *storage.SkillRepository, resourceRepo *storage.ResourceRepository) *LinkService {
+	return &LinkService{
+		skillRepo:    skillRepo,
+		resourceRepo: resourceRepo,
+	}
+}
+
+// SkillLinkFix is a change to the resources a skill lists, to match the
+// skills its resources name
+type SkillLinkFix struct {
+	SkillID core.EntityID
+	Added   []core.EntityID // resources that name the skill but are not listed
+	Removed []core.EntityID // listed resources that are missing or name another skill
+}
+
+// CreateResource saves a new resource and lists it on its skill
+func (s *LinkService) CreateResource(resource *core.Resource) error {
+	if err := s.resourceRepo.Create(resource); err != nil {
+		return err
+	}
+	return s.LinkResource(resource.ID, resource.SkillID)
+}
+
+// UpdateResource saves a resource and, when its skill changed, moves it to
+// the new skill's resources
+func (s *LinkService) UpdateResource(resource *core.Resource) error {
+	if err := s.resourceRepo.Update(resource); err != nil {
+		return err
+	}
+	return s.LinkResource(resource.ID, resource.SkillID)
+}
+
+// DeleteResource deletes a resource and removes it from the skills that
+// list it
+func (s *LinkService) DeleteResource(id core.EntityID) error {
+	if err := s.resourceRepo.Delete(id); err != nil {
+		return err
+	}
+	return s.LinkResource(id, "")
+}
+
+// LinkResource lists a resource on the skill with skillID and on no other
+// skill. An empty skillID removes it from every skill.
+func (s *LinkService) LinkResource(resourceID, skillID core.EntityID) error {
+	skills, err := s.skillRepo.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve skills: %w", err)
+	}
+
+	for _, skill := range skills {
+		listed := slices.Contains(skill.Resources, resourceID)
+		if listed == (skill.ID == skillID) {
+			continue
+		}
+
+		fix := SkillLinkFix{SkillID: skill.ID}
+		if listed {
+			fix.Removed = []core.EntityID{resourceID}
+		} else {
+			fix.Added = []core.EntityID{resourceID}
+		}
+		if err := s.applyFix(fix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckSkillLinks returns the changes that make the resources each skill
+// lists match the skills the resources name, without saving them
+func (s *LinkService) CheckSkillLinks() ([]SkillLinkFix, error) {
+	skills, err := s.skillRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve skills: %w", err)
+	}
+	resources, err := s.resourceRepo.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve resources: %w", err)
+	}
+	return planSkillLinkFixes(skills, resources), nil
+}
+
+// RepairSkillLinks applies the changes found by CheckSkillLinks and returns
+// them
+func (s *LinkService) RepairSkillLinks() ([]SkillLinkFix, error) {
+	fixes, err := s.CheckSkillLinks()
+	if err != nil {
+		return nil, err
+	}
+	for _, fix := range fixes {
+		if err := s.applyFix(fix); err != nil {
+			return nil, err
+		}
+	}
+	return fixes, nil
+}
+
+func (s *LinkService) applyFix(fix SkillLinkFix) error {
+	skill, err := s.skillRepo.GetByIDWithBody(fix.SkillID)
+	if err != nil {
+		return fmt.Errorf("failed to load skill %s: %w", fix.SkillID, err)
+	}
+	for _, id := range fix.Removed {
+		skill.RemoveResource(id)
+	}
+	for _, id := range fix.Added {
+		skill.AddResource(id)
+	}
+	if err := s.skillRepo.Update(skill); err != nil {
+		return fmt.Errorf("failed to update skill %s: %w", skill.ID, err)
+	}
+	return nil
+}
+
+// planSkillLinkFixes compares the resources each skill lists with the
+// resources that name it. Skills that already match are left out.
+func planSkillLinkFixes(skills []*core.Skill, resources []*core.Resource) []SkillLinkFix {
+	owners := make(map[core.EntityID]core.EntityID, len(resources))
+	for _, resource := range resources {
+		owners[resource.ID] = resource.SkillID
+	}
+
+	var fixes []SkillLinkFix
+	for _, skill := range skills {
+		fix := SkillLinkFix{SkillID: skill.ID}
+		for _, id := range skill.Resources {
+			if owner, ok := owners[id]; !ok || owner != skill.ID {
+				fix.Removed = append(fix.Removed, id)
+			}
+		}
+		for _, resource := range resources {
+			if resource.SkillID == skill.ID && !slices.Contains(skill.Resources, resource.ID) {
+				fix.Added = append(fix.Added, resource.ID)
+			}
+		}
+		if len(fix.Added) > 0 || len(fix.Removed) > 0 {
+			fixes = append(fixes, fix)
+		}
+	}
+	return fixes
+}
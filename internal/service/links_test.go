@@ -0,0 +1,130 @@
+package service
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
+)
+
+func newTestLinkService(t *testing.T) *LinkService {
+	t.Helper()
+	dir := t.TempDir()
+	skillRepo, err := storage.NewSkillRepository(filepath.Join(dir, "skills"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceRepo, err := storage.NewResourceRepository(filepath.Join(dir, "resources"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewLinkService(skillRepo, resourceRepo)
+}
+
+func skillResources(t *testing.T, s *LinkService, id core.EntityID) []core.EntityID {
+	t.Helper()
+	skill, err := s.skillRepo.GetByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return skill.Resources
+}
+
+func TestLinkServiceMaintainsSkillResources(t *testing.T) {
+	s := newTestLinkService(t)
+
+	golang, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelBeginner)
+	golang.Body = "Notes on Go"
+	rust, _ := core.NewSkill("skill-002", "Rust", "programming", core.LevelBeginner)
+	for _, skill := range []*core.Skill{golang, rust} {
+		if err := s.skillRepo.Create(skill); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	if err := s.CreateResource(tour); err != nil {
+		t.Fatal(err)
+	}
+	if got := skillResources(t, s, "skill-001"); !reflect.DeepEqual(got, []core.EntityID{"resource-001"}) {
+		t.Errorf("after create, skill-001 resources = %v", got)
+	}
+
+	tour.SkillID = "skill-002"
+	if err := s.UpdateResource(tour); err != nil {
+		t.Fatal(err)
+	}
+	if got := skillResources(t, s, "skill-001"); len(got) != 0 {
+		t.Errorf("after move, skill-001 resources = %v, want none", got)
+	}
+	if got := skillResources(t, s, "skill-002"); !reflect.DeepEqual(got, []core.EntityID{"resource-001"}) {
+		t.Errorf("after move, skill-002 resources = %v", got)
+	}
+
+	if err := s.DeleteResource("resource-001"); err != nil {
+		t.Fatal(err)
+	}
+	if got := skillResources(t, s, "skill-002"); len(got) != 0 {
+		t.Errorf("after delete, skill-002 resources = %v, want none", got)
+	}
+
+	withBody, err := s.skillRepo.GetByIDWithBody("skill-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withBody.Body != "Notes on Go" {
+		t.Errorf("skill body = %q, want it kept", withBody.Body)
+	}
+}
+
+func TestRepairSkillLinks(t *testing.T) {
+	s := newTestLinkService(t)
+
+	golang, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelBeginner)
+	golang.Resources = []core.EntityID{"resource-002", "resource-009"}
+	rust, _ := core.NewSkill("skill-002", "Rust", "programming", core.LevelBeginner)
+	rust.Resources = []core.EntityID{"resource-002"}
+	for _, skill := range []*core.Skill{golang, rust} {
+		if err := s.skillRepo.Create(skill); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tour, _ := core.NewResource("resource-001", "A Tour of Go", core.ResourceCourse, "skill-001")
+	book, _ := core.NewResource("resource-002", "The Rust Book", core.ResourceBook, "skill-002")
+	for _, resource := range []*core.Resource{tour, book} {
+		if err := s.resourceRepo.Create(resource); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fixes, err := s.CheckSkillLinks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []SkillLinkFix{{
+		SkillID: "skill-001",
+		Added:   []core.EntityID{"resource-001"},
+		Removed: []core.EntityID{"resource-002", "resource-009"},
+	}}
+	if !reflect.DeepEqual(fixes, want) {
+		t.Fatalf("CheckSkillLinks() = %+v, want %+v", fixes, want)
+	}
+
+	if _, err := s.RepairSkillLinks(); err != nil {
+		t.Fatal(err)
+	}
+	if got := skillResources(t, s, "skill-001"); !reflect.DeepEqual(got, []core.EntityID{"resource-001"}) {
+		t.Errorf("after repair, skill-001 resources = %v", got)
+	}
+
+	fixes, err = s.CheckSkillLinks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixes) != 0 {
+		t.Errorf("after repair, CheckSkillLinks() = %+v, want none", fixes)
+	}
+}
@@ -0,0 +1,27 @@
+package service
+
+import "errors"
+
+// Rollback collects the steps that undo a multi-entity write, so a save that
+// fails or is interrupted halfway can remove what it already created
+type Rollback struct {
+	steps []func() error
+}
+
+// Add records a step that undoes the last write
+func (r *Rollback) Add(step func() error) {
+	r.steps = append(r.steps, step)
+}
+
+// Undo runs the recorded steps, newest first, and forgets them. It runs
+// every step even when some fail and returns their errors joined.
+func (r *Rollback) Undo() error {
+	var errs []error
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		if err := r.steps[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	r.steps = nil
+	return errors.Join(errs...)
+}
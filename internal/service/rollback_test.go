@@ -0,0 +1,33 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRollbackUndo(t *testing.T) {
+	var rollback Rollback
+	var undone []int
+	for i := 1; i <= 3; i++ {
+		rollback.Add(func() error {
+			undone = append(undone, i)
+			if i == 2 {
+				return errors.New("step 2 failed")
+			}
+			return nil
+		})
+	}
+
+	err := rollback.Undo()
+	if err == nil || err.Error() != "step 2 failed" {
+		t.Errorf("Undo() error = %v, want step 2 failed", err)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(undone, want) {
+		t.Errorf("undone = %v, want %v", undone, want)
+	}
+
+	if err := rollback.Undo(); err != nil || len(undone) != 3 {
+		t.Errorf("second Undo() ran steps again (error %v)", err)
+	}
+}
@@ -0,0 +1,26 @@
+// Package service holds the operations that span several repositories, such
+// as generating and saving a learning path, so the CLI and other frontends
+// share one implementation.
+package service
+
+import (
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/storage"
+)
+
+// Repositories are the entity repositories the services read and write
+type Repositories struct {
+	Skills     *storage.SkillRepository
+	Goals      *storage.GoalRepository
+	Paths      *storage.PathRepository
+	Phases     *storage.PhaseRepository
+	Resources  *storage.ResourceRepository
+	Milestones *storage.MilestoneRepository
+	Progress   *storage.ProgressLogRepository
+	Notes      *storage.NoteRepository
+	Feedback   *storage.FeedbackRepository
+}
+
+// IDGenerator returns the next free ID for an entity type, such as "path"
+// or "resource"
+type IDGenerator func(entityType string) (core.EntityID, error)
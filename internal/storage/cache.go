@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+
+	"github.com/illenko/growth.md/internal/version"
+)
+
+// entityCache stores parsed entity metadata between runs so GetAll only
+// parses files whose size or modification time changed. The cache is derived
+// data: it is rebuilt from the markdown files whenever it is missing, stale or
+// unreadable, and writes through the repository are picked up by their new
+// modification times.
+//
+// Entities with private content are never cached, so nothing decrypted is
+// written to disk outside age encryption.
+type entityCache[T any] struct {
+	path string
+}
+
+type cacheFile[T any] struct {
+	Build   string
+	Entries map[string]cacheEntry[T] // by file name
+}
+
+type cacheEntry[T any] struct {
+	ModTime int64
+	Size    int64
+	Entity  T
+}
+
+// cacheBuild identifies the binary and privacy keys that wrote a cache.
+// Entities cached by a different build are parsed again in case their fields
+// changed meaning, and by different keys in case fields became private.
+func cacheBuild(keys string) string {
+	info := version.Get()
+	return info.Version + "+" + info.Commit + "/" + keys
+}
+
+// load returns the cached entries, or an empty set if the cache cannot be used
+func (c *entityCache[T]) load(keys string) map[string]cacheEntry[T] {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return map[string]cacheEntry[T]{}
+	}
+
+	var file cacheFile[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&file); err != nil || file.Build != cacheBuild(keys) || file.Entries == nil {
+		return map[string]cacheEntry[T]{}
+	}
+
+	return file.Entries
+}
+
+// save replaces the cache atomically so concurrent runs never read a partial file.
+// Failures are ignored: the next run simply parses the files again.
+func (c *entityCache[T]) save(entries map[string]cacheEntry[T], keys string) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cacheFile[T]{Build: cacheBuild(keys), Entries: entries}); err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(buf.Bytes())
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), c.path) != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// iterateCached is Iterate backed by the entity cache. The cache is only
+// rewritten when every file was visited.
+func (r *FilesystemRepository[T, P]) iterateCached(matches []string, fn func(entity *T) bool) {
+	keys := r.privacy.keyFingerprint()
+	cached := r.cache.load(keys)
+	fresh := make(map[string]cacheEntry[T], len(matches))
+	changed := false
+
+	for _, filePath := range matches {
+		info, err := os.Stat(filePath)
+		if err != nil {
+			continue
+		}
+		name := filepath.Base(filePath)
+
+		entry, ok := cached[name]
+		if !ok || entry.ModTime != info.ModTime().UnixNano() || entry.Size != info.Size() {
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				continue
+			}
+			entity, err := r.parseEntity(content, false)
+			if err != nil {
+				continue
+			}
+			// Private content is parsed on every run rather than cached decrypted
+			if hasSealedContent(content) {
+				if !fn(entity) {
+					return
+				}
+				continue
+			}
+			entry = cacheEntry[T]{ModTime: info.ModTime().UnixNano(), Size: info.Size(), Entity: *entity}
+			changed = true
+		}
+
+		fresh[name] = entry
+		entity := entry.Entity
+		if !fn(&entity) {
+			return
+		}
+	}
+
+	if changed || len(fresh) != len(cached) {
+		r.cache.save(fresh, keys)
+	}
+}
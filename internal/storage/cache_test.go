@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedSkillRepo(t *testing.T) (*FilesystemRepository[core.Skill, *core.Skill], string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	repo, err := NewFilesystemRepository[core.Skill](filepath.Join(tmpDir, "skills"), "skill")
+	require.NoError(t, err)
+	cacheDir := filepath.Join(tmpDir, "cache")
+	repo.SetCacheDir(cacheDir)
+	return repo, cacheDir
+}
+
+func skillTitles(skills []*core.Skill) []string {
+	titles := make([]string, 0, len(skills))
+	for _, s := range skills {
+		titles = append(titles, s.Title)
+	}
+	sort.Strings(titles)
+	return titles
+}
+
+func TestFilesystemRepository_GetAllCached(t *testing.T) {
+	t.Run("writes the cache and reads from it", func(t *testing.T) {
+		repo, cacheDir := newCachedSkillRepo(t)
+		python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+		goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelBeginner)
+		require.NoError(t, repo.Create(python))
+		require.NoError(t, repo.Create(goSkill))
+
+		skills, err := repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Python"}, skillTitles(skills))
+		assert.FileExists(t, filepath.Join(cacheDir, "skill.gob"))
+
+		skills, err = repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Python"}, skillTitles(skills))
+		assert.Equal(t, core.LevelIntermediate, skills[0].Level)
+		assert.Equal(t, core.LevelBeginner, skills[1].Level)
+	})
+
+	t.Run("picks up updates, deletions and external edits", func(t *testing.T) {
+		repo, _ := newCachedSkillRepo(t)
+		python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+		goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelBeginner)
+		require.NoError(t, repo.Create(python))
+		require.NoError(t, repo.Create(goSkill))
+		_, err := repo.GetAll()
+		require.NoError(t, err)
+
+		python.Level = core.LevelExpert
+		require.NoError(t, repo.Update(python))
+		require.NoError(t, repo.Delete("skill-002"))
+
+		rust, _ := core.NewSkill("skill-003", "Rust", "programming", core.LevelBeginner)
+		content, err := SerializeFrontmatter(rust, "")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(repo.basePath, "skill-003-rust.md"), content, 0644))
+
+		skills, err := repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Python", "Rust"}, skillTitles(skills))
+		for _, s := range skills {
+			if s.ID == "skill-001" {
+				assert.Equal(t, core.LevelExpert, s.Level)
+			}
+		}
+	})
+
+	t.Run("ignores a corrupt cache", func(t *testing.T) {
+		repo, cacheDir := newCachedSkillRepo(t)
+		python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+		require.NoError(t, repo.Create(python))
+
+		require.NoError(t, os.MkdirAll(cacheDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(cacheDir, "skill.gob"), []byte("not a cache"), 0644))
+
+		skills, err := repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Python"}, skillTitles(skills))
+	})
+
+	t.Run("returns independent copies", func(t *testing.T) {
+		repo, _ := newCachedSkillRepo(t)
+		python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+		require.NoError(t, repo.Create(python))
+
+		skills, err := repo.GetAll()
+		require.NoError(t, err)
+		skills[0].Title = "Changed"
+
+		skills, err = repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, "Python", skills[0].Title)
+	})
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadCategories reads the managed category list from path. A missing file
+// is an empty, non-strict list.
+func LoadCategories(path string) (*core.CategoryList, error) {
+	if path == "" {
+		return nil, errors.New("categories path cannot be empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &core.CategoryList{}, nil
+		}
+		return nil, err
+	}
+
+	var list core.CategoryList
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse categories: %w", err)
+	}
+
+	return &list, nil
+}
+
+// SaveCategories writes the managed category list to path
+func SaveCategories(list *core.CategoryList, path string) error {
+	if list == nil {
+		return errors.New("category list cannot be nil")
+	}
+
+	if path == "" {
+		return errors.New("categories path cannot be empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoriesRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".growth", "categories.yml")
+
+	t.Run("missing file is an empty list", func(t *testing.T) {
+		list, err := LoadCategories(path)
+		require.NoError(t, err)
+
+		assert.False(t, list.Strict)
+		assert.Empty(t, list.Categories)
+	})
+
+	t.Run("saves and loads", func(t *testing.T) {
+		original := &core.CategoryList{Strict: true, Categories: []string{"backend", "devops"}}
+
+		require.NoError(t, SaveCategories(original, path))
+
+		loaded, err := LoadCategories(path)
+		require.NoError(t, err)
+		assert.Equal(t, original, loaded)
+	})
+
+	t.Run("rejects nil list", func(t *testing.T) {
+		assert.Error(t, SaveCategories(nil, path))
+	})
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// CommitMessage is what a commit message template is filled from, as in
+// "{{.Action}} {{.EntityType}}: {{.Title}}"
+type CommitMessage struct {
+	Operation  string // create, update, delete or sync
+	Action     string // Add, Update, Delete or Sync, see ActionForOperation
+	EntityType string // e.g. "skill"; "entities" when a commit spans several types
+	ID         string // empty when the commit spans several entities
+	Title      string
+	Count      int // entities in the commit
+}
+
+// ActionForOperation returns the verb commit messages use for an operation
+func ActionForOperation(operation string) string {
+	switch operation {
+	case "create":
+		return "Add"
+	case "update":
+		return "Update"
+	case "delete":
+		return "Delete"
+	case "sync":
+		return "Sync"
+	default:
+		return "Modify"
+	}
+}
+
+// legacyPlaceholders maps the placeholders of early templates to fields
+var legacyPlaceholders = strings.NewReplacer(
+	"{{operation}}", "{{.Operation}}",
+	"{{entityType}}", "{{.EntityType}}",
+	"{{id}}", "{{.ID}}",
+	"{{title}}", "{{.Title}}",
+)
+
+// ParseCommitTemplate parses a commit message template
+func ParseCommitTemplate(text string) (*template.Template, error) {
+	return template.New("commit").Option("missingkey=error").Parse(legacyPlaceholders.Replace(text))
+}
+
+// RenderCommitMessage fills a commit message template. An empty template
+// gives "Add skill: Python (skill-001)".
+func RenderCommitMessage(text string, msg CommitMessage) (string, error) {
+	if msg.Action == "" {
+		msg.Action = ActionForOperation(msg.Operation)
+	}
+
+	if strings.TrimSpace(text) == "" {
+		if msg.ID == "" {
+			return fmt.Sprintf("%s %s: %s", msg.Action, msg.EntityType, msg.Title), nil
+		}
+		return fmt.Sprintf("%s %s: %s (%s)", msg.Action, msg.EntityType, msg.Title, msg.ID), nil
+	}
+
+	tmpl, err := ParseCommitTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, msg); err != nil {
+		return "", err
+	}
+
+	rendered := strings.TrimSpace(b.String())
+	if rendered == "" {
+		return "", fmt.Errorf("commit message template %q renders an empty message", text)
+	}
+	return rendered, nil
+}
+
+// CommitMessage renders the configured commit message template, falling back
+// to the default format when the template is broken so a commit never fails
+// over its message
+func (g GitConfig) CommitMessage(msg CommitMessage) string {
+	if rendered, err := RenderCommitMessage(g.CommitMessageTemplate, msg); err == nil {
+		return rendered
+	}
+
+	rendered, _ := RenderCommitMessage("", msg)
+	return rendered
+}
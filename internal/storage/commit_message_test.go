@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCommitMessage(t *testing.T) {
+	msg := CommitMessage{Operation: "create", EntityType: "skill", ID: "skill-001", Title: "Python", Count: 1}
+
+	t.Run("fills the template", func(t *testing.T) {
+		rendered, err := RenderCommitMessage("{{.Action}} {{.EntityType}}: {{.Title}}", msg)
+		require.NoError(t, err)
+		assert.Equal(t, "Add skill: Python", rendered)
+	})
+
+	t.Run("supports template logic", func(t *testing.T) {
+		rendered, err := RenderCommitMessage("growth: {{.Action}} {{.Title}}{{if .ID}} [{{.ID}}]{{end}}", msg)
+		require.NoError(t, err)
+		assert.Equal(t, "growth: Add Python [skill-001]", rendered)
+	})
+
+	t.Run("supports legacy placeholders", func(t *testing.T) {
+		rendered, err := RenderCommitMessage("{{operation}} {{entityType}} {{id}}: {{title}}", msg)
+		require.NoError(t, err)
+		assert.Equal(t, "create skill skill-001: Python", rendered)
+	})
+
+	t.Run("uses the default format without a template", func(t *testing.T) {
+		rendered, err := RenderCommitMessage("", msg)
+		require.NoError(t, err)
+		assert.Equal(t, "Add skill: Python (skill-001)", rendered)
+	})
+
+	t.Run("fails for broken templates", func(t *testing.T) {
+		_, err := RenderCommitMessage("{{.Action", msg)
+		assert.Error(t, err)
+
+		_, err = RenderCommitMessage("{{.Author}}", msg)
+		assert.Error(t, err)
+
+		_, err = RenderCommitMessage("{{if false}}x{{end}}", msg)
+		assert.Error(t, err)
+	})
+
+	t.Run("falls back to the default format from the config", func(t *testing.T) {
+		gitConfig := GitConfig{CommitMessageTemplate: "{{.Author}}"}
+		assert.Equal(t, "Add skill: Python (skill-001)", gitConfig.CommitMessage(msg))
+
+		gitConfig.CommitMessageTemplate = "{{.Action}} {{.Count}} {{.EntityType}}"
+		assert.Equal(t, "Sync 3 skill", gitConfig.CommitMessage(CommitMessage{Operation: "sync", EntityType: "skill", Count: 3}))
+	})
+}
+
+func TestConfigValidatesCommitTemplate(t *testing.T) {
+	config := DefaultConfig()
+	config.Git.CommitMessageTemplate = "{{.Action"
+
+	problems := config.Problems()
+	fields := make([]string, len(problems))
+	for i, problem := range problems {
+		fields[i] = problem.Field
+	}
+	assert.Contains(t, fields, "git.commitMessageTemplate")
+}
@@ -2,9 +2,20 @@ package storage
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/git"
+	"github.com/illenko/growth.md/internal/i18n"
+	"github.com/illenko/growth.md/internal/version"
 	"gopkg.in/yaml.v3"
 )
 
@@ -16,38 +27,157 @@ type Config struct {
 	Progress ProgressConfig `yaml:"progress"`
 	Display  DisplayConfig  `yaml:"display"`
 	MCP      MCPConfig      `yaml:"mcp"`
+	Email    EmailConfig    `yaml:"email,omitempty"`
+	Storage  StorageConfig  `yaml:"storage,omitempty"`
+	Calendar CalendarConfig `yaml:"calendar,omitempty"`
+	Privacy  PrivacyConfig  `yaml:"privacy,omitempty"`
+	Tasks    TasksConfig    `yaml:"tasks,omitempty"`
+	Jira     JiraConfig     `yaml:"jira,omitempty"`
+	Time     TimeConfig     `yaml:"time,omitempty"`
+
+	// Aliases maps a short command name to the command line it expands to,
+	// e.g. pl: progress log --hours
+	Aliases map[string]string `yaml:"aliases,omitempty"`
 }
 
 type UserConfig struct {
 	Name  string `yaml:"name"`
 	Email string `yaml:"email,omitempty"`
+
+	// Language is the ISO 639-1 code (e.g. uk, de) of the language for AI
+	// generated content and CLI messages, English when empty
+	Language string `yaml:"language,omitempty"`
 }
 
 type AIConfig struct {
-	Provider      string  `yaml:"provider"`         // gemini, openai, anthropic, local
+	Provider      string  `yaml:"provider"`         // gemini, openai, anthropic, local, mock
 	Model         string  `yaml:"model"`            // model name (uses provider default if empty)
 	APIKey        string  `yaml:"apiKey,omitempty"` // optional, prefers env var
 	Temperature   float32 `yaml:"temperature"`      // 0.0 - 1.0, controls randomness
 	MaxTokens     int     `yaml:"maxTokens"`        // max output tokens
 	DefaultStyle  string  `yaml:"defaultStyle"`     // learning style preference
 	DefaultBudget string  `yaml:"defaultBudget"`    // resource budget preference
+
+	// FallbackProviders are tried in order when the provider fails during
+	// generation, e.g. because it is rate limited
+	FallbackProviders []string `yaml:"fallbackProviders,omitempty"`
+
+	// StagedGeneration generates paths with a planning request and one
+	// request per phase, so large paths are not cut off at the output token
+	// limit. Off by default, as it sends a request per phase more.
+	StagedGeneration bool `yaml:"stagedGeneration,omitempty"`
+
+	// ParallelRequests limits how many phases of a generated path are
+	// detailed at once with stagedGeneration; 0 uses the default of 3
+	ParallelRequests int `yaml:"parallelRequests,omitempty"`
+
+	// Subscriptions lists platforms you already pay for (e.g. oreilly,
+	// pluralsight); resource suggestions prefer content available on them
+	Subscriptions []string `yaml:"subscriptions,omitempty"`
+	// LearningBudget is the yearly amount for paid resources, 0 for no limit.
+	// What is left after this year's resource costs goes into suggestions.
+	LearningBudget float64 `yaml:"learningBudget,omitempty"`
 }
 
 type GitConfig struct {
 	AutoCommit            bool   `yaml:"autoCommit"`
 	CommitOnUpdate        bool   `yaml:"commitOnUpdate"`
 	CommitMessageTemplate string `yaml:"commitMessageTemplate"`
+
+	// Signing signs commits with "gpg" or "ssh" keys, which needs git
+	// installed; empty leaves them unsigned
+	Signing string `yaml:"signing,omitempty"`
+	// SigningKey is the GPG key ID or SSH key file to sign with, git's
+	// user.signingkey when empty
+	SigningKey string `yaml:"signingKey,omitempty"`
 }
 
 type ProgressConfig struct {
 	DefaultView  string `yaml:"defaultView"`
 	WeekStartDay string `yaml:"weekStartDay"`
+
+	// ManualSkillStatus stops progress logs from moving the not-started
+	// skills they mention to learning
+	ManualSkillStatus bool `yaml:"manualSkillStatus,omitempty"`
+
+	// IgnoreEstimateBias stops path schedules and projections from scaling
+	// estimates by the bias factor of 'growth stats calibration'
+	IgnoreEstimateBias bool `yaml:"ignoreEstimateBias,omitempty"`
 }
 
 type DisplayConfig struct {
 	OutputFormat string `yaml:"outputFormat"`
 	Theme        string `yaml:"theme"`
 	DateFormat   string `yaml:"dateFormat"`
+
+	// RelativeDates adds how far away a date is, such as "in 12 days", next
+	// to dates in table output
+	RelativeDates bool `yaml:"relativeDates,omitempty"`
+}
+
+// EmailConfig holds SMTP settings for sending digests.
+// The password is read from the GROWTH_SMTP_PASSWORD environment variable.
+type EmailConfig struct {
+	SMTPHost string   `yaml:"smtpHost,omitempty"`
+	SMTPPort int      `yaml:"smtpPort,omitempty"` // defaults to 587
+	Username string   `yaml:"username,omitempty"`
+	From     string   `yaml:"from,omitempty"`
+	To       []string `yaml:"to,omitempty"`
+}
+
+// CalendarConfig holds settings for planning study time blocks with
+// growth plan week. The Google OAuth client secret is read from the
+// GROWTH_GOOGLE_CLIENT_SECRET environment variable.
+type CalendarConfig struct {
+	ClientID   string   `yaml:"clientId,omitempty"`   // Google OAuth client ID
+	CalendarID string   `yaml:"calendarId,omitempty"` // defaults to primary
+	StudyDays  []string `yaml:"studyDays,omitempty"`  // defaults to monday through friday
+	StartTime  string   `yaml:"startTime,omitempty"`  // HH:MM of the first block each day, defaults to 19:00
+}
+
+// StorageConfig selects where entities are kept. The default "fs" backend
+// stores markdown files; "sqlite" stores them in a single database file for
+// faster queries on very large repositories.
+type StorageConfig struct {
+	Backend string `yaml:"backend,omitempty"` // fs (default) or sqlite
+	Path    string `yaml:"path,omitempty"`    // database file relative to the repository, defaults to .growth/growth.db
+}
+
+// PrivacyConfig sets up encrypting private content in the markdown files, so
+// the repository can be pushed publicly. Body sections between
+// <!-- private --> and <!-- /private --> and the frontmatter fields listed
+// in Fields are encrypted for the recipients with age.
+type PrivacyConfig struct {
+	Recipients []string `yaml:"recipients,omitempty"` // age or SSH public keys, or files of them, that can read private content
+	Identity   string   `yaml:"identity,omitempty"`   // age identity or SSH private key file that decrypts it
+	Fields     []string `yaml:"fields,omitempty"`     // e.g. mood, or progress.mood for one entity type
+}
+
+// TasksConfig selects the task manager that growth sync tasks mirrors
+// active milestones into. The Todoist API token is read from the
+// GROWTH_TODOIST_TOKEN environment variable.
+type TasksConfig struct {
+	Provider string `yaml:"provider,omitempty"` // taskwarrior or todoist
+	Project  string `yaml:"project,omitempty"`  // Taskwarrior project name or Todoist project ID
+	Tag      string `yaml:"tag,omitempty"`      // tag or label of the tasks, defaults to growth
+}
+
+// JiraConfig points growth tickets at the Jira site whose tickets are linked
+// to goals and milestones. The API token is read from the GROWTH_JIRA_TOKEN
+// environment variable.
+type JiraConfig struct {
+	URL   string `yaml:"url,omitempty"`   // e.g. https://example.atlassian.net
+	Email string `yaml:"email,omitempty"` // account of a Jira Cloud API token, empty for a personal access token
+}
+
+// TimeConfig selects the time tracker growth time import reads entries from.
+// API tokens are read from the GROWTH_TOGGL_TOKEN or GROWTH_CLOCKIFY_TOKEN
+// environment variable.
+type TimeConfig struct {
+	Provider  string            `yaml:"provider,omitempty"`  // toggl or clockify
+	Project   string            `yaml:"project,omitempty"`   // project to import, all projects when empty
+	Workspace string            `yaml:"workspace,omitempty"` // Clockify workspace ID, defaults to the active one
+	Tags      map[string]string `yaml:"tags,omitempty"`      // tag to skill ID, for tags not named after a skill
 }
 
 type MCPConfig struct {
@@ -58,7 +188,7 @@ type MCPConfig struct {
 
 func DefaultConfig() *Config {
 	return &Config{
-		Version: "1.0",
+		Version: version.SchemaVersion,
 		User: UserConfig{
 			Name:  "",
 			Email: "",
@@ -109,7 +239,7 @@ func LoadConfig(path string) (*Config, error) {
 
 	var config Config
 	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
 	if err := config.Validate(); err != nil {
@@ -149,78 +279,237 @@ func SaveConfig(config *Config, path string) error {
 	return nil
 }
 
+// FieldError is a config validation problem with a single field, identified
+// by its YAML path, e.g. ai.provider
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// CommitOptions attributes commits to the user and signs them as configured
+func (c *Config) CommitOptions() git.CommitOptions {
+	return git.CommitOptions{
+		Name:       c.User.Name,
+		Email:      c.User.Email,
+		Signing:    c.Git.Signing,
+		SigningKey: c.Git.SigningKey,
+	}
+}
+
+// Validate returns the first problem with the config, if any
 func (c *Config) Validate() error {
-	if c.Version == "" {
-		return errors.New("config version is required")
+	if problems := c.Problems(); len(problems) > 0 {
+		return problems[0]
 	}
+	return nil
+}
 
-	if c.AI.Provider != "" {
-		validProviders := map[string]bool{
-			"gemini":    true,
-			"openai":    true,
-			"anthropic": true,
-			"local":     true,
+// Problems returns every validation problem with the config
+func (c *Config) Problems() []*FieldError {
+	var problems []*FieldError
+	add := func(field, format string, args ...any) {
+		problems = append(problems, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	enum := func(field, label, value string, valid []string) {
+		if value == "" || slices.Contains(valid, value) {
+			return
 		}
-		if !validProviders[c.AI.Provider] {
-			return errors.New("invalid AI provider (must be: gemini, openai, anthropic, or local)")
+		hint := ""
+		if suggestion := core.ClosestMatch(value, valid); suggestion != "" {
+			hint = fmt.Sprintf(" (did you mean %q?)", suggestion)
 		}
+		add(field, "invalid %s %q%s, must be one of: %s", label, value, hint, strings.Join(valid, ", "))
+	}
+
+	if c.Version == "" {
+		add("version", "config version is required")
+	}
+
+	enum("user.language", "language", strings.ToLower(c.User.Language), i18n.Codes())
+
+	providers := []string{"gemini", "openai", "anthropic", "local", "mock"}
+	enum("ai.provider", "AI provider", c.AI.Provider, providers)
+	for i, fallback := range c.AI.FallbackProviders {
+		field := fmt.Sprintf("ai.fallbackProviders[%d]", i)
+		if fallback == c.AI.Provider || slices.Contains(c.AI.FallbackProviders[:i], fallback) {
+			add(field, "fallback provider %q is already in the chain", fallback)
+			continue
+		}
+		enum(field, "fallback provider", fallback, providers)
 	}
 
-	// Validate AI temperature
 	if c.AI.Temperature < 0 || c.AI.Temperature > 1 {
-		return errors.New("AI temperature must be between 0.0 and 1.0")
+		add("ai.temperature", "AI temperature must be between 0.0 and 1.0, got %g", c.AI.Temperature)
 	}
 
-	// Validate AI max tokens
 	if c.AI.MaxTokens < 100 || c.AI.MaxTokens > 100000 {
-		return errors.New("AI max tokens must be between 100 and 100000")
+		add("ai.maxTokens", "AI max tokens must be between 100 and 100000, got %d", c.AI.MaxTokens)
 	}
 
-	// Validate learning style
-	if c.AI.DefaultStyle != "" {
-		validStyles := map[string]bool{
-			"top-down":      true,
-			"bottom-up":     true,
-			"project-based": true,
-		}
-		if !validStyles[c.AI.DefaultStyle] {
-			return errors.New("invalid learning style (must be: top-down, bottom-up, or project-based)")
+	if c.AI.ParallelRequests < 0 || c.AI.ParallelRequests > 10 {
+		add("ai.parallelRequests", "AI parallel requests must be between 1 and 10 (0 for the default), got %d", c.AI.ParallelRequests)
+	}
+
+	enum("ai.defaultStyle", "learning style", c.AI.DefaultStyle, []string{"top-down", "bottom-up", "project-based"})
+	enum("ai.defaultBudget", "budget", c.AI.DefaultBudget, []string{"free", "paid", "any"})
+
+	if c.AI.LearningBudget < 0 {
+		add("ai.learningBudget", "learning budget cannot be negative, got %g", c.AI.LearningBudget)
+	}
+	enum("progress.weekStartDay", "week start day", c.Progress.WeekStartDay, []string{"monday", "sunday", "saturday"})
+	enum("display.outputFormat", "output format", c.Display.OutputFormat, []string{"table", "json", "yaml"})
+	enum("display.theme", "theme", c.Display.Theme, []string{"default", "dark", "plain", "no-emoji"})
+	if layout := c.Display.DateFormat; layout != "" {
+		reference := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)
+		if parsed, err := time.Parse(layout, reference.Format(layout)); err != nil || !parsed.Equal(reference) {
+			add("display.dateFormat", "invalid date format %q, must be a Go layout with the year, month and day, like 2006-01-02 or 02.01.2006", layout)
 		}
 	}
 
-	// Validate budget
-	if c.AI.DefaultBudget != "" {
-		validBudgets := map[string]bool{
-			"free": true,
-			"paid": true,
-			"any":  true,
+	if _, err := ParseCommitTemplate(c.Git.CommitMessageTemplate); err != nil {
+		add("git.commitMessageTemplate", "invalid commit message template: %s", err)
+	}
+	enum("git.signing", "commit signing", c.Git.Signing, []string{"gpg", "ssh"})
+
+	enum("storage.backend", "storage backend", c.Storage.Backend, []string{"fs", "sqlite"})
+
+	if c.Email.SMTPPort < 0 || c.Email.SMTPPort > 65535 {
+		add("email.smtpPort", "invalid SMTP port %d", c.Email.SMTPPort)
+	}
+
+	if _, err := core.ParseStudyWindow(c.Calendar.StudyDays, ""); err != nil {
+		add("calendar.studyDays", "%s", err)
+	}
+	if _, err := core.ParseStudyWindow(nil, c.Calendar.StartTime); err != nil {
+		add("calendar.startTime", "%s", err)
+	}
+
+	if len(c.Privacy.Fields) > 0 && len(c.Privacy.Recipients) == 0 {
+		add("privacy.recipients", "private fields need at least one recipient key to encrypt them for")
+	}
+	for i, recipient := range c.Privacy.Recipients {
+		if _, err := parseRecipients(recipient); err != nil {
+			add(fmt.Sprintf("privacy.recipients[%d]", i), "%s", err)
 		}
-		if !validBudgets[c.AI.DefaultBudget] {
-			return errors.New("invalid budget (must be: free, paid, or any)")
+	}
+
+	enum("tasks.provider", "task manager", c.Tasks.Provider, []string{"taskwarrior", "todoist"})
+	if strings.ContainsAny(c.Tasks.Tag, " \t") {
+		add("tasks.tag", "task tag '%s' cannot contain spaces", c.Tasks.Tag)
+	}
+
+	enum("time.provider", "time tracker", c.Time.Provider, []string{"toggl", "clockify"})
+	tags := make([]string, 0, len(c.Time.Tags))
+	for tag := range c.Time.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		if skill := c.Time.Tags[tag]; !strings.HasPrefix(skill, "skill-") {
+			add("time.tags."+tag, "tag '%s' must map to a skill ID like skill-001, got '%s'", tag, skill)
 		}
 	}
 
-	if c.Progress.WeekStartDay != "" {
-		validDays := map[string]bool{
-			"monday":   true,
-			"sunday":   true,
-			"saturday": true,
+	if c.Jira.URL != "" {
+		if u, err := url.Parse(c.Jira.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("jira.url", "invalid Jira URL '%s', must be like https://example.atlassian.net", c.Jira.URL)
 		}
-		if !validDays[c.Progress.WeekStartDay] {
-			return errors.New("invalid week start day")
+	}
+
+	names := make([]string, 0, len(c.Aliases))
+	for name := range c.Aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+			add("aliases", "invalid alias name '%s'", name)
+		} else if strings.TrimSpace(c.Aliases[name]) == "" {
+			add("aliases."+name, "alias '%s' has an empty command", name)
 		}
 	}
 
-	if c.Display.OutputFormat != "" {
-		validFormats := map[string]bool{
-			"table": true,
-			"json":  true,
-			"yaml":  true,
+	return problems
+}
+
+// CheckConfigFile reports every validation problem in the config file at path,
+// plus unknown keys as warnings. err is set when the file cannot be read or
+// parsed.
+func CheckConfigFile(path string) (problems, warnings []*FieldError, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, errors.New("config file not found")
 		}
-		if !validFormats[c.Display.OutputFormat] {
-			return errors.New("invalid output format")
+		return nil, nil, err
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	warnings, err = UnknownConfigKeys(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config.Problems(), warnings, nil
+}
+
+// UnknownConfigKeys returns the keys in YAML config data that do not match a
+// config field, with suggestions for likely typos
+func UnknownConfigKeys(data []byte) ([]*FieldError, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	var unknown []*FieldError
+	collectUnknownKeys(root.Content[0], reflect.TypeOf(Config{}), "", &unknown)
+	return unknown, nil
+}
+
+func collectUnknownKeys(node *yaml.Node, t reflect.Type, prefix string, unknown *[]*FieldError) {
+	if node.Kind != yaml.MappingNode || t.Kind() != reflect.Struct {
+		return
+	}
+
+	fields := make(map[string]reflect.Type, t.NumField())
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
 		}
+		fields[name] = t.Field(i).Type
+		names = append(names, name)
 	}
 
-	return nil
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		fieldType, ok := fields[key]
+		if !ok {
+			message := fmt.Sprintf("unknown key (line %d)", node.Content[i].Line)
+			if suggestion := core.ClosestMatch(key, names); suggestion != "" {
+				message += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			*unknown = append(*unknown, &FieldError{Field: path, Message: message})
+			continue
+		}
+
+		collectUnknownKeys(node.Content[i+1], fieldType, path, unknown)
+	}
 }
@@ -281,6 +281,248 @@ func TestConfigValidate(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("validates SMTP port", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Email.SMTPPort = 70000
+
+		err := config.Validate()
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid SMTP port")
+	})
+
+	t.Run("validates aliases", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			aliases map[string]string
+			errMsg  string
+		}{
+			{"valid alias", map[string]string{"pl": "progress log --hours"}, ""},
+			{"name with space", map[string]string{"p l": "progress log"}, "invalid alias name"},
+			{"name like a flag", map[string]string{"-p": "progress log"}, "invalid alias name"},
+			{"empty command", map[string]string{"pl": "  "}, "empty command"},
+		}
+
+		for _, tt := range tests {
+			config := DefaultConfig()
+			config.Aliases = tt.aliases
+
+			err := config.Validate()
+
+			if tt.errMsg == "" {
+				assert.NoError(t, err, tt.name)
+			} else {
+				assert.ErrorContains(t, err, tt.errMsg, tt.name)
+			}
+		}
+	})
+}
+
+func TestConfigProblems(t *testing.T) {
+	t.Run("valid config has no problems", func(t *testing.T) {
+		assert.Empty(t, DefaultConfig().Problems())
+	})
+
+	t.Run("reports every problem with its field path", func(t *testing.T) {
+		config := DefaultConfig()
+		config.AI.Provider = "gemni"
+		config.Display.OutputFormat = "csv"
+
+		problems := config.Problems()
+
+		require.Len(t, problems, 2)
+		assert.Equal(t, "ai.provider", problems[0].Field)
+		assert.Contains(t, problems[0].Error(), `did you mean "gemini"?`)
+		assert.Equal(t, "display.outputFormat", problems[1].Field)
+		assert.NotContains(t, problems[1].Error(), "did you mean")
+	})
+
+	t.Run("checks the storage backend", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Storage.Backend = "sqlite"
+		assert.Empty(t, config.Problems())
+
+		config.Storage.Backend = "sqlit"
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "storage.backend", problems[0].Field)
+		assert.Contains(t, problems[0].Error(), `did you mean "sqlite"?`)
+	})
+
+	t.Run("checks the calendar study window", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Calendar.StudyDays = []string{"sat", "sunday"}
+		config.Calendar.StartTime = "08:30"
+		assert.Empty(t, config.Problems())
+
+		config.Calendar.StudyDays = []string{"caturday"}
+		config.Calendar.StartTime = "7pm"
+		problems := config.Problems()
+		require.Len(t, problems, 2)
+		assert.Equal(t, "calendar.studyDays", problems[0].Field)
+		assert.Equal(t, "calendar.startTime", problems[1].Field)
+	})
+
+	t.Run("checks the task manager", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Tasks = TasksConfig{Provider: "todoist", Project: "2203306141", Tag: "learning"}
+		assert.Empty(t, config.Problems())
+
+		config.Tasks = TasksConfig{Provider: "taskwarior", Tag: "to learn"}
+		problems := config.Problems()
+		require.Len(t, problems, 2)
+		assert.Equal(t, "tasks.provider", problems[0].Field)
+		assert.Contains(t, problems[0].Error(), `did you mean "taskwarrior"?`)
+		assert.Equal(t, "tasks.tag", problems[1].Field)
+	})
+
+	t.Run("checks the time tracker", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Time = TimeConfig{Provider: "clockify", Project: "Learning", Tags: map[string]string{"k8s": "skill-004"}}
+		assert.Empty(t, config.Problems())
+
+		config.Time = TimeConfig{Provider: "togl", Tags: map[string]string{"k8s": "Kubernetes"}}
+		problems := config.Problems()
+		require.Len(t, problems, 2)
+		assert.Equal(t, "time.provider", problems[0].Field)
+		assert.Contains(t, problems[0].Error(), `did you mean "toggl"?`)
+		assert.Equal(t, "time.tags.k8s", problems[1].Field)
+	})
+
+	t.Run("checks the Jira URL", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Jira = JiraConfig{URL: "https://example.atlassian.net", Email: "me@example.com"}
+		assert.Empty(t, config.Problems())
+
+		config.Jira.URL = "example.atlassian.net"
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "jira.url", problems[0].Field)
+	})
+
+	t.Run("checks the fallback providers", func(t *testing.T) {
+		config := DefaultConfig()
+		config.AI.FallbackProviders = []string{"openai", "local"}
+		assert.Empty(t, config.Problems())
+
+		config.AI.FallbackProviders = []string{"opanai", "gemini", "local", "local"}
+		problems := config.Problems()
+		require.Len(t, problems, 3)
+		assert.Equal(t, "ai.fallbackProviders[0]", problems[0].Field)
+		assert.Contains(t, problems[0].Error(), `did you mean "openai"?`)
+		assert.Equal(t, "ai.fallbackProviders[1]", problems[1].Field)
+		assert.Equal(t, "ai.fallbackProviders[3]", problems[2].Field)
+	})
+
+	t.Run("checks the learning budget", func(t *testing.T) {
+		config := DefaultConfig()
+		config.AI.Subscriptions = []string{"oreilly", "pluralsight"}
+		config.AI.LearningBudget = 500
+		assert.Empty(t, config.Problems())
+
+		config.AI.LearningBudget = -1
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "ai.learningBudget", problems[0].Field)
+	})
+
+	t.Run("checks parallel requests", func(t *testing.T) {
+		config := DefaultConfig()
+		config.AI.ParallelRequests = 5
+		assert.Empty(t, config.Problems())
+
+		config.AI.ParallelRequests = 11
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "ai.parallelRequests", problems[0].Field)
+	})
+
+	t.Run("checks the language", func(t *testing.T) {
+		config := DefaultConfig()
+		config.User.Language = "UK"
+		assert.Empty(t, config.Problems())
+
+		config.User.Language = "ua"
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "user.language", problems[0].Field)
+	})
+
+	t.Run("checks the theme", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Display.Theme = "no-emoji"
+		assert.Empty(t, config.Problems())
+
+		config.Display.Theme = "drak"
+		problems := config.Problems()
+		require.Len(t, problems, 1)
+		assert.Equal(t, "display.theme", problems[0].Field)
+		assert.Contains(t, problems[0].Message, `did you mean "dark"?`)
+	})
+
+	t.Run("checks the date format", func(t *testing.T) {
+		config := DefaultConfig()
+		for _, layout := range []string{"02.01.2006", "01/02/2006", "Jan 2, 2006"} {
+			config.Display.DateFormat = layout
+			assert.Empty(t, config.Problems(), layout)
+		}
+
+		for _, layout := range []string{"dd.mm.yyyy", "Jan 2"} {
+			config.Display.DateFormat = layout
+			problems := config.Problems()
+			require.Len(t, problems, 1, layout)
+			assert.Equal(t, "display.dateFormat", problems[0].Field)
+		}
+	})
+
+	t.Run("validate returns the first problem", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Progress.WeekStartDay = "mondya"
+
+		err := config.Validate()
+
+		var fieldErr *FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "progress.weekStartDay", fieldErr.Field)
+	})
+}
+
+func TestUnknownConfigKeys(t *testing.T) {
+	data := []byte(`version: "1.0"
+ai:
+  provider: gemini
+  modle: gemini-pro
+dispaly:
+  outputFormat: table
+aliases:
+  pl: progress log
+`)
+
+	unknown, err := UnknownConfigKeys(data)
+
+	require.NoError(t, err)
+	require.Len(t, unknown, 2)
+	assert.Equal(t, "ai.modle", unknown[0].Field)
+	assert.Contains(t, unknown[0].Message, `did you mean "model"?`)
+	assert.Equal(t, "dispaly", unknown[1].Field)
+	assert.Contains(t, unknown[1].Message, "line 5")
+}
+
+func TestCheckConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+
+	_, _, err := CheckConfigFile(path)
+	assert.ErrorContains(t, err, "not found")
+
+	require.NoError(t, os.WriteFile(path, []byte("version: \"1.0\"\nai:\n  provider: openia\n  maxTokens: 8000\nextra: true\n"), 0644))
+
+	problems, warnings, err := CheckConfigFile(path)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "ai.provider", problems[0].Field)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "extra", warnings[0].Field)
 }
 
 func TestConfigRoundTrip(t *testing.T) {
@@ -345,3 +587,28 @@ func TestConfigRoundTrip(t *testing.T) {
 		assert.Equal(t, original.MCP.Port, loaded.MCP.Port)
 	})
 }
+
+func TestCommitOptions(t *testing.T) {
+	t.Run("attributes commits to the user", func(t *testing.T) {
+		config := DefaultConfig()
+		config.User = UserConfig{Name: "Ada Lovelace", Email: "ada@example.com"}
+		config.Git.Signing = "ssh"
+		config.Git.SigningKey = "~/.ssh/id_ed25519.pub"
+
+		opts := config.CommitOptions()
+		assert.Equal(t, "Ada Lovelace", opts.Name)
+		assert.Equal(t, "ada@example.com", opts.Email)
+		assert.Equal(t, "ssh", opts.Signing)
+		assert.Equal(t, "~/.ssh/id_ed25519.pub", opts.SigningKey)
+		assert.Empty(t, config.Problems())
+	})
+
+	t.Run("rejects unknown signing formats", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Git.Signing = "x509"
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "git.signing")
+	})
+}
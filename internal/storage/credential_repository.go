@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type CredentialRepository struct {
+	repo Repository[core.Credential]
+}
+
+func NewCredentialRepository(basePath string) (*CredentialRepository, error) {
+	repo, err := NewFilesystemRepository[core.Credential](basePath, "credential")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CredentialRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewCredentialSQLiteRepository creates a credential repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewCredentialSQLiteRepository(db *sql.DB) (*CredentialRepository, error) {
+	repo, err := NewSQLiteRepository[core.Credential](db, "credential")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CredentialRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *CredentialRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Credential, *core.Credential]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *CredentialRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Credential, *core.Credential]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *CredentialRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *CredentialRepository) Create(credential *core.Credential) error {
+	return r.repo.Create(credential)
+}
+
+func (r *CredentialRepository) GetByID(id core.EntityID) (*core.Credential, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *CredentialRepository) GetByIDWithBody(id core.EntityID) (*core.Credential, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *CredentialRepository) GetAll() ([]*core.Credential, error) {
+	return r.repo.GetAll()
+}
+
+func (r *CredentialRepository) Iterate(fn func(*core.Credential) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *CredentialRepository) Update(credential *core.Credential) error {
+	return r.repo.Update(credential)
+}
+
+func (r *CredentialRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *CredentialRepository) Search(query string) ([]*core.Credential, error) {
+	return r.repo.Search(query)
+}
+
+func (r *CredentialRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindBySkill returns credentials that certify the given skill.
+func (r *CredentialRepository) FindBySkill(skillID core.EntityID) ([]*core.Credential, error) {
+	allCredentials, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Credential
+	for _, credential := range allCredentials {
+		if credential.HasSkill(skillID) {
+			results = append(results, credential)
+		}
+	}
+
+	sortCredentialsByIssueDate(results)
+
+	return results, nil
+}
+
+func sortCredentialsByIssueDate(credentials []*core.Credential) {
+	sort.Slice(credentials, func(i, j int) bool {
+		return credentials[i].IssueDate.After(credentials[j].IssueDate)
+	})
+}
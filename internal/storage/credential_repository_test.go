@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredentialRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewCredentialRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewCredentialRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCredentialRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewCredentialRepository(tmpDir)
+
+	issued := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates and retrieves credential", func(t *testing.T) {
+		credential, _ := core.NewCredential("credential-001", "Certified Kubernetes Administrator", "CNCF", issued)
+		credential.CredentialID = "LF-abc123"
+		credential.URL = "https://www.credly.com/badges/abc123"
+		require.NoError(t, credential.SetExpiryDate(issued.AddDate(2, 0, 0)))
+		credential.AddSkill("skill-002")
+		credential.Body = "Renew by retaking the exam."
+
+		err := repo.Create(credential)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("credential-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Certified Kubernetes Administrator", retrieved.Title)
+		assert.Equal(t, "CNCF", retrieved.Issuer)
+		assert.Equal(t, "LF-abc123", retrieved.CredentialID)
+		assert.True(t, issued.Equal(retrieved.IssueDate))
+		require.NotNil(t, retrieved.ExpiryDate)
+		assert.True(t, issued.AddDate(2, 0, 0).Equal(*retrieved.ExpiryDate))
+		assert.Equal(t, []core.EntityID{"skill-002"}, retrieved.Skills)
+		assert.Contains(t, retrieved.Body, "retaking the exam")
+	})
+
+	t.Run("deletes credential", func(t *testing.T) {
+		err := repo.Delete("credential-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("credential-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestCredentialRepository_FindBySkill(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewCredentialRepository(tmpDir)
+
+	older, _ := core.NewCredential("credential-001", "Older credential", "CNCF", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	older.AddSkill("skill-002")
+	newer, _ := core.NewCredential("credential-002", "Newer credential", "CNCF", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer.AddSkill("skill-002")
+	other, _ := core.NewCredential("credential-003", "Other credential", "AWS", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, repo.Create(older))
+	require.NoError(t, repo.Create(newer))
+	require.NoError(t, repo.Create(other))
+
+	results, err := repo.FindBySkill("skill-002")
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "Newer credential", results[0].Title)
+}
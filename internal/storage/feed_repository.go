@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type FeedRepository struct {
+	repo Repository[core.Feed]
+}
+
+func NewFeedRepository(basePath string) (*FeedRepository, error) {
+	repo, err := NewFilesystemRepository[core.Feed](basePath, "feed")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeedRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewFeedSQLiteRepository creates a feed repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewFeedSQLiteRepository(db *sql.DB) (*FeedRepository, error) {
+	repo, err := NewSQLiteRepository[core.Feed](db, "feed")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeedRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *FeedRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Feed, *core.Feed]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *FeedRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Feed, *core.Feed]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *FeedRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *FeedRepository) Create(feed *core.Feed) error {
+	return r.repo.Create(feed)
+}
+
+func (r *FeedRepository) GetByID(id core.EntityID) (*core.Feed, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *FeedRepository) GetByIDWithBody(id core.EntityID) (*core.Feed, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *FeedRepository) GetAll() ([]*core.Feed, error) {
+	return r.repo.GetAll()
+}
+
+func (r *FeedRepository) Iterate(fn func(*core.Feed) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *FeedRepository) Update(feed *core.Feed) error {
+	return r.repo.Update(feed)
+}
+
+func (r *FeedRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *FeedRepository) Search(query string) ([]*core.Feed, error) {
+	return r.repo.Search(query)
+}
+
+func (r *FeedRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+func (r *FeedRepository) FindBySkillID(skillID core.EntityID) ([]*core.Feed, error) {
+	var results []*core.Feed
+	err := r.repo.Iterate(func(feed *core.Feed) bool {
+		if feed.SkillID == skillID {
+			results = append(results, feed)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindByURL returns the feed watching the given URL, or nil if there is none.
+func (r *FeedRepository) FindByURL(url string) (*core.Feed, error) {
+	allFeeds, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feed := range allFeeds {
+		if feed.URL == url {
+			return feed, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewFeedRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewFeedRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFeedRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFeedRepository(tmpDir)
+
+	t.Run("creates and retrieves feed", func(t *testing.T) {
+		feed, _ := core.NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+		feed.MarkSeen("item-1", "item-2")
+
+		err := repo.Create(feed)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByID("feed-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Go Weekly", retrieved.Title)
+		assert.Equal(t, []string{"item-1", "item-2"}, retrieved.SeenItems)
+	})
+
+	t.Run("deletes feed", func(t *testing.T) {
+		err := repo.Delete("feed-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("feed-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestFeedRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFeedRepository(tmpDir)
+
+	feed1, _ := core.NewFeed("feed-001", "Go Weekly", "https://golangweekly.com/rss", "skill-001")
+	feed2, _ := core.NewFeed("feed-002", "This Week in Rust", "https://this-week-in-rust.org/rss.xml", "skill-002")
+	require.NoError(t, repo.Create(feed1))
+	require.NoError(t, repo.Create(feed2))
+
+	t.Run("finds by skill ID", func(t *testing.T) {
+		feeds, err := repo.FindBySkillID("skill-002")
+
+		require.NoError(t, err)
+		require.Len(t, feeds, 1)
+		assert.Equal(t, core.EntityID("feed-002"), feeds[0].ID)
+	})
+
+	t.Run("finds by URL", func(t *testing.T) {
+		feed, err := repo.FindByURL("https://golangweekly.com/rss")
+
+		require.NoError(t, err)
+		require.NotNil(t, feed)
+		assert.Equal(t, core.EntityID("feed-001"), feed.ID)
+	})
+
+	t.Run("returns nil for unknown URL", func(t *testing.T) {
+		feed, err := repo.FindByURL("https://example.com/rss")
+
+		require.NoError(t, err)
+		assert.Nil(t, feed)
+	})
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type FeedbackRepository struct {
+	repo Repository[core.Feedback]
+}
+
+func NewFeedbackRepository(basePath string) (*FeedbackRepository, error) {
+	repo, err := NewFilesystemRepository[core.Feedback](basePath, "feedback")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeedbackRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewFeedbackSQLiteRepository creates a feedback repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewFeedbackSQLiteRepository(db *sql.DB) (*FeedbackRepository, error) {
+	repo, err := NewSQLiteRepository[core.Feedback](db, "feedback")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeedbackRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *FeedbackRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Feedback, *core.Feedback]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *FeedbackRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Feedback, *core.Feedback]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *FeedbackRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *FeedbackRepository) Create(feedback *core.Feedback) error {
+	return r.repo.Create(feedback)
+}
+
+func (r *FeedbackRepository) GetByID(id core.EntityID) (*core.Feedback, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *FeedbackRepository) GetByIDWithBody(id core.EntityID) (*core.Feedback, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *FeedbackRepository) GetAll() ([]*core.Feedback, error) {
+	return r.repo.GetAll()
+}
+
+func (r *FeedbackRepository) Iterate(fn func(*core.Feedback) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *FeedbackRepository) Update(feedback *core.Feedback) error {
+	return r.repo.Update(feedback)
+}
+
+func (r *FeedbackRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *FeedbackRepository) Search(query string) ([]*core.Feedback, error) {
+	return r.repo.Search(query)
+}
+
+func (r *FeedbackRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindBySkill returns feedback about the given skill, newest first.
+func (r *FeedbackRepository) FindBySkill(skillID core.EntityID) ([]*core.Feedback, error) {
+	allFeedback, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Feedback
+	for _, feedback := range allFeedback {
+		if feedback.HasSkill(skillID) {
+			results = append(results, feedback)
+		}
+	}
+
+	sortFeedbackByDate(results)
+
+	return results, nil
+}
+
+// FindSince returns feedback dated at or after the given time with its
+// comments, newest first.
+func (r *FeedbackRepository) FindSince(since time.Time) ([]*core.Feedback, error) {
+	allFeedback, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Feedback
+	for _, feedback := range allFeedback {
+		if feedback.Date.Before(since) {
+			continue
+		}
+		withBody, err := r.repo.GetByIDWithBody(feedback.ID)
+		if err != nil {
+			continue
+		}
+		results = append(results, withBody)
+	}
+
+	sortFeedbackByDate(results)
+
+	return results, nil
+}
+
+func sortFeedbackByDate(feedback []*core.Feedback) {
+	sort.Slice(feedback, func(i, j int) bool {
+		return feedback[i].Date.After(feedback[j].Date)
+	})
+}
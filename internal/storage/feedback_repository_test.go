@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedbackRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewFeedbackRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewFeedbackRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFeedbackRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFeedbackRepository(tmpDir)
+
+	t.Run("creates and retrieves feedback", func(t *testing.T) {
+		feedback, _ := core.NewFeedback("feedback-001", "Alice", core.FeedbackPeer, time.Now())
+		feedback.Cycle = "2025 H1"
+		feedback.AddSkill("skill-003")
+		require.NoError(t, feedback.SetRating(4))
+		feedback.Strengths = []string{"Thorough code reviews"}
+		feedback.Improvements = []string{"Share designs earlier"}
+		feedback.Body = "Great to work with on the payments migration."
+
+		err := repo.Create(feedback)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("feedback-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Feedback from Alice (peer)", retrieved.Title)
+		assert.Equal(t, core.FeedbackPeer, retrieved.Relationship)
+		assert.Equal(t, "2025 H1", retrieved.Cycle)
+		assert.Equal(t, 4, retrieved.Rating)
+		assert.Equal(t, []core.EntityID{"skill-003"}, retrieved.Skills)
+		assert.Equal(t, []string{"Thorough code reviews"}, retrieved.Strengths)
+		assert.Equal(t, []string{"Share designs earlier"}, retrieved.Improvements)
+		assert.Contains(t, retrieved.Body, "payments migration")
+	})
+
+	t.Run("deletes feedback", func(t *testing.T) {
+		err := repo.Delete("feedback-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("feedback-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestFeedbackRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFeedbackRepository(tmpDir)
+
+	now := time.Now()
+	older, _ := core.NewFeedback("feedback-001", "Alice", core.FeedbackPeer, now.AddDate(0, -6, 0))
+	older.AddSkill("skill-001")
+	newer, _ := core.NewFeedback("feedback-002", "Bo", core.FeedbackManager, now)
+	newer.AddSkill("skill-001")
+	newer.Body = "Ready to lead the next migration."
+	newer.AddSkill("skill-002")
+	other, _ := core.NewFeedback("feedback-003", "Carol", core.FeedbackReport, now.AddDate(0, 0, -3))
+	other.AddSkill("skill-002")
+	require.NoError(t, repo.Create(older))
+	require.NoError(t, repo.Create(newer))
+	require.NoError(t, repo.Create(other))
+
+	t.Run("finds feedback about a skill newest first", func(t *testing.T) {
+		results, err := repo.FindBySkill("skill-001")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, core.EntityID("feedback-002"), results[0].ID)
+		assert.Equal(t, core.EntityID("feedback-001"), results[1].ID)
+	})
+
+	t.Run("finds feedback since date", func(t *testing.T) {
+		results, err := repo.FindSince(now.AddDate(0, -1, 0))
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, core.EntityID("feedback-002"), results[0].ID)
+		assert.Contains(t, results[0].Body, "next migration")
+	})
+}
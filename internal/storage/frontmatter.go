@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -25,18 +27,41 @@ func ParseFrontmatter(content []byte) (frontmatter map[string]interface{}, body
 		return nil, "", errors.New("empty content")
 	}
 
+	frontmatterYAML, body, found, err := splitFrontmatter(content)
+	if err != nil {
+		return nil, "", err
+	}
+	if !found {
+		// No frontmatter, treat entire content as body
+		return make(map[string]interface{}), body, nil
+	}
+
+	// Parse YAML
+	frontmatter = make(map[string]interface{})
+	if len(frontmatterYAML) > 0 {
+		if err := yaml.Unmarshal([]byte(frontmatterYAML), &frontmatter); err != nil {
+			return nil, "", fmt.Errorf("failed to parse frontmatter YAML: %w", err)
+		}
+	}
+
+	return frontmatter, body, nil
+}
+
+// splitFrontmatter separates the YAML between the frontmatter delimiters from
+// the trimmed body. found is false when content has no frontmatter, in which
+// case body is the whole content.
+func splitFrontmatter(content []byte) (frontmatterYAML, body string, found bool, err error) {
 	contentStr := string(content)
 
 	// Check if content starts with frontmatter delimiter
 	if !strings.HasPrefix(contentStr, frontmatterDelimiter) {
-		// No frontmatter, treat entire content as body
-		return make(map[string]interface{}), contentStr, nil
+		return "", contentStr, false, nil
 	}
 
 	// Find the second delimiter
 	lines := strings.Split(contentStr, "\n")
 	if len(lines) < 3 {
-		return nil, "", errors.New("invalid frontmatter: too few lines")
+		return "", "", false, errors.New("invalid frontmatter: too few lines")
 	}
 
 	// Skip first line (opening ---)
@@ -49,43 +74,59 @@ func ParseFrontmatter(content []byte) (frontmatter map[string]interface{}, body
 	}
 
 	if endIdx == -1 {
-		return nil, "", errors.New("invalid frontmatter: missing closing delimiter")
+		return "", "", false, errors.New("invalid frontmatter: missing closing delimiter")
 	}
 
 	// Extract frontmatter YAML (between delimiters)
-	frontmatterLines := lines[1:endIdx]
-	frontmatterYAML := strings.Join(frontmatterLines, "\n")
-
-	// Parse YAML
-	frontmatter = make(map[string]interface{})
-	if len(frontmatterYAML) > 0 {
-		if err := yaml.Unmarshal([]byte(frontmatterYAML), &frontmatter); err != nil {
-			return nil, "", fmt.Errorf("failed to parse frontmatter YAML: %w", err)
-		}
-	}
+	frontmatterYAML = strings.Join(lines[1:endIdx], "\n")
 
 	// Extract body (everything after closing delimiter)
 	if endIdx+1 < len(lines) {
-		body = strings.Join(lines[endIdx+1:], "\n")
-		body = strings.TrimSpace(body)
+		body = strings.TrimSpace(strings.Join(lines[endIdx+1:], "\n"))
 	}
 
-	return frontmatter, body, nil
+	return frontmatterYAML, body, true, nil
 }
 
 // SerializeFrontmatter combines frontmatter and body into markdown with YAML frontmatter.
 // The frontmatter parameter can be any struct or map that can be marshaled to YAML.
+// Struct fields are written in declaration order and map keys sorted, so
+// serializing the same value always produces the same bytes.
 func SerializeFrontmatter(frontmatter interface{}, body string) ([]byte, error) {
+	return serializeFrontmatterWithExtra(frontmatter, nil, body)
+}
+
+// serializeFrontmatterWithExtra is SerializeFrontmatter with extra key/value
+// node pairs appended after the fields of frontmatter, which must marshal to
+// a mapping
+func serializeFrontmatterWithExtra(frontmatter interface{}, extra []*yaml.Node, body string) ([]byte, error) {
 	if frontmatter == nil {
 		return nil, errors.New("frontmatter cannot be nil")
 	}
 
+	var node yaml.Node
+	if err := node.Encode(frontmatter); err != nil {
+		return nil, fmt.Errorf("failed to marshal frontmatter to YAML: %w", err)
+	}
+	if len(extra) > 0 {
+		if node.Kind != yaml.MappingNode {
+			return nil, errors.New("frontmatter must be a mapping to add fields")
+		}
+		node.Content = append(node.Content, extra...)
+	}
+
 	// Marshal frontmatter to YAML
-	yamlBytes, err := yaml.Marshal(frontmatter)
+	yamlBytes, err := yaml.Marshal(&node)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal frontmatter to YAML: %w", err)
 	}
 
+	return joinFrontmatter(yamlBytes, body), nil
+}
+
+// joinFrontmatter puts the frontmatter YAML between the delimiters, followed
+// by the body
+func joinFrontmatter(yamlBytes []byte, body string) []byte {
 	var buf bytes.Buffer
 
 	// Write opening delimiter
@@ -106,5 +147,64 @@ func SerializeFrontmatter(frontmatter interface{}, body string) ([]byte, error)
 		buf.WriteString("\n")
 	}
 
-	return buf.Bytes(), nil
+	return buf.Bytes()
+}
+
+// unknownFields returns the top-level frontmatter entries of content, as
+// key/value node pairs in their original order, that T does not define.
+// These are fields users added by hand, which updates must not drop.
+func unknownFields[T any](content []byte) ([]*yaml.Node, error) {
+	frontmatterYAML, _, found, err := splitFrontmatter(content)
+	if err != nil || !found || strings.TrimSpace(frontmatterYAML) == "" {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontmatterYAML), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse frontmatter YAML: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	mapping := doc.Content[0]
+
+	knownKeys := yamlFieldNames(reflect.TypeOf((*T)(nil)).Elem())
+
+	var extra []*yaml.Node
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if !knownKeys[mapping.Content[i].Value] {
+			extra = append(extra, mapping.Content[i], mapping.Content[i+1])
+		}
+	}
+
+	return extra, nil
+}
+
+// yamlFieldNames returns the frontmatter keys a struct type defines, following
+// inlined structs
+func yamlFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if slices.Contains(strings.Split(opts, ","), "inline") {
+			for inlined := range yamlFieldNames(field.Type) {
+				names[inlined] = true
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		names[name] = true
+	}
+
+	return names
 }
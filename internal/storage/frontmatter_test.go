@@ -180,6 +180,26 @@ func TestSerializeFrontmatter(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot be nil")
 	})
 
+	t.Run("writes fields in a stable order", func(t *testing.T) {
+		type TestStruct struct {
+			Title string `yaml:"title"`
+			ID    string `yaml:"id"`
+		}
+		frontmatter := map[string]interface{}{"zeta": 1, "alpha": 2, "mid": 3}
+
+		first, err := SerializeFrontmatter(frontmatter, "")
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			again, _ := SerializeFrontmatter(frontmatter, "")
+			assert.Equal(t, string(first), string(again))
+		}
+		assert.Equal(t, "---\nalpha: 2\nmid: 3\nzeta: 1\n---\n", string(first))
+
+		result, err := SerializeFrontmatter(TestStruct{ID: "skill-001", Title: "Python"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, "---\ntitle: Python\nid: skill-001\n---\n", string(result))
+	})
+
 	t.Run("handles empty map", func(t *testing.T) {
 		frontmatter := map[string]interface{}{}
 		body := "Just body content."
@@ -219,3 +239,50 @@ func TestRoundTrip(t *testing.T) {
 		assert.Contains(t, parsedBody, "With multiple paragraphs")
 	})
 }
+
+func TestUnknownFields(t *testing.T) {
+	type TestStruct struct {
+		ID    string   `yaml:"id"`
+		Title string   `yaml:"title,omitempty"`
+		Tags  []string `yaml:"tags,omitempty"`
+	}
+
+	t.Run("returns hand-added fields in file order", func(t *testing.T) {
+		content := []byte("---\nrating: 5\nid: skill-001\ntitle: Python\nsource:\n    book: Fluent Python\n---\nBody")
+
+		extra, err := unknownFields[TestStruct](content)
+
+		require.NoError(t, err)
+		require.Len(t, extra, 4)
+		assert.Equal(t, "rating", extra[0].Value)
+		assert.Equal(t, "5", extra[1].Value)
+		assert.Equal(t, "source", extra[2].Value)
+	})
+
+	t.Run("treats empty known fields as known", func(t *testing.T) {
+		content := []byte("---\nid: skill-001\ntitle: \"\"\ntags: []\n---\n")
+
+		extra, err := unknownFields[TestStruct](content)
+
+		require.NoError(t, err)
+		assert.Empty(t, extra)
+	})
+
+	t.Run("appends them after the struct fields", func(t *testing.T) {
+		content := []byte("---\nrating: 5\nid: skill-001\n---\n")
+		extra, err := unknownFields[TestStruct](content)
+		require.NoError(t, err)
+
+		result, err := serializeFrontmatterWithExtra(TestStruct{ID: "skill-001", Title: "Python"}, extra, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "---\nid: skill-001\ntitle: Python\nrating: 5\n---\n", string(result))
+	})
+
+	t.Run("ignores content without frontmatter", func(t *testing.T) {
+		extra, err := unknownFields[TestStruct]([]byte("just a body"))
+
+		require.NoError(t, err)
+		assert.Empty(t, extra)
+	})
+}
@@ -1,40 +1,54 @@
 package storage
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"reflect"
 	"regexp"
 	"strings"
-	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 	"github.com/illenko/growth.md/internal/git"
 	"gopkg.in/yaml.v3"
 )
 
-var _ Repository[any] = (*FilesystemRepository[any])(nil)
+var _ Repository[core.Skill] = (*FilesystemRepository[core.Skill, *core.Skill])(nil)
+
+// EntityPointer constrains a repository to entity types whose pointer
+// implements core.Entity. P is inferred from T, so repositories are created
+// with NewFilesystemRepository[core.Skill](...).
+type EntityPointer[T any] interface {
+	*T
+	core.Entity
+}
 
 // FilesystemRepository implements the Repository interface using the local filesystem.
 // Entities are stored as markdown files with YAML frontmatter.
-type FilesystemRepository[T any] struct {
+type FilesystemRepository[T any, P EntityPointer[T]] struct {
 	basePath   string  // Base directory for this repository
 	entityType string  // Entity type name (e.g., "skill", "goal")
 	config     *Config // Configuration including git settings
+	events     *events.Log
+	cache      *entityCache[T] // nil disables caching
+	ctx        context.Context // stops auto-commits, nil for context.Background()
+
+	privacy    *privacyKeys // nil when private content is not set up
+	privacyErr error        // why the privacy keys failed to load
 }
 
 // NewFilesystemRepository creates a new filesystem-based repository.
 // basePath is the directory where entity files will be stored.
 // entityType is used for file naming (e.g., "skill" -> "skill-001-python.md").
-func NewFilesystemRepository[T any](basePath, entityType string) (*FilesystemRepository[T], error) {
-	return NewFilesystemRepositoryWithConfig[T](basePath, entityType, nil)
+func NewFilesystemRepository[T any, P EntityPointer[T]](basePath, entityType string) (*FilesystemRepository[T, P], error) {
+	return NewFilesystemRepositoryWithConfig[T, P](basePath, entityType, nil)
 }
 
 // NewFilesystemRepositoryWithConfig creates a new filesystem-based repository with config.
 // If config is nil, git integration will be disabled.
-func NewFilesystemRepositoryWithConfig[T any](basePath, entityType string, config *Config) (*FilesystemRepository[T], error) {
+func NewFilesystemRepositoryWithConfig[T any, P EntityPointer[T]](basePath, entityType string, config *Config) (*FilesystemRepository[T, P], error) {
 	if basePath == "" {
 		return nil, errors.New("basePath cannot be empty")
 	}
@@ -47,20 +61,48 @@ func NewFilesystemRepositoryWithConfig[T any](basePath, entityType string, confi
 		return nil, fmt.Errorf("failed to create directory %s: %w", basePath, err)
 	}
 
-	return &FilesystemRepository[T]{
+	r := &FilesystemRepository[T, P]{
 		basePath:   basePath,
 		entityType: entityType,
-		config:     config,
-	}, nil
+	}
+	r.SetConfig(config)
+	return r, nil
 }
 
 // SetConfig sets the configuration for the repository.
 // This allows setting config after repository creation.
-func (r *FilesystemRepository[T]) SetConfig(config *Config) {
+func (r *FilesystemRepository[T, P]) SetConfig(config *Config) {
 	r.config = config
+	r.privacy, r.privacyErr = nil, nil
+	if config != nil {
+		r.privacy, r.privacyErr = loadPrivacyKeys(config.Privacy)
+	}
+}
+
+// SetContext sets the context auto-commits run under, so cancelling it stops
+// a commit in progress. Writes themselves are not cancelled.
+func (r *FilesystemRepository[T, P]) SetContext(ctx context.Context) {
+	r.ctx = ctx
+}
+
+// SetEventLog sets the log that receives domain events for changes made
+// through this repository. A nil log disables events.
+func (r *FilesystemRepository[T, P]) SetEventLog(log *events.Log) {
+	r.events = log
+}
+
+// SetCacheDir enables the entity cache, kept in dir as {entityType}.gob.
+// GetAll then only parses files that changed since the previous call. An
+// empty dir disables the cache.
+func (r *FilesystemRepository[T, P]) SetCacheDir(dir string) {
+	if dir == "" {
+		r.cache = nil
+		return
+	}
+	r.cache = &entityCache[T]{path: filepath.Join(dir, r.entityType+".gob")}
 }
 
-func (r *FilesystemRepository[T]) Create(entity *T) error {
+func (r *FilesystemRepository[T, P]) Create(entity *T) error {
 	if entity == nil {
 		return errors.New("entity cannot be nil")
 	}
@@ -85,32 +127,34 @@ func (r *FilesystemRepository[T]) Create(entity *T) error {
 	fp := filepath.Join(r.basePath, filename)
 
 	// Serialize entity
-	content, err := r.serializeEntity(entity)
+	content, err := r.serializeEntity(entity, nil)
 	if err != nil {
 		return fmt.Errorf("failed to serialize entity: %w", err)
 	}
 
 	// Write to file
-	if err := os.WriteFile(fp, content, 0644); err != nil {
+	if err := writeFileAtomic(fp, content); err != nil {
 		return fmt.Errorf("failed to write file %s: %w", fp, err)
 	}
 
 	// Auto-commit if enabled
 	r.autoCommit("create", fp, string(id), title)
 
+	r.emit(events.Event{Type: events.EntityCreated, EntityID: id, Title: title})
+
 	return nil
 }
 
-func (r *FilesystemRepository[T]) GetByID(id core.EntityID) (*T, error) {
+func (r *FilesystemRepository[T, P]) GetByID(id core.EntityID) (*T, error) {
 	return r.getByID(id, false)
 }
 
-func (r *FilesystemRepository[T]) GetByIDWithBody(id core.EntityID) (*T, error) {
+func (r *FilesystemRepository[T, P]) GetByIDWithBody(id core.EntityID) (*T, error) {
 	return r.getByID(id, true)
 }
 
 // getByID is the internal implementation for both GetByID methods.
-func (r *FilesystemRepository[T]) getByID(id core.EntityID, includeBody bool) (*T, error) {
+func (r *FilesystemRepository[T, P]) getByID(id core.EntityID, includeBody bool) (*T, error) {
 	if id == "" {
 		return nil, errors.New("id cannot be empty")
 	}
@@ -130,27 +174,51 @@ func (r *FilesystemRepository[T]) getByID(id core.EntityID, includeBody bool) (*
 	return entity, nil
 }
 
-func (r *FilesystemRepository[T]) GetAll() ([]*T, error) {
+func (r *FilesystemRepository[T, P]) GetAll() ([]*T, error) {
+	var entities []*T
+	err := r.Iterate(func(entity *T) bool {
+		entities = append(entities, entity)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if entities == nil {
+		entities = []*T{}
+	}
+	return entities, nil
+}
+
+// Iterate calls fn for each entity (metadata only, without body), parsing one
+// file at a time, until fn returns false. Files that fail to parse are skipped.
+func (r *FilesystemRepository[T, P]) Iterate(fn func(entity *T) bool) error {
 	pattern := filepath.Join(r.basePath, fmt.Sprintf("%s-*.md", r.entityType))
 	matches, err := filepath.Glob(pattern)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list files: %w", err)
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+
+	if r.cache != nil {
+		r.iterateCached(matches, fn)
+		return nil
 	}
 
-	entities := make([]*T, 0, len(matches))
 	for _, filePath := range matches {
 		entity, err := r.parseEntityFromFile(filePath, false)
 		if err != nil {
 			// Log error but continue with other files
 			continue
 		}
-		entities = append(entities, entity)
+		if !fn(entity) {
+			break
+		}
 	}
 
-	return entities, nil
+	return nil
 }
 
-func (r *FilesystemRepository[T]) Update(entity *T) error {
+func (r *FilesystemRepository[T, P]) Update(entity *T) error {
 	if entity == nil {
 		return errors.New("entity cannot be nil")
 	}
@@ -166,19 +234,42 @@ func (r *FilesystemRepository[T]) Update(entity *T) error {
 		return fmt.Errorf("entity not found: %w", err)
 	}
 
+	// Remember how much history was already recorded to emit only new changes
+	previousChanges := 0
+	if r.events != nil {
+		if previous, err := r.parseEntityFromFile(oldFilePath, false); err == nil {
+			previousChanges = len(r.getEntityHistory(previous))
+		}
+	}
+
+	// Keep frontmatter fields the user added by hand
+	existing, err := os.ReadFile(oldFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	extra, err := unknownFields[T](existing)
+	if err != nil {
+		return fmt.Errorf("failed to parse existing file: %w", err)
+	}
+
+	// Rewriting private content that could not be decrypted would lose it
+	if !r.privacy.canOpen(existing) {
+		return fmt.Errorf("cannot update %s: %w", id, ErrPrivateLocked)
+	}
+
 	// Generate new filename (title might have changed)
 	title := r.getEntityTitle(entity)
 	newFilename := r.generateFileName(id, title)
 	newFilePath := filepath.Join(r.basePath, newFilename)
 
 	// Serialize entity
-	content, err := r.serializeEntity(entity)
+	content, err := r.serializeEntity(entity, existing, extra...)
 	if err != nil {
 		return fmt.Errorf("failed to serialize entity: %w", err)
 	}
 
 	// Write to file
-	if err := os.WriteFile(newFilePath, content, 0644); err != nil {
+	if err := writeFileAtomic(newFilePath, content); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
@@ -194,10 +285,16 @@ func (r *FilesystemRepository[T]) Update(entity *T) error {
 	// Auto-commit if enabled
 	r.autoCommit("update", newFilePath, string(id), title)
 
+	if history := r.getEntityHistory(entity); len(history) > previousChanges {
+		for _, change := range history[previousChanges:] {
+			r.emit(events.ForChange(r.entityType, id, title, change))
+		}
+	}
+
 	return nil
 }
 
-func (r *FilesystemRepository[T]) Delete(id core.EntityID) error {
+func (r *FilesystemRepository[T, P]) Delete(id core.EntityID) error {
 	if id == "" {
 		return errors.New("id cannot be empty")
 	}
@@ -222,45 +319,44 @@ func (r *FilesystemRepository[T]) Delete(id core.EntityID) error {
 	// Auto-commit if enabled
 	r.autoCommit("delete", filePath, string(id), title)
 
+	r.emit(events.Event{Type: events.EntityDeleted, EntityID: id, Title: title})
+
 	return nil
 }
 
-func (r *FilesystemRepository[T]) Search(query string) ([]*T, error) {
+func (r *FilesystemRepository[T, P]) Search(query string) ([]*T, error) {
 	if query == "" {
 		return r.GetAll()
 	}
 
-	allEntities, err := r.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	queryLower := strings.ToLower(query)
 	var results []*T
 
-	for _, entity := range allEntities {
+	err := r.Iterate(func(entity *T) bool {
 		// Search in title and tags
 		title := strings.ToLower(r.getEntityTitle(entity))
 		if strings.Contains(title, queryLower) {
 			results = append(results, entity)
-			continue
+			return true
 		}
 
 		// Search in tags if entity has them
-		if tags := r.getEntityTags(entity); len(tags) > 0 {
-			for _, tag := range tags {
-				if strings.Contains(strings.ToLower(tag), queryLower) {
-					results = append(results, entity)
-					break
-				}
+		for _, tag := range r.getEntityTags(entity) {
+			if strings.Contains(strings.ToLower(tag), queryLower) {
+				results = append(results, entity)
+				break
 			}
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
-func (r *FilesystemRepository[T]) Exists(id core.EntityID) (bool, error) {
+func (r *FilesystemRepository[T, P]) Exists(id core.EntityID) (bool, error) {
 	if id == "" {
 		return false, errors.New("id cannot be empty")
 	}
@@ -274,7 +370,7 @@ func (r *FilesystemRepository[T]) Exists(id core.EntityID) (bool, error) {
 	return len(matches) > 0, nil
 }
 
-func (r *FilesystemRepository[T]) findFileByID(id core.EntityID) (string, error) {
+func (r *FilesystemRepository[T, P]) findFileByID(id core.EntityID) (string, error) {
 	// Pattern matches: {id}-{slug}.md (e.g., "skill-001-python.md")
 	pattern := filepath.Join(r.basePath, fmt.Sprintf("%s-*.md", id))
 	matches, err := filepath.Glob(pattern)
@@ -293,11 +389,21 @@ func (r *FilesystemRepository[T]) findFileByID(id core.EntityID) (string, error)
 	return matches[0], nil
 }
 
-func (r *FilesystemRepository[T]) parseEntityFromFile(filePath string, includeBody bool) (*T, error) {
+func (r *FilesystemRepository[T, P]) parseEntityFromFile(filePath string, includeBody bool) (*T, error) {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
+	return r.parseEntity(content, includeBody)
+}
+
+// parseEntity parses the markdown content of an entity file
+func (r *FilesystemRepository[T, P]) parseEntity(content []byte, includeBody bool) (*T, error) {
+	// Decrypt private content
+	content, err := r.privacy.openPrivate(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt private content: %w", err)
+	}
 
 	frontmatter, body, err := ParseFrontmatter(content)
 	if err != nil {
@@ -323,23 +429,29 @@ func (r *FilesystemRepository[T]) parseEntityFromFile(filePath string, includeBo
 	return &entity, nil
 }
 
-func (r *FilesystemRepository[T]) serializeEntity(entity *T) ([]byte, error) {
+// serializeEntity renders the entity as markdown, appending the extra
+// key/value frontmatter nodes after its own fields, and encrypts its private
+// content. previous is the file being replaced, if any.
+func (r *FilesystemRepository[T, P]) serializeEntity(entity *T, previous []byte, extra ...*yaml.Node) ([]byte, error) {
 	// Extract body if present
 	body := r.getEntityBody(entity)
 
 	// Serialize entity to YAML frontmatter
-	content, err := SerializeFrontmatter(entity, body)
+	content, err := serializeFrontmatterWithExtra(entity, extra, body)
 	if err != nil {
 		return nil, err
 	}
 
-	return content, nil
+	if r.privacyErr != nil {
+		return nil, r.privacyErr
+	}
+	return r.privacy.sealPrivate(r.entityType, content, previous)
 }
 
 // generateFileName creates a filename for an entity.
 // Format: {id}-{slug}.md (e.g., "skill-001-python.md")
 // Note: The ID already contains the entity type prefix (e.g., "skill-001")
-func (r *FilesystemRepository[T]) generateFileName(id core.EntityID, title string) string {
+func (r *FilesystemRepository[T, P]) generateFileName(id core.EntityID, title string) string {
 	slug := slugify(title)
 	if slug == "" {
 		slug = "untitled"
@@ -347,18 +459,8 @@ func (r *FilesystemRepository[T]) generateFileName(id core.EntityID, title strin
 	return fmt.Sprintf("%s-%s.md", id, slug)
 }
 
-func (r *FilesystemRepository[T]) getEntityID(entity *T) (core.EntityID, error) {
-	v := reflect.ValueOf(entity).Elem()
-	idField := v.FieldByName("ID")
-	if !idField.IsValid() {
-		return "", errors.New("entity does not have an ID field")
-	}
-
-	id, ok := idField.Interface().(core.EntityID)
-	if !ok {
-		return "", errors.New("ID field is not of type EntityID")
-	}
-
+func (r *FilesystemRepository[T, P]) getEntityID(entity *T) (core.EntityID, error) {
+	id := P(entity).GetID()
 	if id == "" {
 		return "", errors.New("entity ID is empty")
 	}
@@ -366,66 +468,53 @@ func (r *FilesystemRepository[T]) getEntityID(entity *T) (core.EntityID, error)
 	return id, nil
 }
 
-func (r *FilesystemRepository[T]) getEntityTitle(entity *T) string {
-	v := reflect.ValueOf(entity).Elem()
-
-	// First try Title field
-	titleField := v.FieldByName("Title")
-	if titleField.IsValid() {
-		if title, ok := titleField.Interface().(string); ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to Date field for entities like ProgressLog
-	dateField := v.FieldByName("Date")
-	if dateField.IsValid() {
-		if date, ok := dateField.Interface().(time.Time); ok && !date.IsZero() {
-			return date.Format("2006-01-02")
-		}
-	}
+func (r *FilesystemRepository[T, P]) getEntityTitle(entity *T) string {
+	return P(entity).GetTitle()
+}
 
-	return ""
+func (r *FilesystemRepository[T, P]) getEntityBody(entity *T) string {
+	return P(entity).GetBody()
 }
 
-func (r *FilesystemRepository[T]) getEntityBody(entity *T) string {
-	v := reflect.ValueOf(entity).Elem()
-	bodyField := v.FieldByName("Body")
-	if !bodyField.IsValid() {
-		return ""
-	}
+func (r *FilesystemRepository[T, P]) setEntityBody(entity *T, body string) {
+	P(entity).SetBody(body)
+}
 
-	body, ok := bodyField.Interface().(string)
-	if !ok {
-		return ""
+func (r *FilesystemRepository[T, P]) getEntityHistory(entity *T) core.History {
+	if tracked, ok := any(P(entity)).(core.Tracked); ok {
+		return tracked.GetHistory()
 	}
-
-	return body
+	return nil
 }
 
-func (r *FilesystemRepository[T]) setEntityBody(entity *T, body string) {
-	v := reflect.ValueOf(entity).Elem()
-	bodyField := v.FieldByName("Body")
-	if !bodyField.IsValid() || !bodyField.CanSet() {
-		return
+func (r *FilesystemRepository[T, P]) getEntityTags(entity *T) []string {
+	if tagged, ok := any(P(entity)).(core.Tagged); ok {
+		return tagged.GetTags()
 	}
-
-	bodyField.SetString(body)
+	return nil
 }
 
-func (r *FilesystemRepository[T]) getEntityTags(entity *T) []string {
-	v := reflect.ValueOf(entity).Elem()
-	tagsField := v.FieldByName("Tags")
-	if !tagsField.IsValid() {
-		return nil
+// writeFileAtomic writes a file through a temporary file in the same
+// directory and renames it into place, so an interrupted write never leaves a
+// half-written entity behind
+func writeFileAtomic(path string, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
 	}
+	defer os.Remove(tmp.Name())
 
-	tags, ok := tagsField.Interface().([]string)
-	if !ok {
-		return nil
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
 	}
-
-	return tags
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
 }
 
 func slugify(s string) string {
@@ -456,9 +545,23 @@ func slugify(s string) string {
 	return s
 }
 
+// emit appends an event to the event log if one is set.
+// Like auto-commit, failures never fail the operation.
+func (r *FilesystemRepository[T, P]) emit(event events.Event) {
+	if r.events == nil {
+		return
+	}
+
+	if event.EntityType == "" {
+		event.EntityType = r.entityType
+	}
+
+	_ = r.events.Append(event)
+}
+
 // autoCommit commits a file change to git if auto-commit is enabled.
 // It handles errors gracefully and logs them without failing the operation.
-func (r *FilesystemRepository[T]) autoCommit(operation, filePath, id, title string) {
+func (r *FilesystemRepository[T, P]) autoCommit(operation, filePath, id, title string) {
 	// Skip if no config
 	if r.config == nil {
 		return
@@ -496,44 +599,31 @@ func (r *FilesystemRepository[T]) autoCommit(operation, filePath, id, title stri
 	// Generate commit message from template
 	message := r.generateCommitMessage(operation, id, title)
 
+	ctx := r.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	// Commit the file
-	if err := git.CommitFile(repoRoot, relPath, message); err != nil {
+	if err := git.CommitWithOptions(ctx, repoRoot, message, []string{relPath}, r.config.CommitOptions()); err != nil {
 		// Log error but don't fail the operation
 		// In a production environment, this might log to a file or stderr
 		_ = err
 	}
 }
 
-// generateCommitMessage generates a commit message from the template or a default format.
-func (r *FilesystemRepository[T]) generateCommitMessage(operation, id, title string) string {
-	// Convert operation to action word
-	var action string
-	switch operation {
-	case "create":
-		action = "Add"
-	case "update":
-		action = "Update"
-	case "delete":
-		action = "Delete"
-	default:
-		action = "Modify"
-	}
-
-	if r.config != nil && r.config.Git.CommitMessageTemplate != "" {
-		// Use template - replace placeholders (supports both {{.Placeholder}} and {{placeholder}})
-		msg := r.config.Git.CommitMessageTemplate
-		msg = strings.ReplaceAll(msg, "{{.Action}}", action)
-		msg = strings.ReplaceAll(msg, "{{.EntityType}}", r.entityType)
-		msg = strings.ReplaceAll(msg, "{{.ID}}", id)
-		msg = strings.ReplaceAll(msg, "{{.Title}}", title)
-		// Also support lowercase versions for backwards compatibility
-		msg = strings.ReplaceAll(msg, "{{operation}}", operation)
-		msg = strings.ReplaceAll(msg, "{{entityType}}", r.entityType)
-		msg = strings.ReplaceAll(msg, "{{id}}", id)
-		msg = strings.ReplaceAll(msg, "{{title}}", title)
-		return msg
-	}
-
-	// Default format
-	return fmt.Sprintf("%s %s: %s (%s)", action, r.entityType, title, id)
+// generateCommitMessage fills the configured commit message template
+func (r *FilesystemRepository[T, P]) generateCommitMessage(operation, id, title string) string {
+	var git GitConfig
+	if r.config != nil {
+		git = r.config.Git
+	}
+
+	return git.CommitMessage(CommitMessage{
+		Operation:  operation,
+		EntityType: r.entityType,
+		ID:         id,
+		Title:      title,
+		Count:      1,
+	})
 }
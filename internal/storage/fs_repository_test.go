@@ -1,12 +1,15 @@
 package storage
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/git"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -190,6 +193,58 @@ func TestFilesystemRepository_GetAll(t *testing.T) {
 	})
 }
 
+func TestFilesystemRepository_Iterate(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewFilesystemRepository[core.Skill](tmpDir, "skill")
+
+	python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+	python.Body = "Body is not loaded"
+	goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelAdvanced)
+	docker, _ := core.NewSkill("skill-003", "Docker", "devops", core.LevelIntermediate)
+	repo.Create(python)
+	repo.Create(goSkill)
+	repo.Create(docker)
+
+	t.Run("visits every entity without body", func(t *testing.T) {
+		var titles []string
+		err := repo.Iterate(func(skill *core.Skill) bool {
+			titles = append(titles, skill.Title)
+			assert.Empty(t, skill.Body)
+			return true
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Python", "Go", "Docker"}, titles)
+	})
+
+	t.Run("stops when fn returns false", func(t *testing.T) {
+		visited := 0
+		err := repo.Iterate(func(skill *core.Skill) bool {
+			visited++
+			return visited < 2
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, visited)
+	})
+
+	t.Run("skips files that fail to parse", func(t *testing.T) {
+		dir := t.TempDir()
+		repo, _ := NewFilesystemRepository[core.Skill](dir, "skill")
+		repo.Create(python)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "skill-002-broken.md"), []byte("---\n: [\n---\n"), 0644))
+
+		visited := 0
+		err := repo.Iterate(func(skill *core.Skill) bool {
+			visited++
+			return true
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, visited)
+	})
+}
+
 func TestFilesystemRepository_Update(t *testing.T) {
 	t.Run("updates existing entity", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -231,6 +286,30 @@ func TestFilesystemRepository_Update(t *testing.T) {
 		assert.NotContains(t, files[0].Name(), "python.md")
 	})
 
+	t.Run("preserves fields added by hand", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		repo, _ := NewFilesystemRepository[core.Skill](tmpDir, "skill")
+
+		skill, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+		skill.AddTag("backend")
+		repo.Create(skill)
+
+		files, _ := os.ReadDir(tmpDir)
+		filePath := filepath.Join(tmpDir, files[0].Name())
+		content, _ := os.ReadFile(filePath)
+		edited := strings.Replace(string(content), "---\n", "---\nrating: 5\n", 1)
+		require.NoError(t, os.WriteFile(filePath, []byte(edited), 0644))
+
+		skill.Level = core.LevelAdvanced
+		skill.Tags = nil
+		require.NoError(t, repo.Update(skill))
+
+		content, _ = os.ReadFile(filePath)
+		assert.Contains(t, string(content), "rating: 5")
+		assert.NotContains(t, string(content), "backend")
+		assert.True(t, strings.HasPrefix(string(content), "---\nid: skill-001\n"))
+	})
+
 	t.Run("fails with non-existent entity", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		repo, _ := NewFilesystemRepository[core.Skill](tmpDir, "skill")
@@ -443,6 +522,28 @@ func TestSlugify(t *testing.T) {
 	}
 }
 
+func TestWriteFileAtomic(t *testing.T) {
+	t.Run("replaces the file and leaves no temporary files", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		path := filepath.Join(tmpDir, "skill-001-go.md")
+		require.NoError(t, os.WriteFile(path, []byte("old"), 0644))
+
+		require.NoError(t, writeFileAtomic(path, []byte("new")))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(content))
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+		entries, err := os.ReadDir(tmpDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
+
 func TestFilesystemRepository_WithGoal(t *testing.T) {
 	t.Run("works with Goal entity", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -463,3 +564,46 @@ func TestFilesystemRepository_WithGoal(t *testing.T) {
 		assert.Contains(t, retrieved.Body, "machine learning")
 	})
 }
+
+func TestFilesystemRepository_AutoCommit(t *testing.T) {
+	setup := func(t *testing.T) (string, *FilesystemRepository[core.Skill, *core.Skill]) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, git.InitRepo(tmpDir))
+
+		config := &Config{Git: GitConfig{AutoCommit: true}}
+		config.User.Name = "Test User"
+		config.User.Email = "test@example.com"
+
+		repo, err := NewFilesystemRepositoryWithConfig[core.Skill](filepath.Join(tmpDir, "skills"), "skill", config)
+		require.NoError(t, err)
+		return tmpDir, repo
+	}
+
+	t.Run("commits created entities", func(t *testing.T) {
+		tmpDir, repo := setup(t)
+		skill, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+
+		require.NoError(t, repo.Create(skill))
+
+		logs, err := git.Log(tmpDir, 1)
+		require.NoError(t, err)
+		assert.Len(t, logs, 1)
+	})
+
+	t.Run("skips the commit once the context is cancelled", func(t *testing.T) {
+		tmpDir, repo := setup(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		repo.SetContext(ctx)
+		skill, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+
+		require.NoError(t, repo.Create(skill))
+		exists, err := repo.Exists("skill-001")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		logs, _ := git.Log(tmpDir, 1)
+		assert.Empty(t, logs)
+	})
+}
@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type GoalRepository struct {
@@ -21,13 +24,47 @@ func NewGoalRepository(basePath string) (*GoalRepository, error) {
 	}, nil
 }
 
+// NewGoalSQLiteRepository creates a goal repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewGoalSQLiteRepository(db *sql.DB) (*GoalRepository, error) {
+	repo, err := NewSQLiteRepository[core.Goal](db, "goal")
+	if err != nil {
+		return nil, err
+	}
+
+	return &GoalRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *GoalRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Goal]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Goal, *core.Goal]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *GoalRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Goal, *core.Goal]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *GoalRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *GoalRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Goal, *core.Goal]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *GoalRepository) Create(goal *core.Goal) error {
 	return r.repo.Create(goal)
 }
@@ -44,6 +81,10 @@ func (r *GoalRepository) GetAll() ([]*core.Goal, error) {
 	return r.repo.GetAll()
 }
 
+func (r *GoalRepository) Iterate(fn func(*core.Goal) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *GoalRepository) Update(goal *core.Goal) error {
 	return r.repo.Update(goal)
 }
@@ -61,32 +102,30 @@ func (r *GoalRepository) Exists(id core.EntityID) (bool, error) {
 }
 
 func (r *GoalRepository) FindByStatus(status core.Status) ([]*core.Goal, error) {
-	allGoals, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Goal
-	for _, goal := range allGoals {
+	err := r.repo.Iterate(func(goal *core.Goal) bool {
 		if goal.Status == status {
 			results = append(results, goal)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *GoalRepository) FindByPriority(priority core.Priority) ([]*core.Goal, error) {
-	allGoals, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Goal
-	for _, goal := range allGoals {
+	err := r.repo.Iterate(func(goal *core.Goal) bool {
 		if goal.Priority == priority {
 			results = append(results, goal)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
@@ -97,19 +136,18 @@ func (r *GoalRepository) FindActive() ([]*core.Goal, error) {
 }
 
 func (r *GoalRepository) FindByTargetDateRange(start, end time.Time) ([]*core.Goal, error) {
-	allGoals, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Goal
-	for _, goal := range allGoals {
+	err := r.repo.Iterate(func(goal *core.Goal) bool {
 		if goal.TargetDate != nil {
 			if (goal.TargetDate.Equal(start) || goal.TargetDate.After(start)) &&
 				(goal.TargetDate.Equal(end) || goal.TargetDate.Before(end)) {
 				results = append(results, goal)
 			}
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
@@ -1,10 +1,12 @@
 package storage
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -194,3 +196,39 @@ func TestGoalRepository_FindByTargetDateRange(t *testing.T) {
 		assert.Equal(t, "Q2 Goal", results[0].Title)
 	})
 }
+
+func TestGoalRepository_EventLog(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewGoalRepository(filepath.Join(tmpDir, "goals"))
+	log := events.NewLog(filepath.Join(tmpDir, ".growth", "events.jsonl"))
+	repo.SetEventLog(log)
+
+	goal, _ := core.NewGoal("goal-001", "Become Senior Engineer", core.PriorityHigh)
+	require.NoError(t, repo.Create(goal))
+
+	goal.UpdatePriority(core.PriorityLow)
+	goal.UpdateStatus(core.StatusCompleted)
+	require.NoError(t, repo.Update(goal))
+
+	// Saving again without changes must not repeat earlier events
+	require.NoError(t, repo.Update(goal))
+	require.NoError(t, repo.Delete("goal-001"))
+
+	recorded, err := log.Read()
+	require.NoError(t, err)
+	require.Len(t, recorded, 4)
+
+	assert.Equal(t, events.EntityCreated, recorded[0].Type)
+	assert.Equal(t, "goal", recorded[0].EntityType)
+	assert.Equal(t, core.EntityID("goal-001"), recorded[0].EntityID)
+	assert.Equal(t, "Become Senior Engineer", recorded[0].Title)
+
+	assert.Equal(t, events.FieldChanged, recorded[1].Type)
+	assert.Equal(t, "priority", recorded[1].Field)
+	assert.Equal(t, "low", recorded[1].To)
+
+	assert.Equal(t, events.GoalCompleted, recorded[2].Type)
+	assert.Equal(t, "active", recorded[2].From)
+
+	assert.Equal(t, events.EntityDeleted, recorded[3].Type)
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type MentorSessionRepository struct {
+	repo Repository[core.MentorSession]
+}
+
+func NewMentorSessionRepository(basePath string) (*MentorSessionRepository, error) {
+	repo, err := NewFilesystemRepository[core.MentorSession](basePath, "mentor")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MentorSessionRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewMentorSessionSQLiteRepository creates a mentor session repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewMentorSessionSQLiteRepository(db *sql.DB) (*MentorSessionRepository, error) {
+	repo, err := NewSQLiteRepository[core.MentorSession](db, "mentor")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MentorSessionRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *MentorSessionRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.MentorSession, *core.MentorSession]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *MentorSessionRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.MentorSession, *core.MentorSession]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *MentorSessionRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *MentorSessionRepository) Create(session *core.MentorSession) error {
+	return r.repo.Create(session)
+}
+
+func (r *MentorSessionRepository) GetByID(id core.EntityID) (*core.MentorSession, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *MentorSessionRepository) GetByIDWithBody(id core.EntityID) (*core.MentorSession, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *MentorSessionRepository) GetAll() ([]*core.MentorSession, error) {
+	return r.repo.GetAll()
+}
+
+func (r *MentorSessionRepository) Iterate(fn func(*core.MentorSession) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *MentorSessionRepository) Update(session *core.MentorSession) error {
+	return r.repo.Update(session)
+}
+
+func (r *MentorSessionRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *MentorSessionRepository) Search(query string) ([]*core.MentorSession, error) {
+	return r.repo.Search(query)
+}
+
+func (r *MentorSessionRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindWith returns sessions with the given person, matched regardless of case, newest first.
+func (r *MentorSessionRepository) FindWith(person string) ([]*core.MentorSession, error) {
+	allSessions, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.MentorSession
+	for _, session := range allSessions {
+		if strings.EqualFold(session.With, strings.TrimSpace(person)) {
+			results = append(results, session)
+		}
+	}
+
+	sortMentorSessionsByDate(results)
+
+	return results, nil
+}
+
+// FindSince returns sessions dated at or after the given time, newest first.
+func (r *MentorSessionRepository) FindSince(since time.Time) ([]*core.MentorSession, error) {
+	allSessions, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.MentorSession
+	for _, session := range allSessions {
+		if !session.Date.Before(since) {
+			results = append(results, session)
+		}
+	}
+
+	sortMentorSessionsByDate(results)
+
+	return results, nil
+}
+
+func sortMentorSessionsByDate(sessions []*core.MentorSession) {
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Date.After(sessions[j].Date)
+	})
+}
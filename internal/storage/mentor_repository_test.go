@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMentorSessionRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewMentorSessionRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewMentorSessionRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestMentorSessionRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewMentorSessionRepository(tmpDir)
+
+	t.Run("creates and retrieves session", func(t *testing.T) {
+		session, _ := core.NewMentorSession("mentor-001", "Alice", core.RoleMentee, time.Now())
+		session.SetHours(1)
+		session.AddSkill("skill-003")
+		session.AddActionItem("milestone-004")
+		session.Body = "Talked about system design interviews."
+
+		err := repo.Create(session)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("mentor-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Mentored by Alice", retrieved.Title)
+		assert.Equal(t, core.RoleMentee, retrieved.Role)
+		assert.Equal(t, 1.0, retrieved.Hours)
+		assert.Equal(t, []core.EntityID{"skill-003"}, retrieved.Skills)
+		assert.Equal(t, []core.EntityID{"milestone-004"}, retrieved.ActionItems)
+		assert.Contains(t, retrieved.Body, "system design")
+	})
+
+	t.Run("deletes session", func(t *testing.T) {
+		err := repo.Delete("mentor-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("mentor-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestMentorSessionRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewMentorSessionRepository(tmpDir)
+
+	now := time.Now()
+	older, _ := core.NewMentorSession("mentor-001", "Alice", core.RoleMentee, now.AddDate(0, 0, -10))
+	newer, _ := core.NewMentorSession("mentor-002", "alice", core.RoleMentee, now)
+	other, _ := core.NewMentorSession("mentor-003", "Bo", core.RoleMentor, now.AddDate(0, 0, -3))
+	require.NoError(t, repo.Create(older))
+	require.NoError(t, repo.Create(newer))
+	require.NoError(t, repo.Create(other))
+
+	t.Run("finds sessions with a person newest first", func(t *testing.T) {
+		results, err := repo.FindWith("ALICE")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, core.EntityID("mentor-002"), results[0].ID)
+	})
+
+	t.Run("finds sessions since date", func(t *testing.T) {
+		results, err := repo.FindSince(now.AddDate(0, 0, -5))
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, core.EntityID("mentor-002"), results[0].ID)
+	})
+}
@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type MilestoneRepository struct {
@@ -19,13 +23,47 @@ func NewMilestoneRepository(basePath string) (*MilestoneRepository, error) {
 	}, nil
 }
 
+// NewMilestoneSQLiteRepository creates a milestone repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewMilestoneSQLiteRepository(db *sql.DB) (*MilestoneRepository, error) {
+	repo, err := NewSQLiteRepository[core.Milestone](db, "milestone")
+	if err != nil {
+		return nil, err
+	}
+
+	return &MilestoneRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *MilestoneRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Milestone]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Milestone, *core.Milestone]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *MilestoneRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Milestone, *core.Milestone]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *MilestoneRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *MilestoneRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Milestone, *core.Milestone]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *MilestoneRepository) Create(milestone *core.Milestone) error {
 	return r.repo.Create(milestone)
 }
@@ -42,6 +80,10 @@ func (r *MilestoneRepository) GetAll() ([]*core.Milestone, error) {
 	return r.repo.GetAll()
 }
 
+func (r *MilestoneRepository) Iterate(fn func(*core.Milestone) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *MilestoneRepository) Update(milestone *core.Milestone) error {
 	return r.repo.Update(milestone)
 }
@@ -59,48 +101,60 @@ func (r *MilestoneRepository) Exists(id core.EntityID) (bool, error) {
 }
 
 func (r *MilestoneRepository) FindByReferenceID(refType core.ReferenceType, refID core.EntityID) ([]*core.Milestone, error) {
-	allMilestones, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Milestone
-	for _, milestone := range allMilestones {
+	err := r.repo.Iterate(func(milestone *core.Milestone) bool {
 		if milestone.ReferenceType == refType && milestone.ReferenceID == refID {
 			results = append(results, milestone)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *MilestoneRepository) FindByStatus(status core.Status) ([]*core.Milestone, error) {
-	allMilestones, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Milestone
-	for _, milestone := range allMilestones {
+	err := r.repo.Iterate(func(milestone *core.Milestone) bool {
 		if milestone.Status == status {
 			results = append(results, milestone)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *MilestoneRepository) FindByType(milestoneType core.MilestoneType) ([]*core.Milestone, error) {
-	allMilestones, err := r.repo.GetAll()
+	var results []*core.Milestone
+	err := r.repo.Iterate(func(milestone *core.Milestone) bool {
+		if milestone.Type == milestoneType {
+			results = append(results, milestone)
+		}
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return results, nil
+}
+
+func (r *MilestoneRepository) FindBySeriesID(seriesID core.EntityID) ([]*core.Milestone, error) {
 	var results []*core.Milestone
-	for _, milestone := range allMilestones {
-		if milestone.Type == milestoneType {
+	err := r.repo.Iterate(func(milestone *core.Milestone) bool {
+		if milestone.SeriesID == seriesID {
 			results = append(results, milestone)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	"github.com/illenko/growth.md/internal/core"
 	"github.com/stretchr/testify/assert"
@@ -178,3 +179,28 @@ func TestMilestoneRepository_FindByType(t *testing.T) {
 		assert.Equal(t, "Skill Level", results[0].Title)
 	})
 }
+
+func TestMilestoneRepository_FindBySeriesID(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewMilestoneRepository(tmpDir)
+
+	first, _ := core.NewMilestone("milestone-001", "Weekly post", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+	first.SetRecurrence(core.RecurrenceWeekly, time.Now().AddDate(0, 0, -7))
+	second, _ := first.NextInstance("milestone-002", time.Now())
+	other, _ := core.NewMilestone("milestone-003", "One-off", core.MilestoneGoalLevel, core.ReferenceGoal, "goal-001")
+
+	repo.Create(first)
+	repo.Create(second)
+	repo.Create(other)
+
+	t.Run("finds all instances of a series", func(t *testing.T) {
+		results, err := repo.FindBySeriesID("milestone-001")
+
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+		for _, milestone := range results {
+			assert.Equal(t, core.RecurrenceWeekly, milestone.Recurrence)
+			assert.NotNil(t, milestone.PeriodStart)
+		}
+	})
+}
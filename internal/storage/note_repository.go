@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type NoteRepository struct {
+	repo Repository[core.Note]
+}
+
+func NewNoteRepository(basePath string) (*NoteRepository, error) {
+	repo, err := NewFilesystemRepository[core.Note](basePath, "note")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NoteRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewNoteSQLiteRepository creates a note repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewNoteSQLiteRepository(db *sql.DB) (*NoteRepository, error) {
+	repo, err := NewSQLiteRepository[core.Note](db, "note")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NoteRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *NoteRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Note, *core.Note]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *NoteRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Note, *core.Note]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *NoteRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *NoteRepository) Create(note *core.Note) error {
+	return r.repo.Create(note)
+}
+
+func (r *NoteRepository) GetByID(id core.EntityID) (*core.Note, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *NoteRepository) GetByIDWithBody(id core.EntityID) (*core.Note, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *NoteRepository) GetAll() ([]*core.Note, error) {
+	return r.repo.GetAll()
+}
+
+func (r *NoteRepository) Iterate(fn func(*core.Note) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *NoteRepository) Update(note *core.Note) error {
+	return r.repo.Update(note)
+}
+
+func (r *NoteRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *NoteRepository) Search(query string) ([]*core.Note, error) {
+	return r.repo.Search(query)
+}
+
+func (r *NoteRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindByReference returns notes attached to the given entity, newest first.
+func (r *NoteRepository) FindByReference(id core.EntityID) ([]*core.Note, error) {
+	allNotes, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Note
+	for _, note := range allNotes {
+		if note.HasReference(id) {
+			results = append(results, note)
+		}
+	}
+
+	sortNotesByDate(results)
+
+	return results, nil
+}
+
+// FindSince returns notes dated at or after the given time, newest first.
+// Bodies are included so notes can be passed on as context.
+func (r *NoteRepository) FindSince(since time.Time) ([]*core.Note, error) {
+	allNotes, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Note
+	for _, note := range allNotes {
+		if note.Date.Before(since) {
+			continue
+		}
+		withBody, err := r.repo.GetByIDWithBody(note.ID)
+		if err != nil {
+			continue
+		}
+		results = append(results, withBody)
+	}
+
+	sortNotesByDate(results)
+
+	return results, nil
+}
+
+func sortNotesByDate(notes []*core.Note) {
+	sort.Slice(notes, func(i, j int) bool {
+		return notes[i].Date.After(notes[j].Date)
+	})
+}
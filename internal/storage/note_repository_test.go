@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNoteRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewNoteRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewNoteRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNoteRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewNoteRepository(tmpDir)
+
+	t.Run("creates and retrieves note", func(t *testing.T) {
+		note, _ := core.NewNote("note-001", "TIL about epoll\nIt scales better than select.", time.Now())
+		note.AddReference("skill-002")
+
+		err := repo.Create(note)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("note-001")
+		require.NoError(t, err)
+		assert.Equal(t, "TIL about epoll", retrieved.Title)
+		assert.Contains(t, retrieved.Body, "scales better")
+		assert.Equal(t, []core.EntityID{"skill-002"}, retrieved.References)
+	})
+
+	t.Run("deletes note", func(t *testing.T) {
+		err := repo.Delete("note-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("note-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestNoteRepository_FindByReference(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewNoteRepository(tmpDir)
+
+	now := time.Now()
+	older, _ := core.NewNote("note-001", "Older note", now.AddDate(0, 0, -2))
+	older.AddReference("skill-002")
+	newer, _ := core.NewNote("note-002", "Newer note", now)
+	newer.AddReference("skill-002")
+	newer.AddReference("goal-001")
+	other, _ := core.NewNote("note-003", "Other note", now)
+	other.AddReference("skill-003")
+
+	repo.Create(older)
+	repo.Create(newer)
+	repo.Create(other)
+
+	t.Run("finds notes for entity newest first", func(t *testing.T) {
+		results, err := repo.FindByReference("skill-002")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Newer note", results[0].Title)
+	})
+
+	t.Run("returns empty for unreferenced entity", func(t *testing.T) {
+		results, err := repo.FindByReference("skill-999")
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("finds notes since date with body", func(t *testing.T) {
+		results, err := repo.FindSince(now.AddDate(0, 0, -1))
+
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+		for _, note := range results {
+			assert.NotEmpty(t, note.Body)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type ObjectiveRepository struct {
+	repo Repository[core.Objective]
+}
+
+func NewObjectiveRepository(basePath string) (*ObjectiveRepository, error) {
+	repo, err := NewFilesystemRepository[core.Objective](basePath, "objective")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectiveRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewObjectiveSQLiteRepository creates a objective repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewObjectiveSQLiteRepository(db *sql.DB) (*ObjectiveRepository, error) {
+	repo, err := NewSQLiteRepository[core.Objective](db, "objective")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectiveRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *ObjectiveRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Objective, *core.Objective]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *ObjectiveRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Objective, *core.Objective]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *ObjectiveRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *ObjectiveRepository) Create(objective *core.Objective) error {
+	return r.repo.Create(objective)
+}
+
+func (r *ObjectiveRepository) GetByID(id core.EntityID) (*core.Objective, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *ObjectiveRepository) GetByIDWithBody(id core.EntityID) (*core.Objective, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *ObjectiveRepository) GetAll() ([]*core.Objective, error) {
+	return r.repo.GetAll()
+}
+
+func (r *ObjectiveRepository) Iterate(fn func(*core.Objective) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *ObjectiveRepository) Update(objective *core.Objective) error {
+	return r.repo.Update(objective)
+}
+
+func (r *ObjectiveRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *ObjectiveRepository) Search(query string) ([]*core.Objective, error) {
+	return r.repo.Search(query)
+}
+
+func (r *ObjectiveRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindByQuarter returns the objectives for a quarter, e.g. 2025-Q1
+func (r *ObjectiveRepository) FindByQuarter(quarter string) ([]*core.Objective, error) {
+	var results []*core.Objective
+	err := r.repo.Iterate(func(objective *core.Objective) bool {
+		if objective.Quarter == quarter {
+			results = append(results, objective)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// FindByGoalID returns the objectives that have the goal as a key result
+func (r *ObjectiveRepository) FindByGoalID(goalID core.EntityID) ([]*core.Objective, error) {
+	var results []*core.Objective
+	err := r.repo.Iterate(func(objective *core.Objective) bool {
+		for _, kr := range objective.KeyResults {
+			if kr.GoalID == goalID {
+				results = append(results, objective)
+				break
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObjectiveRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewObjectiveRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewObjectiveRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestObjectiveRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewObjectiveRepository(tmpDir)
+
+	t.Run("creates and retrieves objective", func(t *testing.T) {
+		objective, _ := core.NewObjective("objective-001", "Grow as a backend engineer", "2025-Q1")
+		require.NoError(t, objective.AddKeyResult(core.KeyResult{GoalID: "goal-001", Metric: core.MetricMilestones, Target: 3, Description: "Ship 3 milestones"}))
+
+		err := repo.Create(objective)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByID("objective-001")
+		require.NoError(t, err)
+		assert.Equal(t, "2025-Q1", retrieved.Quarter)
+		require.Len(t, retrieved.KeyResults, 1)
+		assert.Equal(t, core.MetricMilestones, retrieved.KeyResults[0].Metric)
+		assert.Equal(t, 3.0, retrieved.KeyResults[0].Target)
+	})
+
+	t.Run("deletes objective", func(t *testing.T) {
+		err := repo.Delete("objective-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("objective-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestObjectiveRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewObjectiveRepository(tmpDir)
+
+	objective1, _ := core.NewObjective("objective-001", "Backend depth", "2025-Q1")
+	objective1.AddKeyResult(core.KeyResult{GoalID: "goal-001", Metric: core.MetricCompletion})
+	objective2, _ := core.NewObjective("objective-002", "Leadership", "2025-Q2")
+	objective2.AddKeyResult(core.KeyResult{GoalID: "goal-002", Metric: core.MetricCompletion})
+	require.NoError(t, repo.Create(objective1))
+	require.NoError(t, repo.Create(objective2))
+
+	t.Run("finds by quarter", func(t *testing.T) {
+		results, err := repo.FindByQuarter("2025-Q2")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "Leadership", results[0].Title)
+	})
+
+	t.Run("finds by goal", func(t *testing.T) {
+		results, err := repo.FindByGoalID("goal-001")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, core.EntityID("objective-001"), results[0].ID)
+	})
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type OutputRepository struct {
+	repo Repository[core.Output]
+}
+
+func NewOutputRepository(basePath string) (*OutputRepository, error) {
+	repo, err := NewFilesystemRepository[core.Output](basePath, "output")
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutputRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewOutputSQLiteRepository creates a output repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewOutputSQLiteRepository(db *sql.DB) (*OutputRepository, error) {
+	repo, err := NewSQLiteRepository[core.Output](db, "output")
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutputRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *OutputRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Output, *core.Output]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *OutputRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Output, *core.Output]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *OutputRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *OutputRepository) Create(output *core.Output) error {
+	return r.repo.Create(output)
+}
+
+func (r *OutputRepository) GetByID(id core.EntityID) (*core.Output, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *OutputRepository) GetByIDWithBody(id core.EntityID) (*core.Output, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *OutputRepository) GetAll() ([]*core.Output, error) {
+	return r.repo.GetAll()
+}
+
+func (r *OutputRepository) Iterate(fn func(*core.Output) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *OutputRepository) Update(output *core.Output) error {
+	return r.repo.Update(output)
+}
+
+func (r *OutputRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *OutputRepository) Search(query string) ([]*core.Output, error) {
+	return r.repo.Search(query)
+}
+
+func (r *OutputRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindByReference returns outputs linked to the given skill or goal, newest first.
+func (r *OutputRepository) FindByReference(id core.EntityID) ([]*core.Output, error) {
+	allOutputs, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Output
+	for _, output := range allOutputs {
+		if output.HasReference(id) {
+			results = append(results, output)
+		}
+	}
+
+	sortOutputsByDate(results)
+
+	return results, nil
+}
+
+// FindSince returns outputs dated at or after the given time, newest first.
+func (r *OutputRepository) FindSince(since time.Time) ([]*core.Output, error) {
+	allOutputs, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Output
+	for _, output := range allOutputs {
+		if !output.Date.Before(since) {
+			results = append(results, output)
+		}
+	}
+
+	sortOutputsByDate(results)
+
+	return results, nil
+}
+
+func sortOutputsByDate(outputs []*core.Output) {
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Date.After(outputs[j].Date)
+	})
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOutputRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewOutputRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewOutputRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestOutputRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewOutputRepository(tmpDir)
+
+	t.Run("creates and retrieves output", func(t *testing.T) {
+		output, _ := core.NewOutput("output-001", "Profiling Go services", core.OutputTalk, time.Now())
+		output.Venue = "GopherCon EU"
+		output.URL = "https://example.com/recording"
+		output.AddSkill("skill-001")
+		output.AddGoal("goal-001")
+		output.Body = "Questions about pprof labels."
+
+		err := repo.Create(output)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("output-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Profiling Go services", retrieved.Title)
+		assert.Equal(t, core.OutputTalk, retrieved.Type)
+		assert.Equal(t, "GopherCon EU", retrieved.Venue)
+		assert.Equal(t, []core.EntityID{"skill-001"}, retrieved.Skills)
+		assert.Equal(t, []core.EntityID{"goal-001"}, retrieved.Goals)
+		assert.Contains(t, retrieved.Body, "pprof labels")
+	})
+
+	t.Run("deletes output", func(t *testing.T) {
+		err := repo.Delete("output-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("output-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestOutputRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewOutputRepository(tmpDir)
+
+	now := time.Now()
+	older, _ := core.NewOutput("output-001", "Older post", core.OutputBlogPost, now.AddDate(0, 0, -10))
+	older.AddSkill("skill-001")
+	newer, _ := core.NewOutput("output-002", "Newer talk", core.OutputTalk, now)
+	newer.AddGoal("goal-001")
+	newer.AddSkill("skill-001")
+	require.NoError(t, repo.Create(older))
+	require.NoError(t, repo.Create(newer))
+
+	t.Run("finds outputs by skill newest first", func(t *testing.T) {
+		results, err := repo.FindByReference("skill-001")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Newer talk", results[0].Title)
+	})
+
+	t.Run("finds outputs by goal", func(t *testing.T) {
+		results, err := repo.FindByReference("goal-001")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+	})
+
+	t.Run("finds outputs since date", func(t *testing.T) {
+		results, err := repo.FindSince(now.AddDate(0, 0, -1))
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, core.EntityID("output-002"), results[0].ID)
+	})
+}
@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type PathRepository struct {
@@ -19,13 +23,47 @@ func NewPathRepository(basePath string) (*PathRepository, error) {
 	}, nil
 }
 
+// NewPathSQLiteRepository creates a path repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewPathSQLiteRepository(db *sql.DB) (*PathRepository, error) {
+	repo, err := NewSQLiteRepository[core.LearningPath](db, "path")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PathRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *PathRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.LearningPath]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.LearningPath, *core.LearningPath]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *PathRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.LearningPath, *core.LearningPath]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *PathRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *PathRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.LearningPath, *core.LearningPath]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *PathRepository) Create(path *core.LearningPath) error {
 	return r.repo.Create(path)
 }
@@ -42,6 +80,10 @@ func (r *PathRepository) GetAll() ([]*core.LearningPath, error) {
 	return r.repo.GetAll()
 }
 
+func (r *PathRepository) Iterate(fn func(*core.LearningPath) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *PathRepository) Update(path *core.LearningPath) error {
 	return r.repo.Update(path)
 }
@@ -59,32 +101,30 @@ func (r *PathRepository) Exists(id core.EntityID) (bool, error) {
 }
 
 func (r *PathRepository) FindByType(pathType core.PathType) ([]*core.LearningPath, error) {
-	allPaths, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.LearningPath
-	for _, path := range allPaths {
+	err := r.repo.Iterate(func(path *core.LearningPath) bool {
 		if path.Type == pathType {
 			results = append(results, path)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *PathRepository) FindByStatus(status core.Status) ([]*core.LearningPath, error) {
-	allPaths, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.LearningPath
-	for _, path := range allPaths {
+	err := r.repo.Iterate(func(path *core.LearningPath) bool {
 		if path.Status == status {
 			results = append(results, path)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
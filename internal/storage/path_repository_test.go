@@ -2,6 +2,7 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	"github.com/illenko/growth.md/internal/core"
 	"github.com/stretchr/testify/assert"
@@ -64,6 +65,26 @@ func TestPathRepository_CRUD(t *testing.T) {
 	})
 }
 
+func TestPathRepository_Provenance(t *testing.T) {
+	repo, _ := NewPathRepository(t.TempDir())
+
+	path, _ := core.NewLearningPath("path-001", "Kubernetes Operator", core.PathTypeAIGenerated)
+	path.Provenance = &core.Provenance{
+		Provider:    "openai",
+		Model:       "gpt-4o-mini",
+		Temperature: 0.7,
+		PromptHash:  "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		RespondedAt: time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC),
+	}
+	require.NoError(t, repo.Create(path))
+
+	retrieved, err := repo.GetByID("path-001")
+
+	require.NoError(t, err)
+	require.NotNil(t, retrieved.Provenance)
+	assert.Equal(t, *path.Provenance, *retrieved.Provenance)
+}
+
 func TestPathRepository_FindByType(t *testing.T) {
 	tmpDir := t.TempDir()
 	repo, _ := NewPathRepository(tmpDir)
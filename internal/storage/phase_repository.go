@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
 	"sort"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type PhaseRepository struct {
@@ -21,13 +24,40 @@ func NewPhaseRepository(basePath string) (*PhaseRepository, error) {
 	}, nil
 }
 
+// NewPhaseSQLiteRepository creates a phase repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewPhaseSQLiteRepository(db *sql.DB) (*PhaseRepository, error) {
+	repo, err := NewSQLiteRepository[core.Phase](db, "phase")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PhaseRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *PhaseRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Phase]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Phase, *core.Phase]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *PhaseRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Phase, *core.Phase]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *PhaseRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
 func (r *PhaseRepository) Create(phase *core.Phase) error {
 	return r.repo.Create(phase)
 }
@@ -44,6 +74,10 @@ func (r *PhaseRepository) GetAll() ([]*core.Phase, error) {
 	return r.repo.GetAll()
 }
 
+func (r *PhaseRepository) Iterate(fn func(*core.Phase) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *PhaseRepository) Update(phase *core.Phase) error {
 	return r.repo.Update(phase)
 }
@@ -0,0 +1,425 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"filippo.io/age/armor"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v3"
+)
+
+// Private content is encrypted with age in the markdown files, so a
+// repository can be pushed publicly. Two kinds of content are private:
+//
+//   - frontmatter fields listed in privacy.fields, stored as !private values
+//   - body sections between <!-- private --> and <!-- /private -->, stored as
+//     an encrypted <!-- private ... --> comment
+//
+// Reading decrypts them with the privacy.identity key; without it private
+// fields read as empty and sections as a placeholder.
+
+// privateTag marks an encrypted frontmatter value
+const privateTag = "!private"
+
+// lockedSection replaces a private section that cannot be decrypted
+const lockedSection = "[private section, encrypted]"
+
+var (
+	privateSection = regexp.MustCompile(`(?s)<!-- private -->\n?(.*?)\n?<!-- /private -->`)
+	sealedSection  = regexp.MustCompile(`(?s)<!-- private\n(-----BEGIN AGE ENCRYPTED FILE-----\n.*?-----END AGE ENCRYPTED FILE-----)\n?-->`)
+)
+
+// ErrPrivateLocked is returned when private content must be rewritten
+// without the key to decrypt it
+var ErrPrivateLocked = errors.New("it has private content and no key to decrypt it; set privacy.identity in the config")
+
+// privacyKeys encrypts and decrypts private content
+type privacyKeys struct {
+	recipients []age.Recipient
+	identities []age.Identity
+	fields     map[string]bool
+
+	// fingerprint identifies the keys and fields, so caches built with other
+	// keys are not reused
+	fingerprint string
+}
+
+// loadPrivacyKeys reads the keys the privacy config names. It returns nil
+// when privacy is not set up.
+func loadPrivacyKeys(config PrivacyConfig) (*privacyKeys, error) {
+	if len(config.Recipients) == 0 && config.Identity == "" {
+		return nil, nil
+	}
+
+	keys := &privacyKeys{fields: make(map[string]bool, len(config.Fields))}
+	for _, field := range config.Fields {
+		keys.fields[field] = true
+	}
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%q %q\n", config.Recipients, config.Fields)
+
+	for _, recipient := range config.Recipients {
+		parsed, err := parseRecipients(recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid privacy recipient %q: %w", recipient, err)
+		}
+		keys.recipients = append(keys.recipients, parsed...)
+	}
+
+	if config.Identity != "" {
+		identities, err := readIdentities(expandHome(config.Identity))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read privacy identity %s: %w", config.Identity, err)
+		}
+		keys.identities = identities
+		// The identity file itself, so a rotated key at the same path counts
+		if content, err := os.ReadFile(expandHome(config.Identity)); err == nil {
+			hash.Write(content)
+		}
+	}
+	keys.fingerprint = hex.EncodeToString(hash.Sum(nil))[:16]
+
+	return keys, nil
+}
+
+// parseRecipients parses an age or SSH public key, or reads them from a file
+// with one key per line
+func parseRecipients(s string) ([]age.Recipient, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, "age1"):
+		recipient, err := age.ParseX25519Recipient(s)
+		if err != nil {
+			return nil, err
+		}
+		return []age.Recipient{recipient}, nil
+	case strings.HasPrefix(s, "ssh-"):
+		recipient, err := agessh.ParseRecipient(s)
+		if err != nil {
+			return nil, err
+		}
+		return []age.Recipient{recipient}, nil
+	}
+
+	content, err := os.ReadFile(expandHome(s))
+	if err != nil {
+		return nil, fmt.Errorf("not an age or SSH public key, nor a file of them: %w", err)
+	}
+
+	var recipients []age.Recipient
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, "age1") && !strings.HasPrefix(line, "ssh-") {
+			return nil, fmt.Errorf("unknown key %q in %s", line, s)
+		}
+		parsed, err := parseRecipients(line)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, parsed...)
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("no keys in %s", s)
+	}
+	return recipients, nil
+}
+
+// readIdentities reads an age identity file or an SSH private key
+func readIdentities(path string) ([]age.Identity, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Contains(content, []byte("AGE-SECRET-KEY-")) {
+		return age.ParseIdentities(bytes.NewReader(content))
+	}
+
+	identity, err := agessh.ParseIdentity(content)
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, fmt.Errorf("passphrase-protected SSH keys are not supported, use an age identity")
+		}
+		return nil, err
+	}
+	return []age.Identity{identity}, nil
+}
+
+// expandHome replaces a leading ~ with the home directory
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+func (k *privacyKeys) encrypt(plaintext string) (string, error) {
+	if len(k.recipients) == 0 {
+		return "", errors.New("private content needs privacy.recipients in the config")
+	}
+
+	var buf bytes.Buffer
+	armored := armor.NewWriter(&buf)
+	w, err := age.Encrypt(armored, k.recipients...)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	if err := armored.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// decrypt returns the plaintext of armored, or false when no identity can
+// decrypt it
+func (k *privacyKeys) decrypt(armored string) (string, bool) {
+	if k == nil || len(k.identities) == 0 {
+		return "", false
+	}
+
+	r, err := age.Decrypt(armor.NewReader(strings.NewReader(armored)), k.identities...)
+	if err != nil {
+		return "", false
+	}
+	plaintext, err := io.ReadAll(r)
+	if err != nil {
+		return "", false
+	}
+	return string(plaintext), true
+}
+
+// isPrivateField reports whether a frontmatter key of an entity type is
+// private, listed either as "mood" or as "progress.mood"
+func (k *privacyKeys) isPrivateField(entityType, key string) bool {
+	return k != nil && (k.fields[key] || k.fields[entityType+"."+key])
+}
+
+// keyFingerprint identifies the keys, or is empty without privacy set up
+func (k *privacyKeys) keyFingerprint() string {
+	if k == nil {
+		return ""
+	}
+	return k.fingerprint
+}
+
+// hasSealedContent reports whether markdown content has encrypted fields or
+// sections
+func hasSealedContent(content []byte) bool {
+	return bytes.Contains(content, []byte(privateTag+" ")) || sealedSection.Match(content)
+}
+
+// sealPrivate encrypts the private fields and sections of markdown content.
+// Values and sections unchanged from previous keep their ciphertext, so
+// saving an entity does not rewrite private content that did not change.
+func (k *privacyKeys) sealPrivate(entityType string, content, previous []byte) ([]byte, error) {
+	if k == nil {
+		if privateSection.Match(content) {
+			return nil, errors.New("private sections need privacy.recipients in the config")
+		}
+		return content, nil
+	}
+
+	frontmatterYAML, body, found, err := splitFrontmatter(content)
+	if err != nil || !found {
+		return content, err
+	}
+
+	reuse := k.ciphertexts(previous)
+	seal := func(plaintext string) (string, error) {
+		if armored, ok := reuse[plaintext]; ok {
+			return armored, nil
+		}
+		return k.encrypt(plaintext)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontmatterYAML), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse frontmatter YAML: %w", err)
+	}
+	if len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+		mapping := doc.Content[0]
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			value := mapping.Content[i+1]
+			if !k.isPrivateField(entityType, mapping.Content[i].Value) || value.Tag == privateTag {
+				continue
+			}
+
+			plaintext, err := yaml.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			armored, err := seal(string(plaintext))
+			if err != nil {
+				return nil, err
+			}
+			mapping.Content[i+1] = &yaml.Node{Kind: yaml.ScalarNode, Tag: privateTag, Value: armored, Style: yaml.LiteralStyle}
+		}
+	}
+
+	var sealErr error
+	body = privateSection.ReplaceAllStringFunc(body, func(section string) string {
+		plaintext := privateSection.FindStringSubmatch(section)[1]
+		armored, err := seal(plaintext)
+		if err != nil {
+			sealErr = err
+			return section
+		}
+		return "<!-- private\n" + armored + "-->"
+	})
+	if sealErr != nil {
+		return nil, sealErr
+	}
+
+	frontmatter, err := yaml.Marshal(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal frontmatter to YAML: %w", err)
+	}
+	return joinFrontmatter(frontmatter, body), nil
+}
+
+// ciphertexts maps the plaintexts of the encrypted content in previous to
+// their ciphertext
+func (k *privacyKeys) ciphertexts(previous []byte) map[string]string {
+	reuse := map[string]string{}
+	if len(previous) == 0 {
+		return reuse
+	}
+
+	frontmatterYAML, body, found, err := splitFrontmatter(previous)
+	if err != nil || !found {
+		return reuse
+	}
+
+	var doc yaml.Node
+	if yaml.Unmarshal([]byte(frontmatterYAML), &doc) == nil && len(doc.Content) > 0 {
+		for _, node := range doc.Content[0].Content {
+			if node.Tag != privateTag {
+				continue
+			}
+			if plaintext, ok := k.decrypt(node.Value); ok {
+				reuse[plaintext] = node.Value
+			}
+		}
+	}
+
+	for _, match := range sealedSection.FindAllStringSubmatch(body, -1) {
+		armored := match[1] + "\n"
+		if plaintext, ok := k.decrypt(armored); ok {
+			reuse[plaintext] = armored
+		}
+	}
+	return reuse
+}
+
+// openPrivate decrypts the private fields and sections of markdown content
+// back to their plain form. Fields that cannot be decrypted are dropped and
+// sections replaced by a placeholder; keys may be nil.
+func (k *privacyKeys) openPrivate(content []byte) ([]byte, error) {
+	if !hasSealedContent(content) {
+		return content, nil
+	}
+
+	frontmatterYAML, body, found, err := splitFrontmatter(content)
+	if err != nil || !found {
+		return content, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontmatterYAML), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse frontmatter YAML: %w", err)
+	}
+	if len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+		mapping := doc.Content[0]
+		kept := mapping.Content[:0]
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			key, value := mapping.Content[i], mapping.Content[i+1]
+			if value.Tag == privateTag {
+				plaintext, ok := k.decrypt(value.Value)
+				if !ok {
+					continue
+				}
+				var decrypted yaml.Node
+				if err := yaml.Unmarshal([]byte(plaintext), &decrypted); err != nil || len(decrypted.Content) == 0 {
+					continue
+				}
+				value = decrypted.Content[0]
+			}
+			kept = append(kept, key, value)
+		}
+		mapping.Content = kept
+	}
+
+	body = sealedSection.ReplaceAllStringFunc(body, func(section string) string {
+		plaintext, ok := k.decrypt(sealedSection.FindStringSubmatch(section)[1] + "\n")
+		if !ok {
+			return lockedSection
+		}
+		return "<!-- private -->\n" + plaintext + "\n<!-- /private -->"
+	})
+
+	frontmatter, err := yaml.Marshal(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal frontmatter to YAML: %w", err)
+	}
+	return joinFrontmatter(frontmatter, body), nil
+}
+
+// canOpen reports whether the keys decrypt all sealed content of markdown
+// content
+func (k *privacyKeys) canOpen(content []byte) bool {
+	if !hasSealedContent(content) {
+		return true
+	}
+
+	frontmatterYAML, body, _, err := splitFrontmatter(content)
+	if err != nil {
+		return false
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontmatterYAML), &doc); err != nil {
+		return false
+	}
+	if len(doc.Content) > 0 {
+		for _, node := range doc.Content[0].Content {
+			if node.Tag != privateTag {
+				continue
+			}
+			if _, ok := k.decrypt(node.Value); !ok {
+				return false
+			}
+		}
+	}
+
+	for _, match := range sealedSection.FindAllStringSubmatch(body, -1) {
+		if _, ok := k.decrypt(match[1] + "\n"); !ok {
+			return false
+		}
+	}
+	return true
+}
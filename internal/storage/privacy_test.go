@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// ageKey writes a new age identity file and returns it with its recipient
+func ageKey(t *testing.T) (identityFile, recipient string) {
+	t.Helper()
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	identityFile = filepath.Join(t.TempDir(), "key.txt")
+	require.NoError(t, os.WriteFile(identityFile, []byte(identity.String()+"\n"), 0600))
+	return identityFile, identity.Recipient().String()
+}
+
+func privateProgressRepo(t *testing.T, dir string, privacy PrivacyConfig) *FilesystemRepository[core.ProgressLog, *core.ProgressLog] {
+	t.Helper()
+	config := DefaultConfig()
+	config.Privacy = privacy
+
+	repo, err := NewFilesystemRepositoryWithConfig[core.ProgressLog](dir, "progress", config)
+	require.NoError(t, err)
+	require.NoError(t, repo.privacyErr)
+	return repo
+}
+
+func readEntityFile(t *testing.T, dir string) string {
+	t.Helper()
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.md"))
+	require.Len(t, matches, 1)
+	content, err := os.ReadFile(matches[0])
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestPrivateContent(t *testing.T) {
+	week := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	identity, recipient := ageKey(t)
+	privacy := PrivacyConfig{Recipients: []string{recipient}, Identity: identity, Fields: []string{"progress.mood"}}
+
+	newLog := func() *core.ProgressLog {
+		log, _ := core.NewProgressLog("progress-001", week)
+		log.SetHoursInvested(4)
+		log.SetMood("burned out after the reorg")
+		log.Body = "Read two chapters.\n\n<!-- private -->\nSalary talk went badly.\n<!-- /private -->"
+		return log
+	}
+
+	t.Run("encrypts private fields and sections in the file", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := privateProgressRepo(t, dir, privacy)
+		require.NoError(t, repo.Create(newLog()))
+
+		content := readEntityFile(t, dir)
+		assert.NotContains(t, content, "burned out")
+		assert.NotContains(t, content, "Salary")
+		assert.Contains(t, content, "mood: !private |")
+		assert.Contains(t, content, "<!-- private\n-----BEGIN AGE ENCRYPTED FILE-----")
+		assert.Contains(t, content, "Read two chapters.")
+		assert.Contains(t, content, "hoursInvested: 4")
+	})
+
+	t.Run("decrypts them when reading", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := privateProgressRepo(t, dir, privacy)
+		require.NoError(t, repo.Create(newLog()))
+
+		log, err := repo.GetByIDWithBody("progress-001")
+		require.NoError(t, err)
+		assert.Equal(t, "burned out after the reorg", log.Mood)
+		assert.Equal(t, newLog().Body, log.Body)
+	})
+
+	t.Run("keeps the ciphertext of unchanged content", func(t *testing.T) {
+		dir := t.TempDir()
+		repo := privateProgressRepo(t, dir, privacy)
+		require.NoError(t, repo.Create(newLog()))
+		before := readEntityFile(t, dir)
+
+		log, _ := repo.GetByIDWithBody("progress-001")
+		log.SetHoursInvested(6)
+		require.NoError(t, repo.Update(log))
+		after := readEntityFile(t, dir)
+
+		ciphertext := regexp.MustCompile(`(?s)-----BEGIN AGE ENCRYPTED FILE-----.*?-----END AGE ENCRYPTED FILE-----`)
+		assert.Len(t, ciphertext.FindAllString(after, -1), 2)
+		assert.Equal(t, ciphertext.FindAllString(before, -1), ciphertext.FindAllString(after, -1))
+		assert.Contains(t, after, "hoursInvested: 6")
+	})
+
+	t.Run("hides private content without the identity", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, privateProgressRepo(t, dir, privacy).Create(newLog()))
+
+		repo := privateProgressRepo(t, dir, PrivacyConfig{Recipients: []string{recipient}, Fields: privacy.Fields})
+		log, err := repo.GetByIDWithBody("progress-001")
+		require.NoError(t, err)
+		assert.Empty(t, log.Mood)
+		assert.Equal(t, 4.0, log.HoursInvested)
+		assert.Equal(t, "Read two chapters.\n\n"+lockedSection, log.Body)
+
+		err = repo.Update(log)
+		assert.ErrorIs(t, err, ErrPrivateLocked)
+		assert.Contains(t, readEntityFile(t, dir), "mood: !private")
+	})
+
+	t.Run("never caches decrypted content", func(t *testing.T) {
+		dir := t.TempDir()
+		cacheDir := filepath.Join(t.TempDir(), "cache")
+		repo := privateProgressRepo(t, dir, privacy)
+		repo.SetCacheDir(cacheDir)
+		require.NoError(t, repo.Create(newLog()))
+
+		logs, err := repo.GetAll()
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "burned out after the reorg", logs[0].Mood)
+		if cache, err := os.ReadFile(filepath.Join(cacheDir, "progress.gob")); err == nil {
+			assert.NotContains(t, string(cache), "burned out")
+		}
+
+		// Without the identity the cache must not hand out what it decrypted
+		locked := privateProgressRepo(t, dir, PrivacyConfig{Recipients: []string{recipient}, Fields: privacy.Fields})
+		locked.SetCacheDir(cacheDir)
+		logs, err = locked.GetAll()
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Empty(t, logs[0].Mood)
+	})
+
+	t.Run("reads private content without privacy set up", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, privateProgressRepo(t, dir, privacy).Create(newLog()))
+
+		repo, _ := NewFilesystemRepository[core.ProgressLog](dir, "progress")
+		log, err := repo.GetByID("progress-001")
+		require.NoError(t, err)
+		assert.Empty(t, log.Mood)
+	})
+
+	t.Run("refuses private sections without recipients", func(t *testing.T) {
+		repo, _ := NewFilesystemRepository[core.ProgressLog](t.TempDir(), "progress")
+
+		err := repo.Create(newLog())
+		assert.ErrorContains(t, err, "privacy.recipients")
+	})
+
+	t.Run("works with SSH keys", func(t *testing.T) {
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		sshPublic, err := ssh.NewPublicKey(public)
+		require.NoError(t, err)
+		block, err := ssh.MarshalPrivateKey(private, "")
+		require.NoError(t, err)
+
+		keyDir := t.TempDir()
+		identityFile := filepath.Join(keyDir, "id_ed25519")
+		recipientsFile := filepath.Join(keyDir, "id_ed25519.pub")
+		require.NoError(t, os.WriteFile(identityFile, pem.EncodeToMemory(block), 0600))
+		require.NoError(t, os.WriteFile(recipientsFile, ssh.MarshalAuthorizedKey(sshPublic), 0644))
+
+		dir := t.TempDir()
+		repo := privateProgressRepo(t, dir, PrivacyConfig{Recipients: []string{recipientsFile}, Identity: identityFile, Fields: []string{"mood"}})
+		require.NoError(t, repo.Create(newLog()))
+		assert.NotContains(t, readEntityFile(t, dir), "burned out")
+
+		log, err := repo.GetByIDWithBody("progress-001")
+		require.NoError(t, err)
+		assert.Equal(t, "burned out after the reorg", log.Mood)
+	})
+}
+
+func TestPrivacyConfigValidation(t *testing.T) {
+	config := DefaultConfig()
+	config.Privacy = PrivacyConfig{Fields: []string{"mood"}}
+	require.Error(t, config.Validate())
+	assert.Contains(t, config.Validate().Error(), "privacy.recipients")
+
+	config.Privacy.Recipients = []string{"age1notakey"}
+	assert.Contains(t, config.Validate().Error(), "privacy.recipients[0]")
+}
@@ -1,10 +1,13 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
 	"sort"
 	"time"
 
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type ProgressLogRepository struct {
@@ -22,13 +25,47 @@ func NewProgressLogRepository(basePath string) (*ProgressLogRepository, error) {
 	}, nil
 }
 
+// NewProgressLogSQLiteRepository creates a progress log repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewProgressLogSQLiteRepository(db *sql.DB) (*ProgressLogRepository, error) {
+	repo, err := NewSQLiteRepository[core.ProgressLog](db, "progress")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProgressLogRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *ProgressLogRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.ProgressLog]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.ProgressLog, *core.ProgressLog]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *ProgressLogRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.ProgressLog, *core.ProgressLog]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *ProgressLogRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *ProgressLogRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.ProgressLog, *core.ProgressLog]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *ProgressLogRepository) Create(log *core.ProgressLog) error {
 	return r.repo.Create(log)
 }
@@ -45,6 +82,10 @@ func (r *ProgressLogRepository) GetAll() ([]*core.ProgressLog, error) {
 	return r.repo.GetAll()
 }
 
+func (r *ProgressLogRepository) Iterate(fn func(*core.ProgressLog) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *ProgressLogRepository) Update(log *core.ProgressLog) error {
 	return r.repo.Update(log)
 }
@@ -1,6 +1,9 @@
 package storage
 
-import "github.com/illenko/growth.md/internal/core"
+import (
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
 
 // Repository defines the interface for entity persistence operations.
 // It uses Go generics to work with any entity type.
@@ -21,6 +24,10 @@ type Repository[T any] interface {
 	// Returns an empty slice if no entities exist.
 	GetAll() ([]*T, error)
 
+	// Iterate calls fn for each entity (metadata only, without bodies) until
+	// fn returns false, without loading all entities into memory first.
+	Iterate(fn func(entity *T) bool) error
+
 	// Update persists changes to an existing entity.
 	// Returns an error if the entity does not exist or if persistence fails.
 	Update(entity *T) error
@@ -37,3 +44,8 @@ type Repository[T any] interface {
 	// Exists checks if an entity with the given ID exists.
 	Exists(id core.EntityID) (bool, error)
 }
+
+// eventLogger is implemented by repositories that emit domain events
+type eventLogger interface {
+	SetEventLog(log *events.Log)
+}
@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type ResourceRepository struct {
@@ -19,13 +23,47 @@ func NewResourceRepository(basePath string) (*ResourceRepository, error) {
 	}, nil
 }
 
+// NewResourceSQLiteRepository creates a resource repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewResourceSQLiteRepository(db *sql.DB) (*ResourceRepository, error) {
+	repo, err := NewSQLiteRepository[core.Resource](db, "resource")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *ResourceRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Resource]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Resource, *core.Resource]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *ResourceRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Resource, *core.Resource]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *ResourceRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *ResourceRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Resource, *core.Resource]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *ResourceRepository) Create(resource *core.Resource) error {
 	return r.repo.Create(resource)
 }
@@ -42,6 +80,10 @@ func (r *ResourceRepository) GetAll() ([]*core.Resource, error) {
 	return r.repo.GetAll()
 }
 
+func (r *ResourceRepository) Iterate(fn func(*core.Resource) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *ResourceRepository) Update(resource *core.Resource) error {
 	return r.repo.Update(resource)
 }
@@ -59,48 +101,66 @@ func (r *ResourceRepository) Exists(id core.EntityID) (bool, error) {
 }
 
 func (r *ResourceRepository) FindByType(resourceType core.ResourceType) ([]*core.Resource, error) {
-	allResources, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Resource
-	for _, resource := range allResources {
+	err := r.repo.Iterate(func(resource *core.Resource) bool {
 		if resource.Type == resourceType {
 			results = append(results, resource)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *ResourceRepository) FindBySkillID(skillID core.EntityID) ([]*core.Resource, error) {
-	allResources, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Resource
-	for _, resource := range allResources {
+	err := r.repo.Iterate(func(resource *core.Resource) bool {
 		if resource.SkillID == skillID {
 			results = append(results, resource)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *ResourceRepository) FindByStatus(status core.ResourceStatus) ([]*core.Resource, error) {
-	allResources, err := r.repo.GetAll()
+	var results []*core.Resource
+	err := r.repo.Iterate(func(resource *core.Resource) bool {
+		if resource.Status == status {
+			results = append(results, resource)
+		}
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return results, nil
+}
+
+// FindByURL returns resources whose URL matches the given URL after normalization.
+func (r *ResourceRepository) FindByURL(url string) ([]*core.Resource, error) {
+	normalized := core.NormalizeURL(url)
+	if normalized == "" {
+		return nil, nil
+	}
+
 	var results []*core.Resource
-	for _, resource := range allResources {
-		if resource.Status == status {
+	err := r.repo.Iterate(func(resource *core.Resource) bool {
+		if resource.URL != "" && core.NormalizeURL(resource.URL) == normalized {
 			results = append(results, resource)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
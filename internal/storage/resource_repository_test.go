@@ -177,3 +177,30 @@ func TestResourceRepository_FindByStatus(t *testing.T) {
 		assert.Equal(t, "Completed", results[0].Title)
 	})
 }
+
+func TestResourceRepository_FindByURL(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewResourceRepository(tmpDir)
+
+	course, _ := core.NewResource("resource-001", "Go Course", core.ResourceCourse, "skill-001")
+	course.SetURL("https://www.example.com/courses/go/")
+	book, _ := core.NewResource("resource-002", "No URL", core.ResourceBook, "skill-001")
+
+	repo.Create(course)
+	repo.Create(book)
+
+	t.Run("matches normalized URL", func(t *testing.T) {
+		results, err := repo.FindByURL("http://example.com/courses/go?utm_source=newsletter")
+
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Go Course", results[0].Title)
+	})
+
+	t.Run("returns nothing for unknown URL", func(t *testing.T) {
+		results, err := repo.FindByURL("https://example.com/courses/rust")
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+type SessionRepository struct {
+	repo Repository[core.Session]
+}
+
+func NewSessionRepository(basePath string) (*SessionRepository, error) {
+	repo, err := NewFilesystemRepository[core.Session](basePath, "session")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewSessionSQLiteRepository creates a session repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewSessionSQLiteRepository(db *sql.DB) (*SessionRepository, error) {
+	repo, err := NewSQLiteRepository[core.Session](db, "session")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *SessionRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Session, *core.Session]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *SessionRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Session, *core.Session]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *SessionRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *SessionRepository) Create(session *core.Session) error {
+	return r.repo.Create(session)
+}
+
+func (r *SessionRepository) GetByID(id core.EntityID) (*core.Session, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *SessionRepository) GetByIDWithBody(id core.EntityID) (*core.Session, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *SessionRepository) GetAll() ([]*core.Session, error) {
+	return r.repo.GetAll()
+}
+
+func (r *SessionRepository) Iterate(fn func(*core.Session) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *SessionRepository) Update(session *core.Session) error {
+	return r.repo.Update(session)
+}
+
+func (r *SessionRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *SessionRepository) Search(query string) ([]*core.Session, error) {
+	return r.repo.Search(query)
+}
+
+func (r *SessionRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindByReference returns sessions linked to the given skill or resource, newest first.
+func (r *SessionRepository) FindByReference(id core.EntityID) ([]*core.Session, error) {
+	allSessions, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Session
+	for _, session := range allSessions {
+		if session.HasReference(id) {
+			results = append(results, session)
+		}
+	}
+
+	sortSessionsByDate(results)
+
+	return results, nil
+}
+
+// FindSince returns sessions dated at or after the given time, newest first.
+func (r *SessionRepository) FindSince(since time.Time) ([]*core.Session, error) {
+	allSessions, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*core.Session
+	for _, session := range allSessions {
+		if !session.Date.Before(since) {
+			results = append(results, session)
+		}
+	}
+
+	sortSessionsByDate(results)
+
+	return results, nil
+}
+
+func sortSessionsByDate(sessions []*core.Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Date.After(sessions[j].Date)
+	})
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewSessionRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewSessionRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSessionRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewSessionRepository(tmpDir)
+
+	t.Run("creates and retrieves session", func(t *testing.T) {
+		session, _ := core.NewSession("session-001", "Go study group", time.Now())
+		session.SetHours(1.5)
+		session.AddAttendee("Ana")
+		session.AddTopic("Generics")
+		session.AddSkill("skill-001")
+		session.Body = "Worked through the generics chapter."
+
+		err := repo.Create(session)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("session-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Go study group", retrieved.Title)
+		assert.Equal(t, 1.5, retrieved.Hours)
+		assert.Equal(t, []string{"Ana"}, retrieved.Attendees)
+		assert.Equal(t, []string{"Generics"}, retrieved.Topics)
+		assert.Equal(t, []core.EntityID{"skill-001"}, retrieved.Skills)
+		assert.Contains(t, retrieved.Body, "generics chapter")
+	})
+
+	t.Run("deletes session", func(t *testing.T) {
+		err := repo.Delete("session-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("session-001")
+		assert.False(t, exists)
+	})
+}
+
+func TestSessionRepository_Find(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewSessionRepository(tmpDir)
+
+	now := time.Now()
+	older, _ := core.NewSession("session-001", "Older session", now.AddDate(0, 0, -10))
+	older.AddSkill("skill-001")
+	newer, _ := core.NewSession("session-002", "Newer session", now)
+	newer.AddResource("resource-004")
+	newer.AddSkill("skill-001")
+	require.NoError(t, repo.Create(older))
+	require.NoError(t, repo.Create(newer))
+
+	t.Run("finds sessions by skill newest first", func(t *testing.T) {
+		results, err := repo.FindByReference("skill-001")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Newer session", results[0].Title)
+	})
+
+	t.Run("finds sessions by resource", func(t *testing.T) {
+		results, err := repo.FindByReference("resource-004")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+	})
+
+	t.Run("finds sessions since date", func(t *testing.T) {
+		results, err := repo.FindSince(now.AddDate(0, 0, -1))
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, core.EntityID("session-002"), results[0].ID)
+	})
+}
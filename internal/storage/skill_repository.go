@@ -1,7 +1,11 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
 )
 
 type SkillRepository struct {
@@ -19,13 +23,47 @@ func NewSkillRepository(basePath string) (*SkillRepository, error) {
 	}, nil
 }
 
+// NewSkillSQLiteRepository creates a skill repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewSkillSQLiteRepository(db *sql.DB) (*SkillRepository, error) {
+	repo, err := NewSQLiteRepository[core.Skill](db, "skill")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SkillRepository{
+		repo: repo,
+	}, nil
+}
+
 // SetConfig sets the configuration for git auto-commit.
 func (r *SkillRepository) SetConfig(config *Config) {
-	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Skill]); ok {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Skill, *core.Skill]); ok {
 		fsRepo.SetConfig(config)
 	}
 }
 
+// SetContext sets the context git auto-commits run under.
+func (r *SkillRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Skill, *core.Skill]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *SkillRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+// SetCacheDir enables the entity cache in dir. See FilesystemRepository.SetCacheDir.
+func (r *SkillRepository) SetCacheDir(dir string) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Skill, *core.Skill]); ok {
+		fsRepo.SetCacheDir(dir)
+	}
+}
+
 func (r *SkillRepository) Create(skill *core.Skill) error {
 	return r.repo.Create(skill)
 }
@@ -42,6 +80,10 @@ func (r *SkillRepository) GetAll() ([]*core.Skill, error) {
 	return r.repo.GetAll()
 }
 
+func (r *SkillRepository) Iterate(fn func(*core.Skill) bool) error {
+	return r.repo.Iterate(fn)
+}
+
 func (r *SkillRepository) Update(skill *core.Skill) error {
 	return r.repo.Update(skill)
 }
@@ -59,64 +101,60 @@ func (r *SkillRepository) Exists(id core.EntityID) (bool, error) {
 }
 
 func (r *SkillRepository) FindByCategory(category string) ([]*core.Skill, error) {
-	allSkills, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Skill
-	for _, skill := range allSkills {
+	err := r.repo.Iterate(func(skill *core.Skill) bool {
 		if skill.Category == category {
 			results = append(results, skill)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *SkillRepository) FindByLevel(level core.ProficiencyLevel) ([]*core.Skill, error) {
-	allSkills, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Skill
-	for _, skill := range allSkills {
+	err := r.repo.Iterate(func(skill *core.Skill) bool {
 		if skill.Level == level {
 			results = append(results, skill)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *SkillRepository) FindByStatus(status core.SkillStatus) ([]*core.Skill, error) {
-	allSkills, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Skill
-	for _, skill := range allSkills {
+	err := r.repo.Iterate(func(skill *core.Skill) bool {
 		if skill.Status == status {
 			results = append(results, skill)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
 }
 
 func (r *SkillRepository) FindByCategoryAndLevel(category string, level core.ProficiencyLevel) ([]*core.Skill, error) {
-	allSkills, err := r.repo.GetAll()
-	if err != nil {
-		return nil, err
-	}
-
 	var results []*core.Skill
-	for _, skill := range allSkills {
+	err := r.repo.Iterate(func(skill *core.Skill) bool {
 		if skill.Category == category && skill.Level == level {
 			results = append(results, skill)
 		}
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return results, nil
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+)
+
+// SnapshotRepository stores snapshots. Snapshots are immutable once taken,
+// so there is no Update.
+type SnapshotRepository struct {
+	repo Repository[core.Snapshot]
+}
+
+func NewSnapshotRepository(basePath string) (*SnapshotRepository, error) {
+	repo, err := NewFilesystemRepository[core.Snapshot](basePath, "snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotRepository{
+		repo: repo,
+	}, nil
+}
+
+// NewSnapshotSQLiteRepository creates a snapshot repository stored in a SQLite database
+// opened with OpenSQLite.
+func NewSnapshotSQLiteRepository(db *sql.DB) (*SnapshotRepository, error) {
+	repo, err := NewSQLiteRepository[core.Snapshot](db, "snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotRepository{
+		repo: repo,
+	}, nil
+}
+
+// SetConfig sets the configuration for git auto-commit.
+func (r *SnapshotRepository) SetConfig(config *Config) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Snapshot, *core.Snapshot]); ok {
+		fsRepo.SetConfig(config)
+	}
+}
+
+// SetContext sets the context git auto-commits run under.
+func (r *SnapshotRepository) SetContext(ctx context.Context) {
+	if fsRepo, ok := r.repo.(*FilesystemRepository[core.Snapshot, *core.Snapshot]); ok {
+		fsRepo.SetContext(ctx)
+	}
+}
+
+// SetEventLog sets the log that receives domain events.
+func (r *SnapshotRepository) SetEventLog(log *events.Log) {
+	if repo, ok := r.repo.(eventLogger); ok {
+		repo.SetEventLog(log)
+	}
+}
+
+func (r *SnapshotRepository) Create(snapshot *core.Snapshot) error {
+	return r.repo.Create(snapshot)
+}
+
+func (r *SnapshotRepository) GetByID(id core.EntityID) (*core.Snapshot, error) {
+	return r.repo.GetByID(id)
+}
+
+func (r *SnapshotRepository) GetByIDWithBody(id core.EntityID) (*core.Snapshot, error) {
+	return r.repo.GetByIDWithBody(id)
+}
+
+func (r *SnapshotRepository) GetAll() ([]*core.Snapshot, error) {
+	return r.repo.GetAll()
+}
+
+func (r *SnapshotRepository) Iterate(fn func(*core.Snapshot) bool) error {
+	return r.repo.Iterate(fn)
+}
+
+func (r *SnapshotRepository) Delete(id core.EntityID) error {
+	return r.repo.Delete(id)
+}
+
+func (r *SnapshotRepository) Exists(id core.EntityID) (bool, error) {
+	return r.repo.Exists(id)
+}
+
+// FindByName returns the snapshot with the given review period name
+// (case-insensitive), or nil if there is none.
+func (r *SnapshotRepository) FindByName(name string) (*core.Snapshot, error) {
+	allSnapshots, err := r.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range allSnapshots {
+		if strings.EqualFold(snapshot.Title, strings.TrimSpace(name)) {
+			return snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSnapshotRepository(t *testing.T) {
+	t.Run("creates repository successfully", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		repo, err := NewSnapshotRepository(tmpDir)
+
+		require.NoError(t, err)
+		assert.NotNil(t, repo)
+	})
+
+	t.Run("fails with empty path", func(t *testing.T) {
+		_, err := NewSnapshotRepository("")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSnapshotRepository_CRUD(t *testing.T) {
+	tmpDir := t.TempDir()
+	repo, _ := NewSnapshotRepository(tmpDir)
+
+	skill, _ := core.NewSkill("skill-001", "Go", "programming", core.LevelAdvanced)
+	takenAt := time.Date(2025, 6, 30, 18, 0, 0, 0, time.UTC)
+
+	t.Run("creates and retrieves snapshot", func(t *testing.T) {
+		snapshot, _ := core.NewSnapshot("snapshot-001", "2025-H1", takenAt, core.SnapshotSource{Skills: []*core.Skill{skill}})
+		snapshot.Body = "Summary"
+
+		err := repo.Create(snapshot)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetByIDWithBody("snapshot-001")
+		require.NoError(t, err)
+		assert.Equal(t, "2025-H1", retrieved.Title)
+		assert.True(t, takenAt.Equal(retrieved.TakenAt))
+		require.Len(t, retrieved.Skills, 1)
+		assert.Equal(t, core.LevelAdvanced, retrieved.Skills[0].Level)
+		assert.Contains(t, retrieved.Body, "Summary")
+	})
+
+	t.Run("finds by name case-insensitively", func(t *testing.T) {
+		found, err := repo.FindByName("2025-h1")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, core.EntityID("snapshot-001"), found.ID)
+
+		missing, err := repo.FindByName("2024-H2")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("deletes snapshot", func(t *testing.T) {
+		err := repo.Delete("snapshot-001")
+		require.NoError(t, err)
+
+		exists, _ := repo.Exists("snapshot-001")
+		assert.False(t, exists)
+	})
+}
@@ -0,0 +1,390 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/illenko/growth.md/internal/events"
+	"gopkg.in/yaml.v3"
+	_ "modernc.org/sqlite"
+)
+
+var _ Repository[core.Skill] = (*SQLiteRepository[core.Skill, *core.Skill])(nil)
+
+// sqliteSchema stores every entity type in one table. frontmatter holds the
+// same YAML as the markdown files, and search holds the lowercased title and
+// tags, one per line, for Search.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS entities (
+	type        TEXT NOT NULL,
+	id          TEXT NOT NULL,
+	title       TEXT NOT NULL,
+	search      TEXT NOT NULL,
+	frontmatter TEXT NOT NULL,
+	body        TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (type, id)
+);`
+
+// OpenSQLite opens the SQLite database at path, creating it and its schema
+// if needed
+func OpenSQLite(path string) (*sql.DB, error) {
+	if path == "" {
+		return nil, errors.New("database path cannot be empty")
+	}
+
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create database schema: %w", err)
+	}
+
+	return db, nil
+}
+
+// SQLiteIDs returns the IDs of every stored entity of a type
+func SQLiteIDs(db *sql.DB, entityType string) ([]core.EntityID, error) {
+	rows, err := db.Query(`SELECT id FROM entities WHERE type = ? ORDER BY id`, entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s IDs: %w", entityType, err)
+	}
+	defer rows.Close()
+
+	var ids []core.EntityID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, core.EntityID(id))
+	}
+
+	return ids, rows.Err()
+}
+
+// SQLiteRepository implements the Repository interface on a SQLite database,
+// for repositories too large to query quickly as plain files. Changes emit
+// events like the filesystem repository but are never auto-committed to git.
+type SQLiteRepository[T any, P EntityPointer[T]] struct {
+	db         *sql.DB
+	entityType string
+	events     *events.Log
+}
+
+// NewSQLiteRepository creates a repository for one entity type in db, which
+// must have been opened with OpenSQLite
+func NewSQLiteRepository[T any, P EntityPointer[T]](db *sql.DB, entityType string) (*SQLiteRepository[T, P], error) {
+	if db == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+	if entityType == "" {
+		return nil, errors.New("entityType cannot be empty")
+	}
+
+	return &SQLiteRepository[T, P]{
+		db:         db,
+		entityType: entityType,
+	}, nil
+}
+
+// SetEventLog sets the log that receives domain events for changes made
+// through this repository. A nil log disables events.
+func (r *SQLiteRepository[T, P]) SetEventLog(log *events.Log) {
+	r.events = log
+}
+
+func (r *SQLiteRepository[T, P]) Create(entity *T) error {
+	if entity == nil {
+		return errors.New("entity cannot be nil")
+	}
+
+	id := P(entity).GetID()
+	if id == "" {
+		return errors.New("failed to get entity ID: entity ID is empty")
+	}
+
+	exists, err := r.Exists(id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("entity with ID %s already exists", id)
+	}
+
+	frontmatter, err := yaml.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("failed to serialize entity: %w", err)
+	}
+
+	title := P(entity).GetTitle()
+	_, err = r.db.Exec(`INSERT INTO entities (type, id, title, search, frontmatter, body) VALUES (?, ?, ?, ?, ?, ?)`,
+		r.entityType, string(id), title, searchText[T, P](entity, title), string(frontmatter), P(entity).GetBody())
+	if err != nil {
+		return fmt.Errorf("failed to insert %s: %w", id, err)
+	}
+
+	r.emit(events.Event{Type: events.EntityCreated, EntityID: id, Title: title})
+
+	return nil
+}
+
+func (r *SQLiteRepository[T, P]) GetByID(id core.EntityID) (*T, error) {
+	return r.getByID(id, false)
+}
+
+func (r *SQLiteRepository[T, P]) GetByIDWithBody(id core.EntityID) (*T, error) {
+	return r.getByID(id, true)
+}
+
+func (r *SQLiteRepository[T, P]) getByID(id core.EntityID, includeBody bool) (*T, error) {
+	if id == "" {
+		return nil, errors.New("id cannot be empty")
+	}
+
+	var frontmatter, body string
+	err := r.db.QueryRow(`SELECT frontmatter, body FROM entities WHERE type = ? AND id = ?`, r.entityType, string(id)).
+		Scan(&frontmatter, &body)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("entity with ID %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", id, err)
+	}
+
+	entity, err := r.decode(frontmatter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse entity: %w", err)
+	}
+	if includeBody {
+		P(entity).SetBody(body)
+	}
+
+	return entity, nil
+}
+
+func (r *SQLiteRepository[T, P]) GetAll() ([]*T, error) {
+	entities := []*T{}
+	err := r.Iterate(func(entity *T) bool {
+		entities = append(entities, entity)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// Iterate calls fn for each entity (metadata only, without body), reading one
+// row at a time, until fn returns false. Rows that fail to parse are skipped.
+func (r *SQLiteRepository[T, P]) Iterate(fn func(entity *T) bool) error {
+	return r.iterate(`SELECT frontmatter FROM entities WHERE type = ? ORDER BY id`, []any{r.entityType}, fn)
+}
+
+func (r *SQLiteRepository[T, P]) iterate(query string, args []any, fn func(entity *T) bool) error {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to list %s entities: %w", r.entityType, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var frontmatter string
+		if err := rows.Scan(&frontmatter); err != nil {
+			return err
+		}
+		entity, err := r.decode(frontmatter)
+		if err != nil {
+			continue
+		}
+		if !fn(entity) {
+			break
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *SQLiteRepository[T, P]) Update(entity *T) error {
+	if entity == nil {
+		return errors.New("entity cannot be nil")
+	}
+
+	id := P(entity).GetID()
+	if id == "" {
+		return errors.New("failed to get entity ID: entity ID is empty")
+	}
+
+	previous, err := r.GetByID(id)
+	if err != nil {
+		return fmt.Errorf("entity not found: %w", err)
+	}
+	previousChanges := len(entityHistory[T, P](previous))
+
+	frontmatter, err := yaml.Marshal(entity)
+	if err != nil {
+		return fmt.Errorf("failed to serialize entity: %w", err)
+	}
+
+	title := P(entity).GetTitle()
+	_, err = r.db.Exec(`UPDATE entities SET title = ?, search = ?, frontmatter = ?, body = ? WHERE type = ? AND id = ?`,
+		title, searchText[T, P](entity, title), string(frontmatter), P(entity).GetBody(), r.entityType, string(id))
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", id, err)
+	}
+
+	if history := entityHistory[T, P](entity); len(history) > previousChanges {
+		for _, change := range history[previousChanges:] {
+			r.emit(events.ForChange(r.entityType, id, title, change))
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository[T, P]) Delete(id core.EntityID) error {
+	if id == "" {
+		return errors.New("id cannot be empty")
+	}
+
+	var title string
+	err := r.db.QueryRow(`SELECT title FROM entities WHERE type = ? AND id = ?`, r.entityType, string(id)).Scan(&title)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("entity with ID %s not found", id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", id, err)
+	}
+
+	if _, err := r.db.Exec(`DELETE FROM entities WHERE type = ? AND id = ?`, r.entityType, string(id)); err != nil {
+		return fmt.Errorf("failed to delete %s: %w", id, err)
+	}
+
+	r.emit(events.Event{Type: events.EntityDeleted, EntityID: id, Title: title})
+
+	return nil
+}
+
+// Search matches the query against titles and tags in the database, so only
+// matching entities are parsed
+func (r *SQLiteRepository[T, P]) Search(query string) ([]*T, error) {
+	if query == "" {
+		return r.GetAll()
+	}
+
+	pattern := "%" + likeEscaper.Replace(strings.ToLower(query)) + "%"
+
+	var results []*T
+	err := r.iterate(`SELECT frontmatter FROM entities WHERE type = ? AND search LIKE ? ESCAPE '\' ORDER BY id`,
+		[]any{r.entityType, pattern}, func(entity *T) bool {
+			results = append(results, entity)
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *SQLiteRepository[T, P]) Exists(id core.EntityID) (bool, error) {
+	if id == "" {
+		return false, errors.New("id cannot be empty")
+	}
+
+	var n int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM entities WHERE type = ? AND id = ?`, r.entityType, string(id)).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+func (r *SQLiteRepository[T, P]) decode(frontmatter string) (*T, error) {
+	var entity T
+	if err := yaml.Unmarshal([]byte(frontmatter), &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// emit appends an event to the event log if one is set.
+// Failures never fail the operation.
+func (r *SQLiteRepository[T, P]) emit(event events.Event) {
+	if r.events == nil {
+		return
+	}
+
+	if event.EntityType == "" {
+		event.EntityType = r.entityType
+	}
+
+	_ = r.events.Append(event)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchText is the lowercased title followed by the entity's tags, one per
+// line, so a LIKE match on a query without newlines stays within one of them
+func searchText[T any, P EntityPointer[T]](entity *T, title string) string {
+	lines := []string{strings.ToLower(title)}
+	if tagged, ok := any(P(entity)).(core.Tagged); ok {
+		for _, tag := range tagged.GetTags() {
+			lines = append(lines, strings.ToLower(tag))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func entityHistory[T any, P EntityPointer[T]](entity *T) core.History {
+	if tracked, ok := any(P(entity)).(core.Tracked); ok {
+		return tracked.GetHistory()
+	}
+	return nil
+}
+
+// CopyEntities copies every entity of one repository, including bodies, into
+// another. Entities whose ID already exists in the target are skipped, so an
+// interrupted copy can be rerun. It stops between entities once ctx is
+// cancelled and returns the number copied.
+func CopyEntities[T any, P EntityPointer[T]](ctx context.Context, from, to Repository[T]) (int, error) {
+	all, err := from.GetAll()
+	if err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	for _, summary := range all {
+		if err := ctx.Err(); err != nil {
+			return copied, err
+		}
+
+		id := P(summary).GetID()
+		exists, err := to.Exists(id)
+		if err != nil {
+			return copied, err
+		}
+		if exists {
+			continue
+		}
+
+		entity, err := from.GetByIDWithBody(id)
+		if err != nil {
+			return copied, err
+		}
+		if err := to.Create(entity); err != nil {
+			return copied, fmt.Errorf("failed to copy %s: %w", id, err)
+		}
+		copied++
+	}
+
+	return copied, nil
+}
@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "growth.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSQLiteRepository_CRUD(t *testing.T) {
+	db := openTestDB(t)
+	repo, err := NewSQLiteRepository[core.Skill](db, "skill")
+	require.NoError(t, err)
+
+	skill, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+	skill.Body = "Python notes"
+
+	t.Run("creates and reads back", func(t *testing.T) {
+		require.NoError(t, repo.Create(skill))
+
+		got, err := repo.GetByID("skill-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Python", got.Title)
+		assert.Empty(t, got.Body)
+
+		got, err = repo.GetByIDWithBody("skill-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Python notes", got.Body)
+	})
+
+	t.Run("rejects duplicates", func(t *testing.T) {
+		err := repo.Create(skill)
+		assert.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("updates", func(t *testing.T) {
+		skill.Title = "Python 3"
+		skill.Level = core.LevelAdvanced
+		require.NoError(t, repo.Update(skill))
+
+		got, err := repo.GetByID("skill-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Python 3", got.Title)
+		assert.Equal(t, core.LevelAdvanced, got.Level)
+	})
+
+	t.Run("keeps entity types apart", func(t *testing.T) {
+		goals, err := NewSQLiteRepository[core.Goal](db, "goal")
+		require.NoError(t, err)
+
+		all, err := goals.GetAll()
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		exists, err := goals.Exists("skill-001")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("deletes", func(t *testing.T) {
+		require.NoError(t, repo.Delete("skill-001"))
+
+		_, err := repo.GetByID("skill-001")
+		assert.ErrorContains(t, err, "not found")
+		assert.Error(t, repo.Delete("skill-001"))
+	})
+}
+
+func TestSQLiteRepository_Query(t *testing.T) {
+	db := openTestDB(t)
+	repo, _ := NewSQLiteRepository[core.Skill](db, "skill")
+
+	python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+	python.AddTag("ml")
+	python.AddTag("backend")
+	goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelAdvanced)
+	goSkill.AddTag("backend")
+	docker, _ := core.NewSkill("skill-003", "Docker_100%", "devops", core.LevelIntermediate)
+	require.NoError(t, repo.Create(python))
+	require.NoError(t, repo.Create(goSkill))
+	require.NoError(t, repo.Create(docker))
+
+	t.Run("lists in ID order", func(t *testing.T) {
+		all, err := repo.GetAll()
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		assert.Equal(t, core.EntityID("skill-001"), all[0].ID)
+		assert.Equal(t, core.EntityID("skill-003"), all[2].ID)
+	})
+
+	t.Run("iterates until stopped", func(t *testing.T) {
+		visited := 0
+		err := repo.Iterate(func(skill *core.Skill) bool {
+			visited++
+			return false
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, visited)
+	})
+
+	t.Run("searches titles and tags", func(t *testing.T) {
+		results, err := repo.Search("PYTHON")
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+
+		results, err = repo.Search("backend")
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("treats LIKE wildcards literally", func(t *testing.T) {
+		results, err := repo.Search("_100%")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "Docker_100%", results[0].Title)
+
+		results, err = repo.Search("%")
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("lists IDs", func(t *testing.T) {
+		ids, err := SQLiteIDs(db, "skill")
+		require.NoError(t, err)
+		assert.Equal(t, []core.EntityID{"skill-001", "skill-002", "skill-003"}, ids)
+	})
+}
+
+func TestCopyEntities(t *testing.T) {
+	fsRepo, err := NewFilesystemRepository[core.Skill](t.TempDir(), "skill")
+	require.NoError(t, err)
+	sqlRepo, err := NewSQLiteRepository[core.Skill](openTestDB(t), "skill")
+	require.NoError(t, err)
+
+	python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+	python.Body = "Python notes"
+	goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelAdvanced)
+	require.NoError(t, fsRepo.Create(python))
+	require.NoError(t, fsRepo.Create(goSkill))
+
+	copied, err := CopyEntities[core.Skill](context.Background(), fsRepo, sqlRepo)
+	require.NoError(t, err)
+	assert.Equal(t, 2, copied)
+
+	got, err := sqlRepo.GetByIDWithBody("skill-001")
+	require.NoError(t, err)
+	assert.Equal(t, "Python notes", got.Body)
+
+	t.Run("skips entities already in the target", func(t *testing.T) {
+		copied, err := CopyEntities[core.Skill](context.Background(), fsRepo, sqlRepo)
+		require.NoError(t, err)
+		assert.Zero(t, copied)
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		target, err := NewSQLiteRepository[core.Skill](openTestDB(t), "skill")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		copied, err := CopyEntities[core.Skill](ctx, fsRepo, target)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, copied)
+	})
+}
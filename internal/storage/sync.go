@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// SyncResult counts the changes SyncEntities made to the target repository
+type SyncResult struct {
+	Created   int
+	Updated   int
+	Deleted   int
+	Unchanged int
+}
+
+// Changed reports whether the sync modified the target
+func (s SyncResult) Changed() bool {
+	return s.Created+s.Updated+s.Deleted > 0
+}
+
+// SyncEntities makes the target repository an exact copy of the source:
+// missing entities are created, differing ones updated and extra ones deleted.
+// Entities that already match are not written, so syncing into markdown files
+// only touches files whose content changed. It stops between entities once ctx
+// is cancelled; rerunning it finishes the sync.
+func SyncEntities[T any, P EntityPointer[T]](ctx context.Context, from, to Repository[T]) (SyncResult, error) {
+	var result SyncResult
+
+	all, err := from.GetAll()
+	if err != nil {
+		return result, err
+	}
+
+	keep := make(map[core.EntityID]bool, len(all))
+	for _, summary := range all {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		id := P(summary).GetID()
+		keep[id] = true
+
+		entity, err := from.GetByIDWithBody(id)
+		if err != nil {
+			return result, err
+		}
+
+		exists, err := to.Exists(id)
+		if err != nil {
+			return result, err
+		}
+		if !exists {
+			if err := to.Create(entity); err != nil {
+				return result, fmt.Errorf("failed to create %s: %w", id, err)
+			}
+			result.Created++
+			continue
+		}
+
+		current, err := to.GetByIDWithBody(id)
+		if err != nil {
+			return result, err
+		}
+		if sameEntity[T, P](entity, current) {
+			result.Unchanged++
+			continue
+		}
+		if err := to.Update(entity); err != nil {
+			return result, fmt.Errorf("failed to update %s: %w", id, err)
+		}
+		result.Updated++
+	}
+
+	// Collect first: deleting while iterating a database would hold its cursor open
+	var stale []core.EntityID
+	err = to.Iterate(func(entity *T) bool {
+		if id := P(entity).GetID(); !keep[id] {
+			stale = append(stale, id)
+		}
+		return true
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, id := range stale {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		if err := to.Delete(id); err != nil {
+			return result, fmt.Errorf("failed to delete %s: %w", id, err)
+		}
+		result.Deleted++
+	}
+
+	return result, nil
+}
+
+// sameEntity compares two entities by their serialized frontmatter and body
+func sameEntity[T any, P EntityPointer[T]](a, b *T) bool {
+	if strings.TrimSpace(P(a).GetBody()) != strings.TrimSpace(P(b).GetBody()) {
+		return false
+	}
+
+	ya, errA := yaml.Marshal(a)
+	yb, errB := yaml.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ya, yb)
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncEntities(t *testing.T) {
+	sqlRepo, err := NewSQLiteRepository[core.Skill](openTestDB(t), "skill")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	fsRepo, err := NewFilesystemRepository[core.Skill](dir, "skill")
+	require.NoError(t, err)
+
+	python, _ := core.NewSkill("skill-001", "Python", "programming", core.LevelIntermediate)
+	python.Body = "Python notes"
+	goSkill, _ := core.NewSkill("skill-002", "Go", "programming", core.LevelAdvanced)
+	require.NoError(t, sqlRepo.Create(python))
+	require.NoError(t, sqlRepo.Create(goSkill))
+
+	t.Run("writes every entity", func(t *testing.T) {
+		result, err := SyncEntities[core.Skill](context.Background(), sqlRepo, fsRepo)
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncResult{Created: 2}, result)
+
+		got, err := fsRepo.GetByIDWithBody("skill-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Python notes", got.Body)
+	})
+
+	t.Run("leaves matching files untouched", func(t *testing.T) {
+		result, err := SyncEntities[core.Skill](context.Background(), sqlRepo, fsRepo)
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncResult{Unchanged: 2}, result)
+		assert.False(t, result.Changed())
+	})
+
+	t.Run("updates changes and deletes removed entities", func(t *testing.T) {
+		python.Level = core.LevelExpert
+		require.NoError(t, sqlRepo.Update(python))
+		require.NoError(t, sqlRepo.Delete("skill-002"))
+
+		result, err := SyncEntities[core.Skill](context.Background(), sqlRepo, fsRepo)
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncResult{Updated: 1, Deleted: 1}, result)
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 1)
+		got, err := fsRepo.GetByID("skill-001")
+		require.NoError(t, err)
+		assert.Equal(t, core.LevelExpert, got.Level)
+	})
+
+	t.Run("syncs files back into the database", func(t *testing.T) {
+		rust, _ := core.NewSkill("skill-003", "Rust", "programming", core.LevelBeginner)
+		require.NoError(t, fsRepo.Create(rust))
+
+		result, err := SyncEntities[core.Skill](context.Background(), fsRepo, sqlRepo)
+
+		require.NoError(t, err)
+		assert.Equal(t, SyncResult{Created: 1, Unchanged: 1}, result)
+	})
+}
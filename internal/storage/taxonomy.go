@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/illenko/growth.md/internal/core"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoTaxonomy is returned by LoadTaxonomy when no taxonomy has been imported
+var ErrNoTaxonomy = errors.New("no taxonomy imported")
+
+// LoadTaxonomy reads an imported taxonomy from path
+func LoadTaxonomy(path string) (*core.Taxonomy, error) {
+	if path == "" {
+		return nil, errors.New("taxonomy path cannot be empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoTaxonomy
+		}
+		return nil, err
+	}
+
+	var taxonomy core.Taxonomy
+	if err := yaml.Unmarshal(data, &taxonomy); err != nil {
+		return nil, fmt.Errorf("failed to parse taxonomy: %w", err)
+	}
+
+	return &taxonomy, nil
+}
+
+// SaveTaxonomy writes a taxonomy to path, replacing any previous import
+func SaveTaxonomy(taxonomy *core.Taxonomy, path string) error {
+	if taxonomy == nil {
+		return errors.New("taxonomy cannot be nil")
+	}
+
+	if path == "" {
+		return errors.New("taxonomy path cannot be empty")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(taxonomy)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
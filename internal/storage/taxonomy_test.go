@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/illenko/growth.md/internal/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxonomyRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".growth", "taxonomy.yml")
+
+	t.Run("missing taxonomy", func(t *testing.T) {
+		_, err := LoadTaxonomy(path)
+
+		assert.ErrorIs(t, err, ErrNoTaxonomy)
+	})
+
+	t.Run("saves and loads", func(t *testing.T) {
+		original := &core.Taxonomy{
+			Name:     "SFIA 8",
+			Source:   "sfia.csv",
+			Imported: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+			Entries: []core.TaxonomyEntry{
+				{Code: "PROG", Title: "Programming/software development", Category: "Development and implementation"},
+			},
+		}
+
+		require.NoError(t, SaveTaxonomy(original, path))
+
+		loaded, err := LoadTaxonomy(path)
+		require.NoError(t, err)
+		assert.Equal(t, original, loaded)
+	})
+
+	t.Run("rejects nil taxonomy", func(t *testing.T) {
+		assert.Error(t, SaveTaxonomy(nil, path))
+	})
+}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Link is a milestone's task and its done state as of the last sync
+type Link struct {
+	Task string `yaml:"task"`
+	Done bool   `yaml:"done,omitempty"`
+}
+
+// State remembers which task mirrors which milestone between syncs
+type State struct {
+	Provider string          `yaml:"provider"`
+	Links    map[string]Link `yaml:"links,omitempty"`   // by milestone ID
+	Ignored  []string        `yaml:"ignored,omitempty"` // milestones whose task was deleted, which are not mirrored again
+}
+
+// LoadState reads the state file, or returns an empty state when it does
+// not exist yet
+func LoadState(path string) (*State, error) {
+	state := &State{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task sync state: %w", err)
+	}
+	if err := yaml.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse task sync state %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// Save writes the state file
+func (s *State) Save(path string) error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode task sync state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write task sync state: %w", err)
+	}
+	return nil
+}
+
+// use binds the state to a provider. Links to another provider's tasks
+// cannot be followed, so switching providers needs a fresh state.
+func (s *State) use(provider string) error {
+	if s.Provider != "" && s.Provider != provider && (len(s.Links) > 0 || len(s.Ignored) > 0) {
+		return fmt.Errorf("milestones are synced with %s, not %s: delete the task sync state to start over with %s", s.Provider, provider, provider)
+	}
+	s.Provider = provider
+	if s.Links == nil {
+		s.Links = map[string]Link{}
+	}
+	return nil
+}
+
+func (s *State) isIgnored(key string) bool {
+	for _, ignored := range s.Ignored {
+		if ignored == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Package tasks mirrors milestones into a task manager (Taskwarrior or
+// Todoist) and reads back which of them were completed there, so learning
+// tasks can be handled with everyday todos.
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTag is the tag or label that marks tasks created by growth
+const DefaultTag = "growth"
+
+// TodoistTokenEnvVar holds the Todoist API token
+const TodoistTokenEnvVar = "GROWTH_TODOIST_TOKEN"
+
+// Item is a milestone as it is mirrored into a task
+type Item struct {
+	Key   string // milestone ID
+	Title string
+	Due   *time.Time
+	Done  bool
+}
+
+// Task is a task in a task manager
+type Task struct {
+	ID        string
+	Title     string
+	Due       *time.Time
+	Done      bool
+	Completed *time.Time // when it was done, if the task manager knows
+	Deleted   bool
+}
+
+// Provider reads and writes tasks in one task manager
+type Provider interface {
+	Name() string
+	// Tasks returns the tasks with the IDs that still exist, by ID
+	Tasks(ctx context.Context, ids []string) (map[string]Task, error)
+	// Create adds a task for the item and returns its ID
+	Create(ctx context.Context, item Item) (string, error)
+	// Update sets the title, due date and done state of the task to the item's
+	Update(ctx context.Context, task Task, item Item) error
+}
+
+// Config selects the task manager and where tasks go in it
+type Config struct {
+	Provider string // taskwarrior or todoist
+	Project  string // Taskwarrior project name or Todoist project ID
+	Tag      string // defaults to DefaultTag
+}
+
+// Names lists the supported task managers
+var Names = []string{"taskwarrior", "todoist"}
+
+// NewProvider creates the provider of the configured task manager. Todoist
+// reads its API token from GROWTH_TODOIST_TOKEN.
+func NewProvider(cfg Config, client *http.Client) (Provider, error) {
+	if cfg.Tag == "" {
+		cfg.Tag = DefaultTag
+	}
+
+	switch strings.ToLower(cfg.Provider) {
+	case "taskwarrior":
+		return NewTaskwarrior(cfg.Project, cfg.Tag), nil
+	case "todoist":
+		token := os.Getenv(TodoistTokenEnvVar)
+		if token == "" {
+			return nil, fmt.Errorf("todoist needs an API token: set %s", TodoistTokenEnvVar)
+		}
+		return NewTodoist(client, token, cfg.Project, cfg.Tag), nil
+	case "":
+		return nil, fmt.Errorf("no task manager configured: set tasks.provider to one of: %s", strings.Join(Names, ", "))
+	default:
+		return nil, fmt.Errorf("unsupported task manager '%s' (supported: %s)", cfg.Provider, strings.Join(Names, ", "))
+	}
+}
+
+// Change is something a sync did to one item
+type Change struct {
+	Key    string
+	Title  string
+	Action string // created, updated, completed, reopened or unlinked
+	Date   *time.Time
+}
+
+// Sync actions
+const (
+	Created   = "created"   // a task was added for the item
+	Updated   = "updated"   // the task was changed to match the item
+	Completed = "completed" // the task was completed, so the item should be
+	Reopened  = "reopened"  // the task was reopened, so the item should be
+	Unlinked  = "unlinked"  // the task or the item is gone, they are no longer synced
+)
+
+// Sync mirrors items into the provider and returns what changed. Items that
+// are not done get a task; titles, due dates and done states are copied to
+// tasks. A task completed or reopened since the last sync wins over the
+// item, which is reported as Completed or Reopened for the caller to apply.
+// Items whose task was deleted are not mirrored again, linked items missing
+// from items are unlinked. state is updated with the links.
+func Sync(ctx context.Context, p Provider, items []Item, state *State) ([]Change, error) {
+	if err := state.use(p.Name()); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(state.Links))
+	for _, link := range state.Links {
+		ids = append(ids, link.Task)
+	}
+	sort.Strings(ids)
+	existing, err := p.Tasks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	seen := map[string]bool{}
+	var changes []Change
+
+	for _, item := range items {
+		seen[item.Key] = true
+		if state.isIgnored(item.Key) {
+			continue
+		}
+
+		link, linked := state.Links[item.Key]
+		if !linked {
+			if item.Done {
+				continue
+			}
+			id, err := p.Create(ctx, item)
+			if err != nil {
+				return changes, fmt.Errorf("failed to create a task for %s: %w", item.Key, err)
+			}
+			state.Links[item.Key] = Link{Task: id}
+			changes = append(changes, Change{Key: item.Key, Title: item.Title, Action: Created})
+			continue
+		}
+
+		task, ok := existing[link.Task]
+		if !ok || task.Deleted {
+			delete(state.Links, item.Key)
+			state.Ignored = append(state.Ignored, item.Key)
+			changes = append(changes, Change{Key: item.Key, Title: item.Title, Action: Unlinked})
+			continue
+		}
+
+		if task.Done != link.Done && item.Done == link.Done {
+			item.Done = task.Done
+			change := Change{Key: item.Key, Title: item.Title, Action: Reopened}
+			if task.Done {
+				change.Action = Completed
+				change.Date = task.Completed
+			}
+			changes = append(changes, change)
+		}
+
+		if task.Title != item.Title || task.Done != item.Done || !sameDay(task.Due, item.Due) {
+			if err := p.Update(ctx, task, item); err != nil {
+				return changes, fmt.Errorf("failed to update the task of %s: %w", item.Key, err)
+			}
+			changes = append(changes, Change{Key: item.Key, Title: item.Title, Action: Updated})
+		}
+		state.Links[item.Key] = Link{Task: link.Task, Done: item.Done}
+	}
+
+	keys := make([]string, 0, len(state.Links))
+	for key := range state.Links {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		delete(state.Links, key)
+		changes = append(changes, Change{Key: key, Action: Unlinked})
+	}
+
+	return changes, nil
+}
+
+// sameDay compares due dates, which task managers keep as days
+func sameDay(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Format(dateLayout) == b.Format(dateLayout)
+}
+
+const dateLayout = "2006-01-02"
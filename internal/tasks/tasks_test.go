@@ -0,0 +1,182 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider keeps tasks in memory
+type fakeProvider struct {
+	tasks   map[string]Task
+	updates int
+}
+
+func newFakeProvider() *fakeProvider {
+	return &fakeProvider{tasks: map[string]Task{}}
+}
+
+func (f *fakeProvider) Name() string { return "fake" }
+
+func (f *fakeProvider) Tasks(_ context.Context, ids []string) (map[string]Task, error) {
+	found := map[string]Task{}
+	for _, id := range ids {
+		if task, ok := f.tasks[id]; ok {
+			found[id] = task
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeProvider) Create(_ context.Context, item Item) (string, error) {
+	id := fmt.Sprintf("task-%d", len(f.tasks)+1)
+	f.tasks[id] = Task{ID: id, Title: item.Title, Due: item.Due, Done: item.Done}
+	return id, nil
+}
+
+func (f *fakeProvider) Update(_ context.Context, task Task, item Item) error {
+	f.updates++
+	f.tasks[task.ID] = Task{ID: task.ID, Title: item.Title, Due: item.Due, Done: item.Done}
+	return nil
+}
+
+func day(month time.Month, d int) *time.Time {
+	date := time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates tasks for open milestones only", func(t *testing.T) {
+		provider := newFakeProvider()
+		state := &State{}
+
+		changes, err := Sync(ctx, provider, []Item{
+			{Key: "milestone-002", Title: "Ship", Due: day(2, 1)},
+			{Key: "milestone-001", Title: "Done already", Done: true},
+		}, state)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Key: "milestone-002", Title: "Ship", Action: Created}}, changes)
+		assert.Equal(t, "fake", state.Provider)
+		assert.Equal(t, map[string]Link{"milestone-002": {Task: "task-1"}}, state.Links)
+		assert.Equal(t, day(2, 1), provider.tasks["task-1"].Due)
+	})
+
+	t.Run("second sync changes nothing", func(t *testing.T) {
+		provider := newFakeProvider()
+		state := &State{}
+		items := []Item{{Key: "milestone-001", Title: "Ship", Due: day(2, 1)}}
+		Sync(ctx, provider, items, state)
+
+		changes, err := Sync(ctx, provider, items, state)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+		assert.Zero(t, provider.updates)
+	})
+
+	t.Run("pulls completion from the task manager", func(t *testing.T) {
+		provider := newFakeProvider()
+		state := &State{}
+		items := []Item{{Key: "milestone-001", Title: "Ship"}}
+		Sync(ctx, provider, items, state)
+
+		task := provider.tasks["task-1"]
+		task.Done = true
+		task.Completed = day(2, 3)
+		provider.tasks["task-1"] = task
+
+		changes, err := Sync(ctx, provider, items, state)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Key: "milestone-001", Title: "Ship", Action: Completed, Date: day(2, 3)}}, changes)
+		assert.True(t, state.Links["milestone-001"].Done)
+
+		// Once the milestone is achieved too, nothing is left to do
+		items[0].Done = true
+		changes, _ = Sync(ctx, provider, items, state)
+		assert.Empty(t, changes)
+
+		// Reopening the task reopens the milestone
+		task.Done = false
+		provider.tasks["task-1"] = task
+		changes, _ = Sync(ctx, provider, items, state)
+		assert.Equal(t, []Change{{Key: "milestone-001", Title: "Ship", Action: Reopened}}, changes)
+	})
+
+	t.Run("pushes milestone changes to the task", func(t *testing.T) {
+		provider := newFakeProvider()
+		state := &State{}
+		Sync(ctx, provider, []Item{{Key: "milestone-001", Title: "Ship"}}, state)
+
+		changes, err := Sync(ctx, provider, []Item{{Key: "milestone-001", Title: "Ship v2", Due: day(3, 1), Done: true}}, state)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Key: "milestone-001", Title: "Ship v2", Action: Updated}}, changes)
+		assert.Equal(t, Task{ID: "task-1", Title: "Ship v2", Due: day(3, 1), Done: true}, provider.tasks["task-1"])
+	})
+
+	t.Run("stops syncing deleted tasks and removed milestones", func(t *testing.T) {
+		provider := newFakeProvider()
+		state := &State{}
+		items := []Item{{Key: "milestone-001", Title: "Ship"}, {Key: "milestone-002", Title: "Talk"}}
+		Sync(ctx, provider, items, state)
+
+		delete(provider.tasks, "task-1")
+		changes, err := Sync(ctx, provider, items[:1], state)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{
+			{Key: "milestone-001", Title: "Ship", Action: Unlinked},
+			{Key: "milestone-002", Action: Unlinked},
+		}, changes)
+		assert.Equal(t, []string{"milestone-001"}, state.Ignored)
+
+		changes, _ = Sync(ctx, provider, items[:1], state)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("refuses another provider's state", func(t *testing.T) {
+		state := &State{Provider: "todoist", Links: map[string]Link{"milestone-001": {Task: "123"}}}
+		_, err := Sync(ctx, newFakeProvider(), nil, state)
+		assert.ErrorContains(t, err, "synced with todoist")
+	})
+}
+
+func TestState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".growth", "tasks.yml")
+
+	state, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, &State{}, state)
+
+	state.Provider = "taskwarrior"
+	state.Links = map[string]Link{"milestone-001": {Task: "abc", Done: true}}
+	state.Ignored = []string{"milestone-002"}
+	require.NoError(t, state.Save(path))
+
+	loaded, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, state, loaded)
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Setenv(TodoistTokenEnvVar, "")
+
+	provider, err := NewProvider(Config{Provider: "Taskwarrior"}, http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, "growth", provider.(*Taskwarrior).tag)
+
+	_, err = NewProvider(Config{Provider: "todoist"}, http.DefaultClient)
+	assert.ErrorContains(t, err, TodoistTokenEnvVar)
+
+	_, err = NewProvider(Config{}, http.DefaultClient)
+	assert.ErrorContains(t, err, "tasks.provider")
+
+	_, err = NewProvider(Config{Provider: "jira"}, http.DefaultClient)
+	assert.ErrorContains(t, err, "unsupported task manager")
+}
@@ -0,0 +1,183 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// taskwarriorTime is the format of dates in Taskwarrior's JSON
+const taskwarriorTime = "20060102T150405Z"
+
+// Taskwarrior keeps tasks in Taskwarrior through its task command, reading
+// them with 'task export' and writing them with 'task import'
+type Taskwarrior struct {
+	project string
+	tag     string
+	run     func(ctx context.Context, stdin []byte, args ...string) ([]byte, error)
+}
+
+// NewTaskwarrior creates a provider that adds tasks with the tag to the
+// project, or to no project when it is empty
+func NewTaskwarrior(project, tag string) *Taskwarrior {
+	return &Taskwarrior{project: project, tag: tag, run: runTask}
+}
+
+func (t *Taskwarrior) Name() string {
+	return "taskwarrior"
+}
+
+// runTask runs the task command without prompts or messages
+func runTask(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	args = append([]string{"rc.confirmation=off", "rc.verbose=nothing", "rc.json.array=on"}, args...)
+	cmd := exec.CommandContext(ctx, "task", args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if errors.Is(err, exec.ErrNotFound) {
+		return nil, errors.New("taskwarrior is not installed: the task command was not found")
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("taskwarrior failed: %s", msg)
+		}
+		return nil, fmt.Errorf("taskwarrior failed: %w", err)
+	}
+	return out, nil
+}
+
+// export returns the raw JSON of the tasks matching the filter, so fields
+// growth does not know survive an import
+func (t *Taskwarrior) export(ctx context.Context, filter ...string) ([]map[string]any, error) {
+	out, err := t.run(ctx, nil, append(filter, "export")...)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []map[string]any
+	if err := json.Unmarshal(out, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to parse taskwarrior export: %w", err)
+	}
+	return tasks, nil
+}
+
+func (t *Taskwarrior) importTask(ctx context.Context, task map[string]any) error {
+	data, err := json.Marshal([]map[string]any{task})
+	if err != nil {
+		return err
+	}
+	_, err = t.run(ctx, data, "import", "-")
+	return err
+}
+
+// Tasks reads all tasks with the tag, including completed and deleted ones
+func (t *Taskwarrior) Tasks(ctx context.Context, ids []string) (map[string]Task, error) {
+	raw, err := t.export(ctx, "+"+t.tag)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	tasks := map[string]Task{}
+	for _, item := range raw {
+		id, _ := item["uuid"].(string)
+		if !wanted[id] {
+			continue
+		}
+
+		status, _ := item["status"].(string)
+		task := Task{
+			ID:      id,
+			Done:    status == "completed",
+			Deleted: status == "deleted",
+		}
+		task.Title, _ = item["description"].(string)
+		task.Due = taskwarriorDate(item["due"])
+		if task.Done {
+			task.Completed = taskwarriorDate(item["end"])
+		}
+		tasks[id] = task
+	}
+	return tasks, nil
+}
+
+// Create imports a new pending task for the item
+func (t *Taskwarrior) Create(ctx context.Context, item Item) (string, error) {
+	id := uuid.NewString()
+	task := map[string]any{
+		"uuid":   id,
+		"status": "pending",
+		"entry":  time.Now().UTC().Format(taskwarriorTime),
+		"tags":   []string{t.tag},
+	}
+	if t.project != "" {
+		task["project"] = t.project
+	}
+	setTaskwarriorItem(task, item)
+
+	if err := t.importTask(ctx, task); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Update re-imports the task with the item's title, due date and state
+func (t *Taskwarrior) Update(ctx context.Context, task Task, item Item) error {
+	raw, err := t.export(ctx, "uuid:"+task.ID)
+	if err != nil {
+		return err
+	}
+	if len(raw) != 1 {
+		return fmt.Errorf("task %s not found", task.ID)
+	}
+
+	updated := raw[0]
+	setTaskwarriorItem(updated, item)
+	return t.importTask(ctx, updated)
+}
+
+func setTaskwarriorItem(task map[string]any, item Item) {
+	task["description"] = item.Title
+
+	if item.Due != nil {
+		day := time.Date(item.Due.Year(), item.Due.Month(), item.Due.Day(), 0, 0, 0, 0, time.Local)
+		task["due"] = day.UTC().Format(taskwarriorTime)
+	} else {
+		delete(task, "due")
+	}
+
+	if item.Done {
+		if task["status"] != "completed" {
+			task["status"] = "completed"
+			task["end"] = time.Now().UTC().Format(taskwarriorTime)
+		}
+	} else if task["status"] == "completed" {
+		task["status"] = "pending"
+		delete(task, "end")
+	}
+}
+
+// taskwarriorDate parses a Taskwarrior date into local time, the zone due
+// dates were entered in
+func taskwarriorDate(value any) *time.Time {
+	s, _ := value.(string)
+	date, err := time.Parse(taskwarriorTime, s)
+	if err != nil {
+		return nil
+	}
+	date = date.Local()
+	return &date
+}
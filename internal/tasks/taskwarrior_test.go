@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTask replaces the task command with an in-memory task list
+type fakeTask struct {
+	tasks []map[string]any
+	calls []string
+}
+
+func (f *fakeTask) run(_ context.Context, stdin []byte, args ...string) ([]byte, error) {
+	f.calls = append(f.calls, strings.Join(args, " "))
+
+	switch args[len(args)-1] {
+	case "export":
+		var matching []map[string]any
+		for _, task := range f.tasks {
+			if args[0] == "uuid:"+task["uuid"].(string) || strings.HasPrefix(args[0], "+") {
+				matching = append(matching, task)
+			}
+		}
+		return json.Marshal(matching)
+	case "-":
+		var imported []map[string]any
+		if err := json.Unmarshal(stdin, &imported); err != nil {
+			return nil, err
+		}
+		for _, task := range imported {
+			replaced := false
+			for i, existing := range f.tasks {
+				if existing["uuid"] == task["uuid"] {
+					f.tasks[i] = task
+					replaced = true
+				}
+			}
+			if !replaced {
+				f.tasks = append(f.tasks, task)
+			}
+		}
+		return nil, nil
+	}
+	return nil, nil
+}
+
+func TestTaskwarrior(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeTask{}
+	tw := NewTaskwarrior("learning", "growth")
+	tw.run = fake.run
+
+	due := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	id, err := tw.Create(ctx, Item{Key: "milestone-001", Title: "Ship a service", Due: &due})
+	require.NoError(t, err)
+	require.Len(t, fake.tasks, 1)
+
+	created := fake.tasks[0]
+	assert.Equal(t, id, created["uuid"])
+	assert.Equal(t, "Ship a service", created["description"])
+	assert.Equal(t, "pending", created["status"])
+	assert.Equal(t, "learning", created["project"])
+	assert.Equal(t, []any{"growth"}, created["tags"])
+	assert.Equal(t, []string{"import -"}, fake.calls)
+
+	t.Run("reads tasks back", func(t *testing.T) {
+		tasks, err := tw.Tasks(ctx, []string{id, "missing"})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		task := tasks[id]
+		assert.Equal(t, "Ship a service", task.Title)
+		assert.Equal(t, "2025-02-01", task.Due.Format(dateLayout))
+		assert.False(t, task.Done)
+	})
+
+	t.Run("keeps unknown fields when updating", func(t *testing.T) {
+		fake.tasks[0]["priority"] = "H"
+		tasks, _ := tw.Tasks(ctx, []string{id})
+
+		require.NoError(t, tw.Update(ctx, tasks[id], Item{Key: "milestone-001", Title: "Ship it", Done: true}))
+		updated := fake.tasks[0]
+		assert.Equal(t, "H", updated["priority"])
+		assert.Equal(t, "Ship it", updated["description"])
+		assert.Equal(t, "completed", updated["status"])
+		assert.NotContains(t, updated, "due")
+
+		tasks, _ = tw.Tasks(ctx, []string{id})
+		assert.True(t, tasks[id].Done)
+		assert.NotNil(t, tasks[id].Completed)
+	})
+
+	t.Run("reports deleted tasks", func(t *testing.T) {
+		fake.tasks[0]["status"] = "deleted"
+		tasks, _ := tw.Tasks(ctx, []string{id})
+		assert.True(t, tasks[id].Deleted)
+	})
+}
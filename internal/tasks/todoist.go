@@ -0,0 +1,188 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultTodoistBaseURL = "https://api.todoist.com/api/v1"
+
+	// maxResponseSize limits how much of an API response is read
+	maxResponseSize = 1 << 20
+)
+
+// Todoist keeps tasks in Todoist through its API
+type Todoist struct {
+	client  *http.Client
+	baseURL string
+	token   string
+	project string
+	label   string
+}
+
+// NewTodoist creates a provider that adds tasks with the label to the
+// project ID, or to the inbox when it is empty
+func NewTodoist(client *http.Client, token, project, label string) *Todoist {
+	return &Todoist{client: client, baseURL: defaultTodoistBaseURL, token: token, project: project, label: label}
+}
+
+func (t *Todoist) Name() string {
+	return "todoist"
+}
+
+type todoistTask struct {
+	ID          string `json:"id"`
+	Content     string `json:"content"`
+	Checked     bool   `json:"checked"`
+	IsDeleted   bool   `json:"is_deleted"`
+	CompletedAt string `json:"completed_at"`
+	Due         *struct {
+		Date string `json:"date"`
+	} `json:"due"`
+}
+
+// do sends a request and decodes the response into out, when it is not nil.
+// It reports whether the task or project was found.
+func (t *Todoist) do(ctx context.Context, method, path string, body, out any) (bool, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return false, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, t.baseURL+path, reader)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to reach Todoist: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return false, fmt.Errorf("todoist rejected the API token in %s (status %d)", TodoistTokenEnvVar, resp.StatusCode)
+	case resp.StatusCode >= 300:
+		return false, fmt.Errorf("todoist request %s %s failed: status %d", method, path, resp.StatusCode)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out); err != nil {
+			return false, fmt.Errorf("failed to parse Todoist response: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// Tasks looks up each task, as completed tasks are not listed by Todoist
+func (t *Todoist) Tasks(ctx context.Context, ids []string) (map[string]Task, error) {
+	tasks := map[string]Task{}
+	for _, id := range ids {
+		var body todoistTask
+		found, err := t.do(ctx, http.MethodGet, "/tasks/"+id, nil, &body)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		task := Task{ID: id, Title: body.Content, Done: body.Checked, Deleted: body.IsDeleted}
+		if body.Due != nil {
+			task.Due = todoistDate(body.Due.Date)
+		}
+		if completed, err := time.Parse(time.RFC3339, body.CompletedAt); err == nil && task.Done {
+			completed = completed.Local()
+			task.Completed = &completed
+		}
+		tasks[id] = task
+	}
+	return tasks, nil
+}
+
+// Create adds a task with the label, which is closed when the item is done
+func (t *Todoist) Create(ctx context.Context, item Item) (string, error) {
+	body := map[string]any{
+		"content":     item.Title,
+		"description": "Milestone " + item.Key + " in growth.md",
+		"labels":      []string{t.label},
+	}
+	if t.project != "" {
+		body["project_id"] = t.project
+	}
+	if item.Due != nil {
+		body["due_date"] = item.Due.Format(dateLayout)
+	}
+
+	var created todoistTask
+	found, err := t.do(ctx, http.MethodPost, "/tasks", body, &created)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("todoist project %s not found", t.project)
+	}
+	return created.ID, nil
+}
+
+// Update changes the content and due date, then closes or reopens the task
+func (t *Todoist) Update(ctx context.Context, task Task, item Item) error {
+	if task.Title != item.Title || !sameDay(task.Due, item.Due) {
+		body := map[string]any{"content": item.Title}
+		if item.Due != nil {
+			body["due_date"] = item.Due.Format(dateLayout)
+		} else {
+			body["due_string"] = "no date"
+		}
+		if err := t.post(ctx, "/tasks/"+task.ID, body); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case item.Done && !task.Done:
+		return t.post(ctx, "/tasks/"+task.ID+"/close", nil)
+	case !item.Done && task.Done:
+		return t.post(ctx, "/tasks/"+task.ID+"/reopen", nil)
+	}
+	return nil
+}
+
+func (t *Todoist) post(ctx context.Context, path string, body any) error {
+	found, err := t.do(ctx, http.MethodPost, path, body, nil)
+	if err == nil && !found {
+		err = fmt.Errorf("todoist task not found: %s", path)
+	}
+	return err
+}
+
+// todoistDate parses the day of a due date, which has a time for tasks due
+// at a time of day
+func todoistDate(value string) *time.Time {
+	if len(value) < len(dateLayout) {
+		return nil
+	}
+	date, err := time.ParseInLocation(dateLayout, value[:len(dateLayout)], time.Local)
+	if err != nil {
+		return nil
+	}
+	return &date
+}
@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoist(t *testing.T) {
+	var requests []string
+	var created map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /tasks":
+			json.NewDecoder(r.Body).Decode(&created)
+			fmt.Fprint(w, `{"id": "6X7rM8997g3RQmvh", "content": "Ship"}`)
+		case "GET /tasks/6X7rM8997g3RQmvh":
+			fmt.Fprint(w, `{"id": "6X7rM8997g3RQmvh", "content": "Ship", "checked": true,
+				"completed_at": "2025-02-03T10:00:00Z", "due": {"date": "2025-02-01T18:00:00"}}`)
+		case "POST /tasks/6X7rM8997g3RQmvh", "POST /tasks/6X7rM8997g3RQmvh/reopen":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	todoist := NewTodoist(server.Client(), "secret", "2203306141", "growth")
+	todoist.baseURL = server.URL
+	ctx := context.Background()
+
+	t.Run("creates a labeled task", func(t *testing.T) {
+		due := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		id, err := todoist.Create(ctx, Item{Key: "milestone-001", Title: "Ship", Due: &due})
+
+		require.NoError(t, err)
+		assert.Equal(t, "6X7rM8997g3RQmvh", id)
+		assert.Equal(t, "Ship", created["content"])
+		assert.Equal(t, "2025-02-01", created["due_date"])
+		assert.Equal(t, "2203306141", created["project_id"])
+		assert.Equal(t, []any{"growth"}, created["labels"])
+		assert.Contains(t, created["description"], "milestone-001")
+	})
+
+	t.Run("looks up completed and missing tasks", func(t *testing.T) {
+		tasks, err := todoist.Tasks(ctx, []string{"6X7rM8997g3RQmvh", "gone"})
+
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		task := tasks["6X7rM8997g3RQmvh"]
+		assert.True(t, task.Done)
+		assert.Equal(t, "2025-02-01", task.Due.Format(dateLayout))
+		assert.Equal(t, "2025-02-03", task.Completed.UTC().Format(dateLayout))
+	})
+
+	t.Run("updates only what changed", func(t *testing.T) {
+		requests = nil
+		due := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		task := Task{ID: "6X7rM8997g3RQmvh", Title: "Ship", Due: &due, Done: true}
+
+		require.NoError(t, todoist.Update(ctx, task, Item{Title: "Ship", Due: &due}))
+		assert.Equal(t, []string{"POST /tasks/6X7rM8997g3RQmvh/reopen"}, requests)
+	})
+
+	t.Run("reports a rejected token", func(t *testing.T) {
+		bad := NewTodoist(server.Client(), "wrong", "", "growth")
+		bad.baseURL = server.URL
+		_, err := bad.Tasks(ctx, []string{"6X7rM8997g3RQmvh"})
+		assert.ErrorContains(t, err, TodoistTokenEnvVar)
+	})
+}
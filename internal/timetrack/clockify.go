@@ -0,0 +1,158 @@
+package timetrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultClockifyBaseURL = "https://api.clockify.me/api/v1"
+
+	// clockifyPageSize is how many time entries are read per request
+	clockifyPageSize = 200
+)
+
+// Clockify reads time entries from Clockify
+type Clockify struct {
+	client    *http.Client
+	baseURL   string
+	token     string
+	workspace string
+}
+
+// NewClockify creates a provider for Clockify with an API key, reading the
+// workspace with the ID, or the user's active workspace when it is empty
+func NewClockify(client *http.Client, token, workspace string) *Clockify {
+	return &Clockify{client: client, baseURL: defaultClockifyBaseURL, token: token, workspace: workspace}
+}
+
+func (c *Clockify) Name() string {
+	return "clockify"
+}
+
+type clockifyEntry struct {
+	Description string `json:"description"`
+	Project     *struct {
+		Name string `json:"name"`
+	} `json:"project"`
+	Tags []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	TimeInterval struct {
+		Start time.Time  `json:"start"`
+		End   *time.Time `json:"end"`
+	} `json:"timeInterval"`
+}
+
+func (c *Clockify) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Api-Key", c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach Clockify: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("clockify rejected the API key in %s (status %d)", ClockifyTokenEnvVar, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("clockify request %s failed: status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse Clockify response: %w", err)
+	}
+	return nil
+}
+
+// Entries lists the user's time entries page by page
+func (c *Clockify) Entries(ctx context.Context, from, to time.Time, project string) ([]Entry, error) {
+	var user struct {
+		ID              string `json:"id"`
+		ActiveWorkspace string `json:"activeWorkspace"`
+	}
+	if err := c.get(ctx, "/user", &user); err != nil {
+		return nil, err
+	}
+	workspace := c.workspace
+	if workspace == "" {
+		workspace = user.ActiveWorkspace
+	}
+
+	params := url.Values{}
+	params.Set("start", from.UTC().Format("2006-01-02T15:04:05Z"))
+	params.Set("end", to.UTC().Format("2006-01-02T15:04:05Z"))
+	params.Set("hydrated", "true")
+	params.Set("page-size", strconv.Itoa(clockifyPageSize))
+	if project != "" {
+		id, err := c.projectID(ctx, workspace, project)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("project", id)
+	}
+
+	var entries []Entry
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		var raw []clockifyEntry
+		path := "/workspaces/" + url.PathEscape(workspace) + "/user/" + url.PathEscape(user.ID) + "/time-entries?" + params.Encode()
+		if err := c.get(ctx, path, &raw); err != nil {
+			return nil, err
+		}
+
+		for _, item := range raw {
+			if item.TimeInterval.End == nil {
+				continue
+			}
+			entry := Entry{
+				Start:       item.TimeInterval.Start.Local(),
+				Hours:       item.TimeInterval.End.Sub(item.TimeInterval.Start).Hours(),
+				Description: item.Description,
+			}
+			if item.Project != nil {
+				entry.Project = item.Project.Name
+			}
+			for _, tag := range item.Tags {
+				entry.Tags = append(entry.Tags, tag.Name)
+			}
+			if entry.Hours > 0 {
+				entries = append(entries, entry)
+			}
+		}
+		if len(raw) < clockifyPageSize {
+			return entries, nil
+		}
+	}
+}
+
+// projectID looks up a project by name
+func (c *Clockify) projectID(ctx context.Context, workspace, name string) (string, error) {
+	var projects []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	path := "/workspaces/" + url.PathEscape(workspace) + "/projects?name=" + url.QueryEscape(name)
+	if err := c.get(ctx, path, &projects); err != nil {
+		return "", err
+	}
+	for _, p := range projects {
+		if strings.EqualFold(p.Name, name) {
+			return p.ID, nil
+		}
+	}
+	return "", fmt.Errorf("clockify project '%s' not found", name)
+}
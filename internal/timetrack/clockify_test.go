@@ -0,0 +1,85 @@
+package timetrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockify_Entries(t *testing.T) {
+	var gotProject, gotPage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/user":
+			fmt.Fprint(w, `{"id": "u1", "activeWorkspace": "ws1"}`)
+		case "/workspaces/ws1/projects":
+			fmt.Fprint(w, `[{"id": "p1", "name": "Learning Go"}, {"id": "p2", "name": "Learning"}]`)
+		case "/workspaces/ws1/user/u1/time-entries":
+			gotProject = r.URL.Query().Get("project")
+			gotPage = r.URL.Query().Get("page")
+			fmt.Fprint(w, `[
+				{"description": "Go course", "project": {"name": "Learning"}, "tags": [{"name": "go"}, {"name": "backend"}],
+				 "timeInterval": {"start": "2025-03-03T18:00:00Z", "end": "2025-03-03T19:30:00Z"}},
+				{"description": "Running", "timeInterval": {"start": "2025-03-04T18:00:00Z", "end": null}}
+			]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("reads finished entries of the project", func(t *testing.T) {
+		provider := NewClockify(server.Client(), "secret", "")
+		provider.baseURL = server.URL
+
+		entries, err := provider.Entries(context.Background(), from, to, "learning")
+
+		require.NoError(t, err)
+		assert.Equal(t, "p2", gotProject)
+		assert.Equal(t, "1", gotPage)
+		require.Len(t, entries, 1)
+		assert.Equal(t, 1.5, entries[0].Hours)
+		assert.Equal(t, "Learning", entries[0].Project)
+		assert.Equal(t, []string{"go", "backend"}, entries[0].Tags)
+	})
+
+	t.Run("uses the configured workspace", func(t *testing.T) {
+		provider := NewClockify(server.Client(), "secret", "ws2")
+		provider.baseURL = server.URL
+
+		_, err := provider.Entries(context.Background(), from, to, "")
+
+		assert.ErrorContains(t, err, "status 404")
+	})
+
+	t.Run("reports unknown projects", func(t *testing.T) {
+		provider := NewClockify(server.Client(), "secret", "")
+		provider.baseURL = server.URL
+
+		_, err := provider.Entries(context.Background(), from, to, "Hobby")
+
+		assert.ErrorContains(t, err, "project 'Hobby' not found")
+	})
+
+	t.Run("reports rejected keys", func(t *testing.T) {
+		provider := NewClockify(server.Client(), "wrong", "")
+		provider.baseURL = server.URL
+
+		_, err := provider.Entries(context.Background(), from, to, "")
+
+		assert.ErrorContains(t, err, ClockifyTokenEnvVar)
+	})
+}
@@ -0,0 +1,71 @@
+// Package timetrack reads time entries from a time tracker (Toggl Track or
+// Clockify), so tracked study time can fill progress logs without entering
+// it twice.
+package timetrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Environment variables holding the API tokens
+const (
+	TogglTokenEnvVar    = "GROWTH_TOGGL_TOKEN"
+	ClockifyTokenEnvVar = "GROWTH_CLOCKIFY_TOKEN"
+)
+
+// maxResponseSize limits how much of an API response is read
+const maxResponseSize = 10 << 20
+
+// Entry is a finished time entry
+type Entry struct {
+	Start       time.Time
+	Hours       float64
+	Description string
+	Project     string
+	Tags        []string
+}
+
+// Provider reads time entries from one time tracker
+type Provider interface {
+	Name() string
+	// Entries returns the finished entries started between from and to, in
+	// the named project or in all projects when project is empty
+	Entries(ctx context.Context, from, to time.Time, project string) ([]Entry, error)
+}
+
+// Config selects the time tracker
+type Config struct {
+	Provider  string // toggl or clockify
+	Workspace string // Clockify workspace ID, defaults to the user's active workspace
+}
+
+// Names lists the supported time trackers
+var Names = []string{"toggl", "clockify"}
+
+// NewProvider creates the provider of the configured time tracker, reading
+// its API token from GROWTH_TOGGL_TOKEN or GROWTH_CLOCKIFY_TOKEN
+func NewProvider(cfg Config, client *http.Client) (Provider, error) {
+	switch strings.ToLower(cfg.Provider) {
+	case "toggl":
+		token := os.Getenv(TogglTokenEnvVar)
+		if token == "" {
+			return nil, fmt.Errorf("toggl needs an API token: set %s", TogglTokenEnvVar)
+		}
+		return NewToggl(client, token), nil
+	case "clockify":
+		token := os.Getenv(ClockifyTokenEnvVar)
+		if token == "" {
+			return nil, fmt.Errorf("clockify needs an API key: set %s", ClockifyTokenEnvVar)
+		}
+		return NewClockify(client, token, cfg.Workspace), nil
+	case "":
+		return nil, fmt.Errorf("no time tracker configured: use --provider or set time.provider to one of: %s", strings.Join(Names, ", "))
+	default:
+		return nil, fmt.Errorf("unsupported time tracker '%s' (supported: %s)", cfg.Provider, strings.Join(Names, ", "))
+	}
+}
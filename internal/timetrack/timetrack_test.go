@@ -0,0 +1,27 @@
+package timetrack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Setenv(TogglTokenEnvVar, "toggl-token")
+	t.Setenv(ClockifyTokenEnvVar, "")
+
+	provider, err := NewProvider(Config{Provider: "Toggl"}, http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, "toggl-token", provider.(*Toggl).token)
+
+	_, err = NewProvider(Config{Provider: "clockify"}, http.DefaultClient)
+	assert.ErrorContains(t, err, ClockifyTokenEnvVar)
+
+	_, err = NewProvider(Config{}, http.DefaultClient)
+	assert.ErrorContains(t, err, "time.provider")
+
+	_, err = NewProvider(Config{Provider: "harvest"}, http.DefaultClient)
+	assert.ErrorContains(t, err, "unsupported time tracker")
+}
@@ -0,0 +1,116 @@
+package timetrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultTogglBaseURL = "https://api.track.toggl.com/api/v9"
+
+// Toggl reads time entries from Toggl Track
+type Toggl struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewToggl creates a provider for Toggl Track with an API token
+func NewToggl(client *http.Client, token string) *Toggl {
+	return &Toggl{client: client, baseURL: defaultTogglBaseURL, token: token}
+}
+
+func (t *Toggl) Name() string {
+	return "toggl"
+}
+
+type togglEntry struct {
+	Start       time.Time `json:"start"`
+	Duration    int64     `json:"duration"` // seconds, negative while running
+	Description string    `json:"description"`
+	ProjectID   *int64    `json:"project_id"`
+	Tags        []string  `json:"tags"`
+}
+
+type togglProject struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func (t *Toggl) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(t.token, "api_token")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach Toggl: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("toggl rejected the API token in %s (status %d)", TogglTokenEnvVar, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("toggl request %s failed: status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse Toggl response: %w", err)
+	}
+	return nil
+}
+
+// Entries lists the user's time entries, naming their projects
+func (t *Toggl) Entries(ctx context.Context, from, to time.Time, project string) ([]Entry, error) {
+	var projects []togglProject
+	if err := t.get(ctx, "/me/projects?include_archived=true", &projects); err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(projects))
+	found := project == ""
+	for _, p := range projects {
+		names[p.ID] = p.Name
+		found = found || strings.EqualFold(p.Name, project)
+	}
+	if !found {
+		return nil, fmt.Errorf("toggl project '%s' not found", project)
+	}
+
+	params := url.Values{}
+	params.Set("start_date", from.UTC().Format(time.RFC3339))
+	params.Set("end_date", to.UTC().Format(time.RFC3339))
+	var raw []togglEntry
+	if err := t.get(ctx, "/me/time_entries?"+params.Encode(), &raw); err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, item := range raw {
+		if item.Duration <= 0 {
+			continue
+		}
+		entry := Entry{
+			Start:       item.Start.Local(),
+			Hours:       float64(item.Duration) / 3600,
+			Description: item.Description,
+			Tags:        item.Tags,
+		}
+		if item.ProjectID != nil {
+			entry.Project = names[*item.ProjectID]
+		}
+		if project != "" && !strings.EqualFold(entry.Project, project) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,83 @@
+package timetrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToggl_Entries(t *testing.T) {
+	var gotStart string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, _ := r.BasicAuth(); user != "secret" || pass != "api_token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/me/projects":
+			fmt.Fprint(w, `[{"id": 7, "name": "Learning"}, {"id": 8, "name": "Work"}]`)
+		case "/me/time_entries":
+			gotStart = r.URL.Query().Get("start_date")
+			fmt.Fprint(w, `[
+				{"start": "2025-03-03T18:00:00Z", "duration": 5400, "description": "Go course", "project_id": 7, "tags": ["go"]},
+				{"start": "2025-03-04T09:00:00Z", "duration": 3600, "description": "Standup", "project_id": 8},
+				{"start": "2025-03-05T18:00:00Z", "duration": -1741194000, "description": "Running", "project_id": 7}
+			]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("reads finished entries of the project", func(t *testing.T) {
+		provider := NewToggl(server.Client(), "secret")
+		provider.baseURL = server.URL
+
+		entries, err := provider.Entries(context.Background(), from, to, "learning")
+
+		require.NoError(t, err)
+		assert.Equal(t, "2025-03-03T00:00:00Z", gotStart)
+		require.Len(t, entries, 1)
+		assert.Equal(t, 1.5, entries[0].Hours)
+		assert.Equal(t, "Learning", entries[0].Project)
+		assert.Equal(t, []string{"go"}, entries[0].Tags)
+		assert.True(t, entries[0].Start.Equal(time.Date(2025, 3, 3, 18, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("reads all projects", func(t *testing.T) {
+		provider := NewToggl(server.Client(), "secret")
+		provider.baseURL = server.URL
+
+		entries, err := provider.Entries(context.Background(), from, to, "")
+
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("reports unknown projects", func(t *testing.T) {
+		provider := NewToggl(server.Client(), "secret")
+		provider.baseURL = server.URL
+
+		_, err := provider.Entries(context.Background(), from, to, "Hobby")
+
+		assert.ErrorContains(t, err, "project 'Hobby' not found")
+	})
+
+	t.Run("reports rejected tokens", func(t *testing.T) {
+		provider := NewToggl(server.Client(), "wrong")
+		provider.baseURL = server.URL
+
+		_, err := provider.Entries(context.Background(), from, to, "")
+
+		assert.ErrorContains(t, err, TogglTokenEnvVar)
+	})
+}
@@ -0,0 +1,112 @@
+// Package version describes the running growth binary and the repository
+// schema it supports.
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// Build metadata, set at build time with
+// -ldflags "-X github.com/illenko/growth.md/internal/version.Commit=..."
+var (
+	Version   = "0.1.0-alpha"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// SchemaVersion is the newest repository schema this binary understands. It
+// is written to the version field of .growth/config.yml.
+const SchemaVersion = "1.0"
+
+// Info is the build metadata of the running binary
+type Info struct {
+	Version       string `json:"version" yaml:"version"`
+	Commit        string `json:"commit,omitempty" yaml:"commit,omitempty"`
+	BuildDate     string `json:"buildDate,omitempty" yaml:"buildDate,omitempty"`
+	GoVersion     string `json:"goVersion" yaml:"goVersion"`
+	Platform      string `json:"platform" yaml:"platform"`
+	SchemaVersion string `json:"schemaVersion" yaml:"schemaVersion"`
+}
+
+// Get returns the build metadata, falling back to the VCS details Go embeds
+// in the binary when they were not set with -ldflags
+func Get() Info {
+	info := Info{
+		Version:       Version,
+		Commit:        Commit,
+		BuildDate:     BuildDate,
+		GoVersion:     runtime.Version(),
+		Platform:      runtime.GOOS + "/" + runtime.GOARCH,
+		SchemaVersion: SchemaVersion,
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildDate == "":
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+
+	if len(info.Commit) > 12 {
+		info.Commit = info.Commit[:12]
+	}
+
+	return info
+}
+
+// CompareSchema compares two major.minor schema versions, returning -1, 0 or
+// 1 when a is older than, the same as, or newer than b
+func CompareSchema(a, b string) (int, error) {
+	pa, err := parseSchema(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parseSchema(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1, nil
+		case pa[i] > pb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// IsSupported reports whether this binary understands a repository with the
+// given schema version. Unparseable versions are treated as supported.
+func IsSupported(schema string) bool {
+	cmp, err := CompareSchema(schema, SchemaVersion)
+	return err != nil || cmp <= 0
+}
+
+func parseSchema(v string) ([2]int, error) {
+	var parts [2]int
+
+	major, minor, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".")
+	if minor == "" {
+		minor = "0"
+	}
+
+	for i, s := range []string{major, minor} {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid schema version %q", v)
+		}
+		parts[i] = n
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,51 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareSchema(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1", "1.0", 0},
+		{"1.0", "1.1", -1},
+		{"1.10", "1.9", 1},
+		{"2.0", "1.9", 1},
+		{"v1.2", "1.2", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			cmp, err := CompareSchema(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cmp)
+		})
+	}
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := CompareSchema("one", "1.0")
+		assert.Error(t, err)
+	})
+}
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported(SchemaVersion))
+	assert.True(t, IsSupported("0.9"))
+	assert.False(t, IsSupported("99.0"))
+	assert.True(t, IsSupported("garbage"), "unparseable versions do not block startup")
+}
+
+func TestGet(t *testing.T) {
+	info := Get()
+
+	assert.Equal(t, Version, info.Version)
+	assert.Equal(t, SchemaVersion, info.SchemaVersion)
+	assert.NotEmpty(t, info.GoVersion)
+	assert.NotEmpty(t, info.Platform)
+}